@@ -4,7 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
-	"reflect"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -12,17 +12,34 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/hashing/factory"
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/api/websocket"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
-	"gopkg.in/go-playground/validator.v8"
 )
 
-var log = logger.GetOrCreate("api")
+var log = common.NewRequestIDLogger(logger.GetOrCreate("api"))
+
+const (
+	adminGroupPath   = "/admin"
+	webSocketRPCPath = "/ws"
+)
+
+// reorgAffectedGroupPaths holds the groups serving blocks, whose responses report the recently detected chain reorgs
+var reorgAffectedGroupPaths = map[string]struct{}{
+	"/block":      {},
+	"/blocks":     {},
+	"/hyperblock": {},
+}
 
 type validatorInput struct {
 	Name      string
@@ -35,20 +52,41 @@ func CreateServer(
 	port int,
 	apiLoggingConfig config.ApiLoggingConfig,
 	credentialsConfig config.CredentialsConfig,
+	trustedProxiesConfig config.TrustedProxiesConfig,
+	observerHeadersConfig config.ObserverHeadersConfig,
+	webSocketRPCConfig config.WebSocketRPCConfig,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
+	responseSigner middleware.ResponseSigner,
+	loadShedder middleware.LoadShedder,
+	panicReporter middleware.PanicReporter,
+	featureFlags middleware.FeatureFlags,
+	reorgNotifier middleware.ReorgNotifier,
+	clientsUsage middleware.MiddlewareProcessor,
 	rateLimitTimeWindowInSeconds int,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
 ) (*http.Server, error) {
-	ws := gin.Default()
-	ws.Use(cors.Default())
+	// the default gin recovery is replaced by the recovery middleware registered along with the routes
+	ws := gin.New()
+	ws.Use(gin.Logger())
+	ws.Use(cors.New(createCorsConfig()))
+
+	err := configureTrustedProxies(ws, trustedProxiesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerValidators()
+	if err != nil {
+		return nil, err
+	}
 
-	err := registerValidators()
+	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, observerHeadersConfig.ForwardedClientHeaders, statusMetricsExtractor, responseSigner, loadShedder, panicReporter, featureFlags, reorgNotifier, clientsUsage, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
 	if err != nil {
 		return nil, err
 	}
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
+	err = registerWebSocketRPC(ws, webSocketRPCConfig, credentialsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +99,51 @@ func CreateServer(
 	return httpServer, nil
 }
 
+// configureTrustedProxies sets the reverse proxies allowed to forward the client IP. If none is configured, the
+// forwarding headers are ignored and the client IP is always the remote address of the connection
+func configureTrustedProxies(ws *gin.Engine, trustedProxiesConfig config.TrustedProxiesConfig) error {
+	if len(trustedProxiesConfig.CIDRs) == 0 {
+		ws.ForwardedByClientIP = false
+		return ws.SetTrustedProxies(nil)
+	}
+
+	err := ws.SetTrustedProxies(trustedProxiesConfig.CIDRs)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTrustedProxies, err.Error())
+	}
+
+	ws.ForwardedByClientIP = true
+	if len(trustedProxiesConfig.Headers) > 0 {
+		ws.RemoteIPHeaders = trustedProxiesConfig.Headers
+	}
+
+	return nil
+}
+
+// createCorsConfig allows all the origins, as the default gin config does, and lets the browsers send and read the
+// request identifier and priority headers, as well as read the response signature, the deprecation and the chain reorg
+// headers
+func createCorsConfig() cors.Config {
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowAllOrigins = true
+	corsConfig.AddAllowHeaders(common.RequestIDHeader, middleware.PriorityHeader)
+	corsConfig.AddExposeHeaders(
+		common.RequestIDHeader,
+		middleware.ResponseSignatureHeader,
+		middleware.ResponseSignatureTimestampHeader,
+		middleware.ResponseSignerHeader,
+		middleware.DeprecationHeader,
+		middleware.SunsetHeader,
+		middleware.LinkHeader,
+		middleware.ChainReorgDetectedHeader,
+	)
+
+	return corsConfig
+}
+
 func registerValidators() error {
+	shared.RegisterBodyValidators()
+
 	validators := []validatorInput{
 		{Name: "skValidator", Validator: skValidator},
 	}
@@ -81,7 +163,14 @@ func registerRoutes(
 	versionsRegistry data.VersionsRegistryHandler,
 	apiLoggingConfig config.ApiLoggingConfig,
 	credentialsConfig config.CredentialsConfig,
+	forwardedClientHeaders []string,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
+	responseSigner middleware.ResponseSigner,
+	loadShedder middleware.LoadShedder,
+	panicReporter middleware.PanicReporter,
+	featureFlags middleware.FeatureFlags,
+	reorgNotifier middleware.ReorgNotifier,
+	clientsUsage middleware.MiddlewareProcessor,
 	rateLimitTimeWindowInSeconds int,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
@@ -95,6 +184,13 @@ func registerRoutes(
 		ws.Use(static.ServeRoot("/", "config/swagger"))
 	}
 
+	// registered first, so the request identifier is available to all the other middlewares and in their logs
+	ws.Use(middleware.NewRequestIDMiddleware().MiddlewareHandlerFunc())
+	if len(forwardedClientHeaders) > 0 {
+		ws.Use(middleware.NewForwardedHeadersMiddleware(forwardedClientHeaders).MiddlewareHandlerFunc())
+	}
+	ws.Use(middleware.NewRecoveryMiddleware(panicReporter).MiddlewareHandlerFunc())
+
 	if apiLoggingConfig.LoggingEnabled {
 		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
 		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
@@ -115,18 +211,57 @@ func registerRoutes(
 		}
 		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
 		versionGroup := ws.Group(version)
+		if !check.IfNil(clientsUsage) {
+			versionGroup.Use(clientsUsage.MiddlewareHandlerFunc())
+		}
+		if !check.IfNil(versionData.Serializer) {
+			versionGroup.Use(shared.SerializerMiddleware(versionData.Serializer))
+		}
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
+			if !isGroupEnabled(path, featureFlags) {
+				log.Debug("group is disabled by its feature flag", "version", version, "path", path)
+				continue
+			}
+
 			subGroup := versionGroup.Group(path)
+			err = applyDeprecation(subGroup, path, versionData.ApiConfig, statusMetricsExtractor)
+			if err != nil {
+				return err
+			}
+			applyFeatureFlags(subGroup, path, featureFlags)
+			applyLoadShedding(subGroup, path, versionData.ApiConfig, loadShedder)
+			err = applyIPFilter(subGroup, path, versionData.ApiConfig)
+			if err != nil {
+				return err
+			}
+			applyCacheControl(subGroup, path, versionData.ApiConfig)
+			applyResponseSigning(subGroup, path, versionData.ApiConfig, responseSigner)
+			err = applyReorgNotification(subGroup, path, reorgNotifier)
+			if err != nil {
+				return err
+			}
+
+			var authenticationFunc gin.HandlerFunc
+			authenticationFunc, err = getAuthenticationFuncForGroup(path, credentialsConfig)
+			if err != nil {
+				return err
+			}
+
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				authenticationFunc,
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
 			)
 		}
 	}
 
+	err = registerProbes(ws, versionsMap, metricsMiddleware.MiddlewareHandlerFunc())
+	if err != nil {
+		return err
+	}
+
 	if isProfileModeActivated {
 		pprof.Register(ws)
 	}
@@ -134,17 +269,214 @@ func registerRoutes(
 	return nil
 }
 
+// registerProbes registers the liveness and readiness probes at the root of the server, outside the API packages, so
+// that they are never secured, rate limited or shed
+func registerProbes(ws *gin.Engine, versionsMap map[string]*data.VersionData, statusMetricsExtractor gin.HandlerFunc) error {
+	defaultVersion, ok := versionsMap[""]
+	if !ok {
+		return nil
+	}
+
+	probesGroup, err := groups.NewProbesGroup(defaultVersion.Facade)
+	if err != nil {
+		return err
+	}
+
+	// the routes of the root group are looked up in the API package with an empty name
+	probesRoutesConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"": {
+				Routes: []data.RouteConfig{
+					{Name: "/live", Open: true},
+					{Name: "/ready", Open: true},
+				},
+			},
+		},
+	}
+	probesGroup.RegisterRoutes(&ws.RouterGroup, probesRoutesConfig, nil, nil, statusMetricsExtractor)
+
+	return nil
+}
+
+// registerWebSocketRPC registers the WebSocket endpoint at the root of the server, if enabled. The requests received over
+// the WebSocket connections are dispatched to the server itself, so that they go through the same routes and middlewares
+// as the HTTP requests
+func registerWebSocketRPC(ws *gin.Engine, webSocketRPCConfig config.WebSocketRPCConfig, credentialsConfig config.CredentialsConfig) error {
+	if !webSocketRPCConfig.Enabled {
+		return nil
+	}
+
+	rpcHandler, err := websocket.NewRPCHandler(websocket.ArgsRPCHandler{
+		Dispatcher:    ws,
+		Authenticator: getAuthenticationFunc(credentialsConfig),
+		Path:          webSocketRPCPath,
+		Config:        webSocketRPCConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	ws.GET(webSocketRPCPath, rpcHandler.HandleConnection)
+	log.Info("WebSocket RPC endpoint enabled", "path", webSocketRPCPath)
+
+	return nil
+}
+
+// applyIPFilter restricts the access to the group's routes based on the allowed and denied networks from the API config
+func applyIPFilter(group *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) error {
+	packageConfig, ok := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	if !ok {
+		return nil
+	}
+
+	ipFilter, err := middleware.NewIPFilter(group.BasePath(), packageConfig)
+	if err != nil {
+		return fmt.Errorf("%w in package %s", err, path)
+	}
+	if ipFilter.HasRules() {
+		group.Use(ipFilter.MiddlewareHandlerFunc())
+	}
+
+	return nil
+}
+
+// applyDeprecation marks the responses of the group's deprecated routes and rejects their requests after the sunset
+// date, based on the API config. It is applied first, so that the removed routes are rejected before any other check
+func applyDeprecation(group *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig, recorder middleware.DeprecatedRequestsRecorder) error {
+	packageConfig, ok := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	if !ok {
+		return nil
+	}
+
+	deprecation, err := middleware.NewDeprecation(group.BasePath(), packageConfig, recorder)
+	if err != nil {
+		return fmt.Errorf("%w in package %s", err, path)
+	}
+	if deprecation.HasRules() {
+		group.Use(deprecation.MiddlewareHandlerFunc())
+	}
+
+	return nil
+}
+
+// applyCacheControl adds the Cache-Control header on the group's routes based on the cache max age from the API config
+func applyCacheControl(group *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) {
+	packageConfig, ok := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	if !ok {
+		return
+	}
+
+	cacheControl := middleware.NewCacheControl(group.BasePath(), packageConfig)
+	if cacheControl.HasRules() {
+		group.Use(cacheControl.MiddlewareHandlerFunc())
+	}
+}
+
+// isGroupEnabled returns false if the group belongs to a disabled feature, in which case it is not registered at all and
+// its routes answer with 404
+func isGroupEnabled(path string, featureFlags middleware.FeatureFlags) bool {
+	if check.IfNil(featureFlags) {
+		return true
+	}
+
+	return featureFlags.IsGroupEnabled(path)
+}
+
+// applyFeatureFlags rejects with 501 the requests of the group's routes which belong to a disabled feature, if some
+// feature flags are set
+func applyFeatureFlags(group *gin.RouterGroup, path string, featureFlags middleware.FeatureFlags) {
+	if check.IfNil(featureFlags) || !featureFlags.HasDisabledRoutes(path) {
+		return
+	}
+
+	group.Use(featureFlags.MiddlewareHandlerFunc(group.BasePath(), path))
+}
+
+// applyLoadShedding caps the number of requests served at once by the group, together with all the other groups, if
+// a load shedder is set. It is applied on the groups without a config too, as the cap is global
+func applyLoadShedding(group *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig, loadShedder middleware.LoadShedder) {
+	if check.IfNil(loadShedder) {
+		return
+	}
+
+	packageConfig := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	group.Use(loadShedder.GroupHandlerFunc(group.BasePath(), packageConfig))
+}
+
+// applyResponseSigning signs the responses of the group's routes marked as signed in the API config, if a response
+// signer is set
+func applyResponseSigning(group *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig, responseSigner middleware.ResponseSigner) {
+	if check.IfNil(responseSigner) {
+		return
+	}
+	packageConfig, ok := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	if !ok {
+		return
+	}
+
+	responseSigning := middleware.NewResponseSigning(group.BasePath(), packageConfig, responseSigner)
+	if responseSigning.HasRules() {
+		group.Use(responseSigning.MiddlewareHandlerFunc())
+	}
+}
+
+// applyReorgNotification adds the X-Chain-Reorg-Detected header on the responses of the groups serving blocks, if a
+// reorg notifier is set
+func applyReorgNotification(group *gin.RouterGroup, path string, reorgNotifier middleware.ReorgNotifier) error {
+	if check.IfNil(reorgNotifier) {
+		return nil
+	}
+	_, isAffected := reorgAffectedGroupPaths[path]
+	if !isAffected {
+		return nil
+	}
+
+	reorgNotification, err := middleware.NewReorgNotification(reorgNotifier)
+	if err != nil {
+		return err
+	}
+
+	group.Use(reorgNotification.MiddlewareHandlerFunc())
+	return nil
+}
+
+func getAuthenticationFuncForGroup(path string, credentialsConfig config.CredentialsConfig) (gin.HandlerFunc, error) {
+	if path == adminGroupPath {
+		return getAdminAuthenticationFunc(credentialsConfig)
+	}
+
+	return getAuthenticationFunc(credentialsConfig), nil
+}
+
+// getAdminAuthenticationFunc checks the admin API key and, if a signing secret is set, the signature, the timestamp and
+// the nonce of the runtime mutating admin requests, so that they can not be replayed
+func getAdminAuthenticationFunc(credentialsConfig config.CredentialsConfig) (gin.HandlerFunc, error) {
+	apiKeyCheck := middleware.NewApiKeyChecker(credentialsConfig.AdminApiKey).MiddlewareHandlerFunc()
+	if len(credentialsConfig.AdminRequestSigningSecret) == 0 {
+		return apiKeyCheck, nil
+	}
+
+	maxAge := time.Duration(credentialsConfig.AdminRequestMaxAgeSec) * time.Second
+	adminRequestVerifier, err := middleware.NewAdminRequestVerifier(credentialsConfig.AdminRequestSigningSecret, maxAge)
+	if err != nil {
+		return nil, err
+	}
+	requestVerification := adminRequestVerifier.MiddlewareHandlerFunc()
+
+	return func(c *gin.Context) {
+		apiKeyCheck(c)
+		if c.IsAborted() {
+			return
+		}
+
+		requestVerification(c)
+	}, nil
+}
+
 func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
 	if len(credentialsConfig.Credentials) == 0 {
 		return func(c *gin.Context) {
-			c.AbortWithStatusJSON(
-				http.StatusInternalServerError,
-				data.GenericAPIResponse{
-					Data:  nil,
-					Error: "no credentials found on server",
-					Code:  data.ReturnCodeInternalError,
-				},
-			)
+			shared.AbortWith(c, http.StatusInternalServerError, nil, "no credentials found on server", data.ReturnCodeInternalError)
 		}
 	}
 
@@ -162,32 +494,26 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 	}
 
 	authenticationFunction := func(c *gin.Context) {
+		// the requests received over an authenticated WebSocket connection do not hold the credentials
+		_, isAuthenticated := common.GetAuthenticatedUser(c.Request.Context())
+		if isAuthenticated {
+			return
+		}
+
 		user, pass, ok := c.Request.BasicAuth()
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "this endpoint requires Basic Authentication",
-				Code:  data.ReturnCodeRequestError,
-			})
+			shared.AbortWith(c, http.StatusUnauthorized, nil, "this endpoint requires Basic Authentication", data.ReturnCodeRequestError)
 			return
 		}
 
 		userPassword, ok := accounts[user]
 		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "username does not exist",
-				Code:  data.ReturnCodeRequestError,
-			})
+			shared.AbortWith(c, http.StatusUnauthorized, nil, "username does not exist", data.ReturnCodeRequestError)
 			return
 		}
 
 		if userPassword != hex.EncodeToString(hasher.Compute(pass)) {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "invalid password",
-				Code:  data.ReturnCodeRequestError,
-			})
+			shared.AbortWith(c, http.StatusUnauthorized, nil, "invalid password", data.ReturnCodeRequestError)
 			return
 		}
 	}
@@ -219,14 +545,6 @@ func startRateLimiterReset(rateLimiterDuration int, rl middleware.RateLimiterHan
 }
 
 // skValidator validates a secret key from user input for correctness
-func skValidator(
-	_ *validator.Validate,
-	_ reflect.Value,
-	_ reflect.Value,
-	_ reflect.Value,
-	_ reflect.Type,
-	_ reflect.Kind,
-	_ string,
-) bool {
+func skValidator(_ validator.FieldLevel) bool {
 	return true
 }
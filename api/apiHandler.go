@@ -99,21 +99,75 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	adminGroup, err := groups.NewAdminGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	sovereignGroup, err := groups.NewSovereignGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePassthroughGroup, err := groups.NewNodePassthroughGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionsGroup, err := groups.NewCollectionsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlockGroup, err := groups.NewMiniBlockGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensGroup, err := groups.NewTokensGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	graphQLGroup, err := groups.NewGraphQLGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	faucetGroup, err := groups.NewFaucetGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationGroup, err := groups.NewDelegationGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
-		"/actions":     actionsGroup,
-		"/address":     accountsGroup,
-		"/block":       blockGroup,
-		"/blocks":      blocksGroup,
-		"/internal":    internalGroup,
-		"/hyperblock":  hyperBlocksGroup,
-		"/network":     networkGroup,
-		"/node":        nodeGroup,
-		"/status":      statusGroup,
-		"/transaction": transactionsGroup,
-		"/validator":   validatorsGroup,
-		"/vm-values":   vmValuesGroup,
-		"/proof":       proofGroup,
-		"/about":       aboutGroup,
+		"/actions":          actionsGroup,
+		"/address":          accountsGroup,
+		"/block":            blockGroup,
+		"/blocks":           blocksGroup,
+		"/internal":         internalGroup,
+		"/hyperblock":       hyperBlocksGroup,
+		"/network":          networkGroup,
+		"/node":             nodeGroup,
+		"/status":           statusGroup,
+		"/transaction":      transactionsGroup,
+		"/validator":        validatorsGroup,
+		"/vm-values":        vmValuesGroup,
+		"/proof":            proofGroup,
+		"/about":            aboutGroup,
+		"/admin":            adminGroup,
+		"/sovereign":        sovereignGroup,
+		"/node-passthrough": nodePassthroughGroup,
+		"/collections":      collectionsGroup,
+		"/miniblock":        miniBlockGroup,
+		"/tokens":           tokensGroup,
+		"/graphql":          graphQLGroup,
+		"/faucet":           faucetGroup,
+		"/delegation":       delegationGroup,
 	}, nil
 }
 
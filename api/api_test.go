@@ -0,0 +1,113 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getClientIP(t *testing.T, trustedProxiesConfig config.TrustedProxiesConfig, remoteAddr string, headers map[string]string) string {
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	err := configureTrustedProxies(ws, trustedProxiesConfig)
+	require.NoError(t, err)
+
+	clientIP := ""
+	ws.GET("/ip", func(c *gin.Context) {
+		clientIP = c.ClientIP()
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/ip", nil)
+	req.RemoteAddr = remoteAddr
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+
+	return clientIP
+}
+
+func TestConfigureTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	forwardedHeaders := map[string]string{"X-Forwarded-For": "1.2.3.4", "X-Real-IP": "5.6.7.8"}
+	t.Run("invalid CIDR should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := configureTrustedProxies(gin.New(), config.TrustedProxiesConfig{CIDRs: []string{"not a cidr"}})
+		assert.True(t, errors.Is(err, ErrInvalidTrustedProxies))
+	})
+	t.Run("no trusted proxies should ignore the forwarding headers", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP := getClientIP(t, config.TrustedProxiesConfig{}, "10.0.0.1:1234", forwardedHeaders)
+		assert.Equal(t, "10.0.0.1", clientIP)
+	})
+	t.Run("request from an untrusted proxy should ignore the forwarding headers", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.TrustedProxiesConfig{CIDRs: []string{"192.168.0.0/16"}}
+		clientIP := getClientIP(t, cfg, "10.0.0.1:1234", forwardedHeaders)
+		assert.Equal(t, "10.0.0.1", clientIP)
+	})
+	t.Run("request from a trusted proxy should use the forwarding headers", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.TrustedProxiesConfig{CIDRs: []string{"10.0.0.0/8"}}
+		clientIP := getClientIP(t, cfg, "10.0.0.1:1234", forwardedHeaders)
+		assert.Equal(t, "1.2.3.4", clientIP)
+	})
+	t.Run("configured headers should be used", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.TrustedProxiesConfig{CIDRs: []string{"10.0.0.1"}, Headers: []string{"X-Real-IP"}}
+		clientIP := getClientIP(t, cfg, "10.0.0.1:1234", forwardedHeaders)
+		assert.Equal(t, "5.6.7.8", clientIP)
+	})
+}
+
+func TestIsGroupEnabled(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isGroupEnabled("/admin", nil))
+
+	featureFlags, err := middleware.NewFeatureFlags(map[string]bool{"admin": false})
+	require.NoError(t, err)
+	assert.False(t, isGroupEnabled("/admin", featureFlags))
+	assert.True(t, isGroupEnabled("/address", featureFlags))
+}
+
+func TestRegisterWebSocketRPC(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	cfg := config.WebSocketRPCConfig{
+		Enabled:                 false,
+		MaxConcurrentOperations: 1,
+		OperationTimeoutSec:     1,
+		MaxMessageSizeBytes:     1024,
+		PingIntervalSec:         1,
+	}
+
+	ws := gin.New()
+	err := registerWebSocketRPC(ws, cfg, config.CredentialsConfig{})
+	require.NoError(t, err)
+	assert.Empty(t, ws.Routes())
+
+	cfg.Enabled = true
+	err = registerWebSocketRPC(ws, cfg, config.CredentialsConfig{})
+	require.NoError(t, err)
+	require.Len(t, ws.Routes(), 1)
+	assert.Equal(t, webSocketRPCPath, ws.Routes()[0].Path)
+
+	cfg.PingIntervalSec = 0
+	err = registerWebSocketRPC(gin.New(), cfg, config.CredentialsConfig{})
+	assert.Error(t, err)
+}
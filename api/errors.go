@@ -13,3 +13,6 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidTrustedProxies signals that an invalid trusted proxies configuration has been provided
+var ErrInvalidTrustedProxies = errors.New("invalid trusted proxies")
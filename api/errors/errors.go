@@ -14,6 +14,9 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetKeyValuePairs signals an error in getting the key-value pairs for a given address
 var ErrGetKeyValuePairs = errors.New("get key value pairs error")
 
+// ErrGetKeyValuePairsDiff signals an error in getting the key-value pairs diff for a given address
+var ErrGetKeyValuePairsDiff = errors.New("get key value pairs diff error")
+
 // ErrInvalidAddressesArray signals that an invalid input has been provided
 var ErrInvalidAddressesArray = errors.New("invalid addresses array")
 
@@ -86,6 +89,21 @@ var ErrInvalidGuardianSignatureHex = errors.New("invalid guardian signature, cou
 // ErrInvalidGuardianAddress signals a wrong format for receiver address was provided
 var ErrInvalidGuardianAddress = errors.New("invalid guardian address")
 
+// ErrGasLimitAboveMaximum signals that the gas limit of a transaction is above the maximum allowed by the proxy
+var ErrGasLimitAboveMaximum = errors.New("gas limit above the maximum allowed")
+
+// ErrValueAboveMaximum signals that the value of a transaction is above the maximum allowed by the proxy
+var ErrValueAboveMaximum = errors.New("value above the maximum allowed")
+
+// ErrDataFieldTooLarge signals that the data field of a transaction is larger than allowed by the proxy
+var ErrDataFieldTooLarge = errors.New("data field too large")
+
+// ErrReceiverNotAllowed signals that the receiver of a transaction is not allowed by the proxy
+var ErrReceiverNotAllowed = errors.New("receiver not allowed")
+
+// ErrInvalidTxValue signals that the value of a transaction is not a valid number
+var ErrInvalidTxValue = errors.New("invalid transaction value")
+
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
@@ -116,6 +134,9 @@ var ErrInvalidBlockNonceParam = errors.New("invalid block nonce parameter")
 // ErrInvalidBlockHashParam signals that an invalid block's hash parameter has been provided
 var ErrInvalidBlockHashParam = errors.New("invalid block hash parameter")
 
+// ErrInvalidMiniBlockHashParam signals that an invalid miniblock's hash parameter has been provided
+var ErrInvalidMiniBlockHashParam = errors.New("invalid miniblock hash parameter")
+
 // ErrInvalidShardIDParam signals that an invalid shard ID parameter has been provided
 var ErrInvalidShardIDParam = errors.New("invalid shard ID parameter")
 
@@ -143,16 +164,83 @@ var ErrInvalidFields = errors.New("invalid fields")
 // ErrOperationNotAllowed signals that the operation is not allowed
 var ErrOperationNotAllowed = errors.New("operation not allowed")
 
+// ErrTxPoolChunksRequireShardID signals that a chunk of the transactions pool was requested without a shard
+var ErrTxPoolChunksRequireShardID = errors.New("the transactions pool chunks can only be fetched for a shard")
+
+// ErrTxPoolChunksNotAvailableForSender signals that the transactions pool of a sender was requested in chunks
+var ErrTxPoolChunksNotAvailableForSender = errors.New("the transactions pool of a sender cannot be fetched in chunks")
+
+// ErrTxPoolAllShardsRequireSender signals that the transactions pools of all shards were requested without a sender
+var ErrTxPoolAllShardsRequireSender = errors.New("the transactions pools of all shards can only be merged for a sender")
+
+// ErrTxPoolAllShardsNotAvailableForNonces signals that the last nonce or the nonce gaps of a sender were requested
+// from the transactions pools of all shards
+var ErrTxPoolAllShardsNotAvailableForNonces = errors.New("the last nonce and the nonce gaps of a sender cannot be fetched from all shards")
+
+// ErrInvalidTxPoolChunkSize signals that the requested size of the transactions pool chunks is too big
+var ErrInvalidTxPoolChunkSize = errors.New("invalid transactions pool chunk size")
+
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
-	Message string
-	Reason  string
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
 }
 
 // Error returns the string message of the ErrInvalidTxFields custom error struct
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// ErrGetStuckTransactions signals an error in fetching the stuck transactions of an address
+var ErrGetStuckTransactions = errors.New("cannot get stuck transactions")
+
+// ErrEmptyContractsList signals that no contract address was provided for a multi-contract query
+var ErrEmptyContractsList = errors.New("empty contracts list")
+
+// ErrHyperblockNonceWithBlockCoordinates signals that both a hyperblock nonce and block coordinates were provided
+var ErrHyperblockNonceWithBlockCoordinates = errors.New("the hyperblock nonce cannot be provided along with the block nonce or hash")
+
+// ErrTooManyContracts signals that too many contract addresses were provided for a multi-contract query
+var ErrTooManyContracts = errors.New("too many contracts")
+
+// ErrEmptySendersList signals that no sender was provided for a transactions pool query
+var ErrEmptySendersList = errors.New("empty senders list")
+
+// ErrTooManySenders signals that too many senders were provided for a transactions pool query
+var ErrTooManySenders = errors.New("too many senders")
+
+// ErrGetCollections signals an error in fetching the collections of an address
+var ErrGetCollections = errors.New("cannot get collections")
+
+// ErrGetIssuedTokens signals an error in fetching the tokens issued by an address
+var ErrGetIssuedTokens = errors.New("cannot get issued tokens")
+
+// ErrGetActivitySummary signals an error in fetching the activity summary of an address
+var ErrGetActivitySummary = errors.New("cannot get activity summary")
+
+// ErrGetTokenPrice signals an error in fetching the price of a token
+var ErrGetTokenPrice = errors.New("cannot get token price")
+
+// ErrGetCollection signals an error in fetching the details of a collection
+var ErrGetCollection = errors.New("cannot get collection")
+
+// ErrEmptyCollection signals that an empty collection identifier has been provided
+var ErrEmptyCollection = errors.New("empty collection identifier")
+
+// ErrGetTokenHolders signals an error in fetching the holders of a token
+var ErrGetTokenHolders = errors.New("cannot get token holders")
+
+// ErrReadOnlyMode signals that the transactions cannot be sent because the proxy runs in read-only mode
+var ErrReadOnlyMode = errors.New("the proxy runs in read-only mode, transactions cannot be sent")
+
+// ErrBlockNotFinal signals that the requested block is not deep enough below the chain tip to be reported as final
+var ErrBlockNotFinal = errors.New("block is not final yet")
+
+// ErrTooManyAddresses signals that too many addresses were provided for a bulk request
+var ErrTooManyAddresses = errors.New("too many addresses")
+
+// ErrEmptyDataField signals that an empty transaction data field has been provided for decoding
+var ErrEmptyDataField = errors.New("empty data field")
@@ -0,0 +1,21 @@
+package graphql
+
+import "errors"
+
+// ErrSyntax signals that the query document could not be parsed
+var ErrSyntax = errors.New("syntax error")
+
+// ErrUnsupportedOperation signals that the query document contains a construct which is not supported
+var ErrUnsupportedOperation = errors.New("unsupported operation")
+
+// ErrOperationNotFound signals that the operation to be executed could not be determined
+var ErrOperationNotFound = errors.New("operation not found")
+
+// ErrInvalidVariable signals that a variable was not provided or was not declared
+var ErrInvalidVariable = errors.New("invalid variable")
+
+// ErrInvalidArgument signals that a field argument is unknown or has an invalid value
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrInvalidSelection signals that a selection does not match the schema
+var ErrInvalidSelection = errors.New("invalid selection")
@@ -0,0 +1,364 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const typeNameField = "__typename"
+
+// Request holds a query document along with its variables and the name of the operation to be executed
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// Error defines an error of the response along with the path of the field which produced it, if any
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Response defines the result of a request. The data is missing if the request could not be executed at all
+type Response struct {
+	Data   *OrderedObject `json:"data,omitempty"`
+	Errors []*Error       `json:"errors,omitempty"`
+}
+
+// ErrorMessage returns the messages of the errors of the response, joined
+func (r *Response) ErrorMessage() string {
+	messages := make([]string, 0, len(r.Errors))
+	for _, responseError := range r.Errors {
+		messages = append(messages, responseError.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// OrderedObject holds the resolved fields, serialized in the order in which they were selected
+type OrderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedObject() *OrderedObject {
+	return &OrderedObject{
+		values: make(map[string]interface{}),
+	}
+}
+
+func (oo *OrderedObject) set(key string, value interface{}) {
+	_, exists := oo.values[key]
+	if !exists {
+		oo.keys = append(oo.keys, key)
+	}
+	oo.values[key] = value
+}
+
+// Get returns the value of the provided key
+func (oo *OrderedObject) Get(key string) (interface{}, bool) {
+	value, ok := oo.values[key]
+	return value, ok
+}
+
+// MarshalJSON serializes the fields in their selection order
+func (oo *OrderedObject) MarshalJSON() ([]byte, error) {
+	buff := bytes.Buffer{}
+	buff.WriteByte('{')
+	for i, key := range oo.keys {
+		if i > 0 {
+			buff.WriteByte(',')
+		}
+
+		keyBytes, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		valueBytes, err := json.Marshal(oo.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buff.Write(keyBytes)
+		buff.WriteByte(':')
+		buff.Write(valueBytes)
+	}
+	buff.WriteByte('}')
+
+	return buff.Bytes(), nil
+}
+
+type executionContext struct {
+	variables map[string]interface{}
+	errors    []*Error
+}
+
+// Execute parses the query of the request and resolves it against the schema. A failing field is set to null and
+// its error is added to the response, the rest of the fields being resolved as usual
+func Execute(schema *Schema, request Request) *Response {
+	doc, err := parseDocument(request.Query)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	op, err := selectOperation(doc, request.OperationName)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	variables, err := coerceVariables(op, request.Variables)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	execCtx := &executionContext{
+		variables: variables,
+	}
+	data := execCtx.executeSelectionSet(schema.Query, make(map[string]interface{}), op.selectionSet, nil)
+
+	return &Response{
+		Data:   data,
+		Errors: execCtx.errors,
+	}
+}
+
+func newErrorResponse(err error) *Response {
+	return &Response{
+		Errors: []*Error{{Message: err.Error()}},
+	}
+}
+
+func selectOperation(doc *document, operationName string) (*operation, error) {
+	if len(operationName) == 0 {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("%w: the operation name is required for a document with multiple operations", ErrOperationNotFound)
+		}
+
+		return doc.operations[0], nil
+	}
+
+	for _, op := range doc.operations {
+		if op.name == operationName {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrOperationNotFound, operationName)
+}
+
+func coerceVariables(op *operation, provided map[string]interface{}) (map[string]interface{}, error) {
+	variables := make(map[string]interface{})
+	for _, definition := range op.variables {
+		value, ok := provided[definition.name]
+		if !ok && definition.hasDefault {
+			value, ok = definition.defaultValue, true
+		}
+		if definition.isRequired && (!ok || value == nil) {
+			return nil, fmt.Errorf("%w: $%s is required", ErrInvalidVariable, definition.name)
+		}
+
+		variables[definition.name] = value
+	}
+
+	return variables, nil
+}
+
+func (ec *executionContext) executeSelectionSet(
+	objectType *Object,
+	source map[string]interface{},
+	selectionSet []*selection,
+	path []interface{},
+) *OrderedObject {
+	result := newOrderedObject()
+	for _, sel := range selectionSet {
+		fieldPath := appendPath(path, sel.responseKey())
+		if sel.name == typeNameField {
+			result.set(sel.responseKey(), objectType.Name)
+			continue
+		}
+
+		value, err := ec.resolveField(objectType, source, sel, fieldPath)
+		if err != nil {
+			ec.errors = append(ec.errors, &Error{Message: err.Error(), Path: fieldPath})
+			value = nil
+		}
+
+		result.set(sel.responseKey(), value)
+	}
+
+	return result
+}
+
+func (ec *executionContext) resolveField(
+	objectType *Object,
+	source map[string]interface{},
+	sel *selection,
+	path []interface{},
+) (interface{}, error) {
+	field, ok := objectType.Fields[sel.name]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot query field %s on type %s", ErrInvalidSelection, sel.name, objectType.Name)
+	}
+
+	arguments, err := ec.resolveArguments(field, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if field.Resolve != nil {
+		value, err = field.Resolve(ResolveParams{Source: source, Arguments: arguments})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		value = source[sel.name]
+	}
+
+	return ec.completeValue(field, sel, value, path)
+}
+
+func (ec *executionContext) resolveArguments(field *Field, sel *selection) (map[string]interface{}, error) {
+	arguments := make(map[string]interface{}, len(sel.arguments))
+	for name, value := range sel.arguments {
+		if !isArgumentDeclared(field, name) {
+			return nil, fmt.Errorf("%w: unknown argument %s on field %s", ErrInvalidArgument, name, sel.name)
+		}
+
+		resolved, err := ec.substituteVariables(value)
+		if err != nil {
+			return nil, err
+		}
+		arguments[name] = resolved
+	}
+
+	return arguments, nil
+}
+
+func isArgumentDeclared(field *Field, name string) bool {
+	for _, argument := range field.Arguments {
+		if argument == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ec *executionContext) substituteVariables(value interface{}) (interface{}, error) {
+	switch typedValue := value.(type) {
+	case *variableReference:
+		variable, ok := ec.variables[typedValue.name]
+		if !ok {
+			return nil, fmt.Errorf("%w: $%s is not declared", ErrInvalidVariable, typedValue.name)
+		}
+		return variable, nil
+	case []interface{}:
+		values := make([]interface{}, 0, len(typedValue))
+		for _, element := range typedValue {
+			resolved, err := ec.substituteVariables(element)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, resolved)
+		}
+		return values, nil
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(typedValue))
+		for key, element := range typedValue {
+			resolved, err := ec.substituteVariables(element)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = resolved
+		}
+		return values, nil
+	default:
+		return value, nil
+	}
+}
+
+func (ec *executionContext) completeValue(field *Field, sel *selection, value interface{}, path []interface{}) (interface{}, error) {
+	if isNil(value) {
+		return nil, nil
+	}
+
+	if field.Type == nil {
+		if len(sel.selectionSet) > 0 {
+			return nil, fmt.Errorf("%w: field %s does not have sub-fields", ErrInvalidSelection, sel.name)
+		}
+		return value, nil
+	}
+	if len(sel.selectionSet) == 0 {
+		return nil, fmt.Errorf("%w: field %s of type %s requires a selection of sub-fields", ErrInvalidSelection, sel.name, field.Type.Name)
+	}
+
+	generic, err := toGeneric(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typedValue := generic.(type) {
+	case map[string]interface{}:
+		return ec.executeSelectionSet(field.Type, typedValue, sel.selectionSet, path), nil
+	case []interface{}:
+		results := make([]interface{}, 0, len(typedValue))
+		for i, element := range typedValue {
+			object, ok := element.(map[string]interface{})
+			if !ok {
+				results = append(results, nil)
+				continue
+			}
+			results = append(results, ec.executeSelectionSet(field.Type, object, sel.selectionSet, appendPath(path, i)))
+		}
+		return results, nil
+	default:
+		return nil, fmt.Errorf("%w: field %s did not resolve to an object", ErrInvalidSelection, sel.name)
+	}
+}
+
+// toGeneric converts the value into its JSON representation, so that the nested fields can be selected by their JSON names
+func toGeneric(value interface{}) (interface{}, error) {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return value, nil
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var generic interface{}
+	err = decoder.Decode(&generic)
+
+	return generic, err
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return reflected.IsNil()
+	default:
+		return false
+	}
+}
+
+func appendPath(path []interface{}, element interface{}) []interface{} {
+	newPath := make([]interface{}, 0, len(path)+1)
+	newPath = append(newPath, path...)
+
+	return append(newPath, element)
+}
@@ -0,0 +1,254 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccount struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+}
+
+type testTransaction struct {
+	Hash  string `json:"hash"`
+	Value string `json:"value"`
+}
+
+var errAccountNotFound = errors.New("account not found")
+
+func createTestSchema() *graphql.Schema {
+	transactionType := &graphql.Object{
+		Name: "Transaction",
+		Fields: map[string]*graphql.Field{
+			"hash":  {},
+			"value": {},
+		},
+	}
+	accountType := &graphql.Object{
+		Name: "Account",
+		Fields: map[string]*graphql.Field{
+			"address": {},
+			"nonce":   {},
+			"latestTransactions": {
+				Type:      transactionType,
+				Arguments: []string{"size"},
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					size, _, err := params.Uint64("size")
+					if err != nil {
+						return nil, err
+					}
+
+					transactions := make([]testTransaction, 0)
+					for i := uint64(0); i < size; i++ {
+						transactions = append(transactions, testTransaction{
+							Hash:  params.Source["address"].(string) + "-tx",
+							Value: "1",
+						})
+					}
+					return transactions, nil
+				},
+			},
+		},
+	}
+
+	return &graphql.Schema{
+		Query: &graphql.Object{
+			Name: "Query",
+			Fields: map[string]*graphql.Field{
+				"account": {
+					Type:      accountType,
+					Arguments: []string{"address"},
+					Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+						address, _, err := params.String("address")
+						if err != nil {
+							return nil, err
+						}
+						if address == "missing" {
+							return nil, errAccountNotFound
+						}
+						if address == "empty" {
+							return (*testAccount)(nil), nil
+						}
+
+						return &testAccount{Address: address, Nonce: 7}, nil
+					},
+				},
+				"metrics": {
+					Resolve: func(_ graphql.ResolveParams) (interface{}, error) {
+						return map[string]interface{}{"erd_chain_id": "T"}, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func executeToJSON(t *testing.T, request graphql.Request) string {
+	response := graphql.Execute(createTestSchema(), request)
+	responseBytes, err := json.Marshal(response)
+	require.NoError(t, err)
+
+	return string(responseBytes)
+}
+
+func TestExecute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("syntax error should not return data", func(t *testing.T) {
+		t.Parallel()
+
+		response := graphql.Execute(createTestSchema(), graphql.Request{Query: "{ account("})
+		require.Nil(t, response.Data)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrSyntax.Error())
+	})
+	t.Run("nested fields should be resolved in the selection order", func(t *testing.T) {
+		t.Parallel()
+
+		response := executeToJSON(t, graphql.Request{
+			Query: `{ account(address: "erd1") { __typename nonce latestTransactions(size: 2) { value hash } address } }`,
+		})
+		require.Equal(t,
+			`{"data":{"account":{"__typename":"Account","nonce":7,"latestTransactions":[`+
+				`{"value":"1","hash":"erd1-tx"},{"value":"1","hash":"erd1-tx"}],"address":"erd1"}}}`,
+			response,
+		)
+	})
+	t.Run("aliases and variables should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		response := executeToJSON(t, graphql.Request{
+			Query: `query Accounts($first: String!, $size: Int = 1) {
+				a: account(address: $first) { address latestTransactions(size: $size) { hash } }
+				b: account(address: "erd2") { address }
+			}`,
+			Variables: map[string]interface{}{"first": "erd1"},
+		})
+		require.Equal(t,
+			`{"data":{"a":{"address":"erd1","latestTransactions":[{"hash":"erd1-tx"}]},"b":{"address":"erd2"}}}`,
+			response,
+		)
+	})
+	t.Run("missing required variable should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := graphql.Execute(createTestSchema(), graphql.Request{
+			Query: `query ($address: String!) { account(address: $address) { nonce } }`,
+		})
+		require.Nil(t, response.Data)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrInvalidVariable.Error())
+	})
+	t.Run("operation should be selected by name", func(t *testing.T) {
+		t.Parallel()
+
+		request := graphql.Request{
+			Query: `query First { account(address: "erd1") { nonce } } query Second { metrics }`,
+		}
+		response := graphql.Execute(createTestSchema(), request)
+		require.Nil(t, response.Data)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrOperationNotFound.Error())
+
+		request.OperationName = "Second"
+		require.Equal(t, `{"data":{"metrics":{"erd_chain_id":"T"}}}`, executeToJSON(t, request))
+
+		request.OperationName = "Third"
+		response = graphql.Execute(createTestSchema(), request)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrOperationNotFound.Error())
+	})
+	t.Run("field errors should null the field and keep the others", func(t *testing.T) {
+		t.Parallel()
+
+		response := executeToJSON(t, graphql.Request{
+			Query: `{
+				missing: account(address: "missing") { nonce }
+				empty: account(address: "empty") { nonce }
+				found: account(address: "erd1") { nonce unknown }
+				invalid: account(address: "erd1", shard: 1) { nonce }
+				noSelection: account(address: "erd1")
+				leafSelection: metrics { chain }
+				badArgument: account(address: 1) { nonce }
+			}`,
+		})
+		require.Equal(t,
+			`{"data":{"missing":null,"empty":null,"found":{"nonce":7,"unknown":null},"invalid":null,`+
+				`"noSelection":null,"leafSelection":null,"badArgument":null},"errors":[`+
+				`{"message":"account not found","path":["missing"]},`+
+				`{"message":"invalid selection: cannot query field unknown on type Account","path":["found","unknown"]},`+
+				`{"message":"invalid argument: unknown argument shard on field account","path":["invalid"]},`+
+				`{"message":"invalid selection: field account of type Account requires a selection of sub-fields","path":["noSelection"]},`+
+				`{"message":"invalid selection: field metrics does not have sub-fields","path":["leafSelection"]},`+
+				`{"message":"invalid argument: address should be a string","path":["badArgument"]}]}`,
+			response,
+		)
+	})
+}
+
+func TestResponse_ErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	response := &graphql.Response{}
+	require.Empty(t, response.ErrorMessage())
+
+	response.Errors = []*graphql.Error{{Message: "syntax error"}, {Message: "unknown field"}}
+	require.Equal(t, "syntax error; unknown field", response.ErrorMessage())
+}
+
+func TestResolveParams_Uint64(t *testing.T) {
+	t.Parallel()
+
+	params := graphql.ResolveParams{
+		Arguments: map[string]interface{}{
+			"literal":  int64(5),
+			"variable": float64(6),
+			"number":   json.Number("7"),
+			"negative": int64(-1),
+			"fraction": 1.5,
+			"string":   "8",
+		},
+	}
+
+	for name, expected := range map[string]uint64{"literal": 5, "variable": 6, "number": 7} {
+		value, ok, err := params.Uint64(name)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expected, value)
+	}
+	for _, name := range []string{"negative", "fraction", "string"} {
+		_, _, err := params.Uint64(name)
+		require.True(t, errors.Is(err, graphql.ErrInvalidArgument), name)
+	}
+
+	_, ok, err := params.Uint64("missing")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestNewObjectFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type embedded struct {
+		Round uint64 `json:"round"`
+	}
+	type block struct {
+		embedded
+		Hash    string                 `json:"hash"`
+		Skipped string                 `json:"-"`
+		Shard   uint32                 `json:"shard,omitempty"`
+		Status  map[string]interface{} `json:"status"`
+		Epoch   uint32
+	}
+
+	extraField := &graphql.Field{Arguments: []string{"size"}}
+	object := graphql.NewObjectFromStruct("Block", &block{}, map[string]*graphql.Field{"hash": extraField})
+	require.Equal(t, "Block", object.Name)
+	require.Len(t, object.Fields, 5)
+	for _, name := range []string{"round", "shard", "status", "Epoch"} {
+		require.Equal(t, &graphql.Field{}, object.Fields[name], name)
+	}
+	require.True(t, object.Fields["hash"] == extraField)
+}
@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+const punctuators = "!$():=@[]{}|"
+
+type token struct {
+	kind     tokenKind
+	value    string
+	position int
+}
+
+type lexer struct {
+	source   string
+	position int
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	if l.position >= len(l.source) {
+		return token{kind: tokenEOF, position: l.position}, nil
+	}
+
+	start := l.position
+	char := l.source[l.position]
+	switch {
+	case strings.IndexByte(punctuators, char) >= 0:
+		l.position++
+		return token{kind: tokenPunctuator, value: string(char), position: start}, nil
+	case strings.HasPrefix(l.source[l.position:], "..."):
+		l.position += 3
+		return token{kind: tokenPunctuator, value: "...", position: start}, nil
+	case isNameStart(char):
+		for l.position < len(l.source) && isNameContinue(l.source[l.position]) {
+			l.position++
+		}
+		return token{kind: tokenName, value: l.source[start:l.position], position: start}, nil
+	case char == '-' || isDigit(char):
+		return l.readNumber()
+	case char == '"':
+		return l.readString()
+	default:
+		return token{}, fmt.Errorf("%w: unexpected character %q at position %d", ErrSyntax, char, start)
+	}
+}
+
+func (l *lexer) skipIgnored() {
+	for l.position < len(l.source) {
+		char := l.source[l.position]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == ',':
+			l.position++
+		case char == '#':
+			for l.position < len(l.source) && l.source[l.position] != '\n' {
+				l.position++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) readNumber() (token, error) {
+	start := l.position
+	kind := tokenInt
+	if l.source[l.position] == '-' {
+		l.position++
+	}
+	digits := l.readDigits()
+	if digits == 0 {
+		return token{}, fmt.Errorf("%w: invalid number at position %d", ErrSyntax, start)
+	}
+	if l.position < len(l.source) && l.source[l.position] == '.' {
+		kind = tokenFloat
+		l.position++
+		if l.readDigits() == 0 {
+			return token{}, fmt.Errorf("%w: invalid number at position %d", ErrSyntax, start)
+		}
+	}
+	if l.position < len(l.source) && (l.source[l.position] == 'e' || l.source[l.position] == 'E') {
+		kind = tokenFloat
+		l.position++
+		if l.position < len(l.source) && (l.source[l.position] == '+' || l.source[l.position] == '-') {
+			l.position++
+		}
+		if l.readDigits() == 0 {
+			return token{}, fmt.Errorf("%w: invalid number at position %d", ErrSyntax, start)
+		}
+	}
+
+	return token{kind: kind, value: l.source[start:l.position], position: start}, nil
+}
+
+func (l *lexer) readDigits() int {
+	start := l.position
+	for l.position < len(l.source) && isDigit(l.source[l.position]) {
+		l.position++
+	}
+
+	return l.position - start
+}
+
+func (l *lexer) readString() (token, error) {
+	start := l.position
+	l.position++
+
+	builder := strings.Builder{}
+	for l.position < len(l.source) {
+		char := l.source[l.position]
+		switch char {
+		case '"':
+			l.position++
+			return token{kind: tokenString, value: builder.String(), position: start}, nil
+		case '\n', '\r':
+			return token{}, fmt.Errorf("%w: unterminated string at position %d", ErrSyntax, start)
+		case '\\':
+			if l.position+1 >= len(l.source) {
+				return token{}, fmt.Errorf("%w: unterminated string at position %d", ErrSyntax, start)
+			}
+			escaped, ok := escapedCharacters[l.source[l.position+1]]
+			if !ok {
+				return token{}, fmt.Errorf("%w: invalid escape sequence at position %d", ErrSyntax, l.position)
+			}
+			builder.WriteByte(escaped)
+			l.position += 2
+		default:
+			builder.WriteByte(char)
+			l.position++
+		}
+	}
+
+	return token{}, fmt.Errorf("%w: unterminated string at position %d", ErrSyntax, start)
+}
+
+var escapedCharacters = map[byte]byte{
+	'"':  '"',
+	'\\': '\\',
+	'/':  '/',
+	'b':  '\b',
+	'f':  '\f',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+}
+
+func isNameStart(char byte) bool {
+	return char == '_' || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
+
+func isNameContinue(char byte) bool {
+	return isNameStart(char) || isDigit(char)
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
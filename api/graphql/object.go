@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"reflect"
+	"strings"
+)
+
+// NewObjectFromStruct creates an object type having a leaf field for each JSON field of the provided struct, fields
+// of embedded structs included. Nested values are returned as they are, as JSON scalars. The extra fields are added
+// on top of the generated ones, replacing them if they have the same name
+func NewObjectFromStruct(name string, structValue interface{}, extraFields map[string]*Field) *Object {
+	object := &Object{
+		Name:   name,
+		Fields: make(map[string]*Field),
+	}
+
+	addStructFields(object, reflect.TypeOf(structValue))
+	for fieldName, field := range extraFields {
+		object.Fields[fieldName] = field
+	}
+
+	return object
+}
+
+func addStructFields(object *Object, structType reflect.Type) {
+	for structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		jsonName, hasTag := getJSONName(structField)
+		if jsonName == "-" {
+			continue
+		}
+		if structField.Anonymous && !hasTag {
+			addStructFields(object, structField.Type)
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+
+		object.Fields[jsonName] = &Field{}
+	}
+}
+
+func getJSONName(structField reflect.StructField) (string, bool) {
+	tag, ok := structField.Tag.Lookup("json")
+	if !ok {
+		return structField.Name, false
+	}
+
+	jsonName := strings.Split(tag, ",")[0]
+	if len(jsonName) == 0 {
+		return structField.Name, false
+	}
+
+	return jsonName, true
+}
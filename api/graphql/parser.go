@@ -0,0 +1,402 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type document struct {
+	operations []*operation
+}
+
+type operation struct {
+	name         string
+	variables    []*variableDefinition
+	selectionSet []*selection
+}
+
+type variableDefinition struct {
+	name         string
+	isRequired   bool
+	defaultValue interface{}
+	hasDefault   bool
+}
+
+type selection struct {
+	alias        string
+	name         string
+	arguments    map[string]interface{}
+	selectionSet []*selection
+}
+
+// responseKey returns the key under which the selection is placed in the response
+func (s *selection) responseKey() string {
+	if len(s.alias) > 0 {
+		return s.alias
+	}
+
+	return s.name
+}
+
+// variableReference is a value referencing a variable of the operation
+type variableReference struct {
+	name string
+}
+
+type parser struct {
+	lexer   *lexer
+	current token
+}
+
+func parseDocument(source string) (*document, error) {
+	p := &parser{lexer: &lexer{source: source}}
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &document{}
+	for p.current.kind != tokenEOF {
+		op, errParse := p.parseOperation()
+		if errParse != nil {
+			return nil, errParse
+		}
+		doc.operations = append(doc.operations, op)
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("%w: the document does not contain any operation", ErrSyntax)
+	}
+
+	return doc, nil
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+
+	p.current = tok
+	return nil
+}
+
+func (p *parser) isPunctuator(value string) bool {
+	return p.current.kind == tokenPunctuator && p.current.value == value
+}
+
+func (p *parser) expectPunctuator(value string) error {
+	if !p.isPunctuator(value) {
+		return p.unexpected(fmt.Sprintf("expected %q", value))
+	}
+
+	return p.advance()
+}
+
+func (p *parser) expectName() (string, error) {
+	if p.current.kind != tokenName {
+		return "", p.unexpected("expected a name")
+	}
+
+	name := p.current.value
+	return name, p.advance()
+}
+
+func (p *parser) unexpected(reason string) error {
+	if p.current.kind == tokenEOF {
+		return fmt.Errorf("%w: %s, found the end of the document", ErrSyntax, reason)
+	}
+
+	return fmt.Errorf("%w: %s, found %q at position %d", ErrSyntax, reason, p.current.value, p.current.position)
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	if p.isPunctuator("{") {
+		selectionSet, err := p.parseSelectionSet()
+		return &operation{selectionSet: selectionSet}, err
+	}
+
+	if p.current.kind != tokenName {
+		return nil, p.unexpected("expected an operation")
+	}
+	switch p.current.value {
+	case "query":
+	case "mutation", "subscription", "fragment":
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedOperation, p.current.value)
+	default:
+		return nil, p.unexpected("expected an operation")
+	}
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	op := &operation{}
+	if p.current.kind == tokenName {
+		op.name = p.current.value
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.isPunctuator("(") {
+		op.variables, err = p.parseVariableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.isPunctuator("@") {
+		return nil, fmt.Errorf("%w: directives", ErrUnsupportedOperation)
+	}
+
+	op.selectionSet, err = p.parseSelectionSet()
+	return op, err
+}
+
+func (p *parser) parseVariableDefinitions() ([]*variableDefinition, error) {
+	err := p.expectPunctuator("(")
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make([]*variableDefinition, 0)
+	for !p.isPunctuator(")") {
+		err = p.expectPunctuator("$")
+		if err != nil {
+			return nil, err
+		}
+
+		definition := &variableDefinition{}
+		definition.name, err = p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expectPunctuator(":")
+		if err != nil {
+			return nil, err
+		}
+		definition.isRequired, err = p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if p.isPunctuator("=") {
+			err = p.advance()
+			if err != nil {
+				return nil, err
+			}
+			definition.defaultValue, err = p.parseValue(true)
+			if err != nil {
+				return nil, err
+			}
+			definition.hasDefault = true
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, p.advance()
+}
+
+// parseType consumes a type reference and returns whether it is a non-null one. The types of the variables are
+// not checked against the schema, the resolvers being in charge of validating their arguments
+func (p *parser) parseType() (bool, error) {
+	var err error
+	if p.isPunctuator("[") {
+		err = p.advance()
+		if err != nil {
+			return false, err
+		}
+		_, err = p.parseType()
+		if err != nil {
+			return false, err
+		}
+		err = p.expectPunctuator("]")
+	} else {
+		_, err = p.expectName()
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !p.isPunctuator("!") {
+		return false, nil
+	}
+
+	return true, p.advance()
+}
+
+func (p *parser) parseSelectionSet() ([]*selection, error) {
+	err := p.expectPunctuator("{")
+	if err != nil {
+		return nil, err
+	}
+
+	selectionSet := make([]*selection, 0)
+	for !p.isPunctuator("}") {
+		if p.isPunctuator("...") {
+			return nil, fmt.Errorf("%w: fragments", ErrUnsupportedOperation)
+		}
+
+		sel, errParse := p.parseSelection()
+		if errParse != nil {
+			return nil, errParse
+		}
+		selectionSet = append(selectionSet, sel)
+	}
+	if len(selectionSet) == 0 {
+		return nil, p.unexpected("expected a selection")
+	}
+
+	return selectionSet, p.advance()
+}
+
+func (p *parser) parseSelection() (*selection, error) {
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	sel := &selection{name: name}
+	if p.isPunctuator(":") {
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+		sel.alias = name
+		sel.name, err = p.expectName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.isPunctuator("(") {
+		sel.arguments, err = p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.isPunctuator("@") {
+		return nil, fmt.Errorf("%w: directives", ErrUnsupportedOperation)
+	}
+	if p.isPunctuator("{") {
+		sel.selectionSet, err = p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sel, nil
+}
+
+func (p *parser) parseArguments() (map[string]interface{}, error) {
+	err := p.expectPunctuator("(")
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := make(map[string]interface{})
+	for !p.isPunctuator(")") {
+		name, errName := p.expectName()
+		if errName != nil {
+			return nil, errName
+		}
+		err = p.expectPunctuator(":")
+		if err != nil {
+			return nil, err
+		}
+		arguments[name], err = p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return arguments, p.advance()
+}
+
+func (p *parser) parseValue(isConstant bool) (interface{}, error) {
+	tok := p.current
+	switch {
+	case p.isPunctuator("$") && !isConstant:
+		err := p.advance()
+		if err != nil {
+			return nil, err
+		}
+		name, err := p.expectName()
+		return &variableReference{name: name}, err
+	case p.isPunctuator("["):
+		return p.parseList(isConstant)
+	case p.isPunctuator("{"):
+		return p.parseObject(isConstant)
+	case tok.kind == tokenInt:
+		value, err := strconv.ParseInt(tok.value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid integer %s", ErrSyntax, tok.value)
+		}
+		return value, p.advance()
+	case tok.kind == tokenFloat:
+		value, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid float %s", ErrSyntax, tok.value)
+		}
+		return value, p.advance()
+	case tok.kind == tokenString:
+		return tok.value, p.advance()
+	case tok.kind == tokenName:
+		switch tok.value {
+		case "true":
+			return true, p.advance()
+		case "false":
+			return false, p.advance()
+		case "null":
+			return nil, p.advance()
+		default:
+			// enum values are handed over to the resolvers as strings
+			return tok.value, p.advance()
+		}
+	default:
+		return nil, p.unexpected("expected a value")
+	}
+}
+
+func (p *parser) parseList(isConstant bool) (interface{}, error) {
+	err := p.expectPunctuator("[")
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0)
+	for !p.isPunctuator("]") {
+		value, errValue := p.parseValue(isConstant)
+		if errValue != nil {
+			return nil, errValue
+		}
+		values = append(values, value)
+	}
+
+	return values, p.advance()
+}
+
+func (p *parser) parseObject(isConstant bool) (interface{}, error) {
+	err := p.expectPunctuator("{")
+	if err != nil {
+		return nil, err
+	}
+
+	object := make(map[string]interface{})
+	for !p.isPunctuator("}") {
+		name, errName := p.expectName()
+		if errName != nil {
+			return nil, errName
+		}
+		err = p.expectPunctuator(":")
+		if err != nil {
+			return nil, err
+		}
+		object[name], err = p.parseValue(isConstant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return object, p.advance()
+}
@@ -0,0 +1,84 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid documents should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidDocuments := []string{
+			"",
+			"{",
+			"{ }",
+			"{ account(address: ) { nonce } }",
+			"{ account(address: \"unterminated) }",
+			"{ account(address: \"invalid \\x escape\") }",
+			"query ($size: ) { account }",
+			"{ block(nonce: 1.) { hash } }",
+			"{ account % }",
+		}
+		for _, source := range invalidDocuments {
+			_, err := parseDocument(source)
+			require.True(t, errors.Is(err, ErrSyntax), source)
+		}
+	})
+	t.Run("unsupported constructs should error", func(t *testing.T) {
+		t.Parallel()
+
+		unsupportedDocuments := []string{
+			"mutation { send }",
+			"subscription { blocks }",
+			"fragment accountFields on Account { nonce }",
+			"{ account { ...accountFields } }",
+			"{ account @include(if: true) { nonce } }",
+		}
+		for _, source := range unsupportedDocuments {
+			_, err := parseDocument(source)
+			require.True(t, errors.Is(err, ErrUnsupportedOperation), source)
+		}
+	})
+	t.Run("should parse a named query", func(t *testing.T) {
+		t.Parallel()
+
+		source := `
+			# the account along with its latest transactions
+			query AccountQuery($address: String!, $size: Int = 5) {
+				first: account(address: $address, shard: -1, ratio: 1.5e2, tags: ["a", "b"], filter: {enabled: true, kind: ALL}, extra: null) {
+					nonce
+					latestTransactions(size: $size) { hash }
+				}
+			}`
+		doc, err := parseDocument(source)
+		require.NoError(t, err)
+		require.Len(t, doc.operations, 1)
+
+		op := doc.operations[0]
+		require.Equal(t, "AccountQuery", op.name)
+		require.Len(t, op.variables, 2)
+		require.Equal(t, &variableDefinition{name: "address", isRequired: true}, op.variables[0])
+		require.Equal(t, &variableDefinition{name: "size", defaultValue: int64(5), hasDefault: true}, op.variables[1])
+
+		require.Len(t, op.selectionSet, 1)
+		account := op.selectionSet[0]
+		require.Equal(t, "first", account.responseKey())
+		require.Equal(t, "account", account.name)
+		require.Equal(t, map[string]interface{}{
+			"address": &variableReference{name: "address"},
+			"shard":   int64(-1),
+			"ratio":   float64(150),
+			"tags":    []interface{}{"a", "b"},
+			"filter":  map[string]interface{}{"enabled": true, "kind": "ALL"},
+			"extra":   nil,
+		}, account.arguments)
+		require.Len(t, account.selectionSet, 2)
+		require.Equal(t, "nonce", account.selectionSet[0].responseKey())
+		require.Equal(t, "latestTransactions", account.selectionSet[1].name)
+	})
+}
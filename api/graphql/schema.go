@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Object defines an object type of the schema
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+}
+
+// Field defines a field of an object type. A field without a type is a leaf one, its resolved value being returned
+// as it is. When the resolver is missing, the value is read from the parent object under the field's name
+type Field struct {
+	Type      *Object
+	Arguments []string
+	Resolve   func(params ResolveParams) (interface{}, error)
+}
+
+// Schema defines the types which can be queried, starting from the query root type
+type Schema struct {
+	Query *Object
+}
+
+// ResolveParams holds the parent value, already converted into its JSON representation, and the field arguments
+type ResolveParams struct {
+	Source    map[string]interface{}
+	Arguments map[string]interface{}
+}
+
+// String returns the string argument with the provided name. The returned flag is false if the argument is missing
+func (params ResolveParams) String(name string) (string, bool, error) {
+	value, ok := params.Arguments[name]
+	if !ok || value == nil {
+		return "", false, nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", false, fmt.Errorf("%w: %s should be a string", ErrInvalidArgument, name)
+	}
+
+	return str, true, nil
+}
+
+// Uint64 returns the unsigned integer argument with the provided name. The returned flag is false if the argument is
+// missing
+func (params ResolveParams) Uint64(name string) (uint64, bool, error) {
+	value, ok := params.Arguments[name]
+	if !ok || value == nil {
+		return 0, false, nil
+	}
+
+	invalidArgErr := fmt.Errorf("%w: %s should be an unsigned integer", ErrInvalidArgument, name)
+	switch number := value.(type) {
+	case int64:
+		if number < 0 {
+			return 0, false, invalidArgErr
+		}
+		return uint64(number), true, nil
+	case float64:
+		if number < 0 || number != math.Trunc(number) || number > math.MaxUint64 {
+			return 0, false, invalidArgErr
+		}
+		return uint64(number), true, nil
+	case json.Number:
+		var parsed uint64
+		err := json.Unmarshal([]byte(number), &parsed)
+		if err != nil {
+			return 0, false, invalidArgErr
+		}
+		return parsed, true, nil
+	default:
+		return 0, false, invalidArgErr
+	}
+}
+
+// Bool returns the boolean argument with the provided name. The returned flag is false if the argument is missing
+func (params ResolveParams) Bool(name string) (bool, bool, error) {
+	value, ok := params.Arguments[name]
+	if !ok || value == nil {
+		return false, false, nil
+	}
+
+	boolean, ok := value.(bool)
+	if !ok {
+		return false, false, fmt.Errorf("%w: %s should be a boolean", ErrInvalidArgument, name)
+	}
+
+	return boolean, true, nil
+}
@@ -27,6 +27,7 @@ func NewAboutGroup(facadeHandler data.FacadeHandler) (*aboutGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "", Handler: ag.getAboutInfo, Method: http.MethodGet},
 		{Path: "/nodes-versions", Handler: ag.getNodesVersions, Method: http.MethodGet},
+		{Path: "/excluded-observers", Handler: ag.getExcludedObservers, Method: http.MethodGet},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -40,15 +41,25 @@ func (ag *aboutGroup) getAboutInfo(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, aboutInfo)
+	shared.RespondWithJSON(c, http.StatusOK, aboutInfo)
 }
 
 func (ag *aboutGroup) getNodesVersions(c *gin.Context) {
-	nodesVersions, err := ag.facade.GetNodesVersions()
+	nodesVersions, err := ag.facade.GetNodesVersions(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, nodesVersions)
+	shared.RespondWithJSON(c, http.StatusOK, nodesVersions)
+}
+
+func (ag *aboutGroup) getExcludedObservers(c *gin.Context) {
+	excludedObservers, err := ag.facade.GetExcludedObservers()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, excludedObservers)
 }
@@ -140,3 +140,39 @@ func TestAboutGroup_GetNodesVersions(t *testing.T) {
 		assert.Equal(t, expectedVersions, apiResp.Data.Versions)
 	})
 }
+
+func TestAboutGroup_GetExcludedObservers(t *testing.T) {
+	t.Parallel()
+
+	expectedExcludedObservers := []*data.ExcludedObserver{
+		{Address: "observer0", ShardID: 0, Version: "v1.6.0", MinimumVersion: "v1.7.0"},
+	}
+	facade := &mock.FacadeStub{
+		GetExcludedObserversCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: data.ExcludedObserversResponseData{
+					ExcludedObservers: expectedExcludedObservers,
+				},
+				Code: data.ReturnCodeSuccess,
+			}, nil
+		},
+	}
+	aboutGroup, err := groups.NewAboutGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(aboutGroup, "/about")
+
+	req, _ := http.NewRequest("GET", "/about/excluded-observers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type excludedObserversResponse struct {
+		Data  data.ExcludedObserversResponseData `json:"data"`
+		Error string                             `json:"error"`
+	}
+	apiResp := excludedObserversResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedExcludedObservers, apiResp.Data.ExcludedObservers)
+}
@@ -5,13 +5,22 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// nativeTokenIdentifier is the identifier the price of the native token is requested with
+const nativeTokenIdentifier = "EGLD"
+
+// maxAddressesInNoncesRequest limits the number of addresses whose nonces are fetched by a single request
+const maxAddressesInNoncesRequest = 1000
+
 type accountsGroup struct {
-	facade AccountsFacadeHandler
+	facade          AccountsFacadeHandler
+	pubKeyConverter core.PubkeyConverter
 	*baseGroup
 }
 
@@ -22,9 +31,16 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		return nil, ErrWrongTypeAssertion
 	}
 
+	// the address converter of the chain is needed to recognize the system account, whose prefix may differ from erd
+	pubKeyConverter, err := facade.GetAddressConverter()
+	if err != nil {
+		return nil, err
+	}
+
 	ag := &accountsGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade:          facade,
+		pubKeyConverter: pubKeyConverter,
+		baseGroup:       &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
@@ -35,6 +51,7 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
 		{Path: "/:address/code-hash", Handler: ag.getCodeHash, Method: http.MethodGet},
 		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
+		{Path: "/:address/keys/diff", Handler: ag.getKeyValuePairsDiff, Method: http.MethodGet},
 		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet},
 		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet},
 		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet},
@@ -44,7 +61,13 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
 		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet},
 		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet},
+		{Path: "/:address/stuck-transactions", Handler: ag.getStuckTransactions, Method: http.MethodGet},
+		{Path: "/:address/collections", Handler: ag.getCollections, Method: http.MethodGet},
+		{Path: "/:address/issued-tokens", Handler: ag.getIssuedTokens, Method: http.MethodGet},
+		{Path: "/:address/activity-summary", Handler: ag.getActivitySummary, Method: http.MethodGet},
 		{Path: "/bulk", Handler: ag.getAccounts, Method: http.MethodPost},
+		{Path: "/nonces", Handler: ag.getAccountsNonces, Method: http.MethodPost},
+		{Path: "/verify-signature", Handler: ag.verifySignature, Method: http.MethodPost},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -54,19 +77,22 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*data.AccountModel) gin.H) {
 	address := c.Param("address")
 
-	options, err := parseAccountQueryOptions(c, address)
+	options, err := parseAccountQueryOptions(c, address, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
 		return
 	}
 
-	model, err := group.facade.GetAccount(address, options)
+	model, err := group.facade.GetAccount(c.Request.Context(), address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
 		return
 	}
 
 	response := transform(model)
+	if model.DataFreshness != nil {
+		response["dataFreshness"] = model.DataFreshness
+	}
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
@@ -74,17 +100,72 @@ func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*d
 // about the account correlated with provided address
 func (group *accountsGroup) getAccount(c *gin.Context) {
 	group.respondWithAccount(c, func(model *data.AccountModel) gin.H {
-		return gin.H{"account": model.Account, "blockInfo": model.BlockInfo}
+		response := gin.H{"account": shared.SelectFields(c, model.Account), "blockInfo": model.BlockInfo}
+		group.addDenominatedBalance(c, response, model.Account.Balance)
+
+		return response
 	})
 }
 
-// getBalance returns the balance for the address parameter
+// getBalance returns the balance for the address parameter, along with its USD value and denominated value if requested
 func (group *accountsGroup) getBalance(c *gin.Context) {
 	group.respondWithAccount(c, func(model *data.AccountModel) gin.H {
-		return gin.H{"balance": model.Account.Balance, "blockInfo": model.BlockInfo}
+		response := gin.H{"balance": model.Account.Balance, "blockInfo": model.BlockInfo}
+		usdValue, ok := group.getUsdValue(c, nativeTokenIdentifier, model.Account.Balance)
+		if ok {
+			response["usdValue"] = usdValue
+		}
+		group.addDenominatedBalance(c, response, model.Account.Balance)
+
+		return response
 	})
 }
 
+// addDenominatedBalance sets the balance converted with the decimals of the native token, if it was requested
+func (group *accountsGroup) addDenominatedBalance(c *gin.Context, response gin.H, balance string) {
+	numDecimals, ok := getRequestedDenomination(c, group.facade)
+	if ok {
+		addDenominatedValue(response, "balance", denominatedFieldSuffix, balance, numDecimals)
+	}
+}
+
+// getUsdValue returns the USD value of the amount, if it was requested and a token price provider is configured. The
+// enrichment is best effort, so the balance is returned without it if the price cannot be fetched
+func (group *accountsGroup) getUsdValue(c *gin.Context, token string, amount string) (float64, bool) {
+	withUsdValue, err := parseBoolUrlParam(c, common.UrlParameterWithUsdValue)
+	if err != nil || !withUsdValue || !group.facade.IsTokenPriceEnabled() {
+		return 0, false
+	}
+
+	usdValue, err := group.facade.GetUsdValue(c.Request.Context(), token, amount)
+	if err != nil {
+		return 0, false
+	}
+
+	return usdValue, true
+}
+
+// addTokenUsdValue sets the USD value of the token balance in the token data received from the observer
+func (group *accountsGroup) addTokenUsdValue(c *gin.Context, token string, response *data.GenericAPIResponse) {
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+	tokenData, ok := responseData["tokenData"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	balance, ok := tokenData["balance"].(string)
+	if !ok {
+		return
+	}
+
+	usdValue, ok := group.getUsdValue(c, token, balance)
+	if ok {
+		tokenData["usdValue"] = usdValue
+	}
+}
+
 // getUsername returns the username for the address parameter
 func (group *accountsGroup) getUsername(c *gin.Context) {
 	group.respondWithAccount(c, func(model *data.AccountModel) gin.H {
@@ -102,19 +183,19 @@ func (group *accountsGroup) getNonce(c *gin.Context) {
 // getCodeHash returns the code hash for the address parameter
 func (group *accountsGroup) getCodeHash(c *gin.Context) {
 	address := c.Param("address")
-	options, err := parseAccountQueryOptions(c, address)
+	options, err := parseAccountQueryOptions(c, address, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
 		return
 	}
 
-	codeHashResponse, err := group.facade.GetCodeHash(address, options)
+	codeHashResponse, err := group.facade.GetCodeHash(c.Request.Context(), address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetCodeHash, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, codeHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, codeHashResponse)
 }
 
 // getAccounts will handle the request for a bulk of addresses data
@@ -131,13 +212,37 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 		addr = addresses[0]
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrInvalidFields, err)
 		return
 	}
 
-	response, err := group.facade.GetAccounts(addresses, options)
+	response, err := group.facade.GetAccounts(c.Request.Context(), addresses, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+// getAccountsNonces will handle the request for the current nonces of a bulk of addresses
+func (group *accountsGroup) getAccountsNonces(c *gin.Context) {
+	var addresses []string
+	err := shared.BindJSONBody(c, &addresses)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrInvalidAddressesArray, err)
+		return
+	}
+
+	uniqueAddresses, err := getUniqueAddresses(addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidAddressesArray, err)
+		return
+	}
+
+	response, err := group.facade.GetAccountsNonces(c.Request.Context(), uniqueAddresses)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
 		return
@@ -146,6 +251,30 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
+func getUniqueAddresses(addresses []string) ([]string, error) {
+	uniqueAddresses := make([]string, 0, len(addresses))
+	seenAddresses := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		if address == "" {
+			return nil, errors.ErrEmptyAddress
+		}
+		if _, seen := seenAddresses[address]; seen {
+			continue
+		}
+
+		seenAddresses[address] = struct{}{}
+		uniqueAddresses = append(uniqueAddresses, address)
+	}
+	if len(uniqueAddresses) == 0 {
+		return nil, errors.ErrEmptyAddress
+	}
+	if len(uniqueAddresses) > maxAddressesInNoncesRequest {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", errors.ErrTooManyAddresses, len(uniqueAddresses), maxAddressesInNoncesRequest)
+	}
+
+	return uniqueAddresses, nil
+}
+
 // getKeyValuePairs returns the key-value pairs for the address parameter
 func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 	addr := c.Param("address")
@@ -154,19 +283,42 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
 		return
 	}
 
-	keyValuePairs, err := group.facade.GetKeyValuePairs(addr, options)
+	keyValuePairs, err := group.facade.GetKeyValuePairs(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, keyValuePairs)
+	shared.RespondWithJSON(c, http.StatusOK, keyValuePairs)
+}
+
+// getKeyValuePairsDiff returns the keys of the address which were added, changed or removed between two blocks
+func (group *accountsGroup) getKeyValuePairsDiff(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairsDiff, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountKeysDiffQueryOptions(c, addr, group.pubKeyConverter)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairsDiff, err)
+		return
+	}
+
+	diff, err := group.facade.GetKeyValuePairsDiff(c.Request.Context(), addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairsDiff, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diff": diff}, "", data.ReturnCodeSuccess)
 }
 
 // getValueForKey returns the value for the given address and key
@@ -177,7 +329,7 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetValueForKey, err)
 		return
@@ -189,7 +341,7 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := group.facade.GetValueForKey(addr, key, options)
+	value, err := group.facade.GetValueForKey(c.Request.Context(), addr, key, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetValueForKey, err)
 		return
@@ -229,7 +381,7 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -241,13 +393,14 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTTokenData(addr, tokenIdentifier, options)
+	esdtTokenResponse, err := group.facade.GetESDTTokenData(c.Request.Context(), addr, tokenIdentifier, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
+	group.addTokenUsdValue(c, tokenIdentifier, esdtTokenResponse)
 
-	c.JSON(http.StatusOK, esdtTokenResponse)
+	shared.RespondWithJSON(c, http.StatusOK, esdtTokenResponse)
 }
 
 func (group *accountsGroup) getESDTsRoles(c *gin.Context) {
@@ -257,19 +410,19 @@ func (group *accountsGroup) getESDTsRoles(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetRolesForAccount, err)
 		return
 	}
 
-	tokensRoles, err := group.facade.GetESDTsRoles(addr, options)
+	tokensRoles, err := group.facade.GetESDTsRoles(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrEmptyTokenIdentifier, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, tokensRoles)
+	shared.RespondWithJSON(c, http.StatusOK, tokensRoles)
 }
 
 // getESDTsWithRole returns the token identifiers of the tokens where  the given address has the given role
@@ -280,7 +433,7 @@ func (group *accountsGroup) getESDTsWithRole(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTsWithRole, err)
 		return
@@ -292,13 +445,13 @@ func (group *accountsGroup) getESDTsWithRole(c *gin.Context) {
 		return
 	}
 
-	esdtsWithRole, err := group.facade.GetESDTsWithRole(addr, role, options)
+	esdtsWithRole, err := group.facade.GetESDTsWithRole(c.Request.Context(), addr, role, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTsWithRole, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, esdtsWithRole)
+	shared.RespondWithJSON(c, http.StatusOK, esdtsWithRole)
 }
 
 // getRegisteredNFTs returns the token identifiers of the NFTs registered by the address
@@ -309,19 +462,19 @@ func (group *accountsGroup) getRegisteredNFTs(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetNFTTokenIDsRegisteredByAddress, err)
 		return
 	}
 
-	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(addr, options)
+	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetNFTTokenIDsRegisteredByAddress, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, tokens)
+	shared.RespondWithJSON(c, http.StatusOK, tokens)
 }
 
 // getESDTNftTokenData returns the esdt nft data for the given address, esdt token and nonce
@@ -332,7 +485,7 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -350,13 +503,13 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce, options)
+	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(c.Request.Context(), addr, tokenIdentifier, nonce, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, esdtTokenResponse)
+	shared.RespondWithJSON(c, http.StatusOK, esdtTokenResponse)
 }
 
 func (group *accountsGroup) getGuardianData(c *gin.Context) {
@@ -366,19 +519,19 @@ func (group *accountsGroup) getGuardianData(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetGuardianData, err)
 		return
 	}
 
-	guardianData, err := group.facade.GetGuardianData(addr, options)
+	guardianData, err := group.facade.GetGuardianData(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetGuardianData, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, guardianData)
+	shared.RespondWithJSON(c, http.StatusOK, guardianData)
 }
 
 // getESDTTokens returns the tokens list from this account
@@ -389,18 +542,18 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
-	tokens, err := group.facade.GetAllESDTTokens(addr, options)
+	tokens, err := group.facade.GetAllESDTTokens(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, tokens)
+	shared.RespondWithJSON(c, http.StatusOK, tokens)
 }
 
 func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
@@ -410,17 +563,110 @@ func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
 		return
 	}
 
-	options, err := parseAccountQueryOptions(c, addr)
+	options, err := parseAccountQueryOptions(c, addr, group.pubKeyConverter)
 	if err != nil {
 		shared.RespondWithValidationError(c, errors.ErrIsDataTrieMigrated, err)
 		return
 	}
 
-	isMigrated, err := group.facade.IsDataTrieMigrated(addr, options)
+	isMigrated, err := group.facade.IsDataTrieMigrated(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrIsDataTrieMigrated, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, isMigrated)
+	shared.RespondWithJSON(c, http.StatusOK, isMigrated)
+}
+
+// getStuckTransactions returns the transactions of the address blocked in the pool by missing nonces
+func (group *accountsGroup) getStuckTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStuckTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	stuckTxs, err := group.facade.GetStuckTransactions(c.Request.Context(), addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStuckTransactions, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"stuckTransactions": stuckTxs}, "", data.ReturnCodeSuccess)
+}
+
+// getCollections returns a page of the NFT, SFT and MetaESDT collections registered by the address or on which it has
+// roles
+func (group *accountsGroup) getCollections(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetCollections, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseCollectionsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	collections, err := group.facade.GetCollectionsForAddress(c.Request.Context(), addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetCollections, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"collections": collections}, "", data.ReturnCodeSuccess)
+}
+
+// getIssuedTokens returns the tokens and collections owned by the address
+func (group *accountsGroup) getIssuedTokens(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetIssuedTokens, errors.ErrEmptyAddress)
+		return
+	}
+
+	issuedTokens, err := group.facade.GetTokensIssuedByAddress(c.Request.Context(), addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetIssuedTokens, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"tokens": issuedTokens}, "", data.ReturnCodeSuccess)
+}
+
+// getActivitySummary returns the number of transactions sent and received by the address and its first and last activity
+func (group *accountsGroup) getActivitySummary(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetActivitySummary, errors.ErrEmptyAddress)
+		return
+	}
+
+	activitySummary, err := group.facade.GetAddressActivitySummary(c.Request.Context(), addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetActivitySummary, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"activitySummary": activitySummary}, "", data.ReturnCodeSuccess)
+}
+
+// verifySignature checks if an arbitrary message was signed by the provided address
+func (group *accountsGroup) verifySignature(c *gin.Context) {
+	request := &data.SignatureVerificationRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	verification, err := group.facade.VerifyMessageSignature(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"verification": verification}, "", data.ReturnCodeSuccess)
 }
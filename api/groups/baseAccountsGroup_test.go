@@ -28,7 +28,8 @@ type GeneralResponse struct {
 }
 
 type accountResponseData struct {
-	Account data.Account `json:"account"`
+	Account       data.Account        `json:"account"`
+	DataFreshness *data.DataFreshness `json:"dataFreshness"`
 }
 
 // accountResponse contains the account data and GeneralResponse fields
@@ -38,7 +39,9 @@ type accountResponse struct {
 }
 
 type balanceResponseData struct {
-	Balance string `json:"balance"`
+	Balance            string   `json:"balance"`
+	UsdValue           *float64 `json:"usdValue"`
+	BalanceDenominated string   `json:"balanceDenominated"`
 }
 
 // balanceResponse contains the balance and GeneralResponse fields
@@ -248,9 +251,76 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, accountResponse.Data.Account.Address, reqAddress)
 	assert.Equal(t, accountResponse.Data.Account.Nonce, uint64(1))
 	assert.Equal(t, accountResponse.Data.Account.Balance, "100")
+	assert.Nil(t, accountResponse.Data.DataFreshness)
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_ReturnsSelectedFields(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{
+					Address:  address,
+					Nonce:    1,
+					Balance:  "100",
+					CodeHash: []byte("code hash"),
+				},
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test?fields=nonce,balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Account map[string]interface{} `json:"account"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]interface{}{"nonce": float64(1), "balance": "100"}, response.Data.Account)
+}
+
+func TestGetAccount_ReturnsDataFreshness(t *testing.T) {
+	t.Parallel()
+
+	freshness := &data.DataFreshness{
+		Status:               data.DataFreshnessStale,
+		Nonce:                120,
+		NonceDelta:           20,
+		NumComparedObservers: 2,
+	}
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account:       data.Account{Address: address},
+				DataFreshness: freshness,
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := accountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, freshness, accountResponse.Data.DataFreshness)
+}
+
 //------- GetAccounts
 
 func TestGetAccount_FailsWhenInvalidRequest(t *testing.T) {
@@ -372,6 +442,69 @@ func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, balanceResponse.Error)
 }
 
+func TestGetBalance_WithUsdValue(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(isTokenPriceEnabled bool, usdValueErr error) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address, Balance: "2500000000000000000"}}, nil
+			},
+			IsTokenPriceEnabledCalled: func() bool {
+				return isTokenPriceEnabled
+			},
+			GetUsdValueCalled: func(token string, amount string) (float64, error) {
+				assert.Equal(t, "EGLD", token)
+				assert.Equal(t, "2500000000000000000", amount)
+				return 75.5, usdValueErr
+			},
+		}
+	}
+	getBalance := func(facade *mock.FacadeStub, query string) balanceResponse {
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/balance"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := balanceResponse{}
+		loadResponse(resp.Body, &response)
+
+		return response
+	}
+
+	t.Run("requested usd value should be added", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(true, nil), "?withUsdValue=true")
+		assert.Equal(t, "2500000000000000000", response.Data.Balance)
+		require.NotNil(t, response.Data.UsdValue)
+		assert.Equal(t, 75.5, *response.Data.UsdValue)
+	})
+	t.Run("not requested usd value should not be added", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(true, nil), "")
+		assert.Nil(t, response.Data.UsdValue)
+	})
+	t.Run("disabled price provider should not add the usd value", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(false, nil), "?withUsdValue=true")
+		assert.Nil(t, response.Data.UsdValue)
+	})
+	t.Run("price error should return the balance without the usd value", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(true, errors.New("price API down")), "?withUsdValue=true")
+		assert.Equal(t, "2500000000000000000", response.Data.Balance)
+		assert.Nil(t, response.Data.UsdValue)
+	})
+}
+
 //------- GetUsername
 
 func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
@@ -966,6 +1099,88 @@ func TestGetKeyValuePairs_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, actualResponse.Error)
 }
 
+func TestGetKeyValuePairsDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid block range should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		for _, query := range []string{"", "?fromBlock=10", "?fromBlock=a&toBlock=20", "?fromBlock=20&toBlock=10"} {
+			req, _ := http.NewRequest("GET", "/address/test/keys/diff"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &data.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairsDiff.Error()), query)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsDiffHandler: func(_ string, _ common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/diff?fromBlock=10&toBlock=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return the diff", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &data.AccountKeysDiff{
+			FromBlock: data.BlockInfo{Nonce: 10},
+			ToBlock:   data.BlockInfo{Nonce: 20},
+			Added:     map[string]string{"dd": "05"},
+			Changed:   map[string]data.KeyValueChange{"bb": {Before: "02", After: "04"}},
+			Removed:   map[string]string{},
+		}
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsDiffHandler: func(address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+				require.Equal(t, "test", address)
+				require.Equal(t, common.AccountKeysDiffQueryOptions{FromBlock: 10, ToBlock: 20}, options)
+				return expectedDiff, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys/diff?fromBlock=10&toBlock=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Diff *data.AccountKeysDiff `json:"diff"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDiff, response.Data.Diff)
+	})
+}
+
 // ---- get code hash
 
 func TestGetCodeHash_FailWhenFacadeErrors(t *testing.T) {
@@ -1083,3 +1298,520 @@ func TestAccountsGroup_IsDataTrieMigrated(t *testing.T) {
 		assert.Empty(t, actualResponse.Error)
 	})
 }
+
+func TestAccountsGroup_GetStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetStuckTransactionsCalled: func(_ string) (*data.StuckTransactions, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/stuck-transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStuckTxs := &data.StuckTransactions{
+			Address:       "test",
+			AccountNonce:  5,
+			Gaps:          []data.NonceGap{{From: 5, To: 6}},
+			MissingNonces: []uint64{5, 6},
+			Transactions:  []data.StuckTransaction{{Hash: "hash", Nonce: 7}},
+		}
+		facade := &mock.FacadeStub{
+			GetStuckTransactionsCalled: func(address string) (*data.StuckTransactions, error) {
+				assert.Equal(t, "test", address)
+				return expectedStuckTxs, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/stuck-transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type stuckTxsResponse struct {
+			Data struct {
+				StuckTransactions *data.StuckTransactions `json:"stuckTransactions"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &stuckTxsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedStuckTxs, response.Data.StuckTransactions)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestAccountsGroup_GetCollections(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetCollectionsForAddressCalled: func(_ string, _ common.CollectionsQueryOptions) ([]*data.Collection, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/collections", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetCollectionsForAddressCalled: func(_ string, _ common.CollectionsQueryOptions) ([]*data.Collection, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/collections?size=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCollections := []*data.Collection{
+			{
+				Identifier:    "COL-abcdef",
+				Name:          "Collection",
+				Type:          "NonFungibleESDT",
+				Owner:         "test",
+				Properties:    map[string]bool{"canUpgrade": true},
+				Roles:         map[string][]string{"test": {"ESDTRoleNFTCreate"}},
+				NumIssuedNFTs: 10,
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetCollectionsForAddressCalled: func(address string, options common.CollectionsQueryOptions) ([]*data.Collection, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.CollectionsQueryOptions{From: 5, Size: 10}, options)
+				return expectedCollections, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/collections?from=5&size=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type collectionsResponse struct {
+			Data struct {
+				Collections []*data.Collection `json:"collections"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &collectionsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedCollections, response.Data.Collections)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestAccountsGroup_GetIssuedTokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetTokensIssuedByAddressCalled: func(_ string) ([]*data.IssuedToken, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/issued-tokens", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetIssuedTokens.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTokens := []*data.IssuedToken{
+			{
+				Identifier: "TKN-abcdef",
+				Name:       "Token",
+				Type:       "FungibleESDT",
+				Decimals:   18,
+				Properties: map[string]bool{"canMint": true},
+				Roles:      []string{"ESDTRoleLocalMint"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTokensIssuedByAddressCalled: func(address string) ([]*data.IssuedToken, error) {
+				assert.Equal(t, "test", address)
+				return expectedTokens, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/issued-tokens", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type issuedTokensResponse struct {
+			Data struct {
+				Tokens []*data.IssuedToken `json:"tokens"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &issuedTokensResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedTokens, response.Data.Tokens)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestAccountsGroup_GetActivitySummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetAddressActivitySummaryCalled: func(_ string) (*data.AddressActivitySummary, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/activity-summary", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		timestamp := uint64(1700000000)
+		expectedSummary := &data.AddressActivitySummary{
+			Address:                "test",
+			SentTxsCount:           5,
+			FirstActivityTimestamp: &timestamp,
+			LastActivityTimestamp:  &timestamp,
+			Source:                 data.ActivitySourceExternalStorage,
+		}
+		facade := &mock.FacadeStub{
+			GetAddressActivitySummaryCalled: func(address string) (*data.AddressActivitySummary, error) {
+				assert.Equal(t, "test", address)
+				return expectedSummary, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/activity-summary", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type activitySummaryResponse struct {
+			Data struct {
+				ActivitySummary *data.AddressActivitySummary `json:"activitySummary"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &activitySummaryResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedSummary, response.Data.ActivitySummary)
+		assert.Empty(t, response.Error)
+	})
+}
+
+type accountsNoncesResponse struct {
+	Data  data.AccountsNonces `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+func TestAccountsGroup_GetAccountsNonces(t *testing.T) {
+	t.Parallel()
+
+	postNonces := func(facade *mock.FacadeStub, body string) (int, accountsNoncesResponse) {
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/nonces", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountsNoncesResponse{}
+		loadResponse(resp.Body, &response)
+
+		return resp.Code, response
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, response := postNonces(&mock.FacadeStub{}, "not json")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAddressesArray.Error()))
+	})
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, response := postNonces(&mock.FacadeStub{}, "[]")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyAddress.Error()))
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, response := postNonces(&mock.FacadeStub{}, `["aabb", ""]`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyAddress.Error()))
+	})
+	t.Run("too many addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := make([]string, 1001)
+		for i := range addresses {
+			addresses[i] = fmt.Sprintf("address%d", i)
+		}
+		body, _ := json.Marshal(addresses)
+
+		code, response := postNonces(&mock.FacadeStub{}, string(body))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyAddresses.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsNoncesCalled: func(_ []string) (*data.AccountsNonces, error) {
+				return nil, errors.New("observers unavailable")
+			},
+		}
+
+		code, response := postNonces(facade, `["aabb"]`)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrCannotGetAddresses.Error()))
+	})
+	t.Run("should work with duplicated addresses", func(t *testing.T) {
+		t.Parallel()
+
+		var providedAddresses []string
+		facade := &mock.FacadeStub{
+			GetAccountsNoncesCalled: func(addresses []string) (*data.AccountsNonces, error) {
+				providedAddresses = addresses
+				return &data.AccountsNonces{Nonces: map[string]uint64{"aabb": 5, "bbaa": 0}}, nil
+			},
+		}
+
+		code, response := postNonces(facade, `["aabb", "bbaa", "aabb"]`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{"aabb", "bbaa"}, providedAddresses)
+		assert.Equal(t, map[string]uint64{"aabb": 5, "bbaa": 0}, response.Data.Nonces)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestAccountsGroup_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/verify-signature", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid address")
+		facade := &mock.FacadeStub{
+			VerifyMessageSignatureCalled: func(_ *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/verify-signature", bytes.NewBufferString(`{"address":"test"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyMessageSignatureCalled: func(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+				assert.Equal(t, &data.SignatureVerificationRequest{Address: "test", Message: "msg", Signature: "aabb"}, request)
+				return &data.SignatureVerification{Address: "test", Scheme: "prefixed", IsValid: true}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		body := `{"address":"test","message":"msg","signature":"aabb"}`
+		req, _ := http.NewRequest("POST", "/address/verify-signature", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type verificationResponse struct {
+			Data struct {
+				Verification *data.SignatureVerification `json:"verification"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &verificationResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, response.Data.Verification.IsValid)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestGetBalance_Denominated(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(denominationErr error) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address, Balance: "2500000000000000000"}}, nil
+			},
+			GetNativeTokenDenominationCalled: func() (int, error) {
+				return 18, denominationErr
+			},
+		}
+	}
+	getBalance := func(facade *mock.FacadeStub, query string) balanceResponse {
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/balance"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := balanceResponse{}
+		loadResponse(resp.Body, &response)
+
+		return response
+	}
+
+	t.Run("requested denominated balance should be added", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(nil), "?denominated=true")
+		assert.Equal(t, "2500000000000000000", response.Data.Balance)
+		assert.Equal(t, "2.5", response.Data.BalanceDenominated)
+	})
+	t.Run("not requested denominated balance should not be added", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(nil), "")
+		assert.Empty(t, response.Data.BalanceDenominated)
+	})
+	t.Run("denomination error should return the balance only", func(t *testing.T) {
+		t.Parallel()
+
+		response := getBalance(createFacade(errors.New("network config error")), "?denominated=true")
+		assert.Equal(t, "2500000000000000000", response.Data.Balance)
+		assert.Empty(t, response.Data.BalanceDenominated)
+	})
+}
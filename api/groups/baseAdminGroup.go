@@ -0,0 +1,193 @@
+package groups
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type adminGroup struct {
+	facade AdminFacadeHandler
+	*baseGroup
+}
+
+// NewAdminGroup returns a new instance of adminGroup
+func NewAdminGroup(facadeHandler data.FacadeHandler) (*adminGroup, error) {
+	facade, ok := facadeHandler.(AdminFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	// the admin endpoints change the proxy at runtime and expose the clients usage, so they always require the admin
+	// authentication, even if they are missing from the API config or are not marked as secured in it
+	ag := &adminGroup{
+		facade: facade,
+		baseGroup: &baseGroup{
+			isAlwaysSecured: true,
+		},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/observers", Handler: ag.addObserver, Method: http.MethodPost},
+		{Path: "/observers/:address", Handler: ag.removeObserver, Method: http.MethodDelete},
+		{Path: "/export-blocks", Handler: ag.startBlocksExport, Method: http.MethodPost},
+		{Path: "/export-blocks/:id", Handler: ag.getBlocksExportJob, Method: http.MethodGet},
+		{Path: "/read-only-mode", Handler: ag.getReadOnlyMode, Method: http.MethodGet},
+		{Path: "/read-only-mode", Handler: ag.setReadOnlyMode, Method: http.MethodPut},
+		{Path: "/usage", Handler: ag.getClientUsage, Method: http.MethodGet},
+	}
+	ag.baseGroup.endpoints = baseRoutesHandlers
+
+	return ag, nil
+}
+
+// addObserver will probe the provided observer and add it to the live observers list
+func (group *adminGroup) addObserver(c *gin.Context) {
+	var request = data.ObserverRegistrationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+	if request.Address == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrEmptyAddress.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	// the observers registered without a shard are added to the shard they report
+	node := &data.NodeData{
+		Address:         request.Address,
+		IsFallback:      request.IsFallback,
+		IsSnapshotless:  request.IsSnapshotless,
+		AutoDetectShard: request.ShardID == nil,
+		Zone:            request.Zone,
+		Username:        request.Username,
+		Password:        request.Password,
+		BearerToken:     request.BearerToken,
+	}
+	if request.ShardID != nil {
+		node.ShardId = *request.ShardID
+	}
+	err = group.facade.AddObserver(node)
+	if err != nil {
+		respondWithObserverUpdateError(c, err)
+		return
+	}
+
+	// the credentials are not echoed back, while the detected shard is
+	request.ShardID = &node.ShardId
+	request.Password = ""
+	request.BearerToken = ""
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"observer": request}, "", data.ReturnCodeSuccess)
+}
+
+// removeObserver will remove the observer with the provided address from the live observers list
+func (group *adminGroup) removeObserver(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrEmptyAddress.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	err := group.facade.RemoveObserver(address)
+	if err != nil {
+		respondWithObserverUpdateError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"address": address}, "", data.ReturnCodeSuccess)
+}
+
+func respondWithObserverUpdateError(c *gin.Context, err error) {
+	switch {
+	case stdErrors.Is(err, data.ErrObserverNotFound):
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+	case stdErrors.Is(err, data.ErrInvalidObserver):
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+	default:
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+	}
+}
+
+// startBlocksExport will start the export of the requested range of blocks to a file
+func (group *adminGroup) startBlocksExport(c *gin.Context) {
+	var request = data.BlocksExportRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	job, err := group.facade.StartBlocksExport(c.Request.Context(), &request)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"job": job}, "", data.ReturnCodeSuccess)
+}
+
+// getBlocksExportJob will return the status of a blocks export job
+func (group *adminGroup) getBlocksExportJob(c *gin.Context) {
+	job, err := group.facade.GetBlocksExportJob(c.Param("id"))
+	if err != nil {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"job": job}, "", data.ReturnCodeSuccess)
+}
+
+// getReadOnlyMode will return whether the proxy runs in read-only mode
+func (group *adminGroup) getReadOnlyMode(c *gin.Context) {
+	shared.RespondWith(c, http.StatusOK, gin.H{"readOnlyMode": group.facade.IsReadOnlyModeEnabled()}, "", data.ReturnCodeSuccess)
+}
+
+// setReadOnlyMode will enable or disable the read-only mode, in which the transactions cannot be sent through the proxy
+func (group *adminGroup) setReadOnlyMode(c *gin.Context) {
+	var request = data.ReadOnlyModeRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	group.facade.SetReadOnlyMode(request.Enabled)
+	log.WithContext(c.Request.Context()).Info("read-only mode changed through the admin API", "enabled", request.Enabled)
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"readOnlyMode": request.Enabled}, "", data.ReturnCodeSuccess)
+}
+
+// getClientUsage will return the requests count, the bandwidth and the error rate of the client with the provided API key
+// during the provided interval
+func (group *adminGroup) getClientUsage(c *gin.Context) {
+	options, err := parseClientUsageQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	usage, err := group.facade.GetClientUsage(options.ApiKey, options.From, options.To)
+	if stdErrors.Is(err, data.ErrClientsUsageTrackingDisabled) {
+		shared.RespondWith(c, http.StatusNotImplemented, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"usage": usage}, "", data.ReturnCodeSuccess)
+}
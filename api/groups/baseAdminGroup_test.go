@@ -0,0 +1,661 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminPath = "/admin"
+
+func TestNewAdminGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewAdminGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestAdminGroup_addObserver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString(`{"shardId":1}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			AddObserverCalled: func(node *data.NodeData) error {
+				return expectedErr
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString(`{"address":"http://127.0.0.1:8080"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("invalid observer should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			AddObserverCalled: func(node *data.NodeData) error {
+				return fmt.Errorf("%w: shard mismatch", data.ErrInvalidObserver)
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString(`{"address":"http://127.0.0.1:8080"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		shardID := uint32(2)
+		request := data.ObserverRegistrationRequest{
+			Address:        "http://127.0.0.1:8080",
+			ShardID:        &shardID,
+			IsSnapshotless: true,
+		}
+		var providedNode *data.NodeData
+		facade := &mock.FacadeStub{
+			AddObserverCalled: func(node *data.NodeData) error {
+				providedNode = node
+				return nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		requestBytes, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		expectedNode := &data.NodeData{
+			Address:        request.Address,
+			ShardId:        shardID,
+			IsSnapshotless: true,
+		}
+		assert.Equal(t, expectedNode, providedNode)
+	})
+	t.Run("omitted shard should be detected", func(t *testing.T) {
+		t.Parallel()
+
+		var providedNode *data.NodeData
+		facade := &mock.FacadeStub{
+			AddObserverCalled: func(node *data.NodeData) error {
+				providedNode = node
+				return nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString(`{"address":"http://127.0.0.1:8080"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, providedNode.AutoDetectShard)
+	})
+	t.Run("credentials should be forwarded but not echoed", func(t *testing.T) {
+		t.Parallel()
+
+		var providedNode *data.NodeData
+		facade := &mock.FacadeStub{
+			AddObserverCalled: func(node *data.NodeData) error {
+				providedNode = node
+				return nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		body := `{"address":"http://127.0.0.1:8080","username":"user","password":"pass"}`
+		req, _ := http.NewRequest("POST", "/admin/observers", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "user", providedNode.Username)
+		assert.Equal(t, "pass", providedNode.Password)
+		assert.False(t, strings.Contains(resp.Body.String(), "pass"))
+	})
+}
+
+func TestAdminGroup_removeObserver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			RemoveObserverCalled: func(address string) error {
+				return expectedErr
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("DELETE", "/admin/observers/127.0.0.1:8080", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("unknown observer should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RemoveObserverCalled: func(address string) error {
+				return fmt.Errorf("%w: %s", data.ErrObserverNotFound, address)
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("DELETE", "/admin/observers/127.0.0.1:8080", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("removing the last observer of a shard should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RemoveObserverCalled: func(address string) error {
+				return fmt.Errorf("%w: last observer of shard 0", data.ErrInvalidObserver)
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("DELETE", "/admin/observers/127.0.0.1:8080", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedAddress := ""
+		facade := &mock.FacadeStub{
+			RemoveObserverCalled: func(address string) error {
+				providedAddress = address
+				return nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("DELETE", "/admin/observers/127.0.0.1:8080", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "127.0.0.1:8080", providedAddress)
+	})
+}
+
+func TestAdminGroup_startBlocksExport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/export-blocks", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StartBlocksExportCalled: func(request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+				return nil, expectedErr
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("POST", "/admin/export-blocks", bytes.NewBufferString(`{"shard":0,"fromNonce":1,"toNonce":2}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedRequest *data.BlocksExportRequest
+		facade := &mock.FacadeStub{
+			StartBlocksExportCalled: func(request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+				providedRequest = request
+				return &data.BlocksExportJob{ID: "job", Status: "running"}, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		body := `{"shard":1,"fromNonce":10,"toNonce":20,"format":"proto"}`
+		req, _ := http.NewRequest("POST", "/admin/export-blocks", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, &data.BlocksExportRequest{Shard: 1, FromNonce: 10, ToNonce: 20, Format: "proto"}, providedRequest)
+	})
+}
+
+func TestAdminGroup_getBlocksExportJob(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("job not found")
+		facade := &mock.FacadeStub{
+			GetBlocksExportJobCalled: func(jobID string) (*data.BlocksExportJob, error) {
+				return nil, expectedErr
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/export-blocks/job", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedJob := &data.BlocksExportJob{ID: "job", Status: "completed", NumExported: 11}
+		facade := &mock.FacadeStub{
+			GetBlocksExportJobCalled: func(jobID string) (*data.BlocksExportJob, error) {
+				assert.Equal(t, "job", jobID)
+				return expectedJob, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/export-blocks/job", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type jobResponse struct {
+			Data struct {
+				Job *data.BlocksExportJob `json:"job"`
+			} `json:"data"`
+		}
+		response := jobResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedJob, response.Data.Job)
+	})
+}
+
+func TestAdminGroup_getClientUsage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing api key should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetClientUsageCalled: func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/usage", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetClientUsageCalled: func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/usage?apiKey=client&from=200&to=100", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("tracking disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetClientUsageCalled: func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+				return nil, data.ErrClientsUsageTrackingDisabled
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/usage?apiKey=client", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetClientUsageCalled: func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+				return nil, errors.New("storage down")
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/usage?apiKey=client", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedUsage := &data.ClientUsageReport{
+			ApiKey:    "client",
+			From:      100,
+			To:        200,
+			Requests:  4,
+			Errors:    1,
+			ErrorRate: 0.25,
+			Hourly:    []*data.ClientUsageBucket{{Timestamp: 0, Requests: 4, Errors: 1}},
+		}
+		facade := &mock.FacadeStub{
+			GetClientUsageCalled: func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+				assert.Equal(t, "client", apiKey)
+				assert.Equal(t, int64(100), from.Unix())
+				assert.Equal(t, int64(200), to.Unix())
+				return expectedUsage, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/usage?apiKey=client&from=100&to=200", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type usageResponse struct {
+			Data struct {
+				Usage *data.ClientUsageReport `json:"usage"`
+			} `json:"data"`
+		}
+		response := usageResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedUsage, response.Data.Usage)
+	})
+}
+
+func TestAdminGroup_readOnlyMode(t *testing.T) {
+	t.Parallel()
+
+	type readOnlyModeResponse struct {
+		Data struct {
+			ReadOnlyMode bool `json:"readOnlyMode"`
+		} `json:"data"`
+	}
+
+	t.Run("get should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsReadOnlyModeEnabledCalled: func() bool {
+				return true
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/read-only-mode", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := readOnlyModeResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, response.Data.ReadOnlyMode)
+	})
+	t.Run("set with invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetReadOnlyModeCalled: func(enabled bool) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("PUT", "/admin/read-only-mode", bytes.NewBufferString(`{"enabled":"yes"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("set should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedEnabled bool
+		facade := &mock.FacadeStub{
+			SetReadOnlyModeCalled: func(enabled bool) {
+				providedEnabled = enabled
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("PUT", "/admin/read-only-mode", bytes.NewBufferString(`{"enabled":true}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := readOnlyModeResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, providedEnabled)
+		assert.True(t, response.Data.ReadOnlyMode)
+	})
+	t.Run("set without the admin package in the config should require the authentication", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetReadOnlyModeCalled: func(enabled bool) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := gin.New()
+		authenticationFunc := func(c *gin.Context) {
+			c.AbortWithStatus(http.StatusUnauthorized)
+		}
+		adminGroup.RegisterRoutes(ws.Group(adminPath), data.ApiRoutesConfig{}, authenticationFunc, emptyGinHandler, emptyGinHandler)
+
+		req, _ := http.NewRequest("PUT", "/admin/read-only-mode", bytes.NewBufferString(`{"enabled":true}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+}
+
+func TestAdminGroup_ShouldAlwaysRequireTheAuthentication(t *testing.T) {
+	t.Parallel()
+
+	authenticationFunc := func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+	notSecuredApiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"admin": {
+				Routes: []data.RouteConfig{
+					{Name: "/observers", Open: true, Secured: false},
+					{Name: "/export-blocks/:id", Open: true, Secured: false},
+					{Name: "/read-only-mode", Open: true, Secured: false},
+					{Name: "/usage", Open: true, Secured: false},
+				},
+			},
+		},
+	}
+
+	testRequests := func(ws *gin.Engine, expectedCode int) {
+		requests := []*http.Request{
+			httptest.NewRequest(http.MethodPost, "/admin/observers", bytes.NewBufferString(`{"address":"http://127.0.0.1:8080"}`)),
+			httptest.NewRequest(http.MethodDelete, "/admin/observers/address", nil),
+			httptest.NewRequest(http.MethodPost, "/admin/export-blocks", bytes.NewBufferString(`{"shard":0}`)),
+			httptest.NewRequest(http.MethodGet, "/admin/export-blocks/id", nil),
+			httptest.NewRequest(http.MethodGet, "/admin/read-only-mode", nil),
+			httptest.NewRequest(http.MethodPut, "/admin/read-only-mode", bytes.NewBufferString(`{"enabled":true}`)),
+			httptest.NewRequest(http.MethodGet, "/admin/usage?apiKey=key", nil),
+		}
+		for _, req := range requests {
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+			assert.Equal(t, expectedCode, resp.Code, req.Method+" "+req.URL.Path)
+		}
+	}
+
+	t.Run("admin package missing from the config", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, _ := groups.NewAdminGroup(&mock.FacadeStub{})
+		ws := gin.New()
+		adminGroup.RegisterRoutes(ws.Group(adminPath), data.ApiRoutesConfig{}, authenticationFunc, emptyGinHandler, emptyGinHandler)
+
+		testRequests(ws, http.StatusUnauthorized)
+	})
+	t.Run("routes not secured in the config", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, _ := groups.NewAdminGroup(&mock.FacadeStub{})
+		ws := gin.New()
+		adminGroup.RegisterRoutes(ws.Group(adminPath), notSecuredApiConfig, authenticationFunc, emptyGinHandler, emptyGinHandler)
+
+		testRequests(ws, http.StatusUnauthorized)
+	})
+	t.Run("no authentication should not register the endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, _ := groups.NewAdminGroup(&mock.FacadeStub{})
+		ws := gin.New()
+		adminGroup.RegisterRoutes(ws.Group(adminPath), data.ApiRoutesConfig{}, nil, emptyGinHandler, emptyGinHandler)
+
+		testRequests(ws, http.StatusNotFound)
+	})
+}
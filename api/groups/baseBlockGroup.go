@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -31,6 +32,8 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet},
+		{Path: "/by-hashes", Handler: bg.byHashesHandler, Method: http.MethodPost},
+		{Path: "/:shard/epoch-start/:epoch", Handler: bg.epochStartHandler, Method: http.MethodGet},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -69,13 +72,22 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, options)
+	onlyFinal, err := parseBoolUrlParam(c, common.UrlParameterOnlyFinal)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blockByHashResponse, err := group.facade.GetBlockByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if onlyFinal && group.respondIfBlockNotFinal(c, shardID, blockByHashResponse.Data.Block.Nonce) {
+		return
+	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, selectBlockFields(c, blockByHashResponse))
 }
 
 // byNonceHandler will handle the fetching and returning a block based on its nonce
@@ -110,13 +122,116 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, options)
+	onlyFinal, err := parseBoolUrlParam(c, common.UrlParameterOnlyFinal)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+	if onlyFinal && group.respondIfBlockNotFinal(c, shardID, nonce) {
+		return
+	}
+
+	blockByNonceResponse, err := group.facade.GetBlockByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	shared.RespondWithJSON(c, http.StatusOK, selectBlockFields(c, blockByNonceResponse))
+}
+
+// respondIfBlockNotFinal responds with 404 if the block is not deep enough below the chain tip to be reported as final,
+// so that the clients asking only for final data never see a block which may still be reverted
+func (group *blockGroup) respondIfBlockNotFinal(c *gin.Context, shardID uint32, nonce uint64) bool {
+	isFinal, err := group.facade.IsBlockFinal(c.Request.Context(), shardID, nonce)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return true
+	}
+	if !isFinal {
+		shared.RespondWith(c, http.StatusNotFound, nil, apiErrors.ErrBlockNotFinal.Error(), data.ReturnCodeRequestError)
+		return true
+	}
+
+	return false
+}
+
+// epochStartHandler will handle the fetching and returning of the block which started an epoch in a shard
+func (group *blockGroup) epochStartHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrCannotParseShardID.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	epoch, err := shared.FetchEpochFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrCannotParseEpoch.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	epochStartBlockResponse, err := group.facade.GetEpochStartBlock(c.Request.Context(), shardID, epoch, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, selectBlockFields(c, epochStartBlockResponse))
+}
+
+// byHashesHandler will handle the fetching and returning of the blocks requested by their shards and hashes
+func (group *blockGroup) byHashesHandler(c *gin.Context) {
+	var request = data.BlocksByHashesRequest{}
+	err := shared.BindJSONBody(c, &request)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blocks, err := group.facade.GetBlocksByHashes(c.Request.Context(), request.Blocks, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"blocks": blocks}, "", data.ReturnCodeSuccess)
+}
+
+// selectBlockFields reduces the block of the response to the fields requested with the fields query parameter
+func selectBlockFields(c *gin.Context, response *data.BlockApiResponse) interface{} {
+	if len(c.Query(shared.FieldsQueryParam)) == 0 {
+		return response
+	}
+
+	return data.GenericAPIResponse{
+		Data:  gin.H{"block": shared.SelectFields(c, response.Data.Block)},
+		Error: response.Error,
+		Code:  response.Code,
+	}
 }
 
 func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
@@ -138,13 +253,13 @@ func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(shardID, nonce, options)
+	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByNonceResponse)
 }
 
 func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
@@ -166,11 +281,11 @@ func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(shardID, hash, options)
+	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByHashResponse)
 }
@@ -1,12 +1,15 @@
 package groups_test
 
 import (
+	"bytes"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
@@ -147,6 +150,80 @@ func TestGetBlockByNonce_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+func TestGetEpochStartBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/4294967295/epoch-start/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrCannotParseEpoch.Error(), apiResp.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEpochStartBlockCalled: func(_ uint32, _ uint32, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return nil, errors.New("epoch start not found")
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/4294967295/epoch-start/10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "epoch start not found", apiResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEpochStartBlockCalled: func(shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, core.MetachainShardId, shardID)
+				assert.Equal(t, uint32(10), epoch)
+				assert.True(t, options.WithTransactions)
+
+				return &data.BlockApiResponse{
+					Data: data.BlockApiResponsePayload{Block: api.Block{
+						Nonce:          1234,
+						Epoch:          epoch,
+						EpochStartInfo: &api.EpochStartInfo{TotalSupply: "20000000"},
+					}},
+				}, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/4294967295/epoch-start/10?withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(1234), apiResp.Data.Block.Nonce)
+		assert.Equal(t, "20000000", apiResp.Data.Block.EpochStartInfo.TotalSupply)
+	})
+}
+
 func TestGetBlockByHash_FailWhenShardParamIsInvalid(t *testing.T) {
 	t.Parallel()
 
@@ -267,6 +344,160 @@ func TestGetBlockByHash_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+func TestGetBlockByNonce_OnlyFinal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid onlyFinal parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce/1?onlyFinal=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("finality check error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsBlockFinalCalled: func(shardID uint32, nonce uint64) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce/1?onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), apiResp.Error)
+	})
+	t.Run("not final block should not be returned", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsBlockFinalCalled: func(shardID uint32, nonce uint64) (bool, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(37), nonce)
+				return false, nil
+			},
+			GetBlockByNonceCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Fail(t, "should not have fetched the block")
+				return nil, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/1/by-nonce/37?onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, apiErrors.ErrBlockNotFinal.Error(), apiResp.Error)
+	})
+	t.Run("final block should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsBlockFinalCalled: func(shardID uint32, nonce uint64) (bool, error) {
+				return true, nil
+			},
+			GetBlockByNonceCalled: func(_ uint32, nonce uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return &data.BlockApiResponse{
+					Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce}},
+				}, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce/37?onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(37), apiResp.Data.Block.Nonce)
+	})
+}
+
+func TestGetBlockByHash_OnlyFinal(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(isFinal bool) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			IsBlockFinalCalled: func(shardID uint32, nonce uint64) (bool, error) {
+				assert.Equal(t, uint64(37), nonce)
+				return isFinal, nil
+			},
+			GetBlockByHashCalled: func(_ uint32, hash string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return &data.BlockApiResponse{
+					Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: 37, Hash: hash}},
+				}, nil
+			},
+		}
+	}
+
+	t.Run("not final block should not be returned", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(createFacade(false))
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-hash/aaaa?onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Empty(t, apiResp.Data.Block.Hash)
+		assert.Equal(t, apiErrors.ErrBlockNotFinal.Error(), apiResp.Error)
+	})
+	t.Run("final block should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(createFacade(true))
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-hash/aaaa?onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "aaaa", apiResp.Data.Block.Hash)
+	})
+}
+
 func getAlteredAccounts(t *testing.T, ws *gin.Engine, url string, expectedRespCode int) *data.AlteredAccountsApiResponse {
 	req, _ := http.NewRequest("GET", url, nil)
 	resp := httptest.NewRecorder()
@@ -470,3 +701,75 @@ func TestGetAlteredAccountsByHash(t *testing.T) {
 		require.Equal(t, expectedApiResponse, apiResp)
 	})
 }
+
+func TestGetBlocksByHashes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("POST", "/block/by-hashes", bytes.NewBufferString("invalid"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("too many blocks")
+		facade := &mock.FacadeStub{
+			GetBlocksByHashesCalled: func(requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("POST", "/block/by-hashes", bytes.NewBufferString(`{"blocks":[]}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		results := []*data.BlockByHashResult{
+			{Shard: 1, Hash: "aa", Block: &api.Block{Nonce: 7, Hash: "aa"}},
+			{Shard: 0, Hash: "bb", Error: "not found"},
+		}
+		facade := &mock.FacadeStub{
+			GetBlocksByHashesCalled: func(requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+				assert.Equal(t, []*data.BlockByHashRequest{{Shard: 1, Hash: "aa"}, {Shard: 0, Hash: "bb"}}, requests)
+				assert.True(t, options.WithTransactions)
+				return results, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		body := `{"blocks":[{"shard":1,"hash":"aa"},{"shard":0,"hash":"bb"}]}`
+		req, _ := http.NewRequest("POST", "/block/by-hashes?withTxs=true", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Blocks []*data.BlockByHashResult `json:"blocks"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, results, response.Data.Blocks)
+	})
+}
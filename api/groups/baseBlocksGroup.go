@@ -45,11 +45,11 @@ func (bbp *blocksGroup) byRoundHandler(c *gin.Context) {
 		return
 	}
 
-	blockByRoundResponse, err := bbp.facade.GetBlocksByRound(round, options)
+	blockByRoundResponse, err := bbp.facade.GetBlocksByRound(c.Request.Context(), round, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByRoundResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByRoundResponse)
 }
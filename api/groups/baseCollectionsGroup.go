@@ -0,0 +1,52 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type collectionsGroup struct {
+	facade CollectionsFacadeHandler
+	*baseGroup
+}
+
+// NewCollectionsGroup returns a new instance of collectionsGroup
+func NewCollectionsGroup(facadeHandler data.FacadeHandler) (*collectionsGroup, error) {
+	facade, ok := facadeHandler.(CollectionsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	cg := &collectionsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:collection", Handler: cg.getCollection, Method: http.MethodGet},
+	}
+	cg.baseGroup.endpoints = baseRoutesHandlers
+
+	return cg, nil
+}
+
+// getCollection returns the properties, the roles and the number of issued NFTs of a collection
+func (group *collectionsGroup) getCollection(c *gin.Context) {
+	collection := c.Param("collection")
+	if collection == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetCollection, errors.ErrEmptyCollection)
+		return
+	}
+
+	result, err := group.facade.GetCollection(c.Request.Context(), collection)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetCollection, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"collection": result}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,94 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const collectionsPath = "/collections"
+
+func TestNewCollectionsGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewCollectionsGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestCollectionsGroup_GetCollection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("token is not a collection")
+		facade := &mock.FacadeStub{
+			GetCollectionCalled: func(_ string) (*data.Collection, error) {
+				return nil, expectedErr
+			},
+		}
+		collectionsGroup, err := groups.NewCollectionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(collectionsGroup, collectionsPath)
+
+		req, _ := http.NewRequest("GET", "/collections/TKN-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCollection := &data.Collection{
+			Identifier:    "COL-abcdef",
+			Name:          "Collection",
+			Type:          "SemiFungibleESDT",
+			Owner:         "owner",
+			Properties:    map[string]bool{"canPause": false},
+			Roles:         map[string][]string{"creator": {"ESDTRoleNFTCreate"}},
+			NumIssuedNFTs: 3,
+		}
+		facade := &mock.FacadeStub{
+			GetCollectionCalled: func(collection string) (*data.Collection, error) {
+				assert.Equal(t, "COL-abcdef", collection)
+				return expectedCollection, nil
+			},
+		}
+		collectionsGroup, err := groups.NewCollectionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(collectionsGroup, collectionsPath)
+
+		req, _ := http.NewRequest("GET", "/collections/COL-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type collectionResponse struct {
+			Data struct {
+				Collection *data.Collection `json:"collection"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &collectionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedCollection, response.Data.Collection)
+		assert.Empty(t, response.Error)
+	})
+}
@@ -0,0 +1,57 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type delegationGroup struct {
+	facade DelegationFacadeHandler
+	*baseGroup
+}
+
+// NewDelegationGroup returns a new instance of delegationGroup
+func NewDelegationGroup(facadeHandler data.FacadeHandler) (*delegationGroup, error) {
+	facade, ok := facadeHandler.(DelegationFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	dg := &delegationGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/providers", Handler: dg.getProviders, Method: http.MethodGet},
+		{Path: "/providers/:address", Handler: dg.getProvider, Method: http.MethodGet},
+	}
+	dg.baseGroup.endpoints = baseRoutesHandlers
+
+	return dg, nil
+}
+
+// getProviders returns the decoded data of all the delegation contracts created by the delegation manager
+func (group *delegationGroup) getProviders(c *gin.Context) {
+	providers, err := group.facade.GetDelegationProviders(c.Request.Context())
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"providers": providers}, "", data.ReturnCodeSuccess)
+}
+
+// getProvider returns the decoded data of the provided delegation contract
+func (group *delegationGroup) getProvider(c *gin.Context) {
+	provider, err := group.facade.GetDelegationProvider(c.Request.Context(), c.Param("address"))
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"provider": provider}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,162 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const delegationPath = "/delegation"
+
+func TestNewDelegationGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewDelegationGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestDelegationGroup_GetProviders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProviders := []*data.DelegationProvider{
+			{
+				Address:          "erd1provider0",
+				Owner:            "erd1owner0",
+				ServiceFee:       10,
+				MaxDelegationCap: "0",
+				NumDelegators:    120,
+				TotalActiveStake: "5000",
+				Metadata:         &data.DelegationProviderMetadata{Name: "provider", Website: "provider.com", Identifier: "prv"},
+			},
+			{
+				Address: "erd1provider1",
+				Error:   "query error",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationProvidersHandler: func() ([]*data.DelegationProvider, error) {
+				return expectedProviders, nil
+			},
+		}
+
+		delegationGroup, err := groups.NewDelegationGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(delegationGroup, delegationPath)
+
+		req, _ := http.NewRequest("GET", "/delegation/providers", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Providers []*data.DelegationProvider `json:"providers"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Empty(t, response.Error)
+		require.Equal(t, expectedProviders, response.Data.Providers)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("delegation manager query error")
+		facade := &mock.FacadeStub{
+			GetDelegationProvidersHandler: func() ([]*data.DelegationProvider, error) {
+				return nil, expectedErr
+			},
+		}
+
+		delegationGroup, _ := groups.NewDelegationGroup(facade)
+		ws := startProxyServer(delegationGroup, delegationPath)
+
+		req, _ := http.NewRequest("GET", "/delegation/providers", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, expectedErr.Error(), response.Error)
+		require.Equal(t, data.ReturnCodeInternalError, response.Code)
+	})
+}
+
+func TestDelegationGroup_GetProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProvider := &data.DelegationProvider{
+			Address:          "erd1provider",
+			Owner:            "erd1owner",
+			ServiceFee:       7.5,
+			MaxDelegationCap: "1000",
+			NumDelegators:    3,
+			TotalActiveStake: "900",
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationProviderHandler: func(address string) (*data.DelegationProvider, error) {
+				require.Equal(t, "erd1provider", address)
+				return expectedProvider, nil
+			},
+		}
+
+		delegationGroup, err := groups.NewDelegationGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(delegationGroup, delegationPath)
+
+		req, _ := http.NewRequest("GET", "/delegation/providers/erd1provider", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Provider *data.DelegationProvider `json:"provider"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Empty(t, response.Error)
+		require.Equal(t, expectedProvider, response.Data.Provider)
+	})
+	t.Run("facade error should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("could not create address from provided param")
+		facade := &mock.FacadeStub{
+			GetDelegationProviderHandler: func(address string) (*data.DelegationProvider, error) {
+				return nil, expectedErr
+			},
+		}
+
+		delegationGroup, _ := groups.NewDelegationGroup(facade)
+		ws := startProxyServer(delegationGroup, delegationPath)
+
+		req, _ := http.NewRequest("GET", "/delegation/providers/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, expectedErr.Error(), response.Error)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+}
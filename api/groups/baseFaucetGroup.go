@@ -0,0 +1,45 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type faucetGroup struct {
+	facade FaucetFacadeHandler
+	*baseGroup
+}
+
+// NewFaucetGroup returns a new instance of faucetGroup
+func NewFaucetGroup(facadeHandler data.FacadeHandler) (*faucetGroup, error) {
+	facade, ok := facadeHandler.(FaucetFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	fg := &faucetGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/requests/:id", Handler: fg.getFaucetRequest, Method: http.MethodGet},
+	}
+	fg.baseGroup.endpoints = baseRoutesHandlers
+
+	return fg, nil
+}
+
+// getFaucetRequest returns the status of a queued faucet request
+func (group *faucetGroup) getFaucetRequest(c *gin.Context) {
+	request, err := group.facade.GetFaucetRequest(c.Param("id"))
+	if err != nil {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"request": request}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,88 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const faucetPath = "/faucet"
+
+func TestNewFaucetGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewFaucetGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestFaucetGroup_GetFaucetRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRequest := &data.FaucetRequest{
+			ID:       "id",
+			Receiver: "erd1receiver",
+			Status:   "completed",
+			Attempts: 1,
+			TxHash:   "hash",
+		}
+		facade := &mock.FacadeStub{
+			GetFaucetRequestCalled: func(id string) (*data.FaucetRequest, error) {
+				require.Equal(t, "id", id)
+				return expectedRequest, nil
+			},
+		}
+
+		faucetGroup, err := groups.NewFaucetGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(faucetGroup, faucetPath)
+
+		req, _ := http.NewRequest("GET", "/faucet/requests/id", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Request *data.FaucetRequest `json:"request"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Empty(t, response.Error)
+		require.Equal(t, expectedRequest, response.Data.Request)
+	})
+	t.Run("unknown request should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("faucet request not found")
+		facade := &mock.FacadeStub{
+			GetFaucetRequestCalled: func(id string) (*data.FaucetRequest, error) {
+				return nil, expectedErr
+			},
+		}
+
+		faucetGroup, _ := groups.NewFaucetGroup(facade)
+		ws := startProxyServer(faucetGroup, faucetPath)
+
+		req, _ := http.NewRequest("GET", "/faucet/requests/missing", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Equal(t, expectedErr.Error(), response.Error)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+}
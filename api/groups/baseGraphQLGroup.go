@@ -0,0 +1,81 @@
+package groups
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/graphql"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type graphQLGroup struct {
+	facade GraphQLFacadeHandler
+	schema *graphql.Schema
+	*baseGroup
+}
+
+// NewGraphQLGroup returns a new instance of graphQLGroup
+func NewGraphQLGroup(facadeHandler data.FacadeHandler) (*graphQLGroup, error) {
+	facade, ok := facadeHandler.(GraphQLFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	gg := &graphQLGroup{
+		facade:    facade,
+		schema:    newGraphQLSchema(facade),
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: gg.executePostQuery, Method: http.MethodPost},
+		{Path: "", Handler: gg.executeGetQuery, Method: http.MethodGet},
+	}
+	gg.baseGroup.endpoints = baseRoutesHandlers
+
+	return gg, nil
+}
+
+// executePostQuery executes the query given in the JSON body, as {"query": ..., "variables": ..., "operationName": ...}
+func (gg *graphQLGroup) executePostQuery(c *gin.Context) {
+	request := graphql.Request{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrInvalidJSONRequest.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	gg.execute(c, request)
+}
+
+// executeGetQuery executes the query given in the query parameters, the variables being a JSON encoded object
+func (gg *graphQLGroup) executeGetQuery(c *gin.Context) {
+	request := graphql.Request{
+		Query:         c.Query("query"),
+		OperationName: c.Query("operationName"),
+	}
+
+	variables := c.Query("variables")
+	if len(variables) > 0 {
+		err := json.Unmarshal([]byte(variables), &request.Variables)
+		if err != nil {
+			shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrInvalidJSONRequest.Error(), data.ReturnCodeRequestError)
+			return
+		}
+	}
+
+	gg.execute(c, request)
+}
+
+func (gg *graphQLGroup) execute(c *gin.Context, request graphql.Request) {
+	response := graphql.Execute(gg.schema, request)
+	if response.Data == nil {
+		shared.RespondWithJSON(c, http.StatusBadRequest, response)
+		return
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, response)
+}
@@ -0,0 +1,224 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphQLPath = "/graphql"
+
+type graphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string        `json:"message"`
+		Path    []interface{} `json:"path"`
+	} `json:"errors"`
+}
+
+func postGraphQLQuery(t *testing.T, facade *mock.FacadeStub, body string) (int, *graphQLResponse) {
+	graphQLGroup, err := groups.NewGraphQLGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(graphQLGroup, graphQLPath)
+
+	req, _ := http.NewRequest("POST", graphQLPath, bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &graphQLResponse{}
+	loadResponse(resp.Body, response)
+
+	return resp.Code, response
+}
+
+func createGraphQLRequestBody(query string, variables map[string]interface{}) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+
+	return string(body)
+}
+
+func TestNewGraphQLGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		graphQLGroup, err := groups.NewGraphQLGroup(&mock.WrongFacade{})
+		require.Nil(t, graphQLGroup)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		graphQLGroup, err := groups.NewGraphQLGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, graphQLGroup)
+	})
+}
+
+func TestGraphQLGroup_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, _ := postGraphQLQuery(t, &mock.FacadeStub{}, "not a json")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, response := postGraphQLQuery(t, &mock.FacadeStub{}, createGraphQLRequestBody("{ account(", nil))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Nil(t, response.Data)
+		require.Len(t, response.Errors, 1)
+	})
+	t.Run("account should resolve the nested data", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address, Nonce: 3, Balance: "100"}}, nil
+			},
+			GetShardIDForAddressHandler: func(_ string) (uint32, error) {
+				return 1, nil
+			},
+			GetAllESDTTokensCalled: func(address string, _ common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+				assert.Equal(t, "erd1address", address)
+				return &data.GenericAPIResponse{Data: map[string]interface{}{
+					"esdts": map[string]interface{}{
+						"TKN2-abcdef": map[string]interface{}{"balance": "2"},
+						"TKN1-abcdef": map[string]interface{}{"tokenIdentifier": "TKN1-abcdef", "balance": "1"},
+					},
+				}}, nil
+			},
+			GetAddressLatestTransactionsCalled: func(address string, size uint32) ([]data.DatabaseTransaction, error) {
+				assert.Equal(t, "erd1address", address)
+				assert.Equal(t, uint32(2), size)
+				return []data.DatabaseTransaction{{Hash: "hash1", Fee: "50"}}, nil
+			},
+		}
+
+		query := `query ($address: String!) {
+			account(address: $address) {
+				nonce balance shard
+				tokens { tokenIdentifier balance }
+				latestTransactions(size: 2) { hash fee }
+			}
+		}`
+		code, response := postGraphQLQuery(t, facade, createGraphQLRequestBody(query, map[string]interface{}{"address": "erd1address"}))
+		require.Equal(t, http.StatusOK, code)
+		require.Empty(t, response.Errors)
+
+		expectedAccount := map[string]interface{}{
+			"nonce":   float64(3),
+			"balance": "100",
+			"shard":   float64(1),
+			"tokens": []interface{}{
+				map[string]interface{}{"tokenIdentifier": "TKN1-abcdef", "balance": "1"},
+				map[string]interface{}{"tokenIdentifier": "TKN2-abcdef", "balance": "2"},
+			},
+			"latestTransactions": []interface{}{
+				map[string]interface{}{"hash": "hash1", "fee": "50"},
+			},
+		}
+		assert.Equal(t, expectedAccount, response.Data["account"])
+	})
+	t.Run("failing nested field should not fail the account", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address, Nonce: 3}}, nil
+			},
+			GetAddressLatestTransactionsCalled: func(_ string, _ uint32) ([]data.DatabaseTransaction, error) {
+				return nil, data.ErrNoExternalStorage
+			},
+		}
+
+		query := `{ account(address: "erd1address") { nonce latestTransactions { hash } } }`
+		code, response := postGraphQLQuery(t, facade, createGraphQLRequestBody(query, nil))
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, map[string]interface{}{"nonce": float64(3), "latestTransactions": nil}, response.Data["account"])
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, data.ErrNoExternalStorage.Error(), response.Errors[0].Message)
+		assert.Equal(t, []interface{}{"account", "latestTransactions"}, response.Errors[0].Path)
+	})
+	t.Run("transaction and block should be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				assert.True(t, withResults)
+				return &transaction.ApiTransactionResult{Hash: txHash, Status: transaction.TxStatusSuccess}, nil
+			},
+			GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(10), nonce)
+				assert.True(t, options.WithTransactions)
+				return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Hash: "blockHash"}}}, nil
+			},
+		}
+
+		query := `{
+			transaction(hash: "txHash", withResults: true) { hash status }
+			block(shard: 1, nonce: 10, withTransactions: true) { nonce hash }
+			ambiguousBlock: block(shard: 1, nonce: 10, hash: "blockHash") { nonce }
+		}`
+		code, response := postGraphQLQuery(t, facade, createGraphQLRequestBody(query, nil))
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, map[string]interface{}{"hash": "txHash", "status": "success"}, response.Data["transaction"])
+		assert.Equal(t, map[string]interface{}{"nonce": float64(10), "hash": "blockHash"}, response.Data["block"])
+		assert.Nil(t, response.Data["ambiguousBlock"])
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, []interface{}{"ambiguousBlock"}, response.Errors[0].Path)
+	})
+	t.Run("network data should be resolved from a GET request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{Data: map[string]interface{}{
+					"config": map[string]interface{}{"erd_chain_id": "T"},
+				}}, nil
+			},
+			GetNetworkMetricsHandler: func(shardID uint32) (*data.GenericAPIResponse, error) {
+				return nil, errors.New("no observer online")
+			},
+		}
+		graphQLGroup, err := groups.NewGraphQLGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(graphQLGroup, graphQLPath)
+
+		params := url.Values{}
+		params.Set("query", "query ($shard: Int!) { networkConfig networkStatus(shard: $shard) }")
+		params.Set("variables", `{"shard":0}`)
+		req, _ := http.NewRequest("GET", graphQLPath+"?"+params.Encode(), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &graphQLResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, map[string]interface{}{"erd_chain_id": "T"}, response.Data["networkConfig"])
+		assert.Nil(t, response.Data["networkStatus"])
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "no observer online", response.Errors[0].Message)
+	})
+}
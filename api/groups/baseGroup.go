@@ -6,13 +6,16 @@ import (
 
 	"github.com/gin-gonic/gin"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
-var log = logger.GetOrCreate("api/groups")
+var log = common.NewRequestIDLogger(logger.GetOrCreate("api/groups"))
 
 type baseGroup struct {
 	endpoints []*data.EndpointHandlerData
+	// isAlwaysSecured makes all the endpoints require the authentication, whatever the API config holds for them
+	isAlwaysSecured bool
 	sync.RWMutex
 }
 
@@ -89,10 +92,20 @@ func (bg *baseGroup) RegisterRoutes(
 	bg.RLock()
 	defer bg.RUnlock()
 
+	if bg.isAlwaysSecured && authenticationFunc == nil {
+		log.Error("the endpoints of the group are not registered, as no authentication is set", "group", ws.BasePath())
+		return
+	}
+
 	for _, handlerData := range bg.endpoints {
 		properties := getEndpointProperties(ws, handlerData.Path, apiConfig)
 		if !properties.isFoundInConfig {
 			log.Warn("endpoint not found in config", "path", handlerData.Path)
+			if bg.isAlwaysSecured {
+				ws.Handle(handlerData.Method, handlerData.Path, authenticationFunc, handlerData.Handler)
+				continue
+			}
+
 			ws.Handle(handlerData.Method, handlerData.Path, handlerData.Handler)
 			continue
 		}
@@ -103,7 +116,7 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		middlewares := make([]gin.HandlerFunc, 0)
-		if properties.isSecured {
+		if properties.isSecured || bg.isAlwaysSecured {
 			middlewares = append(middlewares, authenticationFunc)
 		}
 
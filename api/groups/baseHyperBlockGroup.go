@@ -51,13 +51,13 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash, options)
+	blockByHashResponse, err := group.facade.GetHyperBlockByHash(c.Request.Context(), hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByHashResponse)
 }
 
 // hyperBlockByNonceHandler handles "by-nonce" requests
@@ -74,11 +74,11 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce, options)
+	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(c.Request.Context(), nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByNonceResponse)
 }
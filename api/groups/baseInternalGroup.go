@@ -71,13 +71,13 @@ func (group *internalGroup) internalBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Internal)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByHashResponse)
 }
 
 // internalBlockbyNonceHandler will handle the fetching and returning a block based on its hash
@@ -106,13 +106,13 @@ func (group *internalGroup) internalBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Internal)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByNonceResponse)
 }
 
 // rawBlockbyHashHandler will handle the fetching and returning a raw block based on its hash
@@ -142,13 +142,13 @@ func (group *internalGroup) rawBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Proto)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByHashResponse)
 }
 
 // rawBlockbyNonceHandler will handle the fetching and returning a raw block based on its hash
@@ -177,13 +177,13 @@ func (group *internalGroup) rawBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Proto)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	shared.RespondWithJSON(c, http.StatusOK, blockByNonceResponse)
 }
 
 // internalMiniBlockbyHashHandler will handle the fetching and returning a miniblock based on its hash
@@ -225,13 +225,13 @@ func (group *internalGroup) internalMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, miniBlockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, miniBlockByHashResponse)
 }
 
 // rawMiniBlockbyHashHandler will handle the fetching and returning a miniblock based on its hash
@@ -273,13 +273,13 @@ func (group *internalGroup) rawMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, miniBlockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, miniBlockByHashResponse)
 }
 
 // internalStartOfEpochMetaBlock will handle the fetching and returning the start of epoch metablock by epoch
@@ -296,13 +296,13 @@ func (group *internalGroup) internalStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, miniBlockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, miniBlockByHashResponse)
 }
 
 // rawStartOfEpochMetaBlock will handle the fetching and returning the start of epoch metablock by epoch
@@ -319,13 +319,13 @@ func (group *internalGroup) rawStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, miniBlockByHashResponse)
+	shared.RespondWithJSON(c, http.StatusOK, miniBlockByHashResponse)
 }
 
 // internalStartOfEpochValidatorsInfo will handle the fetching and returning the start of epoch validators info by epoch
@@ -342,11 +342,11 @@ func (group *internalGroup) internalStartOfEpochValidatorsInfo(c *gin.Context) {
 		return
 	}
 
-	validatorsInfo, err := group.facade.GetInternalStartOfEpochValidatorsInfo(epoch)
+	validatorsInfo, err := group.facade.GetInternalStartOfEpochValidatorsInfo(c.Request.Context(), epoch)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, validatorsInfo)
+	shared.RespondWithJSON(c, http.StatusOK, validatorsInfo)
 }
@@ -0,0 +1,77 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type miniBlockGroup struct {
+	facade MiniBlockFacadeHandler
+	*baseGroup
+}
+
+// NewMiniBlockGroup returns a new instance of miniBlockGroup
+func NewMiniBlockGroup(facadeHandler data.FacadeHandler) (*miniBlockGroup, error) {
+	facade, ok := facadeHandler.(MiniBlockFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	mbg := &miniBlockGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:shard/by-hash/:hash", Handler: mbg.byHashHandler, Method: http.MethodGet},
+	}
+	mbg.baseGroup.endpoints = baseRoutesHandlers
+
+	return mbg, nil
+}
+
+// byHashHandler will handle the fetching and returning a miniblock based on its hash, optionally along with its
+// transactions
+func (group *miniBlockGroup) byHashHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrCannotParseShardID.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	hash, err := shared.FetchHashFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrInvalidMiniBlockHashParam.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	options, err := parseMiniBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	miniBlock, err := group.facade.GetMiniBlockByHash(c.Request.Context(), shardID, hash, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"miniblock": miniBlock}, "", data.ReturnCodeSuccess)
+}
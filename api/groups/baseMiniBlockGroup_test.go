@@ -0,0 +1,139 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const miniBlockPath = "/miniblock"
+
+type miniBlockResponseData struct {
+	MiniBlock data.MiniBlock `json:"miniblock"`
+}
+
+type miniBlockResponse struct {
+	Data  miniBlockResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewMiniBlockGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewMiniBlockGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestGetMiniBlockByHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlockGroup, err := groups.NewMiniBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(miniBlockGroup, miniBlockPath)
+
+		req, _ := http.NewRequest("GET", "/miniblock/invalid/by-hash/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrCannotParseShardID.Error(), apiResp.Error)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlockGroup, err := groups.NewMiniBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(miniBlockGroup, miniBlockPath)
+
+		req, _ := http.NewRequest("GET", "/miniblock/0/by-hash/not-hex", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidMiniBlockHashParam.Error(), apiResp.Error)
+	})
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlockGroup, err := groups.NewMiniBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(miniBlockGroup, miniBlockPath)
+
+		req, _ := http.NewRequest("GET", "/miniblock/0/by-hash/aabb?epoch=a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMiniBlockByHashCalled: func(shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+				return nil, errors.New("miniblock not found")
+			},
+		}
+		miniBlockGroup, err := groups.NewMiniBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(miniBlockGroup, miniBlockPath)
+
+		req, _ := http.NewRequest("GET", "/miniblock/0/by-hash/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "miniblock not found", apiResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedMiniBlock := data.MiniBlock{
+			Hash:             "aabb",
+			Type:             "TxBlock",
+			DestinationShard: 1,
+			Epoch:            3,
+			TxHashes:         []string{"aa", "bb"},
+		}
+		facade := &mock.FacadeStub{
+			GetMiniBlockByHashCalled: func(shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, "aabb", hash)
+				assert.True(t, options.WithTransactions)
+				assert.Equal(t, uint32(3), options.Epoch.Value)
+				assert.True(t, options.Epoch.HasValue)
+				return &expectedMiniBlock, nil
+			},
+		}
+		miniBlockGroup, err := groups.NewMiniBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(miniBlockGroup, miniBlockPath)
+
+		req, _ := http.NewRequest("GET", "/miniblock/1/by-hash/aabb?withTxs=true&epoch=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := miniBlockResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedMiniBlock, apiResp.Data.MiniBlock)
+	})
+}
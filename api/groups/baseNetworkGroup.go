@@ -10,6 +10,8 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const networkStatusEventName = "status"
+
 type networkGroup struct {
 	facade NetworkFacadeHandler
 	*baseGroup
@@ -28,7 +30,9 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/status/all", Handler: ng.getNetworkStatusSnapshot, Method: http.MethodGet},
 		{Path: "/status/:shard", Handler: ng.getNetworkStatusData, Method: http.MethodGet},
+		{Path: "/status/stream/:shard", Handler: ng.streamNetworkStatus, Method: http.MethodGet},
 		{Path: "/config", Handler: ng.getNetworkConfigData, Method: http.MethodGet},
 		{Path: "/economics", Handler: ng.getEconomicsData, Method: http.MethodGet},
 		{Path: "/esdts", Handler: ng.getEsdts, Method: http.MethodGet},
@@ -39,9 +43,11 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 		{Path: "/enable-epochs", Handler: ng.getEnableEpochs, Method: http.MethodGet},
 		{Path: "/direct-staked-info", Handler: ng.getDirectStakedInfo, Method: http.MethodGet},
 		{Path: "/delegated-info", Handler: ng.getDelegatedInfo, Method: http.MethodGet},
+		{Path: "/staking-overview", Handler: ng.getStakingOverview, Method: http.MethodGet},
 		{Path: "/ratings", Handler: ng.getRatingsConfig, Method: http.MethodGet},
 		{Path: "/genesis-nodes", Handler: ng.getGenesisNodes, Method: http.MethodGet},
 		{Path: "/gas-configs", Handler: ng.getGasConfigs, Method: http.MethodGet},
+		{Path: "/gas-price-suggestion", Handler: ng.getGasPriceSuggestion, Method: http.MethodGet},
 		{Path: "/trie-statistics/:shard", Handler: ng.getTrieStatistics, Method: http.MethodGet},
 		{Path: "/epoch-start/:shard/by-epoch/:epoch", Handler: ng.getEpochStartData, Method: http.MethodGet},
 	}
@@ -58,27 +64,76 @@ func (group *networkGroup) getNetworkStatusData(c *gin.Context) {
 		return
 	}
 
-	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(shardIDUint)
+	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(c.Request.Context(), shardIDUint)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, networkStatusResults)
+	shared.RespondWithJSON(c, http.StatusOK, networkStatusResults)
+}
+
+// getNetworkStatusSnapshot will expose the status of all the shards, fetched concurrently, as a single snapshot
+func (group *networkGroup) getNetworkStatusSnapshot(c *gin.Context) {
+	snapshot, err := group.facade.GetNetworkStatusSnapshot(c.Request.Context())
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": snapshot}, "", data.ReturnCodeSuccess)
+}
+
+// streamNetworkStatus will push the round, nonce and epoch updates of the given shard as server-sent events
+func (group *networkGroup) streamNetworkStatus(c *gin.Context) {
+	shardIDUint, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrInvalidShardIDParam.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	updates, unsubscribe, err := group.facade.SubscribeToNetworkStatus(shardIDUint)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	defer unsubscribe()
+
+	// the headers are sent with the first flush, before any event, so they have to be set upfront
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+
+			c.SSEvent(networkStatusEventName, update)
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
 }
 
 // getNetworkConfigData will expose the node network metrics for the given shard
 func (group *networkGroup) getNetworkConfigData(c *gin.Context) {
-	networkConfigResults, err := group.facade.GetNetworkConfigMetrics()
+	networkConfigResults, err := group.facade.GetNetworkConfigMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, networkConfigResults)
+	shared.RespondWithJSON(c, http.StatusOK, networkConfigResults)
 }
 
-// getEconomicsData will expose the economics data metrics from an observer (if any available) in json format
+// getEconomicsData will expose the economics data metrics from an observer (if any available) in json format, along
+// with the values converted with the decimals of the native token if requested
 func (group *networkGroup) getEconomicsData(c *gin.Context) {
 	economicsData, err := group.facade.GetEconomicsDataMetrics()
 	if err != nil {
@@ -86,62 +141,114 @@ func (group *networkGroup) getEconomicsData(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, economicsData)
+	numDecimals, ok := getRequestedDenomination(c, group.facade)
+	if ok {
+		economicsData = denominateEconomicsData(economicsData, numDecimals)
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, economicsData)
+}
+
+// denominateEconomicsData returns a copy of the economics data, which is cached and shared between the requests, having
+// the denominated values added next to the metrics given in the smallest unit of the native token
+func denominateEconomicsData(economicsData *data.GenericAPIResponse, numDecimals int) *data.GenericAPIResponse {
+	responseData, ok := economicsData.Data.(map[string]interface{})
+	if !ok {
+		return economicsData
+	}
+	metrics, ok := responseData["metrics"].(map[string]interface{})
+	if !ok {
+		return economicsData
+	}
+
+	denominatedMetrics := make(map[string]interface{}, len(metrics)+len(economicsDenominatedMetrics))
+	for key, value := range metrics {
+		denominatedMetrics[key] = value
+	}
+	for _, key := range economicsDenominatedMetrics {
+		value, isString := metrics[key].(string)
+		if isString {
+			addDenominatedValue(denominatedMetrics, key, denominatedMetricSuffix, value, numDecimals)
+		}
+	}
+
+	denominatedData := make(map[string]interface{}, len(responseData))
+	for key, value := range responseData {
+		denominatedData[key] = value
+	}
+	denominatedData["metrics"] = denominatedMetrics
+
+	return &data.GenericAPIResponse{
+		Data:  denominatedData,
+		Error: economicsData.Error,
+		Code:  economicsData.Code,
+	}
 }
 
 func (group *networkGroup) getEsdtHandlerFunc(tokenType string) func(c *gin.Context) {
 	return func(c *gin.Context) {
-		tokens, err := group.facade.GetAllIssuedESDTs(tokenType)
+		tokens, err := group.facade.GetAllIssuedESDTs(c.Request.Context(), tokenType)
 		if err != nil {
 			shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 			return
 		}
 
-		c.JSON(http.StatusOK, tokens)
+		shared.RespondWithJSON(c, http.StatusOK, tokens)
 	}
 }
 
 // getDirectStakedInfo will expose the direct staked values from a metachain observer in json format
 func (group *networkGroup) getDirectStakedInfo(c *gin.Context) {
-	directStakedInfo, err := group.facade.GetDirectStakedInfo()
+	directStakedInfo, err := group.facade.GetDirectStakedInfo(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, directStakedInfo)
+	shared.RespondWithJSON(c, http.StatusOK, directStakedInfo)
 }
 
 // getDelegatedInfo will expose the delegated info values from a metachain observer in json format
 func (group *networkGroup) getDelegatedInfo(c *gin.Context) {
-	delegatedInfo, err := group.facade.GetDelegatedInfo()
+	delegatedInfo, err := group.facade.GetDelegatedInfo(c.Request.Context())
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, delegatedInfo)
+}
+
+// getStakingOverview will expose the aggregated staking economics of the network
+func (group *networkGroup) getStakingOverview(c *gin.Context) {
+	overview, err := group.facade.GetStakingOverview(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, delegatedInfo)
+	shared.RespondWith(c, http.StatusOK, gin.H{"overview": overview}, "", data.ReturnCodeSuccess)
 }
 
 // getEsdts will expose all the issued ESDTs
 func (group *networkGroup) getEsdts(c *gin.Context) {
-	allIssuedESDTs, err := group.facade.GetAllIssuedESDTs("")
+	allIssuedESDTs, err := group.facade.GetAllIssuedESDTs(c.Request.Context(), "")
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, allIssuedESDTs)
+	shared.RespondWithJSON(c, http.StatusOK, allIssuedESDTs)
 }
 
 func (group *networkGroup) getEnableEpochs(c *gin.Context) {
-	enableEpochsMetrics, err := group.facade.GetEnableEpochsMetrics()
+	enableEpochsMetrics, err := group.facade.GetEnableEpochsMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, enableEpochsMetrics)
+	shared.RespondWithJSON(c, http.StatusOK, enableEpochsMetrics)
 }
 
 func (group *networkGroup) getESDTSupply(c *gin.Context) {
@@ -157,46 +264,57 @@ func (group *networkGroup) getESDTSupply(c *gin.Context) {
 		return
 	}
 
-	esdtSupply, err := group.facade.GetESDTSupply(tokenIdentifier)
+	esdtSupply, err := group.facade.GetESDTSupply(c.Request.Context(), tokenIdentifier)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, esdtSupply)
+	shared.RespondWithJSON(c, http.StatusOK, esdtSupply)
 }
 
 // getRatingsConfig will expose the ratings configuration
 func (group *networkGroup) getRatingsConfig(c *gin.Context) {
-	networkConfigResults, err := group.facade.GetRatingsConfig()
+	networkConfigResults, err := group.facade.GetRatingsConfig(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, networkConfigResults)
+	shared.RespondWithJSON(c, http.StatusOK, networkConfigResults)
 }
 
 // getGenesisNodes will expose genesis nodes public keys
 func (group *networkGroup) getGenesisNodes(c *gin.Context) {
-	genesisNodes, err := group.facade.GetGenesisNodesPubKeys()
+	genesisNodes, err := group.facade.GetGenesisNodesPubKeys(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, genesisNodes)
+	shared.RespondWithJSON(c, http.StatusOK, genesisNodes)
 }
 
 // getGasConfigs will expose gas configs
 func (group *networkGroup) getGasConfigs(c *gin.Context) {
-	gasConfigs, err := group.facade.GetGasConfigs()
+	gasConfigs, err := group.facade.GetGasConfigs(c.Request.Context())
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithJSON(c, http.StatusOK, gasConfigs)
+}
+
+// getGasPriceSuggestion will expose the suggested gas price, computed based on the transactions pool congestion
+func (group *networkGroup) getGasPriceSuggestion(c *gin.Context) {
+	suggestion, err := group.facade.GetGasPriceSuggestion(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, gasConfigs)
+	shared.RespondWith(c, http.StatusOK, gin.H{"suggestion": suggestion}, "", data.ReturnCodeSuccess)
 }
 
 // getTrieStatistics will expose trie statistics
@@ -207,13 +325,13 @@ func (group *networkGroup) getTrieStatistics(c *gin.Context) {
 		return
 	}
 
-	trieStatistics, err := group.facade.GetTriesStatistics(shardID)
+	trieStatistics, err := group.facade.GetTriesStatistics(c.Request.Context(), shardID)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, trieStatistics)
+	shared.RespondWithJSON(c, http.StatusOK, trieStatistics)
 }
 
 // getEpochStartData will expose epoch-start data for a given shard and epoch
@@ -230,11 +348,11 @@ func (group *networkGroup) getEpochStartData(c *gin.Context) {
 		return
 	}
 
-	epochStartData, err := group.facade.GetEpochStartData(epoch, shardID)
+	epochStartData, err := group.facade.GetEpochStartData(c.Request.Context(), epoch, shardID)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, epochStartData)
+	shared.RespondWithJSON(c, http.StatusOK, epochStartData)
 }
@@ -95,6 +95,68 @@ func TestGetNetworkStatusData_ShouldWork(t *testing.T) {
 	assert.Equal(t, respMap, result.Data)
 }
 
+func TestGetNetworkStatusSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetNetworkStatusSnapshotHandler: func() (*data.NetworkStatusSnapshot, error) {
+				return nil, errors.New("no observer")
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/status/all", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot := &data.NetworkStatusSnapshot{
+			Timestamp: 1700000000,
+			Shards: []*data.ShardNetworkStatus{
+				{ShardID: 0, Nonce: 500, Round: 1010, Epoch: 5},
+				{ShardID: 4294967295, Nonce: 123, Round: 1011, Epoch: 5},
+			},
+			MaxRoundSkew: 1,
+			SameEpoch:    true,
+		}
+		facade := &mock.FacadeStub{
+			GetNetworkStatusSnapshotHandler: func() (*data.NetworkStatusSnapshot, error) {
+				return snapshot, nil
+			},
+			GetNetworkMetricsHandler: func(_ uint32) (*data.GenericAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/status/all", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		result := struct {
+			Data struct {
+				Status *data.NetworkStatusSnapshot `json:"status"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &result)
+		assert.Equal(t, snapshot, result.Data.Status)
+	})
+}
+
 func TestGetNetworkConfigData_BadRequestShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -219,6 +281,43 @@ func TestGetEconomicsData_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedResp.Data, ecDataResp.Data) //extra safe
 }
 
+func TestGetEconomicsData_DenominatedShouldNotAlterTheCachedMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := map[string]interface{}{
+		"erd_total_supply":             "20000000000000000000000000",
+		"erd_inflation":                "1500000000000000000",
+		"erd_epoch_for_economics_data": float64(10),
+	}
+	cachedResp := data.GenericAPIResponse{Data: map[string]interface{}{"metrics": metrics}}
+	facade := &mock.FacadeStub{
+		GetEconomicsDataMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &cachedResp, nil
+		},
+		GetNativeTokenDenominationCalled: func() (int, error) {
+			return 18, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/economics?denominated=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	ecDataResp := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &ecDataResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	responseMetrics := ecDataResp.Data.(map[string]interface{})["metrics"].(map[string]interface{})
+	assert.Equal(t, "20000000", responseMetrics["erd_total_supply_denominated"])
+	assert.Equal(t, "1.5", responseMetrics["erd_inflation_denominated"])
+	assert.Equal(t, "20000000000000000000000000", responseMetrics["erd_total_supply"])
+	assert.Equal(t, float64(10), responseMetrics["erd_epoch_for_economics_data"])
+	assert.Len(t, metrics, 3)
+}
+
 func TestGetAllIssuedESDTs_ShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -328,6 +427,72 @@ func TestGetDelegatedInfo_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedResp.Data, delegatedInfoResp.Data) //extra safe
 }
 
+func TestGetStakingOverview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStakingOverviewHandler: func() (*data.StakingOverview, error) {
+				return nil, errors.New("missing economics metric")
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/staking-overview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		queueSize := uint64(3)
+		overview := &data.StakingOverview{
+			TotalStaked:       "5000",
+			TotalBaseStaked:   "4000",
+			TotalTopUp:        "1000",
+			NumValidators:     3,
+			NumNodesPerStatus: map[string]int{"eligible": 2, "auction": 1},
+			Auction: &data.StakingAuctionSummary{
+				NumOwners:         1,
+				NumNodes:          1,
+				NumQualifiedNodes: 1,
+				MinQualifiedTopUp: "1000",
+			},
+			EstimatedAPR: 0.08,
+			QueueSize:    &queueSize,
+			Timestamp:    1700000000,
+		}
+		facade := &mock.FacadeStub{
+			GetStakingOverviewHandler: func() (*data.StakingOverview, error) {
+				return overview, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/staking-overview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		result := struct {
+			Data struct {
+				Overview *data.StakingOverview `json:"overview"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &result)
+		assert.Equal(t, overview, result.Data.Overview)
+	})
+}
+
 func TestGetDirectStaked_ShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -608,3 +773,135 @@ func TestGetTriesStatistics_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedResp, response)
 	assert.Equal(t, expectedResp.Data, response.Data)
 }
+
+func TestGetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func() (*data.GasPriceSuggestion, error) {
+				return nil, expectedErr
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/gas-price-suggestion", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, apiResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), apiResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuggestion := &data.GasPriceSuggestion{
+			MinGasPrice:       1000,
+			SuggestedGasPrice: 1500,
+			Strategy:          "linear",
+			Shards: []*data.ShardGasPriceSuggestion{
+				{ShardID: 0, PoolSize: 50, SuggestedGasPrice: 1500},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func() (*data.GasPriceSuggestion, error) {
+				return expectedSuggestion, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/gas-price-suggestion", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type gasPriceSuggestionResponse struct {
+			Data struct {
+				Suggestion *data.GasPriceSuggestion `json:"suggestion"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		apiResp := &gasPriceSuggestionResponse{}
+		loadResponse(resp.Body, apiResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, apiResp.Error)
+		assert.Equal(t, expectedSuggestion, apiResp.Data.Suggestion)
+	})
+}
+
+func TestStreamNetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/status/stream/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeToNetworkStatusCalled: func(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+				return nil, nil, errors.New("unknown shard")
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/status/stream/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should stream the updates", func(t *testing.T) {
+		t.Parallel()
+
+		unsubscribeCalled := false
+		facade := &mock.FacadeStub{
+			SubscribeToNetworkStatusCalled: func(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+				require.Equal(t, uint32(1), shardID)
+
+				updates := make(chan *data.NetworkStatusUpdate, 1)
+				updates <- &data.NetworkStatusUpdate{ShardID: 1, Round: 10, Nonce: 9, Epoch: 2}
+				close(updates)
+
+				return updates, func() { unsubscribeCalled = true }, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/status/stream/1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+		assert.Contains(t, resp.Body.String(), "event:status")
+		assert.Contains(t, resp.Body.String(), `"round":10`)
+		assert.True(t, unsubscribeCalled)
+	})
+}
@@ -0,0 +1,76 @@
+package groups
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const passthroughPathParam = "path"
+
+type nodePassthroughGroup struct {
+	facade NodePassthroughFacadeHandler
+	*baseGroup
+}
+
+// NewNodePassthroughGroup returns a new instance of nodePassthroughGroup
+func NewNodePassthroughGroup(facadeHandler data.FacadeHandler) (*nodePassthroughGroup, error) {
+	facade, ok := facadeHandler.(NodePassthroughFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	npg := &nodePassthroughGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:shard/*path", Handler: npg.forwardToNode, Method: http.MethodGet},
+		{Path: "/:shard/*path", Handler: npg.forwardToNode, Method: http.MethodPost},
+	}
+	npg.baseGroup.endpoints = baseRoutesHandlers
+
+	return npg, nil
+}
+
+// forwardToNode will forward the request to an observer of the given shard and return its raw response
+func (group *nodePassthroughGroup) forwardToNode(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrInvalidShardIDParam.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	var body []byte
+	if c.Request.Method == http.MethodPost {
+		body, err = io.ReadAll(c.Request.Body)
+		if err != nil {
+			shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+	}
+
+	statusCode, response, err := group.facade.ForwardToNode(c.Request.Context(),
+		shardID,
+		c.Request.Method,
+		c.Param(passthroughPathParam),
+		c.Request.URL.RawQuery,
+		body,
+	)
+	if err != nil {
+		returnCode := data.ReturnCodeRequestError
+		if statusCode >= http.StatusInternalServerError {
+			returnCode = data.ReturnCodeInternalError
+		}
+		shared.RespondWith(c, statusCode, nil, err.Error(), returnCode)
+		return
+	}
+
+	// the node responses follow the generic API response format, so they get the envelope of the version as well
+	shared.RespondWithJSON(c, statusCode, response)
+}
@@ -0,0 +1,94 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodePassthroughPath = "/node-passthrough"
+
+func TestNewNodePassthroughGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewNodePassthroughGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestNodePassthroughGroup_ForwardToNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewNodePassthroughGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(group, nodePassthroughPath)
+
+		req, _ := http.NewRequest("GET", "/node-passthrough/invalid/node/peerinfo", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should return its status code", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ForwardToNodeCalled: func(shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error) {
+				return http.StatusForbidden, nil, errors.New("endpoint not allowed")
+			},
+		}
+		group, err := groups.NewNodePassthroughGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(group, nodePassthroughPath)
+
+		req, _ := http.NewRequest("GET", "/node-passthrough/0/node/status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.Equal(t, "endpoint not allowed", response.Error)
+		assert.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("should return the raw response", func(t *testing.T) {
+		t.Parallel()
+
+		rawResponse := `{"data":{"info":[]},"error":"","code":"successful"}`
+		facade := &mock.FacadeStub{
+			ForwardToNodeCalled: func(shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error) {
+				assert.Equal(t, uint32(2), shardID)
+				assert.Equal(t, http.MethodPost, method)
+				assert.Equal(t, "/debug/query", endpoint)
+				assert.Equal(t, "a=b", rawQuery)
+				assert.Equal(t, []byte(`{"key":"value"}`), body)
+
+				return http.StatusOK, json.RawMessage(rawResponse), nil
+			},
+		}
+		group, err := groups.NewNodePassthroughGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(group, nodePassthroughPath)
+
+		req, _ := http.NewRequest("POST", "/node-passthrough/2/debug/query?a=b", bytes.NewBufferString(`{"key":"value"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, rawResponse, resp.Body.String())
+	})
+}
@@ -0,0 +1,56 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const probeFailedErrorMsg = "probe failed"
+
+type probesGroup struct {
+	facade ProbesFacadeHandler
+	*baseGroup
+}
+
+// NewProbesGroup returns a new instance of probesGroup, serving the liveness and readiness probes
+func NewProbesGroup(facadeHandler data.FacadeHandler) (*probesGroup, error) {
+	facade, ok := facadeHandler.(ProbesFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	pg := &probesGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/live", Handler: pg.getLiveness, Method: http.MethodGet},
+		{Path: "/ready", Handler: pg.getReadiness, Method: http.MethodGet},
+	}
+	pg.baseGroup.endpoints = baseRoutesHandlers
+
+	return pg, nil
+}
+
+// getLiveness responds with 200 if the proxy is alive, or with 503 if it should be restarted
+func (group *probesGroup) getLiveness(c *gin.Context) {
+	respondWithProbeStatus(c, group.facade.GetLiveness())
+}
+
+// getReadiness responds with 200 if the proxy can serve requests, or with 503 if it should not receive traffic
+func (group *probesGroup) getReadiness(c *gin.Context) {
+	respondWithProbeStatus(c, group.facade.GetReadiness())
+}
+
+func respondWithProbeStatus(c *gin.Context, status *data.ProbeStatus) {
+	if !status.Healthy {
+		shared.RespondWith(c, http.StatusServiceUnavailable, gin.H{"status": status}, probeFailedErrorMsg, data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": status}, "", data.ReturnCodeSuccess)
+}
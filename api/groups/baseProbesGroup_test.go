@@ -0,0 +1,81 @@
+package groups_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const probesPath = "/probes"
+
+type probeStatusResponse struct {
+	Data struct {
+		Status data.ProbeStatus `json:"status"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewProbesGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewProbesGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestProbesGroup(t *testing.T) {
+	t.Parallel()
+
+	healthyStatus := &data.ProbeStatus{
+		Healthy: true,
+		Checks:  []*data.ProbeCheck{{Name: "nodesStateChecks", Healthy: true}},
+	}
+	unhealthyStatus := &data.ProbeStatus{
+		Healthy: false,
+		Checks:  []*data.ProbeCheck{{Name: "observers", Healthy: false, Details: "no synced observer in shards 1"}},
+	}
+	facade := &mock.FacadeStub{
+		GetLivenessCalled: func() *data.ProbeStatus {
+			return healthyStatus
+		},
+		GetReadinessCalled: func() *data.ProbeStatus {
+			return unhealthyStatus
+		},
+	}
+	probesGroup, err := groups.NewProbesGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(probesGroup, probesPath)
+
+	t.Run("healthy probe should respond with 200", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", probesPath+"/live", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := probeStatusResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *healthyStatus, response.Data.Status)
+		assert.Empty(t, response.Error)
+	})
+	t.Run("unhealthy probe should respond with 503", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", probesPath+"/ready", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := probeStatusResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, *unhealthyStatus, response.Data.Status)
+		assert.NotEmpty(t, response.Error)
+	})
+}
@@ -51,13 +51,13 @@ func (pg *proofGroup) getProof(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProof(rootHash, address)
+	getProofResp, err := pg.facade.GetProof(c.Request.Context(), rootHash, address)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, getProofResp)
+	shared.RespondWithJSON(c, http.StatusOK, getProofResp)
 }
 
 func (pg *proofGroup) getProofDataTrie(c *gin.Context) {
@@ -79,13 +79,13 @@ func (pg *proofGroup) getProofDataTrie(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProofDataTrie(rootHash, address, key)
+	getProofResp, err := pg.facade.GetProofDataTrie(c.Request.Context(), rootHash, address, key)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, getProofResp)
+	shared.RespondWithJSON(c, http.StatusOK, getProofResp)
 }
 
 func (pg *proofGroup) getProofCurrentRootHash(c *gin.Context) {
@@ -95,13 +95,13 @@ func (pg *proofGroup) getProofCurrentRootHash(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProofCurrentRootHash(address)
+	getProofResp, err := pg.facade.GetProofCurrentRootHash(c.Request.Context(), address)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, getProofResp)
+	shared.RespondWithJSON(c, http.StatusOK, getProofResp)
 }
 
 func (pg *proofGroup) verifyProof(c *gin.Context) {
@@ -118,11 +118,11 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 		return
 	}
 
-	verifyProofResp, err := pg.facade.VerifyProof(proofParams.RootHash, proofParams.Address, proofParams.Proof)
+	verifyProofResp, err := pg.facade.VerifyProof(c.Request.Context(), proofParams.RootHash, proofParams.Address, proofParams.Proof)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, verifyProofResp)
+	shared.RespondWithJSON(c, http.StatusOK, verifyProofResp)
 }
@@ -0,0 +1,90 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type sovereignGroup struct {
+	facade SovereignFacadeHandler
+	*baseGroup
+}
+
+// NewSovereignGroup returns a new instance of sovereignGroup
+func NewSovereignGroup(facadeHandler data.FacadeHandler) (*sovereignGroup, error) {
+	facade, ok := facadeHandler.(SovereignFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	sg := &sovereignGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/validators/:epoch", Handler: sg.getValidators, Method: http.MethodGet},
+		{Path: "/chain-parameters", Handler: sg.getChainParameters, Method: http.MethodGet},
+		{Path: "/notarized-main-chain-headers", Handler: sg.getNotarizedMainChainHeaders, Method: http.MethodGet},
+	}
+	sg.baseGroup.endpoints = baseRoutesHandlers
+
+	return sg, nil
+}
+
+// getValidators will expose the sovereign chain's validator set for the given epoch
+func (group *sovereignGroup) getValidators(c *gin.Context) {
+	epoch, err := shared.FetchEpochFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("error while parsing the epoch: %s", err.Error()))
+		return
+	}
+
+	validatorsInfo, err := group.facade.GetSovereignValidatorsInfo(c.Request.Context(), epoch)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"validators": validatorsInfo}, "", data.ReturnCodeSuccess)
+}
+
+// getChainParameters will expose the sovereign chain specific settings, such as the bridge contracts and the native token
+func (group *sovereignGroup) getChainParameters(c *gin.Context) {
+	chainParameters, err := group.facade.GetSovereignChainParameters(c.Request.Context())
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"parameters": chainParameters}, "", data.ReturnCodeSuccess)
+}
+
+// getNotarizedMainChainHeaders will expose the main chain headers notarized by the sovereign blocks with the nonces
+// between the from and to query parameters, both included
+func (group *sovereignGroup) getNotarizedMainChainHeaders(c *gin.Context) {
+	from, err := parseUint64UrlParam(c, common.UrlParameterFrom)
+	if err != nil || !from.HasValue {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, fmt.Errorf("invalid %s parameter", common.UrlParameterFrom))
+		return
+	}
+	to, err := parseUint64UrlParam(c, common.UrlParameterTo)
+	if err != nil || !to.HasValue {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, fmt.Errorf("invalid %s parameter", common.UrlParameterTo))
+		return
+	}
+
+	notarizedHeaders, err := group.facade.GetSovereignNotarizedMainChainHeaders(c.Request.Context(), from.Value, to.Value)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, notarizedHeaders, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,256 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sovereignPath = "/sovereign"
+
+type sovereignValidatorsResponse struct {
+	Data struct {
+		Validators *data.SovereignValidatorsInfo `json:"validators"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type sovereignChainParametersResponse struct {
+	Data struct {
+		Parameters *data.SovereignChainParameters `json:"parameters"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type notarizedMainChainHeadersResponse struct {
+	Data  *data.NotarizedMainChainHeaders `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestNewSovereignGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewSovereignGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestSovereignGroup_getValidators(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignGroup, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/validators/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "error while parsing the epoch")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetSovereignValidatorsInfoCalled: func(epoch uint32) (*data.SovereignValidatorsInfo, error) {
+				return nil, expectedErr
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/validators/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedInfo := &data.SovereignValidatorsInfo{
+			Epoch:              3,
+			ConsensusGroupSize: 2,
+			TotalStake:         "2500",
+			Validators: []*data.SovereignValidator{
+				{PublicKey: "aa", List: "eligible", Owner: "erd1owner", Stake: "2500"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetSovereignValidatorsInfoCalled: func(epoch uint32) (*data.SovereignValidatorsInfo, error) {
+				assert.Equal(t, uint32(3), epoch)
+				return expectedInfo, nil
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/validators/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &sovereignValidatorsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedInfo, response.Data.Validators)
+	})
+}
+
+func TestSovereignGroup_getChainParameters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetSovereignChainParametersCalled: func() (*data.SovereignChainParameters, error) {
+				return nil, expectedErr
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/chain-parameters", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedParameters := &data.SovereignChainParameters{
+			ChainID:                        "S",
+			NativeTokenIdentifier:          "SOV-123456",
+			MainChainNotarizationAddresses: []string{"erd1notary"},
+			BridgeContracts: data.SovereignBridgeContracts{
+				ESDTSafeAddress: "erd1safe",
+			},
+			RoundDuration:  6000,
+			RoundsPerEpoch: 200,
+		}
+		facade := &mock.FacadeStub{
+			GetSovereignChainParametersCalled: func() (*data.SovereignChainParameters, error) {
+				return expectedParameters, nil
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/chain-parameters", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &sovereignChainParametersResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedParameters, response.Data.Parameters)
+	})
+}
+
+func TestSovereignGroup_getNotarizedMainChainHeaders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignGroup, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		for _, query := range []string{"", "?from=1", "?to=2", "?from=a&to=2", "?from=1&to=-2"} {
+			req, _ := http.NewRequest("GET", "/sovereign/notarized-main-chain-headers"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetSovereignNotarizedMainChainHeadersCalled: func(from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+				return nil, expectedErr
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/notarized-main-chain-headers?from=1&to=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHeaders := &data.NotarizedMainChainHeaders{
+			From: 1,
+			To:   2,
+			Headers: []*data.NotarizedMainChainHeader{
+				{Hash: "mainHash", Nonce: 101, Round: 201, SovereignBlockNonce: 2, SovereignBlockHash: "sovereignHash"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetSovereignNotarizedMainChainHeadersCalled: func(from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+				assert.Equal(t, uint64(1), from)
+				assert.Equal(t, uint64(2), to)
+				return expectedHeaders, nil
+			},
+		}
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(sovereignGroup, sovereignPath)
+
+		req, _ := http.NewRequest("GET", "/sovereign/notarized-main-chain-headers?from=1&to=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &notarizedMainChainHeadersResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedHeaders, response.Data)
+	})
+}
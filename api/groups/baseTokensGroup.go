@@ -0,0 +1,88 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type tokensGroup struct {
+	facade TokensFacadeHandler
+	*baseGroup
+}
+
+// NewTokensGroup returns a new instance of tokensGroup
+func NewTokensGroup(facadeHandler data.FacadeHandler) (*tokensGroup, error) {
+	facade, ok := facadeHandler.(TokensFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	tg := &tokensGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:token/price", Handler: tg.getTokenPrice, Method: http.MethodGet},
+		{Path: "/:token/holders", Handler: tg.getTokenHolders, Method: http.MethodGet},
+	}
+	tg.baseGroup.endpoints = baseRoutesHandlers
+
+	return tg, nil
+}
+
+// getTokenPrice returns the USD price of a token, as served by the configured price provider
+func (group *tokensGroup) getTokenPrice(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, apiErrors.ErrGetTokenPrice, apiErrors.ErrEmptyTokenIdentifier)
+		return
+	}
+
+	tokenPrice, err := group.facade.GetTokenPrice(token)
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrGetTokenPrice, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"price": tokenPrice}, "", data.ReturnCodeSuccess)
+}
+
+// getTokenHolders returns a page of the addresses holding a token, as indexed by the external storage
+func (group *tokensGroup) getTokenHolders(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, apiErrors.ErrGetTokenHolders, apiErrors.ErrEmptyTokenIdentifier)
+		return
+	}
+
+	options, err := parseTokenHoldersQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	holders, err := group.facade.GetTokenHolders(token, options)
+	if errors.Is(err, data.ErrNoExternalStorage) {
+		shared.RespondWith(
+			c,
+			http.StatusNotImplemented,
+			nil,
+			fmt.Sprintf("%s: %s", apiErrors.ErrGetTokenHolders.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrGetTokenHolders, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"holders": holders}, "", data.ReturnCodeSuccess)
+}
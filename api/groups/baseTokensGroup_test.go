@@ -0,0 +1,190 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokensPath = "/tokens"
+
+func TestNewTokensGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewTokensGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestTokensGroup_GetTokenPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error when facade returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("token price provider is disabled")
+		facade := &mock.FacadeStub{
+			GetTokenPriceCalled: func(_ string) (*data.TokenPrice, error) {
+				return nil, expectedErr
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/price", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return successfully", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPrice := &data.TokenPrice{
+			Token:     "TKN-abcdef",
+			Price:     1.25,
+			Source:    "price-api",
+			Timestamp: 1700000000,
+		}
+		facade := &mock.FacadeStub{
+			GetTokenPriceCalled: func(token string) (*data.TokenPrice, error) {
+				assert.Equal(t, "TKN-abcdef", token)
+				return expectedPrice, nil
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/price", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type tokenPriceResponse struct {
+			Data struct {
+				Price *data.TokenPrice `json:"price"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := &tokenPriceResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPrice, response.Data.Price)
+		assert.Empty(t, response.Error)
+	})
+}
+
+func TestTokensGroup_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	type tokenHoldersResponse struct {
+		Data struct {
+			Holders *data.TokenHoldersPage `json:"holders"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokensGroup, err := groups.NewTokensGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/holders?size=ten", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("no external storage should respond with not implemented", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTokenHoldersCalled: func(_ string, _ common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+				return nil, data.ErrNoExternalStorage
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/holders", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+		assert.True(t, strings.Contains(response.Error, data.ErrNoExternalStorage.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("cannot query database")
+		facade := &mock.FacadeStub{
+			GetTokenHoldersCalled: func(_ string, _ common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+				return nil, expectedErr
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/holders", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should return the holders page", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPage := &data.TokenHoldersPage{
+			Token: "TKN-abcdef",
+			Holders: []*data.TokenHolder{
+				{Address: "erd1alice", Balance: "1000"},
+				{Address: "erd1bob", Balance: "10"},
+			},
+			NextCursor: "next",
+		}
+		facade := &mock.FacadeStub{
+			GetTokenHoldersCalled: func(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+				assert.Equal(t, "TKN-abcdef", token)
+				assert.Equal(t, common.TokenHoldersQueryOptions{Size: 2, Cursor: "previous"}, options)
+				return expectedPage, nil
+			},
+		}
+		tokensGroup, err := groups.NewTokensGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(tokensGroup, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-abcdef/holders?size=2&cursor=previous", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPage, response.Data.Holders)
+	})
+}
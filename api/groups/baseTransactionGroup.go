@@ -1,17 +1,34 @@
 package groups
 
 import (
+	"encoding/json"
+	stdErrors "errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const (
+	// maxSendersInPoolRequest limits the number of senders whose transactions from pool are fetched by a single request
+	maxSendersInPoolRequest = 100
+
+	// defaultTxPoolChunkSize is the number of transactions of a pool chunk, if not specified by the request
+	defaultTxPoolChunkSize = 1000
+	// maxTxPoolChunkSize limits the number of transactions of a pool chunk, so that a chunk cannot time out as the entire
+	// pool would
+	maxTxPoolChunkSize = 10000
+
+	mimeNDJSON = "application/x-ndjson"
+)
+
 type transactionGroup struct {
 	facade TransactionFacadeHandler
 	*baseGroup
@@ -32,13 +49,23 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
+		{Path: "/validate", Handler: tg.validateTransaction, Method: http.MethodPost},
+		{Path: "/fee", Handler: tg.computeTransactionFee, Method: http.MethodPost},
+		{Path: "/build/esdt-transfer", Handler: tg.buildESDTTransfer, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
+		{Path: "/compute-contract-address", Handler: tg.computeContractAddress, Method: http.MethodPost},
+		{Path: "/decode-data", Handler: tg.decodeDataField, Method: http.MethodPost},
+		{Path: "/check-receiver", Handler: tg.checkTransferReceiver, Method: http.MethodPost},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
+		{Path: "/:txhash/parsed-outcome", Handler: tg.getTransactionOutcome, Method: http.MethodGet},
+		{Path: "/:txhash/full-journey", Handler: tg.getTransactionJourney, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
 		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet},
+		{Path: "/pool/by-senders", Handler: tg.getTransactionsPoolBySenders, Method: http.MethodPost},
+		{Path: "/pool/aged", Handler: tg.getAgedTransactionsPool, Method: http.MethodGet},
 	}
 	tg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -47,30 +74,32 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (group *transactionGroup) sendTransaction(c *gin.Context) {
+	if group.respondIfReadOnlyMode(c) {
+		return
+	}
+
 	var tx = data.Transaction{}
-	err := c.ShouldBindJSON(&tx)
+	err := shared.BindJSONBody(c, &tx)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusBadRequest,
-			nil,
-			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
-			data.ReturnCodeRequestError,
-		)
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
 		return
 	}
 
-	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	statusCode, sentTx, err := group.facade.SendTransaction(c.Request.Context(), &tx)
 	if err != nil {
-		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithTransactionError(c, statusCode, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, sentTx, "", data.ReturnCodeSuccess)
 }
 
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
 func (group *transactionGroup) sendUserFunds(c *gin.Context) {
+	if group.respondIfReadOnlyMode(c) {
+		return
+	}
+
 	if !group.facade.IsFaucetEnabled() {
 		shared.RespondWith(
 			c,
@@ -83,23 +112,39 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 	}
 
 	var gtx = data.FundsRequest{}
-	err := c.ShouldBindJSON(&gtx)
+	err := shared.BindJSONBody(c, &gtx)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	if group.facade.IsFaucetQueueEnabled() {
+		group.enqueueUserFunds(c, &gtx)
+		return
+	}
+
+	err = group.facade.SendUserFunds(c.Request.Context(), gtx.Receiver, gtx.Value)
 	if err != nil {
 		shared.RespondWith(
 			c,
-			http.StatusBadRequest,
+			http.StatusInternalServerError,
 			nil,
-			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
 			data.ReturnCodeRequestError,
 		)
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok"}, "", data.ReturnCodeSuccess)
+}
+
+// enqueueUserFunds will queue the faucet request and respond with its id, the request being sent asynchronously
+func (group *transactionGroup) enqueueUserFunds(c *gin.Context, gtx *data.FundsRequest) {
+	request, err := group.facade.EnqueueUserFunds(gtx.Receiver, gtx.Value)
 	if err != nil {
 		shared.RespondWith(
 			c,
-			http.StatusInternalServerError,
+			http.StatusBadRequest,
 			nil,
 			fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
 			data.ReturnCodeRequestError,
@@ -107,25 +152,38 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok"}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusAccepted, gin.H{"request": request}, "", data.ReturnCodeSuccess)
 }
 
 // sendMultipleTransactions will send multiple transactions at once
 func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
+	if group.respondIfReadOnlyMode(c) {
+		return
+	}
+
 	var txs []*data.Transaction
-	err := c.ShouldBindJSON(&txs)
+	err := shared.BindJSONBody(c, &txs)
 	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := group.facade.SendMultipleTransactions(c.Request.Context(), txs)
+	if err != nil && len(response.Results) > 0 {
+		// none of the transactions was valid, the result of each one holding the reason
 		shared.RespondWith(
 			c,
 			http.StatusBadRequest,
-			nil,
-			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			gin.H{
+				"numOfSentTxs": response.NumOfTxs,
+				"txsHashes":    response.TxsHashes,
+				"results":      response.Results,
+			},
+			fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
 			data.ReturnCodeRequestError,
 		)
 		return
 	}
-
-	response, err := group.facade.SendMultipleTransactions(txs)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -143,6 +201,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		gin.H{
 			"numOfSentTxs": response.NumOfTxs,
 			"txsHashes":    response.TxsHashes,
+			"results":      response.Results,
 		},
 		"",
 		data.ReturnCodeSuccess,
@@ -152,15 +211,9 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 // simulateTransaction will receive a transaction from the client and will send it for simulation purpose
 func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 	var tx = data.Transaction{}
-	err := c.ShouldBindJSON(&tx)
+	err := shared.BindJSONBody(c, &tx)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusBadRequest,
-			nil,
-			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
-			data.ReturnCodeRequestError,
-		)
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
 		return
 	}
 
@@ -170,47 +223,207 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	simulationResponse, err := group.facade.SimulateTransaction(&tx, options.CheckSignature)
+	simulationResponse, err := group.facade.SimulateTransaction(c.Request.Context(), &tx, options.CheckSignature)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithTransactionError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	c.JSON(
+	shared.RespondWithJSON(
+		c,
 		http.StatusOK,
 		simulationResponse,
 	)
 }
 
+// validateTransaction will statically validate a transaction, without sending it, and return all the problems found
+func (group *transactionGroup) validateTransaction(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := shared.BindJSONBody(c, &tx)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	validationResult, err := group.facade.ValidateTransaction(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"validation": validationResult}, "", data.ReturnCodeSuccess)
+}
+
+// computeTransactionFee will compute the fee of a transaction locally, without calling the observers
+func (group *transactionGroup) computeTransactionFee(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := shared.BindJSONBody(c, &tx)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	fee, err := group.facade.ComputeTransactionFee(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"fee": fee}, "", data.ReturnCodeSuccess)
+}
+
+// buildESDTTransfer will build the unsigned transaction which transfers the requested tokens, encoding the data field
+// of the MultiESDTNFTTransfer built-in function and estimating its gas limit
+func (group *transactionGroup) buildESDTTransfer(c *gin.Context) {
+	var request = data.ESDTTransferBuildRequest{}
+	err := shared.BindJSONBody(c, &request)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if request.Sender == "" {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidSenderAddress.Error())
+		return
+	}
+	if request.Receiver == "" {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidReceiverAddress.Error())
+		return
+	}
+
+	result, err := group.facade.BuildESDTTransfer(c.Request.Context(), &request)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"esdtTransfer": result}, "", data.ReturnCodeSuccess)
+}
+
 // requestTransactionCost will return an estimation of how many gas unit a transaction will cost
 func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	var tx = data.Transaction{}
-	err := c.ShouldBindJSON(&tx)
+	err := shared.BindJSONBody(c, &tx)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusBadRequest,
-			nil,
-			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
-			data.ReturnCodeInternalError,
-		)
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
 		return
 	}
 
-	cost, err := group.facade.TransactionCostRequest(&tx)
+	cost, err := group.facade.TransactionCostRequest(c.Request.Context(), &tx)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithTransactionError(c, http.StatusInternalServerError, err)
 		return
 	}
 
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
+// respondIfReadOnlyMode answers with 405 the transactions sending requests received while the proxy runs in read-only
+// mode and returns true if it did so
+func (group *transactionGroup) respondIfReadOnlyMode(c *gin.Context) bool {
+	if !group.facade.IsReadOnlyModeEnabled() {
+		return false
+	}
+
+	shared.RespondWith(c, http.StatusMethodNotAllowed, nil, errors.ErrReadOnlyMode.Error(), data.ReturnCodeRequestError)
+	return true
+}
+
+// respondWithTransactionError answers the transactions with invalid fields, including the ones breaking the operator's
+// transactions policy, with 400 and the message and reason of the rejection as data, so that the clients can tell which
+// rule was broken
+func respondWithTransactionError(c *gin.Context, statusCode int, err error) {
+	invalidTxFields := &errors.ErrInvalidTxFields{}
+	if stdErrors.As(err, &invalidTxFields) {
+		shared.RespondWith(c, http.StatusBadRequest, invalidTxFields, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+}
+
+// computeContractAddress will return the address of the contract to be deployed by the given deployer with the given nonce
+func (group *transactionGroup) computeContractAddress(c *gin.Context) {
+	var request = data.ContractAddressRequest{}
+	err := shared.BindJSONBody(c, &request)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if request.Deployer == "" {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidSenderAddress.Error())
+		return
+	}
+
+	contractAddress, err := group.facade.ComputeContractAddress(request.Deployer, request.Nonce)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": contractAddress}, "", data.ReturnCodeSuccess)
+}
+
+// decodeDataField will return the function called by the given transaction data field and its decoded arguments
+func (group *transactionGroup) decodeDataField(c *gin.Context) {
+	var request = data.DecodeDataFieldRequest{}
+	err := shared.BindJSONBody(c, &request)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if request.Data == "" {
+		shared.RespondWithBadRequest(c, errors.ErrEmptyDataField.Error())
+		return
+	}
+
+	decodedDataField, err := group.facade.DecodeDataField(request.Data)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"decoded": decodedDataField}, "", data.ReturnCodeSuccess)
+}
+
+// checkTransferReceiver will return whether the EGLD or the tokens transferred by the transaction would be rejected by
+// the receiver, because it is a contract which is not payable
+func (group *transactionGroup) checkTransferReceiver(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := shared.BindJSONBody(c, &tx)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if tx.Receiver == "" {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidReceiverAddress.Error())
+		return
+	}
+
+	receiverCheck, err := group.facade.CheckTransferReceiver(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"receiverCheck": receiverCheck}, "", data.ReturnCodeSuccess)
+}
+
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
 	sender := c.Request.URL.Query().Get("sender")
-	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
+	onlyFinal, err := parseBoolUrlParam(c, common.UrlParameterOnlyFinal)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	var txStatus string
+	if onlyFinal {
+		txStatus, err = group.facade.GetFinalTransactionStatus(c.Request.Context(), txHash, sender)
+	} else {
+		txStatus, err = group.facade.GetTransactionStatus(c.Request.Context(), txHash, sender)
+	}
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -235,17 +448,17 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 
 	sndAddr := c.Request.URL.Query().Get("sender")
 	if sndAddr != "" {
-		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options.WithResults)
+		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options)
 		return
 	}
 
-	tx, err := group.facade.GetTransaction(txHash, options.WithResults)
+	tx, err := group.facade.GetTransaction(c.Request.Context(), txHash, options.WithResults)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, transactionResponse(c, group.facade, tx, options.WithHashVerification), "", data.ReturnCodeSuccess)
 }
 
 func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
@@ -255,7 +468,7 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := group.facade.GetProcessedTransactionStatus(txHash)
+	status, err := group.facade.GetProcessedTransactionStatus(c.Request.Context(), txHash)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -264,8 +477,47 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"status": status.Status, "reason": status.Reason}, "", data.ReturnCodeSuccess)
 }
 
-func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+// getTransactionOutcome will return the return code, return message and return data of a smart contract call
+func (group *transactionGroup) getTransactionOutcome(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	outcome, err := group.facade.GetTransactionOutcome(c.Request.Context(), txHash)
+	if stdErrors.Is(err, data.ErrTransactionOutcomeNotFound) {
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"outcome": outcome}, "", data.ReturnCodeSuccess)
+}
+
+// getTransactionJourney will return the transaction fetched from both its source and destination shards, along with
+// the timeline of the blocks which included it and its smart contract results
+func (group *transactionGroup) getTransactionJourney(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	journey, err := group.facade.GetTransactionJourney(c.Request.Context(), txHash)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"journey": journey}, "", data.ReturnCodeSuccess)
+}
+
+func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, options common.TransactionQueryOptions) {
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(c.Request.Context(), txHash, sndAddr, options.WithResults)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -275,7 +527,31 @@ func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHa
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, transactionResponse(c, ef, tx, options.WithHashVerification), "", data.ReturnCodeSuccess)
+}
+
+// transactionResponse returns the response data of a fetched transaction, holding the decoded operation next to the
+// transaction if its data field calls a known built-in function, and the decoded events if it was fetched along with
+// its results and logged well-known events. If requested, it also flags whether the transaction hash matches the one
+// re-computed from its fields, for the transactions whose hash can be computed
+func transactionResponse(c *gin.Context, ef TransactionFacadeHandler, tx *transaction.ApiTransactionResult, withHashVerification bool) gin.H {
+	response := gin.H{"transaction": shared.SelectFields(c, tx)}
+	operation := ef.DecodeTransactionOperation(tx)
+	if operation != nil {
+		response["operation"] = operation
+	}
+	decodedEvents := ef.DecodeTransactionEvents(tx)
+	if len(decodedEvents) > 0 {
+		response["decodedEvents"] = decodedEvents
+	}
+	if withHashVerification {
+		isHashVerified, err := ef.VerifyTransactionHash(c.Request.Context(), tx)
+		if err == nil {
+			response["hashVerified"] = isHashVerified
+		}
+	}
+
+	return response
 }
 
 // getTransactionsPool should return transactions from pool
@@ -293,17 +569,30 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 	}
 
 	if options.Sender == "" {
-		if options.ShardID == "" {
-			getTxPool(c, group.facade, options.Fields)
-			return
+		shardID := core.OptionalUint32{}
+		if options.ShardID != "" {
+			parsedShardID, err := strconv.ParseUint(options.ShardID, 10, 32)
+			if err != nil {
+				shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrBadUrlParams.Error(), data.ReturnCodeRequestError)
+				return
+			}
+			shardID = core.OptionalUint32{Value: uint32(parsedShardID), HasValue: true}
 		}
 
-		shardID, err := strconv.ParseUint(options.ShardID, 10, 32)
-		if err != nil {
-			shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrBadUrlParams.Error(), data.ReturnCodeRequestError)
+		if options.Stream {
+			streamTxPool(c, group.facade, shardID, options.Fields, getTxPoolChunkSize(options))
+			return
+		}
+		if isTxPoolChunkRequested(options) {
+			getTxPoolChunk(c, group.facade, shardID.Value, options.Fields, options.From, getTxPoolChunkSize(options))
 			return
 		}
-		getTxPoolForShard(c, group.facade, uint32(shardID), options.Fields)
+		if !shardID.HasValue {
+			getTxPool(c, group.facade, options.Fields)
+			return
+		}
+
+		getTxPoolForShard(c, group.facade, shardID.Value, options.Fields)
 		return
 	}
 
@@ -317,10 +606,89 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	getTxPoolForSender(c, group.facade, options.Sender, options.Fields)
+	getTxPoolForSender(c, group.facade, options.Sender, options.Fields, options.AllShards)
+}
+
+// getAgedTransactionsPool should return the transactions pending in the pools of all shards for at least the number
+// of seconds given by the olderThan query parameter
+func (group *transactionGroup) getAgedTransactionsPool(c *gin.Context) {
+	olderThan, err := parseUint64UrlParam(c, common.UrlParameterOlderThan)
+	if err != nil || !olderThan.HasValue {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, fmt.Errorf("invalid %s parameter", common.UrlParameterOlderThan))
+		return
+	}
+
+	agedTxPool, err := group.facade.GetAgedTransactionsPool(c.Request.Context(), olderThan.Value)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": agedTxPool}, "", data.ReturnCodeSuccess)
+}
+
+// getTransactionsPoolBySenders should return the transactions from pool of each of the provided senders
+func (group *transactionGroup) getTransactionsPoolBySenders(c *gin.Context) {
+	var request = data.TransactionsPoolBySendersRequest{}
+	err := shared.BindJSONBody(c, &request)
+	if err != nil {
+		shared.RespondWithBodyValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	senders, err := getUniqueSenders(request.Senders)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if request.Fields != "" && request.Fields != "*" {
+		err = validateFields(request.Fields)
+		if err != nil {
+			shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+	}
+
+	txPools, err := group.facade.GetTransactionsPoolForSenders(c.Request.Context(), senders, request.Fields)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPools}, "", data.ReturnCodeSuccess)
+}
+
+func getUniqueSenders(senders []string) ([]string, error) {
+	uniqueSenders := make([]string, 0, len(senders))
+	seenSenders := make(map[string]struct{}, len(senders))
+	for _, sender := range senders {
+		if sender == "" {
+			return nil, errors.ErrInvalidSenderAddress
+		}
+		if _, seen := seenSenders[sender]; seen {
+			continue
+		}
+
+		seenSenders[sender] = struct{}{}
+		uniqueSenders = append(uniqueSenders, sender)
+	}
+
+	if len(uniqueSenders) == 0 {
+		return nil, errors.ErrEmptySendersList
+	}
+	if len(uniqueSenders) > maxSendersInPoolRequest {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", errors.ErrTooManySenders, len(uniqueSenders), maxSendersInPoolRequest)
+	}
+
+	return uniqueSenders, nil
 }
 
 func validateOptions(options common.TransactionsPoolOptions) error {
+	err := validateTxPoolChunkOptions(options)
+	if err != nil {
+		return err
+	}
+
 	if options.Fields != "" && options.LastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
 	}
@@ -337,6 +705,14 @@ func validateOptions(options common.TransactionsPoolOptions) error {
 		return errors.ErrEmptySenderToGetNonceGaps
 	}
 
+	if options.AllShards && options.Sender == "" {
+		return errors.ErrTxPoolAllShardsRequireSender
+	}
+
+	if options.AllShards && (options.LastNonce || options.NonceGaps) {
+		return errors.ErrTxPoolAllShardsNotAvailableForNonces
+	}
+
 	if options.Fields == "*" {
 		return nil
 	}
@@ -348,6 +724,33 @@ func validateOptions(options common.TransactionsPoolOptions) error {
 	return nil
 }
 
+func validateTxPoolChunkOptions(options common.TransactionsPoolOptions) error {
+	isChunkRequested := isTxPoolChunkRequested(options)
+	if options.Sender != "" && (options.Stream || isChunkRequested) {
+		return errors.ErrTxPoolChunksNotAvailableForSender
+	}
+	if !options.Stream && isChunkRequested && options.ShardID == "" {
+		return errors.ErrTxPoolChunksRequireShardID
+	}
+	if options.Size > maxTxPoolChunkSize {
+		return fmt.Errorf("%w: the maximum size is %d", errors.ErrInvalidTxPoolChunkSize, maxTxPoolChunkSize)
+	}
+
+	return nil
+}
+
+func isTxPoolChunkRequested(options common.TransactionsPoolOptions) bool {
+	return options.From > 0 || options.Size > 0
+}
+
+func getTxPoolChunkSize(options common.TransactionsPoolOptions) uint32 {
+	if options.Size == 0 {
+		return defaultTxPoolChunkSize
+	}
+
+	return options.Size
+}
+
 func validateFields(fields string) error {
 	for _, c := range fields {
 		if c == ',' {
@@ -365,7 +768,7 @@ func validateFields(fields string) error {
 }
 
 func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
-	txPool, err := ef.GetTransactionsPool(fields)
+	txPool, err := ef.GetTransactionsPool(c.Request.Context(), fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -375,7 +778,7 @@ func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
 }
 
 func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
-	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
+	txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -384,8 +787,63 @@ func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
 
+func getTxPoolChunk(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string, from uint32, size uint32) {
+	chunk, err := ef.GetTransactionsPoolChunk(c.Request.Context(), shardID, fields, from, size)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithJSON(
+		c,
+		http.StatusOK,
+		data.GenericAPIResponse{
+			Data: gin.H{"chunk": chunk},
+			Code: data.ReturnCodeSuccess,
+		},
+	)
+}
+
+// streamTxPool writes the pool as newline delimited JSON, one chunk per line, flushing each of them as soon as it is
+// fetched. An error occurring after the first chunk was written can only be reported on a last line
+func streamTxPool(c *gin.Context, ef TransactionFacadeHandler, shardID core.OptionalUint32, fields string, chunkSize uint32) {
+	isStreamStarted := false
+	err := ef.StreamTransactionsPool(c.Request.Context(), shardID, fields, chunkSize, func(chunk *data.TransactionsPoolChunk) error {
+		if !isStreamStarted {
+			c.Header("Content-Type", mimeNDJSON)
+			c.Header("X-Accel-Buffering", "no")
+			c.Status(http.StatusOK)
+			isStreamStarted = true
+		}
+
+		buff, err := json.Marshal(chunk)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.Writer.Write(append(buff, '\n'))
+		if err != nil {
+			return err
+		}
+		c.Writer.Flush()
+
+		return c.Request.Context().Err()
+	})
+	if err == nil {
+		return
+	}
+	if !isStreamStarted {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	buff, _ := json.Marshal(gin.H{"error": err.Error()})
+	_, _ = c.Writer.Write(append(buff, '\n'))
+	c.Writer.Flush()
+}
+
 func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	lastNonce, err := ef.GetLastPoolNonceForSender(sender)
+	lastNonce, err := ef.GetLastPoolNonceForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -395,7 +853,7 @@ func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(sender)
+	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -404,8 +862,8 @@ func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, se
 	shared.RespondWith(c, http.StatusOK, gin.H{"nonceGaps": nonceGaps}, "", data.ReturnCodeSuccess)
 }
 
-func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string) {
-	txPool, err := ef.GetTransactionsPoolForSender(sender, fields)
+func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string, allShards bool) {
+	txPool, err := ef.GetTransactionsPoolForSender(c.Request.Context(), sender, fields, allShards)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
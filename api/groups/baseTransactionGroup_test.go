@@ -2,13 +2,17 @@ package groups_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -30,7 +34,8 @@ type TxHashResponse struct {
 }
 
 type numOfSentTxsResponseData struct {
-	Num uint64 `json:"numOfSentTxs"`
+	Num     uint64                        `json:"numOfSentTxs"`
+	Results []*data.TransactionSendResult `json:"results"`
 }
 
 // MultiTxsResponse structure
@@ -48,6 +53,15 @@ type txPoolResp struct {
 	Data txPool
 }
 
+type agedTxPool struct {
+	TxPool data.AgedTransactionsPool `json:"txPool"`
+}
+
+type agedTxPoolResp struct {
+	GeneralResponse
+	Data agedTxPool
+}
+
 type txPoolForSender struct {
 	TxPool data.TransactionsPoolForSender `json:"txPool"`
 }
@@ -57,6 +71,13 @@ type txPoolForSenderResp struct {
 	Data txPoolForSender
 }
 
+type txPoolForSendersResp struct {
+	GeneralResponse
+	Data struct {
+		TxPool data.TransactionsPoolForSenders `json:"txPool"`
+	} `json:"data"`
+}
+
 type lastNonceResp struct {
 	GeneralResponse
 	Data data.TransactionsPoolLastNonceForSender
@@ -79,6 +100,23 @@ type txProcessedStatusResp struct {
 	} `json:"data"`
 }
 
+type txOutcomeResp struct {
+	GeneralResponse
+	Data struct {
+		Outcome data.TransactionOutcome `json:"outcome"`
+	} `json:"data"`
+}
+
+type txWithOperationResp struct {
+	GeneralResponse
+	Data struct {
+		Transaction   transaction.ApiTransactionResult `json:"transaction"`
+		Operation     *data.TransactionOperation       `json:"operation"`
+		DecodedEvents []*data.TransactionDecodedEvent  `json:"decodedEvents"`
+		HashVerified  *bool                            `json:"hashVerified"`
+	} `json:"data"`
+}
+
 func TestNewTransactionGroup_WrongFacadeShouldErr(t *testing.T) {
 	wrongFacade := &mock.WrongFacade{}
 	group, err := groups.NewTransactionGroup(wrongFacade)
@@ -119,6 +157,37 @@ func TestSendTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
 }
 
+func TestSendMultipleTransactions_InvalidFieldsShouldReturnTheFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			assert.Fail(t, "should not have sent the transactions")
+			return data.MultipleTransactionsResponseData{}, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `[{"nonce": 1, "value": "10", "signature": "aabb"}, {"nonce": 2, "value": "1.5", "signature": "aabb"}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		GeneralResponse
+		Data struct {
+			Fields []data.FieldValidationError `json:"fields"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, []data.FieldValidationError{{Field: "[1].value", Message: "must be a base-10 string"}}, response.Data.Fields)
+	assert.Equal(t, apiErrors.ErrValidation.Error()+": [1].value must be a base-10 string", response.Error)
+}
+
 func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	t.Parallel()
 	sender := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
@@ -129,8 +198,8 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	errorString := "send transaction error"
 
 	facade := &mock.FacadeStub{
-		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
-			return http.StatusInternalServerError, "", errors.New(errorString)
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return http.StatusInternalServerError, nil, errors.New(errorString)
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
@@ -157,6 +226,39 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	assert.Contains(t, response.Error, errorString)
 }
 
+func TestSendTransaction_InvalidTxFieldsShouldReturnTheStructuredReason(t *testing.T) {
+	t.Parallel()
+
+	invalidTxFields := &apiErrors.ErrInvalidTxFields{
+		Message: apiErrors.ErrGasLimitAboveMaximum.Error(),
+		Reason:  "gas limit 1001 is above the maximum of 1000",
+	}
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return http.StatusBadRequest, nil, invalidTxFields
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(`{"gasLimit": 1001}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data  *apiErrors.ErrInvalidTxFields `json:"data"`
+		Error string                        `json:"error"`
+		Code  string                        `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, invalidTxFields, response.Data)
+	assert.Equal(t, invalidTxFields.Error(), response.Error)
+	assert.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+}
+
 func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -169,8 +271,8 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	txHash := "tx hash"
 
 	facade := &mock.FacadeStub{
-		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
-			return 0, txHash, nil
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return 0, &data.SentTransaction{TxHash: txHash}, nil
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
@@ -199,6 +301,34 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, string(data.ReturnCodeSuccess), response.GeneralResponse.Code)
 }
 
+func TestSendTransaction_AlreadySubmittedShouldReturnTheOriginalHash(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return http.StatusOK, &data.SentTransaction{TxHash: "original hash", AlreadySubmitted: true}, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `{"nonce": 1, "sender": "erd1alice", "receiver": "erd1bob", "value": "10", "signature": "aabbccdd"}`
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		GeneralResponse
+		Data data.SentTransaction `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, data.SentTransaction{TxHash: "original hash", AlreadySubmitted: true}, response.Data)
+}
+
 func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -318,138 +448,442 @@ func TestSimulateTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, expectedResult.Data, response.Data)
 }
 
-func TestSendMultipleTransactions_WrongParametersShouldErrorOnValidation(t *testing.T) {
-	t.Parallel()
+type txValidationResp struct {
+	GeneralResponse
+	Data struct {
+		Validation data.TransactionValidationResult `json:"validation"`
+	} `json:"data"`
+}
 
-	sender := "addr1"
-	receiver := "addr2"
-	value := "ishouldbeint"
-	dataField := "data"
+func TestValidateTransaction(t *testing.T) {
+	t.Parallel()
 
-	facade := &mock.FacadeStub{}
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	jsonStr := fmt.Sprintf(
-		`[{"sender":"%s", "receiver":"%s", "value":%s, "data":"%s"}]`,
-		sender,
-		receiver,
-		value,
-		dataField,
-	)
-	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"nonce": "invalid"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
 
-	response := GeneralResponse{}
-	loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	assert.Equal(t, http.StatusBadRequest, resp.Code)
-	assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
-}
+		facade := &mock.FacadeStub{
+			ValidateTransactionCalled: func(tx *data.Transaction) (*data.TransactionValidationResult, error) {
+				return nil, errors.New("network config unavailable")
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
-	t.Parallel()
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"nonce": 1}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	nonce := uint64(1)
-	sender := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-	value := big.NewInt(10)
-	dataField := "data"
-	signature := "aabbccdd"
-	txHash := "tx hash"
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("should return the problems found", func(t *testing.T) {
+		t.Parallel()
 
-	facade := &mock.FacadeStub{
-		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
-			return 0, txHash, nil
-		},
-		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-			return data.MultipleTransactionsResponseData{
-				NumOfTxs:  10,
-				TxsHashes: nil,
-			}, nil
-		},
-	}
+		expectedResult := data.TransactionValidationResult{
+			Valid: false,
+			Problems: []data.TransactionValidationProblem{
+				{Field: "chainID", Message: "missing chain ID"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			ValidateTransactionCalled: func(tx *data.Transaction) (*data.TransactionValidationResult, error) {
+				require.Equal(t, uint64(7), tx.Nonce)
+				return &expectedResult, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		req, _ := http.NewRequest("POST", "/transaction/validate", bytes.NewBuffer([]byte(`{"nonce": 7}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	jsonStr := fmt.Sprintf(
-		`[{"nonce": %d, "sender": "%s", "receiver": "%s", "value": "%s", "signature": "%s", "data": "%s"	}]`,
-		nonce,
-		sender,
-		receiver,
-		value,
-		signature,
-		dataField,
-	)
-	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+		response := txValidationResp{}
+		loadResponse(resp.Body, &response)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResult, response.Data.Validation)
+	})
+}
 
-	response := MultiTxsResponse{}
-	loadResponse(resp.Body, &response)
+type txFeeResp struct {
+	GeneralResponse
+	Data struct {
+		Fee data.TransactionFee `json:"fee"`
+	} `json:"data"`
+}
 
-	assert.Equal(t, http.StatusOK, resp.Code)
-	assert.Empty(t, response.Error)
-	assert.Equal(t, uint64(10), response.Data.Num)
+type esdtTransferBuildResp struct {
+	GeneralResponse
+	Data struct {
+		ESDTTransfer data.ESDTTransferBuildResult `json:"esdtTransfer"`
+	} `json:"data"`
 }
 
-func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
+func TestBuildESDTTransfer(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-	errorString := "send user funds error"
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
 
-	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return errors.New(errorString)
-		},
-	}
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		req, _ := http.NewRequest("POST", "/transaction/build/esdt-transfer", bytes.NewBuffer([]byte(`{"nonce": "invalid"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s"}`, receiver)
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
 
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("missing addresses should error", func(t *testing.T) {
+		t.Parallel()
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	response := GeneralResponse{}
-	loadResponse(resp.Body, &response)
+		req, _ := http.NewRequest("POST", "/transaction/build/esdt-transfer", bytes.NewBuffer([]byte(`{"receiver": "erd1receiver"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	assert.Equal(t, http.StatusInternalServerError, resp.Code)
-	assert.Contains(t, response.Error, errorString)
-}
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidSenderAddress.Error(), response.Error)
 
-func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
-	t.Parallel()
+		req, _ = http.NewRequest("POST", "/transaction/build/esdt-transfer", bytes.NewBuffer([]byte(`{"sender": "erd1sender"}`)))
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+		response = GeneralResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidReceiverAddress.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return nil
-		},
-	}
+		facade := &mock.FacadeStub{
+			BuildESDTTransferCalled: func(request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error) {
+				return nil, errors.New("no ESDT transfers provided")
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		req, _ := http.NewRequest("POST", "/transaction/build/esdt-transfer", bytes.NewBuffer([]byte(`{"sender": "erd1sender", "receiver": "erd1receiver"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s"}`, receiver)
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
 
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "no ESDT transfers provided", response.Error)
+	})
+	t.Run("should return the built transaction", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResult := data.ESDTTransferBuildResult{
+			Transaction: &data.Transaction{
+				Sender:   "erd1sender",
+				Receiver: "erd1sender",
+				Value:    "0",
+				GasLimit: 1500000,
+				Data:     []byte("MultiESDTNFTTransfer@aa@01@544b4e2d313233343536@@0a"),
+			},
+			Data: "MultiESDTNFTTransfer@aa@01@544b4e2d313233343536@@0a",
+		}
+		facade := &mock.FacadeStub{
+			BuildESDTTransferCalled: func(request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error) {
+				require.Equal(t, []*data.ESDTTransfer{{Token: "TKN-123456", Amount: "10"}}, request.Transfers)
+				return &expectedResult, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := `{"sender": "erd1sender", "receiver": "erd1receiver", "transfers": [{"token": "TKN-123456", "amount": "10"}]}`
+		req, _ := http.NewRequest("POST", "/transaction/build/esdt-transfer", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtTransferBuildResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResult, response.Data.ESDTTransfer)
+	})
+}
+
+func TestComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(`{"gasLimit": "invalid"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeCalled: func(tx *data.Transaction) (*data.TransactionFee, error) {
+				return nil, errors.New("insufficient gas limit")
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(`{"gasLimit": 1}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "insufficient gas limit", response.Error)
+	})
+	t.Run("should return the fee", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFee := data.TransactionFee{
+			Fee:            "50000000000000",
+			FeeDenominated: "0.00005",
+			MoveBalanceGas: 50000,
+		}
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeCalled: func(tx *data.Transaction) (*data.TransactionFee, error) {
+				require.Equal(t, uint64(50000), tx.GasLimit)
+				return &expectedFee, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(`{"gasLimit": 50000}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txFeeResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedFee, response.Data.Fee)
+	})
+}
+
+func TestSendMultipleTransactions_WrongParametersShouldErrorOnValidation(t *testing.T) {
+	t.Parallel()
+
+	sender := "addr1"
+	receiver := "addr2"
+	value := "ishouldbeint"
+	dataField := "data"
+
+	facade := &mock.FacadeStub{}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`[{"sender":"%s", "receiver":"%s", "value":%s, "data":"%s"}]`,
+		sender,
+		receiver,
+		value,
+		dataField,
+	)
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+}
+
+func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(1)
+	sender := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	value := big.NewInt(10)
+	dataField := "data"
+	signature := "aabbccdd"
+	txHash := "tx hash"
+
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return 0, &data.SentTransaction{TxHash: txHash}, nil
+		},
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			return data.MultipleTransactionsResponseData{
+				NumOfTxs:  10,
+				TxsHashes: nil,
+				Results: []*data.TransactionSendResult{
+					{Index: 0, Hash: txHash},
+				},
+			}, nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`[{"nonce": %d, "sender": "%s", "receiver": "%s", "value": "%s", "signature": "%s", "data": "%s"	}]`,
+		nonce,
+		sender,
+		receiver,
+		value,
+		signature,
+		dataField,
+	)
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := MultiTxsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, uint64(10), response.Data.Num)
+	require.Equal(t, 1, len(response.Data.Results))
+	assert.Equal(t, txHash, response.Data.Results[0].Hash)
+}
+
+func TestSendMultipleTransactions_NoValidTransactionShouldReturnTheResults(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			return data.MultipleTransactionsResponseData{
+				TxsHashes: map[int]string{},
+				Results: []*data.TransactionSendResult{
+					{Index: 0, Error: "invalid sender address"},
+				},
+			}, errors.New("no valid transaction to send")
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `[{"nonce": 1, "sender": "invalid", "value": "10", "signature": "aabb"}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := MultiTxsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, "no valid transaction to send")
+	assert.Equal(t, uint64(0), response.Data.Num)
+	require.Equal(t, 1, len(response.Data.Results))
+	assert.Equal(t, "invalid sender address", response.Data.Results[0].Error)
+}
+
+func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	errorString := "send user funds error"
+
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			return errors.New(errorString)
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s"}`, receiver)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errorString)
+}
+
+func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			return nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s"}`, receiver)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
 
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
@@ -552,40 +986,160 @@ func TestSendUserFunds_FaucetNotEnabled(t *testing.T) {
 	assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
 }
 
-func TestGetTransactionsPool_InvalidOptions(t *testing.T) {
+func TestSendUserFunds_QueueEnabled(t *testing.T) {
 	t.Parallel()
 
-	t.Run("bad URL parameters - wrong format", testInvalidParameters("?last-nonce=true,fields=sender", apiErrors.ErrBadUrlParams))
-	t.Run("cannot have last nonce and sender", testInvalidParameters("?last-nonce=true&fields=sender", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
-	t.Run("cannot have nonce gaps and sender", testInvalidParameters("?nonce-gaps=true&fields=sender", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
-	t.Run("empty sender when fetching last nonce", testInvalidParameters("?last-nonce=true", apiErrors.ErrEmptySenderToGetLatestNonce))
-	t.Run("empty sender when fetching nonce gaps", testInvalidParameters("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
-	t.Run("invalid fields - numeric", testInvalidParameters("?fields=123", apiErrors.ErrInvalidFields))
-	t.Run("invalid characters on fields", testInvalidParameters("?fields=_/+", apiErrors.ErrInvalidFields))
-	t.Run("fields + wild card", testInvalidParameters("?fields=nonce,sender,*", apiErrors.ErrInvalidFields))
-}
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
 
-func testInvalidParameters(path string, expectedErr error) func(t *testing.T) {
-	return func(t *testing.T) {
+	t.Run("should queue the request", func(t *testing.T) {
 		t.Parallel()
 
-		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
-		require.NoError(t, err)
+		expectedRequest := &data.FaucetRequest{
+			ID:       "id",
+			Receiver: receiver,
+			Value:    "100",
+			Status:   "pending",
+		}
+		facade := &mock.FacadeStub{
+			IsFaucetQueueEnabledCalled: func() bool {
+				return true
+			},
+			SendUserFundsCalled: func(receiver string, value *big.Int) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+			EnqueueUserFundsCalled: func(rcv string, value *big.Int) (*data.FaucetRequest, error) {
+				require.Equal(t, receiver, rcv)
+				require.Equal(t, big.NewInt(100), value)
+				return expectedRequest, nil
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
 		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-		req, _ := http.NewRequest("GET", "/transaction/pool"+path, nil)
-
+		jsonStr := fmt.Sprintf(`{"receiver":"%s", "value": 100}`, receiver)
+		req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
 		resp := httptest.NewRecorder()
 		ws.ServeHTTP(resp, req)
 
-		response := GeneralResponse{}
+		response := struct {
+			Data struct {
+				Request *data.FaucetRequest `json:"request"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
 		loadResponse(resp.Body, &response)
 
-		assert.Equal(t, http.StatusBadRequest, resp.Code)
-		assert.Equal(t, response.Error, expectedErr.Error())
-	}
-}
-
+		require.Equal(t, http.StatusAccepted, resp.Code)
+		require.Empty(t, response.Error)
+		require.Equal(t, expectedRequest, response.Data.Request)
+	})
+	t.Run("enqueue error should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("faucet queue is full")
+		facade := &mock.FacadeStub{
+			IsFaucetQueueEnabledCalled: func() bool {
+				return true
+			},
+			EnqueueUserFundsCalled: func(rcv string, value *big.Int) (*data.FaucetRequest, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		jsonStr := fmt.Sprintf(`{"receiver":"%s"}`, receiver)
+		req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+}
+
+func TestSendTransactionsEndpoints_ReadOnlyModeShouldReturnMethodNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		IsReadOnlyModeEnabledCalled: func() bool {
+			return true
+		},
+		SendTransactionHandler: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			require.Fail(t, "should have not been called")
+			return 0, nil, nil
+		},
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			require.Fail(t, "should have not been called")
+			return data.MultipleTransactionsResponseData{}, nil
+		},
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	for _, path := range []string{"/transaction/send", "/transaction/send-multiple", "/transaction/send-user-funds"} {
+		req, _ := http.NewRequest("POST", path, bytes.NewBufferString("{}"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+		assert.Equal(t, apiErrors.ErrReadOnlyMode.Error(), response.Error)
+	}
+}
+
+func TestGetTransactionsPool_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bad URL parameters - wrong format", testInvalidParameters("?last-nonce=true,fields=sender", apiErrors.ErrBadUrlParams))
+	t.Run("cannot have last nonce and sender", testInvalidParameters("?last-nonce=true&fields=sender", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
+	t.Run("cannot have nonce gaps and sender", testInvalidParameters("?nonce-gaps=true&fields=sender", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
+	t.Run("empty sender when fetching last nonce", testInvalidParameters("?last-nonce=true", apiErrors.ErrEmptySenderToGetLatestNonce))
+	t.Run("empty sender when fetching nonce gaps", testInvalidParameters("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
+	t.Run("invalid fields - numeric", testInvalidParameters("?fields=123", apiErrors.ErrInvalidFields))
+	t.Run("invalid characters on fields", testInvalidParameters("?fields=_/+", apiErrors.ErrInvalidFields))
+	t.Run("fields + wild card", testInvalidParameters("?fields=nonce,sender,*", apiErrors.ErrInvalidFields))
+	t.Run("chunk without shard", testInvalidParameters("?from=10&size=10", apiErrors.ErrTxPoolChunksRequireShardID))
+	t.Run("chunk of a sender's pool", testInvalidParameters("?by-sender=sender&size=10", apiErrors.ErrTxPoolChunksNotAvailableForSender))
+	t.Run("stream of a sender's pool", testInvalidParameters("?by-sender=sender&stream=true", apiErrors.ErrTxPoolChunksNotAvailableForSender))
+	t.Run("all shards without sender", testInvalidParameters("?allShards=true", apiErrors.ErrTxPoolAllShardsRequireSender))
+	t.Run("last nonce from all shards", testInvalidParameters("?by-sender=sender&allShards=true&last-nonce=true", apiErrors.ErrTxPoolAllShardsNotAvailableForNonces))
+	t.Run("nonce gaps from all shards", testInvalidParameters("?by-sender=sender&allShards=true&nonce-gaps=true", apiErrors.ErrTxPoolAllShardsNotAvailableForNonces))
+	t.Run("chunk size too big", testInvalidParameters("?shard-id=0&size=10001", fmt.Errorf("%w: the maximum size is 10000", apiErrors.ErrInvalidTxPoolChunkSize)))
+}
+
+func testInvalidParameters(path string, expectedErr error) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool"+path, nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, response.Error, expectedErr.Error())
+	}
+}
+
 func TestGetTransactionsPool_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -656,6 +1210,131 @@ func TestGetTransactionsPoolForShard_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, providedTxPool, &response.Data.TxPool)
 }
 
+func TestGetTransactionsPoolChunk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("operation not allowed")
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolChunkHandler: func(shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool?shard-id=1&from=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedChunk := &data.TransactionsPoolChunk{
+			ShardID: 1,
+			From:    5,
+			TxPool: data.TransactionsPool{
+				RegularTransactions: []data.WrappedTransaction{{TxFields: map[string]interface{}{"hash": "hash"}}},
+			},
+			NextFrom: 6,
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolChunkHandler: func(shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, "hash", fields)
+				assert.Equal(t, uint32(5), from)
+				assert.Equal(t, uint32(1000), size)
+				return providedChunk, nil
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool?shard-id=1&from=5&fields=hash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Chunk *data.TransactionsPoolChunk `json:"chunk"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, providedChunk, response.Data.Chunk)
+	})
+}
+
+func TestStreamTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error before the first chunk should respond with error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("operation not allowed")
+		facade := &mock.FacadeStub{
+			StreamTransactionsPoolHandler: func(shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error {
+				return expectedErr
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool?stream=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should write a line for each chunk and for the error which stopped the stream", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamTransactionsPoolHandler: func(shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error {
+				assert.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, shardID)
+				assert.Equal(t, uint32(50), chunkSize)
+
+				_ = handler(&data.TransactionsPoolChunk{ShardID: 2, NextFrom: 50, HasMore: true})
+				_ = handler(&data.TransactionsPoolChunk{ShardID: 2, From: 50, NextFrom: 100, HasMore: true})
+				return errors.New("observer down")
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool?stream=true&shard-id=2&size=50", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+		require.Len(t, lines, 3)
+
+		chunk := &data.TransactionsPoolChunk{}
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), chunk))
+		assert.Equal(t, uint32(50), chunk.From)
+		assert.Equal(t, uint32(100), chunk.NextFrom)
+		assert.Equal(t, `{"error":"observer down"}`, lines[2])
+	})
+}
+
 func TestGetTransactionsPoolForSender_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -668,8 +1347,10 @@ func TestGetTransactionsPoolForSender_ReturnsSuccessfully(t *testing.T) {
 	providedTxPool := &data.TransactionsPoolForSender{
 		Transactions: []data.WrappedTransaction{providedTx},
 	}
+	var requestedAllShards []bool
 	facade := &mock.FacadeStub{
-		GetTransactionsPoolForSenderHandler: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+		GetTransactionsPoolForSenderHandler: func(sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
+			requestedAllShards = append(requestedAllShards, allShards)
 			return providedTxPool, nil
 		},
 	}
@@ -678,17 +1359,174 @@ func TestGetTransactionsPoolForSender_ReturnsSuccessfully(t *testing.T) {
 	require.NoError(t, err)
 	ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	req, _ := http.NewRequest("GET", "/transaction/pool?by-sender=dummy", nil)
+	for _, path := range []string{"/transaction/pool?by-sender=dummy", "/transaction/pool?by-sender=dummy&allShards=true"} {
+		req, _ := http.NewRequest("GET", path, nil)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	response := txPoolForSenderResp{}
-	loadResponse(resp.Body, &response)
+		response := txPoolForSenderResp{}
+		loadResponse(resp.Body, &response)
 
-	assert.Equal(t, http.StatusOK, resp.Code)
-	assert.Equal(t, response.Error, "")
-	assert.Equal(t, providedTxPool, &response.Data.TxPool)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, response.Error, "")
+		assert.Equal(t, providedTxPool, &response.Data.TxPool)
+	}
+	assert.Equal(t, []bool{false, true}, requestedAllShards)
+}
+
+func TestGetAgedTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing or invalid olderThan should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		for _, path := range []string{"/transaction/pool/aged", "/transaction/pool/aged?olderThan=-1"} {
+			req, _ := http.NewRequest("GET", path, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := agedTxPoolResp{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), path)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAgedTransactionsPoolHandler: func(olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+				return nil, apiErrors.ErrOperationNotAllowed
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/aged?olderThan=60", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := agedTxPoolResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedAgedPool := &data.AgedTransactionsPool{
+			OlderThanSeconds: 60,
+			Transactions: []*data.AgedPoolTransaction{
+				{Hash: "hash", Sender: "sender", Nonce: 7, Type: "regular", Shard: 1, ReceivedAt: 1700000000, AgeSeconds: 90},
+			},
+			TransactionsWithoutAge: 2,
+			UnavailableShards:      []uint32{0},
+		}
+		facade := &mock.FacadeStub{
+			GetAgedTransactionsPoolHandler: func(olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+				assert.Equal(t, uint64(60), olderThanSeconds)
+				return providedAgedPool, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/aged?olderThan=60", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := agedTxPoolResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, providedAgedPool, &response.Data.TxPool)
+	})
+}
+
+func TestGetTransactionsPoolBySenders(t *testing.T) {
+	t.Parallel()
+
+	postRequest := func(facade *mock.FacadeStub, body string) *httptest.ResponseRecorder {
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/pool/by-senders", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		tooManySenders := make([]string, 0, 101)
+		for i := 0; i < 101; i++ {
+			tooManySenders = append(tooManySenders, fmt.Sprintf("%q", fmt.Sprintf("sender%d", i)))
+		}
+
+		invalidBodies := map[string]string{
+			`{"senders": "sender"}`:       apiErrors.ErrValidation.Error(),
+			`{"senders": []}`:             apiErrors.ErrEmptySendersList.Error(),
+			`{"senders": ["sender", ""]}`: apiErrors.ErrInvalidSenderAddress.Error(),
+			`{"senders": [` + strings.Join(tooManySenders, ",") + `]}`: apiErrors.ErrTooManySenders.Error(),
+			`{"senders": ["sender"], "fields": "nonce,*"}`:             apiErrors.ErrInvalidFields.Error(),
+		}
+		for body, expectedError := range invalidBodies {
+			resp := postRequest(&mock.FacadeStub{}, body)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, response.Error, expectedError)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSendersHandler: func(senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+				return nil, errors.New("invalid sender address")
+			},
+		}
+		resp := postRequest(facade, `{"senders": ["sender"]}`)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "invalid sender address", response.Error)
+	})
+	t.Run("should return the pool of each sender", func(t *testing.T) {
+		t.Parallel()
+
+		providedTxPools := &data.TransactionsPoolForSenders{
+			Senders: map[string]*data.TransactionsPoolForSender{
+				"sender1": {Transactions: []data.WrappedTransaction{{TxFields: map[string]interface{}{"nonce": float64(1)}}}},
+				"sender2": {Transactions: []data.WrappedTransaction{}},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSendersHandler: func(senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+				assert.Equal(t, []string{"sender1", "sender2"}, senders)
+				assert.Equal(t, "nonce", fields)
+				return providedTxPools, nil
+			},
+		}
+		resp := postRequest(facade, `{"senders": ["sender1", "sender2", "sender1"], "fields": "nonce"}`)
+
+		response := txPoolForSendersResp{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedTxPools, &response.Data.TxPool)
+	})
 }
 
 func TestLastPoolNonceForSender_ReturnsSuccessfully(t *testing.T) {
@@ -829,3 +1667,687 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Equal(t, status.Reason, response.Data.Reason)
 	})
 }
+
+func TestTransactionGroup_getTransactionStatusOnlyFinal(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	sender := "sender"
+	facade := &mock.FacadeStub{
+		GetTransactionStatusHandler: func(txHash string, sender string) (string, error) {
+			return "success", nil
+		},
+		GetFinalTransactionStatusHandler: func(txHash string, snd string) (string, error) {
+			assert.Equal(t, hash, txHash)
+			assert.Equal(t, sender, snd)
+			return "pending", nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	t.Run("invalid onlyFinal parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/status?onlyFinal=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("without onlyFinal should report the current status", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/status?sender="+sender, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txProcessedStatusResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "success", response.Data.Status)
+	})
+	t.Run("with onlyFinal should report the final status", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/status?sender="+sender+"&onlyFinal=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txProcessedStatusResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "pending", response.Data.Status)
+	})
+}
+
+func TestTransactionGroup_getTransactionOutcome(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	hash := "hash"
+	t.Run("no tx hash provided, should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction//parsed-outcome", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionHashMissing.Error(), response.Error)
+	})
+	t.Run("GetTransactionOutcome errors, should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionOutcomeHandler: func(txHash string) (*data.TransactionOutcome, error) {
+				assert.Equal(t, hash, txHash)
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/parsed-outcome", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("outcome not found should return 404", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionOutcomeHandler: func(txHash string) (*data.TransactionOutcome, error) {
+				return nil, data.ErrTransactionOutcomeNotFound
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/parsed-outcome", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, data.ErrTransactionOutcomeNotFound.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		outcome := &data.TransactionOutcome{
+			ReturnCode:    "ok",
+			ReturnMessage: "message",
+			ReturnData:    [][]byte{[]byte("value")},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionOutcomeHandler: func(txHash string) (*data.TransactionOutcome, error) {
+				assert.Equal(t, hash, txHash)
+				return outcome, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/parsed-outcome", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txOutcomeResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *outcome, response.Data.Outcome)
+	})
+}
+
+func TestTransactionGroup_getTransactionJourney(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	t.Run("GetTransactionJourney errors, should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("transaction not found")
+		facade := &mock.FacadeStub{
+			GetTransactionJourneyHandler: func(txHash string) (*data.TransactionJourney, error) {
+				assert.Equal(t, hash, txHash)
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/full-journey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		journey := &data.TransactionJourney{
+			Transaction:  &transaction.ApiTransactionResult{Hash: hash, SourceShard: 0, DestinationShard: 1},
+			IsCrossShard: true,
+			Timeline: []*data.TransactionJourneyStep{
+				{Stage: "source", Hash: hash, ShardID: 0, BlockNonce: 10, BlockHash: "blockHash0"},
+				{Stage: "destination", Hash: hash, ShardID: 1, BlockNonce: 12, BlockHash: "blockHash1"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionJourneyHandler: func(txHash string) (*data.TransactionJourney, error) {
+				assert.Equal(t, hash, txHash)
+				return journey, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/full-journey", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Journey *data.TransactionJourney `json:"journey"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, journey, response.Data.Journey)
+	})
+}
+
+func TestTransactionGroup_computeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	deployer := "erd1j0hxzs7dcyxw08c4k2nv9tfcaxmqy8rj59meq505w92064x0h40qcxh3ap"
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/compute-contract-address", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("missing deployer should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/compute-contract-address", bytes.NewBufferString(`{"nonce":1}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidSenderAddress.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ComputeContractAddressHandler: func(deployerAddress string, nonce uint64) (*data.ContractAddress, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/compute-contract-address", bytes.NewBufferString(`{"deployer":"invalid","nonce":1}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedContract := &data.ContractAddress{
+			Address: "erd1qqqqqqqqqqqqqpgqde8eqjywyu6zlxjxuxqfg5kgtmn3setxh40qen8egy",
+			ShardID: 1,
+		}
+		facade := &mock.FacadeStub{
+			ComputeContractAddressHandler: func(deployerAddress string, nonce uint64) (*data.ContractAddress, error) {
+				assert.Equal(t, deployer, deployerAddress)
+				assert.Equal(t, uint64(1), nonce)
+				return expectedContract, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := fmt.Sprintf(`{"deployer":"%s","nonce":1}`, deployer)
+		req, _ := http.NewRequest("POST", "/transaction/compute-contract-address", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type contractAddressResponse struct {
+			Data struct {
+				Contract *data.ContractAddress `json:"contract"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := contractAddressResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedContract, response.Data.Contract)
+	})
+}
+
+func TestTransactionGroup_decodeDataField(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/decode-data", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("missing data should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/decode-data", bytes.NewBufferString(`{}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrEmptyDataField.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			DecodeDataFieldHandler: func(dataField string) (*data.DecodedDataField, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/decode-data", bytes.NewBufferString(`{"data":"claim@zz"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDecoded := &data.DecodedDataField{
+			Function: "claim",
+			Arguments: []*data.DecodedDataArgument{
+				{Hex: "0a", Decimal: "10"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			DecodeDataFieldHandler: func(dataField string) (*data.DecodedDataField, error) {
+				assert.Equal(t, "claim@0a", dataField)
+				return expectedDecoded, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/decode-data", bytes.NewBufferString(`{"data":"claim@0a"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type decodedDataFieldResponse struct {
+			Data struct {
+				Decoded *data.DecodedDataField `json:"decoded"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := decodedDataFieldResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedDecoded, response.Data.Decoded)
+	})
+}
+
+func TestTransactionGroup_checkTransferReceiver(t *testing.T) {
+	t.Parallel()
+
+	contract := "erd1qqqqqqqqqqqqqpgqde8eqjywyu6zlxjxuxqfg5kgtmn3setxh40qen8egy"
+	t.Run("missing receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/check-receiver", bytes.NewBufferString(`{"value":"1"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidReceiverAddress.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			CheckTransferReceiverHandler: func(tx *data.Transaction) (*data.ReceiverCheck, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/check-receiver", bytes.NewBufferString(`{"receiver":"invalid","value":"1"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCheck := &data.ReceiverCheck{
+			Receiver:       contract,
+			IsContract:     true,
+			IsDeployed:     true,
+			TransfersEGLD:  true,
+			WillBeRejected: true,
+			Reason:         "the contract is not payable, so the direct transfers to it are rejected",
+		}
+		facade := &mock.FacadeStub{
+			CheckTransferReceiverHandler: func(tx *data.Transaction) (*data.ReceiverCheck, error) {
+				assert.Equal(t, contract, tx.Receiver)
+				assert.Equal(t, "1000", tx.Value)
+				return expectedCheck, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		body := fmt.Sprintf(`{"receiver":"%s","value":"1000"}`, contract)
+		req, _ := http.NewRequest("POST", "/transaction/check-receiver", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				ReceiverCheck *data.ReceiverCheck `json:"receiverCheck"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedCheck, response.Data.ReceiverCheck)
+	})
+}
+
+func TestTransactionGroup_getTransactionShouldReturnTheDecodedOperation(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	tx := &transaction.ApiTransactionResult{Hash: hash, Data: []byte("ESDTTransfer@54455354@0a")}
+	operation := &data.TransactionOperation{
+		Function: "ESDTTransfer",
+		Tokens:   []*data.TransactionOperationToken{{Identifier: "TEST", Amount: "10"}},
+	}
+
+	t.Run("known built-in function should add the operation", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return tx, nil
+			},
+			DecodeTransactionOperationCalled: func(apiTx *transaction.ApiTransactionResult) *data.TransactionOperation {
+				assert.Equal(t, tx, apiTx)
+				return operation
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txWithOperationResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, hash, response.Data.Transaction.Hash)
+		assert.Equal(t, operation, response.Data.Operation)
+	})
+	t.Run("unknown data field should not add the operation", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionByHashAndSenderAddressHandler: func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+				return tx, http.StatusOK, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?sender=erd1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.NotContains(t, resp.Body.String(), `"operation":{`)
+	})
+}
+
+func TestTransactionGroup_getTransactionWithResultsShouldReturnTheDecodedEvents(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	tx := &transaction.ApiTransactionResult{Hash: hash}
+	decodedEvents := []*data.TransactionDecodedEvent{
+		{Identifier: "signalError", Address: "erd1contract", Sender: "erd1sender", Message: "insufficient funds"},
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.True(t, withResults)
+			return tx, nil
+		},
+		DecodeTransactionEventsCalled: func(apiTx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent {
+			assert.Equal(t, tx, apiTx)
+			return decodedEvents
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/"+hash+"?withResults=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txWithOperationResp{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, decodedEvents, response.Data.DecodedEvents)
+	assert.Nil(t, response.Data.Operation)
+}
+
+func TestTransactionGroup_getTransactionWithHashVerification(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	tx := &transaction.ApiTransactionResult{Hash: hash}
+	getResponse := func(verifyHashHandler func(tx *transaction.ApiTransactionResult) (bool, error), query string) txWithOperationResp {
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return tx, nil
+			},
+			GetTransactionByHashAndSenderAddressHandler: func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+				return tx, http.StatusOK, nil
+			},
+			VerifyTransactionHashCalled: verifyHashHandler,
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := txWithOperationResp{}
+		loadResponse(resp.Body, &response)
+
+		return response
+	}
+
+	t.Run("not requested should not verify the hash", func(t *testing.T) {
+		t.Parallel()
+
+		response := getResponse(func(tx *transaction.ApiTransactionResult) (bool, error) {
+			assert.Fail(t, "should not have been called")
+			return false, nil
+		}, "")
+		assert.Nil(t, response.Data.HashVerified)
+	})
+	t.Run("verified hash should be flagged", func(t *testing.T) {
+		t.Parallel()
+
+		response := getResponse(func(apiTx *transaction.ApiTransactionResult) (bool, error) {
+			assert.Equal(t, tx, apiTx)
+			return true, nil
+		}, "?withHashVerification=true")
+		require.NotNil(t, response.Data.HashVerified)
+		assert.True(t, *response.Data.HashVerified)
+	})
+	t.Run("mismatched hash should be flagged, also when fetching by sender", func(t *testing.T) {
+		t.Parallel()
+
+		response := getResponse(func(apiTx *transaction.ApiTransactionResult) (bool, error) {
+			return false, nil
+		}, "?sender=erd1&withHashVerification=true")
+		require.NotNil(t, response.Data.HashVerified)
+		assert.False(t, *response.Data.HashVerified)
+	})
+	t.Run("not verifiable transaction should not be flagged", func(t *testing.T) {
+		t.Parallel()
+
+		response := getResponse(func(apiTx *transaction.ApiTransactionResult) (bool, error) {
+			return false, errors.New("not verifiable")
+		}, "?withHashVerification=true")
+		assert.Nil(t, response.Data.HashVerified)
+	})
+	t.Run("invalid parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?withHashVerification=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
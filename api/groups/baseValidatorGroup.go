@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -28,25 +29,38 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/statistics", Handler: vg.statistics, Method: http.MethodGet},
 		{Path: "/auction", Handler: vg.auctionList, Method: http.MethodGet},
+		{Path: "/keys/:blsKey", Handler: vg.getBLSKeyInfo, Method: http.MethodGet},
 	}
 	vg.baseGroup.endpoints = baseRoutesHandlers
 
 	return vg, nil
 }
 
-// statistics returns the validator statistics
+// statistics returns the validator statistics, filtered and paginated based on the query parameters
 func (group *validatorGroup) statistics(c *gin.Context) {
-	validatorStatistics, err := group.facade.ValidatorStatistics()
+	options, err := parseValidatorStatisticsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	validatorStatistics, err := group.facade.ValidatorStatistics(c.Request.Context(), options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": validatorStatistics}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"statistics": validatorStatistics.Statistics, "totalCount": validatorStatistics.TotalCount},
+		"",
+		data.ReturnCodeSuccess,
+	)
 }
 
 func (group *validatorGroup) auctionList(c *gin.Context) {
-	auctionList, err := group.facade.AuctionList()
+	auctionList, err := group.facade.AuctionList(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
 		return
@@ -54,3 +68,14 @@ func (group *validatorGroup) auctionList(c *gin.Context) {
 
 	shared.RespondWith(c, http.StatusOK, gin.H{"auctionList": auctionList}, "", data.ReturnCodeSuccess)
 }
+
+// getBLSKeyInfo returns the staking status, the owner and the reward address of the provided BLS key
+func (group *validatorGroup) getBLSKeyInfo(c *gin.Context) {
+	blsKeyInfo, err := group.facade.GetBLSKeyInfo(c.Request.Context(), c.Param("blsKey"))
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"key": blsKeyInfo}, "", data.ReturnCodeSuccess)
+}
@@ -7,8 +7,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +21,7 @@ const validatorPath = "/validator"
 
 type valStatsResponseData struct {
 	Statistics map[string]*data.ValidatorApiResponse `json:"statistics"`
+	TotalCount int                                   `json:"totalCount"`
 }
 
 // ValStatsResponse structure
@@ -38,7 +42,7 @@ func TestValidatorStatistics_ShouldErrWhenFacadeFails(t *testing.T) {
 
 	errStr := "expected err"
 	facade := &mock.FacadeStub{
-		ValidatorStatisticsHandler: func() (map[string]*data.ValidatorApiResponse, error) {
+		ValidatorStatisticsHandler: func(_ common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
 			return nil, errors.New(errStr)
 		},
 	}
@@ -80,8 +84,8 @@ func TestValidatorStatistics_ShouldWork(t *testing.T) {
 		RatingModifier:                     1.5,
 	}
 	facade := &mock.FacadeStub{
-		ValidatorStatisticsHandler: func() (map[string]*data.ValidatorApiResponse, error) {
-			return valStatsMap, nil
+		ValidatorStatisticsHandler: func(_ common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+			return &data.ValidatorStatisticsPage{Statistics: valStatsMap, TotalCount: len(valStatsMap)}, nil
 		},
 	}
 	validatorGroup, err := groups.NewValidatorGroup(facade)
@@ -98,6 +102,64 @@ func TestValidatorStatistics_ShouldWork(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Equal(t, response.Data.Statistics["statistics"], valStatsMap["statistics"])
+	assert.Equal(t, 1, response.Data.TotalCount)
+}
+
+func TestValidatorStatistics_QueryOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should parse the filtering and pagination options", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.ValidatorStatisticsQueryOptions
+		facade := &mock.FacadeStub{
+			ValidatorStatisticsHandler: func(options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+				providedOptions = options
+				return &data.ValidatorStatisticsPage{}, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/statistics?shard-id=1&minRating=40.5&maxRating=90&minLeaderSuccess=2&maxLeaderSuccess=10&from=20&size=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, common.ValidatorStatisticsQueryOptions{
+			ShardID:          core.OptionalUint32{Value: 1, HasValue: true},
+			MinRating:        common.OptionalFloat64{Value: 40.5, HasValue: true},
+			MaxRating:        common.OptionalFloat64{Value: 90, HasValue: true},
+			MinLeaderSuccess: core.OptionalUint32{Value: 2, HasValue: true},
+			MaxLeaderSuccess: core.OptionalUint32{Value: 10, HasValue: true},
+			From:             20,
+			Size:             10,
+		}, providedOptions)
+	})
+	t.Run("invalid option should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ValidatorStatisticsHandler: func(_ common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/statistics?minRating=high", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
 }
 
 func TestValidatorGroup_GetAuctionList(t *testing.T) {
@@ -169,3 +231,71 @@ func TestValidatorGroup_GetAuctionList(t *testing.T) {
 		}, response)
 	})
 }
+
+func TestValidatorGroup_GetBLSKeyInfo(t *testing.T) {
+	t.Parallel()
+
+	blsKey := strings.Repeat("ab", 96)
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blsKeyInfo := &data.BLSKeyInfo{
+			BLSKey:        blsKey,
+			Status:        "staked",
+			Owner:         "erd1owner",
+			RewardAddress: "erd1reward",
+			Statistics:    &data.ValidatorApiResponse{ShardId: 1, ValidatorStatus: "eligible"},
+		}
+		facade := &mock.FacadeStub{
+			GetBLSKeyInfoCalled: func(key string) (*data.BLSKeyInfo, error) {
+				require.Equal(t, blsKey, key)
+				return blsKeyInfo, nil
+			},
+		}
+
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/keys/"+blsKey, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Key *data.BLSKeyInfo `json:"key"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Empty(t, response.Error)
+		require.Equal(t, blsKeyInfo, response.Data.Key)
+	})
+
+	t.Run("facade error should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("invalid BLS key")
+		facade := &mock.FacadeStub{
+			GetBLSKeyInfoCalled: func(key string) (*data.BLSKeyInfo, error) {
+				return nil, errFacade
+			},
+		}
+
+		validatorGroup, _ := groups.NewValidatorGroup(facade)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/keys/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, errFacade.Error(), response.Error)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+}
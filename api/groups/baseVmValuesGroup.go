@@ -2,6 +2,7 @@ package groups
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -16,15 +17,29 @@ import (
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
 type VMValueRequest struct {
-	ScAddress      string   `json:"scAddress"`
-	FuncName       string   `json:"funcName"`
+	ScAddress      string   `json:"scAddress" binding:"required"`
+	FuncName       string   `json:"funcName" binding:"required"`
 	CallerAddr     string   `json:"caller"`
-	CallValue      string   `json:"value"`
+	CallValue      string   `json:"value" binding:"omitempty,bigint"`
 	SameScState    bool     `json:"sameScState"`
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
-	Args           []string `json:"args"`
+	Args           []string `json:"args" binding:"dive,hexstring"`
 }
 
+// VMValuesMultiContractRequest represents the structure of a request running the same query against many contracts
+type VMValuesMultiContractRequest struct {
+	ScAddresses    []string `json:"scAddresses"`
+	FuncName       string   `json:"funcName" binding:"required"`
+	CallerAddr     string   `json:"caller"`
+	CallValue      string   `json:"value" binding:"omitempty,bigint"`
+	SameScState    bool     `json:"sameScState"`
+	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	Args           []string `json:"args" binding:"dive,hexstring"`
+}
+
+// maxContractsInMultiContractQuery limits the number of contracts queried by a single request
+const maxContractsInMultiContractQuery = 100
+
 type vmValuesGroup struct {
 	facade VmValuesFacadeHandler
 	*baseGroup
@@ -47,6 +62,7 @@ func NewVmValuesGroup(facadeHandler data.FacadeHandler) (*vmValuesGroup, error)
 		{Path: "/string", Handler: vvg.getString, Method: http.MethodPost},
 		{Path: "/int", Handler: vvg.getInt, Method: http.MethodPost},
 		{Path: "/query", Handler: vvg.executeQuery, Method: http.MethodPost},
+		{Path: "/multi-contract", Handler: vvg.executeMultiContractQuery, Method: http.MethodPost},
 	}
 	vvg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -98,9 +114,9 @@ func (group *vmValuesGroup) executeQuery(context *gin.Context) {
 
 func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, data.BlockInfo, error) {
 	request := VMValueRequest{}
-	err := context.ShouldBindJSON(&request)
+	err := shared.BindJSONBody(context, &request)
 	if err != nil {
-		return nil, data.BlockInfo{}, apiErrors.ErrInvalidJSONRequest
+		return nil, data.BlockInfo{}, fmt.Errorf("%w: %w", apiErrors.ErrInvalidJSONRequest, err)
 	}
 
 	command, err := createSCQuery(&request)
@@ -113,7 +129,7 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, data.BlockInfo{}, err
 	}
 
-	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(command)
+	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
@@ -121,6 +137,63 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 	return vmOutput, blockInfo, nil
 }
 
+// executeMultiContractQuery runs the same query against all the requested contracts and returns the results by contract address
+func (group *vmValuesGroup) executeMultiContractQuery(context *gin.Context) {
+	request := VMValuesMultiContractRequest{}
+	err := shared.BindJSONBody(context, &request)
+	if err != nil {
+		returnBadRequest(context, "executeMultiContractQuery", fmt.Errorf("%w: %w", apiErrors.ErrInvalidJSONRequest, err))
+		return
+	}
+	if len(request.ScAddresses) == 0 {
+		returnBadRequest(context, "executeMultiContractQuery", apiErrors.ErrEmptyContractsList)
+		return
+	}
+	if len(request.ScAddresses) > maxContractsInMultiContractQuery {
+		err = fmt.Errorf("%w: provided %d, maximum %d", apiErrors.ErrTooManyContracts, len(request.ScAddresses), maxContractsInMultiContractQuery)
+		returnBadRequest(context, "executeMultiContractQuery", err)
+		return
+	}
+
+	command, err := createSCQuery(&VMValueRequest{
+		FuncName:       request.FuncName,
+		CallerAddr:     request.CallerAddr,
+		CallValue:      request.CallValue,
+		SameScState:    request.SameScState,
+		ShouldBeSynced: request.ShouldBeSynced,
+		Args:           request.Args,
+	})
+	if err != nil {
+		returnBadRequest(context, "executeMultiContractQuery", err)
+		return
+	}
+
+	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	if err != nil {
+		returnBadRequest(context, "executeMultiContractQuery", err)
+		return
+	}
+
+	command.HyperblockNonce, err = parseUint64UrlParam(context, common.UrlParameterHyperblockNonce)
+	if err != nil {
+		returnBadRequest(context, "executeMultiContractQuery", fmt.Errorf("%w for hyperblock nonce", err))
+		return
+	}
+	hasBlockCoordinates := command.BlockNonce.HasValue || len(command.BlockHash) > 0
+	if command.HyperblockNonce.HasValue && hasBlockCoordinates {
+		returnBadRequest(context, "executeMultiContractQuery", apiErrors.ErrHyperblockNonceWithBlockCoordinates)
+		return
+	}
+
+	results, err := group.facade.ExecuteSCMultiContractQuery(context.Request.Context(), command, request.ScAddresses)
+	if err != nil {
+		returnBadRequest(context, "executeMultiContractQuery", err)
+		return
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"results": results}, "", data.ReturnCodeSuccess)
+}
+
 func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
 	arguments := make([][]byte, len(request.Args))
 	for i, arg := range request.Args {
@@ -158,8 +231,7 @@ func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte,
 }
 
 func returnBadRequest(context *gin.Context, errScope string, err error) {
-	message := fmt.Sprintf("%s: %s", errScope, err)
-	shared.RespondWith(context, http.StatusBadRequest, nil, message, data.ReturnCodeRequestError)
+	shared.RespondWithBodyValidationError(context, errors.New(errScope), err)
 }
 
 func returnOkResponse(context *gin.Context, dataToReturn interface{}, blockInfo interface{}) {
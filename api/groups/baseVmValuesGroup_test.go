@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
@@ -191,6 +192,138 @@ func TestQuery_ShouldWorkWithCoordinates(t *testing.T) {
 	require.Equal(t, providedBlockInfo, response.Data.BlockInfo)
 }
 
+func TestMultiContractQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty contracts list should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesMultiContractRequest{
+			FuncName: "function",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/multi-contract", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrEmptyContractsList.Error())
+	})
+	t.Run("too many contracts should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesMultiContractRequest{
+			ScAddresses: make([]string, 101),
+			FuncName:    "function",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/multi-contract", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrTooManyContracts.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExecuteSCMultiContractQueryHandler: func(query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+				return nil, expectedErr
+			},
+		}
+		request := groups.VMValuesMultiContractRequest{
+			ScAddresses: []string{DummyScAddress},
+			FuncName:    "function",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/multi-contract", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedNonce := uint64(123)
+		otherScAddress := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+		facade := &mock.FacadeStub{
+			ExecuteSCMultiContractQueryHandler: func(query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+				require.Equal(t, "balanceOf", query.FuncName)
+				require.Equal(t, [][]byte{{0xaa}}, query.Arguments)
+				require.Equal(t, providedNonce, query.BlockNonce.Value)
+				require.Equal(t, []string{DummyScAddress, otherScAddress}, scAddresses)
+
+				return map[string]*data.SCQueryResult{
+					DummyScAddress: {
+						Data:      &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}},
+						BlockInfo: data.BlockInfo{Nonce: providedNonce},
+					},
+					otherScAddress: {
+						Error: "function not found",
+					},
+				}, nil
+			},
+		}
+		request := groups.VMValuesMultiContractRequest{
+			ScAddresses: []string{DummyScAddress, otherScAddress},
+			FuncName:    "balanceOf",
+			Args:        []string{"aa"},
+		}
+
+		type multiContractResponse struct {
+			Data struct {
+				Results map[string]*data.SCQueryResult `json:"results"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := multiContractResponse{}
+		statusCode := doPost(t, facade, "/vm-values/multi-contract?blockNonce="+strconv.FormatUint(providedNonce, 10), request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+		require.Len(t, response.Data.Results, 2)
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.Results[DummyScAddress].Data.ReturnData[0]).Int64())
+		require.Equal(t, providedNonce, response.Data.Results[DummyScAddress].BlockInfo.Nonce)
+		require.Equal(t, "function not found", response.Data.Results[otherScAddress].Error)
+	})
+	t.Run("hyperblock nonce should be passed to the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCMultiContractQueryHandler: func(query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.HyperblockNonce)
+				require.False(t, query.BlockNonce.HasValue)
+				return map[string]*data.SCQueryResult{}, nil
+			},
+		}
+		request := groups.VMValuesMultiContractRequest{
+			ScAddresses: []string{DummyScAddress},
+			FuncName:    "function",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/multi-contract?hyperblockNonce=37", request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+	})
+	t.Run("hyperblock nonce with block nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesMultiContractRequest{
+			ScAddresses: []string{DummyScAddress},
+			FuncName:    "function",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/multi-contract?hyperblockNonce=37&blockNonce=5", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrHyperblockNonceWithBlockCoordinates.Error())
+	})
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -272,6 +405,23 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	requireErrorOnGetSingleValueRoutes(t, &facade, []byte("dummy"), apiErrors.ErrInvalidJSONRequest)
 }
 
+func TestAllRoutes_WhenMissingFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		CallValue: "ten",
+	}
+
+	requireErrorOnAllRoutes(t, &facade, request, errors.New("funcName is required; value must be a base-10 string"))
+}
+
 func TestAllRoutes_WithSameScStateAndShouldBySyncedFilled(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,56 @@
+package groups
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+const (
+	denominatedFieldSuffix  = "Denominated"
+	denominatedMetricSuffix = "_denominated"
+)
+
+// economicsDenominatedMetrics holds the economics metrics given in the smallest unit of the native token
+var economicsDenominatedMetrics = []string{
+	"erd_total_supply",
+	"erd_total_staked_value",
+	"erd_total_base_staked_value",
+	"erd_total_top_up_value",
+	"erd_dev_rewards",
+	"erd_total_fees",
+	"erd_inflation",
+}
+
+type nativeTokenDenominationHandler interface {
+	GetNativeTokenDenomination(ctx context.Context) (int, error)
+}
+
+// getRequestedDenomination returns the number of decimals of the native token, if the denominated values were requested.
+// The enrichment is best effort, so the response is returned without the denominated values if the number of decimals
+// cannot be fetched
+func getRequestedDenomination(c *gin.Context, facade nativeTokenDenominationHandler) (int, bool) {
+	denominated, err := parseBoolUrlParam(c, common.UrlParameterDenominated)
+	if err != nil || !denominated {
+		return 0, false
+	}
+
+	numDecimals, err := facade.GetNativeTokenDenomination(c.Request.Context())
+	if err != nil {
+		log.WithContext(c.Request.Context()).Debug("cannot get the native token denomination", "error", err.Error())
+		return 0, false
+	}
+
+	return numDecimals, true
+}
+
+// addDenominatedValue sets the value converted with the provided number of decimals under the key suffixed with
+// denominatedSuffix, leaving the response as it is if the value is not a base 10 integer
+func addDenominatedValue(response map[string]interface{}, key string, denominatedSuffix string, value string, numDecimals int) {
+	denominated, err := common.DenominateValue(value, numDecimals)
+	if err != nil {
+		return
+	}
+
+	response[key+denominatedSuffix] = denominated
+}
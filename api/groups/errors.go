@@ -16,3 +16,15 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrForcedShardIDCannotBeProvided signals that the forced shard id cannot be provided for a different address other than the system account address
 var ErrForcedShardIDCannotBeProvided = errors.New("forced shard id parameter can only be provided for system accounts")
+
+// ErrMissingBlockRange signals that the block range of a query has not been provided
+var ErrMissingBlockRange = errors.New("both fromBlock and toBlock parameters must be provided")
+
+// ErrInvalidBlockRange signals that the start of the block range is after its end
+var ErrInvalidBlockRange = errors.New("fromBlock must not be greater than toBlock")
+
+// ErrMissingClientApiKey signals that the API key of the client has not been provided
+var ErrMissingClientApiKey = errors.New("the apiKey parameter must be provided")
+
+// ErrInvalidUsageInterval signals that the start of the usage interval is after its end
+var ErrInvalidUsageInterval = errors.New("from must not be greater than to")
@@ -0,0 +1,280 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/graphql"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtsResponseKey           = "esdts"
+	tokenIdentifierField       = "tokenIdentifier"
+	networkConfigResponseKey   = "config"
+	networkStatusResponseKey   = "status"
+	addressGraphQLField        = "address"
+	hashGraphQLArgument        = "hash"
+	shardGraphQLArgument       = "shard"
+	nonceGraphQLArgument       = "nonce"
+	sizeGraphQLArgument        = "size"
+	withResultsGraphQLArg      = "withResults"
+	withTransactionsGraphQLArg = "withTransactions"
+)
+
+// newGraphQLSchema creates the schema resolving the accounts, the transactions, the blocks and the network data
+// through the facade. The nested fields of an account, such as its tokens or its latest transactions, are fetched
+// only if they are selected
+func newGraphQLSchema(facade GraphQLFacadeHandler) *graphql.Schema {
+	tokenType := &graphql.Object{
+		Name: "Token",
+		Fields: map[string]*graphql.Field{
+			tokenIdentifierField: {},
+			"balance":            {},
+			"nonce":              {},
+			"name":               {},
+			"creator":            {},
+			"royalties":          {},
+			"hash":               {},
+			"attributes":         {},
+			"uris":               {},
+		},
+	}
+	accountTransactionType := graphql.NewObjectFromStruct("AccountTransaction", data.DatabaseTransaction{}, nil)
+	accountType := graphql.NewObjectFromStruct("Account", data.Account{}, map[string]*graphql.Field{
+		"shard": {
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return facade.GetShardIDForAddress(sourceAddress(params))
+			},
+		},
+		"tokens": {
+			Type: tokenType,
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return resolveAccountTokens(context.Background(), facade, sourceAddress(params))
+			},
+		},
+		"latestTransactions": {
+			Type:      accountTransactionType,
+			Arguments: []string{sizeGraphQLArgument},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				size, _, err := getUint32GraphQLArgument(params, sizeGraphQLArgument)
+				if err != nil {
+					return nil, err
+				}
+
+				return facade.GetAddressLatestTransactions(sourceAddress(params), size)
+			},
+		},
+	})
+	transactionType := graphql.NewObjectFromStruct("Transaction", transaction.ApiTransactionResult{}, nil)
+	blockType := graphql.NewObjectFromStruct("Block", api.Block{}, nil)
+
+	return &graphql.Schema{
+		Query: &graphql.Object{
+			Name: "Query",
+			Fields: map[string]*graphql.Field{
+				"account": {
+					Type:      accountType,
+					Arguments: []string{addressGraphQLField},
+					Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+						return resolveAccount(context.Background(), facade, params)
+					},
+				},
+				"transaction": {
+					Type:      transactionType,
+					Arguments: []string{hashGraphQLArgument, withResultsGraphQLArg},
+					Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+						return resolveTransaction(context.Background(), facade, params)
+					},
+				},
+				"block": {
+					Type:      blockType,
+					Arguments: []string{shardGraphQLArgument, nonceGraphQLArgument, hashGraphQLArgument, withTransactionsGraphQLArg},
+					Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+						return resolveBlock(context.Background(), facade, params)
+					},
+				},
+				"networkConfig": {
+					Resolve: func(_ graphql.ResolveParams) (interface{}, error) {
+						response, err := facade.GetNetworkConfigMetrics(context.Background())
+						return unwrapGenericResponse(response, networkConfigResponseKey), err
+					},
+				},
+				"networkStatus": {
+					Arguments: []string{shardGraphQLArgument},
+					Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+						shardID, err := getRequiredUint32GraphQLArgument(params, shardGraphQLArgument)
+						if err != nil {
+							return nil, err
+						}
+
+						response, err := facade.GetNetworkStatusMetrics(context.Background(), shardID)
+						return unwrapGenericResponse(response, networkStatusResponseKey), err
+					},
+				},
+			},
+		},
+	}
+}
+
+func resolveAccount(ctx context.Context, facade GraphQLFacadeHandler, params graphql.ResolveParams) (interface{}, error) {
+	address, err := getRequiredStringGraphQLArgument(params, addressGraphQLField)
+	if err != nil {
+		return nil, err
+	}
+
+	accountModel, err := facade.GetAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrGetAccount, err.Error())
+	}
+
+	return &accountModel.Account, nil
+}
+
+func resolveAccountTokens(ctx context.Context, facade GraphQLFacadeHandler, address string) (interface{}, error) {
+	response, err := facade.GetAllESDTTokens(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	esdts, ok := unwrapGenericResponse(response, esdtsResponseKey).(map[string]interface{})
+	if !ok {
+		return make([]interface{}, 0), nil
+	}
+
+	identifiers := make([]string, 0, len(esdts))
+	for identifier := range esdts {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	tokens := make([]interface{}, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		token, isObject := esdts[identifier].(map[string]interface{})
+		if !isObject {
+			continue
+		}
+
+		_, hasIdentifier := token[tokenIdentifierField]
+		if !hasIdentifier {
+			token[tokenIdentifierField] = identifier
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func resolveTransaction(ctx context.Context, facade GraphQLFacadeHandler, params graphql.ResolveParams) (interface{}, error) {
+	txHash, err := getRequiredStringGraphQLArgument(params, hashGraphQLArgument)
+	if err != nil {
+		return nil, err
+	}
+	withResults, _, err := params.Bool(withResultsGraphQLArg)
+	if err != nil {
+		return nil, err
+	}
+
+	return facade.GetTransaction(ctx, txHash, withResults)
+}
+
+func resolveBlock(ctx context.Context, facade GraphQLFacadeHandler, params graphql.ResolveParams) (interface{}, error) {
+	shardID, err := getRequiredUint32GraphQLArgument(params, shardGraphQLArgument)
+	if err != nil {
+		return nil, err
+	}
+	withTransactions, _, err := params.Bool(withTransactionsGraphQLArg)
+	if err != nil {
+		return nil, err
+	}
+	nonce, hasNonce, err := params.Uint64(nonceGraphQLArgument)
+	if err != nil {
+		return nil, err
+	}
+	hash, hasHash, err := params.String(hashGraphQLArgument)
+	if err != nil {
+		return nil, err
+	}
+	if hasNonce == hasHash {
+		return nil, fmt.Errorf("%w: exactly one of %s and %s should be provided", graphql.ErrInvalidArgument, nonceGraphQLArgument, hashGraphQLArgument)
+	}
+
+	options := common.BlockQueryOptions{WithTransactions: withTransactions}
+	var response *data.BlockApiResponse
+	if hasNonce {
+		response, err = facade.GetBlockByNonce(ctx, shardID, nonce, options)
+	} else {
+		response, err = facade.GetBlockByHash(ctx, shardID, hash, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data.Block, nil
+}
+
+func sourceAddress(params graphql.ResolveParams) string {
+	address, _ := params.Source[addressGraphQLField].(string)
+	return address
+}
+
+// unwrapGenericResponse returns the value found under the provided key of the response data, if any
+func unwrapGenericResponse(response *data.GenericAPIResponse, key string) interface{} {
+	if response == nil {
+		return nil
+	}
+
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return response.Data
+	}
+
+	value, ok := responseData[key]
+	if !ok {
+		return responseData
+	}
+
+	return value
+}
+
+func getRequiredStringGraphQLArgument(params graphql.ResolveParams, name string) (string, error) {
+	value, ok, err := params.String(name)
+	if err != nil {
+		return "", err
+	}
+	if !ok || len(value) == 0 {
+		return "", fmt.Errorf("%w: %s is required", graphql.ErrInvalidArgument, name)
+	}
+
+	return value, nil
+}
+
+func getRequiredUint32GraphQLArgument(params graphql.ResolveParams, name string) (uint32, error) {
+	value, ok, err := getUint32GraphQLArgument(params, name)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w: %s is required", graphql.ErrInvalidArgument, name)
+	}
+
+	return value, nil
+}
+
+func getUint32GraphQLArgument(params graphql.ResolveParams, name string) (uint32, bool, error) {
+	value, ok, err := params.Uint64(name)
+	if err != nil {
+		return 0, false, err
+	}
+	if value > math.MaxUint32 {
+		return 0, false, fmt.Errorf("%w: %s is too large", graphql.ErrInvalidArgument, name)
+	}
+
+	return uint32(value), ok, nil
+}
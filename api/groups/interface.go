@@ -1,8 +1,12 @@
 package groups
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -11,72 +15,96 @@ import (
 
 // AccountsFacadeHandler interface defines methods that can be used from the facade
 type AccountsFacadeHandler interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
-	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetNativeTokenDenomination(ctx context.Context) (int, error)
+	GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
-	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
-	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairsDiff(ctx context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error)
+	GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsNonces(ctx context.Context, addresses []string) (*data.AccountsNonces, error)
+	GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetStuckTransactions(ctx context.Context, address string) (*data.StuckTransactions, error)
+	GetCollectionsForAddress(ctx context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error)
+	GetTokensIssuedByAddress(ctx context.Context, address string) ([]*data.IssuedToken, error)
+	VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error)
+	GetAddressActivitySummary(ctx context.Context, address string) (*data.AddressActivitySummary, error)
+	IsTokenPriceEnabled() bool
+	GetUsdValue(ctx context.Context, token string, amount string) (float64, error)
+	GetAddressConverter() (core.PubkeyConverter, error)
 }
 
 // BlockFacadeHandler interface defines methods that can be used from the facade
 type BlockFacadeHandler interface {
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
-	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlocksByHashes(ctx context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error)
+	GetEpochStartBlock(ctx context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	IsBlockFinal(ctx context.Context, shardID uint32, nonce uint64) (bool, error)
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from the facade
 type BlocksFacadeHandler interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+}
+
+// MiniBlockFacadeHandler interface defines methods that can be used from the facade
+type MiniBlockFacadeHandler interface {
+	GetMiniBlockByHash(ctx context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error)
 }
 
 // InternalFacadeHandler interface defines methods that can be used from facade context variable
 type InternalFacadeHandler interface {
-	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonce(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 }
 
 // HyperBlockFacadeHandler defines the actions needed for fetching the hyperblocks from the nodes
 type HyperBlockFacadeHandler interface {
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
 type NetworkFacadeHandler interface {
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkStatusSnapshot(ctx context.Context) (*data.NetworkStatusSnapshot, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
-	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
-	GetDelegatedInfo() (*data.GenericAPIResponse, error)
-	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
-	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
-	GetRatingsConfig() (*data.GenericAPIResponse, error)
-	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
-	GetGasConfigs() (*data.GenericAPIResponse, error)
-	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
-	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNativeTokenDenomination(ctx context.Context) (int, error)
+	GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetStakingOverview(ctx context.Context) (*data.StakingOverview, error)
+	GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error)
+	GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error)
+	GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetGasPriceSuggestion(ctx context.Context) (*data.GasPriceSuggestion, error)
+	SubscribeToNetworkStatus(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error)
 }
 
 // NodeFacadeHandler interface defines methods that can be used from the facade
 type NodeFacadeHandler interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
-	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
-	GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error)
+	GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
 
 // StatusFacadeHandler interface defines methods that can be used from the facade
@@ -87,40 +115,67 @@ type StatusFacadeHandler interface {
 
 // TransactionFacadeHandler interface defines methods that can be used from the facade
 type TransactionFacadeHandler interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, *data.SentTransaction, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	IsFaucetEnabled() bool
-	SendUserFunds(receiver string, value *big.Int) error
-	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
-	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
-	GetLastPoolNonceForSender(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	IsReadOnlyModeEnabled() bool
+	SendUserFunds(ctx context.Context, receiver string, value *big.Int) error
+	IsFaucetQueueEnabled() bool
+	EnqueueUserFunds(receiver string, value *big.Int) (*data.FaucetRequest, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetFinalTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionOutcome(ctx context.Context, txHash string) (*data.TransactionOutcome, error)
+	GetTransactionJourney(ctx context.Context, txHash string) (*data.TransactionJourney, error)
+	ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error)
+	DecodeDataField(dataField string) (*data.DecodedDataField, error)
+	CheckTransferReceiver(ctx context.Context, tx *data.Transaction) (*data.ReceiverCheck, error)
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolForSenders(ctx context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error)
+	GetAgedTransactionsPool(ctx context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error)
+	GetTransactionsPoolChunk(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error)
+	StreamTransactionsPool(ctx context.Context, shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
+	ValidateTransaction(ctx context.Context, tx *data.Transaction) (*data.TransactionValidationResult, error)
+	ComputeTransactionFee(ctx context.Context, tx *data.Transaction) (*data.TransactionFee, error)
+	BuildESDTTransfer(ctx context.Context, request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error)
+	DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation
+	DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent
+	VerifyTransactionHash(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error)
+}
+
+// ProbesFacadeHandler interface defines methods that can be used from the facade
+type ProbesFacadeHandler interface {
+	GetLiveness() *data.ProbeStatus
+	GetReadiness() *data.ProbeStatus
 }
 
 // ProofFacadeHandler interface defines methods that can be used from the facade
 type ProofFacadeHandler interface {
-	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
-	GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
-	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
+	GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // ValidatorFacadeHandler interface defines methods that can be used from the facade
 type ValidatorFacadeHandler interface {
-	ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error)
-	AuctionList() ([]*data.AuctionListValidatorAPIResponse, error)
+	ValidatorStatistics(ctx context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error)
+	AuctionList(ctx context.Context) ([]*data.AuctionListValidatorAPIResponse, error)
+	GetBLSKeyInfo(ctx context.Context, blsKey string) (*data.BLSKeyInfo, error)
 }
 
 // VmValuesFacadeHandler interface defines methods that can be used from the facade
 type VmValuesFacadeHandler interface {
-	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCQuery(context.Context, *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCMultiContractQuery(ctx context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error)
 }
 
 // ActionsFacadeHandler interface defines methods that can be used from the facade
@@ -129,8 +184,67 @@ type ActionsFacadeHandler interface {
 	ReloadFullHistoryObservers() data.NodesReloadResponse
 }
 
+// AdminFacadeHandler interface defines methods that can be used from the facade
+type AdminFacadeHandler interface {
+	AddObserver(node *data.NodeData) error
+	RemoveObserver(address string) error
+	StartBlocksExport(ctx context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error)
+	GetBlocksExportJob(jobID string) (*data.BlocksExportJob, error)
+	SetReadOnlyMode(enabled bool)
+	IsReadOnlyModeEnabled() bool
+	GetClientUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error)
+}
+
+// SovereignFacadeHandler interface defines methods that can be used from the facade
+type SovereignFacadeHandler interface {
+	GetSovereignValidatorsInfo(ctx context.Context, epoch uint32) (*data.SovereignValidatorsInfo, error)
+	GetSovereignChainParameters(ctx context.Context) (*data.SovereignChainParameters, error)
+	GetSovereignNotarizedMainChainHeaders(ctx context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error)
+}
+
+// NodePassthroughFacadeHandler interface defines methods that can be used from the facade
+type NodePassthroughFacadeHandler interface {
+	ForwardToNode(ctx context.Context, shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error)
+}
+
+// DelegationFacadeHandler interface defines methods that can be used from the facade
+type DelegationFacadeHandler interface {
+	GetDelegationProviders(ctx context.Context) ([]*data.DelegationProvider, error)
+	GetDelegationProvider(ctx context.Context, address string) (*data.DelegationProvider, error)
+}
+
+// FaucetFacadeHandler interface defines methods that can be used from the facade
+type FaucetFacadeHandler interface {
+	GetFaucetRequest(id string) (*data.FaucetRequest, error)
+}
+
+// CollectionsFacadeHandler interface defines methods that can be used from the facade
+type CollectionsFacadeHandler interface {
+	GetCollection(ctx context.Context, collection string) (*data.Collection, error)
+}
+
+// TokensFacadeHandler interface defines methods that can be used from the facade
+type TokensFacadeHandler interface {
+	GetTokenPrice(token string) (*data.TokenPrice, error)
+	GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error)
+}
+
 // AboutFacadeHandler defines the methods that can be used from the facade
 type AboutFacadeHandler interface {
 	GetAboutInfo() (*data.GenericAPIResponse, error)
-	GetNodesVersions() (*data.GenericAPIResponse, error)
+	GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetExcludedObservers() (*data.GenericAPIResponse, error)
+}
+
+// GraphQLFacadeHandler defines the methods used by the GraphQL resolvers
+type GraphQLFacadeHandler interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetShardIDForAddress(address string) (uint32, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error)
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
 }
@@ -3,15 +3,32 @@ package groups
 import (
 	"encoding/hex"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
-// SystemAccountAddressBech is the const for the system account address
+// SystemAccountAddressBech is the const for the system account address, encoded with the default "erd" address prefix.
+// The chains using another prefix are handled by comparing the decoded address with common.SystemAccountAddressHex
 const SystemAccountAddressBech = "erd1lllllllllllllllllllllllllllllllllllllllllllllllllllsckry7t"
 
+// defaultClientUsageInterval is the usage interval reported when the from parameter is not provided
+const defaultClientUsageInterval = 24 * time.Hour
+
+// isSystemAccountAddress returns true if the provided address, decoded with the address converter of the chain, is the
+// system account address
+func isSystemAccountAddress(pubKeyConverter core.PubkeyConverter, address string) bool {
+	if check.IfNil(pubKeyConverter) {
+		return false
+	}
+
+	addressBytes, err := pubKeyConverter.Decode(address)
+	return err == nil && hex.EncodeToString(addressBytes) == common.SystemAccountAddressHex
+}
+
 func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
 	withTxs, err := parseBoolUrlParam(c, common.UrlParameterWithTransactions)
 	if err != nil {
@@ -32,6 +49,20 @@ func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
 	return options, nil
 }
 
+func parseMiniBlockQueryOptions(c *gin.Context) (common.MiniBlockQueryOptions, error) {
+	withTxs, err := parseBoolUrlParam(c, common.UrlParameterWithTransactions)
+	if err != nil {
+		return common.MiniBlockQueryOptions{}, err
+	}
+
+	epoch, err := parseUint32UrlParam(c, common.UrlParameterEpoch)
+	if err != nil {
+		return common.MiniBlockQueryOptions{}, err
+	}
+
+	return common.MiniBlockQueryOptions{WithTransactions: withTxs, Epoch: epoch}, nil
+}
+
 func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions, error) {
 	withLogs, err := parseBoolUrlParam(c, common.UrlParameterWithLogs)
 	if err != nil {
@@ -64,7 +95,7 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 	}, nil
 }
 
-func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQueryOptions, error) {
+func parseAccountQueryOptions(c *gin.Context, address string, pubKeyConverter core.PubkeyConverter) (common.AccountQueryOptions, error) {
 	onFinalBlock, err := parseBoolUrlParam(c, common.UrlParameterOnFinalBlock)
 	if err != nil {
 		return common.AccountQueryOptions{}, err
@@ -105,7 +136,12 @@ func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQue
 		return common.AccountQueryOptions{}, err
 	}
 
-	if shardID.HasValue && address != SystemAccountAddressBech {
+	withGuardians, err := parseBoolUrlParam(c, common.UrlParameterWithGuardians)
+	if err != nil {
+		return common.AccountQueryOptions{}, err
+	}
+
+	if shardID.HasValue && !isSystemAccountAddress(pubKeyConverter, address) {
 		return common.AccountQueryOptions{}, ErrForcedShardIDCannotBeProvided
 	}
 
@@ -118,6 +154,7 @@ func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQue
 		HintEpoch:      hintEpoch,
 		ForcedShardID:  shardID,
 		WithKeys:       withKeys,
+		WithGuardians:  withGuardians,
 	}
 
 	return options, nil
@@ -129,7 +166,15 @@ func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOption
 		return common.TransactionQueryOptions{}, err
 	}
 
-	options := common.TransactionQueryOptions{WithResults: withResults}
+	withHashVerification, err := parseBoolUrlParam(c, common.UrlParameterWithHashVerification)
+	if err != nil {
+		return common.TransactionQueryOptions{}, err
+	}
+
+	options := common.TransactionQueryOptions{
+		WithResults:          withResults,
+		WithHashVerification: withHashVerification,
+	}
 	return options, nil
 }
 
@@ -219,12 +264,36 @@ func parseTransactionsPoolQueryOptions(c *gin.Context) (common.TransactionsPoolO
 		return common.TransactionsPoolOptions{}, err
 	}
 
+	from, err := parseUint32UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
+	stream, err := parseBoolUrlParam(c, common.UrlParameterStream)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
+	allShards, err := parseBoolUrlParam(c, common.UrlParameterAllShards)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
 	return common.TransactionsPoolOptions{
 		ShardID:   parseStringUrlParam(c, common.UrlParameterShardID),
 		Sender:    parseStringUrlParam(c, common.UrlParameterSender),
 		Fields:    parseStringUrlParam(c, common.UrlParameterFields),
 		LastNonce: lastNonce,
 		NonceGaps: nonceGaps,
+		From:      from.Value,
+		Size:      size.Value,
+		Stream:    stream,
+		AllShards: allShards,
 	}, nil
 }
 
@@ -235,3 +304,165 @@ func parseAlteredAccountOptions(c *gin.Context) (common.GetAlteredAccountsForBlo
 		TokensFilter: tokensFilter,
 	}, nil
 }
+
+func parseValidatorStatisticsQueryOptions(c *gin.Context) (common.ValidatorStatisticsQueryOptions, error) {
+	shardID, err := parseUint32UrlParam(c, common.UrlParameterShardID)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	minRating, err := parseFloat64UrlParam(c, common.UrlParameterMinRating)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	maxRating, err := parseFloat64UrlParam(c, common.UrlParameterMaxRating)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	minLeaderSuccess, err := parseUint32UrlParam(c, common.UrlParameterMinLeaderSuccess)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	maxLeaderSuccess, err := parseUint32UrlParam(c, common.UrlParameterMaxLeaderSuccess)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	from, err := parseUint32UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.ValidatorStatisticsQueryOptions{}, err
+	}
+
+	return common.ValidatorStatisticsQueryOptions{
+		ShardID:          shardID,
+		MinRating:        minRating,
+		MaxRating:        maxRating,
+		MinLeaderSuccess: minLeaderSuccess,
+		MaxLeaderSuccess: maxLeaderSuccess,
+		From:             from.Value,
+		Size:             size.Value,
+	}, nil
+}
+
+func parseTokenHoldersQueryOptions(c *gin.Context) (common.TokenHoldersQueryOptions, error) {
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.TokenHoldersQueryOptions{}, err
+	}
+
+	return common.TokenHoldersQueryOptions{
+		Size:   size.Value,
+		Cursor: parseStringUrlParam(c, common.UrlParameterCursor),
+	}, nil
+}
+
+func parseCollectionsQueryOptions(c *gin.Context) (common.CollectionsQueryOptions, error) {
+	from, err := parseUint32UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return common.CollectionsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.CollectionsQueryOptions{}, err
+	}
+
+	return common.CollectionsQueryOptions{
+		From: from.Value,
+		Size: size.Value,
+	}, nil
+}
+
+func parseAccountKeysDiffQueryOptions(c *gin.Context, address string, pubKeyConverter core.PubkeyConverter) (common.AccountKeysDiffQueryOptions, error) {
+	fromBlock, err := parseUint64UrlParam(c, common.UrlParameterFromBlock)
+	if err != nil {
+		return common.AccountKeysDiffQueryOptions{}, err
+	}
+
+	toBlock, err := parseUint64UrlParam(c, common.UrlParameterToBlock)
+	if err != nil {
+		return common.AccountKeysDiffQueryOptions{}, err
+	}
+
+	shardID, err := parseUint32UrlParam(c, common.UrlParameterForcedShardID)
+	if err != nil {
+		return common.AccountKeysDiffQueryOptions{}, err
+	}
+
+	if !fromBlock.HasValue || !toBlock.HasValue {
+		return common.AccountKeysDiffQueryOptions{}, ErrMissingBlockRange
+	}
+	if fromBlock.Value > toBlock.Value {
+		return common.AccountKeysDiffQueryOptions{}, ErrInvalidBlockRange
+	}
+	if shardID.HasValue && !isSystemAccountAddress(pubKeyConverter, address) {
+		return common.AccountKeysDiffQueryOptions{}, ErrForcedShardIDCannotBeProvided
+	}
+
+	return common.AccountKeysDiffQueryOptions{
+		FromBlock:     fromBlock.Value,
+		ToBlock:       toBlock.Value,
+		ForcedShardID: shardID,
+	}, nil
+}
+
+// parseClientUsageQueryOptions parses the apiKey and the from and to unix timestamps of the interval, which defaults to
+// the last defaultClientUsageInterval
+func parseClientUsageQueryOptions(c *gin.Context) (common.ClientUsageQueryOptions, error) {
+	apiKey := parseStringUrlParam(c, common.UrlParameterApiKey)
+	if apiKey == "" {
+		return common.ClientUsageQueryOptions{}, ErrMissingClientApiKey
+	}
+
+	from, err := parseUint64UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return common.ClientUsageQueryOptions{}, err
+	}
+
+	to, err := parseUint64UrlParam(c, common.UrlParameterTo)
+	if err != nil {
+		return common.ClientUsageQueryOptions{}, err
+	}
+
+	options := common.ClientUsageQueryOptions{
+		ApiKey: apiKey,
+		To:     time.Now(),
+	}
+	if to.HasValue {
+		options.To = time.Unix(int64(to.Value), 0)
+	}
+	options.From = options.To.Add(-defaultClientUsageInterval)
+	if from.HasValue {
+		options.From = time.Unix(int64(from.Value), 0)
+	}
+	if options.From.After(options.To) {
+		return common.ClientUsageQueryOptions{}, ErrInvalidUsageInterval
+	}
+
+	return options, nil
+}
+
+func parseFloat64UrlParam(c *gin.Context, name string) (common.OptionalFloat64, error) {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return common.OptionalFloat64{}, nil
+	}
+
+	value, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return common.OptionalFloat64{}, err
+	}
+
+	return common.OptionalFloat64{
+		Value:    value,
+		HasValue: true,
+	}, nil
+}
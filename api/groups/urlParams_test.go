@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/stretchr/testify/require"
 )
@@ -37,7 +39,7 @@ func TestParseAccountOptions(t *testing.T) {
 			HasValue: true,
 		},
 	}
-	options, err := parseAccountQueryOptions(createDummyGinContextWithQuery("hintEpoch=3737"), "")
+	options, err := parseAccountQueryOptions(createDummyGinContextWithQuery("hintEpoch=3737"), "", nil)
 	require.Nil(t, err)
 	require.Equal(t, expectedOptions, options)
 }
@@ -127,17 +129,26 @@ func TestParseHyperblockQueryOptions(t *testing.T) {
 }
 
 func TestParseAccountQueryOptions(t *testing.T) {
-	options, err := parseAccountQueryOptions(createDummyGinContextWithQuery("onFinalBlock=true"), "")
+	options, err := parseAccountQueryOptions(createDummyGinContextWithQuery("onFinalBlock=true"), "", nil)
 	require.Nil(t, err)
 	require.Equal(t, common.AccountQueryOptions{OnFinalBlock: true}, options)
 
-	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery(""), "")
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery(""), "", nil)
 	require.Nil(t, err)
 	require.Empty(t, options)
 
-	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("onFinalBlock=foobar"), "")
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("onFinalBlock=foobar"), "", nil)
 	require.NotNil(t, err)
 	require.Empty(t, options)
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("forced-shard-id=1"), SystemAccountAddressBech, converter)
+	require.Nil(t, err)
+	require.Equal(t, common.AccountQueryOptions{ForcedShardID: core.OptionalUint32{Value: 1, HasValue: true}}, options)
+
+	options, err = parseAccountQueryOptions(createDummyGinContextWithQuery("forced-shard-id=1"), "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", converter)
+	require.Equal(t, ErrForcedShardIDCannotBeProvided, err)
+	require.Empty(t, options)
 }
 
 func TestParseTransactionQueryOptions(t *testing.T) {
@@ -145,6 +156,10 @@ func TestParseTransactionQueryOptions(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, common.TransactionQueryOptions{WithResults: true}, options)
 
+	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery("withResults=true&withHashVerification=true"))
+	require.Nil(t, err)
+	require.Equal(t, common.TransactionQueryOptions{WithResults: true, WithHashVerification: true}, options)
+
 	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery(""))
 	require.Nil(t, err)
 	require.Empty(t, options)
@@ -152,6 +167,10 @@ func TestParseTransactionQueryOptions(t *testing.T) {
 	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery("withResults=foobar"))
 	require.NotNil(t, err)
 	require.Empty(t, options)
+
+	options, err = parseTransactionQueryOptions(createDummyGinContextWithQuery("withHashVerification=foobar"))
+	require.NotNil(t, err)
+	require.Empty(t, options)
 }
 
 func TestParseTransactionSimulationOptions(t *testing.T) {
@@ -307,3 +326,22 @@ func TestParseAlteredAccountOptions(t *testing.T) {
 	require.Nil(t, err)
 
 }
+
+func TestIsSystemAccountAddress(t *testing.T) {
+	t.Parallel()
+
+	systemAccountAddressBytes, _ := hex.DecodeString(common.SystemAccountAddressHex)
+	erdConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	sovConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "sov")
+	sovSystemAccountAddress, _ := sovConverter.Encode(systemAccountAddressBytes)
+
+	require.True(t, isSystemAccountAddress(erdConverter, SystemAccountAddressBech))
+	require.True(t, isSystemAccountAddress(sovConverter, sovSystemAccountAddress))
+	require.False(t, isSystemAccountAddress(sovConverter, SystemAccountAddressBech))
+	require.False(t, isSystemAccountAddress(erdConverter, sovSystemAccountAddress))
+	require.False(t, isSystemAccountAddress(erdConverter, common.SystemAccountAddressHex))
+	require.False(t, isSystemAccountAddress(erdConverter, "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"))
+	require.False(t, isSystemAccountAddress(erdConverter, "erd1lllllllllllllllllllllllllllllllllllllllllllllllllllsckry7a"))
+	require.False(t, isSystemAccountAddress(erdConverter, "not an address"))
+	require.False(t, isSystemAccountAddress(nil, SystemAccountAddressBech))
+}
@@ -60,7 +60,7 @@ func NewAccountsGroupV_next(baseAccountsGroup data.GroupHandler, facadeHandler d
 // NewEndpoint is an example of a new endpoint added in the version v_next
 func (ag *accountsGroupV_next) NewEndpoint(c *gin.Context) {
 	res := ag.facade.NextEndpointHandler()
-	c.JSON(http.StatusOK, &data.GenericAPIResponse{
+	shared.RespondWithJSON(c, http.StatusOK, &data.GenericAPIResponse{
 		Data:  res,
 		Error: "",
 		Code:  data.ReturnCodeSuccess,
@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// AdminTimestampHeader defines the header that has to hold the unix timestamp of a signed admin request
+	AdminTimestampHeader = "X-Admin-Timestamp"
+	// AdminNonceHeader defines the header that has to hold the unique nonce of a signed admin request
+	AdminNonceHeader = "X-Admin-Nonce"
+	// AdminSignatureHeader defines the header that has to hold the hex encoded HMAC-SHA256 of a signed admin request
+	AdminSignatureHeader = "X-Admin-Signature"
+
+	maxAdminNonceLength = 128
+)
+
+type adminRequestVerifier struct {
+	secret         []byte
+	maxAge         time.Duration
+	usedNonces     map[string]time.Time
+	getTimeHandler func() time.Time
+	mutUsedNonces  sync.Mutex
+}
+
+// NewAdminRequestVerifier returns a new instance of adminRequestVerifier, checking the HMAC-SHA256 signatures of the
+// admin requests made with the provided shared secret and rejecting the ones older than the provided maximum age
+func NewAdminRequestVerifier(secret string, maxAge time.Duration) (*adminRequestVerifier, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptyAdminRequestSigningSecret
+	}
+	if maxAge <= 0 {
+		return nil, ErrInvalidAdminRequestMaxAge
+	}
+
+	return &adminRequestVerifier{
+		secret:         []byte(secret),
+		maxAge:         maxAge,
+		usedNonces:     make(map[string]time.Time),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the runtime mutating admin requests which are not
+// signed, are stale or replay an already used nonce. The read-only requests are not required to be signed
+func (arv *adminRequestVerifier) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			return
+		}
+
+		err := arv.verifyRequest(c.Request)
+		if err != nil {
+			shared.AbortWith(c, http.StatusUnauthorized, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+	}
+}
+
+func (arv *adminRequestVerifier) verifyRequest(request *http.Request) error {
+	timestampStr := request.Header.Get(AdminTimestampHeader)
+	nonce := request.Header.Get(AdminNonceHeader)
+	signatureHex := request.Header.Get(AdminSignatureHeader)
+	if len(timestampStr) == 0 || len(nonce) == 0 || len(signatureHex) == 0 {
+		return fmt.Errorf("this endpoint requires the %s, %s and %s headers", AdminTimestampHeader, AdminNonceHeader, AdminSignatureHeader)
+	}
+	if len(nonce) > maxAdminNonceLength {
+		return fmt.Errorf("the %s header is longer than %d characters", AdminNonceHeader, maxAdminNonceLength)
+	}
+
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", AdminTimestampHeader)
+	}
+	now := arv.getTimeHandler()
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > arv.maxAge || age < -arv.maxAge {
+		return fmt.Errorf("stale request, the %s header is outside the accepted window", AdminTimestampHeader)
+	}
+
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", AdminSignatureHeader)
+	}
+	body, err := readAndRestoreBody(request)
+	if err != nil {
+		return fmt.Errorf("cannot read the request body: %w", err)
+	}
+	if !hmac.Equal(signature, ComputeAdminRequestSignature(arv.secret, request.Method, request.URL.RequestURI(), timestampStr, nonce, body)) {
+		return fmt.Errorf("invalid %s header", AdminSignatureHeader)
+	}
+
+	return arv.markNonceUsed(nonce, now)
+}
+
+// markNonceUsed rejects the nonces used before within the accepted window. The nonces are kept for twice the maximum
+// age, as the timestamps are accepted on both sides of the current time
+func (arv *adminRequestVerifier) markNonceUsed(nonce string, now time.Time) error {
+	arv.mutUsedNonces.Lock()
+	defer arv.mutUsedNonces.Unlock()
+
+	for usedNonce, usedAt := range arv.usedNonces {
+		if now.Sub(usedAt) > 2*arv.maxAge {
+			delete(arv.usedNonces, usedNonce)
+		}
+	}
+
+	_, isUsed := arv.usedNonces[nonce]
+	if isUsed {
+		return fmt.Errorf("replayed request, the %s header was already used", AdminNonceHeader)
+	}
+
+	arv.usedNonces[nonce] = now
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (arv *adminRequestVerifier) IsInterfaceNil() bool {
+	return arv == nil
+}
+
+// ComputeAdminRequestSignature returns the HMAC-SHA256 of "<method>\n<request URI>\n<timestamp>\n<nonce>\n<body>"
+// computed with the shared secret, as expected in the X-Admin-Signature header (hex encoded)
+func ComputeAdminRequestSignature(secret []byte, method string, requestURI string, timestamp string, nonce string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(fmt.Sprintf("%s\n%s\n%s\n%s\n", method, requestURI, timestamp, nonce)))
+	_, _ = mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+func readAndRestoreBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAdminSecret = "secret"
+
+func startApiServerWithAdminRequestVerifier(arv *adminRequestVerifier) (*gin.Engine, *[]string) {
+	receivedBodies := make([]string, 0)
+	ws := gin.New()
+	ws.Use(arv.MiddlewareHandlerFunc())
+	ws.POST("/admin/observers", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		receivedBodies = append(receivedBodies, string(body))
+		c.JSON(http.StatusOK, nil)
+	})
+	ws.GET("/admin/read-only-mode", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws, &receivedBodies
+}
+
+func createSignedAdminRequest(secret string, timestamp int64, nonce string, body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "/admin/observers?shard=0", bytes.NewBufferString(body))
+	timestampStr := strconv.FormatInt(timestamp, 10)
+	signature := ComputeAdminRequestSignature([]byte(secret), http.MethodPost, "/admin/observers?shard=0", timestampStr, nonce, []byte(body))
+	req.Header.Set(AdminTimestampHeader, timestampStr)
+	req.Header.Set(AdminNonceHeader, nonce)
+	req.Header.Set(AdminSignatureHeader, hex.EncodeToString(signature))
+
+	return req
+}
+
+func doAdminRequest(ws *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewAdminRequestVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty secret should error", func(t *testing.T) {
+		t.Parallel()
+
+		arv, err := NewAdminRequestVerifier("", time.Minute)
+		assert.Nil(t, arv)
+		assert.Equal(t, ErrEmptyAdminRequestSigningSecret, err)
+	})
+	t.Run("invalid max age should error", func(t *testing.T) {
+		t.Parallel()
+
+		arv, err := NewAdminRequestVerifier(testAdminSecret, 0)
+		assert.Nil(t, arv)
+		assert.Equal(t, ErrInvalidAdminRequestMaxAge, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arv, err := NewAdminRequestVerifier(testAdminSecret, time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, arv.IsInterfaceNil())
+	})
+}
+
+func TestAdminRequestVerifier_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	createVerifier := func() *adminRequestVerifier {
+		arv, _ := NewAdminRequestVerifier(testAdminSecret, time.Minute)
+		arv.getTimeHandler = func() time.Time {
+			return now
+		}
+
+		return arv
+	}
+
+	t.Run("signed request should pass once, with its body", func(t *testing.T) {
+		t.Parallel()
+
+		ws, receivedBodies := startApiServerWithAdminRequestVerifier(createVerifier())
+
+		resp := doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, now.Unix()-30, "nonce0", `{"address":"observer"}`))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []string{`{"address":"observer"}`}, *receivedBodies)
+
+		resp = doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, now.Unix()-30, "nonce0", `{"address":"observer"}`))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), "replayed request"))
+		assert.Len(t, *receivedBodies, 1)
+	})
+	t.Run("read-only request should not require a signature", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startApiServerWithAdminRequestVerifier(createVerifier())
+
+		req, _ := http.NewRequest(http.MethodGet, "/admin/read-only-mode", nil)
+		resp := doAdminRequest(ws, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("missing headers should reject", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startApiServerWithAdminRequestVerifier(createVerifier())
+
+		req := createSignedAdminRequest(testAdminSecret, now.Unix(), "nonce0", "{}")
+		req.Header.Del(AdminNonceHeader)
+		resp := doAdminRequest(ws, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("stale or future timestamp should reject", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startApiServerWithAdminRequestVerifier(createVerifier())
+
+		resp := doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, now.Unix()-61, "nonce0", "{}"))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), "stale request"))
+
+		resp = doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, now.Unix()+61, "nonce1", "{}"))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("wrong secret or tampered body should reject", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := startApiServerWithAdminRequestVerifier(createVerifier())
+
+		resp := doAdminRequest(ws, createSignedAdminRequest("other secret", now.Unix(), "nonce0", "{}"))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		req := createSignedAdminRequest(testAdminSecret, now.Unix(), "nonce1", `{"address":"observer"}`)
+		req.Body = io.NopCloser(bytes.NewBufferString(`{"address":"other observer"}`))
+		resp = doAdminRequest(ws, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("nonce should be accepted again after the window", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := now
+		arv, _ := NewAdminRequestVerifier(testAdminSecret, time.Minute)
+		arv.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		ws, _ := startApiServerWithAdminRequestVerifier(arv)
+
+		resp := doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, currentTime.Unix(), "nonce0", "{}"))
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		currentTime = currentTime.Add(3 * time.Minute)
+		resp = doAdminRequest(ws, createSignedAdminRequest(testAdminSecret, currentTime.Unix(), "nonce0", "{}"))
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ApiKeyHeader defines the header that has to hold the API key on the requests made to the protected endpoints
+const ApiKeyHeader = "X-Api-Key"
+
+type apiKeyChecker struct {
+	apiKey []byte
+}
+
+// NewApiKeyChecker returns a new instance of apiKeyChecker
+func NewApiKeyChecker(apiKey string) *apiKeyChecker {
+	return &apiKeyChecker{
+		apiKey: []byte(apiKey),
+	}
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests not holding the configured API key
+func (akc *apiKeyChecker) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(akc.apiKey) == 0 {
+			shared.AbortWith(c, http.StatusInternalServerError, nil, "no API key found on server", data.ReturnCodeInternalError)
+			return
+		}
+
+		providedApiKey := c.GetHeader(ApiKeyHeader)
+		if len(providedApiKey) == 0 {
+			shared.AbortWith(c, http.StatusUnauthorized, nil, "this endpoint requires the "+ApiKeyHeader+" header", data.ReturnCodeRequestError)
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(providedApiKey), akc.apiKey) != 1 {
+			shared.AbortWith(c, http.StatusUnauthorized, nil, "invalid API key", data.ReturnCodeRequestError)
+			return
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akc *apiKeyChecker) IsInterfaceNil() bool {
+	return akc == nil
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func startApiServerWithApiKeyChecker(akc *apiKeyChecker) *gin.Engine {
+	ws := gin.New()
+	ws.Use(akc.MiddlewareHandlerFunc())
+	ws.GET("/admin/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+func TestNewApiKeyChecker(t *testing.T) {
+	t.Parallel()
+
+	akc := NewApiKeyChecker("key")
+	assert.False(t, check.IfNil(akc))
+}
+
+func TestApiKeyChecker_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	testRequest := func(configuredKey string, providedKey string, expectedCode int) {
+		ws := startApiServerWithApiKeyChecker(NewApiKeyChecker(configuredKey))
+
+		req, _ := http.NewRequest(http.MethodGet, "/admin/test", nil)
+		if providedKey != "" {
+			req.Header.Set(ApiKeyHeader, providedKey)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, expectedCode, resp.Code)
+	}
+
+	t.Run("no API key configured should reject", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest("", "key", http.StatusInternalServerError)
+	})
+	t.Run("missing header should reject", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest("key", "", http.StatusUnauthorized)
+	})
+	t.Run("wrong API key should reject", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest("key", "other key", http.StatusUnauthorized)
+	})
+	t.Run("correct API key should work", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest("key", "key", http.StatusOK)
+	})
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const cacheControlHeader = "Cache-Control"
+
+type cacheControl struct {
+	groupMaxAgeSec   uint64
+	routesMaxAgeSecs map[string]uint64
+}
+
+// NewCacheControl returns a new instance of cacheControl, built from the cache max age of an API package and of its
+// routes. The max age defined on a route replaces the one defined on its package
+func NewCacheControl(basePath string, packageConfig data.APIPackageConfig) *cacheControl {
+	routesMaxAgeSecs := make(map[string]uint64)
+	for _, route := range packageConfig.Routes {
+		if route.CacheMaxAgeSec > 0 {
+			routesMaxAgeSecs[basePath+route.Name] = route.CacheMaxAgeSec
+		}
+	}
+
+	return &cacheControl{
+		groupMaxAgeSec:   packageConfig.CacheMaxAgeSec,
+		routesMaxAgeSecs: routesMaxAgeSecs,
+	}
+}
+
+// HasRules returns true if a cache max age is set, either on the package or on one of its routes
+func (cc *cacheControl) HasRules() bool {
+	return cc.groupMaxAgeSec > 0 || len(cc.routesMaxAgeSecs) > 0
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that adds the Cache-Control header on the successful GET responses
+func (cc *cacheControl) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			return
+		}
+
+		maxAgeSec, found := cc.routesMaxAgeSecs[c.FullPath()]
+		if !found {
+			maxAgeSec = cc.groupMaxAgeSec
+		}
+		if maxAgeSec == 0 {
+			return
+		}
+
+		c.Writer = &cacheControlWriter{
+			ResponseWriter: c.Writer,
+			headerValue:    fmt.Sprintf("public, max-age=%d", maxAgeSec),
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *cacheControl) IsInterfaceNil() bool {
+	return cc == nil
+}
+
+// cacheControlWriter sets the Cache-Control header right before the response is written, so that only the
+// successful responses are cached
+type cacheControlWriter struct {
+	gin.ResponseWriter
+	headerValue string
+}
+
+// Write sets the Cache-Control header, if needed, and writes the data
+func (w *cacheControlWriter) Write(data []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString sets the Cache-Control header, if needed, and writes the string
+func (w *cacheControlWriter) WriteString(s string) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.WriteString(s)
+}
+
+// WriteHeaderNow sets the Cache-Control header, if needed, and writes the status code
+func (w *cacheControlWriter) WriteHeaderNow() {
+	w.setHeader()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *cacheControlWriter) setHeader() {
+	if w.Written() || w.Status() != http.StatusOK {
+		return
+	}
+
+	w.Header().Set(cacheControlHeader, w.headerValue)
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func startApiServerWithCacheControl(cc *cacheControl) *gin.Engine {
+	ws := gin.New()
+	group := ws.Group("/network")
+	group.Use(cc.MiddlewareHandlerFunc())
+	group.GET("/config", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	group.GET("/economics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	group.GET("/status/:shard", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, nil)
+	})
+	group.POST("/config", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+func doCacheControlRequest(ws *gin.Engine, method string, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewCacheControl(t *testing.T) {
+	t.Parallel()
+
+	cc := NewCacheControl("/network", data.APIPackageConfig{Routes: []data.RouteConfig{{Name: "/config"}}})
+	assert.False(t, cc.IsInterfaceNil())
+	assert.False(t, cc.HasRules())
+
+	cc = NewCacheControl("/network", data.APIPackageConfig{CacheMaxAgeSec: 10})
+	assert.True(t, cc.HasRules())
+
+	cc = NewCacheControl("/network", data.APIPackageConfig{Routes: []data.RouteConfig{{Name: "/config", CacheMaxAgeSec: 10}}})
+	assert.True(t, cc.HasRules())
+}
+
+func TestCacheControl_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("route max age should replace the package one", func(t *testing.T) {
+		t.Parallel()
+
+		cc := NewCacheControl("/network", data.APIPackageConfig{
+			CacheMaxAgeSec: 6,
+			Routes:         []data.RouteConfig{{Name: "/config", CacheMaxAgeSec: 60}},
+		})
+		ws := startApiServerWithCacheControl(cc)
+
+		resp := doCacheControlRequest(ws, http.MethodGet, "/network/config")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "public, max-age=60", resp.Header().Get(cacheControlHeader))
+
+		resp = doCacheControlRequest(ws, http.MethodGet, "/network/economics")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "public, max-age=6", resp.Header().Get(cacheControlHeader))
+	})
+	t.Run("route without max age should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		cc := NewCacheControl("/network", data.APIPackageConfig{
+			Routes: []data.RouteConfig{{Name: "/config", CacheMaxAgeSec: 60}},
+		})
+		ws := startApiServerWithCacheControl(cc)
+
+		resp := doCacheControlRequest(ws, http.MethodGet, "/network/economics")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(cacheControlHeader))
+	})
+	t.Run("failed response should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		cc := NewCacheControl("/network", data.APIPackageConfig{CacheMaxAgeSec: 6})
+		ws := startApiServerWithCacheControl(cc)
+
+		resp := doCacheControlRequest(ws, http.MethodGet, "/network/status/0")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Empty(t, resp.Header().Get(cacheControlHeader))
+	})
+	t.Run("non GET request should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		cc := NewCacheControl("/network", data.APIPackageConfig{CacheMaxAgeSec: 6})
+		ws := startApiServerWithCacheControl(cc)
+
+		resp := doCacheControlRequest(ws, http.MethodPost, "/network/config")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(cacheControlHeader))
+	})
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type clientsUsage struct {
+	recorder     ClientsUsageRecorder
+	apiKeyHeader string
+	apiKeys      map[string]struct{}
+}
+
+// NewClientsUsage returns a new instance of clientsUsage. Only the provided API keys are tracked separately
+func NewClientsUsage(recorder ClientsUsageRecorder, apiKeyHeader string, apiKeys []string) (*clientsUsage, error) {
+	if check.IfNil(recorder) {
+		return nil, ErrNilClientsUsageRecorder
+	}
+	if len(apiKeyHeader) == 0 {
+		return nil, ErrEmptyClientApiKeyHeader
+	}
+
+	apiKeysMap := make(map[string]struct{}, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if len(apiKey) == 0 || apiKey == data.AnonymousClientApiKey {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidClientApiKey, apiKey)
+		}
+		apiKeysMap[apiKey] = struct{}{}
+	}
+
+	return &clientsUsage{
+		recorder:     recorder,
+		apiKeyHeader: apiKeyHeader,
+		apiKeys:      apiKeysMap,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that records the served requests, along with the size of their
+// bodies and whether they were answered with an error. The requests without a configured client API key are recorded
+// in the anonymous usage, so that the clients can not fill the usage storage with made up keys
+func (cu *clientsUsage) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := c.GetHeader(cu.apiKeyHeader)
+		_, isKnown := cu.apiKeys[apiKey]
+		if !isKnown {
+			apiKey = data.AnonymousClientApiKey
+		}
+
+		c.Next()
+
+		bytesReceived := uint64(0)
+		if c.Request.ContentLength > 0 {
+			bytesReceived = uint64(c.Request.ContentLength)
+		}
+		bytesSent := uint64(0)
+		if c.Writer.Size() > 0 {
+			bytesSent = uint64(c.Writer.Size())
+		}
+		withError := c.Writer.Status() != http.StatusOK
+
+		cu.recorder.AddRequest(apiKey, withError, bytesReceived, bytesSent)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cu *clientsUsage) IsInterfaceNil() bool {
+	return cu == nil
+}
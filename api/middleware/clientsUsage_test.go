@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientApiKeyHeader = "X-Client-Api-Key"
+
+var testClientApiKeys = []string{"client-key"}
+
+type recordedClientRequest struct {
+	apiKey        string
+	withError     bool
+	bytesReceived uint64
+	bytesSent     uint64
+}
+
+type clientsUsageRecorderStub struct {
+	requests []recordedClientRequest
+}
+
+func (stub *clientsUsageRecorderStub) AddRequest(apiKey string, withError bool, bytesReceived uint64, bytesSent uint64) {
+	stub.requests = append(stub.requests, recordedClientRequest{
+		apiKey:        apiKey,
+		withError:     withError,
+		bytesReceived: bytesReceived,
+		bytesSent:     bytesSent,
+	})
+}
+
+func (stub *clientsUsageRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func doClientsUsageRequest(recorder *clientsUsageRecorderStub, apiKey string, body string, status int) {
+	cu, _ := NewClientsUsage(recorder, testClientApiKeyHeader, testClientApiKeys)
+
+	ws := gin.New()
+	ws.Use(cu.MiddlewareHandlerFunc())
+	ws.POST("/transaction/send", func(c *gin.Context) {
+		c.String(status, "response")
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/transaction/send", bytes.NewBufferString(body))
+	if len(apiKey) > 0 {
+		req.Header.Set(testClientApiKeyHeader, apiKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+}
+
+func TestNewClientsUsage(t *testing.T) {
+	t.Parallel()
+
+	cu, err := NewClientsUsage(nil, testClientApiKeyHeader, testClientApiKeys)
+	assert.Nil(t, cu)
+	assert.Equal(t, ErrNilClientsUsageRecorder, err)
+
+	cu, err = NewClientsUsage(&clientsUsageRecorderStub{}, "", testClientApiKeys)
+	assert.Nil(t, cu)
+	assert.Equal(t, ErrEmptyClientApiKeyHeader, err)
+
+	cu, err = NewClientsUsage(&clientsUsageRecorderStub{}, testClientApiKeyHeader, []string{""})
+	assert.Nil(t, cu)
+	assert.ErrorIs(t, err, ErrInvalidClientApiKey)
+
+	cu, err = NewClientsUsage(&clientsUsageRecorderStub{}, testClientApiKeyHeader, []string{data.AnonymousClientApiKey})
+	assert.Nil(t, cu)
+	assert.ErrorIs(t, err, ErrInvalidClientApiKey)
+
+	cu, err = NewClientsUsage(&clientsUsageRecorderStub{}, testClientApiKeyHeader, testClientApiKeys)
+	assert.NoError(t, err)
+	assert.False(t, cu.IsInterfaceNil())
+}
+
+func TestClientsUsage_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request without API key should be recorded as anonymous", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &clientsUsageRecorderStub{}
+		doClientsUsageRequest(recorder, "", "request", http.StatusOK)
+		require.Equal(t, []recordedClientRequest{{apiKey: data.AnonymousClientApiKey, bytesReceived: 7, bytesSent: 8}}, recorder.requests)
+	})
+	t.Run("request with an unknown API key should be recorded as anonymous", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &clientsUsageRecorderStub{}
+		doClientsUsageRequest(recorder, "made-up-key", "request", http.StatusOK)
+		require.Equal(t, []recordedClientRequest{{apiKey: data.AnonymousClientApiKey, bytesReceived: 7, bytesSent: 8}}, recorder.requests)
+	})
+	t.Run("successful request should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &clientsUsageRecorderStub{}
+		doClientsUsageRequest(recorder, "client-key", "request", http.StatusOK)
+		require.Equal(t, []recordedClientRequest{{apiKey: "client-key", bytesReceived: 7, bytesSent: 8}}, recorder.requests)
+	})
+	t.Run("failed request should be recorded with error", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &clientsUsageRecorderStub{}
+		doClientsUsageRequest(recorder, "client-key", "", http.StatusTooManyRequests)
+		require.Equal(t, []recordedClientRequest{{apiKey: "client-key", withError: true, bytesSent: 8}}, recorder.requests)
+	})
+}
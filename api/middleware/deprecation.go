@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// DeprecationHeader is the header marking the responses of the deprecated endpoints
+	DeprecationHeader = "Deprecation"
+	// SunsetHeader is the header holding the date after which a deprecated endpoint is removed
+	SunsetHeader = "Sunset"
+	// LinkHeader is the header pointing to the endpoint replacing a deprecated one
+	LinkHeader = "Link"
+
+	sunsetDateLayout = "2006-01-02"
+)
+
+type deprecatedRoute struct {
+	sunset      time.Time
+	replacement string
+}
+
+type deprecation struct {
+	recorder         DeprecatedRequestsRecorder
+	deprecatedRoutes map[string]*deprecatedRoute
+	getTime          func() time.Time
+}
+
+// NewDeprecation returns a new instance of deprecation, built from the API package's routes configured with
+// Deprecated = true or with a SunsetDate. The sunset date is either a date, such as 2026-06-30, or an RFC 3339 time
+func NewDeprecation(basePath string, packageConfig data.APIPackageConfig, recorder DeprecatedRequestsRecorder) (*deprecation, error) {
+	deprecatedRoutes := make(map[string]*deprecatedRoute)
+	for _, route := range packageConfig.Routes {
+		if !route.Deprecated && len(route.SunsetDate) == 0 {
+			continue
+		}
+
+		sunset, err := parseSunsetDate(route.SunsetDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w for route %s", err, route.Name)
+		}
+
+		deprecatedRoutes[basePath+route.Name] = &deprecatedRoute{
+			sunset:      sunset,
+			replacement: route.Replacement,
+		}
+	}
+
+	return &deprecation{
+		recorder:         recorder,
+		deprecatedRoutes: deprecatedRoutes,
+		getTime:          time.Now,
+	}, nil
+}
+
+func parseSunsetDate(sunsetDate string) (time.Time, error) {
+	if len(sunsetDate) == 0 {
+		return time.Time{}, nil
+	}
+
+	sunset, err := time.Parse(sunsetDateLayout, sunsetDate)
+	if err == nil {
+		return sunset, nil
+	}
+
+	sunset, err = time.Parse(time.RFC3339, sunsetDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidSunsetDate, sunsetDate)
+	}
+
+	return sunset, nil
+}
+
+// HasRules returns true if at least one of the package's routes is deprecated
+func (d *deprecation) HasRules() bool {
+	return len(d.deprecatedRoutes) > 0
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that marks the responses of the deprecated routes with the
+// Deprecation, Sunset and Link headers and rejects the requests with 410 once the route's sunset date has passed
+func (d *deprecation) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route, isDeprecated := d.deprecatedRoutes[c.FullPath()]
+		if !isDeprecated {
+			return
+		}
+
+		d.recorder.AddDeprecatedRequest(c.FullPath())
+
+		c.Header(DeprecationHeader, "true")
+		if len(route.replacement) > 0 {
+			c.Header(LinkHeader, fmt.Sprintf("<%s>; rel=\"successor-version\"", route.replacement))
+		}
+		if route.sunset.IsZero() {
+			return
+		}
+
+		c.Header(SunsetHeader, route.sunset.UTC().Format(http.TimeFormat))
+		if d.getTime().Before(route.sunset) {
+			return
+		}
+
+		errMessage := fmt.Sprintf("this endpoint was removed on %s", route.sunset.UTC().Format(time.RFC3339))
+		shared.AbortWith(c, http.StatusGone, gin.H{"replacement": route.replacement}, errMessage, data.ReturnCodeRequestError)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *deprecation) IsInterfaceNil() bool {
+	return d == nil
+}
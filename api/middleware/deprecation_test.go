@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startApiServerWithDeprecation(d *deprecation) *gin.Engine {
+	ws := gin.New()
+	group := ws.Group("/address")
+	group.Use(d.MiddlewareHandlerFunc())
+	group.GET("/:address", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	group.GET("/:address/nonce", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	group.GET("/:address/balance", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+func doDeprecationRequest(ws *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewDeprecation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid sunset date should error", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewDeprecation("/address", data.APIPackageConfig{
+			Routes: []data.RouteConfig{{Name: "/:address/nonce", Deprecated: true, SunsetDate: "30.06.2026"}},
+		}, &mock.StatusMetricsExporterStub{})
+		require.Nil(t, d)
+		require.True(t, errors.Is(err, ErrInvalidSunsetDate))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewDeprecation("/address", data.APIPackageConfig{Routes: []data.RouteConfig{{Name: "/:address"}}}, &mock.StatusMetricsExporterStub{})
+		require.NoError(t, err)
+		require.False(t, d.IsInterfaceNil())
+		require.False(t, d.HasRules())
+
+		d, err = NewDeprecation("/address", data.APIPackageConfig{
+			Routes: []data.RouteConfig{
+				{Name: "/:address/nonce", Deprecated: true},
+				{Name: "/:address/balance", SunsetDate: "2026-06-30T12:00:00Z"},
+			},
+		}, &mock.StatusMetricsExporterStub{})
+		require.NoError(t, err)
+		require.True(t, d.HasRules())
+	})
+}
+
+func TestDeprecation_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	packageConfig := data.APIPackageConfig{
+		Routes: []data.RouteConfig{
+			{Name: "/:address"},
+			{Name: "/:address/nonce", Deprecated: true, SunsetDate: "2026-06-30", Replacement: "/address/:address"},
+			{Name: "/:address/balance", Deprecated: true},
+		},
+	}
+	createDeprecation := func(now time.Time, deprecatedRequests *[]string) *deprecation {
+		d, _ := NewDeprecation("/address", packageConfig, &mock.StatusMetricsExporterStub{
+			AddDeprecatedRequestCalled: func(path string) {
+				*deprecatedRequests = append(*deprecatedRequests, path)
+			},
+		})
+		d.getTime = func() time.Time {
+			return now
+		}
+
+		return d
+	}
+
+	t.Run("not deprecated route should not carry headers", func(t *testing.T) {
+		t.Parallel()
+
+		var deprecatedRequests []string
+		ws := startApiServerWithDeprecation(createDeprecation(time.Now(), &deprecatedRequests))
+
+		resp := doDeprecationRequest(ws, "/address/erd1")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(DeprecationHeader))
+		assert.Empty(t, deprecatedRequests)
+	})
+	t.Run("deprecated route without sunset should only carry the deprecation header", func(t *testing.T) {
+		t.Parallel()
+
+		var deprecatedRequests []string
+		ws := startApiServerWithDeprecation(createDeprecation(time.Now(), &deprecatedRequests))
+
+		resp := doDeprecationRequest(ws, "/address/erd1/balance")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "true", resp.Header().Get(DeprecationHeader))
+		assert.Empty(t, resp.Header().Get(SunsetHeader))
+		assert.Empty(t, resp.Header().Get(LinkHeader))
+		assert.Equal(t, []string{"/address/:address/balance"}, deprecatedRequests)
+	})
+	t.Run("deprecated route before sunset should carry the sunset and link headers", func(t *testing.T) {
+		t.Parallel()
+
+		var deprecatedRequests []string
+		ws := startApiServerWithDeprecation(createDeprecation(time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC), &deprecatedRequests))
+
+		resp := doDeprecationRequest(ws, "/address/erd1/nonce")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "true", resp.Header().Get(DeprecationHeader))
+		assert.Equal(t, "Tue, 30 Jun 2026 00:00:00 GMT", resp.Header().Get(SunsetHeader))
+		assert.Equal(t, `</address/:address>; rel="successor-version"`, resp.Header().Get(LinkHeader))
+		assert.Equal(t, []string{"/address/:address/nonce"}, deprecatedRequests)
+	})
+	t.Run("deprecated route after sunset should return gone", func(t *testing.T) {
+		t.Parallel()
+
+		var deprecatedRequests []string
+		ws := startApiServerWithDeprecation(createDeprecation(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), &deprecatedRequests))
+
+		resp := doDeprecationRequest(ws, "/address/erd1/nonce")
+		assert.Equal(t, http.StatusGone, resp.Code)
+		assert.Contains(t, resp.Body.String(), `"replacement":"/address/:address"`)
+		assert.Contains(t, resp.Body.String(), "this endpoint was removed on 2026-06-30T00:00:00Z")
+		assert.Equal(t, []string{"/address/:address/nonce"}, deprecatedRequests)
+	})
+}
@@ -7,3 +7,53 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrInvalidCIDR signals that an invalid CIDR or IP has been provided
+var ErrInvalidCIDR = errors.New("invalid CIDR")
+
+// ErrInvalidMaxInFlightRequests signals that an invalid maximum number of in-flight requests has been provided
+var ErrInvalidMaxInFlightRequests = errors.New("invalid maximum number of in-flight requests")
+
+// ErrInvalidReservedPriorityRequests signals that an invalid number of slots reserved for the priority requests has
+// been provided
+var ErrInvalidReservedPriorityRequests = errors.New("invalid number of reserved priority requests")
+
+// ErrInvalidMaxQueuedRequests signals that an invalid maximum number of queued requests has been provided
+var ErrInvalidMaxQueuedRequests = errors.New("invalid maximum number of queued requests")
+
+// ErrInvalidMaxLowPriorityRequests signals that an invalid maximum number of in-flight low priority requests has been
+// provided
+var ErrInvalidMaxLowPriorityRequests = errors.New("invalid maximum number of low priority requests")
+
+// ErrInvalidPanicReportingDSN signals that an invalid DSN of the panic reporting service has been provided
+var ErrInvalidPanicReportingDSN = errors.New("invalid panic reporting DSN")
+
+// ErrInvalidPanicReportingTimeout signals that an invalid timeout of the panic reporting requests has been provided
+var ErrInvalidPanicReportingTimeout = errors.New("invalid panic reporting request timeout")
+
+// ErrInvalidMaxPendingPanicReports signals that an invalid maximum number of panic reports sent at once has been provided
+var ErrInvalidMaxPendingPanicReports = errors.New("invalid maximum number of pending panic reports")
+
+// ErrInvalidSunsetDate signals that an invalid sunset date of a deprecated route has been provided
+var ErrInvalidSunsetDate = errors.New("invalid sunset date")
+
+// ErrUnknownFeature signals that a flag was provided for an unknown feature
+var ErrUnknownFeature = errors.New("unknown feature")
+
+// ErrNilReorgNotifier signals that a nil reorg notifier has been provided
+var ErrNilReorgNotifier = errors.New("nil reorg notifier")
+
+// ErrEmptyAdminRequestSigningSecret signals that an empty secret for signing the admin requests has been provided
+var ErrEmptyAdminRequestSigningSecret = errors.New("empty admin request signing secret")
+
+// ErrInvalidAdminRequestMaxAge signals that an invalid maximum age of the signed admin requests has been provided
+var ErrInvalidAdminRequestMaxAge = errors.New("invalid admin request maximum age")
+
+// ErrNilClientsUsageRecorder signals that a nil clients usage recorder has been provided
+var ErrNilClientsUsageRecorder = errors.New("nil clients usage recorder")
+
+// ErrEmptyClientApiKeyHeader signals that an empty client API key header has been provided
+var ErrEmptyClientApiKeyHeader = errors.New("empty client API key header")
+
+// ErrInvalidClientApiKey signals that an invalid client API key has been configured
+var ErrInvalidClientApiKey = errors.New("invalid client API key")
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// FeaturePool is the flag of the transactions pool endpoints
+	FeaturePool = "pool"
+	// FeatureFaucet is the flag of the faucet endpoints
+	FeatureFaucet = "faucet"
+	// FeatureAdmin is the flag of the admin endpoints
+	FeatureAdmin = "admin"
+	// FeatureGraphQL is the flag of the GraphQL endpoint
+	FeatureGraphQL = "graphql"
+)
+
+// featureScope holds the endpoints covered by a feature: whole groups, which are not registered at all when the
+// feature is disabled, and routes of other groups, which are rejected at request time
+type featureScope struct {
+	groups []string
+	routes map[string][]string
+}
+
+var featuresScopes = map[string]featureScope{
+	FeaturePool: {
+		routes: map[string][]string{"/transaction": {"/pool"}},
+	},
+	FeatureFaucet: {
+		groups: []string{"/faucet"},
+		routes: map[string][]string{"/transaction": {"/send-user-funds"}},
+	},
+	FeatureAdmin: {
+		groups: []string{"/admin"},
+	},
+	FeatureGraphQL: {
+		groups: []string{"/graphql"},
+	},
+}
+
+type disabledRoute struct {
+	routePrefix string
+	feature     string
+}
+
+type featureFlags struct {
+	disabledGroups map[string]string
+	disabledRoutes map[string][]disabledRoute
+}
+
+// NewFeatureFlags returns a new instance of featureFlags, built from the flags of the optional endpoints. The features
+// missing from the provided flags are enabled
+func NewFeatureFlags(flags map[string]bool) (*featureFlags, error) {
+	ff := &featureFlags{
+		disabledGroups: make(map[string]string),
+		disabledRoutes: make(map[string][]disabledRoute),
+	}
+
+	for name, isEnabled := range flags {
+		feature := strings.ToLower(name)
+		scope, found := featuresScopes[feature]
+		if !found {
+			return nil, fmt.Errorf("%w: %s, the known ones are %s", ErrUnknownFeature, name, strings.Join(getKnownFeatures(), ", "))
+		}
+		if isEnabled {
+			continue
+		}
+
+		for _, group := range scope.groups {
+			ff.disabledGroups[group] = feature
+		}
+		for group, routePrefixes := range scope.routes {
+			for _, routePrefix := range routePrefixes {
+				ff.disabledRoutes[group] = append(ff.disabledRoutes[group], disabledRoute{
+					routePrefix: routePrefix,
+					feature:     feature,
+				})
+			}
+		}
+	}
+
+	return ff, nil
+}
+
+func getKnownFeatures() []string {
+	features := make([]string, 0, len(featuresScopes))
+	for feature := range featuresScopes {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+
+	return features
+}
+
+// IsGroupEnabled returns false if the group at the given path belongs to a disabled feature, so it should not be
+// registered
+func (ff *featureFlags) IsGroupEnabled(groupPath string) bool {
+	_, isDisabled := ff.disabledGroups[groupPath]
+	return !isDisabled
+}
+
+// HasDisabledRoutes returns true if some routes of the group at the given path belong to a disabled feature
+func (ff *featureFlags) HasDisabledRoutes(groupPath string) bool {
+	return len(ff.disabledRoutes[groupPath]) > 0
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects with 501 the requests of the group's routes which belong
+// to a disabled feature. The base path is the one the group is registered on, such as /v1.0/transaction
+func (ff *featureFlags) MiddlewareHandlerFunc(basePath string, groupPath string) gin.HandlerFunc {
+	disabledRoutes := ff.disabledRoutes[groupPath]
+
+	return func(c *gin.Context) {
+		for _, route := range disabledRoutes {
+			if !isRouteCovered(c.FullPath(), basePath+route.routePrefix) {
+				continue
+			}
+
+			shared.AbortWith(c, http.StatusNotImplemented, nil, fmt.Sprintf("the %s feature is disabled on this proxy", route.feature), data.ReturnCodeRequestError)
+			return
+		}
+	}
+}
+
+func isRouteCovered(fullPath string, routePrefix string) bool {
+	return fullPath == routePrefix || strings.HasPrefix(fullPath, routePrefix+"/")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ff *featureFlags) IsInterfaceNil() bool {
+	return ff == nil
+}
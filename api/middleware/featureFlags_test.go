@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown feature should error", func(t *testing.T) {
+		t.Parallel()
+
+		ff, err := NewFeatureFlags(map[string]bool{"pool": true, "rosetta": false})
+		assert.Nil(t, ff)
+		assert.True(t, errors.Is(err, ErrUnknownFeature))
+		assert.Contains(t, err.Error(), "rosetta")
+	})
+	t.Run("no flags should enable everything", func(t *testing.T) {
+		t.Parallel()
+
+		ff, err := NewFeatureFlags(nil)
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(ff))
+		assert.True(t, ff.IsGroupEnabled("/admin"))
+		assert.True(t, ff.IsGroupEnabled("/faucet"))
+		assert.False(t, ff.HasDisabledRoutes("/transaction"))
+	})
+	t.Run("disabled features should disable their groups and routes", func(t *testing.T) {
+		t.Parallel()
+
+		ff, err := NewFeatureFlags(map[string]bool{"Faucet": false, "admin": false, "graphql": true})
+		require.Nil(t, err)
+		assert.False(t, ff.IsGroupEnabled("/faucet"))
+		assert.False(t, ff.IsGroupEnabled("/admin"))
+		assert.True(t, ff.IsGroupEnabled("/graphql"))
+		assert.True(t, ff.IsGroupEnabled("/transaction"))
+		assert.True(t, ff.HasDisabledRoutes("/transaction"))
+		assert.False(t, ff.HasDisabledRoutes("/address"))
+	})
+}
+
+func TestFeatureFlags_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	ff, err := NewFeatureFlags(map[string]bool{"pool": false, "faucet": true})
+	require.Nil(t, err)
+
+	ws := gin.New()
+	group := ws.Group("/v1.0/transaction")
+	group.Use(ff.MiddlewareHandlerFunc(group.BasePath(), "/transaction"))
+	for _, path := range []string{"/pool", "/pool/by-senders", "/send-user-funds", "/:txhash"} {
+		group.GET(path, func(c *gin.Context) {
+			c.JSON(http.StatusOK, nil)
+		})
+	}
+
+	testCases := map[string]int{
+		"/v1.0/transaction/pool":            http.StatusNotImplemented,
+		"/v1.0/transaction/pool/by-senders": http.StatusNotImplemented,
+		"/v1.0/transaction/send-user-funds": http.StatusOK,
+		"/v1.0/transaction/pool-hash":       http.StatusOK,
+	}
+	for path, expectedStatus := range testCases {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, expectedStatus, resp.Code, path)
+		if expectedStatus == http.StatusNotImplemented {
+			assert.Contains(t, resp.Body.String(), "the pool feature is disabled on this proxy")
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+type forwardedHeadersMiddleware struct {
+	headerNames []string
+}
+
+// NewForwardedHeadersMiddleware returns a new instance of forwardedHeadersMiddleware
+func NewForwardedHeadersMiddleware(headerNames []string) *forwardedHeadersMiddleware {
+	return &forwardedHeadersMiddleware{
+		headerNames: headerNames,
+	}
+}
+
+// MiddlewareHandlerFunc binds the allowed headers of the client request, if present, to the context of the request
+// being served, so they are forwarded to the observers called while serving it
+func (fhm *forwardedHeadersMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		headers := make(http.Header)
+		for _, name := range fhm.headerNames {
+			for _, value := range c.Request.Header.Values(name) {
+				headers.Add(name, value)
+			}
+		}
+		c.Request = c.Request.WithContext(common.ContextWithForwardedHeaders(c.Request.Context(), headers))
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fhm *forwardedHeadersMiddleware) IsInterfaceNil() bool {
+	return fhm == nil
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewForwardedHeadersMiddleware(t *testing.T) {
+	t.Parallel()
+
+	fhm := NewForwardedHeadersMiddleware([]string{"X-Tenant-ID"})
+	assert.False(t, check.IfNil(fhm))
+}
+
+func TestForwardedHeadersMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	servedHeaders := make([]http.Header, 0)
+	ws := gin.New()
+	ws.Use(NewRequestIDMiddleware().MiddlewareHandlerFunc())
+	ws.Use(NewForwardedHeadersMiddleware([]string{"X-Tenant-ID", "X-Client-Token"}).MiddlewareHandlerFunc())
+	ws.GET("/test", func(c *gin.Context) {
+		servedHeaders = append(servedHeaders, common.GetForwardedHeaders(c.Request.Context()))
+		c.JSON(http.StatusOK, nil)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-Tenant-ID", "tenant")
+	req.Header.Set("X-Other", "not forwarded")
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+
+	req, _ = http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-Other", "not forwarded")
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, []http.Header{
+		{"X-Tenant-Id": []string{"tenant"}},
+		nil,
+	}, servedHeaders)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
@@ -15,6 +16,52 @@ type RateLimiterHandler interface {
 // StatusMetricsExtractor defines what a status metrics extractor should do
 type StatusMetricsExtractor interface {
 	AddRequestData(path string, withError bool, duration time.Duration)
+	AddDeprecatedRequest(path string)
+	IsInterfaceNil() bool
+}
+
+// DeprecatedRequestsRecorder defines what a component counting the requests of the deprecated routes should do
+type DeprecatedRequestsRecorder interface {
+	AddDeprecatedRequest(path string)
+	IsInterfaceNil() bool
+}
+
+// ResponseSigner defines what a signer of the API responses should do
+type ResponseSigner interface {
+	SignResponse(timestamp int64, method string, requestURI string, body []byte) ([]byte, error)
+	SignerID() string
+	IsInterfaceNil() bool
+}
+
+// LoadShedder defines what a component capping the number of requests served at once should do
+type LoadShedder interface {
+	GroupHandlerFunc(basePath string, packageConfig data.APIPackageConfig) gin.HandlerFunc
+	IsInterfaceNil() bool
+}
+
+// FeatureFlags defines what a component enabling the optional endpoints should do
+type FeatureFlags interface {
+	IsGroupEnabled(groupPath string) bool
+	HasDisabledRoutes(groupPath string) bool
+	MiddlewareHandlerFunc(basePath string, groupPath string) gin.HandlerFunc
+	IsInterfaceNil() bool
+}
+
+// PanicReporter defines what a component sending the recovered panics to an error tracking service should do
+type PanicReporter interface {
+	ReportPanic(report *PanicReport)
+	IsInterfaceNil() bool
+}
+
+// ReorgNotifier defines what a component reporting the recently detected chain reorganizations should do
+type ReorgNotifier interface {
+	GetRecentReorgs() []*data.ChainReorg
+	IsInterfaceNil() bool
+}
+
+// ClientsUsageRecorder defines what a component recording the requests made by each client should do
+type ClientsUsageRecorder interface {
+	AddRequest(apiKey string, withError bool, bytesReceived uint64, bytesSent uint64)
 	IsInterfaceNil() bool
 }
 
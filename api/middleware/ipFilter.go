@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type ipRules struct {
+	allowed []*net.IPNet
+	denied  []*net.IPNet
+}
+
+type ipFilter struct {
+	groupRules  *ipRules
+	routesRules map[string]*ipRules
+}
+
+// NewIPFilter returns a new instance of ipFilter, built from the allowed and denied networks of an API package.
+// The rules defined on a route replace the ones defined on its package
+func NewIPFilter(basePath string, packageConfig data.APIPackageConfig) (*ipFilter, error) {
+	groupRules, err := newIPRules(packageConfig.AllowedCIDRs, packageConfig.DeniedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	routesRules := make(map[string]*ipRules)
+	for _, route := range packageConfig.Routes {
+		rules, errRoute := newIPRules(route.AllowedCIDRs, route.DeniedCIDRs)
+		if errRoute != nil {
+			return nil, fmt.Errorf("%w for route %s", errRoute, route.Name)
+		}
+		if rules != nil {
+			routesRules[basePath+route.Name] = rules
+		}
+	}
+
+	return &ipFilter{
+		groupRules:  groupRules,
+		routesRules: routesRules,
+	}, nil
+}
+
+func newIPRules(allowedCIDRs []string, deniedCIDRs []string) (*ipRules, error) {
+	if len(allowedCIDRs) == 0 && len(deniedCIDRs) == 0 {
+		return nil, nil
+	}
+
+	allowed, err := parseCIDRs(allowedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	denied, err := parseCIDRs(deniedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ipRules{
+		allowed: allowed,
+		denied:  denied,
+	}, nil
+}
+
+// parseCIDRs accepts both networks in CIDR notation and plain IPs
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidCIDR, cidr)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCIDR, cidr)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// HasRules returns true if at least one network is allowed or denied, either on the package or on one of its routes
+func (ipf *ipFilter) HasRules() bool {
+	return ipf.groupRules != nil || len(ipf.routesRules) > 0
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests coming from IPs that are not allowed
+func (ipf *ipFilter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rules, found := ipf.routesRules[c.FullPath()]
+		if !found {
+			rules = ipf.groupRules
+		}
+
+		if rules.isAllowed(net.ParseIP(c.ClientIP())) {
+			return
+		}
+
+		shared.AbortWith(c, http.StatusForbidden, nil, "your IP is not allowed to access this endpoint", data.ReturnCodeRequestError)
+	}
+}
+
+// isAllowed returns false if the IP is denied or if there are allowed networks and none of them contains the IP
+func (rules *ipRules) isAllowed(ip net.IP) bool {
+	if rules == nil {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	if containsIP(rules.denied, ip) {
+		return false
+	}
+
+	return len(rules.allowed) == 0 || containsIP(rules.allowed, ip)
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ipf *ipFilter) IsInterfaceNil() bool {
+	return ipf == nil
+}
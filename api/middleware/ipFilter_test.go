@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startApiServerWithIPFilter(ipf *ipFilter) *gin.Engine {
+	ws := gin.New()
+	group := ws.Group("/transaction")
+	group.Use(ipf.MiddlewareHandlerFunc())
+	group.POST("/send", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	group.GET("/:txhash", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+func TestNewIPFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid package CIDR should error", func(t *testing.T) {
+		t.Parallel()
+
+		ipf, err := NewIPFilter("/transaction", data.APIPackageConfig{AllowedCIDRs: []string{"10.0.0.0/33"}})
+		assert.True(t, check.IfNil(ipf))
+		assert.True(t, errors.Is(err, ErrInvalidCIDR))
+	})
+	t.Run("invalid route IP should error", func(t *testing.T) {
+		t.Parallel()
+
+		packageConfig := data.APIPackageConfig{
+			Routes: []data.RouteConfig{{Name: "/send", DeniedCIDRs: []string{"not an ip"}}},
+		}
+		ipf, err := NewIPFilter("/transaction", packageConfig)
+		assert.True(t, check.IfNil(ipf))
+		assert.True(t, errors.Is(err, ErrInvalidCIDR))
+		assert.Contains(t, err.Error(), "/send")
+	})
+	t.Run("no rules should work", func(t *testing.T) {
+		t.Parallel()
+
+		ipf, err := NewIPFilter("/transaction", data.APIPackageConfig{Routes: []data.RouteConfig{{Name: "/send"}}})
+		require.NoError(t, err)
+		assert.False(t, ipf.HasRules())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ipf, err := NewIPFilter("/transaction", data.APIPackageConfig{DeniedCIDRs: []string{"10.0.0.1", "::1"}})
+		require.NoError(t, err)
+		assert.True(t, ipf.HasRules())
+	})
+}
+
+func TestIpFilter_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	packageConfig := data.APIPackageConfig{
+		DeniedCIDRs: []string{"10.0.0.5"},
+		Routes: []data.RouteConfig{
+			{Name: "/send", AllowedCIDRs: []string{"192.168.0.0/16"}, DeniedCIDRs: []string{"192.168.1.1"}},
+			{Name: "/:txhash"},
+		},
+	}
+	ipf, err := NewIPFilter("/transaction", packageConfig)
+	require.NoError(t, err)
+	ws := startApiServerWithIPFilter(ipf)
+
+	testRequest := func(method string, path string, remoteAddr string, expectedCode int) {
+		req, _ := http.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, expectedCode, resp.Code)
+	}
+
+	t.Run("IP from allowed network should pass", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodPost, "/transaction/send", "192.168.2.3:1000", http.StatusOK)
+	})
+	t.Run("IP outside the allowed networks should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodPost, "/transaction/send", "10.0.0.1:1000", http.StatusForbidden)
+	})
+	t.Run("denied IP should be rejected even if allowed", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodPost, "/transaction/send", "192.168.1.1:1000", http.StatusForbidden)
+	})
+	t.Run("route without rules should use the package rules", func(t *testing.T) {
+		t.Parallel()
+
+		testRequest(http.MethodGet, "/transaction/hash", "10.0.0.1:1000", http.StatusOK)
+		testRequest(http.MethodGet, "/transaction/hash", "10.0.0.5:1000", http.StatusForbidden)
+	})
+}
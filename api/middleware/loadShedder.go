@@ -0,0 +1,273 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// LoadClassPriority marks the routes which can use the slots reserved for them, such as the transaction sending ones
+	LoadClassPriority = "priority"
+	// LoadClassHeavy marks the expensive routes, which are rejected right away instead of being queued when the proxy
+	// is at capacity
+	LoadClassHeavy = "heavy"
+	// LoadClassStreaming marks the long-lived routes, such as the server-sent events ones, which are not shed, as they
+	// would hold a slot for as long as the client stays connected. They are capped by their own subscribers limit
+	LoadClassStreaming = "streaming"
+
+	// PriorityHeader is the header through which the clients can set the priority of their requests under load, to one
+	// of the low, normal or high values
+	PriorityHeader = "X-Priority"
+
+	priorityLowValue  = "low"
+	priorityHighValue = "high"
+
+	retryAfterHeader   = "Retry-After"
+	overloadedErrorMsg = "the proxy is overloaded, please retry later"
+)
+
+type requestPriority int
+
+const (
+	priorityLow requestPriority = iota
+	priorityNormal
+	priorityHigh
+)
+
+// ArgsLoadShedder holds the arguments needed to create a load shedder
+type ArgsLoadShedder struct {
+	MaxInFlightRequests      int
+	ReservedPriorityRequests int
+	MaxQueuedRequests        int
+	MaxLowPriorityRequests   int
+	AllowHighPriorityHeader  bool
+	QueueTimeout             time.Duration
+	RetryAfter               time.Duration
+}
+
+type loadShedder struct {
+	slots                   chan struct{}
+	prioritySlots           chan struct{}
+	lowPrioritySlots        chan struct{}
+	allowHighPriorityHeader bool
+	numQueued               int64
+	maxQueued               int64
+	queueTimeout            time.Duration
+	retryAfterSec           string
+}
+
+// NewLoadShedder returns a new instance of loadShedder, capping the number of requests served at once by all the API
+// groups it is applied on. The requests exceeding the cap wait in a bounded queue and are rejected with 503 once the
+// queue is full or their wait times out
+func NewLoadShedder(args ArgsLoadShedder) (*loadShedder, error) {
+	if args.MaxInFlightRequests <= 0 {
+		return nil, ErrInvalidMaxInFlightRequests
+	}
+	if args.ReservedPriorityRequests < 0 {
+		return nil, ErrInvalidReservedPriorityRequests
+	}
+	if args.MaxQueuedRequests < 0 {
+		return nil, ErrInvalidMaxQueuedRequests
+	}
+	if args.MaxLowPriorityRequests < 0 {
+		return nil, ErrInvalidMaxLowPriorityRequests
+	}
+
+	var prioritySlots chan struct{}
+	if args.ReservedPriorityRequests > 0 {
+		prioritySlots = make(chan struct{}, args.ReservedPriorityRequests)
+	}
+
+	// the low priority requests are not capped separately if no limit is configured
+	var lowPrioritySlots chan struct{}
+	if args.MaxLowPriorityRequests > 0 {
+		lowPrioritySlots = make(chan struct{}, args.MaxLowPriorityRequests)
+	}
+
+	retryAfterSec := int64(args.RetryAfter.Seconds())
+	if retryAfterSec < 1 {
+		retryAfterSec = 1
+	}
+
+	return &loadShedder{
+		slots:                   make(chan struct{}, args.MaxInFlightRequests),
+		prioritySlots:           prioritySlots,
+		lowPrioritySlots:        lowPrioritySlots,
+		allowHighPriorityHeader: args.AllowHighPriorityHeader,
+		maxQueued:               int64(args.MaxQueuedRequests),
+		queueTimeout:            args.QueueTimeout,
+		retryAfterSec:           strconv.FormatInt(retryAfterSec, 10),
+	}, nil
+}
+
+// GroupHandlerFunc returns the gin middleware applying the load shedding on the routes of an API package, each route
+// being handled according to its LoadClass and each request according to its X-Priority header
+func (ls *loadShedder) GroupHandlerFunc(basePath string, packageConfig data.APIPackageConfig) gin.HandlerFunc {
+	routesLoadClasses := make(map[string]string)
+	for _, route := range packageConfig.Routes {
+		if len(route.LoadClass) > 0 {
+			routesLoadClasses[basePath+route.Name] = route.LoadClass
+		}
+	}
+
+	return func(c *gin.Context) {
+		loadClass := routesLoadClasses[c.FullPath()]
+		if loadClass == LoadClassStreaming {
+			c.Next()
+			return
+		}
+
+		priority := ls.getRequestPriority(c.GetHeader(PriorityHeader), loadClass)
+		release, acquired := ls.acquire(c.Request.Context(), loadClass, priority)
+		if !acquired {
+			c.Header(retryAfterHeader, ls.retryAfterSec)
+			shared.AbortWith(c, http.StatusServiceUnavailable, nil, overloadedErrorMsg, data.ReturnCodeSystemBusy)
+			return
+		}
+
+		defer release()
+		c.Next()
+	}
+}
+
+// getRequestPriority returns the priority requested by the client, if any, defaulting to the one of the route. Any
+// client can lower the priority of its requests, while raising it to high is only allowed if configured so, as the
+// high priority requests can use the reserved slots
+func (ls *loadShedder) getRequestPriority(priorityHeader string, loadClass string) requestPriority {
+	switch strings.ToLower(strings.TrimSpace(priorityHeader)) {
+	case priorityLowValue:
+		return priorityLow
+	case priorityHighValue:
+		if ls.allowHighPriorityHeader {
+			return priorityHigh
+		}
+	}
+
+	if loadClass == LoadClassPriority {
+		return priorityHigh
+	}
+
+	return priorityNormal
+}
+
+// acquire takes a free slot for the request, waiting in the queue if the request's class allows it. The high priority
+// requests can also take the reserved slots, the low priority ones must also take one of the slots they are capped
+// to, while the heavy ones are never queued
+func (ls *loadShedder) acquire(ctx context.Context, loadClass string, priority requestPriority) (func(), bool) {
+	isPriority := priority == priorityHigh
+	// the capped slots channel is nil for the requests which are not low priority, so they are not limited by it
+	var lowPrioritySlots chan struct{}
+	if priority == priorityLow {
+		lowPrioritySlots = ls.lowPrioritySlots
+	}
+
+	hasLowPrioritySlot := lowPrioritySlots == nil
+	if !hasLowPrioritySlot {
+		select {
+		case lowPrioritySlots <- struct{}{}:
+			hasLowPrioritySlot = true
+		default:
+		}
+	}
+	if hasLowPrioritySlot {
+		release, acquired := ls.tryAcquireSlot(isPriority)
+		if acquired {
+			return ls.combineReleaseFuncs(release, lowPrioritySlots), true
+		}
+	}
+	if loadClass == LoadClassHeavy {
+		ls.releaseLowPrioritySlot(lowPrioritySlots, hasLowPrioritySlot)
+		return nil, false
+	}
+
+	if atomic.AddInt64(&ls.numQueued, 1) > ls.maxQueued {
+		atomic.AddInt64(&ls.numQueued, -1)
+		ls.releaseLowPrioritySlot(lowPrioritySlots, hasLowPrioritySlot)
+		return nil, false
+	}
+	defer atomic.AddInt64(&ls.numQueued, -1)
+
+	timer := time.NewTimer(ls.queueTimeout)
+	defer timer.Stop()
+
+	if !hasLowPrioritySlot {
+		select {
+		case lowPrioritySlots <- struct{}{}:
+		case <-timer.C:
+			return nil, false
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+
+	// the reserved slots channel is nil for the requests without priority, so they only wait for the shared slots
+	prioritySlots := ls.prioritySlots
+	if !isPriority {
+		prioritySlots = nil
+	}
+
+	select {
+	case ls.slots <- struct{}{}:
+		return ls.combineReleaseFuncs(ls.releaseFunc(ls.slots), lowPrioritySlots), true
+	case prioritySlots <- struct{}{}:
+		return ls.combineReleaseFuncs(ls.releaseFunc(prioritySlots), lowPrioritySlots), true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	ls.releaseLowPrioritySlot(lowPrioritySlots, true)
+	return nil, false
+}
+
+func (ls *loadShedder) tryAcquireSlot(isPriority bool) (func(), bool) {
+	select {
+	case ls.slots <- struct{}{}:
+		return ls.releaseFunc(ls.slots), true
+	default:
+	}
+	if isPriority {
+		select {
+		case ls.prioritySlots <- struct{}{}:
+			return ls.releaseFunc(ls.prioritySlots), true
+		default:
+		}
+	}
+
+	return nil, false
+}
+
+func (ls *loadShedder) combineReleaseFuncs(release func(), lowPrioritySlots chan struct{}) func() {
+	if lowPrioritySlots == nil {
+		return release
+	}
+
+	return func() {
+		release()
+		<-lowPrioritySlots
+	}
+}
+
+func (ls *loadShedder) releaseLowPrioritySlot(lowPrioritySlots chan struct{}, hasLowPrioritySlot bool) {
+	if lowPrioritySlots != nil && hasLowPrioritySlot {
+		<-lowPrioritySlots
+	}
+}
+
+func (ls *loadShedder) releaseFunc(slots chan struct{}) func() {
+	return func() {
+		<-slots
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ls *loadShedder) IsInterfaceNil() bool {
+	return ls == nil
+}
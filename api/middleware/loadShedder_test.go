@@ -0,0 +1,333 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestLoadShedderArgs() ArgsLoadShedder {
+	return ArgsLoadShedder{
+		MaxInFlightRequests:      1,
+		ReservedPriorityRequests: 1,
+		MaxQueuedRequests:        1,
+		QueueTimeout:             50 * time.Millisecond,
+		RetryAfter:               2 * time.Second,
+	}
+}
+
+// startApiServerWithLoadShedder returns a server whose /tx/block route blocks until the release channel is closed
+func startApiServerWithLoadShedder(ls *loadShedder, release chan struct{}) *gin.Engine {
+	packageConfig := data.APIPackageConfig{
+		Routes: []data.RouteConfig{
+			{Name: "/send", LoadClass: LoadClassPriority},
+			{Name: "/pool", LoadClass: LoadClassHeavy},
+			{Name: "/stream", LoadClass: LoadClassStreaming},
+		},
+	}
+
+	ws := gin.New()
+	group := ws.Group("/tx")
+	group.Use(ls.GroupHandlerFunc("/tx", packageConfig))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	}
+	group.GET("/block", func(c *gin.Context) {
+		<-release
+		c.JSON(http.StatusOK, gin.H{})
+	})
+	group.POST("/send", handler)
+	group.GET("/pool", handler)
+	group.GET("/stream", handler)
+	group.GET("/:txhash", handler)
+
+	return ws
+}
+
+func doLoadShedderRequest(ws *gin.Engine, method string, path string) *httptest.ResponseRecorder {
+	return doLoadShedderRequestWithPriority(ws, method, path, "")
+}
+
+func doLoadShedderRequestWithPriority(ws *gin.Engine, method string, path string, priority string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(priority) > 0 {
+		req.Header.Set(PriorityHeader, priority)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+// occupySlots sends the blocking requests and waits until they are served
+func occupySlots(ls *loadShedder, ws *gin.Engine, numRequests int) *sync.WaitGroup {
+	wg := &sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func() {
+			defer wg.Done()
+			_ = doLoadShedderRequest(ws, http.MethodGet, "/tx/block")
+		}()
+	}
+
+	for len(ls.slots) < cap(ls.slots) {
+		time.Sleep(time.Millisecond)
+	}
+
+	return wg
+}
+
+func TestNewLoadShedder(t *testing.T) {
+	t.Parallel()
+
+	args := createTestLoadShedderArgs()
+	args.MaxInFlightRequests = 0
+	ls, err := NewLoadShedder(args)
+	assert.Nil(t, ls)
+	assert.Equal(t, ErrInvalidMaxInFlightRequests, err)
+
+	args = createTestLoadShedderArgs()
+	args.ReservedPriorityRequests = -1
+	ls, err = NewLoadShedder(args)
+	assert.Nil(t, ls)
+	assert.Equal(t, ErrInvalidReservedPriorityRequests, err)
+
+	args = createTestLoadShedderArgs()
+	args.MaxQueuedRequests = -1
+	ls, err = NewLoadShedder(args)
+	assert.Nil(t, ls)
+	assert.Equal(t, ErrInvalidMaxQueuedRequests, err)
+
+	args = createTestLoadShedderArgs()
+	args.MaxLowPriorityRequests = -1
+	ls, err = NewLoadShedder(args)
+	assert.Nil(t, ls)
+	assert.Equal(t, ErrInvalidMaxLowPriorityRequests, err)
+
+	ls, err = NewLoadShedder(createTestLoadShedderArgs())
+	require.Nil(t, err)
+	assert.False(t, ls.IsInterfaceNil())
+	assert.Equal(t, "2", ls.retryAfterSec)
+}
+
+func TestLoadShedder_GroupHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requests under the cap should be served", func(t *testing.T) {
+		t.Parallel()
+
+		ls, _ := NewLoadShedder(createTestLoadShedderArgs())
+		ws := startApiServerWithLoadShedder(ls, make(chan struct{}))
+
+		for i := 0; i < 3; i++ {
+			resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/hash")
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+		assert.Zero(t, len(ls.slots))
+	})
+	t.Run("requests over the cap should be shed after the queue timeout", func(t *testing.T) {
+		t.Parallel()
+
+		ls, _ := NewLoadShedder(createTestLoadShedderArgs())
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/hash")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, "2", resp.Header().Get(retryAfterHeader))
+		assert.Contains(t, resp.Body.String(), string(data.ReturnCodeSystemBusy))
+
+		close(release)
+		wg.Wait()
+	})
+	t.Run("queued request should be served once a slot is released", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.QueueTimeout = time.Minute
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		time.AfterFunc(20*time.Millisecond, func() {
+			close(release)
+		})
+		resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/hash")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		wg.Wait()
+	})
+	t.Run("full queue should shed the requests right away", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.MaxQueuedRequests = 0
+		args.QueueTimeout = time.Minute
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/hash")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+		close(release)
+		wg.Wait()
+	})
+	t.Run("heavy requests should not be queued", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.QueueTimeout = time.Minute
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/pool")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+		close(release)
+		wg.Wait()
+	})
+	t.Run("streaming requests should not be shed nor hold a slot", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.ReservedPriorityRequests = 0
+		args.MaxQueuedRequests = 0
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequest(ws, http.MethodGet, "/tx/stream")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, 1, len(ls.slots))
+
+		close(release)
+		wg.Wait()
+	})
+	t.Run("priority requests should use the reserved slots", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.MaxQueuedRequests = 0
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequest(ws, http.MethodPost, "/tx/send")
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp = doLoadShedderRequest(ws, http.MethodGet, "/tx/hash")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+		close(release)
+		wg.Wait()
+	})
+	t.Run("low priority requests should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.MaxInFlightRequests = 2
+		args.MaxLowPriorityRequests = 1
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/block", "low")
+		}()
+		for len(ls.lowPrioritySlots) < cap(ls.lowPrioritySlots) {
+			time.Sleep(time.Millisecond)
+		}
+
+		resp := doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/hash", "LOW")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+		resp = doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/hash", "normal")
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		close(release)
+		wg.Wait()
+		assert.Zero(t, len(ls.lowPrioritySlots))
+		assert.Zero(t, len(ls.slots))
+	})
+	t.Run("queued low priority request should be served once a capped slot is released", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.MaxInFlightRequests = 2
+		args.MaxLowPriorityRequests = 1
+		args.QueueTimeout = time.Minute
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/block", "low")
+		}()
+		for len(ls.lowPrioritySlots) < cap(ls.lowPrioritySlots) {
+			time.Sleep(time.Millisecond)
+		}
+
+		time.AfterFunc(20*time.Millisecond, func() {
+			close(release)
+		})
+		resp := doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/hash", "low")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		wg.Wait()
+	})
+	t.Run("high priority header should use the reserved slots only if allowed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestLoadShedderArgs()
+		args.MaxQueuedRequests = 0
+		ls, _ := NewLoadShedder(args)
+		release := make(chan struct{})
+		ws := startApiServerWithLoadShedder(ls, release)
+		wg := occupySlots(ls, ws, 1)
+
+		resp := doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/hash", "high")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+		ls.allowHighPriorityHeader = true
+		resp = doLoadShedderRequestWithPriority(ws, http.MethodGet, "/tx/hash", "high")
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		close(release)
+		wg.Wait()
+	})
+}
+
+func TestLoadShedder_GetRequestPriority(t *testing.T) {
+	t.Parallel()
+
+	ls, _ := NewLoadShedder(createTestLoadShedderArgs())
+	assert.Equal(t, priorityNormal, ls.getRequestPriority("", ""))
+	assert.Equal(t, priorityNormal, ls.getRequestPriority("normal", ""))
+	assert.Equal(t, priorityNormal, ls.getRequestPriority("unknown", ""))
+	assert.Equal(t, priorityLow, ls.getRequestPriority(" Low ", ""))
+	assert.Equal(t, priorityLow, ls.getRequestPriority("low", LoadClassPriority))
+	assert.Equal(t, priorityHigh, ls.getRequestPriority("", LoadClassPriority))
+	assert.Equal(t, priorityNormal, ls.getRequestPriority("high", ""))
+
+	ls.allowHighPriorityHeader = true
+	assert.Equal(t, priorityHigh, ls.getRequestPriority("HIGH", ""))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -48,11 +49,7 @@ func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 		numRequests := rl.addInRequestsMap(key)
 		if numRequests >= limitForEndpoint {
 			printMessage := fmt.Sprintf("your IP exceeded the limit of %d requests in %v for this endpoint", limitForEndpoint, rl.countDuration)
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
-				Data:  nil,
-				Error: printMessage,
-				Code:  data.ReturnCode(ReturnCodeRequestError),
-			})
+			shared.AbortWith(c, http.StatusTooManyRequests, nil, printMessage, data.ReturnCode(ReturnCodeRequestError))
 		}
 	}
 }
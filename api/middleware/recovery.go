@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const internalPanicErrorMessage = "internal server error"
+
+// PanicReport holds the details of a panic recovered while serving a request
+type PanicReport struct {
+	RequestID string
+	Method    string
+	Path      string
+	Message   string
+	Stack     string
+	Timestamp time.Time
+}
+
+type recoveryMiddleware struct {
+	panicReporter PanicReporter
+}
+
+// NewRecoveryMiddleware returns a new instance of recoveryMiddleware. The panic reporter is optional, the recovered
+// panics being only logged if it is nil
+func NewRecoveryMiddleware(panicReporter PanicReporter) *recoveryMiddleware {
+	return &recoveryMiddleware{
+		panicReporter: panicReporter,
+	}
+}
+
+// MiddlewareHandlerFunc recovers the panics of the following handlers and responds with a 500 holding the request
+// identifier, so that the failure can be matched with the logged and reported stack trace
+func (rm *recoveryMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			rm.handlePanic(c, recovered)
+		}()
+
+		c.Next()
+	}
+}
+
+func (rm *recoveryMiddleware) handlePanic(c *gin.Context, recovered interface{}) {
+	if isBrokenConnection(recovered) {
+		log.WithContext(c.Request.Context()).Debug("connection closed while serving the request", "path", c.Request.URL.Path, "error", recovered)
+		c.Abort()
+		return
+	}
+
+	report := &PanicReport{
+		RequestID: c.GetString(RequestIDContextKey),
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+		Message:   fmt.Sprint(recovered),
+		Stack:     string(debug.Stack()),
+		Timestamp: time.Now(),
+	}
+	log.WithContext(c.Request.Context()).Error("panic recovered while serving the request",
+		"method", report.Method,
+		"path", report.Path,
+		"panic", report.Message,
+		"stack", report.Stack)
+
+	if !check.IfNil(rm.panicReporter) {
+		rm.panicReporter.ReportPanic(report)
+	}
+
+	if c.Writer.Written() {
+		c.Abort()
+		return
+	}
+
+	responseData := gin.H{"requestId": report.RequestID}
+	shared.AbortWith(c, http.StatusInternalServerError, responseData, internalPanicErrorMessage, data.ReturnCodeInternalError)
+}
+
+// isBrokenConnection returns true if the panic was caused by the client closing the connection, case in which no
+// response can be sent and nothing has to be reported
+func isBrokenConnection(recovered interface{}) bool {
+	err, ok := recovered.(error)
+	if !ok {
+		return false
+	}
+
+	return errors.Is(err, http.ErrAbortHandler) || errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rm *recoveryMiddleware) IsInterfaceNil() bool {
+	return rm == nil
+}
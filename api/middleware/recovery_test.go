@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type panicReporterStub struct {
+	mut     sync.Mutex
+	reports []*PanicReport
+}
+
+func (stub *panicReporterStub) ReportPanic(report *PanicReport) {
+	stub.mut.Lock()
+	stub.reports = append(stub.reports, report)
+	stub.mut.Unlock()
+}
+
+func (stub *panicReporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startApiServerWithRecoveryMiddleware(panicReporter PanicReporter) *gin.Engine {
+	ws := gin.New()
+	ws.Use(NewRequestIDMiddleware().MiddlewareHandlerFunc())
+	ws.Use(NewRecoveryMiddleware(panicReporter).MiddlewareHandlerFunc())
+	ws.GET("/ok", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+	ws.GET("/panic", func(c *gin.Context) {
+		panic("nil observer")
+	})
+	ws.GET("/panic-after-write", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic("nil observer")
+	})
+	ws.GET("/broken-pipe", func(c *gin.Context) {
+		panic(syscall.EPIPE)
+	})
+
+	return ws
+}
+
+func TestNewRecoveryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rm := NewRecoveryMiddleware(nil)
+	assert.False(t, check.IfNil(rm))
+}
+
+func TestRecoveryMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request without panic should pass through", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := &panicReporterStub{}
+		ws := startApiServerWithRecoveryMiddleware(reporter)
+
+		req, _ := http.NewRequest(http.MethodGet, "/ok", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, reporter.reports)
+	})
+	t.Run("panic should respond with the request ID and be reported", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := &panicReporterStub{}
+		ws := startApiServerWithRecoveryMiddleware(reporter)
+
+		req, _ := http.NewRequest(http.MethodGet, "/panic", nil)
+		req.Header.Set(common.RequestIDHeader, "client-request")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		response := data.GenericAPIResponse{}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &response))
+		assert.Equal(t, map[string]interface{}{"requestId": "client-request"}, response.Data)
+		assert.Equal(t, internalPanicErrorMessage, response.Error)
+		assert.Equal(t, data.ReturnCodeInternalError, response.Code)
+
+		require.Len(t, reporter.reports, 1)
+		report := reporter.reports[0]
+		assert.Equal(t, "client-request", report.RequestID)
+		assert.Equal(t, http.MethodGet, report.Method)
+		assert.Equal(t, "/panic", report.Path)
+		assert.Equal(t, "nil observer", report.Message)
+		assert.Contains(t, report.Stack, "recovery_test.go")
+	})
+	t.Run("panic after the response was written should keep the response", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := &panicReporterStub{}
+		ws := startApiServerWithRecoveryMiddleware(reporter)
+
+		req, _ := http.NewRequest(http.MethodGet, "/panic-after-write", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "partial", resp.Body.String())
+		assert.Len(t, reporter.reports, 1)
+	})
+	t.Run("broken connection should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := &panicReporterStub{}
+		ws := startApiServerWithRecoveryMiddleware(reporter)
+
+		req, _ := http.NewRequest(http.MethodGet, "/broken-pipe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Empty(t, resp.Body.String())
+		assert.Empty(t, reporter.reports)
+	})
+	t.Run("nil reporter should only respond", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startApiServerWithRecoveryMiddleware(nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/panic", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ChainReorgDetectedHeader is the header set on the responses while some chain reorganizations are recently detected.
+// It holds the comma separated "<shard>:<nonce>" of the reorgs
+const ChainReorgDetectedHeader = "X-Chain-Reorg-Detected"
+
+type reorgNotification struct {
+	reorgNotifier ReorgNotifier
+}
+
+// NewReorgNotification returns a new instance of reorgNotification
+func NewReorgNotification(reorgNotifier ReorgNotifier) (*reorgNotification, error) {
+	if check.IfNil(reorgNotifier) {
+		return nil, ErrNilReorgNotifier
+	}
+
+	return &reorgNotification{
+		reorgNotifier: reorgNotifier,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that adds the X-Chain-Reorg-Detected header on the responses, if
+// some reorgs were detected recently. The header is computed right before the response is written, so that a reorg
+// detected while serving the request is reported as well
+func (rn *reorgNotification) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer = &reorgNotificationWriter{
+			ResponseWriter: c.Writer,
+			reorgNotifier:  rn.reorgNotifier,
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rn *reorgNotification) IsInterfaceNil() bool {
+	return rn == nil
+}
+
+// reorgNotificationWriter sets the X-Chain-Reorg-Detected header right before the response is written
+type reorgNotificationWriter struct {
+	gin.ResponseWriter
+	reorgNotifier ReorgNotifier
+}
+
+// Write sets the X-Chain-Reorg-Detected header, if needed, and writes the data
+func (w *reorgNotificationWriter) Write(data []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString sets the X-Chain-Reorg-Detected header, if needed, and writes the string
+func (w *reorgNotificationWriter) WriteString(s string) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.WriteString(s)
+}
+
+// WriteHeaderNow sets the X-Chain-Reorg-Detected header, if needed, and writes the status code
+func (w *reorgNotificationWriter) WriteHeaderNow() {
+	w.setHeader()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *reorgNotificationWriter) setHeader() {
+	if w.Written() {
+		return
+	}
+
+	recentReorgs := w.reorgNotifier.GetRecentReorgs()
+	if len(recentReorgs) == 0 {
+		return
+	}
+
+	reorgs := make([]string, 0, len(recentReorgs))
+	reportedReorgs := make(map[string]struct{}, len(recentReorgs))
+	for _, reorg := range recentReorgs {
+		value := fmt.Sprintf("%d:%d", reorg.Shard, reorg.Nonce)
+		_, reported := reportedReorgs[value]
+		if reported {
+			continue
+		}
+
+		reportedReorgs[value] = struct{}{}
+		reorgs = append(reorgs, value)
+	}
+	w.Header().Set(ChainReorgDetectedHeader, strings.Join(reorgs, ","))
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+type reorgNotifierStub struct {
+	recentReorgs []*data.ChainReorg
+}
+
+func (stub *reorgNotifierStub) GetRecentReorgs() []*data.ChainReorg {
+	return stub.recentReorgs
+}
+
+func (stub *reorgNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func doReorgNotificationRequest(notifier *reorgNotifierStub, reorgsDetectedWhileServing []*data.ChainReorg) *httptest.ResponseRecorder {
+	rn, _ := NewReorgNotification(notifier)
+
+	ws := gin.New()
+	ws.Use(rn.MiddlewareHandlerFunc())
+	ws.GET("/block/:shard/by-nonce/:nonce", func(c *gin.Context) {
+		notifier.recentReorgs = append(notifier.recentReorgs, reorgsDetectedWhileServing...)
+		c.JSON(http.StatusOK, nil)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/block/0/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewReorgNotification(t *testing.T) {
+	t.Parallel()
+
+	rn, err := NewReorgNotification(nil)
+	assert.Nil(t, rn)
+	assert.Equal(t, ErrNilReorgNotifier, err)
+
+	rn, err = NewReorgNotification(&reorgNotifierStub{})
+	assert.NoError(t, err)
+	assert.False(t, rn.IsInterfaceNil())
+}
+
+func TestReorgNotification_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no recent reorg should not set the header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doReorgNotificationRequest(&reorgNotifierStub{}, nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(ChainReorgDetectedHeader))
+	})
+	t.Run("recent reorgs should be reported once", func(t *testing.T) {
+		t.Parallel()
+
+		notifier := &reorgNotifierStub{
+			recentReorgs: []*data.ChainReorg{
+				{Shard: 0, Nonce: 5},
+				{Shard: 1, Nonce: 7},
+				{Shard: 0, Nonce: 5},
+			},
+		}
+		resp := doReorgNotificationRequest(notifier, nil)
+		assert.Equal(t, "0:5,1:7", resp.Header().Get(ChainReorgDetectedHeader))
+	})
+	t.Run("reorg detected while serving the request should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doReorgNotificationRequest(&reorgNotifierStub{}, []*data.ChainReorg{{Shard: 4294967295, Nonce: 10}})
+		assert.Equal(t, "4294967295:10", resp.Header().Get(ChainReorgDetectedHeader))
+	})
+}
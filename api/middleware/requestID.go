@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+const (
+	// RequestIDContextKey is the key under which the request identifier is stored in the gin context
+	RequestIDContextKey = "requestID"
+
+	generatedRequestIDNumBytes = 16
+	maxRequestIDLength         = 128
+)
+
+type requestIDMiddleware struct {
+	generateRequestID func() string
+}
+
+// NewRequestIDMiddleware returns a new instance of requestIDMiddleware
+func NewRequestIDMiddleware() *requestIDMiddleware {
+	return &requestIDMiddleware{
+		generateRequestID: generateRequestID,
+	}
+}
+
+// MiddlewareHandlerFunc assigns an identifier to each request, or propagates the one received from the client, and
+// returns it in the response. The identifier is bound to the context of the request, which is passed down to the
+// processors, so it is added to the log lines and forwarded to the observers
+func (rim *requestIDMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(common.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = rim.generateRequestID()
+		}
+
+		c.Set(RequestIDContextKey, requestID)
+		c.Header(common.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(common.ContextWithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rim *requestIDMiddleware) IsInterfaceNil() bool {
+	return rim == nil
+}
+
+// isValidRequestID accepts only short identifiers with characters that can be safely written in logs and headers
+func isValidRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, ch := range requestID {
+		isAlphanumeric := (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+		isSeparator := ch == '-' || ch == '_' || ch == '.' || ch == ':'
+		if !isAlphanumeric && !isSeparator {
+			return false
+		}
+	}
+
+	return true
+}
+
+func generateRequestID() string {
+	buff := make([]byte, generatedRequestIDNumBytes)
+	_, err := rand.Read(buff)
+	if err != nil {
+		log.Warn("cannot generate request ID", "error", err.Error())
+	}
+
+	return hex.EncodeToString(buff)
+}
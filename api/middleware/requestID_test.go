@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func startApiServerWithRequestIDMiddleware(rim *requestIDMiddleware, servedRequestIDs *[]string) *gin.Engine {
+	ws := gin.New()
+	ws.Use(rim.MiddlewareHandlerFunc())
+	ws.GET("/test", func(c *gin.Context) {
+		*servedRequestIDs = append(*servedRequestIDs, common.GetRequestID(c.Request.Context()))
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+func TestNewRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rim := NewRequestIDMiddleware()
+	assert.False(t, check.IfNil(rim))
+}
+
+func TestRequestIDMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	rim := NewRequestIDMiddleware()
+	rim.generateRequestID = func() string {
+		return "generated"
+	}
+	servedRequestIDs := make([]string, 0)
+	ws := startApiServerWithRequestIDMiddleware(rim, &servedRequestIDs)
+
+	testRequest := func(providedRequestID string, expectedRequestID string) {
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		if providedRequestID != "" {
+			req.Header.Set(common.RequestIDHeader, providedRequestID)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, expectedRequestID, resp.Header().Get(common.RequestIDHeader))
+	}
+
+	testRequest("", "generated")
+	testRequest("client-request.1:a_b", "client-request.1:a_b")
+	testRequest("invalid request id", "generated")
+	testRequest("bad\nheader", "generated")
+	testRequest(strings.Repeat("a", maxRequestIDLength+1), "generated")
+
+	assert.Equal(t, []string{"generated", "client-request.1:a_b", "generated", "generated", "generated"}, servedRequestIDs)
+}
+
+func TestGenerateRequestID(t *testing.T) {
+	t.Parallel()
+
+	first := generateRequestID()
+	second := generateRequestID()
+	assert.Len(t, first, generatedRequestIDNumBytes*2)
+	assert.NotEqual(t, first, second)
+}
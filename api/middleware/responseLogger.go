@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -11,9 +12,10 @@ import (
 
 	"github.com/gin-gonic/gin"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
-var log = logger.GetOrCreate("api/middleware")
+var log = common.NewRequestIDLogger(logger.GetOrCreate("api/middleware"))
 
 const (
 	prefixDurationTooLong      = "[too long]"
@@ -26,7 +28,7 @@ const (
 
 type responseLoggerMiddleware struct {
 	thresholdDurationForLoggingRequest time.Duration
-	printRequestFunc                   func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string)
+	printRequestFunc                   func(ctx context.Context, title string, path string, duration time.Duration, status int, clientIP string, request string, response string)
 }
 
 // NewResponseLoggerMiddleware returns a new instance of responseLoggerMiddleware
@@ -78,7 +80,7 @@ func (rlm *responseLoggerMiddleware) IsInterfaceNil() bool {
 func (rlm *responseLoggerMiddleware) logRequestAndResponse(c *gin.Context, duration time.Duration, status int, request string, response string) {
 	title := rlm.computeLogTitle(status)
 
-	rlm.printRequestFunc(title, c.Request.RequestURI, duration, status, c.ClientIP(), request, response)
+	rlm.printRequestFunc(c.Request.Context(), title, c.Request.RequestURI, duration, status, c.ClientIP(), request, response)
 }
 
 func (rlm *responseLoggerMiddleware) computeLogTitle(status int) string {
@@ -94,8 +96,8 @@ func (rlm *responseLoggerMiddleware) computeLogTitle(status int) string {
 	return fmt.Sprintf("%s api request", logPrefix)
 }
 
-func (rlm *responseLoggerMiddleware) printRequest(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
-	log.Warn(title,
+func (rlm *responseLoggerMiddleware) printRequest(ctx context.Context, title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+	log.WithContext(ctx).Warn(title,
 		"path", path,
 		"duration", duration,
 		"status", status,
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -65,7 +66,7 @@ func TestResponseLoggerMiddleware_DurationExceedsTimeout(t *testing.T) {
 	}
 
 	rlf := responseLogFields{}
-	printHandler := func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+	printHandler := func(_ context.Context, title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
 		rlf.title = title
 		rlf.path = path
 		rlf.duration = duration
@@ -104,7 +105,7 @@ func TestResponseLoggerMiddleware_InternalError(t *testing.T) {
 	}
 
 	rlf := responseLogFields{}
-	printHandler := func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+	printHandler := func(_ context.Context, title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
 		rlf.title = title
 		rlf.path = path
 		rlf.duration = duration
@@ -146,7 +147,7 @@ func TestResponseLoggerMiddleware_ShouldNotCallHandler(t *testing.T) {
 	}
 
 	handlerWasCalled := false
-	printHandler := func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+	printHandler := func(_ context.Context, title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
 		handlerWasCalled = true
 	}
 
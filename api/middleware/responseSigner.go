@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+)
+
+type responseSigner struct {
+	privateKey crypto.PrivateKey
+	signer     crypto.SingleSigner
+	signerID   string
+}
+
+// NewResponseSigner creates a signer of the API responses holding the first ed25519 key of the provided pem file. The
+// signer is identified by the name of the key in the pem file, usually its address
+func NewResponseSigner(pemFileLocation string) (*responseSigner, error) {
+	skHex, signerID, err := core.LoadSkPkFromPemFile(pemFileLocation, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := hex.DecodeString(string(skHex))
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := signing.NewKeyGenerator(ed25519.NewEd25519()).PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responseSigner{
+		privateKey: privateKey,
+		signer:     &singlesig.Ed25519Signer{},
+		signerID:   signerID,
+	}, nil
+}
+
+// SignResponse signs the response body along with the request it answers and the time it was produced at:
+// <method> <request URI>\n<timestamp>.<body>, so that a signed response can not be passed off as the one of another request
+func (rs *responseSigner) SignResponse(timestamp int64, method string, requestURI string, body []byte) ([]byte, error) {
+	return rs.signer.Sign(rs.privateKey, computeSignedResponsePayload(timestamp, method, requestURI, body))
+}
+
+// SignPayload signs the payload, such as a heartbeat, along with the time it was produced at: <timestamp>.<payload>
+func (rs *responseSigner) SignPayload(timestamp int64, payload []byte) ([]byte, error) {
+	return rs.signer.Sign(rs.privateKey, computeSignedPayload(timestamp, payload))
+}
+
+// SignerID returns the identifier of the key the responses are signed with
+func (rs *responseSigner) SignerID() string {
+	return rs.signerID
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *responseSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func computeSignedResponsePayload(timestamp int64, method string, requestURI string, body []byte) []byte {
+	payload := make([]byte, 0, len(method)+len(requestURI)+len(body)+22)
+	payload = append(payload, method...)
+	payload = append(payload, ' ')
+	payload = append(payload, requestURI...)
+	payload = append(payload, '\n')
+
+	return appendSignedPayload(payload, timestamp, body)
+}
+
+func computeSignedPayload(timestamp int64, body []byte) []byte {
+	return appendSignedPayload(make([]byte, 0, len(body)+20), timestamp, body)
+}
+
+func appendSignedPayload(payload []byte, timestamp int64, body []byte) []byte {
+	payload = strconv.AppendInt(payload, timestamp, 10)
+	payload = append(payload, '.')
+
+	return append(payload, body...)
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// ResponseSignatureHeader is the header holding the hex encoded signature of a signed response
+	ResponseSignatureHeader = "X-Proxy-Signature"
+	// ResponseSignatureTimestampHeader is the header holding the unix timestamp included in the signature
+	ResponseSignatureTimestampHeader = "X-Proxy-Signature-Timestamp"
+	// ResponseSignerHeader is the header holding the identifier of the key the response was signed with
+	ResponseSignerHeader = "X-Proxy-Signer"
+)
+
+type responseSigning struct {
+	signer       ResponseSigner
+	signedRoutes map[string]struct{}
+	getTimestamp func() int64
+}
+
+// NewResponseSigning returns a new instance of responseSigning, signing the responses of the API package's routes
+// configured with Signed = true
+func NewResponseSigning(basePath string, packageConfig data.APIPackageConfig, signer ResponseSigner) *responseSigning {
+	signedRoutes := make(map[string]struct{})
+	for _, route := range packageConfig.Routes {
+		if route.Signed {
+			signedRoutes[basePath+route.Name] = struct{}{}
+		}
+	}
+
+	return &responseSigning{
+		signer:       signer,
+		signedRoutes: signedRoutes,
+		getTimestamp: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+}
+
+// HasRules returns true if at least one of the package's routes is signed
+func (rs *responseSigning) HasRules() bool {
+	return len(rs.signedRoutes) > 0
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that holds back the responses of the signed routes until they are
+// complete, so that the signature of the body and the timestamp it covers can be sent in the response headers
+func (rs *responseSigning) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, isSigned := rs.signedRoutes[c.FullPath()]
+		if !isSigned {
+			return
+		}
+
+		writer := &signedResponseWriter{
+			ResponseWriter: c.Writer,
+		}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		rs.writeSignedResponse(c.Request, writer)
+	}
+}
+
+func (rs *responseSigning) writeSignedResponse(request *http.Request, writer *signedResponseWriter) {
+	ctx := request.Context()
+	body := writer.body.Bytes()
+	timestamp := rs.getTimestamp()
+	signature, err := rs.signer.SignResponse(timestamp, request.Method, request.URL.RequestURI(), body)
+	if err != nil {
+		log.WithContext(ctx).Warn("cannot sign response", "error", err.Error())
+	} else {
+		writer.Header().Set(ResponseSignatureHeader, hex.EncodeToString(signature))
+		writer.Header().Set(ResponseSignatureTimestampHeader, strconv.FormatInt(timestamp, 10))
+		writer.Header().Set(ResponseSignerHeader, rs.signer.SignerID())
+	}
+
+	if len(body) == 0 {
+		writer.ResponseWriter.WriteHeaderNow()
+		return
+	}
+
+	_, err = writer.ResponseWriter.Write(body)
+	if err != nil {
+		log.WithContext(ctx).Debug("cannot write signed response", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *responseSigning) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+// signedResponseWriter buffers the response body, so that the headers holding its signature can still be set once
+// the handler completes
+type signedResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write buffers the data
+func (w *signedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+// WriteString buffers the string
+func (w *signedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// WriteHeaderNow does nothing, the status code being written along with the signed response
+func (w *signedResponseWriter) WriteHeaderNow() {
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	sentryAuthHeader    = "X-Sentry-Auth"
+	sentryClientName    = "mx-chain-proxy-go"
+	sentryEventIDLength = 16
+	sentryPanicLevel    = "fatal"
+)
+
+// ArgsSentryPanicReporter holds the arguments needed to create a reporter of the panics to a Sentry compatible service
+type ArgsSentryPanicReporter struct {
+	DSN               string
+	Environment       string
+	RequestTimeout    time.Duration
+	MaxPendingReports int
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger"`
+	Environment string            `json:"environment,omitempty"`
+	Message     string            `json:"message"`
+	Tags        map[string]string `json:"tags"`
+	Extra       map[string]string `json:"extra"`
+}
+
+type sentryPanicReporter struct {
+	storeURL       string
+	authHeader     string
+	environment    string
+	httpClient     *http.Client
+	pendingReports chan struct{}
+}
+
+// NewSentryPanicReporter creates a reporter sending the recovered panics to the store endpoint of the project given
+// by the DSN, such as https://<public key>@sentry.example.com/<project ID>. At most MaxPendingReports reports are
+// sent at once, the following ones being dropped until a slot is released
+func NewSentryPanicReporter(args ArgsSentryPanicReporter) (*sentryPanicReporter, error) {
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidPanicReportingTimeout
+	}
+	if args.MaxPendingReports <= 0 {
+		return nil, ErrInvalidMaxPendingPanicReports
+	}
+
+	storeURL, authHeader, err := parseSentryDSN(args.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sentryPanicReporter{
+		storeURL:       storeURL,
+		authHeader:     authHeader,
+		environment:    args.Environment,
+		httpClient:     &http.Client{Timeout: args.RequestTimeout},
+		pendingReports: make(chan struct{}, args.MaxPendingReports),
+	}, nil
+}
+
+func parseSentryDSN(dsn string) (string, string, error) {
+	parsedDSN, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidPanicReportingDSN, err.Error())
+	}
+	if parsedDSN.Scheme != "http" && parsedDSN.Scheme != "https" {
+		return "", "", fmt.Errorf("%w: unsupported scheme %q", ErrInvalidPanicReportingDSN, parsedDSN.Scheme)
+	}
+	if parsedDSN.User == nil || len(parsedDSN.User.Username()) == 0 {
+		return "", "", fmt.Errorf("%w: missing public key", ErrInvalidPanicReportingDSN)
+	}
+
+	path := strings.TrimSuffix(parsedDSN.Path, "/")
+	lastSlashIndex := strings.LastIndex(path, "/")
+	projectID := path[lastSlashIndex+1:]
+	if len(projectID) == 0 {
+		return "", "", fmt.Errorf("%w: missing project ID", ErrInvalidPanicReportingDSN)
+	}
+
+	storeURL := fmt.Sprintf("%s://%s%s/api/%s/store/", parsedDSN.Scheme, parsedDSN.Host, path[:lastSlashIndex], projectID)
+	authHeader := fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s", sentryClientName, parsedDSN.User.Username())
+	secretKey, hasSecretKey := parsedDSN.User.Password()
+	if hasSecretKey {
+		authHeader += ", sentry_secret=" + secretKey
+	}
+
+	return storeURL, authHeader, nil
+}
+
+// ReportPanic sends the report in the background, so that the response of the failed request is not delayed
+func (spr *sentryPanicReporter) ReportPanic(report *PanicReport) {
+	if report == nil {
+		return
+	}
+
+	select {
+	case spr.pendingReports <- struct{}{}:
+	default:
+		log.Warn("too many pending panic reports, dropping the report", "path", report.Path)
+		return
+	}
+
+	go func() {
+		defer func() {
+			<-spr.pendingReports
+		}()
+
+		err := spr.sendReport(report)
+		if err != nil {
+			log.Warn("cannot report the panic", "path", report.Path, "error", err.Error())
+		}
+	}()
+}
+
+func (spr *sentryPanicReporter) sendReport(report *PanicReport) error {
+	event := spr.createEvent(report)
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, spr.storeURL, bytes.NewReader(eventBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(sentryAuthHeader, spr.authHeader)
+
+	resp, err := spr.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (spr *sentryPanicReporter) createEvent(report *PanicReport) *sentryEvent {
+	return &sentryEvent{
+		EventID:     generateSentryEventID(),
+		Timestamp:   report.Timestamp.UTC().Format(time.RFC3339),
+		Level:       sentryPanicLevel,
+		Platform:    "go",
+		Logger:      sentryClientName,
+		Environment: spr.environment,
+		Message:     "panic: " + report.Message,
+		Tags: map[string]string{
+			"request_id": report.RequestID,
+			"method":     report.Method,
+			"path":       report.Path,
+		},
+		Extra: map[string]string{
+			"stack": report.Stack,
+		},
+	}
+}
+
+func generateSentryEventID() string {
+	buff := make([]byte, sentryEventIDLength)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spr *sentryPanicReporter) IsInterfaceNil() bool {
+	return spr == nil
+}
@@ -1,7 +1,10 @@
 package mock
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -15,9 +18,12 @@ type FacadeStub struct {
 	IsFaucetEnabledHandler                       func() bool
 	GetAccountHandler                            func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccountsHandler                           func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsNoncesCalled                      func(addresses []string) (*data.AccountsNonces, error)
 	GetShardIDForAddressHandler                  func(address string) (uint32, error)
+	GetAddressConverterHandler                   func() (core.PubkeyConverter, error)
 	GetValueForKeyHandler                        func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetKeyValuePairsHandler                      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairsDiffHandler                  func(address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error)
 	GetESDTTokenDataCalled                       func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenDataCalled                    func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsWithRoleCalled                       func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -27,22 +33,61 @@ type FacadeStub struct {
 	GetTransactionHandler                        func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPoolHandler                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardHandler           func(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSenderHandler          func(sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolForSenderHandler          func(sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolForSendersHandler         func(senders []string, fields string) (*data.TransactionsPoolForSenders, error)
+	GetAgedTransactionsPoolHandler               func(olderThanSeconds uint64) (*data.AgedTransactionsPool, error)
+	GetTransactionsPoolChunkHandler              func(shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error)
+	StreamTransactionsPoolHandler                func(shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error
 	GetLastPoolNonceForSenderHandler             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderHandler func(sender string) (*data.TransactionsPoolNonceGaps, error)
-	SendTransactionHandler                       func(tx *data.Transaction) (int, string, error)
+	SendTransactionHandler                       func(tx *data.Transaction) (int, *data.SentTransaction, error)
 	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	ValidateTransactionCalled                    func(tx *data.Transaction) (*data.TransactionValidationResult, error)
+	ComputeTransactionFeeCalled                  func(tx *data.Transaction) (*data.TransactionFee, error)
+	BuildESDTTransferCalled                      func(request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error)
+	DecodeTransactionOperationCalled             func(tx *transaction.ApiTransactionResult) *data.TransactionOperation
+	VerifyTransactionHashCalled                  func(tx *transaction.ApiTransactionResult) (bool, error)
+	DecodeTransactionEventsCalled                func(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent
+	GetLivenessCalled                            func() *data.ProbeStatus
+	GetReadinessCalled                           func() *data.ProbeStatus
 	SendUserFundsCalled                          func(receiver string, value *big.Int) error
+	IsFaucetQueueEnabledCalled                   func() bool
+	EnqueueUserFundsCalled                       func(receiver string, value *big.Int) (*data.FaucetRequest, error)
+	GetFaucetRequestCalled                       func(id string) (*data.FaucetRequest, error)
 	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCMultiContractQueryHandler           func(query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error)
 	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
-	ValidatorStatisticsHandler                   func() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatisticsHandler                   func(options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error)
 	AuctionListHandler                           func() ([]*data.AuctionListValidatorAPIResponse, error)
+	GetBLSKeyInfoCalled                          func(blsKey string) (*data.BLSKeyInfo, error)
 	TransactionCostRequestHandler                func(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatusHandler                  func(txHash string, sender string) (string, error)
+	GetFinalTransactionStatusHandler             func(txHash string, sender string) (string, error)
+	IsBlockFinalCalled                           func(shardID uint32, nonce uint64) (bool, error)
 	GetProcessedTransactionStatusHandler         func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionOutcomeHandler                 func(txHash string) (*data.TransactionOutcome, error)
+	GetTransactionJourneyHandler                 func(txHash string) (*data.TransactionJourney, error)
+	ComputeContractAddressHandler                func(deployer string, nonce uint64) (*data.ContractAddress, error)
+	DecodeDataFieldHandler                       func(dataField string) (*data.DecodedDataField, error)
+	CheckTransferReceiverHandler                 func(tx *data.Transaction) (*data.ReceiverCheck, error)
+	GetStuckTransactionsCalled                   func(address string) (*data.StuckTransactions, error)
+	GetCollectionsForAddressCalled               func(address string, options common.CollectionsQueryOptions) ([]*data.Collection, error)
+	GetTokensIssuedByAddressCalled               func(address string) ([]*data.IssuedToken, error)
+	VerifyMessageSignatureCalled                 func(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error)
+	GetAddressActivitySummaryCalled              func(address string) (*data.AddressActivitySummary, error)
+	GetAddressLatestTransactionsCalled           func(address string, size uint32) ([]data.DatabaseTransaction, error)
+	GetTokenPriceCalled                          func(token string) (*data.TokenPrice, error)
+	GetTokenHoldersCalled                        func(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error)
+	IsTokenPriceEnabledCalled                    func() bool
+	GetUsdValueCalled                            func(token string, amount string) (float64, error)
+	GetCollectionCalled                          func(collection string) (*data.Collection, error)
 	GetConfigMetricsHandler                      func() (*data.GenericAPIResponse, error)
 	GetNetworkMetricsHandler                     func(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkStatusSnapshotHandler              func() (*data.NetworkStatusSnapshot, error)
+	GetStakingOverviewHandler                    func() (*data.StakingOverview, error)
+	GetDelegationProvidersHandler                func() ([]*data.DelegationProvider, error)
+	GetDelegationProviderHandler                 func(address string) (*data.DelegationProvider, error)
 	GetAllIssuedESDTsHandler                     func(tokenType string) (*data.GenericAPIResponse, error)
 	GetEnableEpochsMetricsHandler                func() (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetricsHandler               func() (*data.GenericAPIResponse, error)
@@ -56,12 +101,23 @@ type FacadeStub struct {
 	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled                func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled             func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetMiniBlockByHashCalled                     func(shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error)
+	GetBlocksByHashesCalled                      func(requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error)
+	GetEpochStartBlockCalled                     func(shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlockCalled       func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	ReloadObserversCalled                        func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled             func() data.NodesReloadResponse
+	AddObserverCalled                            func(node *data.NodeData) error
+	RemoveObserverCalled                         func(address string) error
+	StartBlocksExportCalled                      func(request *data.BlocksExportRequest) (*data.BlocksExportJob, error)
+	GetBlocksExportJobCalled                     func(jobID string) (*data.BlocksExportJob, error)
+	SetReadOnlyModeCalled                        func(enabled bool)
+	GetNativeTokenDenominationCalled             func() (int, error)
+	IsReadOnlyModeEnabledCalled                  func() bool
+	GetClientUsageCalled                         func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error)
 	GetProofCalled                               func(string, string) (*data.GenericAPIResponse, error)
 	GetProofDataTrieCalled                       func(string, string, string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHashCalled                func(string) (*data.GenericAPIResponse, error)
@@ -72,9 +128,16 @@ type FacadeStub struct {
 	GetPrometheusMetricsCalled                   func() string
 	GetGenesisNodesPubKeysCalled                 func() (*data.GenericAPIResponse, error)
 	GetGasConfigsCalled                          func() (*data.GenericAPIResponse, error)
+	GetGasPriceSuggestionCalled                  func() (*data.GasPriceSuggestion, error)
+	SubscribeToNetworkStatusCalled               func(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error)
+	GetSovereignValidatorsInfoCalled             func(epoch uint32) (*data.SovereignValidatorsInfo, error)
+	GetSovereignChainParametersCalled            func() (*data.SovereignChainParameters, error)
+	GetSovereignNotarizedMainChainHeadersCalled  func(from uint64, to uint64) (*data.NotarizedMainChainHeaders, error)
+	ForwardToNodeCalled                          func(shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error)
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
 	GetAboutInfoCalled                           func() (*data.GenericAPIResponse, error)
 	GetNodesVersionsCalled                       func() (*data.GenericAPIResponse, error)
+	GetExcludedObserversCalled                   func() (*data.GenericAPIResponse, error)
 	GetAlteredAccountsByNonceCalled              func(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHashCalled               func(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetTriesStatisticsCalled                     func(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
@@ -86,7 +149,7 @@ type FacadeStub struct {
 }
 
 // GetProof -
-func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProof(_ context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCalled != nil {
 		return f.GetProofCalled(rootHash, address)
 	}
@@ -95,7 +158,7 @@ func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPI
 }
 
 // GetProofDataTrie -
-func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofDataTrie(_ context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	if f.GetProofDataTrieCalled != nil {
 		return f.GetProofDataTrieCalled(rootHash, address, key)
 	}
@@ -104,7 +167,7 @@ func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key strin
 }
 
 // GetProofCurrentRootHash -
-func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofCurrentRootHash(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCurrentRootHashCalled != nil {
 		return f.GetProofCurrentRootHashCalled(address)
 	}
@@ -113,7 +176,7 @@ func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIRe
 }
 
 // VerifyProof -
-func (f *FacadeStub) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) VerifyProof(_ context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if f.VerifyProofCalled != nil {
 		return f.VerifyProofCalled(rootHash, address, proof)
 	}
@@ -148,8 +211,78 @@ func (f *FacadeStub) ReloadFullHistoryObservers() data.NodesReloadResponse {
 	return data.NodesReloadResponse{}
 }
 
+// AddObserver -
+func (f *FacadeStub) AddObserver(node *data.NodeData) error {
+	if f.AddObserverCalled != nil {
+		return f.AddObserverCalled(node)
+	}
+
+	return nil
+}
+
+// RemoveObserver -
+func (f *FacadeStub) RemoveObserver(address string) error {
+	if f.RemoveObserverCalled != nil {
+		return f.RemoveObserverCalled(address)
+	}
+
+	return nil
+}
+
+// StartBlocksExport -
+func (f *FacadeStub) StartBlocksExport(_ context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+	if f.StartBlocksExportCalled != nil {
+		return f.StartBlocksExportCalled(request)
+	}
+
+	return &data.BlocksExportJob{}, nil
+}
+
+// GetBlocksExportJob -
+func (f *FacadeStub) GetBlocksExportJob(jobID string) (*data.BlocksExportJob, error) {
+	if f.GetBlocksExportJobCalled != nil {
+		return f.GetBlocksExportJobCalled(jobID)
+	}
+
+	return &data.BlocksExportJob{}, nil
+}
+
+// GetNativeTokenDenomination -
+func (f *FacadeStub) GetNativeTokenDenomination(_ context.Context) (int, error) {
+	if f.GetNativeTokenDenominationCalled != nil {
+		return f.GetNativeTokenDenominationCalled()
+	}
+
+	return 18, nil
+}
+
+// SetReadOnlyMode -
+func (f *FacadeStub) SetReadOnlyMode(enabled bool) {
+	if f.SetReadOnlyModeCalled != nil {
+		f.SetReadOnlyModeCalled(enabled)
+	}
+}
+
+// IsReadOnlyModeEnabled -
+func (f *FacadeStub) IsReadOnlyModeEnabled() bool {
+	if f.IsReadOnlyModeEnabledCalled != nil {
+		return f.IsReadOnlyModeEnabledCalled()
+	}
+
+	return false
+}
+
+// GetClientUsage -
+func (f *FacadeStub) GetClientUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+	if f.GetClientUsageCalled != nil {
+		return f.GetClientUsageCalled(apiKey, from, to)
+	}
+
+	return &data.ClientUsageReport{}, nil
+}
+
 // GetNetworkStatusMetrics -
-func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if f.GetNetworkMetricsHandler != nil {
 		return f.GetNetworkMetricsHandler(shardID)
 	}
@@ -157,8 +290,44 @@ func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIRe
 	return nil, nil
 }
 
+// GetNetworkStatusSnapshot -
+func (f *FacadeStub) GetNetworkStatusSnapshot(_ context.Context) (*data.NetworkStatusSnapshot, error) {
+	if f.GetNetworkStatusSnapshotHandler != nil {
+		return f.GetNetworkStatusSnapshotHandler()
+	}
+
+	return nil, nil
+}
+
+// GetStakingOverview -
+func (f *FacadeStub) GetStakingOverview(_ context.Context) (*data.StakingOverview, error) {
+	if f.GetStakingOverviewHandler != nil {
+		return f.GetStakingOverviewHandler()
+	}
+
+	return nil, nil
+}
+
+// GetDelegationProviders -
+func (f *FacadeStub) GetDelegationProviders(_ context.Context) ([]*data.DelegationProvider, error) {
+	if f.GetDelegationProvidersHandler != nil {
+		return f.GetDelegationProvidersHandler()
+	}
+
+	return nil, nil
+}
+
+// GetDelegationProvider -
+func (f *FacadeStub) GetDelegationProvider(_ context.Context, address string) (*data.DelegationProvider, error) {
+	if f.GetDelegationProviderHandler != nil {
+		return f.GetDelegationProviderHandler(address)
+	}
+
+	return nil, nil
+}
+
 // GetNetworkConfigMetrics -
-func (f *FacadeStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetConfigMetricsHandler != nil {
 		return f.GetConfigMetricsHandler()
 	}
@@ -176,7 +345,7 @@ func (f *FacadeStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
 }
 
 // GetAllIssuedESDTs -
-func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllIssuedESDTs(_ context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if f.GetAllIssuedESDTsHandler != nil {
 		return f.GetAllIssuedESDTsHandler(tokenType)
 	}
@@ -185,7 +354,7 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIRespon
 }
 
 // GetESDTsWithRole -
-func (f *FacadeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsWithRoleCalled != nil {
 		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
@@ -194,7 +363,7 @@ func (f *FacadeStub) GetESDTsWithRole(address string, role string, options commo
 }
 
 // GetESDTsRoles -
-func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsRolesCalled != nil {
 		return f.GetESDTsRolesCalled(address, options)
 	}
@@ -203,7 +372,7 @@ func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOp
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
 		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
@@ -212,7 +381,7 @@ func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options c
 }
 
 // GetDirectStakedInfo -
-func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDirectStakedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDirectStakedInfoCalled != nil {
 		return f.GetDirectStakedInfoCalled()
 	}
@@ -221,7 +390,7 @@ func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetDelegatedInfo -
-func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDelegatedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDelegatedInfoCalled != nil {
 		return f.GetDelegatedInfoCalled()
 	}
@@ -230,17 +399,17 @@ func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetEnableEpochsMetrics -
-func (f *FacadeStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEnableEpochsMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetEnableEpochsMetricsHandler()
 }
 
 // GetRatingsConfig -
-func (f *FacadeStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetRatingsConfig(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetRatingsConfigCalled()
 }
 
 // GetESDTSupply -
-func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+func (f *FacadeStub) GetESDTSupply(_ context.Context, token string) (*data.ESDTSupplyResponse, error) {
 	if f.GetESDTSupplyCalled != nil {
 		return f.GetESDTSupplyCalled(token)
 	}
@@ -249,16 +418,16 @@ func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, erro
 }
 
 // ValidatorStatistics -
-func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *FacadeStub) ValidatorStatistics(_ context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
 	if f.ValidatorStatisticsHandler != nil {
-		return f.ValidatorStatisticsHandler()
+		return f.ValidatorStatisticsHandler(options)
 	}
 
 	return nil, nil
 }
 
 // AuctionList -
-func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
+func (f *FacadeStub) AuctionList(_ context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
 	if f.AuctionListHandler != nil {
 		return f.AuctionListHandler()
 	}
@@ -266,28 +435,51 @@ func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, err
 	return nil, nil
 }
 
+// GetBLSKeyInfo -
+func (f *FacadeStub) GetBLSKeyInfo(_ context.Context, blsKey string) (*data.BLSKeyInfo, error) {
+	if f.GetBLSKeyInfoCalled != nil {
+		return f.GetBLSKeyInfoCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (f *FacadeStub) GetAccount(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return f.GetAccountHandler(address, options)
 }
 
 // GetAccounts -
-func (f *FacadeStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (f *FacadeStub) GetAccounts(_ context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return f.GetAccountsHandler(addresses, options)
 }
 
+// GetAccountsNonces -
+func (f *FacadeStub) GetAccountsNonces(_ context.Context, addresses []string) (*data.AccountsNonces, error) {
+	if f.GetAccountsNoncesCalled != nil {
+		return f.GetAccountsNoncesCalled(addresses)
+	}
+
+	return nil, nil
+}
+
+// GetKeyValuePairsDiff -
+func (f *FacadeStub) GetKeyValuePairsDiff(_ context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+	return f.GetKeyValuePairsDiffHandler(address, options)
+}
+
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetKeyValuePairsHandler(address, options)
 }
 
 // GetValueForKey -
-func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (f *FacadeStub) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return f.GetValueForKeyHandler(address, key, options)
 }
 
 // GetGuardianData -
-func (f *FacadeStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGuardianData(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetGuardianDataCalled(address, options)
 }
 
@@ -297,7 +489,7 @@ func (f *FacadeStub) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData -
-func (f *FacadeStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTTokenDataCalled != nil {
 		return f.GetESDTTokenDataCalled(address, key, options)
 	}
@@ -306,7 +498,7 @@ func (f *FacadeStub) GetESDTTokenData(address string, key string, options common
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address, options)
 	}
@@ -315,7 +507,7 @@ func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQuer
 }
 
 // GetESDTNftTokenData -
-func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTNftTokenDataCalled != nil {
 		return f.GetESDTNftTokenDataCalled(address, key, nonce, options)
 	}
@@ -324,7 +516,7 @@ func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint6
 }
 
 // IsOldStorageForToken -
-func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (f *FacadeStub) IsOldStorageForToken(_ context.Context, tokenID string, nonce uint64) (bool, error) {
 	if f.IsOldStorageForTokenCalled != nil {
 		return f.IsOldStorageForTokenCalled(tokenID, nonce)
 	}
@@ -338,17 +530,17 @@ func (f *FacadeStub) GetTransactions(address string) ([]data.DatabaseTransaction
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (f *FacadeStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (f *FacadeStub) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	return f.GetTransactionByHashAndSenderAddressHandler(txHash, sndAddr, withEvents)
 }
 
 // GetTransaction -
-func (f *FacadeStub) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (f *FacadeStub) GetTransaction(_ context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return f.GetTransactionHandler(txHash, withResults)
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolHandler != nil {
 		return f.GetTransactionsPoolHandler(fields)
 	}
@@ -357,7 +549,7 @@ func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool,
 }
 
 // GetTransactionsPoolForShard -
-func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolForShardHandler != nil {
 		return f.GetTransactionsPoolForShardHandler(shardID, fields)
 	}
@@ -366,16 +558,52 @@ func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string)
 }
 
 // GetTransactionsPoolForSender -
-func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (f *FacadeStub) GetTransactionsPoolForSender(_ context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
 	if f.GetTransactionsPoolForSenderHandler != nil {
-		return f.GetTransactionsPoolForSenderHandler(sender, fields)
+		return f.GetTransactionsPoolForSenderHandler(sender, fields, allShards)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSenders -
+func (f *FacadeStub) GetTransactionsPoolForSenders(_ context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+	if f.GetTransactionsPoolForSendersHandler != nil {
+		return f.GetTransactionsPoolForSendersHandler(senders, fields)
 	}
 
 	return nil, nil
 }
 
+// GetAgedTransactionsPool -
+func (f *FacadeStub) GetAgedTransactionsPool(_ context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+	if f.GetAgedTransactionsPoolHandler != nil {
+		return f.GetAgedTransactionsPoolHandler(olderThanSeconds)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolChunk -
+func (f *FacadeStub) GetTransactionsPoolChunk(_ context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+	if f.GetTransactionsPoolChunkHandler != nil {
+		return f.GetTransactionsPoolChunkHandler(shardID, fields, from, size)
+	}
+
+	return nil, nil
+}
+
+// StreamTransactionsPool -
+func (f *FacadeStub) StreamTransactionsPool(_ context.Context, shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error {
+	if f.StreamTransactionsPoolHandler != nil {
+		return f.StreamTransactionsPoolHandler(shardID, fields, chunkSize, handler)
+	}
+
+	return nil
+}
+
 // GetLastPoolNonceForSender -
-func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (f *FacadeStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if f.GetLastPoolNonceForSenderHandler != nil {
 		return f.GetLastPoolNonceForSenderHandler(sender)
 	}
@@ -384,7 +612,7 @@ func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if f.GetTransactionsPoolNonceGapsForSenderHandler != nil {
 		return f.GetTransactionsPoolNonceGapsForSenderHandler(sender)
 	}
@@ -393,67 +621,341 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data
 }
 
 // SendTransaction -
-func (f *FacadeStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (f *FacadeStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, *data.SentTransaction, error) {
 	return f.SendTransactionHandler(tx)
 }
 
 // SimulateTransaction -
-func (f *FacadeStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx, checkSignature)
 }
 
+// ValidateTransaction -
+func (f *FacadeStub) ValidateTransaction(_ context.Context, tx *data.Transaction) (*data.TransactionValidationResult, error) {
+	if f.ValidateTransactionCalled != nil {
+		return f.ValidateTransactionCalled(tx)
+	}
+
+	return nil, nil
+}
+
+// ComputeTransactionFee -
+func (f *FacadeStub) ComputeTransactionFee(_ context.Context, tx *data.Transaction) (*data.TransactionFee, error) {
+	if f.ComputeTransactionFeeCalled != nil {
+		return f.ComputeTransactionFeeCalled(tx)
+	}
+
+	return nil, nil
+}
+
+// BuildESDTTransfer -
+func (f *FacadeStub) BuildESDTTransfer(_ context.Context, request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error) {
+	if f.BuildESDTTransferCalled != nil {
+		return f.BuildESDTTransferCalled(request)
+	}
+
+	return nil, nil
+}
+
+// VerifyTransactionHash -
+func (f *FacadeStub) VerifyTransactionHash(_ context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	if f.VerifyTransactionHashCalled != nil {
+		return f.VerifyTransactionHashCalled(tx)
+	}
+
+	return false, nil
+}
+
+// DecodeTransactionOperation -
+func (f *FacadeStub) DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation {
+	if f.DecodeTransactionOperationCalled != nil {
+		return f.DecodeTransactionOperationCalled(tx)
+	}
+
+	return nil
+}
+
+// DecodeTransactionEvents -
+func (f *FacadeStub) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent {
+	if f.DecodeTransactionEventsCalled != nil {
+		return f.DecodeTransactionEventsCalled(tx)
+	}
+
+	return nil
+}
+
+// GetLiveness -
+func (f *FacadeStub) GetLiveness() *data.ProbeStatus {
+	if f.GetLivenessCalled != nil {
+		return f.GetLivenessCalled()
+	}
+
+	return &data.ProbeStatus{Healthy: true}
+}
+
+// GetReadiness -
+func (f *FacadeStub) GetReadiness() *data.ProbeStatus {
+	if f.GetReadinessCalled != nil {
+		return f.GetReadinessCalled()
+	}
+
+	return &data.ProbeStatus{Healthy: true}
+}
+
 // GetAddressConverter -
 func (f *FacadeStub) GetAddressConverter() (core.PubkeyConverter, error) {
+	if f.GetAddressConverterHandler != nil {
+		return f.GetAddressConverterHandler()
+	}
+
 	return nil, nil
 }
 
 // SendMultipleTransactions -
-func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (f *FacadeStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
 }
 
 // TransactionCostRequest -
-func (f *FacadeStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (f *FacadeStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return f.TransactionCostRequestHandler(tx)
 }
 
 // GetTransactionStatus -
-func (f *FacadeStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (f *FacadeStub) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	return f.GetTransactionStatusHandler(txHash, sender)
 }
 
+// GetFinalTransactionStatus -
+func (f *FacadeStub) GetFinalTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
+	if f.GetFinalTransactionStatusHandler != nil {
+		return f.GetFinalTransactionStatusHandler(txHash, sender)
+	}
+
+	return "", nil
+}
+
 // GetProcessedTransactionStatus -
-func (f *FacadeStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (f *FacadeStub) GetProcessedTransactionStatus(_ context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	return f.GetProcessedTransactionStatusHandler(txHash)
 }
 
+// GetStuckTransactions -
+func (f *FacadeStub) GetStuckTransactions(_ context.Context, address string) (*data.StuckTransactions, error) {
+	if f.GetStuckTransactionsCalled != nil {
+		return f.GetStuckTransactionsCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetCollectionsForAddress -
+func (f *FacadeStub) GetCollectionsForAddress(_ context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error) {
+	if f.GetCollectionsForAddressCalled != nil {
+		return f.GetCollectionsForAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetTokensIssuedByAddress -
+func (f *FacadeStub) GetTokensIssuedByAddress(_ context.Context, address string) ([]*data.IssuedToken, error) {
+	if f.GetTokensIssuedByAddressCalled != nil {
+		return f.GetTokensIssuedByAddressCalled(address)
+	}
+
+	return nil, nil
+}
+
+// VerifyMessageSignature -
+func (f *FacadeStub) VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+	if f.VerifyMessageSignatureCalled != nil {
+		return f.VerifyMessageSignatureCalled(request)
+	}
+
+	return &data.SignatureVerification{}, nil
+}
+
+// GetAddressActivitySummary -
+func (f *FacadeStub) GetAddressActivitySummary(_ context.Context, address string) (*data.AddressActivitySummary, error) {
+	if f.GetAddressActivitySummaryCalled != nil {
+		return f.GetAddressActivitySummaryCalled(address)
+	}
+
+	return &data.AddressActivitySummary{}, nil
+}
+
+// GetAddressLatestTransactions -
+func (f *FacadeStub) GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error) {
+	if f.GetAddressLatestTransactionsCalled != nil {
+		return f.GetAddressLatestTransactionsCalled(address, size)
+	}
+
+	return make([]data.DatabaseTransaction, 0), nil
+}
+
+// GetTokenPrice -
+func (f *FacadeStub) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	if f.GetTokenPriceCalled != nil {
+		return f.GetTokenPriceCalled(token)
+	}
+
+	return &data.TokenPrice{}, nil
+}
+
+// GetTokenHolders -
+func (f *FacadeStub) GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+	if f.GetTokenHoldersCalled != nil {
+		return f.GetTokenHoldersCalled(token, options)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
+
+// IsTokenPriceEnabled -
+func (f *FacadeStub) IsTokenPriceEnabled() bool {
+	if f.IsTokenPriceEnabledCalled != nil {
+		return f.IsTokenPriceEnabledCalled()
+	}
+
+	return false
+}
+
+// GetUsdValue -
+func (f *FacadeStub) GetUsdValue(_ context.Context, token string, amount string) (float64, error) {
+	if f.GetUsdValueCalled != nil {
+		return f.GetUsdValueCalled(token, amount)
+	}
+
+	return 0, nil
+}
+
+// GetCollection -
+func (f *FacadeStub) GetCollection(_ context.Context, collection string) (*data.Collection, error) {
+	if f.GetCollectionCalled != nil {
+		return f.GetCollectionCalled(collection)
+	}
+
+	return nil, nil
+}
+
+// ComputeContractAddress -
+func (f *FacadeStub) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	if f.ComputeContractAddressHandler != nil {
+		return f.ComputeContractAddressHandler(deployer, nonce)
+	}
+
+	return nil, nil
+}
+
+// DecodeDataField -
+func (f *FacadeStub) DecodeDataField(dataField string) (*data.DecodedDataField, error) {
+	if f.DecodeDataFieldHandler != nil {
+		return f.DecodeDataFieldHandler(dataField)
+	}
+
+	return nil, nil
+}
+
+// CheckTransferReceiver -
+func (f *FacadeStub) CheckTransferReceiver(_ context.Context, tx *data.Transaction) (*data.ReceiverCheck, error) {
+	if f.CheckTransferReceiverHandler != nil {
+		return f.CheckTransferReceiverHandler(tx)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionOutcome -
+func (f *FacadeStub) GetTransactionOutcome(_ context.Context, txHash string) (*data.TransactionOutcome, error) {
+	if f.GetTransactionOutcomeHandler != nil {
+		return f.GetTransactionOutcomeHandler(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionJourney -
+func (f *FacadeStub) GetTransactionJourney(_ context.Context, txHash string) (*data.TransactionJourney, error) {
+	if f.GetTransactionJourneyHandler != nil {
+		return f.GetTransactionJourneyHandler(txHash)
+	}
+
+	return nil, nil
+}
+
 // SendUserFunds -
-func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
+func (f *FacadeStub) SendUserFunds(_ context.Context, receiver string, value *big.Int) error {
 	return f.SendUserFundsCalled(receiver, value)
 }
 
+// IsFaucetQueueEnabled -
+func (f *FacadeStub) IsFaucetQueueEnabled() bool {
+	if f.IsFaucetQueueEnabledCalled != nil {
+		return f.IsFaucetQueueEnabledCalled()
+	}
+
+	return false
+}
+
+// EnqueueUserFunds -
+func (f *FacadeStub) EnqueueUserFunds(receiver string, value *big.Int) (*data.FaucetRequest, error) {
+	if f.EnqueueUserFundsCalled != nil {
+		return f.EnqueueUserFundsCalled(receiver, value)
+	}
+
+	return nil, nil
+}
+
+// GetFaucetRequest -
+func (f *FacadeStub) GetFaucetRequest(id string) (*data.FaucetRequest, error) {
+	if f.GetFaucetRequestCalled != nil {
+		return f.GetFaucetRequestCalled(id)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery -
-func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (f *FacadeStub) ExecuteSCQuery(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCMultiContractQuery -
+func (f *FacadeStub) ExecuteSCMultiContractQuery(_ context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+	if f.ExecuteSCMultiContractQueryHandler != nil {
+		return f.ExecuteSCMultiContractQueryHandler(query, scAddresses)
+	}
+
+	return nil, nil
+}
+
 // GetHeartbeatData -
-func (f *FacadeStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (f *FacadeStub) GetHeartbeatData(_ context.Context) (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
 }
 
 // GetBlockByHash -
-func (f *FacadeStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByHash(_ context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByHashCalled(shardID, hash, options)
 }
 
 // GetBlockByNonce -
-func (f *FacadeStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
+// IsBlockFinal -
+func (f *FacadeStub) IsBlockFinal(_ context.Context, shardID uint32, nonce uint64) (bool, error) {
+	if f.IsBlockFinalCalled != nil {
+		return f.IsBlockFinalCalled(shardID, nonce)
+	}
+
+	return true, nil
+}
+
 // GetBlocksByRound -
-func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (f *FacadeStub) GetBlocksByRound(_ context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if f.GetBlocksByRoundCalled != nil {
 		return f.GetBlocksByRoundCalled(round, options)
 	}
@@ -461,32 +963,47 @@ func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOpt
 }
 
 // GetInternalBlockByHash -
-func (f *FacadeStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByHash(_ context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (f *FacadeStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (f *FacadeStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalMiniBlockByHash(_ context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return f.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
+// GetBlocksByHashes -
+func (f *FacadeStub) GetBlocksByHashes(_ context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+	return f.GetBlocksByHashesCalled(requests, options)
+}
+
+// GetEpochStartBlock -
+func (f *FacadeStub) GetEpochStartBlock(_ context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return f.GetEpochStartBlockCalled(shardID, epoch, options)
+}
+
+// GetMiniBlockByHash -
+func (f *FacadeStub) GetMiniBlockByHash(_ context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+	return f.GetMiniBlockByHashCalled(shardID, hash, options)
+}
+
 // GetInternalStartOfEpochMetaBlock -
-func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(_ context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
 
 // GetHyperBlockByHash -
-func (f *FacadeStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByHash(_ context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByHashCalled(hash, options)
 }
 
 // GetHyperBlockByNonce -
-func (f *FacadeStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
@@ -501,27 +1018,90 @@ func (f *FacadeStub) GetMetricsForPrometheus() string {
 }
 
 // GetGenesisNodesPubKeys -
-func (f *FacadeStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGenesisNodesPubKeys(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGenesisNodesPubKeysCalled()
 }
 
 // GetGasConfigs -
-func (f *FacadeStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGasConfigs(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGasConfigsCalled()
 }
 
+// GetGasPriceSuggestion -
+func (f *FacadeStub) GetGasPriceSuggestion(_ context.Context) (*data.GasPriceSuggestion, error) {
+	if f.GetGasPriceSuggestionCalled != nil {
+		return f.GetGasPriceSuggestionCalled()
+	}
+
+	return nil, nil
+}
+
+// SubscribeToNetworkStatus -
+func (f *FacadeStub) SubscribeToNetworkStatus(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+	if f.SubscribeToNetworkStatusCalled != nil {
+		return f.SubscribeToNetworkStatusCalled(shardID)
+	}
+
+	return make(chan *data.NetworkStatusUpdate), func() {}, nil
+}
+
+// ForwardToNode -
+func (f *FacadeStub) ForwardToNode(_ context.Context, shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error) {
+	if f.ForwardToNodeCalled != nil {
+		return f.ForwardToNodeCalled(shardID, method, endpoint, rawQuery, body)
+	}
+
+	return 0, nil, nil
+}
+
+// GetSovereignChainParameters -
+func (f *FacadeStub) GetSovereignChainParameters(_ context.Context) (*data.SovereignChainParameters, error) {
+	if f.GetSovereignChainParametersCalled != nil {
+		return f.GetSovereignChainParametersCalled()
+	}
+
+	return nil, nil
+}
+
+// GetSovereignNotarizedMainChainHeaders -
+func (f *FacadeStub) GetSovereignNotarizedMainChainHeaders(_ context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+	if f.GetSovereignNotarizedMainChainHeadersCalled != nil {
+		return f.GetSovereignNotarizedMainChainHeadersCalled(from, to)
+	}
+
+	return nil, nil
+}
+
+// GetSovereignValidatorsInfo -
+func (f *FacadeStub) GetSovereignValidatorsInfo(_ context.Context, epoch uint32) (*data.SovereignValidatorsInfo, error) {
+	if f.GetSovereignValidatorsInfoCalled != nil {
+		return f.GetSovereignValidatorsInfoCalled(epoch)
+	}
+
+	return nil, nil
+}
+
 // GetAboutInfo -
 func (f *FacadeStub) GetAboutInfo() (*data.GenericAPIResponse, error) {
 	return f.GetAboutInfoCalled()
 }
 
 // GetNodesVersions -
-func (f *FacadeStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNodesVersions(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetNodesVersionsCalled()
 }
 
+// GetExcludedObservers -
+func (f *FacadeStub) GetExcludedObservers() (*data.GenericAPIResponse, error) {
+	if f.GetExcludedObserversCalled != nil {
+		return f.GetExcludedObserversCalled()
+	}
+
+	return nil, nil
+}
+
 // GetAlteredAccountsByNonce -
-func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByNonce(_ context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByNonceCalled != nil {
 		return f.GetAlteredAccountsByNonceCalled(shardID, nonce, options)
 	}
@@ -529,7 +1109,7 @@ func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, opt
 }
 
 // GetAlteredAccountsByHash -
-func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByHash(_ context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByHashCalled != nil {
 		return f.GetAlteredAccountsByHashCalled(shardID, hash, options)
 	}
@@ -538,7 +1118,7 @@ func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, optio
 }
 
 // GetTriesStatistics -
-func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+func (f *FacadeStub) GetTriesStatistics(_ context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if f.GetTriesStatisticsCalled != nil {
 		return f.GetTriesStatisticsCalled(shardID)
 	}
@@ -546,22 +1126,22 @@ func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPI
 }
 
 // GetEpochStartData -
-func (f *FacadeStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEpochStartData(_ context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return f.GetEpochStartDataCalled(epoch, shardID)
 }
 
 // GetInternalStartOfEpochValidatorsInfo -
-func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(_ context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return f.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
 
 // GetCodeHash -
-func (f *FacadeStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetCodeHash(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetCodeHashCalled(address, options)
 }
 
 // IsDataTrieMigrated -
-func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) IsDataTrieMigrated(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.IsDataTrieMigratedCalled != nil {
 		return f.IsDataTrieMigratedCalled(address, options)
 	}
@@ -570,7 +1150,7 @@ func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQu
 }
 
 // GetWaitingEpochsLeftForPublicKey -
-func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(_ context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if f.GetWaitingEpochsLeftForPublicKeyCalled != nil {
 		return f.GetWaitingEpochsLeftForPublicKeyCalled(publicKey)
 	}
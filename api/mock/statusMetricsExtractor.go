@@ -6,7 +6,8 @@ import (
 
 // StatusMetricsExporterStub -
 type StatusMetricsExporterStub struct {
-	AddRequestDataCalled func(path string, withError bool, duration time.Duration)
+	AddRequestDataCalled       func(path string, withError bool, duration time.Duration)
+	AddDeprecatedRequestCalled func(path string)
 }
 
 // AddRequestData -
@@ -16,6 +17,13 @@ func (s *StatusMetricsExporterStub) AddRequestData(path string, withError bool,
 	}
 }
 
+// AddDeprecatedRequest -
+func (s *StatusMetricsExporterStub) AddDeprecatedRequest(path string) {
+	if s.AddDeprecatedRequestCalled != nil {
+		s.AddDeprecatedRequestCalled(path)
+	}
+}
+
 // IsInterfaceNil -
 func (s *StatusMetricsExporterStub) IsInterfaceNil() bool {
 	return s == nil
@@ -0,0 +1,262 @@
+package shared
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// BigIntValidationTag is the binding tag of the fields holding a base-10 unsigned big integer as string
+	BigIntValidationTag = "bigint"
+	// HexStringValidationTag is the binding tag of the fields holding a hex encoded string, possibly empty
+	HexStringValidationTag = "hexstring"
+)
+
+var registerBodyValidatorsOnce sync.Once
+
+// BodyValidationError holds the field-level errors found while binding a request body
+type BodyValidationError struct {
+	Fields []data.FieldValidationError
+}
+
+// Error returns the field-level errors as a single string
+func (err *BodyValidationError) Error() string {
+	messages := make([]string, 0, len(err.Fields))
+	for _, field := range err.Fields {
+		if len(field.Field) == 0 {
+			messages = append(messages, field.Message)
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s %s", field.Field, field.Message))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// RegisterBodyValidators registers the custom binding tags and makes the validation errors refer to the JSON names of
+// the fields. It is safe to be called multiple times
+func RegisterBodyValidators() {
+	registerBodyValidatorsOnce.Do(func() {
+		validate, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		validate.RegisterTagNameFunc(jsonFieldName)
+		_ = validate.RegisterValidation(BigIntValidationTag, isBigIntString)
+		_ = validate.RegisterValidation(HexStringValidationTag, isHexString)
+	})
+}
+
+// BindJSONBody decodes the JSON body of the request into the provided object and validates it against its binding
+// tags. The errors are returned as a *BodyValidationError, holding a readable message for each invalid field. The
+// elements of a list body are validated one by one, so the errors are reported along with their index
+func BindJSONBody(c *gin.Context, obj interface{}) error {
+	RegisterBodyValidators()
+
+	if c.Request == nil || c.Request.Body == nil {
+		return &BodyValidationError{Fields: []data.FieldValidationError{{Message: "empty request body"}}}
+	}
+
+	err := json.NewDecoder(c.Request.Body).Decode(obj)
+	if err != nil {
+		return &BodyValidationError{Fields: []data.FieldValidationError{decodingErrorToFieldError(err)}}
+	}
+
+	fieldErrors := validateBody(reflect.ValueOf(obj), "")
+	if len(fieldErrors) > 0 {
+		return &BodyValidationError{Fields: fieldErrors}
+	}
+
+	return nil
+}
+
+// RespondWithBodyValidationError responds with 400, holding the field-level errors in the data field of the response
+// if the inner error was returned by BindJSONBody
+func RespondWithBodyValidationError(c *gin.Context, err error, innerErr error) {
+	var dataField interface{}
+	bodyValidationErr := &BodyValidationError{}
+	if errors.As(innerErr, &bodyValidationErr) {
+		dataField = gin.H{"fields": bodyValidationErr.Fields}
+	}
+
+	RespondWith(
+		c,
+		http.StatusBadRequest,
+		dataField,
+		fmt.Sprintf("%s: %s", err.Error(), innerErr.Error()),
+		data.ReturnCodeRequestError,
+	)
+}
+
+func validateBody(value reflect.Value, prefix string) []data.FieldValidationError {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		return validationErrorToFieldErrors(binding.Validator.ValidateStruct(value.Addr().Interface()), prefix)
+	case reflect.Slice, reflect.Array:
+		fieldErrors := make([]data.FieldValidationError, 0)
+		for idx := 0; idx < value.Len(); idx++ {
+			fieldErrors = append(fieldErrors, validateBody(value.Index(idx), fmt.Sprintf("%s[%d]", prefix, idx))...)
+		}
+
+		return fieldErrors
+	default:
+		return nil
+	}
+}
+
+func validationErrorToFieldErrors(err error, prefix string) []data.FieldValidationError {
+	if err == nil {
+		return nil
+	}
+
+	validationErrors := validator.ValidationErrors{}
+	if !errors.As(err, &validationErrors) {
+		return []data.FieldValidationError{{Field: prefix, Message: err.Error()}}
+	}
+
+	fieldErrors := make([]data.FieldValidationError, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		fieldErrors = append(fieldErrors, data.FieldValidationError{
+			Field:   joinFieldPath(prefix, trimStructName(fieldErr.Namespace())),
+			Message: describeFailedTag(fieldErr),
+		})
+	}
+
+	return fieldErrors
+}
+
+func describeFailedTag(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case BigIntValidationTag:
+		return "must be a base-10 string"
+	case HexStringValidationTag:
+		return "is not a valid hex string"
+	case "min":
+		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", fieldErr.Param())
+	default:
+		return fmt.Sprintf("failed the %s check", fieldErr.Tag())
+	}
+}
+
+func decodingErrorToFieldError(err error) data.FieldValidationError {
+	if errors.Is(err, io.EOF) {
+		return data.FieldValidationError{Message: "empty request body"}
+	}
+
+	typeErr := &json.UnmarshalTypeError{}
+	if errors.As(err, &typeErr) {
+		return data.FieldValidationError{
+			Field:   typeErr.Field,
+			Message: fmt.Sprintf("must be %s, not a JSON %s", describeExpectedType(typeErr.Type), typeErr.Value),
+		}
+	}
+
+	syntaxErr := &json.SyntaxError{}
+	if errors.As(err, &syntaxErr) {
+		return data.FieldValidationError{Message: fmt.Sprintf("malformed JSON at offset %d: %s", syntaxErr.Offset, syntaxErr.Error())}
+	}
+
+	return data.FieldValidationError{Message: err.Error()}
+}
+
+func describeExpectedType(expectedType reflect.Type) string {
+	if expectedType == reflect.TypeOf(big.Int{}) {
+		return "an integer"
+	}
+
+	switch expectedType.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a non-negative integer"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		if expectedType.Elem().Kind() == reflect.Uint8 {
+			return "a base64 string"
+		}
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	default:
+		return expectedType.String()
+	}
+}
+
+// trimStructName removes the name of the root struct from the namespace of the field, as the clients only know the
+// JSON names of the fields
+func trimStructName(namespace string) string {
+	idx := strings.Index(namespace, ".")
+	if idx < 0 {
+		return namespace
+	}
+
+	return namespace[idx+1:]
+}
+
+func joinFieldPath(prefix string, field string) string {
+	if len(prefix) == 0 {
+		return field
+	}
+
+	return prefix + "." + field
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	if len(name) == 0 {
+		return field.Name
+	}
+
+	return name
+}
+
+func isBigIntString(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if len(value) == 0 || strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		return false
+	}
+
+	_, ok := big.NewInt(0).SetString(value, 10)
+	return ok
+}
+
+func isHexString(fl validator.FieldLevel) bool {
+	_, err := hex.DecodeString(fl.Field().String())
+	return err == nil
+}
@@ -0,0 +1,133 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransfer struct {
+	Token  string `json:"token" binding:"required"`
+	Amount string `json:"amount" binding:"required,bigint"`
+}
+
+type testBody struct {
+	Value     string          `json:"value" binding:"omitempty,bigint"`
+	GasLimit  uint64          `json:"gasLimit" binding:"required"`
+	Signature string          `json:"signature" binding:"hexstring"`
+	Transfers []*testTransfer `json:"transfers" binding:"dive"`
+}
+
+func createContextWithBody(body string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+
+	return c
+}
+
+func requireFieldErrors(t *testing.T, err error, expectedFieldErrors ...data.FieldValidationError) {
+	bodyValidationErr := &BodyValidationError{}
+	require.True(t, errors.As(err, &bodyValidationErr))
+	require.Equal(t, expectedFieldErrors, bodyValidationErr.Fields)
+}
+
+func TestBindJSONBody(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty body should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := BindJSONBody(createContextWithBody(""), &testBody{})
+		requireFieldErrors(t, err, data.FieldValidationError{Message: "empty request body"})
+	})
+	t.Run("malformed JSON should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := BindJSONBody(createContextWithBody(`{"gasLimit": }`), &testBody{})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "malformed JSON at offset")
+	})
+	t.Run("wrong JSON type should report the field", func(t *testing.T) {
+		t.Parallel()
+
+		err := BindJSONBody(createContextWithBody(`{"gasLimit": "50000"}`), &testBody{})
+		requireFieldErrors(t, err, data.FieldValidationError{Field: "gasLimit", Message: "must be a non-negative integer, not a JSON string"})
+	})
+	t.Run("invalid fields should be reported by their JSON names", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"value": "1e18", "signature": "zz", "transfers": [{"token": "TKN-123456", "amount": "10"}, {"amount": "-1"}]}`
+		err := BindJSONBody(createContextWithBody(body), &testBody{})
+		requireFieldErrors(t, err,
+			data.FieldValidationError{Field: "value", Message: "must be a base-10 string"},
+			data.FieldValidationError{Field: "gasLimit", Message: "is required"},
+			data.FieldValidationError{Field: "signature", Message: "is not a valid hex string"},
+			data.FieldValidationError{Field: "transfers[1].token", Message: "is required"},
+			data.FieldValidationError{Field: "transfers[1].amount", Message: "must be a base-10 string"},
+		)
+		assert.Equal(t, "value must be a base-10 string; gasLimit is required; signature is not a valid hex string; "+
+			"transfers[1].token is required; transfers[1].amount must be a base-10 string", err.Error())
+	})
+	t.Run("list body should report the index of the invalid elements", func(t *testing.T) {
+		t.Parallel()
+
+		bodies := make([]*testBody, 0)
+		err := BindJSONBody(createContextWithBody(`[{"gasLimit": 1}, {"value": "10"}]`), &bodies)
+		requireFieldErrors(t, err, data.FieldValidationError{Field: "[1].gasLimit", Message: "is required"})
+	})
+	t.Run("valid body should work", func(t *testing.T) {
+		t.Parallel()
+
+		body := &testBody{}
+		err := BindJSONBody(createContextWithBody(`{"value": "1000000000000000000000", "gasLimit": 50000, "signature": ""}`), body)
+		require.Nil(t, err)
+		assert.Equal(t, &testBody{Value: "1000000000000000000000", GasLimit: 50000}, body)
+	})
+}
+
+func TestRespondWithBodyValidationError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("body validation error should return the fields", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		innerErr := &BodyValidationError{Fields: []data.FieldValidationError{{Field: "gasLimit", Message: "is required"}}}
+		RespondWithBodyValidationError(c, errors.New("validation error"), innerErr)
+
+		response := struct {
+			Data struct {
+				Fields []data.FieldValidationError `json:"fields"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}{}
+		require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Equal(t, innerErr.Fields, response.Data.Fields)
+		assert.Equal(t, "validation error: gasLimit is required", response.Error)
+		assert.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("other error should not return data", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		RespondWithBodyValidationError(c, errors.New("validation error"), errors.New("other error"))
+
+		response := data.GenericAPIResponse{}
+		require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Nil(t, response.Data)
+		assert.Equal(t, "validation error: other error", response.Error)
+	})
+}
@@ -0,0 +1,103 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldsQueryParam is the query parameter holding the comma separated list of fields to be kept in a response
+const FieldsQueryParam = "fields"
+
+// fieldsTree holds the selected fields, indexed by their JSON name. A field without selected sub-fields (nil) is kept
+// as it is
+type fieldsTree map[string]fieldsTree
+
+// SelectFields returns the value reduced to the fields listed in the fields query parameter, such as
+// ?fields=nonce,balance. The fields are matched against the JSON names of the value and nested fields are selected
+// with a dotted path, such as ?fields=nonce,miniBlocks.hash, the selection being applied on each element of an array.
+// Unknown fields are ignored. If no field is requested, the value is returned unchanged
+func SelectFields(c *gin.Context, value interface{}) interface{} {
+	selectedFields := parseFields(c.Query(FieldsQueryParam))
+	if len(selectedFields) == 0 {
+		return value
+	}
+
+	jsonBuff, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBuff))
+	decoder.UseNumber()
+
+	var generic interface{}
+	err = decoder.Decode(&generic)
+	if err != nil {
+		return value
+	}
+
+	return projectFields(generic, selectedFields)
+}
+
+func parseFields(fieldsParam string) fieldsTree {
+	selectedFields := make(fieldsTree)
+	for _, field := range strings.Split(fieldsParam, ",") {
+		field = strings.TrimSpace(field)
+		if len(field) == 0 {
+			continue
+		}
+
+		node := selectedFields
+		names := strings.Split(field, ".")
+		for i, name := range names {
+			child, found := node[name]
+			isWholeField := found && child == nil
+			if isWholeField {
+				break
+			}
+			if i == len(names)-1 {
+				node[name] = nil
+				break
+			}
+
+			if !found {
+				child = make(fieldsTree)
+				node[name] = child
+			}
+			node = child
+		}
+	}
+
+	return selectedFields
+}
+
+func projectFields(value interface{}, selectedFields fieldsTree) interface{} {
+	if len(selectedFields) == 0 {
+		return value
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		projection := make(map[string]interface{}, len(selectedFields))
+		for name, subFields := range selectedFields {
+			fieldValue, found := typedValue[name]
+			if !found {
+				continue
+			}
+
+			projection[name] = projectFields(fieldValue, subFields)
+		}
+		return projection
+	case []interface{}:
+		projection := make([]interface{}, 0, len(typedValue))
+		for _, element := range typedValue {
+			projection = append(projection, projectFields(element, selectedFields))
+		}
+		return projection
+	default:
+		return value
+	}
+}
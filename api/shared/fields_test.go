@@ -0,0 +1,87 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMiniBlock struct {
+	Hash    string `json:"hash"`
+	Type    string `json:"type"`
+	NumTxs  int    `json:"numTxs"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type testBlock struct {
+	Nonce      uint64           `json:"nonce"`
+	Hash       string           `json:"hash"`
+	Balance    string           `json:"balance"`
+	MiniBlocks []*testMiniBlock `json:"miniBlocks"`
+}
+
+func createContextWithQuery(query string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest(http.MethodGet, "/test?"+query, nil)
+
+	return c
+}
+
+func selectFieldsAsJSON(t *testing.T, query string, value interface{}) string {
+	buff, err := json.Marshal(SelectFields(createContextWithQuery(query), value))
+	require.Nil(t, err)
+
+	return string(buff)
+}
+
+func TestSelectFields(t *testing.T) {
+	t.Parallel()
+
+	block := &testBlock{
+		Nonce:   18446744073709551615,
+		Hash:    "aa",
+		Balance: "1000",
+		MiniBlocks: []*testMiniBlock{
+			{Hash: "bb", Type: "TxBlock", NumTxs: 2},
+			{Hash: "cc", Type: "SmartContractResultBlock", NumTxs: 1},
+		},
+	}
+
+	t.Run("no fields should return the value unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		c := createContextWithQuery("")
+		assert.True(t, block == SelectFields(c, block))
+
+		c = createContextWithQuery("fields=,")
+		assert.True(t, block == SelectFields(c, block))
+	})
+	t.Run("top level fields should be selected", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `{"balance":"1000","nonce":18446744073709551615}`, selectFieldsAsJSON(t, "fields=nonce, balance", block))
+	})
+	t.Run("nested fields should be selected on each element", func(t *testing.T) {
+		t.Parallel()
+
+		expected := `{"miniBlocks":[{"hash":"bb"},{"hash":"cc"}],"nonce":18446744073709551615}`
+		assert.Equal(t, expected, selectFieldsAsJSON(t, "fields=nonce,miniBlocks.hash", block))
+	})
+	t.Run("a whole field should win over its sub-fields", func(t *testing.T) {
+		t.Parallel()
+
+		expected := `{"miniBlocks":[{"hash":"bb","numTxs":2,"type":"TxBlock"},{"hash":"cc","numTxs":1,"type":"SmartContractResultBlock"}]}`
+		assert.Equal(t, expected, selectFieldsAsJSON(t, "fields=miniBlocks.hash,miniBlocks", block))
+		assert.Equal(t, expected, selectFieldsAsJSON(t, "fields=miniBlocks,miniBlocks.hash", block))
+	})
+	t.Run("unknown fields should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `{"hash":"aa","miniBlocks":[{},{}]}`, selectFieldsAsJSON(t, "fields=hash,unknown,miniBlocks.unknown.other", block))
+	})
+}
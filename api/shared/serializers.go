@@ -0,0 +1,297 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const serializerContextKey = "responseSerializer"
+
+type responseSerializerV1 struct {
+}
+
+// NewResponseSerializerV1 returns a serializer that writes the responses as they are, using the generic API response
+func NewResponseSerializerV1() *responseSerializerV1 {
+	return &responseSerializerV1{}
+}
+
+// Serialize writes the response as JSON, without altering its shape
+func (rs *responseSerializerV1) Serialize(c *gin.Context, status int, response interface{}) {
+	c.JSON(status, response)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *responseSerializerV1) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+type responseSerializerV2 struct {
+}
+
+// NewResponseSerializerV2 returns a serializer that writes the responses using the v2 API response, which holds
+// the error as an object that is omitted on successful requests. The responses not following the generic API response
+// format are wrapped in it, so that all the v2 endpoints return the same data, error and code envelope
+func NewResponseSerializerV2() *responseSerializerV2 {
+	return &responseSerializerV2{}
+}
+
+// Serialize converts the response to the v2 API response and writes it as JSON
+func (rs *responseSerializerV2) Serialize(c *gin.Context, status int, response interface{}) {
+	genericResponse, ok := toGenericAPIResponse(response)
+	if !ok {
+		c.JSON(status, wrapInAPIResponseV2(status, response))
+		return
+	}
+
+	responseV2 := data.GenericAPIResponseV2{
+		Data: genericResponse.Data,
+		Code: genericResponse.Code,
+	}
+	if len(genericResponse.Error) > 0 {
+		responseV2.Error = &data.APIErrorV2{
+			Message: genericResponse.Error,
+		}
+	}
+
+	c.JSON(status, responseV2)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *responseSerializerV2) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+// errorMessageHolder defines the responses which carry their own errors, such as the GraphQL ones
+type errorMessageHolder interface {
+	ErrorMessage() string
+}
+
+// wrapInAPIResponseV2 holds the response as data, with the code derived from the status. The error message is the one
+// carried by the response, if any, the status text being used only for the responses without one
+func wrapInAPIResponseV2(status int, response interface{}) *data.GenericAPIResponseV2 {
+	responseV2 := &data.GenericAPIResponseV2{
+		Data: response,
+		Code: data.ReturnCodeSuccess,
+	}
+	switch {
+	case status >= http.StatusInternalServerError:
+		responseV2.Code = data.ReturnCodeInternalError
+	case status >= http.StatusBadRequest:
+		responseV2.Code = data.ReturnCodeRequestError
+	}
+	if status >= http.StatusBadRequest {
+		responseV2.Error = &data.APIErrorV2{
+			Message: getErrorMessage(status, response),
+		}
+	}
+
+	return responseV2
+}
+
+func getErrorMessage(status int, response interface{}) string {
+	message := ""
+	switch typedResponse := response.(type) {
+	case errorMessageHolder:
+		message = typedResponse.ErrorMessage()
+	case error:
+		message = typedResponse.Error()
+	}
+	if len(message) == 0 {
+		return http.StatusText(status)
+	}
+
+	return message
+}
+
+// toGenericAPIResponse returns false if the response does not follow the generic API response format, that is it is
+// not an object holding the data field along with, optionally, the error and the code
+func toGenericAPIResponse(response interface{}) (*data.GenericAPIResponse, bool) {
+	switch typedResponse := response.(type) {
+	case data.GenericAPIResponse:
+		return &typedResponse, true
+	case *data.GenericAPIResponse:
+		return typedResponse, true
+	case gin.H:
+		return genericAPIResponseFromMap(typedResponse)
+	case map[string]interface{}:
+		return genericAPIResponseFromMap(typedResponse)
+	case json.RawMessage:
+		return genericAPIResponseFromJSON(typedResponse)
+	}
+
+	// the responses fetched from the observers are typed structs mirroring the generic API response, so their
+	// fields are read by their JSON names
+	return genericAPIResponseFromStruct(reflect.ValueOf(response))
+}
+
+// genericAPIResponseFromJSON converts the responses forwarded as they were received from the nodes
+func genericAPIResponseFromJSON(response json.RawMessage) (*data.GenericAPIResponse, bool) {
+	fields := make(map[string]json.RawMessage)
+	err := json.Unmarshal(response, &fields)
+	if err != nil {
+		return nil, false
+	}
+
+	genericResponse := &data.GenericAPIResponse{}
+	for field, value := range fields {
+		switch field {
+		case "data":
+			genericResponse.Data = value
+		case "error":
+			err = json.Unmarshal(value, &genericResponse.Error)
+		case "code":
+			err = json.Unmarshal(value, &genericResponse.Code)
+		default:
+			return nil, false
+		}
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	_, hasData := fields["data"]
+	return genericResponse, hasData
+}
+
+func genericAPIResponseFromMap(response map[string]interface{}) (*data.GenericAPIResponse, bool) {
+	genericResponse := &data.GenericAPIResponse{}
+	for field, value := range response {
+		ok := setGenericAPIResponseField(genericResponse, field, reflect.ValueOf(value))
+		if !ok {
+			return nil, false
+		}
+	}
+
+	_, hasData := response["data"]
+	return genericResponse, hasData
+}
+
+func genericAPIResponseFromStruct(response reflect.Value) (*data.GenericAPIResponse, bool) {
+	for response.Kind() == reflect.Pointer {
+		if response.IsNil() {
+			return nil, false
+		}
+		response = response.Elem()
+	}
+	if response.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	genericResponse := &data.GenericAPIResponse{}
+	hasData := false
+	responseType := response.Type()
+	for idx := 0; idx < responseType.NumField(); idx++ {
+		structField := responseType.Field(idx)
+		if !structField.IsExported() {
+			continue
+		}
+
+		field := getJSONFieldName(structField)
+		if field == "-" {
+			continue
+		}
+
+		ok := setGenericAPIResponseField(genericResponse, field, response.Field(idx))
+		if !ok {
+			return nil, false
+		}
+		hasData = hasData || field == "data"
+	}
+
+	return genericResponse, hasData
+}
+
+func getJSONFieldName(structField reflect.StructField) string {
+	name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+	if len(name) == 0 {
+		return structField.Name
+	}
+
+	return name
+}
+
+// setGenericAPIResponseField returns false if the field is not one of the generic API response or does not hold a
+// value of the expected type
+func setGenericAPIResponseField(genericResponse *data.GenericAPIResponse, field string, value reflect.Value) bool {
+	switch field {
+	case "data":
+		if value.IsValid() {
+			genericResponse.Data = value.Interface()
+		}
+		return true
+	case "error":
+		if !value.IsValid() {
+			return true
+		}
+		if value.Kind() != reflect.String {
+			return false
+		}
+		genericResponse.Error = value.String()
+		return true
+	case "code":
+		if !value.IsValid() {
+			return true
+		}
+		if value.Kind() != reflect.String {
+			return false
+		}
+		genericResponse.Code = data.ReturnCode(value.String())
+		return true
+	default:
+		return false
+	}
+}
+
+// SerializerMiddleware returns a middleware that sets the response serializer to be used by the handlers of a version
+func SerializerMiddleware(serializer data.ResponseSerializer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(serializerContextKey, serializer)
+		c.Next()
+	}
+}
+
+// RespondWithJSON will write the response using the serializer of the version the request was routed to
+func RespondWithJSON(c *gin.Context, status int, response interface{}) {
+	setObserverZoneHeader(c)
+	getSerializer(c).Serialize(c, status, response)
+}
+
+// AbortWith stops the handlers chain and responds with the generic API response, using the serializer of the version
+// the request was routed to, so that the requests rejected by the middlewares get the same envelope as the others
+func AbortWith(c *gin.Context, status int, dataField interface{}, error string, code data.ReturnCode) {
+	c.Abort()
+	RespondWith(c, status, dataField, error, code)
+}
+
+// setObserverZoneHeader exposes the zone of the observer which served the request, if the observers are grouped by zone
+func setObserverZoneHeader(c *gin.Context) {
+	if c.Request == nil {
+		return
+	}
+
+	zone := common.GetObserverZone(c.Request.Context())
+	if len(zone) > 0 {
+		c.Header(common.ObserverZoneHeader, zone)
+	}
+}
+
+func getSerializer(c *gin.Context) data.ResponseSerializer {
+	value, ok := c.Get(serializerContextKey)
+	if !ok {
+		return NewResponseSerializerV1()
+	}
+
+	serializer, ok := value.(data.ResponseSerializer)
+	if !ok || check.IfNil(serializer) {
+		return NewResponseSerializerV1()
+	}
+
+	return serializer
+}
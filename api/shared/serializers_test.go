@@ -0,0 +1,206 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+type observerResponse struct {
+	Data  map[string]interface{} `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type responseWithErrors struct {
+	Errors []string `json:"errors"`
+}
+
+func (r *responseWithErrors) ErrorMessage() string {
+	return strings.Join(r.Errors, "; ")
+}
+
+func serveWithSerializer(serializer data.ResponseSerializer, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	group := ws.Group("/test")
+	if serializer != nil {
+		group.Use(SerializerMiddleware(serializer))
+	}
+	group.GET("", handler)
+
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestRespondWithJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no serializer should use the v1 format", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(nil, func(c *gin.Context) {
+			RespondWith(c, http.StatusBadRequest, nil, "invalid shard", data.ReturnCodeRequestError)
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t, `{"data":null,"error":"invalid shard","code":"bad_request"}`, resp.Body.String())
+	})
+	t.Run("the zone of the observer which served the request should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(nil, func(c *gin.Context) {
+			RespondWith(c, http.StatusOK, nil, "", data.ReturnCodeSuccess)
+		})
+		assert.Empty(t, resp.Header().Get(common.ObserverZoneHeader))
+
+		resp = serveWithSerializer(nil, func(c *gin.Context) {
+			c.Request = c.Request.WithContext(common.ContextWithRequestID(c.Request.Context(), "request"))
+			common.SetObserverZone(c.Request.Context(), "eu-west")
+			RespondWith(c, http.StatusOK, nil, "", data.ReturnCodeSuccess)
+		})
+		assert.Equal(t, "eu-west", resp.Header().Get(common.ObserverZoneHeader))
+	})
+	t.Run("v1 serializer should not alter the response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV1(), func(c *gin.Context) {
+			RespondWithJSON(c, http.StatusOK, &observerResponse{Data: map[string]interface{}{"nonce": 5}, Code: "successful"})
+		})
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"nonce":5},"error":"","code":"successful"}`, resp.Body.String())
+	})
+	t.Run("v2 serializer should return the error as object", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV2(), func(c *gin.Context) {
+			RespondWith(c, http.StatusBadRequest, nil, "invalid shard", data.ReturnCodeRequestError)
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t, `{"data":null,"error":{"message":"invalid shard"},"code":"bad_request"}`, resp.Body.String())
+	})
+	t.Run("v2 serializer should omit the error on success", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV2(), func(c *gin.Context) {
+			RespondWithJSON(c, http.StatusOK, &observerResponse{Data: map[string]interface{}{"nonce": 5}, Code: "successful"})
+		})
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"nonce":5},"code":"successful"}`, resp.Body.String())
+	})
+	t.Run("v2 serializer should wrap the responses not following the generic format", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV2(), func(c *gin.Context) {
+			RespondWithJSON(c, http.StatusOK, []int{1, 2})
+		})
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":[1,2],"code":"successful"}`, resp.Body.String())
+	})
+	t.Run("v2 serializer should wrap the failed responses not following the generic format with an error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV2(), func(c *gin.Context) {
+			RespondWithJSON(c, http.StatusBadRequest, gin.H{"data": nil, "errors": []string{"syntax error"}})
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t, `{"data":{"data":null,"errors":["syntax error"]},"error":{"message":"Bad Request"},"code":"bad_request"}`, resp.Body.String())
+	})
+	t.Run("v2 serializer should keep the errors carried by the wrapped responses", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serveWithSerializer(NewResponseSerializerV2(), func(c *gin.Context) {
+			RespondWithJSON(c, http.StatusBadRequest, &responseWithErrors{Errors: []string{"syntax error", "unknown field"}})
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t, `{"data":{"errors":["syntax error","unknown field"]},"error":{"message":"syntax error; unknown field"},"code":"bad_request"}`, resp.Body.String())
+	})
+}
+
+func TestToGenericAPIResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("typed response should be converted", func(t *testing.T) {
+		t.Parallel()
+
+		response := &data.HyperblockApiResponse{Error: "err", Code: data.ReturnCodeInternalError}
+		genericResponse, ok := toGenericAPIResponse(response)
+		assert.True(t, ok)
+		assert.Equal(t, &data.GenericAPIResponse{Data: response.Data, Error: "err", Code: data.ReturnCodeInternalError}, genericResponse)
+	})
+	t.Run("raw node response should be converted", func(t *testing.T) {
+		t.Parallel()
+
+		genericResponse, ok := toGenericAPIResponse(json.RawMessage(`{"data":{"nonce":5},"error":"","code":"successful"}`))
+		assert.True(t, ok)
+		assert.Equal(t, &data.GenericAPIResponse{Data: json.RawMessage(`{"nonce":5}`), Code: data.ReturnCodeSuccess}, genericResponse)
+
+		_, ok = toGenericAPIResponse(json.RawMessage(`{"data":{"nonce":5},"status":"ok"}`))
+		assert.False(t, ok)
+	})
+	t.Run("map response should be converted", func(t *testing.T) {
+		t.Parallel()
+
+		genericResponse, ok := toGenericAPIResponse(gin.H{"data": 5, "code": "successful"})
+		assert.True(t, ok)
+		assert.Equal(t, &data.GenericAPIResponse{Data: 5, Code: data.ReturnCodeSuccess}, genericResponse)
+	})
+	t.Run("responses not following the generic format should not be converted", func(t *testing.T) {
+		t.Parallel()
+
+		responses := []interface{}{
+			nil,
+			[]int{1, 2},
+			(*observerResponse)(nil),
+			gin.H{"code": "successful"},
+			gin.H{"data": 5, "error": 3},
+			struct {
+				Data   int `json:"data"`
+				Status int `json:"status"`
+			}{},
+		}
+		for _, response := range responses {
+			_, ok := toGenericAPIResponse(response)
+			assert.False(t, ok, "%v", response)
+		}
+	})
+}
+
+func TestAbortWith(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	group := ws.Group("/v2")
+	group.Use(SerializerMiddleware(NewResponseSerializerV2()))
+	handlerCalled := false
+	group.GET("/test", func(c *gin.Context) {
+		AbortWith(c, http.StatusTooManyRequests, nil, "too many requests", data.ReturnCodeRequestError)
+	}, func(c *gin.Context) {
+		handlerCalled = true
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v2/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.False(t, handlerCalled)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.JSONEq(t, `{"data":null,"error":{"message":"too many requests"},"code":"bad_request"}`, resp.Body.String())
+}
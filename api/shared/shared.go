@@ -13,7 +13,8 @@ import (
 
 // RespondWith will respond with the generic API response
 func RespondWith(c *gin.Context, status int, dataField interface{}, error string, code data.ReturnCode) {
-	c.JSON(
+	RespondWithJSON(
+		c,
 		status,
 		data.GenericAPIResponse{
 			Data:  dataField,
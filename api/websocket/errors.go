@@ -0,0 +1,27 @@
+package websocket
+
+import "errors"
+
+// ErrNilDispatcher signals that a nil requests dispatcher has been provided
+var ErrNilDispatcher = errors.New("nil requests dispatcher")
+
+// ErrNilAuthenticator signals that a nil authenticator has been provided
+var ErrNilAuthenticator = errors.New("nil authenticator")
+
+// ErrInvalidConfigValue signals that an invalid value has been provided in the WebSocket RPC config
+var ErrInvalidConfigValue = errors.New("invalid WebSocket RPC config value")
+
+// ErrInvalidRequest signals that a message could not be decoded as a request
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ErrUnsupportedMethod signals that a request has a method which can not be used over the WebSocket
+var ErrUnsupportedMethod = errors.New("unsupported method")
+
+// ErrInvalidPath signals that a request has an invalid path
+var ErrInvalidPath = errors.New("invalid path")
+
+// ErrStreamingNotSupported signals that a request targeted a streaming endpoint
+var ErrStreamingNotSupported = errors.New("the streaming endpoints can not be called over the WebSocket")
+
+// ErrOperationTimeout signals that a request did not finish in time
+var ErrOperationTimeout = errors.New("operation timeout")
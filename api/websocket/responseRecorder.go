@@ -0,0 +1,53 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+)
+
+// responseRecorder holds the response of a request dispatched to the API routes. A flush is only issued by the streaming
+// endpoints, which can not be served over the WebSocket, so it cancels the request instead
+type responseRecorder struct {
+	header        http.Header
+	status        int
+	body          bytes.Buffer
+	isStreaming   bool
+	cancelRequest context.CancelFunc
+}
+
+func newResponseRecorder(cancelRequest context.CancelFunc) *responseRecorder {
+	return &responseRecorder{
+		header:        make(http.Header),
+		cancelRequest: cancelRequest,
+	}
+}
+
+// Header returns the headers of the response
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+// Write appends the bytes to the response body
+func (rr *responseRecorder) Write(buff []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+
+	return rr.body.Write(buff)
+}
+
+// WriteHeader sets the status of the response, only the first call being taken into account
+func (rr *responseRecorder) WriteHeader(statusCode int) {
+	if rr.status != 0 {
+		return
+	}
+
+	rr.status = statusCode
+}
+
+// Flush marks the response as streamed and cancels the request
+func (rr *responseRecorder) Flush() {
+	rr.isStreaming = true
+	rr.cancelRequest()
+}
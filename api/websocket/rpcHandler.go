@@ -0,0 +1,371 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+var log = common.NewRequestIDLogger(logger.GetOrCreate("api/websocket"))
+
+// hopHeaders holds the headers of the upgrade request which are specific to the WebSocket connection, so they are not
+// copied on the dispatched requests. The request identifier is generated for each request instead
+var hopHeaders = []string{
+	"Connection",
+	"Upgrade",
+	"Accept-Encoding",
+	"Sec-Websocket-Key",
+	"Sec-Websocket-Version",
+	"Sec-Websocket-Extensions",
+	"Sec-Websocket-Protocol",
+	common.RequestIDHeader,
+}
+
+// credentialHeaders holds the headers of the upgrade request which carry credentials. They are never copied on the
+// dispatched requests, as the connection is authenticated once, when it is opened
+var credentialHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	middleware.ApiKeyHeader,
+}
+
+// Request defines an API request sent as a message over the WebSocket connection. The path is the one of the HTTP
+// request, along with the version and the query, such as /v1.0/address/erd1...?onFinalBlock=true
+type Request struct {
+	ID     string          `json:"id"`
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response defines the answer to a request, sent with the same id. The response holds the body returned by the API
+// route, while the error is only set when the request could not be dispatched
+type Response struct {
+	ID       string          `json:"id"`
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// ArgsRPCHandler holds the arguments needed to create a WebSocket RPC handler. The authenticator checks the Basic
+// Authentication credentials of the upgrade request, aborting it if they are invalid
+type ArgsRPCHandler struct {
+	Dispatcher    http.Handler
+	Authenticator gin.HandlerFunc
+	Path          string
+	Config        config.WebSocketRPCConfig
+}
+
+type rpcHandler struct {
+	dispatcher              http.Handler
+	authenticator           gin.HandlerFunc
+	path                    string
+	allowedOrigins          map[string]struct{}
+	maxConcurrentOperations int
+	operationTimeout        time.Duration
+	maxMessageSize          int64
+	pingInterval            time.Duration
+	upgrader                websocket.Upgrader
+}
+
+// NewRPCHandler creates a handler serving the API requests received over WebSocket connections. The requests are
+// dispatched to the provided handler, which holds the API routes
+func NewRPCHandler(args ArgsRPCHandler) (*rpcHandler, error) {
+	if args.Dispatcher == nil {
+		return nil, ErrNilDispatcher
+	}
+	if args.Authenticator == nil {
+		return nil, ErrNilAuthenticator
+	}
+	if args.Config.MaxConcurrentOperations <= 0 {
+		return nil, fmt.Errorf("%w for MaxConcurrentOperations: %d", ErrInvalidConfigValue, args.Config.MaxConcurrentOperations)
+	}
+	if args.Config.OperationTimeoutSec <= 0 {
+		return nil, fmt.Errorf("%w for OperationTimeoutSec: %d", ErrInvalidConfigValue, args.Config.OperationTimeoutSec)
+	}
+	if args.Config.MaxMessageSizeBytes <= 0 {
+		return nil, fmt.Errorf("%w for MaxMessageSizeBytes: %d", ErrInvalidConfigValue, args.Config.MaxMessageSizeBytes)
+	}
+	if args.Config.PingIntervalSec <= 0 {
+		return nil, fmt.Errorf("%w for PingIntervalSec: %d", ErrInvalidConfigValue, args.Config.PingIntervalSec)
+	}
+
+	allowedOrigins := make(map[string]struct{}, len(args.Config.AllowedOrigins))
+	for _, origin := range args.Config.AllowedOrigins {
+		allowedOrigins[strings.ToLower(origin)] = struct{}{}
+	}
+
+	rh := &rpcHandler{
+		dispatcher:              args.Dispatcher,
+		authenticator:           args.Authenticator,
+		path:                    args.Path,
+		allowedOrigins:          allowedOrigins,
+		maxConcurrentOperations: args.Config.MaxConcurrentOperations,
+		operationTimeout:        time.Duration(args.Config.OperationTimeoutSec) * time.Second,
+		maxMessageSize:          args.Config.MaxMessageSizeBytes,
+		pingInterval:            time.Duration(args.Config.PingIntervalSec) * time.Second,
+	}
+	rh.upgrader = websocket.Upgrader{
+		EnableCompression: true,
+		CheckOrigin:       rh.checkOrigin,
+	}
+
+	return rh, nil
+}
+
+// checkOrigin accepts the connections opened by the browsers only from the allowed origins. The other clients do not
+// send the Origin header
+func (rh *rpcHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if len(origin) == 0 {
+		return true
+	}
+
+	_, isAllowed := rh.allowedOrigins[strings.ToLower(origin)]
+	return isAllowed
+}
+
+// HandleConnection upgrades the request to a WebSocket connection and serves the requests received on it until the
+// connection is closed. If the upgrade request holds credentials, they are checked once, the requests received on the
+// connection being then served as the ones of the authenticated user
+func (rh *rpcHandler) HandleConnection(c *gin.Context) {
+	user, _, hasCredentials := c.Request.BasicAuth()
+	if hasCredentials {
+		rh.authenticator(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	conn, err := rh.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has already answered with the error
+		log.WithContext(c.Request.Context()).Debug("websocket upgrade", "error", err.Error())
+		return
+	}
+
+	s := newSession(rh, conn, c.Request, user, hasCredentials)
+	s.serve()
+}
+
+// session serves the requests of a single WebSocket connection. The requests are served concurrently, up to the maximum
+// number of concurrent operations, while the responses are written one at a time
+type session struct {
+	handler         *rpcHandler
+	conn            *websocket.Conn
+	upgradeRequest  *http.Request
+	user            string
+	isAuthenticated bool
+	throttler       chan struct{}
+	mutWrite        sync.Mutex
+	ctx             context.Context
+	cancel          context.CancelFunc
+}
+
+func newSession(handler *rpcHandler, conn *websocket.Conn, upgradeRequest *http.Request, user string, isAuthenticated bool) *session {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &session{
+		handler:         handler,
+		conn:            conn,
+		upgradeRequest:  upgradeRequest,
+		user:            user,
+		isAuthenticated: isAuthenticated,
+		throttler:       make(chan struct{}, handler.maxConcurrentOperations),
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+}
+
+func (s *session) serve() {
+	defer func() {
+		_ = s.conn.Close()
+	}()
+
+	pongWait := 2 * s.handler.pingInterval
+	s.conn.SetReadLimit(s.handler.maxMessageSize)
+	_ = s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	s.conn.SetPongHandler(func(_ string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	go s.keepAlive()
+
+	wg := sync.WaitGroup{}
+	for {
+		_, message, err := s.conn.ReadMessage()
+		if err != nil {
+			log.Debug("websocket connection closed", "remote address", s.upgradeRequest.RemoteAddr, "reason", err.Error())
+			break
+		}
+		_ = s.conn.SetReadDeadline(time.Now().Add(pongWait))
+
+		request := &Request{}
+		err = json.Unmarshal(message, request)
+		if err != nil {
+			s.writeResponse(&Response{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("%s: %s", ErrInvalidRequest.Error(), err.Error()),
+			})
+			continue
+		}
+
+		s.throttler <- struct{}{}
+		wg.Add(1)
+		go func(request *Request) {
+			defer func() {
+				<-s.throttler
+				wg.Done()
+			}()
+
+			s.writeResponse(s.execute(request))
+		}(request)
+	}
+
+	// the requests still being served are canceled, as their responses can not be sent anymore
+	s.cancel()
+	wg.Wait()
+}
+
+func (s *session) keepAlive() {
+	ticker := time.NewTicker(s.handler.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// the control messages can be written concurrently with the other messages
+			err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.handler.pingInterval))
+			if err != nil {
+				log.Debug("websocket ping", "remote address", s.upgradeRequest.RemoteAddr, "error", err.Error())
+				return
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *session) execute(request *Request) *Response {
+	response := &Response{
+		ID: request.ID,
+	}
+
+	err := s.checkRequest(request)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		response.Error = err.Error()
+		return response
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.handler.operationTimeout)
+	defer cancel()
+
+	requestCtx := ctx
+	if s.isAuthenticated {
+		requestCtx = common.ContextWithAuthenticatedUser(ctx, s.user)
+	}
+	httpRequest, err := http.NewRequestWithContext(requestCtx, request.Method, request.Path, bytes.NewReader(request.Body))
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		response.Error = fmt.Sprintf("%s: %s", ErrInvalidRequest.Error(), err.Error())
+		return response
+	}
+	s.copyUpgradeRequestHeaders(httpRequest)
+	if len(request.Body) > 0 {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+
+	recorder := newResponseRecorder(cancel)
+	s.handler.dispatcher.ServeHTTP(recorder, httpRequest)
+
+	if recorder.isStreaming {
+		response.Status = http.StatusBadRequest
+		response.Error = ErrStreamingNotSupported.Error()
+		return response
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		response.Status = http.StatusGatewayTimeout
+		response.Error = ErrOperationTimeout.Error()
+		return response
+	}
+
+	response.Status = recorder.status
+	response.Response = toRawMessage(recorder.body.Bytes())
+
+	return response
+}
+
+func (s *session) checkRequest(request *Request) error {
+	if request.Method != http.MethodGet && request.Method != http.MethodPost {
+		return fmt.Errorf("%w: %s", ErrUnsupportedMethod, request.Method)
+	}
+	if !strings.HasPrefix(request.Path, "/") {
+		return fmt.Errorf("%w: %s", ErrInvalidPath, request.Path)
+	}
+
+	path := strings.SplitN(request.Path, "?", 2)[0]
+	if path == s.handler.path {
+		return fmt.Errorf("%w: %s", ErrInvalidPath, request.Path)
+	}
+
+	return nil
+}
+
+// copyUpgradeRequestHeaders sets the headers of the upgrade request, except the credentials, on the dispatched request,
+// which is also seen as coming from the same remote address, so that it is rate limited and filtered as the HTTP
+// requests of the client are
+func (s *session) copyUpgradeRequestHeaders(httpRequest *http.Request) {
+	httpRequest.RemoteAddr = s.upgradeRequest.RemoteAddr
+	httpRequest.Header = s.upgradeRequest.Header.Clone()
+	for _, header := range hopHeaders {
+		httpRequest.Header.Del(header)
+	}
+	for _, header := range credentialHeaders {
+		httpRequest.Header.Del(header)
+	}
+}
+
+func (s *session) writeResponse(response *Response) {
+	s.mutWrite.Lock()
+	defer s.mutWrite.Unlock()
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(s.handler.operationTimeout))
+	err := s.conn.WriteJSON(response)
+	if err != nil {
+		log.Debug("websocket write response", "id", response.ID, "error", err.Error())
+	}
+}
+
+// toRawMessage keeps the JSON responses as they are and wraps the other ones, such as the plain text errors, as JSON
+// strings
+func toRawMessage(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+
+	wrappedBody, _ := json.Marshal(string(body))
+	return wrappedBody
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *rpcHandler) IsInterfaceNil() bool {
+	return rh == nil
+}
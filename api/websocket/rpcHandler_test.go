@@ -0,0 +1,308 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	wsrpc "github.com/multiversx/mx-chain-proxy-go/api/websocket"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+const rpcPath = "/ws"
+
+func createTestConfig() config.WebSocketRPCConfig {
+	return config.WebSocketRPCConfig{
+		Enabled:                 true,
+		MaxConcurrentOperations: 4,
+		OperationTimeoutSec:     1,
+		MaxMessageSizeBytes:     1024,
+		PingIntervalSec:         10,
+	}
+}
+
+func testAuthenticator(c *gin.Context) {
+	user, pass, _ := c.Request.BasicAuth()
+	if user != "user" || pass != "pass" {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+}
+
+func startTestServerWithHeader(t *testing.T, registerRoutes func(ws *gin.Engine), header http.Header) (*websocket.Conn, *http.Response, error) {
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	registerRoutes(ws)
+
+	cfg := createTestConfig()
+	cfg.AllowedOrigins = []string{"https://wallet.example.com"}
+	rpcHandler, err := wsrpc.NewRPCHandler(wsrpc.ArgsRPCHandler{
+		Dispatcher:    ws,
+		Authenticator: testAuthenticator,
+		Path:          rpcPath,
+		Config:        cfg,
+	})
+	require.NoError(t, err)
+	ws.GET(rpcPath, rpcHandler.HandleConnection)
+
+	server := httptest.NewServer(ws)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + rpcPath
+	conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+	if err == nil {
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+	}
+
+	return conn, resp, err
+}
+
+func startTestServer(t *testing.T, registerRoutes func(ws *gin.Engine)) *websocket.Conn {
+	header := http.Header{}
+	header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	header.Set("Cookie", "session=abc")
+	conn, _, err := startTestServerWithHeader(t, registerRoutes, header)
+	require.NoError(t, err)
+
+	return conn
+}
+
+func sendRequest(t *testing.T, conn *websocket.Conn, request *wsrpc.Request) {
+	err := conn.WriteJSON(request)
+	require.NoError(t, err)
+}
+
+func readResponse(t *testing.T, conn *websocket.Conn) *wsrpc.Response {
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	response := &wsrpc.Response{}
+	err := conn.ReadJSON(response)
+	require.NoError(t, err)
+
+	return response
+}
+
+func TestNewRPCHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil dispatcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		rpcHandler, err := wsrpc.NewRPCHandler(wsrpc.ArgsRPCHandler{Authenticator: testAuthenticator, Path: rpcPath, Config: createTestConfig()})
+		require.Nil(t, rpcHandler)
+		require.Equal(t, wsrpc.ErrNilDispatcher, err)
+	})
+	t.Run("nil authenticator should error", func(t *testing.T) {
+		t.Parallel()
+
+		rpcHandler, err := wsrpc.NewRPCHandler(wsrpc.ArgsRPCHandler{Dispatcher: gin.New(), Path: rpcPath, Config: createTestConfig()})
+		require.Nil(t, rpcHandler)
+		require.Equal(t, wsrpc.ErrNilAuthenticator, err)
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidConfigs := map[string]func(cfg *config.WebSocketRPCConfig){
+			"MaxConcurrentOperations": func(cfg *config.WebSocketRPCConfig) { cfg.MaxConcurrentOperations = 0 },
+			"OperationTimeoutSec":     func(cfg *config.WebSocketRPCConfig) { cfg.OperationTimeoutSec = 0 },
+			"MaxMessageSizeBytes":     func(cfg *config.WebSocketRPCConfig) { cfg.MaxMessageSizeBytes = -1 },
+			"PingIntervalSec":         func(cfg *config.WebSocketRPCConfig) { cfg.PingIntervalSec = 0 },
+		}
+		for name, setInvalidValue := range invalidConfigs {
+			cfg := createTestConfig()
+			setInvalidValue(&cfg)
+
+			rpcHandler, err := wsrpc.NewRPCHandler(wsrpc.ArgsRPCHandler{Dispatcher: gin.New(), Authenticator: testAuthenticator, Path: rpcPath, Config: cfg})
+			require.Nil(t, rpcHandler)
+			require.True(t, errors.Is(err, wsrpc.ErrInvalidConfigValue))
+			require.Contains(t, err.Error(), name)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rpcHandler, err := wsrpc.NewRPCHandler(wsrpc.ArgsRPCHandler{Dispatcher: gin.New(), Authenticator: testAuthenticator, Path: rpcPath, Config: createTestConfig()})
+		require.NoError(t, err)
+		require.False(t, rpcHandler.IsInterfaceNil())
+	})
+}
+
+func TestRPCHandler_HandleConnection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should serve the requests concurrently and answer with their ids", func(t *testing.T) {
+		t.Parallel()
+
+		fastRequestServed := make(chan struct{})
+		conn := startTestServer(t, func(ws *gin.Engine) {
+			ws.GET("/v1.0/address/:address", func(c *gin.Context) {
+				// answered only after the next request, to check that the responses are correlated by their ids
+				<-fastRequestServed
+				user, _ := common.GetAuthenticatedUser(c.Request.Context())
+				c.JSON(http.StatusOK, gin.H{
+					"address":       c.Param("address"),
+					"onFinalBlock":  c.Query("onFinalBlock"),
+					"authorization": c.GetHeader("Authorization"),
+					"cookie":        c.GetHeader("Cookie"),
+					"user":          user,
+				})
+			})
+			ws.POST("/v1.0/transaction/send", func(c *gin.Context) {
+				defer close(fastRequestServed)
+
+				body, _ := io.ReadAll(c.Request.Body)
+				c.JSON(http.StatusOK, gin.H{
+					"contentType": c.GetHeader("Content-Type"),
+					"body":        string(body),
+				})
+			})
+		})
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "slow", Method: http.MethodGet, Path: "/v1.0/address/erd1abc?onFinalBlock=true"})
+		sendRequest(t, conn, &wsrpc.Request{ID: "fast", Method: http.MethodPost, Path: "/v1.0/transaction/send", Body: json.RawMessage(`{"nonce":1}`)})
+
+		fastResponse := readResponse(t, conn)
+		require.Equal(t, "fast", fastResponse.ID)
+		require.Equal(t, http.StatusOK, fastResponse.Status)
+		require.Empty(t, fastResponse.Error)
+		require.JSONEq(t, `{"contentType":"application/json","body":"{\"nonce\":1}"}`, string(fastResponse.Response))
+
+		slowResponse := readResponse(t, conn)
+		require.Equal(t, "slow", slowResponse.ID)
+		require.Equal(t, http.StatusOK, slowResponse.Status)
+		require.JSONEq(t, `{"address":"erd1abc","onFinalBlock":"true","authorization":"","cookie":"","user":"user"}`, string(slowResponse.Response))
+	})
+	t.Run("invalid credentials should reject the upgrade", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Authorization", "Basic dXNlcjp3cm9uZw==")
+		conn, resp, err := startTestServerWithHeader(t, func(ws *gin.Engine) {}, header)
+		require.Nil(t, conn)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+	t.Run("connection without credentials should not be authenticated", func(t *testing.T) {
+		t.Parallel()
+
+		conn, _, err := startTestServerWithHeader(t, func(ws *gin.Engine) {
+			ws.GET("/v1.0/network/config", func(c *gin.Context) {
+				_, isAuthenticated := common.GetAuthenticatedUser(c.Request.Context())
+				c.JSON(http.StatusOK, gin.H{"isAuthenticated": isAuthenticated})
+			})
+		}, nil)
+		require.NoError(t, err)
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "1", Method: http.MethodGet, Path: "/v1.0/network/config"})
+		response := readResponse(t, conn)
+		require.Equal(t, http.StatusOK, response.Status)
+		require.JSONEq(t, `{"isAuthenticated":false}`, string(response.Response))
+	})
+	t.Run("should accept only the allowed origins", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Origin", "https://attacker.example.com")
+		conn, resp, err := startTestServerWithHeader(t, func(ws *gin.Engine) {}, header)
+		require.Nil(t, conn)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		header.Set("Origin", "https://Wallet.example.com")
+		conn, _, err = startTestServerWithHeader(t, func(ws *gin.Engine) {}, header)
+		require.NoError(t, err)
+		require.NotNil(t, conn)
+	})
+	t.Run("should forward the status of the route and wrap the plain text responses", func(t *testing.T) {
+		t.Parallel()
+
+		conn := startTestServer(t, func(ws *gin.Engine) {})
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "1", Method: http.MethodGet, Path: "/v1.0/missing"})
+
+		response := readResponse(t, conn)
+		require.Equal(t, "1", response.ID)
+		require.Equal(t, http.StatusNotFound, response.Status)
+		require.Equal(t, `"404 page not found"`, string(response.Response))
+	})
+	t.Run("invalid requests should error and keep the connection open", func(t *testing.T) {
+		t.Parallel()
+
+		conn := startTestServer(t, func(ws *gin.Engine) {
+			ws.GET("/v1.0/network/config", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"config": "ok"})
+			})
+		})
+
+		err := conn.WriteMessage(websocket.TextMessage, []byte("not json"))
+		require.NoError(t, err)
+		response := readResponse(t, conn)
+		require.Equal(t, http.StatusBadRequest, response.Status)
+		require.Contains(t, response.Error, wsrpc.ErrInvalidRequest.Error())
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "2", Method: http.MethodDelete, Path: "/v1.0/network/config"})
+		response = readResponse(t, conn)
+		require.Equal(t, "2", response.ID)
+		require.Equal(t, http.StatusBadRequest, response.Status)
+		require.Contains(t, response.Error, wsrpc.ErrUnsupportedMethod.Error())
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "3", Method: http.MethodGet, Path: "v1.0/network/config"})
+		response = readResponse(t, conn)
+		require.Equal(t, "3", response.ID)
+		require.Contains(t, response.Error, wsrpc.ErrInvalidPath.Error())
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "4", Method: http.MethodGet, Path: rpcPath + "?a=b"})
+		response = readResponse(t, conn)
+		require.Equal(t, "4", response.ID)
+		require.Contains(t, response.Error, wsrpc.ErrInvalidPath.Error())
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "5", Method: http.MethodGet, Path: "/v1.0/network/config"})
+		response = readResponse(t, conn)
+		require.Equal(t, "5", response.ID)
+		require.Equal(t, http.StatusOK, response.Status)
+		require.JSONEq(t, `{"config":"ok"}`, string(response.Response))
+	})
+	t.Run("streaming endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := startTestServer(t, func(ws *gin.Engine) {
+			ws.GET("/v1.0/network/status/stream/:shard", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+				c.Writer.Flush()
+				<-c.Request.Context().Done()
+			})
+		})
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "stream", Method: http.MethodGet, Path: "/v1.0/network/status/stream/0"})
+
+		response := readResponse(t, conn)
+		require.Equal(t, "stream", response.ID)
+		require.Equal(t, http.StatusBadRequest, response.Status)
+		require.Equal(t, wsrpc.ErrStreamingNotSupported.Error(), response.Error)
+	})
+	t.Run("request not finished in time should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := startTestServer(t, func(ws *gin.Engine) {
+			ws.GET("/v1.0/slow", func(c *gin.Context) {
+				<-c.Request.Context().Done()
+			})
+		})
+
+		sendRequest(t, conn, &wsrpc.Request{ID: "slow", Method: http.MethodGet, Path: "/v1.0/slow"})
+
+		response := readResponse(t, conn)
+		require.Equal(t, "slow", response.ID)
+		require.Equal(t, http.StatusGatewayTimeout, response.Status)
+		require.Equal(t, wsrpc.ErrOperationTimeout.Error(), response.Error)
+	})
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
@@ -22,6 +21,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -31,7 +31,9 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/database"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
 )
@@ -41,7 +43,6 @@ const (
 	logFilePrefix        = "mx-chain-proxy-go"
 	logFileLifeSpanInSec = 86400
 	logFileMaxSizeInMB   = 1024
-	addressHRP           = "erd"
 )
 
 // commitID and appVersion should be populated at build time using ldflags
@@ -285,17 +286,35 @@ func startProxy(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	data.ApplyObserversCredentials(generalConfig.Observers, credentialsConfig.ObserversCredentials)
+	data.ApplyObserversCredentials(generalConfig.FullHistoryNodes, credentialsConfig.ObserversCredentials)
 
 	statusMetricsProvider := metrics.NewStatusMetrics()
+	reorgDetector, err := processFactory.CreateReorgDetector(generalConfig.ReorgDetection)
+	if err != nil {
+		return err
+	}
+
+	storer, err := storage.NewStorer(generalConfig.Storage, credentialsConfig.StorageRedisPassword)
+	if err != nil {
+		return err
+	}
+
+	clientsUsageTracker, err := processFactory.CreateClientsUsageTracker(generalConfig.ClientsUsage, storer)
+	if err != nil {
+		return err
+	}
 
 	shouldStartSwaggerUI := ctx.GlobalBool(startSwaggerUI.Name)
 	skipStatusCheck := ctx.GlobalBool(noStatusCheck.Name)
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck)
+	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, credentialsConfig, statusMetricsProvider, reorgDetector, storer, clientsUsageTracker, closableComponents, skipStatusCheck)
 	if err != nil {
 		return err
 	}
+	// closed after the components persisting their data in the storage
+	closableComponents.Add(clientsUsageTracker, storer)
 
-	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, reorgDetector, clientsUsageTracker, isProfileModeActivated, shouldStartSwaggerUI)
 	if err != nil {
 		return err
 	}
@@ -324,7 +343,11 @@ func createVersionsRegistryTestOrProduction(
 	ctx *cli.Context,
 	cfg *config.Config,
 	configurationFilePath string,
+	credentialsConfig *config.CredentialsConfig,
 	statusMetricsHandler data.StatusMetricsProvider,
+	reorgDetector process.ReorgDetector,
+	storer storage.Storer,
+	clientsUsageTracker process.ClientsUsageHandler,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
 ) (data.VersionsRegistryHandler, error) {
@@ -346,11 +369,17 @@ func createVersionsRegistryTestOrProduction(
 				ValStatsCacheValidityDurationSec:         60,
 				EconomicsMetricsCacheValidityDurationSec: 6,
 				FaucetValue:                              "10000000000",
+				NetworkStatusStreamPollIntervalMs:        500,
 			},
 			ApiLogging: config.ApiLoggingConfig{
 				LoggingEnabled:          true,
 				ThresholdInMicroSeconds: 10000,
 			},
+			GasPriceSuggestion: config.GasPriceSuggestionConfig{
+				Strategy:            process.GasPriceStrategyLinear,
+				CongestionThreshold: 10000,
+				MaxMultiplier:       2,
+			},
 			Observers: []*data.NodeData{
 				{
 					ShardId: 0,
@@ -387,7 +416,11 @@ func createVersionsRegistryTestOrProduction(
 		return createVersionsRegistry(
 			testCfg,
 			configurationFilePath,
+			credentialsConfig,
 			statusMetricsHandler,
+			reorgDetector,
+			storer,
+			clientsUsageTracker,
 			ctx.GlobalString(walletKeyPemFile.Name),
 			ctx.GlobalString(apiConfigDirectory.Name),
 			ctx.GlobalBool(sovereign.Name),
@@ -399,7 +432,11 @@ func createVersionsRegistryTestOrProduction(
 	return createVersionsRegistry(
 		cfg,
 		configurationFilePath,
+		credentialsConfig,
 		statusMetricsHandler,
+		reorgDetector,
+		storer,
+		clientsUsageTracker,
 		ctx.GlobalString(walletKeyPemFile.Name),
 		ctx.GlobalString(apiConfigDirectory.Name),
 		ctx.GlobalBool(sovereign.Name),
@@ -411,14 +448,18 @@ func createVersionsRegistryTestOrProduction(
 func createVersionsRegistry(
 	cfg *config.Config,
 	configurationFilePath string,
+	credentialsConfig *config.CredentialsConfig,
 	statusMetricsHandler data.StatusMetricsProvider,
+	reorgDetector process.ReorgDetector,
+	storer storage.Storer,
+	clientsUsageTracker process.ClientsUsageHandler,
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
 	isSovereignConfig bool,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
 ) (data.VersionsRegistryHandler, error) {
-	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.AddressPubkeyConverter.Length, addressHRP)
+	pubKeyConverter, err := processFactory.CreateAddressPubkeyConverter(cfg.AddressPubkeyConverter)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +478,12 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, configurationFilePath, numShards)
+	err = resolveObserversShards(cfg, !skipStatusCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, configurationFilePath, credentialsConfig.ObserversCredentials, numShards)
 	if err != nil {
 		return nil, err
 	}
@@ -459,27 +505,83 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	shardIDCache, err := processFactory.CreateShardIDCache(cfg.GeneralSettings.ShardIDCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	observersRanker, err := processFactory.CreateObserversRanker(
+		cfg.GeneralSettings.LatencyAwareRouting,
+		cfg.GeneralSettings.DeterministicObserversOrder,
+		statusMetricsHandler,
+		cfg.GeneralSettings.SlowObserverLatencyFactor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	observerEventsNotifier, err := processFactory.CreateObserverEventsNotifier(cfg.FailoverWebhooks)
+	if err != nil {
+		return nil, err
+	}
+
+	observersAffinityCache, err := processFactory.CreateObserversAffinityCache(
+		cfg.GeneralSettings.ReadYourWritesCacheSize,
+		time.Duration(cfg.GeneralSettings.ReadYourWritesWindowSec)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	bp, err := process.NewBaseProcessor(
 		cfg.GeneralSettings.RequestTimeoutSec,
 		shardCoord,
 		observersProvider,
 		fullHistoryNodesProvider,
 		pubKeyConverter,
+		shardIDCache,
+		cfg.GeneralSettings.MinObserverVersion,
 		skipStatusCheck,
+		observersRanker,
+		cfg.GeneralSettings.ExpectedChainID,
+		cfg.GeneralSettings.ExpectedMinTransactionVersion,
+		cfg.GeneralSettings.Zone,
+		!cfg.GeneralSettings.DisableObserverResponseCompression,
+		observerEventsNotifier,
+		observersAffinityCache,
+		cfg.ObserverHeaders.StaticHeaders,
 	)
 	if err != nil {
 		return nil, err
 	}
 	bp.StartNodesSyncStateChecks()
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter)
+	externalStorageConnector, err := createElasticSearchConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dataFreshnessProc, err := process.NewDataFreshnessProcessor(bp, cfg.DataFreshness.Enabled, cfg.DataFreshness.MaxNonceDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, externalStorageConnector, dataFreshnessProc)
 	if err != nil {
 		return nil, err
 	}
 
 	faucetValue := big.NewInt(0)
 	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
-	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, pemFileLocation)
+	faucetProc, err := processFactory.CreateFaucetProcessor(
+		bp,
+		shardCoord,
+		faucetValue,
+		pubKeyConverter,
+		pemFileLocation,
+		cfg.FaucetExternalSigner,
+		credentialsConfig.FaucetExternalSignerAuthorizationToken,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -494,6 +596,33 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	txStatusCache, err := processFactory.CreateTxStatusCache(
+		cfg.GeneralSettings.TxStatusCacheSize,
+		time.Duration(cfg.GeneralSettings.TxStatusCachePendingTTLMs)*time.Millisecond,
+		time.Duration(cfg.GeneralSettings.TxStatusCacheTerminalTTLSec)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sentTxsCache, err := processFactory.CreateSentTxsCache(
+		time.Duration(cfg.GeneralSettings.SentTxsDeduplicationWindowSec)*time.Second,
+		storer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txsPolicyChecker, err := processFactory.CreateTransactionsPolicyChecker(cfg.TransactionsPolicy, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsABIRegistry, err := processFactory.CreateEventsABIRegistry(cfg.ContractABIs, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	txProc, err := processFactory.CreateTransactionProcessor(
 		bp,
 		pubKeyConverter,
@@ -501,10 +630,17 @@ func createVersionsRegistry(
 		marshalizer,
 		cfg.GeneralSettings.AllowEntireTxPoolFetch,
 		runTypeComponents,
+		txStatusCache,
+		sentTxsCache,
+		txsPolicyChecker,
+		cfg.GeneralSettings.TransactionBroadcastFanout,
+		eventsABIRegistry,
+		cfg.GeneralSettings.NonceGapWarningThreshold,
 	)
 	if err != nil {
 		return nil, err
 	}
+	txProc.SetReadOnlyMode(cfg.GeneralSettings.ReadOnlyMode)
 
 	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
 	if err != nil {
@@ -519,10 +655,15 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	cacheSnapshotPersister, err := processFactory.CreateCacheSnapshotPersister(cfg.CachePersistence, storer)
+	if err != nil {
+		return nil, err
+	}
+
 	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
 
-	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
+	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity, cacheSnapshotPersister)
 	if err != nil {
 		return nil, err
 	}
@@ -530,7 +671,7 @@ func createVersionsRegistry(
 	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
 
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
+	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity, cacheSnapshotPersister)
 	if err != nil {
 		return nil, err
 	}
@@ -541,7 +682,12 @@ func createVersionsRegistry(
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(bp)
+	hyperblocksCache, err := processFactory.CreateHyperblocksCache(cfg.GeneralSettings.HyperblocksCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	blockProc, err := process.NewBlockProcessor(bp, cfg.GeneralSettings.MaxBlocksInMultiHashRequest, hyperblocksCache, reorgDetector)
 	if err != nil {
 		return nil, err
 	}
@@ -561,7 +707,7 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler)
+	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler, shardIDCache, reorgDetector)
 	if err != nil {
 		return nil, err
 	}
@@ -571,6 +717,111 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	gasPriceProc, err := process.NewGasPriceProcessor(
+		bp,
+		cfg.GasPriceSuggestion.Strategy,
+		cfg.GasPriceSuggestion.CongestionThreshold,
+		cfg.GasPriceSuggestion.MaxMultiplier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sovereignProc, err := process.NewSovereignProcessor(bp, scQueryProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	networkStatusStreamPollInterval := time.Duration(cfg.GeneralSettings.NetworkStatusStreamPollIntervalMs) * time.Millisecond
+	networkStatusStreamer, err := process.NewNetworkStatusStreamer(bp, networkStatusStreamPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(networkStatusStreamer)
+
+	nodePassthroughProc, err := process.NewNodePassthroughProcessor(bp, cfg.NodePassthrough.AllowedEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtMetadataCache, esdtMetadataFollower, err := processFactory.CreateESDTMetadataCache(cfg.ESDTMetadataCache, bp, blockProc)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(esdtMetadataFollower)
+
+	collectionsProc, err := process.NewCollectionsProcessor(bp, scQueryProc, pubKeyConverter, esdtMetadataCache)
+	if err != nil {
+		return nil, err
+	}
+
+	blocksExporter, err := processFactory.CreateBlocksExporter(blockProc, cfg.BlocksExport)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(blocksExporter)
+
+	faucetRequestsQueue, err := processFactory.CreateFaucetRequestsQueue(faucetProc, accntProc, nodeStatusProc, txProc, cfg.FaucetQueue, storer)
+	if err != nil {
+		return nil, err
+	}
+	// the storage is closed after the components using it, as the components are closed in the order they were added
+	closableComponents.Add(faucetRequestsQueue)
+
+	tokenPriceProvider, err := processFactory.CreateTokenPriceProvider(cfg.TokenPrice, time.Duration(cfg.GeneralSettings.RequestTimeoutSec)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPriceProc, err := process.NewTokenPriceProcessor(tokenPriceProvider, scQueryProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	probesProc, err := process.NewProbesProcessor(
+		bp,
+		bp,
+		htbCacher,
+		valStatsCacher,
+		economicMetricsCacher,
+		time.Duration(cfg.GeneralSettings.LivenessMaxNodesStateCheckDelaySec)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	finalityProc, err := process.NewFinalityProcessor(bp, cfg.GeneralSettings.FinalityDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorKeysProc, err := process.NewValidatorKeysProcessor(scQueryProc, valStatsProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingOverviewCacheValidity := time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	stakingOverviewProc, err := process.NewStakingOverviewProcessor(
+		nodeStatusProc,
+		valStatsProc,
+		scQueryProc,
+		pubKeyConverter,
+		stakingOverviewCacheValidity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationProc, err := process.NewDelegationProcessor(scQueryProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = startUptimeHeartbeatReporter(cfg, bp, statusMetricsHandler, closableComponents)
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -587,6 +838,21 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		GasPriceProcessor:            gasPriceProc,
+		SovereignProcessor:           sovereignProc,
+		NetworkStatusStreamer:        networkStatusStreamer,
+		NodePassthroughProc:          nodePassthroughProc,
+		CollectionsProc:              collectionsProc,
+		DataFreshnessProc:            dataFreshnessProc,
+		BlocksExporter:               blocksExporter,
+		TokenPriceProcessor:          tokenPriceProc,
+		ProbesProcessor:              probesProc,
+		FinalityProcessor:            finalityProc,
+		ValidatorKeysProcessor:       validatorKeysProc,
+		FaucetRequestsQueue:          faucetRequestsQueue,
+		StakingOverviewProcessor:     stakingOverviewProc,
+		DelegationProcessor:          delegationProc,
+		ClientsUsageProvider:         clientsUsageTracker,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -616,6 +882,8 @@ func startWebServer(
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
 	statusMetricsProvider data.StatusMetricsProvider,
+	reorgDetector process.ReorgDetector,
+	clientsUsageTracker process.ClientsUsageHandler,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
 ) (*http.Server, error) {
@@ -628,12 +896,42 @@ func startWebServer(
 		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
 			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
 	}
+	responseSigner, err := createResponseSigner(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+	loadShedder, err := createLoadShedder(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+	panicReporter, err := createPanicReporter(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+	featureFlags, err := middleware.NewFeatureFlags(generalConfig.FeatureFlags.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+	clientsUsage, err := createClientsUsageMiddleware(generalConfig, credentialsConfig, clientsUsageTracker)
+	if err != nil {
+		return nil, err
+	}
+
 	httpServer, err = api.CreateServer(
 		versionsRegistry,
 		port,
 		generalConfig.ApiLogging,
 		credentialsConfig,
+		generalConfig.TrustedProxies,
+		generalConfig.ObserverHeaders,
+		generalConfig.WebSocketRPC,
 		statusMetricsProvider,
+		responseSigner,
+		loadShedder,
+		panicReporter,
+		featureFlags,
+		reorgDetector,
+		clientsUsage,
 		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
 		isProfileModeActivated,
 		shouldStartSwaggerUI,
@@ -666,17 +964,131 @@ func waitForServerShutdown(httpServer *http.Server, closableComponents *data.Clo
 	_ = httpServer.Close()
 }
 
+func createElasticSearchConnector(cfg *config.Config) (process.ExternalStorageConnector, error) {
+	connectorConfig := cfg.ElasticSearchConnector
+	if !connectorConfig.Enabled {
+		return database.NewDisabledElasticSearchConnector(), nil
+	}
+
+	return database.NewElasticSearchConnector(
+		connectorConfig.URL,
+		connectorConfig.Username,
+		connectorConfig.Password,
+		time.Duration(cfg.GeneralSettings.RequestTimeoutSec)*time.Second,
+	)
+}
+
+// createClientsUsageMiddleware returns nil if the clients usage tracking is disabled, so that no request is recorded
+func createClientsUsageMiddleware(
+	cfg *config.Config,
+	credentialsConfig config.CredentialsConfig,
+	recorder middleware.ClientsUsageRecorder,
+) (middleware.MiddlewareProcessor, error) {
+	if !cfg.ClientsUsage.Enabled {
+		return nil, nil
+	}
+
+	return middleware.NewClientsUsage(recorder, cfg.ClientsUsage.ApiKeyHeader, credentialsConfig.ClientApiKeys)
+}
+
+// createResponseSigner returns nil if the responses signing is disabled, so that no route gets signed
+func createResponseSigner(cfg *config.Config) (middleware.ResponseSigner, error) {
+	if !cfg.ResponseSigning.Enabled {
+		return nil, nil
+	}
+
+	return middleware.NewResponseSigner(cfg.ResponseSigning.PemFile)
+}
+
+// startUptimeHeartbeatReporter starts posting the signed heartbeats to the uptime registry, if enabled
+func startUptimeHeartbeatReporter(
+	cfg *config.Config,
+	proc process.Processor,
+	statusMetricsHandler data.StatusMetricsProvider,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	if !cfg.UptimeHeartbeat.Enabled {
+		return nil
+	}
+
+	signer, err := middleware.NewResponseSigner(cfg.UptimeHeartbeat.PemFile)
+	if err != nil {
+		return err
+	}
+
+	uptimeHeartbeatReporter, err := process.NewUptimeHeartbeatReporter(process.ArgsUptimeHeartbeatReporter{
+		Proc:                  proc,
+		StatusMetricsProvider: statusMetricsHandler,
+		Signer:                signer,
+		RegistryURL:           cfg.UptimeHeartbeat.RegistryURL,
+		PublicURL:             cfg.UptimeHeartbeat.PublicURL,
+		Version:               appVersion,
+		Interval:              time.Duration(cfg.UptimeHeartbeat.IntervalSec) * time.Second,
+		RequestTimeout:        time.Duration(cfg.UptimeHeartbeat.RequestTimeoutSec) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("uptime heartbeats are enabled", "registry", cfg.UptimeHeartbeat.RegistryURL, "signer", signer.SignerID())
+	closableComponents.Add(uptimeHeartbeatReporter)
+
+	return nil
+}
+
+// createPanicReporter returns nil if the panic reporting is disabled, so that the recovered panics are only logged
+func createPanicReporter(cfg *config.Config) (middleware.PanicReporter, error) {
+	if !cfg.PanicReporting.Enabled {
+		return nil, nil
+	}
+
+	return middleware.NewSentryPanicReporter(middleware.ArgsSentryPanicReporter{
+		DSN:               cfg.PanicReporting.DSN,
+		Environment:       cfg.PanicReporting.Environment,
+		RequestTimeout:    time.Duration(cfg.PanicReporting.RequestTimeoutSec) * time.Second,
+		MaxPendingReports: cfg.PanicReporting.MaxPendingReports,
+	})
+}
+
+func createLoadShedder(cfg *config.Config) (middleware.LoadShedder, error) {
+	if !cfg.LoadShedding.Enabled {
+		return nil, nil
+	}
+
+	return middleware.NewLoadShedder(middleware.ArgsLoadShedder{
+		MaxInFlightRequests:      cfg.LoadShedding.MaxInFlightRequests,
+		ReservedPriorityRequests: cfg.LoadShedding.ReservedPriorityRequests,
+		MaxQueuedRequests:        cfg.LoadShedding.MaxQueuedRequests,
+		MaxLowPriorityRequests:   cfg.LoadShedding.MaxLowPriorityRequests,
+		AllowHighPriorityHeader:  cfg.LoadShedding.AllowHighPriorityHeader,
+		QueueTimeout:             time.Duration(cfg.LoadShedding.QueueTimeoutMs) * time.Millisecond,
+		RetryAfter:               time.Duration(cfg.LoadShedding.RetryAfterSec) * time.Second,
+	})
+}
+
+// createStartupHttpClient creates the client used for the observer requests issued while the proxy starts, carrying
+// the configured static observer headers
+func createStartupHttpClient(cfg *config.Config) (*http.Client, error) {
+	transport, err := process.NewObserverHeadersTransport(http.DefaultTransport, cfg.ObserverHeaders.StaticHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Timeout:   time.Duration(cfg.GeneralSettings.RequestTimeoutSec) * time.Second,
+		Transport: transport,
+	}, nil
+}
+
 // getNumOfShards will delay the start of proxy until it successfully gets the number of shards
 func getNumOfShards(cfg *config.Config) (uint32, error) {
-	httpClient := &http.Client{}
-	httpClient.Timeout = time.Duration(cfg.GeneralSettings.RequestTimeoutSec) * time.Second
-	observersList := make([]string, 0, len(cfg.Observers))
-	for _, node := range cfg.Observers {
-		observersList = append(observersList, node.Address)
+	httpClient, err := createStartupHttpClient(cfg)
+	if err != nil {
+		return 0, err
 	}
 	argsNumShardsProcessor := process.ArgNumShardsProcessor{
 		HttpClient:                    httpClient,
-		Observers:                     observersList,
+		Observers:                     cfg.Observers,
 		TimeBetweenNodesRequestsInSec: cfg.GeneralSettings.TimeBetweenNodesRequestsInSec,
 		NumShardsTimeoutInSec:         cfg.GeneralSettings.NumShardsTimeoutInSec,
 		RequestTimeoutInSec:           cfg.GeneralSettings.RequestTimeoutSec,
@@ -692,6 +1104,30 @@ func getNumOfShards(cfg *config.Config) (uint32, error) {
 	return numShardsProcessor.GetNetworkNumShards(ctx)
 }
 
+// resolveObserversShards will detect the shards of the nodes declared without one and, if enabled, check that the other
+// nodes report their declared shards, failing the start of the proxy on mismatch
+func resolveObserversShards(cfg *config.Config, validateDeclaredShards bool) error {
+	httpClient, err := createStartupHttpClient(cfg)
+	if err != nil {
+		return err
+	}
+	shardsResolver, err := process.NewObserversShardsResolver(process.ArgObserversShardsResolver{
+		HttpClient:             httpClient,
+		RequestTimeoutInSec:    cfg.GeneralSettings.RequestTimeoutSec,
+		ValidateDeclaredShards: validateDeclaredShards,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = shardsResolver.ResolveShards(cfg.Observers)
+	if err != nil {
+		return err
+	}
+
+	return shardsResolver.ResolveShards(cfg.FullHistoryNodes)
+}
+
 func removeLogColors() {
 	err := logger.RemoveLogObserver(os.Stdout)
 	if err != nil {
@@ -0,0 +1,21 @@
+package common
+
+import "context"
+
+type authenticatedUserKey struct{}
+
+// ContextWithAuthenticatedUser returns a copy of the context holding the user already authenticated for the request,
+// such as the one authenticated when a WebSocket connection was opened, for which the requests do not hold credentials
+func ContextWithAuthenticatedUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, authenticatedUserKey{}, user)
+}
+
+// GetAuthenticatedUser returns the user already authenticated for the request under the context, if any
+func GetAuthenticatedUser(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	user, ok := ctx.Value(authenticatedUserKey{}).(string)
+	return user, ok
+}
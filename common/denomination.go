@@ -0,0 +1,49 @@
+package common
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidDenominationValue signals that a value to be denominated is not a base 10 integer
+var ErrInvalidDenominationValue = errors.New("invalid value to be denominated")
+
+// ErrInvalidNumDecimals signals that a negative number of decimals has been provided
+var ErrInvalidNumDecimals = errors.New("invalid number of decimals")
+
+// DenominateValue converts the value, given in the smallest unit of a token, into a decimal string with the provided
+// number of decimals, such as "1.5" for "1500000000000000000" and 18 decimals. The conversion is exact, while the
+// trailing zeros of the fractional part are removed
+func DenominateValue(value string, numDecimals int) (string, error) {
+	if numDecimals < 0 {
+		return "", ErrInvalidNumDecimals
+	}
+
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return "", ErrInvalidDenominationValue
+	}
+	if numDecimals == 0 {
+		return bigValue.String(), nil
+	}
+
+	sign := ""
+	if bigValue.Sign() < 0 {
+		sign = "-"
+		bigValue.Neg(bigValue)
+	}
+
+	digits := bigValue.String()
+	if len(digits) <= numDecimals {
+		digits = strings.Repeat("0", numDecimals-len(digits)+1) + digits
+	}
+
+	integerPart := digits[:len(digits)-numDecimals]
+	fractionalPart := strings.TrimRight(digits[len(digits)-numDecimals:], "0")
+	if len(fractionalPart) == 0 {
+		return sign + integerPart, nil
+	}
+
+	return sign + integerPart + "." + fractionalPart, nil
+}
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenominateValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative number of decimals should error", func(t *testing.T) {
+		t.Parallel()
+
+		denominated, err := DenominateValue("1", -1)
+		require.Equal(t, ErrInvalidNumDecimals, err)
+		require.Empty(t, denominated)
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		denominated, err := DenominateValue("1.5", 18)
+		require.Equal(t, ErrInvalidDenominationValue, err)
+		require.Empty(t, denominated)
+
+		denominated, err = DenominateValue("", 18)
+		require.Equal(t, ErrInvalidDenominationValue, err)
+		require.Empty(t, denominated)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			value       string
+			numDecimals int
+			expected    string
+		}{
+			{value: "0", numDecimals: 18, expected: "0"},
+			{value: "1", numDecimals: 18, expected: "0.000000000000000001"},
+			{value: "1000000000000000000", numDecimals: 18, expected: "1"},
+			{value: "1500000000000000000", numDecimals: 18, expected: "1.5"},
+			{value: "20000000000000000000000000", numDecimals: 18, expected: "20000000"},
+			{value: "123456", numDecimals: 6, expected: "0.123456"},
+			{value: "-2500000", numDecimals: 6, expected: "-2.5"},
+			{value: "42", numDecimals: 0, expected: "42"},
+		}
+		for _, testCase := range testCases {
+			denominated, err := DenominateValue(testCase.value, testCase.numDecimals)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, denominated, testCase.value)
+		}
+	})
+}
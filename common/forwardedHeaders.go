@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+	"net/http"
+)
+
+type forwardedHeadersKey struct{}
+
+// ContextWithForwardedHeaders returns a copy of the context holding the client headers to be forwarded to the
+// observers called while serving the request. The context is returned unchanged if there are no headers
+func ContextWithForwardedHeaders(ctx context.Context, headers http.Header) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, forwardedHeadersKey{}, headers)
+}
+
+// GetForwardedHeaders returns the client headers to be forwarded to the observers called under the context or nil if
+// there are none
+func GetForwardedHeaders(ctx context.Context) http.Header {
+	if ctx == nil {
+		return nil
+	}
+
+	headers, _ := ctx.Value(forwardedHeadersKey{}).(http.Header)
+	return headers
+}
@@ -0,0 +1,33 @@
+package common
+
+import "context"
+
+// ObserverZoneHeader is the header holding the zone of the observer which served a request
+const ObserverZoneHeader = "X-Observer-Zone"
+
+// SetObserverZone records the zone of the observer which answered the request served under the context. Nothing is
+// recorded if the context is not bound to a request
+func SetObserverZone(ctx context.Context, zone string) {
+	scope := getRequestScope(ctx)
+	if len(zone) == 0 || scope == nil {
+		return
+	}
+
+	scope.mutZone.Lock()
+	scope.observerZone = zone
+	scope.mutZone.Unlock()
+}
+
+// GetObserverZone returns the zone of the last observer which answered the request served under the context or an
+// empty string if none was recorded
+func GetObserverZone(ctx context.Context) string {
+	scope := getRequestScope(ctx)
+	if scope == nil {
+		return ""
+	}
+
+	scope.mutZone.RLock()
+	defer scope.mutZone.RUnlock()
+
+	return scope.observerZone
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 )
@@ -25,6 +26,8 @@ const (
 	UrlParameterBlockNonce = "blockNonce"
 	// UrlParameterBlockHash represents the name of an URL parameter
 	UrlParameterBlockHash = "blockHash"
+	// UrlParameterHyperblockNonce represents the name of an URL parameter
+	UrlParameterHyperblockNonce = "hyperblockNonce"
 	// UrlParameterBlockRootHash represents the name of an URL parameter
 	UrlParameterBlockRootHash = "blockRootHash"
 	// UrlParameterHintEpoch represents the name of an URL parameter
@@ -33,6 +36,8 @@ const (
 	UrlParameterCheckSignature = "checkSignature"
 	// UrlParameterWithResults represents the name of an URL parameter
 	UrlParameterWithResults = "withResults"
+	// UrlParameterWithHashVerification represents the name of an URL parameter
+	UrlParameterWithHashVerification = "withHashVerification"
 	// UrlParameterShardID represents the name of an URL parameter
 	UrlParameterShardID = "shard-id"
 	// UrlParameterForcedShardID represents the name of an URL parameter
@@ -51,8 +56,52 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterWithGuardians represents the name of an URL parameter
+	UrlParameterWithGuardians = "withGuardians"
+	// UrlParameterMinRating represents the name of an URL parameter
+	UrlParameterMinRating = "minRating"
+	// UrlParameterMaxRating represents the name of an URL parameter
+	UrlParameterMaxRating = "maxRating"
+	// UrlParameterMinLeaderSuccess represents the name of an URL parameter
+	UrlParameterMinLeaderSuccess = "minLeaderSuccess"
+	// UrlParameterMaxLeaderSuccess represents the name of an URL parameter
+	UrlParameterMaxLeaderSuccess = "maxLeaderSuccess"
+	// UrlParameterFrom represents the name of an URL parameter
+	UrlParameterFrom = "from"
+	// UrlParameterTo represents the name of an URL parameter
+	UrlParameterTo = "to"
+	// UrlParameterSize represents the name of an URL parameter
+	UrlParameterSize = "size"
+	// UrlParameterStream represents the name of an URL parameter
+	UrlParameterStream = "stream"
+	// UrlParameterOlderThan represents the name of an URL parameter
+	UrlParameterOlderThan = "olderThan"
+	// UrlParameterOnlyFinal represents the name of an URL parameter
+	UrlParameterOnlyFinal = "onlyFinal"
+	// UrlParameterFromBlock represents the name of an URL parameter
+	UrlParameterFromBlock = "fromBlock"
+	// UrlParameterToBlock represents the name of an URL parameter
+	UrlParameterToBlock = "toBlock"
+	// UrlParameterEpoch represents the name of an URL parameter
+	UrlParameterEpoch = "epoch"
+	// UrlParameterWithUsdValue represents the name of an URL parameter
+	UrlParameterWithUsdValue = "withUsdValue"
+	// UrlParameterCursor represents the name of an URL parameter
+	UrlParameterCursor = "cursor"
+	// UrlParameterDenominated represents the name of an URL parameter
+	UrlParameterDenominated = "denominated"
+	// UrlParameterAllShards represents the name of an URL parameter
+	UrlParameterAllShards = "allShards"
+	// UrlParameterApiKey represents the name of an URL parameter
+	UrlParameterApiKey = "apiKey"
 )
 
+// OptionalFloat64 holds an optional float64 value
+type OptionalFloat64 struct {
+	Value    float64
+	HasValue bool
+}
+
 // BlockQueryOptions holds options for block queries
 type BlockQueryOptions struct {
 	WithTransactions bool
@@ -60,6 +109,13 @@ type BlockQueryOptions struct {
 	ForHyperblock    bool
 }
 
+// MiniBlockQueryOptions holds options for miniblock queries. If the epoch is not set, the miniblock is searched in the
+// current epoch of the shard
+type MiniBlockQueryOptions struct {
+	WithTransactions bool
+	Epoch            core.OptionalUint32
+}
+
 // HyperblockQueryOptions holds options for hyperblock queries
 type HyperblockQueryOptions struct {
 	WithLogs               bool
@@ -70,7 +126,8 @@ type HyperblockQueryOptions struct {
 
 // TransactionQueryOptions holds options for transaction queries
 type TransactionQueryOptions struct {
-	WithResults bool
+	WithResults          bool
+	WithHashVerification bool
 }
 
 // TransactionSimulationOptions holds options for transaction simulation requests
@@ -78,13 +135,43 @@ type TransactionSimulationOptions struct {
 	CheckSignature bool
 }
 
-// TransactionsPoolOptions holds options for transactions pool requests
+// TransactionsPoolOptions holds options for transactions pool requests. From and Size select a chunk of a shard's pool,
+// while Stream returns the entire pool in chunks of Size transactions
 type TransactionsPoolOptions struct {
 	ShardID   string
 	Sender    string
 	Fields    string
 	LastNonce bool
 	NonceGaps bool
+	From      uint32
+	Size      uint32
+	Stream    bool
+	AllShards bool
+}
+
+// ValidatorStatisticsQueryOptions holds the filtering and pagination options for validator statistics requests
+type ValidatorStatisticsQueryOptions struct {
+	ShardID          core.OptionalUint32
+	MinRating        OptionalFloat64
+	MaxRating        OptionalFloat64
+	MinLeaderSuccess core.OptionalUint32
+	MaxLeaderSuccess core.OptionalUint32
+	From             uint32
+	Size             uint32
+}
+
+// TokenHoldersQueryOptions holds the pagination options for token holders requests. The cursor is the one returned along
+// with the previous page, if any
+type TokenHoldersQueryOptions struct {
+	Size   uint32
+	Cursor string
+}
+
+// CollectionsQueryOptions holds the pagination options for collections requests. The pages are computed over the sorted
+// tokens of the address, so a page can hold fewer collections than its size
+type CollectionsQueryOptions struct {
+	From uint32
+	Size uint32
 }
 
 // GetAlteredAccountsForBlockOptions specifies the options for returning altered accounts for a given block
@@ -121,6 +208,21 @@ type AccountQueryOptions struct {
 	BlockRootHash  []byte
 	HintEpoch      core.OptionalUint32
 	WithKeys       bool
+	WithGuardians  bool
+}
+
+// AccountKeysDiffQueryOptions holds the options for the account keys diff queries
+type AccountKeysDiffQueryOptions struct {
+	FromBlock     uint64
+	ToBlock       uint64
+	ForcedShardID core.OptionalUint32
+}
+
+// ClientUsageQueryOptions holds the options for the client usage queries
+type ClientUsageQueryOptions struct {
+	ApiKey string
+	From   time.Time
+	To     time.Time
 }
 
 // AreHistoricalCoordinatesSet returns true if historical block coordinates are set
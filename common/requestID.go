@@ -0,0 +1,132 @@
+package common
+
+import (
+	"context"
+	"sync"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// RequestIDHeader is the header holding the identifier of a request, received from the clients, returned in the
+// responses and forwarded to the observers
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDLogKey = "request id"
+
+type requestScopeKey struct{}
+
+// requestScope holds the data bound to a request for its whole duration. The observer zone is recorded while the
+// request is served, possibly by the goroutines started for it, so it is protected by a mutex
+type requestScope struct {
+	requestID    string
+	mutZone      sync.RWMutex
+	observerZone string
+}
+
+// ContextWithRequestID returns a copy of the context bound to the request with the provided identifier. The context
+// is passed down to the processors, so the identifier is added to the log lines and forwarded to the observers
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestScopeKey{}, &requestScope{
+		requestID: requestID,
+	})
+}
+
+// GetRequestID returns the identifier of the request served under the context or an empty string if the context is
+// not bound to a request
+func GetRequestID(ctx context.Context) string {
+	scope := getRequestScope(ctx)
+	if scope == nil {
+		return ""
+	}
+
+	return scope.requestID
+}
+
+func getRequestScope(ctx context.Context) *requestScope {
+	if ctx == nil {
+		return nil
+	}
+
+	scope, _ := ctx.Value(requestScopeKey{}).(*requestScope)
+	return scope
+}
+
+// RequestIDLogger wraps a logger so the lines emitted while serving a request can hold its identifier
+type RequestIDLogger struct {
+	logger.Logger
+}
+
+// NewRequestIDLogger returns a new instance of RequestIDLogger
+func NewRequestIDLogger(log logger.Logger) *RequestIDLogger {
+	return &RequestIDLogger{
+		Logger: log,
+	}
+}
+
+// WithContext returns the logger adding the identifier of the request served under the context, if any, to the
+// printed lines
+func (rl *RequestIDLogger) WithContext(ctx context.Context) logger.Logger {
+	requestID := GetRequestID(ctx)
+	if len(requestID) == 0 {
+		return rl.Logger
+	}
+
+	return &requestIDLogger{
+		Logger:    rl.Logger,
+		requestID: requestID,
+	}
+}
+
+type requestIDLogger struct {
+	logger.Logger
+	requestID string
+}
+
+// Trace -
+func (rl *requestIDLogger) Trace(message string, args ...interface{}) {
+	rl.Log(logger.LogTrace, message, args...)
+}
+
+// Debug -
+func (rl *requestIDLogger) Debug(message string, args ...interface{}) {
+	rl.Log(logger.LogDebug, message, args...)
+}
+
+// Info -
+func (rl *requestIDLogger) Info(message string, args ...interface{}) {
+	rl.Log(logger.LogInfo, message, args...)
+}
+
+// Warn -
+func (rl *requestIDLogger) Warn(message string, args ...interface{}) {
+	rl.Log(logger.LogWarning, message, args...)
+}
+
+// Error -
+func (rl *requestIDLogger) Error(message string, args ...interface{}) {
+	rl.Log(logger.LogError, message, args...)
+}
+
+// LogIfError -
+func (rl *requestIDLogger) LogIfError(err error, args ...interface{}) {
+	if err == nil {
+		return
+	}
+
+	rl.Log(logger.LogError, err.Error(), args...)
+}
+
+// Log appends the request identifier to the arguments of the lines that will be printed
+func (rl *requestIDLogger) Log(logLevel logger.LogLevel, message string, args ...interface{}) {
+	if logLevel < rl.Logger.GetLevel() {
+		return
+	}
+
+	args = append(args, requestIDLogKey, rl.requestID)
+	rl.Logger.Log(logLevel, message, args...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rl *requestIDLogger) IsInterfaceNil() bool {
+	return rl == nil
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+)
+
+type loggerStub struct {
+	logger.Logger
+	level      logger.LogLevel
+	loggedArgs [][]interface{}
+}
+
+func (stub *loggerStub) Log(_ logger.LogLevel, _ string, args ...interface{}) {
+	stub.loggedArgs = append(stub.loggedArgs, args)
+}
+
+func (stub *loggerStub) Info(message string, args ...interface{}) {
+	stub.Log(logger.LogInfo, message, args...)
+}
+
+func (stub *loggerStub) GetLevel() logger.LogLevel {
+	return stub.level
+}
+
+func TestRequestID_ShouldBeBoundToTheContext(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, GetRequestID(nil))
+	require.Empty(t, GetRequestID(context.Background()))
+
+	ctx := ContextWithRequestID(context.Background(), "first")
+	require.Equal(t, "first", GetRequestID(ctx))
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// the goroutines started while serving the request keep its identifier
+		require.Equal(t, "first", GetRequestID(ctx))
+
+		otherCtx := ContextWithRequestID(context.Background(), "second")
+		require.Equal(t, "second", GetRequestID(otherCtx))
+	}()
+	wg.Wait()
+
+	require.Equal(t, "first", GetRequestID(ctx))
+}
+
+func TestRequestIDLogger(t *testing.T) {
+	t.Parallel()
+
+	stub := &loggerStub{level: logger.LogInfo}
+	log := NewRequestIDLogger(stub)
+
+	log.Info("no request", "key", "value")
+	log.WithContext(context.Background()).Info("no request", "key", "value")
+
+	requestLog := log.WithContext(ContextWithRequestID(context.Background(), "abc"))
+	require.False(t, requestLog.IsInterfaceNil())
+	requestLog.Warn("with request", "key", "value")
+	requestLog.Debug("filtered")
+	requestLog.LogIfError(nil)
+
+	require.Equal(t, [][]interface{}{
+		{"key", "value"},
+		{"key", "value"},
+		{"key", "value", "request id", "abc"},
+	}, stub.loggedArgs)
+}
+
+func TestObserverZone_ShouldBeBoundToTheRequest(t *testing.T) {
+	t.Parallel()
+
+	SetObserverZone(context.Background(), "ignored")
+	require.Empty(t, GetObserverZone(context.Background()))
+
+	ctx := ContextWithRequestID(context.Background(), "request")
+	require.Empty(t, GetObserverZone(ctx))
+
+	SetObserverZone(ctx, "eu-west")
+	require.Equal(t, "eu-west", GetObserverZone(ctx))
+	SetObserverZone(ctx, "")
+	require.Equal(t, "eu-west", GetObserverZone(ctx))
+
+	require.Empty(t, GetObserverZone(ContextWithRequestID(context.Background(), "another request")))
+}
+
+func TestForwardedHeaders_ShouldBeBoundToTheContext(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, GetForwardedHeaders(nil))
+	require.Nil(t, GetForwardedHeaders(context.Background()))
+
+	ctx := ContextWithForwardedHeaders(context.Background(), nil)
+	require.Equal(t, context.Background(), ctx)
+
+	headers := http.Header{"X-Tenant-Id": []string{"tenant"}}
+	ctx = ContextWithForwardedHeaders(ContextWithRequestID(context.Background(), "request"), headers)
+	require.Equal(t, headers, GetForwardedHeaders(ctx))
+}
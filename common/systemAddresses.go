@@ -0,0 +1,16 @@
+package common
+
+// the addresses of the system account and of the system smart contracts are kept as hex encoded bytes, as their human
+// readable form depends on the address prefix of the chain
+const (
+	// SystemAccountAddressHex is the hex encoded address of the system account
+	SystemAccountAddressHex = "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	// StakingContractAddressHex is the hex encoded address of the staking system smart contract
+	StakingContractAddressHex = "000000000000000000010000000000000000000000000000000000000000ffff"
+	// ValidatorContractAddressHex is the hex encoded address of the validator system smart contract
+	ValidatorContractAddressHex = "000000000000000000010000000000000000000000000000000000000001ffff"
+	// ESDTContractAddressHex is the hex encoded address of the ESDT system smart contract
+	ESDTContractAddressHex = "000000000000000000010000000000000000000000000000000000000002ffff"
+	// DelegationManagerContractAddressHex is the hex encoded address of the delegation manager system smart contract
+	DelegationManagerContractAddressHex = "000000000000000000010000000000000000000000000000000000000004ffff"
+)
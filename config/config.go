@@ -18,6 +18,30 @@ type GeneralSettingsConfig struct {
 	AllowEntireTxPoolFetch                   bool
 	NumShardsTimeoutInSec                    int
 	TimeBetweenNodesRequestsInSec            int
+	ShardIDCacheSize                         int
+	TxStatusCacheSize                        int
+	TxStatusCachePendingTTLMs                int
+	TxStatusCacheTerminalTTLSec              int
+	HyperblocksCacheSize                     int
+	SentTxsDeduplicationWindowSec            int
+	ReadYourWritesCacheSize                  int
+	ReadYourWritesWindowSec                  int
+	TransactionBroadcastFanout               int
+	NonceGapWarningThreshold                 uint64
+	FinalityDepth                            uint64
+	MinObserverVersion                       string
+	ExpectedChainID                          string
+	ExpectedMinTransactionVersion            uint32
+	Zone                                     string
+	NetworkStatusStreamPollIntervalMs        int
+	ObserverWarmUpDurationSec                int
+	LatencyAwareRouting                      bool
+	DeterministicObserversOrder              bool
+	SlowObserverLatencyFactor                float64
+	DisableObserverResponseCompression       bool
+	MaxBlocksInMultiHashRequest              int
+	LivenessMaxNodesStateCheckDelaySec       int
+	ReadOnlyMode                             bool
 }
 
 // Config will hold the whole config file's data
@@ -27,6 +51,30 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	GasPriceSuggestion     GasPriceSuggestionConfig
+	TrustedProxies         TrustedProxiesConfig
+	ObserverHeaders        ObserverHeadersConfig
+	FeatureFlags           FeatureFlagsConfig
+	WebSocketRPC           WebSocketRPCConfig
+	NodePassthrough        NodePassthroughConfig
+	DataFreshness          DataFreshnessConfig
+	FaucetExternalSigner   FaucetExternalSignerConfig
+	FaucetQueue            FaucetQueueConfig
+	BlocksExport           BlocksExportConfig
+	CachePersistence       CachePersistenceConfig
+	ElasticSearchConnector ElasticSearchConnectorConfig
+	ResponseSigning        ResponseSigningConfig
+	LoadShedding           LoadSheddingConfig
+	PanicReporting         PanicReportingConfig
+	TokenPrice             TokenPriceConfig
+	FailoverWebhooks       FailoverWebhooksConfig
+	UptimeHeartbeat        UptimeHeartbeatConfig
+	ReorgDetection         ReorgDetectionConfig
+	Storage                StorageConfig
+	ESDTMetadataCache      ESDTMetadataCacheConfig
+	ClientsUsage           ClientsUsageConfig
+	TransactionsPolicy     TransactionsPolicyConfig
+	ContractABIs           ContractABIsConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -40,6 +88,7 @@ type TypeConfig struct {
 type PubkeyConfig struct {
 	Length          int
 	Type            string
+	Hrp             string
 	SignatureLength int
 }
 
@@ -49,8 +98,229 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// GasPriceSuggestionConfig holds the configuration related to the gas price suggestions
+type GasPriceSuggestionConfig struct {
+	Strategy            string
+	CongestionThreshold uint64
+	MaxMultiplier       float64
+}
+
+// TrustedProxiesConfig holds the configuration related to the reverse proxies allowed to forward the client IP
+type TrustedProxiesConfig struct {
+	CIDRs   []string
+	Headers []string
+}
+
+// ObserverHeadersConfig holds the configuration related to the headers added to all the requests sent to the observers
+type ObserverHeadersConfig struct {
+	StaticHeaders          []StaticHeaderConfig
+	ForwardedClientHeaders []string
+}
+
+// StaticHeaderConfig holds a header added with the same value to all the requests sent to the observers
+type StaticHeaderConfig struct {
+	Name  string
+	Value string
+}
+
+// FeatureFlagsConfig holds the flags enabling the optional endpoints, by feature name
+type FeatureFlagsConfig struct {
+	Endpoints map[string]bool
+}
+
+// WebSocketRPCConfig holds the configuration related to the WebSocket endpoint multiplexing the API requests over a
+// single connection
+type WebSocketRPCConfig struct {
+	Enabled                 bool
+	MaxConcurrentOperations int
+	OperationTimeoutSec     int
+	MaxMessageSizeBytes     int64
+	PingIntervalSec         int
+	AllowedOrigins          []string
+}
+
+// NodePassthroughConfig holds the configuration related to the node endpoints forwarded as they are to the observers
+type NodePassthroughConfig struct {
+	AllowedEndpoints []string
+}
+
+// DataFreshnessConfig holds the configuration related to the freshness check of the account and network status reads
+type DataFreshnessConfig struct {
+	Enabled       bool
+	MaxNonceDelta uint64
+}
+
+// FaucetExternalSignerConfig holds the configuration of the external service which signs the faucet transactions
+type FaucetExternalSignerConfig struct {
+	Enabled           bool
+	URLs              []string
+	SenderAddresses   []string
+	RequestTimeoutSec int
+}
+
+// FaucetQueueConfig holds the configuration of the queue in which the faucet requests are processed asynchronously
+type FaucetQueueConfig struct {
+	Enabled              bool
+	MaxPendingRequests   int
+	MaxAttempts          int
+	RetryDelaySec        int
+	RequestsRetentionSec int
+}
+
+// ResponseSigningConfig holds the configuration of the key used for signing the responses of the signed routes
+type ResponseSigningConfig struct {
+	Enabled bool
+	PemFile string
+}
+
+// LoadSheddingConfig holds the configuration related to the cap of the requests served at once
+type LoadSheddingConfig struct {
+	Enabled                  bool
+	MaxInFlightRequests      int
+	ReservedPriorityRequests int
+	MaxQueuedRequests        int
+	MaxLowPriorityRequests   int
+	AllowHighPriorityHeader  bool
+	QueueTimeoutMs           int
+	RetryAfterSec            int
+}
+
+// PanicReportingConfig holds the configuration of the error tracking service the recovered panics are reported to
+type PanicReportingConfig struct {
+	Enabled           bool
+	DSN               string
+	Environment       string
+	RequestTimeoutSec int
+	MaxPendingReports int
+}
+
+// TokenPriceConfig holds the configuration of the provider of the token prices
+type TokenPriceConfig struct {
+	Enabled          bool
+	Type             string
+	Name             string
+	URL              string
+	PriceField       string
+	CacheValiditySec int
+}
+
+// FailoverWebhooksConfig holds the configuration of the webhooks alerted on the observers failover events
+type FailoverWebhooksConfig struct {
+	Enabled           bool
+	RequestTimeoutSec int
+	Webhooks          []WebhookConfig
+}
+
+// WebhookConfig holds the URL of a webhook and the format of the payload posted to it
+type WebhookConfig struct {
+	URL    string
+	Format string
+}
+
+// UptimeHeartbeatConfig holds the configuration of the signed heartbeats reporting the health of the proxy to an
+// external uptime registry
+type UptimeHeartbeatConfig struct {
+	Enabled           bool
+	RegistryURL       string
+	PublicURL         string
+	IntervalSec       int
+	RequestTimeoutSec int
+	PemFile           string
+}
+
+// ReorgDetectionConfig holds the configuration of the detector flagging the blocks served with a different hash than
+// before for the same nonce
+type ReorgDetectionConfig struct {
+	Enabled                  bool
+	WindowSec                int
+	MaxTrackedNoncesPerShard int
+}
+
+// ESDTMetadataCacheConfig holds the configuration of the cache of the tokens metadata and of the blocks follower which
+// invalidates it
+type ESDTMetadataCacheConfig struct {
+	Enabled          bool
+	CacheSize        int
+	CacheValiditySec int
+	PollIntervalMs   int
+	MaxBlocksPerPoll uint64
+}
+
+// ClientsUsageConfig holds the configuration of the tracking of the requests made by each client, identified by its API key
+type ClientsUsageConfig struct {
+	Enabled          bool
+	ApiKeyHeader     string
+	FlushIntervalSec int
+	RetentionDays    int
+}
+
+// TransactionsPolicyConfig holds the limits and the receivers lists enforced on the transactions relayed by the proxy
+type TransactionsPolicyConfig struct {
+	Enabled          bool
+	MaxGasLimit      uint64
+	MaxValue         string
+	MaxDataFieldSize int
+	AllowedReceivers []string
+	DeniedReceivers  []string
+}
+
+// ContractABIsConfig holds the contracts whose ABIs are used to decode the events they log
+type ContractABIsConfig struct {
+	Enabled   bool
+	Contracts []ContractABIConfig
+}
+
+// ContractABIConfig holds the bech32 address of a contract and the path of its JSON ABI file
+type ContractABIConfig struct {
+	Address string
+	ABIFile string
+}
+
+// ElasticSearchConnectorConfig holds the configuration of the connector to the Elasticsearch cluster fed by the indexer
+type ElasticSearchConnectorConfig struct {
+	Enabled  bool
+	URL      string
+	Username string
+	Password string
+}
+
+// BlocksExportConfig holds the configuration of the export of block ranges to files
+type BlocksExportConfig struct {
+	Enabled         bool
+	Directory       string
+	MaxBlocksPerJob uint64
+}
+
+// CachePersistenceConfig holds the configuration of the snapshots of the cached data saved on disk
+type CachePersistenceConfig struct {
+	Enabled           bool
+	MaxSnapshotAgeSec uint64
+}
+
+// StorageConfig holds the configuration of the storage in which the proxy-side data is persisted
+type StorageConfig struct {
+	Type     string
+	FilePath string
+	Redis    RedisStorageConfig
+}
+
+// RedisStorageConfig holds the configuration of the Redis server used as storage
+type RedisStorageConfig struct {
+	Address    string
+	DB         int
+	KeyPrefix  string
+	TimeoutSec int
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
-	Credentials []data.Credential
-	Hasher      TypeConfig
+	Credentials                            []data.Credential
+	Hasher                                 TypeConfig
+	AdminApiKey                            string
+	AdminRequestSigningSecret              string
+	AdminRequestMaxAgeSec                  int
+	ClientApiKeys                          []string
+	StorageRedisPassword                   string
+	FaucetExternalSignerAuthorizationToken string
+	ObserversCredentials                   []data.ObserverCredentials
 }
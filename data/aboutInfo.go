@@ -2,8 +2,32 @@ package data
 
 // AboutInfo defines the structure needed for exposing app info
 type AboutInfo struct {
-	AppVersion string `json:"appVersion"`
-	CommitID   string `json:"commitID"`
+	AppVersion string         `json:"appVersion"`
+	CommitID   string         `json:"commitID"`
+	Shards     []*ShardNonces `json:"shards,omitempty"`
+}
+
+// ShardNonces holds the highest nonce reported by the observers of a shard during the last nodes sync state check,
+// along with the lag of each observer behind it
+type ShardNonces struct {
+	ShardID   uint32              `json:"shard"`
+	MaxNonce  uint64              `json:"maxNonce"`
+	Observers []*ObserverNonceLag `json:"observers"`
+}
+
+// ObserverNonceLag holds the nonce reported by an observer and the number of blocks it lags behind the highest nonce
+// of its shard
+type ObserverNonceLag struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Lag     uint64 `json:"lag"`
+}
+
+// ObserverNonce holds the nonce reported by an observer during the last nodes sync state check
+type ObserverNonce struct {
+	Address string
+	ShardID uint32
+	Nonce   uint64
 }
 
 // NodesVersionProxyResponseData maps the response data for the proxy's nodes version endpoint
@@ -21,3 +45,31 @@ type NodeVersionAPIResponse struct {
 	Error string `json:"error"`
 	Code  string `json:"code"`
 }
+
+// ExcludedObserver holds the details of an observer excluded for running a version below the minimum accepted one or
+// for being connected to another network
+type ExcludedObserver struct {
+	Address                       string `json:"address"`
+	ShardID                       uint32 `json:"shard"`
+	Reason                        string `json:"reason"`
+	Version                       string `json:"version"`
+	MinimumVersion                string `json:"minimumVersion,omitempty"`
+	ChainID                       string `json:"chainID,omitempty"`
+	ExpectedChainID               string `json:"expectedChainID,omitempty"`
+	MinTransactionVersion         uint32 `json:"minTransactionVersion,omitempty"`
+	ExpectedMinTransactionVersion uint32 `json:"expectedMinTransactionVersion,omitempty"`
+}
+
+const (
+	// ExclusionReasonVersion signals that the observer runs a version below the minimum accepted one
+	ExclusionReasonVersion = "version too low"
+
+	// ExclusionReasonNetworkMismatch signals that the observer reports a chain ID or a min transaction version
+	// different from the expected ones
+	ExclusionReasonNetworkMismatch = "network mismatch"
+)
+
+// ExcludedObserversResponseData maps the response data for the proxy's excluded observers endpoint
+type ExcludedObserversResponseData struct {
+	ExcludedObservers []*ExcludedObserver `json:"excludedObservers"`
+}
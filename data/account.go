@@ -4,8 +4,9 @@ import "github.com/multiversx/mx-chain-core-go/data/validator"
 
 // AccountModel defines an account model (with associated information)
 type AccountModel struct {
-	Account   Account   `json:"account"`
-	BlockInfo BlockInfo `json:"blockInfo"`
+	Account       Account        `json:"account"`
+	BlockInfo     BlockInfo      `json:"blockInfo"`
+	DataFreshness *DataFreshness `json:"dataFreshness,omitempty"`
 }
 
 // AccountsModel defines the model of the accounts response
@@ -13,6 +14,11 @@ type AccountsModel struct {
 	Accounts map[string]*Account `json:"accounts"`
 }
 
+// AccountsNonces holds the current nonces of a bulk of addresses, indexed by address
+type AccountsNonces struct {
+	Nonces map[string]uint64 `json:"nonces"`
+}
+
 // Account defines the data structure for an account
 type Account struct {
 	Address         string            `json:"address"`
@@ -26,6 +32,41 @@ type Account struct {
 	DeveloperReward string            `json:"developerReward"`
 	OwnerAddress    string            `json:"ownerAddress"`
 	Pairs           map[string]string `json:"pairs,omitempty"`
+	// the guardian fields are not part of the observer's account response, being merged from its guardian data on request
+	IsGuarded       bool          `json:"isGuarded,omitempty"`
+	ActiveGuardian  *GuardianInfo `json:"activeGuardian,omitempty"`
+	PendingGuardian *GuardianInfo `json:"pendingGuardian,omitempty"`
+	// GuardianCooldown is computed by the proxy when the account has a pending guardian
+	GuardianCooldown *GuardianCooldown `json:"guardianCooldown,omitempty"`
+}
+
+// GuardianInfo holds the details of a guardian set on an account
+type GuardianInfo struct {
+	Address         string `json:"address"`
+	ActivationEpoch uint32 `json:"activationEpoch"`
+	ServiceUID      string `json:"serviceUID"`
+}
+
+// GuardianDataResponse matches the data field of an observer's guardian data response
+type GuardianDataResponse struct {
+	GuardianData struct {
+		ActiveGuardian  *GuardianInfo `json:"activeGuardian,omitempty"`
+		PendingGuardian *GuardianInfo `json:"pendingGuardian,omitempty"`
+		Guarded         bool          `json:"guarded"`
+	} `json:"guardianData"`
+}
+
+// GuardianDataApiResponse defines a wrapped guardian data response coming from an observer
+type GuardianDataApiResponse struct {
+	Data  GuardianDataResponse `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+// GuardianCooldown holds the number of epochs left until the pending guardian of an account becomes active
+type GuardianCooldown struct {
+	CurrentEpoch    uint32 `json:"currentEpoch"`
+	RemainingEpochs uint32 `json:"remainingEpochs"`
 }
 
 // ValidatorApiResponse represents the data which is fetched from each validator for returning it in API call
@@ -36,6 +77,13 @@ type ValidatorStatisticsResponse struct {
 	Statistics map[string]*ValidatorApiResponse `json:"statistics"`
 }
 
+// ValidatorStatisticsPage holds a page of the filtered validator statistics, along with the number of the validators
+// matching the filters
+type ValidatorStatisticsPage struct {
+	Statistics map[string]*ValidatorApiResponse `json:"statistics"`
+	TotalCount int                              `json:"totalCount"`
+}
+
 // ValidatorStatisticsApiResponse respects the format the validator statistics are received from the observers
 type ValidatorStatisticsApiResponse struct {
 	Data  ValidatorStatisticsResponse `json:"data"`
@@ -68,3 +116,65 @@ type AccountKeyValueResponse struct {
 	Error string                      `json:"error"`
 	Code  string                      `json:"code"`
 }
+
+// AccountKeyValuePairsResponseData follows the format of the data field on an account key-value pairs response
+type AccountKeyValuePairsResponseData struct {
+	Pairs     map[string]string `json:"pairs"`
+	BlockInfo BlockInfo         `json:"blockInfo"`
+}
+
+// AccountKeyValuePairsApiResponse defines the response for a request for all the key-value pairs of an account
+type AccountKeyValuePairsApiResponse struct {
+	Data  AccountKeyValuePairsResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+// KeyValueChange holds the values of a key before and after a change
+type KeyValueChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AccountKeysDiff holds the keys of an account which were added, changed or removed between two blocks
+type AccountKeysDiff struct {
+	FromBlock BlockInfo                 `json:"fromBlock"`
+	ToBlock   BlockInfo                 `json:"toBlock"`
+	Added     map[string]string         `json:"added"`
+	Changed   map[string]KeyValueChange `json:"changed"`
+	Removed   map[string]string         `json:"removed"`
+}
+
+// SignatureVerificationRequest holds the details needed for verifying the signature of an arbitrary message
+type SignatureVerificationRequest struct {
+	Address   string `json:"address"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	Scheme    string `json:"scheme"`
+}
+
+// SignatureVerification holds the result of the verification of a message signature
+type SignatureVerification struct {
+	Address string `json:"address"`
+	Scheme  string `json:"scheme"`
+	IsValid bool   `json:"isValid"`
+}
+
+// AddressActivitySummary holds the number of transactions sent and received by an address and the timestamps of its
+// first and last activity. When served by the observers, only the number of sent transactions is known
+type AddressActivitySummary struct {
+	Address                string  `json:"address"`
+	SentTxsCount           uint64  `json:"sentTxsCount"`
+	ReceivedTxsCount       *uint64 `json:"receivedTxsCount,omitempty"`
+	FirstActivityTimestamp *uint64 `json:"firstActivityTimestamp,omitempty"`
+	LastActivityTimestamp  *uint64 `json:"lastActivityTimestamp,omitempty"`
+	Source                 string  `json:"source"`
+}
+
+const (
+	// ActivitySourceExternalStorage signals that the activity summary was computed by the external storage
+	ActivitySourceExternalStorage = "external storage"
+
+	// ActivitySourceObservers signals that the activity summary was computed from the account served by the observers
+	ActivitySourceObservers = "observers"
+)
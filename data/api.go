@@ -1,6 +1,7 @@
 package data
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,13 +14,40 @@ type GenericAPIResponse struct {
 	Code  ReturnCode  `json:"code"`
 }
 
+// GenericAPIResponseV2 defines the structure of all responses on the v2 API endpoints
+type GenericAPIResponseV2 struct {
+	Data  interface{} `json:"data"`
+	Error *APIErrorV2 `json:"error,omitempty"`
+	Code  ReturnCode  `json:"code"`
+}
+
+// APIErrorV2 defines the structure of the error object returned on the v2 API endpoints
+type APIErrorV2 struct {
+	Message string `json:"message"`
+}
+
+// FieldValidationError describes why a field of a request body is not valid
+type FieldValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 // NetworkConfig is a dto that will keep information about the network config
 type NetworkConfig struct {
 	Config struct {
-		ChainID               string `json:"erd_chain_id"`
-		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
-		MinGasPrice           uint64 `json:"erd_min_gas_price"`
-		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		ChainID                string      `json:"erd_chain_id"`
+		MinGasLimit            uint64      `json:"erd_min_gas_limit"`
+		MinGasPrice            uint64      `json:"erd_min_gas_price"`
+		MinTransactionVersion  uint32      `json:"erd_min_transaction_version"`
+		ShardConsensusSize     uint32      `json:"erd_shard_consensus_group_size"`
+		GasPerDataByte         uint64      `json:"erd_gas_per_data_byte"`
+		MaxGasPerTransaction   uint64      `json:"erd_max_gas_per_transaction"`
+		GasPriceModifier       json.Number `json:"erd_gas_price_modifier"`
+		Denomination           int         `json:"erd_denomination"`
+		ExtraGasLimitGuardedTx uint64      `json:"erd_extra_gas_limit_guarded_tx"`
+		RoundDuration          uint64      `json:"erd_round_duration"`
+		RoundsPerEpoch         uint32      `json:"erd_rounds_per_epoch"`
+		StartTime              uint64      `json:"erd_start_time"`
 	} `json:"config"`
 }
 
@@ -35,6 +63,9 @@ const (
 
 	// ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 	ReturnCodeRequestError ReturnCode = "bad_request"
+
+	// ReturnCodeSystemBusy defines a request which hasn't been executed because the proxy is overloaded
+	ReturnCodeSystemBusy ReturnCode = "system_busy"
 )
 
 // VersionData holds the components specific for each version
@@ -42,6 +73,13 @@ type VersionData struct {
 	Facade     FacadeHandler
 	ApiHandler ApiHandler
 	ApiConfig  ApiRoutesConfig
+	Serializer ResponseSerializer
+}
+
+// ResponseSerializer defines the actions that a component writing the API responses of a version should do
+type ResponseSerializer interface {
+	Serialize(c *gin.Context, status int, response interface{})
+	IsInterfaceNil() bool
 }
 
 // EndpointHandlerData holds the items needed for creating a new HTTP endpoint
@@ -86,6 +124,9 @@ type StatusMetricsProvider interface {
 	GetAll() map[string]*EndpointMetrics
 	GetMetricsForPrometheus() string
 	AddRequestData(path string, withError bool, duration time.Duration)
+	AddDeprecatedRequest(path string)
+	AddObserverRequestData(address string, method string, duration time.Duration)
+	GetObserverLatency(address string, method string) (time.Duration, bool)
 	IsInterfaceNil() bool
 }
 
@@ -96,15 +137,31 @@ type ApiRoutesConfig struct {
 
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
-	Routes []RouteConfig
+	Routes         []RouteConfig
+	AllowedCIDRs   []string
+	DeniedCIDRs    []string
+	CacheMaxAgeSec uint64
 }
 
 // RouteConfig holds the configuration for a single route
 type RouteConfig struct {
-	Name      string
-	Open      bool
-	Secured   bool
-	RateLimit uint64
+	Name           string
+	Open           bool
+	Secured        bool
+	RateLimit      uint64
+	AllowedCIDRs   []string
+	DeniedCIDRs    []string
+	CacheMaxAgeSec uint64
+	Signed         bool
+	LoadClass      string
+	Deprecated     bool
+	SunsetDate     string
+	Replacement    string
+}
+
+// ReadOnlyModeRequest holds the state of the read-only mode requested through the admin API
+type ReadOnlyModeRequest struct {
+	Enabled bool `json:"enabled"`
 }
 
 // Credential holds an username and a password
@@ -3,6 +3,7 @@ package data
 import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 // BlockApiResponse is a response holding a block
@@ -17,6 +18,37 @@ type BlockApiResponsePayload struct {
 	Block api.Block `json:"block"`
 }
 
+// EpochStartDataApiResponse is a response holding the data of the epoch start block of a shard, as returned by the
+// observers
+type EpochStartDataApiResponse struct {
+	Data  EpochStartDataApiResponsePayload `json:"data"`
+	Error string                           `json:"error"`
+	Code  ReturnCode                       `json:"code"`
+}
+
+// EpochStartDataApiResponsePayload wraps the epoch start data
+type EpochStartDataApiResponsePayload struct {
+	EpochStart EpochStartData `json:"epochStart"`
+}
+
+// EpochStartData holds the coordinates of the epoch start block of a shard
+type EpochStartData struct {
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+	Shard uint32 `json:"shard"`
+}
+
+// ChainReorg holds a chain reorganization detected on a shard, a different hash having been served for an already
+// served nonce
+type ChainReorg struct {
+	Shard        uint32 `json:"shard"`
+	Nonce        uint64 `json:"nonce"`
+	PreviousHash string `json:"previousHash"`
+	Hash         string `json:"hash"`
+	DetectedAt   int64  `json:"detectedAt"`
+}
+
 // HyperblockApiResponse is a response holding a hyperblock
 type HyperblockApiResponse struct {
 	Data  HyperblockApiResponsePayload `json:"data"`
@@ -75,6 +107,19 @@ type InternalMiniBlockApiResponsePayload struct {
 	MiniBlock interface{} `json:"miniblock"`
 }
 
+// MiniBlock holds a miniblock along with its hash and the epoch it was fetched for. The transactions are only set if
+// the hydration was requested, in which case the hashes which could not be resolved are listed as missing
+type MiniBlock struct {
+	Hash             string                              `json:"hash"`
+	Type             string                              `json:"type"`
+	SourceShard      uint32                              `json:"sourceShard"`
+	DestinationShard uint32                              `json:"destinationShard"`
+	Epoch            uint32                              `json:"epoch"`
+	TxHashes         []string                            `json:"txHashes"`
+	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
+	MissingTxHashes  []string                            `json:"missingTxHashes,omitempty"`
+}
+
 // AlteredAccountsApiResponse is a response holding a altered accounts
 type AlteredAccountsApiResponse struct {
 	Data  AlteredAccountsPayload `json:"data"`
@@ -86,3 +131,47 @@ type AlteredAccountsApiResponse struct {
 type AlteredAccountsPayload struct {
 	Accounts []*alteredAccount.AlteredAccount `json:"accounts"`
 }
+
+// BlocksExportRequest holds the details of a range of blocks to be exported to a file
+type BlocksExportRequest struct {
+	Shard       uint32 `json:"shard"`
+	FromNonce   uint64 `json:"fromNonce"`
+	ToNonce     uint64 `json:"toNonce"`
+	Format      string `json:"format"`
+	Hyperblocks bool   `json:"hyperblocks"`
+}
+
+// BlocksExportJob holds the status of an export of a range of blocks
+type BlocksExportJob struct {
+	ID          string `json:"id"`
+	Shard       uint32 `json:"shard"`
+	FromNonce   uint64 `json:"fromNonce"`
+	ToNonce     uint64 `json:"toNonce"`
+	Format      string `json:"format"`
+	Hyperblocks bool   `json:"hyperblocks"`
+	Status      string `json:"status"`
+	NumExported uint64 `json:"numExported"`
+	File        string `json:"file"`
+	Error       string `json:"error,omitempty"`
+	StartTime   int64  `json:"startTime"`
+	EndTime     int64  `json:"endTime,omitempty"`
+}
+
+// BlockByHashRequest identifies a block requested by its shard and hash
+type BlockByHashRequest struct {
+	Shard uint32 `json:"shard"`
+	Hash  string `json:"hash" binding:"required,hexstring"`
+}
+
+// BlocksByHashesRequest holds the blocks requested at once by their shards and hashes
+type BlocksByHashesRequest struct {
+	Blocks []*BlockByHashRequest `json:"blocks" binding:"dive"`
+}
+
+// BlockByHashResult holds the block fetched for a requested shard and hash, or the error which prevented it
+type BlockByHashResult struct {
+	Shard uint32     `json:"shard"`
+	Hash  string     `json:"hash"`
+	Block *api.Block `json:"block,omitempty"`
+	Error string     `json:"error,omitempty"`
+}
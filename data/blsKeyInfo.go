@@ -0,0 +1,11 @@
+package data
+
+// BLSKeyInfo holds the staking status of a validator BLS key, as reported by the staking contract, along with its owner,
+// its reward address and, if the key is part of the validator statistics, its current statistics
+type BLSKeyInfo struct {
+	BLSKey        string                `json:"blsKey"`
+	Status        string                `json:"status"`
+	Owner         string                `json:"owner"`
+	RewardAddress string                `json:"rewardAddress"`
+	Statistics    *ValidatorApiResponse `json:"statistics,omitempty"`
+}
@@ -0,0 +1,28 @@
+package data
+
+// AnonymousClientApiKey is the key under which the usage of the requests without an API key, or with one not configured
+// in the credentials, is tracked
+const AnonymousClientApiKey = "anonymous"
+
+// ClientUsageReport holds the usage of the proxy by a client, identified by its API key, between the From and To unix
+// timestamps. The error rate is the share of the requests answered with a status other than 200
+type ClientUsageReport struct {
+	ApiKey        string               `json:"apiKey"`
+	From          int64                `json:"from"`
+	To            int64                `json:"to"`
+	Requests      uint64               `json:"requests"`
+	Errors        uint64               `json:"errors"`
+	ErrorRate     float64              `json:"errorRate"`
+	BytesReceived uint64               `json:"bytesReceived"`
+	BytesSent     uint64               `json:"bytesSent"`
+	Hourly        []*ClientUsageBucket `json:"hourly"`
+}
+
+// ClientUsageBucket holds the usage of the proxy by a client during the hour starting at the Timestamp
+type ClientUsageBucket struct {
+	Timestamp     int64  `json:"timestamp"`
+	Requests      uint64 `json:"requests"`
+	Errors        uint64 `json:"errors"`
+	BytesReceived uint64 `json:"bytesReceived"`
+	BytesSent     uint64 `json:"bytesSent"`
+}
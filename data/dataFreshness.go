@@ -0,0 +1,23 @@
+package data
+
+// DataFreshnessStatus represents the outcome of comparing the nonces of the observers a read was performed against
+type DataFreshnessStatus string
+
+const (
+	// DataFreshnessFresh means that the compared observers are in sync
+	DataFreshnessFresh DataFreshnessStatus = "fresh"
+
+	// DataFreshnessStale means that one of the compared observers lags behind more than the allowed nonce delta
+	DataFreshnessStale DataFreshnessStatus = "stale"
+
+	// DataFreshnessUnverified means that only one observer answered, so there was nothing to compare against
+	DataFreshnessUnverified DataFreshnessStatus = "unverified"
+)
+
+// DataFreshness holds the freshness indicator of a read performed against multiple observers
+type DataFreshness struct {
+	Status               DataFreshnessStatus `json:"status"`
+	Nonce                uint64              `json:"nonce"`
+	NonceDelta           uint64              `json:"nonceDelta"`
+	NumComparedObservers int                 `json:"numComparedObservers"`
+}
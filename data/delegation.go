@@ -0,0 +1,29 @@
+package data
+
+// DelegationProviderMetadata holds the metadata set by the owner of a delegation contract
+type DelegationProviderMetadata struct {
+	Name       string `json:"name"`
+	Website    string `json:"website"`
+	Identifier string `json:"identifier"`
+}
+
+// DelegationProvider holds the decoded configuration of a delegation contract, along with its number of delegators and
+// its total active stake. The service fee is given in percents and a zero max delegation cap means an uncapped
+// contract. The error is set instead when the contract could not be queried
+type DelegationProvider struct {
+	Address              string                      `json:"address"`
+	Owner                string                      `json:"owner,omitempty"`
+	ServiceFee           float64                     `json:"serviceFee"`
+	MaxDelegationCap     string                      `json:"maxDelegationCap,omitempty"`
+	InitialOwnerFunds    string                      `json:"initialOwnerFunds,omitempty"`
+	AutomaticActivation  bool                        `json:"automaticActivation"`
+	WithDelegationCap    bool                        `json:"withDelegationCap"`
+	ChangeableServiceFee bool                        `json:"changeableServiceFee"`
+	CheckCapOnRedelegate bool                        `json:"checkCapOnRedelegate"`
+	CreatedNonce         uint64                      `json:"createdNonce"`
+	UnBondPeriodInEpochs uint64                      `json:"unBondPeriodInEpochs"`
+	NumDelegators        uint64                      `json:"numDelegators"`
+	TotalActiveStake     string                      `json:"totalActiveStake,omitempty"`
+	Metadata             *DelegationProviderMetadata `json:"metadata,omitempty"`
+	Error                string                      `json:"error,omitempty"`
+}
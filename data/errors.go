@@ -7,3 +7,20 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrNoExternalStorage signals that the requested data is only served by an external storage, such as the Elasticsearch
+// cluster fed by the indexer, and none is configured
+var ErrNoExternalStorage = errors.New("no external storage configured")
+
+// ErrClientsUsageTrackingDisabled signals that the usage of the clients is not tracked, as the feature is disabled
+var ErrClientsUsageTrackingDisabled = errors.New("clients usage tracking is disabled")
+
+// ErrTransactionOutcomeNotFound signals that the smart contract call outcome could not be found in the transaction results
+var ErrTransactionOutcomeNotFound = errors.New("smart contract call outcome not found for transaction")
+
+// ErrInvalidObserver signals that the observer provided for registration was rejected, either by its probe or by the
+// live observers list
+var ErrInvalidObserver = errors.New("invalid observer")
+
+// ErrObserverNotFound signals that no observer with the provided address is registered
+var ErrObserverNotFound = errors.New("observer not found")
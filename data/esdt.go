@@ -35,3 +35,66 @@ func IsValidEsdtPath(path string) bool {
 
 	return false
 }
+
+// Collection holds the details of an NFT, SFT or MetaESDT collection
+type Collection struct {
+	Identifier    string              `json:"identifier"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Owner         string              `json:"owner"`
+	Decimals      uint32              `json:"decimals"`
+	Properties    map[string]bool     `json:"properties"`
+	Roles         map[string][]string `json:"roles"`
+	NumIssuedNFTs uint64              `json:"numIssuedNFTs"`
+}
+
+// IssuedToken holds the properties of a token or collection owned by an address, along with the roles the address holds
+// on it
+type IssuedToken struct {
+	Identifier string          `json:"identifier"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Decimals   uint32          `json:"decimals"`
+	Properties map[string]bool `json:"properties"`
+	Roles      []string        `json:"roles"`
+}
+
+// RegisteredNFTsResponse defines the response of a node for the tokens registered by an address
+type RegisteredNFTsResponse struct {
+	Data struct {
+		Tokens []string `json:"tokens"`
+	} `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// ESDTRolesResponse defines the response of a node for the tokens roles of an address
+type ESDTRolesResponse struct {
+	Data struct {
+		Roles map[string][]string `json:"roles"`
+	} `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// TokenPrice holds the price of a token in USD, as returned by the configured price provider
+type TokenPrice struct {
+	Token     string  `json:"token"`
+	Price     float64 `json:"price"`
+	Source    string  `json:"source"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// TokenHolder holds an address holding a token and its balance
+type TokenHolder struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// TokenHoldersPage holds a page of the holders of a token, sorted by their balance in descending order. The next page
+// is requested with the returned cursor, which is empty on the last page
+type TokenHoldersPage struct {
+	Token      string         `json:"token"`
+	Holders    []*TokenHolder `json:"holders"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,16 @@
+package data
+
+// FaucetRequest holds the status of a queued send-user-funds request. Value is empty if the default faucet value is
+// sent. TxHash is set once the transaction was accepted by an observer, while Error holds the reason of the last failed
+// attempt
+type FaucetRequest struct {
+	ID        string `json:"id"`
+	Receiver  string `json:"receiver"`
+	Value     string `json:"value,omitempty"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	TxHash    string `json:"txHash,omitempty"`
+	Error     string `json:"error,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
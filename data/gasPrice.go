@@ -0,0 +1,16 @@
+package data
+
+// GasPriceSuggestion holds the suggested gas price, computed from the transactions pool congestion of each shard
+type GasPriceSuggestion struct {
+	MinGasPrice       uint64                     `json:"minGasPrice"`
+	SuggestedGasPrice uint64                     `json:"suggestedGasPrice"`
+	Strategy          string                     `json:"strategy"`
+	Shards            []*ShardGasPriceSuggestion `json:"shards"`
+}
+
+// ShardGasPriceSuggestion holds the gas price suggestion for a single shard
+type ShardGasPriceSuggestion struct {
+	ShardID           uint32 `json:"shardId"`
+	PoolSize          uint64 `json:"poolSize"`
+	SuggestedGasPrice uint64 `json:"suggestedGasPrice"`
+}
@@ -9,4 +9,21 @@ type EndpointMetrics struct {
 	TotalResponseTime   time.Duration `json:"total_response_time"`
 	LowestResponseTime  time.Duration `json:"lowest_response_time"`
 	HighestResponseTime time.Duration `json:"highest_response_time"`
+	NumDeprecated       uint64        `json:"num_deprecated,omitempty"`
+}
+
+// ShardIDCacheMetrics holds statistics about the usage of the computed shard IDs cache
+type ShardIDCacheMetrics struct {
+	Capacity int     `json:"capacity"`
+	Size     int     `json:"size"`
+	Hits     uint64  `json:"hits"`
+	Misses   uint64  `json:"misses"`
+	HitRate  float64 `json:"hit_rate"`
+}
+
+// ReorgDetectorMetrics holds statistics about the chain reorganizations detected from the served blocks
+type ReorgDetectorMetrics struct {
+	NumReorgsPerShard  map[uint32]uint64 `json:"num_reorgs_per_shard"`
+	LastReorgTimestamp int64             `json:"last_reorg_timestamp"`
+	NumTrackedBlocks   int               `json:"num_tracked_blocks"`
 }
@@ -44,6 +44,8 @@ type NodeStatusResponse struct {
 	Nonce                uint64 `json:"erd_nonce"`
 	ProbableHighestNonce uint64 `json:"erd_probable_highest_nonce"`
 	AreVmQueriesReady    string `json:"erd_are_vm_queries_ready"`
+	ShardID              uint32 `json:"erd_shard_id"`
+	AppVersion           string `json:"erd_app_version"`
 }
 
 // NodeStatusAPIResponseData holds the mapping of the data field when returning the status of a node
@@ -81,3 +83,49 @@ type WaitingEpochsLeftApiResponse struct {
 	Error string                    `json:"error"`
 	Code  string                    `json:"code"`
 }
+
+// NetworkStatusMetrics holds the network status metrics needed for tracking the chain's progress
+type NetworkStatusMetrics struct {
+	Nonce        uint64 `json:"erd_nonce"`
+	CurrentRound uint64 `json:"erd_current_round"`
+	EpochNumber  uint32 `json:"erd_epoch_number"`
+}
+
+// NetworkStatusApiResponse represents the mapping of the response of a node's network status
+type NetworkStatusApiResponse struct {
+	Data struct {
+		Status NetworkStatusMetrics `json:"status"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ShardNetworkStatus holds the status of a shard, as part of a network status snapshot. For the regular shards, the
+// cross-check meta nonce is the latest metachain nonce seen by the shard and the meta nonce lag is the number of
+// metachain blocks the shard is behind at the snapshot time
+type ShardNetworkStatus struct {
+	ShardID             uint32  `json:"shard"`
+	Nonce               uint64  `json:"nonce"`
+	Round               uint64  `json:"round"`
+	Epoch               uint32  `json:"epoch"`
+	CrossCheckMetaNonce *uint64 `json:"crossCheckMetaNonce,omitempty"`
+	MetaNonceLag        *uint64 `json:"metaNonceLag,omitempty"`
+	Error               string  `json:"error,omitempty"`
+}
+
+// NetworkStatusSnapshot holds the status of all the shards, fetched concurrently, along with the time the snapshot was
+// taken at. The round skew is the difference between the highest and the lowest round among the shards which answered
+type NetworkStatusSnapshot struct {
+	Timestamp    int64                 `json:"timestamp"`
+	Shards       []*ShardNetworkStatus `json:"shards"`
+	MaxRoundSkew uint64                `json:"maxRoundSkew"`
+	SameEpoch    bool                  `json:"sameEpoch"`
+}
+
+// NetworkStatusUpdate holds the round, nonce and epoch of a shard, as pushed to the network status stream subscribers
+type NetworkStatusUpdate struct {
+	ShardID uint32 `json:"shard"`
+	Round   uint64 `json:"round"`
+	Nonce   uint64 `json:"nonce"`
+	Epoch   uint32 `json:"epoch"`
+}
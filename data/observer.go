@@ -7,6 +7,65 @@ type NodeData struct {
 	IsSynced       bool
 	IsFallback     bool
 	IsSnapshotless bool
+
+	// AutoDetectShard marks the nodes declared without a shard, which is detected from the shard reported by the node
+	// when it is registered. ShardId is ignored until then
+	AutoDetectShard bool
+
+	// Zone is the optional zone or region the observer is deployed in. The observers in the same zone as the proxy are
+	// preferred, the others being used only for failover
+	Zone string
+
+	// Username, Password and BearerToken are the optional credentials attached to every request sent to the observer,
+	// for observers placed behind an authenticated reverse proxy. They are never read from the main config file, being
+	// set from the credentials file or through the admin API
+	Username    string `json:"-" toml:"-"`
+	Password    string `json:"-" toml:"-"`
+	BearerToken string `json:"-" toml:"-"`
+}
+
+// HasCredentials returns true if either basic auth credentials or a bearer token are set for the observer
+func (nd *NodeData) HasCredentials() bool {
+	return len(nd.Username) > 0 || len(nd.BearerToken) > 0
+}
+
+// ObserverCredentials holds the credentials, read from the credentials file, of the observer with the given address
+type ObserverCredentials struct {
+	Address     string
+	Username    string
+	Password    string
+	BearerToken string
+}
+
+// ApplyObserversCredentials sets the provided credentials on the nodes with the same address
+func ApplyObserversCredentials(nodes []*NodeData, credentials []ObserverCredentials) {
+	credentialsByAddress := make(map[string]ObserverCredentials, len(credentials))
+	for _, nodeCredentials := range credentials {
+		credentialsByAddress[nodeCredentials.Address] = nodeCredentials
+	}
+
+	for _, node := range nodes {
+		nodeCredentials, found := credentialsByAddress[node.Address]
+		if !found {
+			continue
+		}
+
+		node.Username = nodeCredentials.Username
+		node.Password = nodeCredentials.Password
+		node.BearerToken = nodeCredentials.BearerToken
+	}
+}
+
+// ObserverRegistrationRequest holds the details of an observer to be added at runtime
+type ObserverRegistrationRequest struct {
+	Address        string  `json:"address"`
+	ShardID        *uint32 `json:"shardId,omitempty"`
+	IsFallback     bool    `json:"isFallback"`
+	IsSnapshotless bool    `json:"isSnapshotless"`
+	Zone           string  `json:"zone,omitempty"`
+	Username       string  `json:"username,omitempty"`
+	Password       string  `json:"password,omitempty"`
+	BearerToken    string  `json:"bearerToken,omitempty"`
 }
 
 // NodesReloadResponse is a DTO that holds details about nodes reloading
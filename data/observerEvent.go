@@ -0,0 +1,28 @@
+package data
+
+// ObserverEventType identifies a change of the availability of the observers, as detected by the nodes sync state checks
+type ObserverEventType string
+
+const (
+	// ObserverQuarantined is the event type of an observer which got out of sync and stopped receiving requests
+	ObserverQuarantined ObserverEventType = "observerQuarantined"
+
+	// ObserverRestored is the event type of an observer which got back in sync and receives requests again
+	ObserverRestored ObserverEventType = "observerRestored"
+
+	// ShardWithoutObservers is the event type of a shard whose observers all got out of sync
+	ShardWithoutObservers ObserverEventType = "shardWithoutObservers"
+
+	// ShardObserversRestored is the event type of a shard which has synced observers again
+	ShardObserversRestored ObserverEventType = "shardObserversRestored"
+)
+
+// ObserverEvent holds the details of an observers failover event, as posted to the alerting webhooks
+type ObserverEvent struct {
+	Type      ObserverEventType `json:"type"`
+	NodeType  NodeType          `json:"nodeType"`
+	ShardID   uint32            `json:"shardId"`
+	Address   string            `json:"address,omitempty"`
+	Message   string            `json:"message"`
+	Timestamp int64             `json:"timestamp"`
+}
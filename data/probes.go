@@ -0,0 +1,14 @@
+package data
+
+// ProbeCheck holds the outcome of one of the checks performed by a liveness or readiness probe
+type ProbeCheck struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Details string `json:"details,omitempty"`
+}
+
+// ProbeStatus holds the outcome of a liveness or readiness probe, which is healthy only if all its checks are
+type ProbeStatus struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []*ProbeCheck `json:"checks"`
+}
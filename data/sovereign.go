@@ -0,0 +1,92 @@
+package data
+
+// SovereignValidatorsInfo holds the sovereign chain's validator set for an epoch
+type SovereignValidatorsInfo struct {
+	Epoch              uint32                `json:"epoch"`
+	ConsensusGroupSize uint32                `json:"consensusGroupSize"`
+	TotalStake         string                `json:"totalStake"`
+	Validators         []*SovereignValidator `json:"validators"`
+}
+
+// SovereignValidator holds the details of a validator from the sovereign chain's validator set
+type SovereignValidator struct {
+	PublicKey string `json:"publicKey"`
+	ShardID   uint32 `json:"shardId"`
+	List      string `json:"list"`
+	Index     uint32 `json:"index"`
+	Rating    uint32 `json:"rating"`
+	Owner     string `json:"owner"`
+	Stake     string `json:"stake"`
+	TopUp     string `json:"topUp"`
+}
+
+// StartOfEpochValidatorInfo matches a validator entry returned by the observers' start of epoch validators endpoint
+type StartOfEpochValidatorInfo struct {
+	PublicKey  []byte `json:"publicKey"`
+	ShardId    uint32 `json:"shardId"`
+	List       string `json:"list"`
+	Index      uint32 `json:"index"`
+	TempRating uint32 `json:"tempRating"`
+}
+
+// StartOfEpochValidatorsApiResponse matches the output of an observer's start of epoch validators endpoint
+type StartOfEpochValidatorsApiResponse struct {
+	Data struct {
+		Validators []*StartOfEpochValidatorInfo `json:"validators"`
+	} `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// SovereignBridgeContracts holds the addresses of the contracts bridging a sovereign chain with the main chain
+type SovereignBridgeContracts struct {
+	MainChainESDTSafeAddress string `json:"mainChainESDTSafeAddress"`
+	ESDTSafeAddress          string `json:"esdtSafeAddress"`
+	HeaderVerifierAddress    string `json:"headerVerifierAddress"`
+	FeeMarketAddress         string `json:"feeMarketAddress"`
+}
+
+// SovereignConfig matches the sovereign specific settings returned by an observer's sovereign config endpoint
+type SovereignConfig struct {
+	NativeESDT                     string                   `json:"nativeESDT"`
+	MainChainNotarizationAddresses []string                 `json:"mainChainNotarizationAddresses"`
+	BridgeContracts                SovereignBridgeContracts `json:"bridgeContracts"`
+}
+
+// SovereignConfigApiResponse matches the output of an observer's sovereign config endpoint
+type SovereignConfigApiResponse struct {
+	Data struct {
+		Config SovereignConfig `json:"config"`
+	} `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// SovereignChainParameters holds the settings needed by a tool to bootstrap against a sovereign chain
+type SovereignChainParameters struct {
+	ChainID                        string                   `json:"chainId"`
+	NativeTokenIdentifier          string                   `json:"nativeTokenIdentifier"`
+	Denomination                   int                      `json:"denomination"`
+	MainChainNotarizationAddresses []string                 `json:"mainChainNotarizationAddresses"`
+	BridgeContracts                SovereignBridgeContracts `json:"bridgeContracts"`
+	RoundDuration                  uint64                   `json:"roundDuration"`
+	RoundsPerEpoch                 uint32                   `json:"roundsPerEpoch"`
+	StartTime                      uint64                   `json:"startTime"`
+}
+
+// NotarizedMainChainHeader holds a main chain header notarized by the sovereign chain, along with the sovereign block
+// which notarized it
+type NotarizedMainChainHeader struct {
+	Hash                string `json:"hash"`
+	Nonce               uint64 `json:"nonce"`
+	Round               uint64 `json:"round"`
+	SovereignBlockNonce uint64 `json:"sovereignBlockNonce"`
+	SovereignBlockHash  string `json:"sovereignBlockHash"`
+}
+
+// NotarizedMainChainHeaders holds the main chain headers notarized by a range of sovereign blocks
+type NotarizedMainChainHeaders struct {
+	From    uint64                      `json:"from"`
+	To      uint64                      `json:"to"`
+	Headers []*NotarizedMainChainHeader `json:"headers"`
+}
@@ -0,0 +1,25 @@
+package data
+
+// StakingAuctionSummary holds the totals of the auction list, the minimum qualified top-up being the lowest top-up per
+// node among the owners having at least one qualified node
+type StakingAuctionSummary struct {
+	NumOwners         int    `json:"numOwners"`
+	NumNodes          int    `json:"numNodes"`
+	NumQualifiedNodes int    `json:"numQualifiedNodes"`
+	MinQualifiedTopUp string `json:"minQualifiedTopUp"`
+}
+
+// StakingOverview aggregates the staking economics of the network. The values are given in the smallest unit of the
+// native token, the estimated APR being the yearly inflation rewards divided by the total staked value, before any
+// service fee. The queue size is missing if the staking contract does not expose it anymore
+type StakingOverview struct {
+	TotalStaked       string                 `json:"totalStaked"`
+	TotalBaseStaked   string                 `json:"totalBaseStaked"`
+	TotalTopUp        string                 `json:"totalTopUp"`
+	NumValidators     int                    `json:"numValidators"`
+	NumNodesPerStatus map[string]int         `json:"numNodesPerStatus"`
+	Auction           *StakingAuctionSummary `json:"auction"`
+	EstimatedAPR      float64                `json:"estimatedAPR"`
+	QueueSize         *uint64                `json:"queueSize,omitempty"`
+	Timestamp         int64                  `json:"timestamp"`
+}
@@ -13,7 +13,7 @@ type Transaction struct {
 	// This field is used to tag transactions for send-multiple route
 	Index             int    `json:"-"`
 	Nonce             uint64 `json:"nonce"`
-	Value             string `json:"value"`
+	Value             string `json:"value" binding:"omitempty,bigint"`
 	Receiver          string `json:"receiver"`
 	Sender            string `json:"sender"`
 	SenderUsername    []byte `json:"senderUsername,omitempty"`
@@ -21,14 +21,14 @@ type Transaction struct {
 	GasPrice          uint64 `json:"gasPrice"`
 	GasLimit          uint64 `json:"gasLimit"`
 	Data              []byte `json:"data,omitempty"`
-	Signature         string `json:"signature,omitempty"`
+	Signature         string `json:"signature,omitempty" binding:"hexstring"`
 	ChainID           string `json:"chainID"`
 	Version           uint32 `json:"version"`
 	Options           uint32 `json:"options,omitempty"`
 	GuardianAddr      string `json:"guardian,omitempty"`
-	GuardianSignature string `json:"guardianSignature,omitempty"`
+	GuardianSignature string `json:"guardianSignature,omitempty" binding:"hexstring"`
 	RelayerAddr       string `json:"relayer,omitempty"`
-	RelayerSignature  string `json:"relayerSignature,omitempty"`
+	RelayerSignature  string `json:"relayerSignature,omitempty" binding:"hexstring"`
 }
 
 // GetTransactionResponseData follows the format of the data field of get transaction response
@@ -113,6 +113,23 @@ type TransactionResponseData struct {
 	TxHash string `json:"txHash"`
 }
 
+const (
+	// TxHashSourceObserver signals that the hash of a sent transaction was returned by the observer
+	TxHashSourceObserver = "observer"
+	// TxHashSourceComputed signals that the hash of a sent transaction was computed by the proxy
+	TxHashSourceComputed = "computed"
+)
+
+// SentTransaction holds the hash of a sent transaction. AlreadySubmitted is set if an identical signed transaction was
+// already relayed during the deduplication window, in which case the transaction was not broadcast again. HashSource
+// tells whether the hash was returned by the observer or computed by the proxy, as some observer versions omit it
+type SentTransaction struct {
+	TxHash           string `json:"txHash"`
+	HashSource       string `json:"hashSource,omitempty"`
+	AlreadySubmitted bool   `json:"alreadySubmitted,omitempty"`
+	Warning          string `json:"warning,omitempty"`
+}
+
 // ResponseTransaction defines a response tx holding the resulting hash
 type ResponseTransaction struct {
 	Data  TransactionResponseData `json:"data"`
@@ -155,8 +172,18 @@ type ResponseTransactionSimulationCrossShard struct {
 
 // MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions
 type MultipleTransactionsResponseData struct {
-	NumOfTxs  uint64         `json:"txsSent"`
-	TxsHashes map[int]string `json:"txsHashes"`
+	NumOfTxs  uint64                   `json:"txsSent"`
+	TxsHashes map[int]string           `json:"txsHashes"`
+	Results   []*TransactionSendResult `json:"results,omitempty"`
+}
+
+// TransactionSendResult holds the outcome of sending one of the transactions of a batch, identified by its index
+type TransactionSendResult struct {
+	Index    int     `json:"index"`
+	Hash     string  `json:"hash,omitempty"`
+	Error    string  `json:"error,omitempty"`
+	ShardID  *uint32 `json:"shardID,omitempty"`
+	Observer string  `json:"observer,omitempty"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -216,9 +243,41 @@ type TransactionsPool struct {
 	Rewards              []WrappedTransaction `json:"rewards"`
 }
 
-// TransactionsPoolResponseData matches the data field of get tx pool response
+// TransactionsPoolChunk holds a chunk of a shard's transactions pool, the regular transactions, smart contract results
+// and rewards being counted in this order. NextFrom is the index of the chunk to be requested next, if HasMore is set
+type TransactionsPoolChunk struct {
+	ShardID  uint32           `json:"shardId"`
+	From     uint32           `json:"from"`
+	TxPool   TransactionsPool `json:"txPool"`
+	NextFrom uint32           `json:"nextFrom"`
+	HasMore  bool             `json:"hasMore"`
+}
+
+// AgedPoolTransaction holds a transaction which is pending in the pool for longer than the requested duration
+type AgedPoolTransaction struct {
+	Hash       string `json:"hash"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Nonce      uint64 `json:"nonce"`
+	Type       string `json:"type"`
+	Shard      uint32 `json:"shard"`
+	ReceivedAt int64  `json:"receivedAt"`
+	AgeSeconds uint64 `json:"ageSeconds"`
+}
+
+// AgedTransactionsPool holds the long pending transactions from the pools of all shards, from the oldest to the newest
+type AgedTransactionsPool struct {
+	OlderThanSeconds       uint64                 `json:"olderThanSeconds"`
+	Transactions           []*AgedPoolTransaction `json:"transactions"`
+	TransactionsWithoutAge uint64                 `json:"transactionsWithoutAge"`
+	UnavailableShards      []uint32               `json:"unavailableShards"`
+}
+
+// TransactionsPoolResponseData matches the data field of get tx pool response. Paginated is set by the observers which
+// applied the from and size parameters
 type TransactionsPoolResponseData struct {
 	Transactions TransactionsPool `json:"txPool"`
+	Paginated    bool             `json:"paginated,omitempty"`
 }
 
 // TransactionsPoolApiResponse matches the output of an observer's tx pool endpoint
@@ -233,6 +292,18 @@ type TransactionsPoolForSender struct {
 	Transactions []WrappedTransaction `json:"transactions"`
 }
 
+// TransactionsPoolBySendersRequest holds the senders whose transactions from pool are requested, along with the
+// fields to be returned for each transaction
+type TransactionsPoolBySendersRequest struct {
+	Senders []string `json:"senders"`
+	Fields  string   `json:"fields"`
+}
+
+// TransactionsPoolForSenders holds the transactions from pool of multiple senders, indexed by sender
+type TransactionsPoolForSenders struct {
+	Senders map[string]*TransactionsPoolForSender `json:"senders"`
+}
+
 // TransactionsPoolForSenderResponseData matches the data field of get tx pool for sender response
 type TransactionsPoolForSenderResponseData struct {
 	TxPool TransactionsPoolForSender `json:"txPool"`
@@ -287,3 +358,150 @@ type ProcessStatusResponse struct {
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 }
+
+// TransactionOutcome represents the parsed outcome of a smart contract call
+type TransactionOutcome struct {
+	ReturnCode    string   `json:"returnCode"`
+	ReturnMessage string   `json:"returnMessage"`
+	ReturnData    [][]byte `json:"returnData"`
+}
+
+// ContractAddressRequest holds the details needed for computing the address of a contract to be deployed
+type ContractAddressRequest struct {
+	Deployer string `json:"deployer"`
+	Nonce    uint64 `json:"nonce"`
+}
+
+// ContractAddress holds the address of a contract to be deployed and the shard it will reside in
+type ContractAddress struct {
+	Address string `json:"address"`
+	ShardID uint32 `json:"shardId"`
+}
+
+// DecodeDataFieldRequest holds the transaction data field to be decoded
+type DecodeDataFieldRequest struct {
+	Data string `json:"data"`
+}
+
+// DecodedDataField holds the function called by a transaction data field and its decoded arguments
+type DecodedDataField struct {
+	Function  string                 `json:"function"`
+	Arguments []*DecodedDataArgument `json:"arguments"`
+}
+
+// DecodedDataArgument holds a hex encoded argument of a transaction data field along with its best-effort
+// interpretations as a number, an address and a text
+type DecodedDataArgument struct {
+	Hex     string `json:"hex"`
+	Decimal string `json:"decimal"`
+	Address string `json:"address,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// StuckTransactions holds the transactions of a sender that are blocked in the pool by missing nonces
+type StuckTransactions struct {
+	Address       string             `json:"address"`
+	AccountNonce  uint64             `json:"accountNonce"`
+	Gaps          []NonceGap         `json:"gaps"`
+	MissingNonces []uint64           `json:"missingNonces"`
+	Transactions  []StuckTransaction `json:"transactions"`
+}
+
+// StuckTransaction holds the details of a transaction blocked in the pool by a missing nonce
+type StuckTransaction struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+}
+
+// TransactionValidationProblem holds a problem found while statically validating a transaction
+type TransactionValidationProblem struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// TransactionValidationResult holds the outcome of the static validation of a transaction
+type TransactionValidationResult struct {
+	Valid    bool                           `json:"valid"`
+	Problems []TransactionValidationProblem `json:"problems"`
+}
+
+// TransactionFee holds the fee of a transaction, computed locally from the network economics parameters
+type TransactionFee struct {
+	Fee                string `json:"fee"`
+	FeeDenominated     string `json:"feeDenominated"`
+	MoveBalanceGas     uint64 `json:"moveBalanceGas"`
+	ProcessingGas      uint64 `json:"processingGas"`
+	ProcessingGasPrice uint64 `json:"processingGasPrice"`
+}
+
+// ESDTTransferBuildRequest holds the details needed for building a transaction which transfers one or more tokens
+type ESDTTransferBuildRequest struct {
+	Sender    string          `json:"sender"`
+	Receiver  string          `json:"receiver"`
+	Nonce     uint64          `json:"nonce"`
+	GasPrice  uint64          `json:"gasPrice,omitempty"`
+	Transfers []*ESDTTransfer `json:"transfers" binding:"dive"`
+}
+
+// ESDTTransfer holds a token transfer. The nonce is 0 for fungible tokens
+type ESDTTransfer struct {
+	Token  string `json:"token" binding:"required"`
+	Nonce  uint64 `json:"nonce"`
+	Amount string `json:"amount" binding:"required,bigint"`
+}
+
+// ESDTTransferBuildResult holds the unsigned transaction built for an ESDT transfer request, along with its readable
+// data field
+type ESDTTransferBuildResult struct {
+	Transaction *Transaction `json:"transaction"`
+	Data        string       `json:"data"`
+}
+
+// TransactionOperation holds the built-in function call decoded from the data field of a transaction
+type TransactionOperation struct {
+	Function        string                       `json:"function"`
+	Receiver        string                       `json:"receiver,omitempty"`
+	Tokens          []*TransactionOperationToken `json:"tokens,omitempty"`
+	Guardian        string                       `json:"guardian,omitempty"`
+	ServiceID       string                       `json:"serviceId,omitempty"`
+	NewOwner        string                       `json:"newOwner,omitempty"`
+	CalledFunction  string                       `json:"calledFunction,omitempty"`
+	CalledArguments []string                     `json:"calledArguments,omitempty"`
+}
+
+// TransactionOperationToken holds a token moved, minted or burnt by a built-in function call
+type TransactionOperationToken struct {
+	Identifier string `json:"identifier"`
+	Collection string `json:"collection,omitempty"`
+	Nonce      uint64 `json:"nonce,omitempty"`
+	Amount     string `json:"amount"`
+}
+
+// TransactionDecodedEvent holds the readable fields decoded from the topics of a well-known event logged by a transaction
+type TransactionDecodedEvent struct {
+	Identifier      string                       `json:"identifier"`
+	Address         string                       `json:"address"`
+	Sender          string                       `json:"sender,omitempty"`
+	Receiver        string                       `json:"receiver,omitempty"`
+	Tokens          []*TransactionOperationToken `json:"tokens,omitempty"`
+	ContractAddress string                       `json:"contractAddress,omitempty"`
+	Deployer        string                       `json:"deployer,omitempty"`
+	Message         string                       `json:"message,omitempty"`
+	Event           string                       `json:"event,omitempty"`
+	Fields          map[string]interface{}       `json:"fields,omitempty"`
+}
+
+// ReceiverCheck holds the result of checking whether a transfer would be accepted by its receiver, according to the
+// code metadata of the receiving contract
+type ReceiverCheck struct {
+	Receiver                 string `json:"receiver"`
+	IsContract               bool   `json:"isContract"`
+	IsDeployed               bool   `json:"isDeployed"`
+	IsPayable                bool   `json:"isPayable"`
+	IsPayableBySmartContract bool   `json:"isPayableBySmartContract"`
+	TransfersEGLD            bool   `json:"transfersEGLD"`
+	TransfersTokens          bool   `json:"transfersTokens"`
+	CalledFunction           string `json:"calledFunction,omitempty"`
+	WillBeRejected           bool   `json:"willBeRejected"`
+	Reason                   string `json:"reason,omitempty"`
+}
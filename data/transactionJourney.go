@@ -0,0 +1,27 @@
+package data
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// TransactionJourney holds a transaction as seen by both its source and destination shards, with the smart contract
+// results and the logs merged, along with the timeline of its processing
+type TransactionJourney struct {
+	Transaction  *transaction.ApiTransactionResult `json:"transaction"`
+	IsCrossShard bool                              `json:"isCrossShard"`
+	Timeline     []*TransactionJourneyStep         `json:"timeline"`
+}
+
+// TransactionJourneyStep holds the block which included the transaction in a shard, or one of its smart contract results
+type TransactionJourneyStep struct {
+	Stage                string `json:"stage"`
+	Hash                 string `json:"hash"`
+	ShardID              uint32 `json:"shardId"`
+	Sender               string `json:"sender,omitempty"`
+	Receiver             string `json:"receiver,omitempty"`
+	BlockNonce           uint64 `json:"blockNonce,omitempty"`
+	BlockHash            string `json:"blockHash,omitempty"`
+	MiniBlockHash        string `json:"miniblockHash,omitempty"`
+	NotarizedInMetaNonce uint64 `json:"notarizedInMetaNonce,omitempty"`
+	NotarizedInMetaHash  string `json:"notarizedInMetaHash,omitempty"`
+	Timestamp            int64  `json:"timestamp,omitempty"`
+	Pending              bool   `json:"pending"`
+}
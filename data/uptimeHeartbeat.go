@@ -0,0 +1,15 @@
+package data
+
+// UptimeHeartbeat holds the health summary of the proxy periodically reported to an external uptime registry
+type UptimeHeartbeat struct {
+	PublicURL         string   `json:"publicUrl"`
+	Version           string   `json:"version"`
+	Timestamp         int64    `json:"timestamp"`
+	Healthy           bool     `json:"healthy"`
+	HealthyShards     []uint32 `json:"healthyShards"`
+	UnhealthyShards   []uint32 `json:"unhealthyShards"`
+	NumRequests       uint64   `json:"numRequests"`
+	NumErrors         uint64   `json:"numErrors"`
+	RequestsPerSecond float64  `json:"requestsPerSecond"`
+	ErrorsPerSecond   float64  `json:"errorsPerSecond"`
+}
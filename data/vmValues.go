@@ -40,4 +40,14 @@ type SCQuery struct {
 	Arguments      [][]byte
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	// HyperblockNonce is used by the multi-contract queries, so that each shard is queried at the block notarized in
+	// the provided hyperblock
+	HyperblockNonce core.OptionalUint64
+}
+
+// SCQueryResult holds the outcome of a smart contract query executed as part of a multi-contract query
+type SCQueryResult struct {
+	Data      *vm.VMOutputApi `json:"data,omitempty"`
+	BlockInfo BlockInfo       `json:"blockInfo"`
+	Error     string          `json:"error,omitempty"`
 }
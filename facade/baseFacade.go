@@ -1,8 +1,10 @@
 package facade
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -41,8 +43,23 @@ type ProxyFacade struct {
 	esdtSuppliesProc ESDTSupplyProcessor
 	statusProc       StatusProcessor
 
-	pubKeyConverter core.PubkeyConverter
-	aboutInfoProc   AboutInfoProcessor
+	pubKeyConverter       core.PubkeyConverter
+	aboutInfoProc         AboutInfoProcessor
+	gasPriceProc          GasPriceProcessor
+	sovereignProc         SovereignProcessor
+	networkStatusStreamer NetworkStatusStreamer
+	nodePassthroughProc   NodePassthroughProcessor
+	collectionsProc       CollectionsProcessor
+	dataFreshnessProc     DataFreshnessProcessor
+	blocksExporter        BlocksExporter
+	tokenPriceProc        TokenPriceProcessor
+	probesProc            ProbesProcessor
+	finalityProc          FinalityProcessor
+	validatorKeysProc     ValidatorKeysProcessor
+	faucetRequestsQueue   FaucetRequestsQueue
+	stakingOverviewProc   StakingOverviewProcessor
+	delegationProc        DelegationProcessor
+	clientsUsageProvider  ClientsUsageProvider
 }
 
 // NewProxyFacade creates a new ProxyFacade instance
@@ -62,6 +79,21 @@ func NewProxyFacade(
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
 	aboutInfoProc AboutInfoProcessor,
+	gasPriceProc GasPriceProcessor,
+	sovereignProc SovereignProcessor,
+	networkStatusStreamer NetworkStatusStreamer,
+	nodePassthroughProc NodePassthroughProcessor,
+	collectionsProc CollectionsProcessor,
+	dataFreshnessProc DataFreshnessProcessor,
+	blocksExporter BlocksExporter,
+	tokenPriceProc TokenPriceProcessor,
+	probesProc ProbesProcessor,
+	finalityProc FinalityProcessor,
+	validatorKeysProc ValidatorKeysProcessor,
+	faucetRequestsQueue FaucetRequestsQueue,
+	stakingOverviewProc StakingOverviewProcessor,
+	delegationProc DelegationProcessor,
+	clientsUsageProvider ClientsUsageProvider,
 ) (*ProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -106,53 +138,158 @@ func NewProxyFacade(
 		return nil, ErrNilAboutInfoProcessor
 	}
 
+	if gasPriceProc == nil {
+		return nil, ErrNilGasPriceProcessor
+	}
+	if sovereignProc == nil {
+		return nil, ErrNilSovereignProcessor
+	}
+	if networkStatusStreamer == nil {
+		return nil, ErrNilNetworkStatusStreamer
+	}
+	if nodePassthroughProc == nil {
+		return nil, ErrNilNodePassthroughProcessor
+	}
+	if collectionsProc == nil {
+		return nil, ErrNilCollectionsProcessor
+	}
+	if dataFreshnessProc == nil {
+		return nil, ErrNilDataFreshnessProcessor
+	}
+	if blocksExporter == nil {
+		return nil, ErrNilBlocksExporter
+	}
+	if tokenPriceProc == nil {
+		return nil, ErrNilTokenPriceProcessor
+	}
+	if probesProc == nil {
+		return nil, ErrNilProbesProcessor
+	}
+	if finalityProc == nil {
+		return nil, ErrNilFinalityProcessor
+	}
+	if validatorKeysProc == nil {
+		return nil, ErrNilValidatorKeysProcessor
+	}
+	if faucetRequestsQueue == nil {
+		return nil, ErrNilFaucetRequestsQueue
+	}
+	if stakingOverviewProc == nil {
+		return nil, ErrNilStakingOverviewProcessor
+	}
+	if delegationProc == nil {
+		return nil, ErrNilDelegationProcessor
+	}
+	if clientsUsageProvider == nil {
+		return nil, ErrNilClientsUsageProvider
+	}
 	return &ProxyFacade{
-		actionsProc:      actionsProc,
-		accountProc:      accountProc,
-		txProc:           txProc,
-		scQueryService:   scQueryService,
-		nodeGroupProc:    nodeGroupProc,
-		valStatsProc:     valStatsProc,
-		faucetProc:       faucetProc,
-		nodeStatusProc:   nodeStatusProc,
-		blockProc:        blockProc,
-		blocksProc:       blocksProc,
-		proofProc:        proofProc,
-		pubKeyConverter:  pubKeyConverter,
-		esdtSuppliesProc: esdtSuppliesProc,
-		statusProc:       statusProc,
-		aboutInfoProc:    aboutInfoProc,
+		actionsProc:           actionsProc,
+		accountProc:           accountProc,
+		txProc:                txProc,
+		scQueryService:        scQueryService,
+		nodeGroupProc:         nodeGroupProc,
+		valStatsProc:          valStatsProc,
+		faucetProc:            faucetProc,
+		nodeStatusProc:        nodeStatusProc,
+		blockProc:             blockProc,
+		blocksProc:            blocksProc,
+		proofProc:             proofProc,
+		pubKeyConverter:       pubKeyConverter,
+		esdtSuppliesProc:      esdtSuppliesProc,
+		statusProc:            statusProc,
+		aboutInfoProc:         aboutInfoProc,
+		gasPriceProc:          gasPriceProc,
+		sovereignProc:         sovereignProc,
+		networkStatusStreamer: networkStatusStreamer,
+		nodePassthroughProc:   nodePassthroughProc,
+		collectionsProc:       collectionsProc,
+		dataFreshnessProc:     dataFreshnessProc,
+		blocksExporter:        blocksExporter,
+		tokenPriceProc:        tokenPriceProc,
+		probesProc:            probesProc,
+		finalityProc:          finalityProc,
+		validatorKeysProc:     validatorKeysProc,
+		faucetRequestsQueue:   faucetRequestsQueue,
+		stakingOverviewProc:   stakingOverviewProc,
+		delegationProc:        delegationProc,
+		clientsUsageProvider:  clientsUsageProvider,
 	}, nil
 }
 
 // GetAccount returns an account based on the input address
-func (pf *ProxyFacade) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return pf.accountProc.GetAccount(address, options)
+func (pf *ProxyFacade) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return pf.accountProc.GetAccount(ctx, address, options)
+}
+
+// GetStuckTransactions returns the transactions of the given address blocked in the pool by missing nonces
+func (pf *ProxyFacade) GetStuckTransactions(ctx context.Context, address string) (*data.StuckTransactions, error) {
+	account, err := pf.accountProc.GetAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.GetStuckTransactionsForSender(ctx, address, account.Account.Nonce)
+}
+
+// GetCollectionsForAddress returns a page of the collections registered by the given address or on which it has roles
+func (pf *ProxyFacade) GetCollectionsForAddress(ctx context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error) {
+	return pf.collectionsProc.GetCollectionsForAddress(ctx, address, options)
+}
+
+// GetTokensIssuedByAddress returns the tokens and collections owned by the given address
+func (pf *ProxyFacade) GetTokensIssuedByAddress(ctx context.Context, address string) ([]*data.IssuedToken, error) {
+	return pf.collectionsProc.GetTokensIssuedByAddress(ctx, address)
+}
+
+// GetCollection returns the properties, the roles and the number of issued NFTs of the given collection
+func (pf *ProxyFacade) GetCollection(ctx context.Context, collection string) (*data.Collection, error) {
+	return pf.collectionsProc.GetCollection(ctx, collection)
 }
 
 // GetCodeHash returns the code hash for the given address
-func (pf *ProxyFacade) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetCodeHash(address, options)
+func (pf *ProxyFacade) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetCodeHash(ctx, address, options)
+}
+
+// GetAccountsNonces returns the current nonces of the provided addresses
+func (pf *ProxyFacade) GetAccountsNonces(ctx context.Context, addresses []string) (*data.AccountsNonces, error) {
+	return pf.accountProc.GetAccountsNonces(ctx, addresses)
+}
+
+// GetKeyValuePairsDiff returns the keys of the given address which were added, changed or removed between two blocks
+func (pf *ProxyFacade) GetKeyValuePairsDiff(ctx context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+	return pf.accountProc.GetKeyValuePairsDiff(ctx, address, options)
 }
 
 // GetKeyValuePairs returns the key-value pairs for the given address
-func (pf *ProxyFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetKeyValuePairs(address, options)
+func (pf *ProxyFacade) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetKeyValuePairs(ctx, address, options)
 }
 
 // GetAccounts returns data about the provided addresses
-func (pf *ProxyFacade) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
-	return pf.accountProc.GetAccounts(addresses, options)
+func (pf *ProxyFacade) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	return pf.accountProc.GetAccounts(ctx, addresses, options)
+}
+
+// VerifyMessageSignature checks if the provided message was signed by the provided address
+func (pf *ProxyFacade) VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+	return pf.accountProc.VerifyMessageSignature(request)
+}
+
+// GetAddressActivitySummary returns the number of transactions sent and received by the address and its first and last activity
+func (pf *ProxyFacade) GetAddressActivitySummary(ctx context.Context, address string) (*data.AddressActivitySummary, error) {
+	return pf.accountProc.GetAddressActivitySummary(ctx, address)
 }
 
 // GetValueForKey returns the value for the given address and key
-func (pf *ProxyFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	return pf.accountProc.GetValueForKey(address, key, options)
+func (pf *ProxyFacade) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
+	return pf.accountProc.GetValueForKey(ctx, address, key, options)
 }
 
 // GetGuardianData returns the guardian data for the given address
-func (pf *ProxyFacade) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetGuardianData(address, options)
+func (pf *ProxyFacade) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetGuardianData(ctx, address, options)
 }
 
 // GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
@@ -161,68 +298,140 @@ func (pf *ProxyFacade) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTTokenData(address, key, options)
+func (pf *ProxyFacade) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTTokenData(ctx, address, key, options)
 }
 
 // GetESDTNftTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTNftTokenData(address, key, nonce, options)
+func (pf *ProxyFacade) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTNftTokenData(ctx, address, key, nonce, options)
 }
 
 // GetESDTsWithRole returns the tokens where the given address has the assigned role
-func (pf *ProxyFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsWithRole(address, role, options)
+func (pf *ProxyFacade) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTsWithRole(ctx, address, role, options)
 }
 
 // GetESDTsRoles returns the tokens and roles for the given address
-func (pf *ProxyFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsRoles(address, options)
+func (pf *ProxyFacade) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetESDTsRoles(ctx, address, options)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (pf *ProxyFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetNFTTokenIDsRegisteredByAddress(address, options)
+func (pf *ProxyFacade) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetNFTTokenIDsRegisteredByAddress(ctx, address, options)
 }
 
 // GetAllESDTTokens returns all the ESDT tokens for a given address
-func (pf *ProxyFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetAllESDTTokens(address, options)
+func (pf *ProxyFacade) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.GetAllESDTTokens(ctx, address, options)
 }
 
 // SendTransaction should send the transaction to the correct observer
-func (pf *ProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return pf.txProc.SendTransaction(tx)
+func (pf *ProxyFacade) SendTransaction(ctx context.Context, tx *data.Transaction) (int, *data.SentTransaction, error) {
+	return pf.txProc.SendTransaction(ctx, tx)
 }
 
 // SendMultipleTransactions should send the transactions to the correct observers
-func (pf *ProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return pf.txProc.SendMultipleTransactions(txs)
+func (pf *ProxyFacade) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	return pf.txProc.SendMultipleTransactions(ctx, txs)
 }
 
 // SimulateTransaction should send the transaction to the correct observer for simulation
-func (pf *ProxyFacade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-	return pf.txProc.SimulateTransaction(tx, checkSignature)
+func (pf *ProxyFacade) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+	return pf.txProc.SimulateTransaction(ctx, tx, checkSignature)
+}
+
+// ValidateTransaction performs the static validation of a transaction against the current network configuration
+func (pf *ProxyFacade) ValidateTransaction(ctx context.Context, tx *data.Transaction) (*data.TransactionValidationResult, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.ValidateTransaction(tx, networkCfg), nil
+}
+
+// ComputeTransactionFee computes the fee of a transaction from the cached network economics parameters
+func (pf *ProxyFacade) ComputeTransactionFee(ctx context.Context, tx *data.Transaction) (*data.TransactionFee, error) {
+	networkCfg, err := pf.nodeStatusProc.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.ComputeTransactionFee(tx, networkCfg)
+}
+
+// BuildESDTTransfer builds the unsigned transaction which transfers the requested tokens, using the cached network
+// economics parameters
+func (pf *ProxyFacade) BuildESDTTransfer(ctx context.Context, request *data.ESDTTransferBuildRequest) (*data.ESDTTransferBuildResult, error) {
+	networkCfg, err := pf.nodeStatusProc.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.BuildESDTTransfer(request, networkCfg)
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (pf *ProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
-	return pf.txProc.TransactionCostRequest(tx)
+func (pf *ProxyFacade) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	return pf.txProc.TransactionCostRequest(ctx, tx)
 }
 
 // GetTransactionStatus should return transaction status
-func (pf *ProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return pf.txProc.GetTransactionStatus(txHash, sender)
+func (pf *ProxyFacade) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	return pf.txProc.GetTransactionStatus(ctx, txHash, sender)
 }
 
 // GetProcessedTransactionStatus should return transaction status after internal processing of the transaction results
-func (pf *ProxyFacade) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
-	return pf.txProc.GetProcessedTransactionStatus(txHash)
+func (pf *ProxyFacade) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	return pf.txProc.GetProcessedTransactionStatus(ctx, txHash)
+}
+
+// ComputeContractAddress should return the address of the contract to be deployed by the deployer with the given nonce
+func (pf *ProxyFacade) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	return pf.txProc.ComputeContractAddress(deployer, nonce)
+}
+
+// DecodeDataField should return the function called by the given transaction data field and its decoded arguments
+func (pf *ProxyFacade) DecodeDataField(dataField string) (*data.DecodedDataField, error) {
+	return pf.txProc.DecodeDataField(dataField)
+}
+
+// CheckTransferReceiver should return whether the transfer would be rejected by a non-payable receiving contract
+func (pf *ProxyFacade) CheckTransferReceiver(ctx context.Context, tx *data.Transaction) (*data.ReceiverCheck, error) {
+	return pf.txProc.CheckTransferReceiver(ctx, tx)
+}
+
+// GetTransactionOutcome should return the parsed outcome of a smart contract call transaction
+func (pf *ProxyFacade) GetTransactionOutcome(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+	return pf.txProc.GetTransactionOutcome(ctx, txHash)
+}
+
+// GetTransactionJourney should return the transaction as seen by its source and destination shards, along with the
+// timeline of its processing
+func (pf *ProxyFacade) GetTransactionJourney(ctx context.Context, txHash string) (*data.TransactionJourney, error) {
+	return pf.txProc.GetTransactionJourney(ctx, txHash)
 }
 
 // GetTransaction should return a transaction by hash
-func (pf *ProxyFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	return pf.txProc.GetTransaction(txHash, withResults)
+func (pf *ProxyFacade) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return pf.txProc.GetTransaction(ctx, txHash, withResults)
+}
+
+// DecodeTransactionOperation returns the decoded built-in function call of the transaction, if any
+func (pf *ProxyFacade) DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation {
+	return pf.txProc.DecodeTransactionOperation(tx)
+}
+
+// VerifyTransactionHash re-computes the hash of the transaction from its fields and compares it with the returned one
+func (pf *ProxyFacade) VerifyTransactionHash(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	return pf.txProc.VerifyTransactionHash(ctx, tx)
+}
+
+// DecodeTransactionEvents returns the decoded well-known events logged by the transaction and its results
+func (pf *ProxyFacade) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent {
+	return pf.txProc.DecodeTransactionEvents(tx)
 }
 
 // ReloadObservers will try to reload the observers
@@ -235,9 +444,107 @@ func (pf *ProxyFacade) ReloadFullHistoryObservers() data.NodesReloadResponse {
 	return pf.actionsProc.ReloadFullHistoryObservers()
 }
 
+// AddObserver will try to add the provided observer at runtime
+func (pf *ProxyFacade) AddObserver(node *data.NodeData) error {
+	return pf.actionsProc.AddObserver(node)
+}
+
+// RemoveObserver will try to remove the observer with the provided address at runtime
+func (pf *ProxyFacade) RemoveObserver(address string) error {
+	return pf.actionsProc.RemoveObserver(address)
+}
+
+// StartBlocksExport starts the export of the requested range of blocks to a file
+func (pf *ProxyFacade) StartBlocksExport(ctx context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+	return pf.blocksExporter.StartExport(ctx, request)
+}
+
+// GetBlocksExportJob returns the status of a blocks export job
+func (pf *ProxyFacade) GetBlocksExportJob(jobID string) (*data.BlocksExportJob, error) {
+	return pf.blocksExporter.GetExportJob(jobID)
+}
+
+// GetAddressLatestTransactions returns the most recent transactions sent or received by the address
+func (pf *ProxyFacade) GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error) {
+	return pf.accountProc.GetAddressLatestTransactions(address, size)
+}
+
+// GetTokenHolders returns a page of the addresses holding the token along with their balances
+func (pf *ProxyFacade) GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+	return pf.accountProc.GetTokenHolders(token, options)
+}
+
+// GetTokenPrice returns the USD price of the token
+func (pf *ProxyFacade) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	return pf.tokenPriceProc.GetTokenPrice(token)
+}
+
+// IsTokenPriceEnabled returns true if a token price provider is configured
+func (pf *ProxyFacade) IsTokenPriceEnabled() bool {
+	return pf.tokenPriceProc.IsEnabled()
+}
+
+// GetUsdValue returns the USD value of the amount of token, given in its smallest denomination
+func (pf *ProxyFacade) GetUsdValue(ctx context.Context, token string, amount string) (float64, error) {
+	return pf.tokenPriceProc.GetUsdValue(ctx, token, amount)
+}
+
+// GetLiveness returns the liveness of the proxy
+func (pf *ProxyFacade) GetLiveness() *data.ProbeStatus {
+	return pf.probesProc.GetLiveness()
+}
+
+// GetReadiness returns the readiness of the proxy to serve requests
+func (pf *ProxyFacade) GetReadiness() *data.ProbeStatus {
+	return pf.probesProc.GetReadiness()
+}
+
+// GetFinalTransactionStatus returns the status of a transaction, reporting the executed transactions as pending until
+// the blocks which included them are final
+func (pf *ProxyFacade) GetFinalTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	var tx *transaction.ApiTransactionResult
+	var err error
+	if len(sender) > 0 {
+		tx, _, err = pf.txProc.GetTransactionByHashAndSenderAddress(ctx, txHash, sender, false)
+	} else {
+		tx, err = pf.txProc.GetTransaction(ctx, txHash, false)
+	}
+	if err != nil {
+		return string(data.TxStatusUnknown), err
+	}
+	if tx.Status == transaction.TxStatusPending {
+		return string(tx.Status), nil
+	}
+
+	isFinal, err := pf.finalityProc.IsTransactionFinal(ctx, tx)
+	if err != nil {
+		return string(data.TxStatusUnknown), err
+	}
+	if !isFinal {
+		return string(transaction.TxStatusPending), nil
+	}
+
+	return string(tx.Status), nil
+}
+
 // GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
-func (pf *ProxyFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
-	return pf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+func (pf *ProxyFacade) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+	return pf.txProc.GetTransactionByHashAndSenderAddress(ctx, txHash, sndAddr, withEvents)
+}
+
+// SetReadOnlyMode enables or disables the read-only mode, in which the transactions cannot be sent through the proxy
+func (pf *ProxyFacade) SetReadOnlyMode(enabled bool) {
+	pf.txProc.SetReadOnlyMode(enabled)
+}
+
+// IsReadOnlyModeEnabled returns true if the proxy runs in read-only mode
+func (pf *ProxyFacade) IsReadOnlyModeEnabled() bool {
+	return pf.txProc.IsReadOnlyModeEnabled()
+}
+
+// GetClientUsage returns the usage of the proxy by the client with the provided API key during the provided interval
+func (pf *ProxyFacade) GetClientUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+	return pf.clientsUsageProvider.GetUsage(apiKey, from, to)
 }
 
 // IsFaucetEnabled returns true if the faucet mechanism is enabled or false otherwise
@@ -246,18 +553,18 @@ func (pf *ProxyFacade) IsFaucetEnabled() bool {
 }
 
 // SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
+func (pf *ProxyFacade) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	senderSk, senderPk, err := pf.faucetProc.SenderDetailsFromPem(receiver)
 	if err != nil {
 		return err
 	}
 
-	senderAccount, err := pf.accountProc.GetAccount(senderPk, common.AccountQueryOptions{})
+	senderAccount, err := pf.accountProc.GetAccount(ctx, senderPk, common.AccountQueryOptions{})
 	if err != nil {
 		return err
 	}
 
-	networkCfg, err := pf.getNetworkConfig()
+	networkCfg, err := pf.getNetworkConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -274,12 +581,27 @@ func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
 		return err
 	}
 
-	_, _, err = pf.txProc.SendTransaction(tx)
+	_, _, err = pf.txProc.SendTransaction(ctx, tx)
 	return err
 }
 
-func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
-	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics()
+// IsFaucetQueueEnabled returns true if the faucet requests are queued instead of being sent synchronously
+func (pf *ProxyFacade) IsFaucetQueueEnabled() bool {
+	return pf.faucetRequestsQueue.IsEnabled()
+}
+
+// EnqueueUserFunds queues a request for loading one user's account with extra funds and returns its initial status
+func (pf *ProxyFacade) EnqueueUserFunds(receiver string, value *big.Int) (*data.FaucetRequest, error) {
+	return pf.faucetRequestsQueue.Enqueue(receiver, value)
+}
+
+// GetFaucetRequest returns the status of a queued faucet request
+func (pf *ProxyFacade) GetFaucetRequest(id string) (*data.FaucetRequest, error) {
+	return pf.faucetRequestsQueue.GetRequest(id)
+}
+
+func (pf *ProxyFacade) getNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -296,28 +618,97 @@ func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (pf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return pf.scQueryService.ExecuteQuery(query)
+func (pf *ProxyFacade) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return pf.scQueryService.ExecuteQuery(ctx, query)
+}
+
+// ExecuteSCMultiContractQuery executes the same query against all the provided contracts
+func (pf *ProxyFacade) ExecuteSCMultiContractQuery(ctx context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+	return pf.scQueryService.ExecuteMultiContractQuery(ctx, query, scAddresses)
 }
 
 // GetHeartbeatData retrieves the heartbeat status from one observer
-func (pf *ProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return pf.nodeGroupProc.GetHeartbeatData()
+func (pf *ProxyFacade) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return pf.nodeGroupProc.GetHeartbeatData(ctx)
+}
+
+// GetGasPriceSuggestion returns the gas price suggestion based on the current transactions pool congestion
+func (pf *ProxyFacade) GetGasPriceSuggestion(ctx context.Context) (*data.GasPriceSuggestion, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.gasPriceProc.GetGasPriceSuggestion(ctx, networkCfg.Config.MinGasPrice)
+}
+
+// SubscribeToNetworkStatus returns a channel receiving the round, nonce and epoch updates of the provided shard
+func (pf *ProxyFacade) SubscribeToNetworkStatus(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+	return pf.networkStatusStreamer.Subscribe(shardID)
+}
+
+// ForwardToNode forwards the request of an allowed node endpoint to an observer of the given shard
+func (pf *ProxyFacade) ForwardToNode(ctx context.Context, shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error) {
+	return pf.nodePassthroughProc.ForwardRequest(ctx, shardID, method, endpoint, rawQuery, body)
+}
+
+// GetSovereignValidatorsInfo retrieves the sovereign chain's validator set for the provided epoch
+func (pf *ProxyFacade) GetSovereignValidatorsInfo(ctx context.Context, epoch uint32) (*data.SovereignValidatorsInfo, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.sovereignProc.GetValidatorsInfo(ctx, epoch, networkCfg.Config.ShardConsensusSize)
+}
+
+// GetSovereignChainParameters retrieves the sovereign chain specific settings
+func (pf *ProxyFacade) GetSovereignChainParameters(ctx context.Context) (*data.SovereignChainParameters, error) {
+	networkCfg, err := pf.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.sovereignProc.GetChainParameters(ctx, networkCfg)
+}
+
+// GetSovereignNotarizedMainChainHeaders retrieves the main chain headers notarized by a range of sovereign blocks
+func (pf *ProxyFacade) GetSovereignNotarizedMainChainHeaders(ctx context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+	return pf.sovereignProc.GetNotarizedMainChainHeaders(ctx, from, to)
 }
 
 // GetNetworkConfigMetrics retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkConfigMetrics()
+func (pf *ProxyFacade) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
+}
+
+// GetNetworkStatusSnapshot retrieves the status of all the shards as a single snapshot
+func (pf *ProxyFacade) GetNetworkStatusSnapshot(ctx context.Context) (*data.NetworkStatusSnapshot, error) {
+	return pf.nodeStatusProc.GetNetworkStatusSnapshot(ctx)
 }
 
 // GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (pf *ProxyFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
+func (pf *ProxyFacade) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	if pf.dataFreshnessProc.IsEnabled() {
+		return pf.dataFreshnessProc.GetNetworkStatusMetrics(ctx, shardID)
+	}
+
+	return pf.nodeStatusProc.GetNetworkStatusMetrics(ctx, shardID)
 }
 
 // GetESDTSupply retrieves the supply for the provided token
-func (pf *ProxyFacade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
-	return pf.esdtSuppliesProc.GetESDTSupply(token)
+func (pf *ProxyFacade) GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error) {
+	return pf.esdtSuppliesProc.GetESDTSupply(ctx, token)
+}
+
+// GetNativeTokenDenomination returns the number of decimals of the native token, as set in the network config
+func (pf *ProxyFacade) GetNativeTokenDenomination(ctx context.Context) (int, error) {
+	networkConfig, err := pf.nodeStatusProc.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return networkConfig.Config.Denomination, nil
 }
 
 // GetEconomicsDataMetrics retrieves the node's network metrics for a given shard
@@ -326,88 +717,103 @@ func (pf *ProxyFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, erro
 }
 
 // GetDelegatedInfo retrieves the node's network delegated info
-func (pf *ProxyFacade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDelegatedInfo()
+func (pf *ProxyFacade) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetDelegatedInfo(ctx)
 }
 
 // GetDirectStakedInfo retrieves the node's direct staked values
-func (pf *ProxyFacade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDirectStakedInfo()
+func (pf *ProxyFacade) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetDirectStakedInfo(ctx)
 }
 
 // GetAllIssuedESDTs retrieves all the issued ESDTs from the node
-func (pf *ProxyFacade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetAllIssuedESDTs(tokenType)
+func (pf *ProxyFacade) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetAllIssuedESDTs(ctx, tokenType)
 }
 
 // GetEnableEpochsMetrics retrieves the activation epochs
-func (pf *ProxyFacade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEnableEpochsMetrics()
+func (pf *ProxyFacade) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetEnableEpochsMetrics(ctx)
 }
 
 // GetRatingsConfig retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetRatingsConfig()
+func (pf *ProxyFacade) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetRatingsConfig(ctx)
 }
 
 // GetBlockByHash retrieves the block by hash for a given shard
-func (pf *ProxyFacade) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByHash(shardID, hash, options)
+func (pf *ProxyFacade) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByHash(ctx, shardID, hash, options)
+}
+
+// IsBlockFinal returns true if the block with the provided nonce is deep enough below the chain tip to be final
+func (pf *ProxyFacade) IsBlockFinal(ctx context.Context, shardID uint32, nonce uint64) (bool, error) {
+	return pf.finalityProc.IsBlockFinal(ctx, shardID, nonce)
 }
 
 // GetBlockByNonce retrieves the block by nonce for a given shard
-func (pf *ProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByNonce(shardID, nonce, options)
+func (pf *ProxyFacade) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByNonce(ctx, shardID, nonce, options)
 }
 
 // GetBlocksByRound retrieves the blocks for a given round
-func (pf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
-	return pf.blocksProc.GetBlocksByRound(round, options)
+func (pf *ProxyFacade) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return pf.blocksProc.GetBlocksByRound(ctx, round, options)
 }
 
 // GetInternalBlockByHash retrieves the internal block by hash for a given shard
-func (pf *ProxyFacade) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalBlockByHash(shardID, hash, format)
+func (pf *ProxyFacade) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalBlockByHash(ctx, shardID, hash, format)
 }
 
 // GetInternalBlockByNonce retrieves the internal block by nonce for a given shard
-func (pf *ProxyFacade) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalBlockByNonce(shardID, nonce, format)
+func (pf *ProxyFacade) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalBlockByNonce(ctx, shardID, nonce, format)
 }
 
 // GetInternalStartOfEpochMetaBlock retrieves the internal block by nonce for a given shard
-func (pf *ProxyFacade) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return pf.blockProc.GetInternalStartOfEpochMetaBlock(epoch, format)
+func (pf *ProxyFacade) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return pf.blockProc.GetInternalStartOfEpochMetaBlock(ctx, epoch, format)
 }
 
 // GetInternalMiniBlockByHash retrieves the internal miniblock by hash for a given shard
-func (pf *ProxyFacade) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
-	return pf.blockProc.GetInternalMiniBlockByHash(shardID, hash, epoch, format)
+func (pf *ProxyFacade) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	return pf.blockProc.GetInternalMiniBlockByHash(ctx, shardID, hash, epoch, format)
+}
+
+// GetBlocksByHashes retrieves concurrently the blocks of the requested shards and hashes
+func (pf *ProxyFacade) GetBlocksByHashes(ctx context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+	return pf.blockProc.GetBlocksByHashes(ctx, requests, options)
+}
+
+// GetEpochStartBlock retrieves the block which started the provided epoch in the provided shard
+func (pf *ProxyFacade) GetEpochStartBlock(ctx context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetEpochStartBlock(ctx, shardID, epoch, options)
+}
+
+// GetMiniBlockByHash retrieves the miniblock by hash for a given shard, optionally along with its transactions
+func (pf *ProxyFacade) GetMiniBlockByHash(ctx context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+	return pf.blockProc.GetMiniBlockByHash(ctx, shardID, hash, options)
 }
 
 // GetHyperBlockByHash retrieves the hyperblock by hash
-func (pf *ProxyFacade) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return pf.blockProc.GetHyperBlockByHash(hash, options)
+func (pf *ProxyFacade) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByHash(ctx, hash, options)
 }
 
 // GetHyperBlockByNonce retrieves the block by nonce
-func (pf *ProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return pf.blockProc.GetHyperBlockByNonce(nonce, options)
+func (pf *ProxyFacade) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByNonce(ctx, nonce, options)
 }
 
-// ValidatorStatistics will return the statistics from an observer
-func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
-	valStats, err := pf.valStatsProc.GetValidatorStatistics()
-	if err != nil {
-		return nil, err
-	}
-
-	return valStats.Statistics, nil
+// ValidatorStatistics will return the page of statistics matching the provided options
+func (pf *ProxyFacade) ValidatorStatistics(ctx context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+	return pf.valStatsProc.GetFilteredValidatorStatistics(ctx, options)
 }
 
 // AuctionList will return the auction list
-func (epf *ProxyFacade) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
-	auctionList, err := epf.valStatsProc.GetAuctionList()
+func (epf *ProxyFacade) AuctionList(ctx context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
+	auctionList, err := epf.valStatsProc.GetAuctionList(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -415,14 +821,34 @@ func (epf *ProxyFacade) AuctionList() ([]*data.AuctionListValidatorAPIResponse,
 	return auctionList.AuctionListValidators, nil
 }
 
+// GetStakingOverview returns the aggregated staking economics of the network
+func (pf *ProxyFacade) GetStakingOverview(ctx context.Context) (*data.StakingOverview, error) {
+	return pf.stakingOverviewProc.GetStakingOverview(ctx)
+}
+
+// GetDelegationProviders returns the decoded data of all the delegation contracts
+func (pf *ProxyFacade) GetDelegationProviders(ctx context.Context) ([]*data.DelegationProvider, error) {
+	return pf.delegationProc.GetDelegationProviders(ctx)
+}
+
+// GetDelegationProvider returns the decoded data of the provided delegation contract
+func (pf *ProxyFacade) GetDelegationProvider(ctx context.Context, address string) (*data.DelegationProvider, error) {
+	return pf.delegationProc.GetDelegationProvider(ctx, address)
+}
+
+// GetBLSKeyInfo returns the staking status, the owner and the reward address of the provided BLS key
+func (pf *ProxyFacade) GetBLSKeyInfo(ctx context.Context, blsKey string) (*data.BLSKeyInfo, error) {
+	return pf.validatorKeysProc.GetBLSKeyInfo(ctx, blsKey)
+}
+
 // GetAddressConverter returns the address converter
 func (pf *ProxyFacade) GetAddressConverter() (core.PubkeyConverter, error) {
 	return pf.pubKeyConverter, nil
 }
 
 // GetLatestFullySynchronizedHyperblockNonce returns the latest fully synchronized hyperblock nonce
-func (pf *ProxyFacade) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
-	return pf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+func (pf *ProxyFacade) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
+	return pf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(ctx)
 }
 
 // ComputeTransactionHash will compute hash of a given transaction
@@ -431,53 +857,73 @@ func (pf *ProxyFacade) ComputeTransactionHash(tx *data.Transaction) (string, err
 }
 
 // GetTransactionsPool returns all txs from pool
-func (pf *ProxyFacade) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPool(fields)
+func (pf *ProxyFacade) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
+	return pf.txProc.GetTransactionsPool(ctx, fields)
 }
 
 // GetTransactionsPoolForShard returns all txs from shard's pool
-func (pf *ProxyFacade) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPoolForShard(shardID, fields)
+func (pf *ProxyFacade) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
+	return pf.txProc.GetTransactionsPoolForShard(ctx, shardID, fields)
 }
 
 // GetTransactionsPoolForSender returns tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	return pf.txProc.GetTransactionsPoolForSender(sender, fields)
+func (pf *ProxyFacade) GetTransactionsPoolForSender(ctx context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
+	return pf.txProc.GetTransactionsPoolForSender(ctx, sender, fields, allShards)
+}
+
+// GetTransactionsPoolForSenders returns tx pool for each of the provided senders
+func (pf *ProxyFacade) GetTransactionsPoolForSenders(ctx context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+	return pf.txProc.GetTransactionsPoolForSenders(ctx, senders, fields)
+}
+
+// GetAgedTransactionsPool returns the transactions pending in the pools of all shards for at least the given duration
+func (pf *ProxyFacade) GetAgedTransactionsPool(ctx context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+	return pf.txProc.GetAgedTransactionsPool(ctx, olderThanSeconds)
+}
+
+// GetTransactionsPoolChunk returns a chunk of the shard's pool, starting with the provided index
+func (pf *ProxyFacade) GetTransactionsPoolChunk(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+	return pf.txProc.GetTransactionsPoolChunk(ctx, shardID, fields, from, size)
+}
+
+// StreamTransactionsPool passes the pools of the shards to the handler, chunk by chunk
+func (pf *ProxyFacade) StreamTransactionsPool(ctx context.Context, shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error {
+	return pf.txProc.StreamTransactionsPool(ctx, shardID, fields, chunkSize, handler)
 }
 
 // GetLastPoolNonceForSender returns last nonce from tx pool for sender
-func (pf *ProxyFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return pf.txProc.GetLastPoolNonceForSender(sender)
+func (pf *ProxyFacade) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return pf.txProc.GetLastPoolNonceForSender(ctx, sender)
 }
 
 // IsOldStorageForToken returns true is the storage for a given token is old
-func (pf *ProxyFacade) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
-	return pf.nodeGroupProc.IsOldStorageForToken(tokenID, nonce)
+func (pf *ProxyFacade) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
+	return pf.nodeGroupProc.IsOldStorageForToken(ctx, tokenID, nonce)
 }
 
 // GetTransactionsPoolNonceGapsForSender returns all nonce gaps from tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return pf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
+func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return pf.txProc.GetTransactionsPoolNonceGapsForSender(ctx, sender)
 }
 
 // GetProof returns the Merkle proof for the given address
-func (pf *ProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProof(rootHash, address)
+func (pf *ProxyFacade) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProof(ctx, rootHash, address)
 }
 
 // GetProofDataTrie returns a Merkle proof for the given address and a Merkle proof for the given key
-func (pf *ProxyFacade) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProofDataTrie(rootHash, address, key)
+func (pf *ProxyFacade) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProofDataTrie(ctx, rootHash, address, key)
 }
 
 // GetProofCurrentRootHash returns the Merkle proof for the given address
-func (pf *ProxyFacade) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.GetProofCurrentRootHash(address)
+func (pf *ProxyFacade) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetProofCurrentRootHash(ctx, address)
 }
 
 // VerifyProof verifies the given Merkle proof
-func (pf *ProxyFacade) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
-	return pf.proofProc.VerifyProof(rootHash, address, proof)
+func (pf *ProxyFacade) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.VerifyProof(ctx, rootHash, address, proof)
 }
 
 // GetMetrics will return the status metrics
@@ -491,13 +937,13 @@ func (pf *ProxyFacade) GetMetricsForPrometheus() string {
 }
 
 // GetGenesisNodesPubKeys retrieves the node's configuration public keys
-func (pf *ProxyFacade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGenesisNodesPubKeys()
+func (pf *ProxyFacade) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetGenesisNodesPubKeys(ctx)
 }
 
 // GetGasConfigs retrieves the current gas schedule configs
-func (pf *ProxyFacade) GetGasConfigs() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGasConfigs()
+func (pf *ProxyFacade) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetGasConfigs(ctx)
 }
 
 // GetAboutInfo will return the app info
@@ -506,41 +952,46 @@ func (pf *ProxyFacade) GetAboutInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetNodesVersions will return the version of the nodes
-func (pf *ProxyFacade) GetNodesVersions() (*data.GenericAPIResponse, error) {
-	return pf.aboutInfoProc.GetNodesVersions()
+func (pf *ProxyFacade) GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return pf.aboutInfoProc.GetNodesVersions(ctx)
+}
+
+// GetExcludedObservers will return the observers excluded for running a version below the minimum accepted one
+func (pf *ProxyFacade) GetExcludedObservers() (*data.GenericAPIResponse, error) {
+	return pf.aboutInfoProc.GetExcludedObservers(), nil
 }
 
 // GetAlteredAccountsByNonce returns altered accounts by nonce in block
-func (pf *ProxyFacade) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
-	return pf.blockProc.GetAlteredAccountsByNonce(shardID, nonce, options)
+func (pf *ProxyFacade) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+	return pf.blockProc.GetAlteredAccountsByNonce(ctx, shardID, nonce, options)
 }
 
 // GetAlteredAccountsByHash returns altered accounts by hash in block
-func (pf *ProxyFacade) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
-	return pf.blockProc.GetAlteredAccountsByHash(shardID, hash, options)
+func (pf *ProxyFacade) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+	return pf.blockProc.GetAlteredAccountsByHash(ctx, shardID, hash, options)
 }
 
 // GetTriesStatistics will return trie statistics
-func (pf *ProxyFacade) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	return pf.nodeStatusProc.GetTriesStatistics(shardID)
+func (pf *ProxyFacade) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	return pf.nodeStatusProc.GetTriesStatistics(ctx, shardID)
 }
 
 // GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
-func (pf *ProxyFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEpochStartData(epoch, shardID)
+func (pf *ProxyFacade) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+	return pf.nodeStatusProc.GetEpochStartData(ctx, epoch, shardID)
 }
 
 // GetInternalStartOfEpochValidatorsInfo retrieves the validators info by epoch
-func (pf *ProxyFacade) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
-	return pf.blockProc.GetInternalStartOfEpochValidatorsInfo(epoch)
+func (pf *ProxyFacade) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+	return pf.blockProc.GetInternalStartOfEpochValidatorsInfo(ctx, epoch)
 }
 
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
-func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
-	return epf.nodeGroupProc.GetWaitingEpochsLeftForPublicKey(publicKey)
+func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+	return epf.nodeGroupProc.GetWaitingEpochsLeftForPublicKey(ctx, publicKey)
 }
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (pf *ProxyFacade) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.IsDataTrieMigrated(address, options)
+func (pf *ProxyFacade) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return pf.accountProc.IsDataTrieMigrated(ctx, address, options)
 }
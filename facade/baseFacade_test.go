@@ -1,12 +1,14 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
@@ -45,6 +47,21 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -70,6 +87,21 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -95,6 +127,21 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -120,6 +167,21 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -145,6 +207,21 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -170,6 +247,21 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -195,6 +287,21 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -220,6 +327,21 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -245,6 +367,21 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -270,6 +407,21 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -295,6 +447,21 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -320,12 +487,627 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilGasPriceProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilGasPriceProcessor, err)
+}
+
+func TestNewProxyFacade_NilSovereignProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		nil,
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilSovereignProcessor, err)
+}
+
+func TestNewProxyFacade_NilNetworkStatusStreamerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		nil,
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNetworkStatusStreamer, err)
+}
+
+func TestNewProxyFacade_NilNodePassthroughProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		nil,
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNodePassthroughProcessor, err)
+}
+
+func TestNewProxyFacade_NilCollectionsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		nil,
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilCollectionsProcessor, err)
+}
+
+func TestNewProxyFacade_NilDataFreshnessProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		nil,
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilDataFreshnessProcessor, err)
+}
+
+func TestNewProxyFacade_NilBlocksExporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		nil,
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilBlocksExporter, err)
+}
+
+func TestNewProxyFacade_NilTokenPriceProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		nil,
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTokenPriceProcessor, err)
+}
+
+func TestNewProxyFacade_NilProbesProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		nil,
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilProbesProcessor, err)
+}
+
+func TestNewProxyFacade_NilFinalityProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		nil,
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilFinalityProcessor, err)
+}
+
+func TestNewProxyFacade_NilValidatorKeysProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		nil,
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilValidatorKeysProcessor, err)
+}
+
+func TestNewProxyFacade_NilFaucetRequestsQueueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		nil,
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilFaucetRequestsQueue, err)
+}
+
+func TestNewProxyFacade_NilStakingOverviewProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		nil,
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilStakingOverviewProcessor, err)
+}
+
+func TestNewProxyFacade_NilDelegationProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		nil,
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilDelegationProcessor, err)
+}
+
+func TestNewProxyFacade_NilClientsUsageProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilClientsUsageProvider, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +1127,21 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -393,14 +1190,29 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 	require.NoError(t, err)
 
-	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := epf.GetBlocksByRound(context.Background(), 3, common.BlockQueryOptions{WithTransactions: true})
 	require.Equal(t, errGetBlockByRound, err)
 	require.Nil(t, ret)
 
-	ret, err = epf.GetBlocksByRound(4, common.BlockQueryOptions{WithTransactions: true})
+	ret, err = epf.GetBlocksByRound(context.Background(), 4, common.BlockQueryOptions{WithTransactions: true})
 	require.Nil(t, err)
 	require.Equal(t, expectedResponse, ret)
 }
@@ -430,9 +1242,24 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
+	_, _ = epf.GetAccount(context.Background(), "", common.AccountQueryOptions{})
 
 	assert.True(t, wasCalled)
 }
@@ -445,10 +1272,10 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ActionsProcessorStub{},
 		&mock.AccountProcessorStub{},
 		&mock.TransactionProcessorStub{
-			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
 				wasCalled = true
 
-				return 0, "", nil
+				return 0, nil, nil
 			},
 		},
 		&mock.SCQueryServiceStub{},
@@ -463,9 +1290,24 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	_, _, _ = epf.SendTransaction(&data.Transaction{})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
@@ -495,9 +1337,24 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
+	_, _ = epf.SimulateTransaction(context.Background(), &data.Transaction{}, false)
 
 	assert.True(t, wasCalled)
 }
@@ -518,9 +1375,9 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 			},
 		},
 		&mock.TransactionProcessorStub{
-			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
 				wasCalled = true
-				return 0, "", nil
+				return 0, nil, nil
 			},
 		},
 		&mock.SCQueryServiceStub{},
@@ -553,9 +1410,24 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
+	_ = epf.SendUserFunds(context.Background(), "", big.NewInt(0))
 
 	assert.True(t, wasCalled)
 }
@@ -585,9 +1457,24 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	_, _, _ = epf.ExecuteSCQuery(nil)
+	_, _, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -623,9 +1510,24 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, _ := epf.GetHeartbeatData()
+	actualResult, _ := epf.GetHeartbeatData(context.Background())
 
 	assert.Equal(t, expectedResults, actualResult)
 }
@@ -658,6 +1560,21 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -693,6 +1610,21 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -732,9 +1664,24 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByHash(context.Background(), 0, "aaaa", common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -772,9 +1719,24 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByNonce(context.Background(), 0, 10, common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -814,9 +1776,24 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	actualResult, err := epf.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -854,9 +1831,24 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
+	actualResult, err := epf.GetInternalBlockByNonce(context.Background(), 0, 10, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -894,9 +1886,24 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	actualResult, err := epf.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -932,9 +1939,24 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetRatingsConfig()
+	actualResult, err := epf.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -975,7 +1997,7 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 			GetTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
 				return expectedTxPool, nil
 			},
-			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+			GetTransactionsPoolForSenderCalled: func(sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
 				return expectedTxPoolForSender, nil
 			},
 			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
@@ -997,25 +2019,40 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualTxPool, err := epf.GetTransactionsPool("")
+	actualTxPool, err := epf.GetTransactionsPool(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPool, err = epf.GetTransactionsPoolForShard(0, "")
+	actualTxPool, err = epf.GetTransactionsPoolForShard(context.Background(), 0, "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender("", "")
+	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender(context.Background(), "", "", false)
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPoolForSender, actualTxPoolForSender)
 
-	actualNonce, err := epf.GetLastPoolNonceForSender("")
+	actualNonce, err := epf.GetLastPoolNonceForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, providedNonce, actualNonce)
 
-	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender("")
+	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedNonceGaps, actualNonceGaps)
 }
@@ -1051,9 +2088,24 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, err := epf.GetGasConfigs()
+	actualResult, err := epf.GetGasConfigs(context.Background())
 	require.Nil(t, err)
 
 	assert.True(t, wasCalled)
@@ -1088,13 +2140,174 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
 	)
 
-	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
+	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 
 	assert.Equal(t, expectedResults, actualResult)
 }
 
+func TestProxyFacade_GetFinalTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	createFacade := func(tx *transaction.ApiTransactionResult, getTxErr error, isFinal bool, finalityErr error) *facade.ProxyFacade {
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{},
+			&mock.TransactionProcessorStub{
+				GetTransactionCalled: func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+					return tx, getTxErr
+				},
+				GetTransactionByHashAndSenderAddressCalled: func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+					return tx, 0, getTxErr
+				},
+			},
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			&mock.FaucetProcessorStub{},
+			&mock.NodeStatusProcessorStub{},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.GasPriceProcessorStub{},
+			&mock.SovereignProcessorStub{},
+			&mock.NetworkStatusStreamerStub{},
+			&mock.NodePassthroughProcessorStub{},
+			&mock.CollectionsProcessorStub{},
+			&mock.DataFreshnessProcessorStub{},
+			&mock.BlocksExporterStub{},
+			&mock.TokenPriceProcessorStub{},
+			&mock.ProbesProcessorStub{},
+			&mock.FinalityProcessorStub{
+				IsTransactionFinalCalled: func(tx *transaction.ApiTransactionResult) (bool, error) {
+					return isFinal, finalityErr
+				},
+			},
+			&mock.ValidatorKeysProcessorStub{},
+			&mock.FaucetRequestsQueueStub{},
+			&mock.StakingOverviewProcessorStub{},
+			&mock.DelegationProcessorStub{},
+			&mock.ClientsUsageProviderStub{},
+		)
+
+		return epf
+	}
+
+	t.Run("cannot get the transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		status, err := createFacade(nil, expectedErr, true, nil).GetFinalTransactionStatus(context.Background(), "hash", "")
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, string(data.TxStatusUnknown), status)
+	})
+	t.Run("pending transaction should be reported as is", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{Status: transaction.TxStatusPending}
+		status, err := createFacade(tx, nil, false, expectedErr).GetFinalTransactionStatus(context.Background(), "hash", "sender")
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusPending), status)
+	})
+	t.Run("finality check error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess, BlockNonce: 10}
+		status, err := createFacade(tx, nil, false, expectedErr).GetFinalTransactionStatus(context.Background(), "hash", "")
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, string(data.TxStatusUnknown), status)
+	})
+	t.Run("not final transaction should be reported as pending", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess, BlockNonce: 10}
+		status, err := createFacade(tx, nil, false, nil).GetFinalTransactionStatus(context.Background(), "hash", "")
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusPending), status)
+	})
+	t.Run("final transaction should report its status", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{Status: transaction.TxStatusFail, BlockNonce: 10}
+		status, err := createFacade(tx, nil, true, nil).GetFinalTransactionStatus(context.Background(), "hash", "sender")
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusFail), status)
+	})
+}
+
+func TestProxyFacade_ComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.MinGasLimit = 50000
+	expectedFee := &data.TransactionFee{Fee: "50000"}
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			ComputeTransactionFeeCalled: func(tx *data.Transaction, providedConfig *data.NetworkConfig) (*data.TransactionFee, error) {
+				assert.Equal(t, networkConfig, providedConfig)
+				return expectedFee, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return networkConfig, nil
+			},
+		},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.GasPriceProcessorStub{},
+		&mock.SovereignProcessorStub{},
+		&mock.NetworkStatusStreamerStub{},
+		&mock.NodePassthroughProcessorStub{},
+		&mock.CollectionsProcessorStub{},
+		&mock.DataFreshnessProcessorStub{},
+		&mock.BlocksExporterStub{},
+		&mock.TokenPriceProcessorStub{},
+		&mock.ProbesProcessorStub{},
+		&mock.FinalityProcessorStub{},
+		&mock.ValidatorKeysProcessorStub{},
+		&mock.FaucetRequestsQueueStub{},
+		&mock.StakingOverviewProcessorStub{},
+		&mock.DelegationProcessorStub{},
+		&mock.ClientsUsageProviderStub{},
+	)
+
+	fee, err := epf.ComputeTransactionFee(context.Background(), &data.Transaction{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedFee, fee)
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
@@ -43,3 +43,48 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilGasPriceProcessor signals that a nil gas price processor has been provided
+var ErrNilGasPriceProcessor = errors.New("nil gas price processor")
+
+// ErrNilSovereignProcessor signals that a nil sovereign processor has been provided
+var ErrNilSovereignProcessor = errors.New("nil sovereign processor")
+
+// ErrNilNetworkStatusStreamer signals that a nil network status streamer has been provided
+var ErrNilNetworkStatusStreamer = errors.New("nil network status streamer")
+
+// ErrNilNodePassthroughProcessor signals that a nil node passthrough processor has been provided
+var ErrNilNodePassthroughProcessor = errors.New("nil node passthrough processor")
+
+// ErrNilCollectionsProcessor signals that a nil collections processor has been provided
+var ErrNilCollectionsProcessor = errors.New("nil collections processor")
+
+// ErrNilDataFreshnessProcessor signals that a nil data freshness processor has been provided
+var ErrNilDataFreshnessProcessor = errors.New("nil data freshness processor")
+
+// ErrNilBlocksExporter signals that a nil blocks exporter has been provided
+var ErrNilBlocksExporter = errors.New("nil blocks exporter")
+
+// ErrNilTokenPriceProcessor signals that a nil token price processor has been provided
+var ErrNilTokenPriceProcessor = errors.New("nil token price processor")
+
+// ErrNilProbesProcessor signals that a nil probes processor has been provided
+var ErrNilProbesProcessor = errors.New("nil probes processor")
+
+// ErrNilFinalityProcessor signals that a nil finality processor has been provided
+var ErrNilFinalityProcessor = errors.New("nil finality processor")
+
+// ErrNilFaucetRequestsQueue signals that a nil faucet requests queue has been provided
+var ErrNilFaucetRequestsQueue = errors.New("nil faucet requests queue")
+
+// ErrNilStakingOverviewProcessor signals that a nil staking overview processor has been provided
+var ErrNilStakingOverviewProcessor = errors.New("nil staking overview processor")
+
+// ErrNilDelegationProcessor signals that a nil delegation processor has been provided
+var ErrNilDelegationProcessor = errors.New("nil delegation processor")
+
+// ErrNilClientsUsageProvider signals that a nil clients usage provider has been provided
+var ErrNilClientsUsageProvider = errors.New("nil clients usage provider")
+
+// ErrNilValidatorKeysProcessor signals that a nil validator keys processor has been provided
+var ErrNilValidatorKeysProcessor = errors.New("nil validator keys processor")
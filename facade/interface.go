@@ -1,8 +1,12 @@
 package facade
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
@@ -14,112 +18,145 @@ import (
 type ActionsProcessor interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
+	AddObserver(node *data.NodeData) error
+	RemoveObserver(address string) error
 }
 
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
-	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsNonces(ctx context.Context, addresses []string) (*data.AccountsNonces, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
-	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairsDiff(ctx context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error)
+	GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error)
+	GetAddressActivitySummary(ctx context.Context, address string) (*data.AddressActivitySummary, error)
+	GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error)
+	GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
-	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, *data.SentTransaction, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionOutcome(ctx context.Context, txHash string) (*data.TransactionOutcome, error)
+	GetTransactionJourney(ctx context.Context, txHash string) (*data.TransactionJourney, error)
+	ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error)
+	DecodeDataField(dataField string) (*data.DecodedDataField, error)
+	CheckTransferReceiver(ctx context.Context, tx *data.Transaction) (*data.ReceiverCheck, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
-	GetLastPoolNonceForSender(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolForSenders(ctx context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error)
+	GetAgedTransactionsPool(ctx context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error)
+	GetTransactionsPoolChunk(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error)
+	StreamTransactionsPool(ctx context.Context, shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetStuckTransactionsForSender(ctx context.Context, sender string, accountNonce uint64) (*data.StuckTransactions, error)
+	ValidateTransaction(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult
+	ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFee, error)
+	BuildESDTTransfer(request *data.ESDTTransferBuildRequest, networkConfig *data.NetworkConfig) (*data.ESDTTransferBuildResult, error)
+	DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation
+	DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent
+	VerifyTransactionHash(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error)
+	SetReadOnlyMode(enabled bool)
+	IsReadOnlyModeEnabled() bool
 }
 
 // ProofProcessor defines what a proof request processor should do
 type ProofProcessor interface {
-	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
-	GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
-	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
+	GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteMultiContractQuery(ctx context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error)
 }
 
 // NodeGroupProcessor defines what a node group processor should do
 type NodeGroupProcessor interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
-	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
-	GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error)
+	GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
 
 // ValidatorStatisticsProcessor defines what a validator statistics processor should do
 type ValidatorStatisticsProcessor interface {
-	GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
-	GetAuctionList() (*data.AuctionListResponse, error)
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
+	GetFilteredValidatorStatistics(ctx context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error)
+	GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error)
 }
 
 // ESDTSupplyProcessor defines what an esdt supply processor should do
 type ESDTSupplyProcessor interface {
-	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error)
 }
 
 // NodeStatusProcessor defines what a node status processor should do
 type NodeStatusProcessor interface {
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkStatusSnapshot(ctx context.Context) (*data.NetworkStatusSnapshot, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
-	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
-	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
-	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
-	GetDelegatedInfo() (*data.GenericAPIResponse, error)
-	GetRatingsConfig() (*data.GenericAPIResponse, error)
-	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
-	GetGasConfigs() (*data.GenericAPIResponse, error)
-	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
-	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error)
+	GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error)
+	GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 }
 
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlocksByHashes(ctx context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error)
+	GetEpochStartBlock(ctx context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 
-	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetMiniBlockByHash(ctx context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error)
 
-	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
-	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
-	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 }
 
 // FaucetProcessor defines what a component which will handle faucets should do
@@ -136,6 +173,14 @@ type FaucetProcessor interface {
 	) (*data.Transaction, error)
 }
 
+// FaucetRequestsQueue defines what a component processing the faucet requests asynchronously should do
+type FaucetRequestsQueue interface {
+	IsEnabled() bool
+	Enqueue(receiver string, value *big.Int) (*data.FaucetRequest, error)
+	GetRequest(id string) (*data.FaucetRequest, error)
+	Close() error
+}
+
 // StatusProcessor defines what a component which will handle status request should do
 type StatusProcessor interface {
 	GetMetrics() map[string]*data.EndpointMetrics
@@ -145,5 +190,88 @@ type StatusProcessor interface {
 // AboutInfoProcessor defines the behaviour of about info processor
 type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
-	GetNodesVersions() (*data.GenericAPIResponse, error)
+	GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetExcludedObservers() *data.GenericAPIResponse
+}
+
+// GasPriceProcessor defines what a gas price suggestion processor should do
+type GasPriceProcessor interface {
+	GetGasPriceSuggestion(ctx context.Context, minGasPrice uint64) (*data.GasPriceSuggestion, error)
+}
+
+// NetworkStatusStreamer defines what a network status stream provider should do
+type NetworkStatusStreamer interface {
+	Subscribe(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error)
+}
+
+// BlocksExporter defines what a component which exports ranges of blocks to files should do
+type BlocksExporter interface {
+	StartExport(ctx context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error)
+	GetExportJob(jobID string) (*data.BlocksExportJob, error)
+	Close() error
+}
+
+// TokenPriceProcessor defines what a processor serving the token prices should do
+type TokenPriceProcessor interface {
+	IsEnabled() bool
+	GetTokenPrice(token string) (*data.TokenPrice, error)
+	GetUsdValue(ctx context.Context, token string, amount string) (float64, error)
+}
+
+// FinalityProcessor defines what a processor deciding whether the blocks and the transactions are final should do
+type FinalityProcessor interface {
+	IsBlockFinal(ctx context.Context, shardID uint32, nonce uint64) (bool, error)
+	IsTransactionFinal(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error)
+}
+
+// ValidatorKeysProcessor defines what a processor resolving the staking data of the validator BLS keys should do
+type ValidatorKeysProcessor interface {
+	GetBLSKeyInfo(ctx context.Context, blsKey string) (*data.BLSKeyInfo, error)
+}
+
+// StakingOverviewProcessor defines what a processor aggregating the staking economics of the network should do
+type StakingOverviewProcessor interface {
+	GetStakingOverview(ctx context.Context) (*data.StakingOverview, error)
+}
+
+// ClientsUsageProvider defines what a component serving the usage of the proxy by each client should do
+type ClientsUsageProvider interface {
+	GetUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error)
+}
+
+// DelegationProcessor defines what a processor decoding the data of the delegation contracts should do
+type DelegationProcessor interface {
+	GetDelegationProviders(ctx context.Context) ([]*data.DelegationProvider, error)
+	GetDelegationProvider(ctx context.Context, address string) (*data.DelegationProvider, error)
+}
+
+// ProbesProcessor defines what a processor computing the liveness and the readiness of the proxy should do
+type ProbesProcessor interface {
+	GetLiveness() *data.ProbeStatus
+	GetReadiness() *data.ProbeStatus
+}
+
+// SovereignProcessor defines what a sovereign chain data processor should do
+type SovereignProcessor interface {
+	GetValidatorsInfo(ctx context.Context, epoch uint32, consensusGroupSize uint32) (*data.SovereignValidatorsInfo, error)
+	GetChainParameters(ctx context.Context, networkConfig *data.NetworkConfig) (*data.SovereignChainParameters, error)
+	GetNotarizedMainChainHeaders(ctx context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error)
+}
+
+// NodePassthroughProcessor defines what a processor forwarding raw requests to the observers should do
+type NodePassthroughProcessor interface {
+	ForwardRequest(ctx context.Context, shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error)
+}
+
+// CollectionsProcessor defines what a NFT, SFT and MetaESDT collections processor should do
+type CollectionsProcessor interface {
+	GetCollection(ctx context.Context, collection string) (*data.Collection, error)
+	GetCollectionsForAddress(ctx context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error)
+	GetTokensIssuedByAddress(ctx context.Context, address string) ([]*data.IssuedToken, error)
+}
+
+// DataFreshnessProcessor defines what a processor checking the freshness of the reads against multiple observers should do
+type DataFreshnessProcessor interface {
+	IsEnabled() bool
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
 }
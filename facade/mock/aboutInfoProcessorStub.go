@@ -1,11 +1,15 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // AboutInfoProcessorStub -
 type AboutInfoProcessorStub struct {
-	GetAboutInfoCalled     func() *data.GenericAPIResponse
-	GetNodesVersionsCalled func() (*data.GenericAPIResponse, error)
+	GetAboutInfoCalled         func() *data.GenericAPIResponse
+	GetNodesVersionsCalled     func() (*data.GenericAPIResponse, error)
+	GetExcludedObserversCalled func() *data.GenericAPIResponse
 }
 
 // GetAboutInfo -
@@ -18,10 +22,19 @@ func (stub *AboutInfoProcessorStub) GetAboutInfo() *data.GenericAPIResponse {
 }
 
 // GetNodesVersions -
-func (stub *AboutInfoProcessorStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (stub *AboutInfoProcessorStub) GetNodesVersions(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetNodesVersionsCalled != nil {
 		return stub.GetNodesVersionsCalled()
 	}
 
 	return nil, nil
 }
+
+// GetExcludedObservers -
+func (stub *AboutInfoProcessorStub) GetExcludedObservers() *data.GenericAPIResponse {
+	if stub.GetExcludedObserversCalled != nil {
+		return stub.GetExcludedObserversCalled()
+	}
+
+	return nil
+}
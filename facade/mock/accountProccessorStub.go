@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -9,6 +10,7 @@ import (
 type AccountProcessorStub struct {
 	GetAccountCalled                        func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccountsCalled                       func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountsNoncesCalled                 func(addresses []string) (*data.AccountsNonces, error)
 	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
 	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
@@ -19,39 +21,49 @@ type AccountProcessorStub struct {
 	GetESDTsWithRoleCalled                  func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetKeyValuePairsCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairsDiffCalled              func(address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error)
 	GetESDTsRolesCalled                     func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetCodeHashCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianDataCalled                   func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	VerifyMessageSignatureCalled            func(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error)
+	GetAddressActivitySummaryCalled         func(address string) (*data.AddressActivitySummary, error)
+	GetAddressLatestTransactionsCalled      func(address string, size uint32) ([]data.DatabaseTransaction, error)
+	GetTokenHoldersCalled                   func(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error)
 }
 
 // GetKeyValuePairs -
-func (aps *AccountProcessorStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetKeyValuePairsCalled(address, options)
 }
 
+// GetKeyValuePairsDiff -
+func (aps *AccountProcessorStub) GetKeyValuePairsDiff(_ context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+	return aps.GetKeyValuePairsDiffCalled(address, options)
+}
+
 // GetAllESDTTokens -
-func (aps *AccountProcessorStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetAllESDTTokensCalled(address, options)
 }
 
 // GetESDTTokenData -
-func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key, options)
 }
 
 // GetESDTNftTokenData -
-func (aps *AccountProcessorStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTNftTokenDataCalled(address, key, nonce, options)
 }
 
 // GetESDTsWithRole -
-func (aps *AccountProcessorStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTsWithRoleCalled(address, role, options)
 }
 
 // GetESDTsRoles -
-func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.GetESDTsRolesCalled != nil {
 		return aps.GetESDTsRolesCalled(address, options)
 	}
@@ -60,27 +72,36 @@ func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.Ac
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 }
 
 // GetAccount -
-func (aps *AccountProcessorStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (aps *AccountProcessorStub) GetAccount(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return aps.GetAccountCalled(address, options)
 }
 
 // GetAccounts -
-func (aps *AccountProcessorStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (aps *AccountProcessorStub) GetAccounts(_ context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	return aps.GetAccountsCalled(addresses, options)
 }
 
+// GetAccountsNonces -
+func (aps *AccountProcessorStub) GetAccountsNonces(_ context.Context, addresses []string) (*data.AccountsNonces, error) {
+	if aps.GetAccountsNoncesCalled != nil {
+		return aps.GetAccountsNoncesCalled(addresses)
+	}
+
+	return nil, nil
+}
+
 // GetValueForKey -
-func (aps *AccountProcessorStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (aps *AccountProcessorStub) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return aps.GetValueForKeyCalled(address, key, options)
 }
 
 // GetGuardianData -
-func (aps *AccountProcessorStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetGuardianData(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetGuardianDataCalled(address, options)
 }
 
@@ -90,7 +111,7 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 }
 
 // GetCodeHash -
-func (aps *AccountProcessorStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetCodeHash(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetCodeHashCalled(address, options)
 }
 
@@ -100,7 +121,7 @@ func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.Validat
 }
 
 // IsDataTrieMigrated --
-func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) IsDataTrieMigrated(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.IsDataTrieMigratedCalled != nil {
 		return aps.IsDataTrieMigratedCalled(address, options)
 	}
@@ -112,3 +133,39 @@ func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options comm
 func (aps *AccountProcessorStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
 	return nil, nil
 }
+
+// VerifyMessageSignature -
+func (aps *AccountProcessorStub) VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+	if aps.VerifyMessageSignatureCalled != nil {
+		return aps.VerifyMessageSignatureCalled(request)
+	}
+
+	return &data.SignatureVerification{}, nil
+}
+
+// GetAddressActivitySummary -
+func (aps *AccountProcessorStub) GetAddressActivitySummary(_ context.Context, address string) (*data.AddressActivitySummary, error) {
+	if aps.GetAddressActivitySummaryCalled != nil {
+		return aps.GetAddressActivitySummaryCalled(address)
+	}
+
+	return &data.AddressActivitySummary{}, nil
+}
+
+// GetAddressLatestTransactions -
+func (aps *AccountProcessorStub) GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error) {
+	if aps.GetAddressLatestTransactionsCalled != nil {
+		return aps.GetAddressLatestTransactionsCalled(address, size)
+	}
+
+	return make([]data.DatabaseTransaction, 0), nil
+}
+
+// GetTokenHolders -
+func (aps *AccountProcessorStub) GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+	if aps.GetTokenHoldersCalled != nil {
+		return aps.GetTokenHoldersCalled(token, options)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
@@ -8,6 +8,8 @@ import (
 type ActionsProcessorStub struct {
 	ReloadObserversCalled            func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled func() data.NodesReloadResponse
+	AddObserverCalled                func(node *data.NodeData) error
+	RemoveObserverCalled             func(address string) error
 }
 
 // ReloadObservers -
@@ -27,3 +29,21 @@ func (a *ActionsProcessorStub) ReloadFullHistoryObservers() data.NodesReloadResp
 
 	return data.NodesReloadResponse{}
 }
+
+// AddObserver -
+func (a *ActionsProcessorStub) AddObserver(node *data.NodeData) error {
+	if a.AddObserverCalled != nil {
+		return a.AddObserverCalled(node)
+	}
+
+	return nil
+}
+
+// RemoveObserver -
+func (a *ActionsProcessorStub) RemoveObserver(address string) error {
+	if a.RemoveObserverCalled != nil {
+		return a.RemoveObserverCalled(address)
+	}
+
+	return nil
+}
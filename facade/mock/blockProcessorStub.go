@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -16,18 +17,21 @@ type BlockProcessorStub struct {
 	GetInternalMiniBlockByHashCalled            func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlockCalled      func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetMiniBlockByHashCalled                    func(shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error)
+	GetBlocksByHashesCalled                     func(requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error)
+	GetEpochStartBlockCalled                    func(shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 }
 
-func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByHash(_ context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByHashCalled(shardID, hash, options)
 }
 
-func (bps *BlockProcessorStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
 // GetHyperBlockByHash -
-func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByHash(_ context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByHashCalled != nil {
 		return bps.GetHyperBlockByHashCalled(hash, options)
 	}
@@ -36,7 +40,7 @@ func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string, options common.H
 }
 
 // GetHyperBlockByNonce -
-func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByNonceCalled != nil {
 		return bps.GetHyperBlockByNonceCalled(nonce, options)
 	}
@@ -45,36 +49,51 @@ func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common
 }
 
 // GetInternalBlockByHash -
-func (bps *BlockProcessorStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalBlockByHash(_ context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (bps *BlockProcessorStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (bps *BlockProcessorStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalMiniBlockByHash(_ context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return bps.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
+// GetBlocksByHashes -
+func (bps *BlockProcessorStub) GetBlocksByHashes(_ context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+	return bps.GetBlocksByHashesCalled(requests, options)
+}
+
+// GetEpochStartBlock -
+func (bps *BlockProcessorStub) GetEpochStartBlock(_ context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return bps.GetEpochStartBlockCalled(shardID, epoch, options)
+}
+
+// GetMiniBlockByHash -
+func (bps *BlockProcessorStub) GetMiniBlockByHash(_ context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+	return bps.GetMiniBlockByHashCalled(shardID, hash, options)
+}
+
 // GetInternalStartOfEpochMetaBlock -
-func (bps *BlockProcessorStub) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalStartOfEpochMetaBlock(_ context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
 
 // GetAlteredAccountsByNonce -
-func (bps *BlockProcessorStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bps *BlockProcessorStub) GetAlteredAccountsByNonce(_ context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	return nil, nil
 }
 
 // GetAlteredAccountsByHash -
-func (bps *BlockProcessorStub) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bps *BlockProcessorStub) GetAlteredAccountsByHash(_ context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	return nil, nil
 }
 
 // GetInternalStartOfEpochValidatorsInfo -
-func (bps *BlockProcessorStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalStartOfEpochValidatorsInfo(_ context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return bps.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlocksExporterStub -
+type BlocksExporterStub struct {
+	StartExportCalled  func(request *data.BlocksExportRequest) (*data.BlocksExportJob, error)
+	GetExportJobCalled func(jobID string) (*data.BlocksExportJob, error)
+}
+
+// StartExport -
+func (stub *BlocksExporterStub) StartExport(_ context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+	if stub.StartExportCalled != nil {
+		return stub.StartExportCalled(request)
+	}
+
+	return &data.BlocksExportJob{}, nil
+}
+
+// GetExportJob -
+func (stub *BlocksExporterStub) GetExportJob(jobID string) (*data.BlocksExportJob, error) {
+	if stub.GetExportJobCalled != nil {
+		return stub.GetExportJobCalled(jobID)
+	}
+
+	return &data.BlocksExportJob{}, nil
+}
+
+// Close -
+func (stub *BlocksExporterStub) Close() error {
+	return nil
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ClientsUsageProviderStub -
+type ClientsUsageProviderStub struct {
+	GetUsageCalled func(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error)
+}
+
+// GetUsage -
+func (stub *ClientsUsageProviderStub) GetUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+	if stub.GetUsageCalled != nil {
+		return stub.GetUsageCalled(apiKey, from, to)
+	}
+
+	return &data.ClientUsageReport{}, nil
+}
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// CollectionsProcessorStub -
+type CollectionsProcessorStub struct {
+	GetCollectionCalled            func(collection string) (*data.Collection, error)
+	GetCollectionsForAddressCalled func(address string, options common.CollectionsQueryOptions) ([]*data.Collection, error)
+	GetTokensIssuedByAddressCalled func(address string) ([]*data.IssuedToken, error)
+}
+
+// GetCollection -
+func (stub *CollectionsProcessorStub) GetCollection(_ context.Context, collection string) (*data.Collection, error) {
+	if stub.GetCollectionCalled != nil {
+		return stub.GetCollectionCalled(collection)
+	}
+
+	return nil, nil
+}
+
+// GetCollectionsForAddress -
+func (stub *CollectionsProcessorStub) GetCollectionsForAddress(_ context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error) {
+	if stub.GetCollectionsForAddressCalled != nil {
+		return stub.GetCollectionsForAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetTokensIssuedByAddress -
+func (stub *CollectionsProcessorStub) GetTokensIssuedByAddress(_ context.Context, address string) ([]*data.IssuedToken, error) {
+	if stub.GetTokensIssuedByAddressCalled != nil {
+		return stub.GetTokensIssuedByAddressCalled(address)
+	}
+
+	return nil, nil
+}
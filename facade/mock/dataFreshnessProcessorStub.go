@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DataFreshnessProcessorStub -
+type DataFreshnessProcessorStub struct {
+	IsEnabledCalled               func() bool
+	GetNetworkStatusMetricsCalled func(shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+// IsEnabled -
+func (stub *DataFreshnessProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetNetworkStatusMetrics -
+func (stub *DataFreshnessProcessorStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkStatusMetricsCalled != nil {
+		return stub.GetNetworkStatusMetricsCalled(shardID)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
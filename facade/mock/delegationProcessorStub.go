@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DelegationProcessorStub -
+type DelegationProcessorStub struct {
+	GetDelegationProvidersCalled func() ([]*data.DelegationProvider, error)
+	GetDelegationProviderCalled  func(address string) (*data.DelegationProvider, error)
+}
+
+// GetDelegationProviders -
+func (stub *DelegationProcessorStub) GetDelegationProviders(_ context.Context) ([]*data.DelegationProvider, error) {
+	if stub.GetDelegationProvidersCalled != nil {
+		return stub.GetDelegationProvidersCalled()
+	}
+
+	return make([]*data.DelegationProvider, 0), nil
+}
+
+// GetDelegationProvider -
+func (stub *DelegationProcessorStub) GetDelegationProvider(_ context.Context, address string) (*data.DelegationProvider, error) {
+	if stub.GetDelegationProviderCalled != nil {
+		return stub.GetDelegationProviderCalled(address)
+	}
+
+	return &data.DelegationProvider{}, nil
+}
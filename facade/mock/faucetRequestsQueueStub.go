@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// FaucetRequestsQueueStub -
+type FaucetRequestsQueueStub struct {
+	IsEnabledCalled  func() bool
+	EnqueueCalled    func(receiver string, value *big.Int) (*data.FaucetRequest, error)
+	GetRequestCalled func(id string) (*data.FaucetRequest, error)
+}
+
+// IsEnabled -
+func (stub *FaucetRequestsQueueStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// Enqueue -
+func (stub *FaucetRequestsQueueStub) Enqueue(receiver string, value *big.Int) (*data.FaucetRequest, error) {
+	if stub.EnqueueCalled != nil {
+		return stub.EnqueueCalled(receiver, value)
+	}
+
+	return &data.FaucetRequest{}, nil
+}
+
+// GetRequest -
+func (stub *FaucetRequestsQueueStub) GetRequest(id string) (*data.FaucetRequest, error) {
+	if stub.GetRequestCalled != nil {
+		return stub.GetRequestCalled(id)
+	}
+
+	return &data.FaucetRequest{}, nil
+}
+
+// Close -
+func (stub *FaucetRequestsQueueStub) Close() error {
+	return nil
+}
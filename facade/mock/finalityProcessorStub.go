@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// FinalityProcessorStub -
+type FinalityProcessorStub struct {
+	IsBlockFinalCalled       func(shardID uint32, nonce uint64) (bool, error)
+	IsTransactionFinalCalled func(tx *transaction.ApiTransactionResult) (bool, error)
+}
+
+// IsBlockFinal -
+func (stub *FinalityProcessorStub) IsBlockFinal(_ context.Context, shardID uint32, nonce uint64) (bool, error) {
+	if stub.IsBlockFinalCalled != nil {
+		return stub.IsBlockFinalCalled(shardID, nonce)
+	}
+
+	return true, nil
+}
+
+// IsTransactionFinal -
+func (stub *FinalityProcessorStub) IsTransactionFinal(_ context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	if stub.IsTransactionFinalCalled != nil {
+		return stub.IsTransactionFinalCalled(tx)
+	}
+
+	return true, nil
+}
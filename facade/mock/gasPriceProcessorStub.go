@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// GasPriceProcessorStub -
+type GasPriceProcessorStub struct {
+	GetGasPriceSuggestionCalled func(minGasPrice uint64) (*data.GasPriceSuggestion, error)
+}
+
+// GetGasPriceSuggestion -
+func (stub *GasPriceProcessorStub) GetGasPriceSuggestion(_ context.Context, minGasPrice uint64) (*data.GasPriceSuggestion, error) {
+	if stub.GetGasPriceSuggestionCalled != nil {
+		return stub.GetGasPriceSuggestionCalled(minGasPrice)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkStatusStreamerStub -
+type NetworkStatusStreamerStub struct {
+	SubscribeCalled func(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error)
+}
+
+// Subscribe -
+func (stub *NetworkStatusStreamerStub) Subscribe(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(shardID)
+	}
+
+	return make(chan *data.NetworkStatusUpdate), func() {}, nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// NodePassthroughProcessorStub -
+type NodePassthroughProcessorStub struct {
+	ForwardRequestCalled func(shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error)
+}
+
+// ForwardRequest -
+func (stub *NodePassthroughProcessorStub) ForwardRequest(_ context.Context, shardID uint32, method string, endpoint string, rawQuery string, body []byte) (int, json.RawMessage, error) {
+	if stub.ForwardRequestCalled != nil {
+		return stub.ForwardRequestCalled(shardID, method, endpoint, rawQuery, body)
+	}
+
+	return 0, nil, nil
+}
@@ -1,11 +1,16 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodeStatusProcessorStub --
 type NodeStatusProcessorStub struct {
 	GetConfigMetricsCalled                          func() (*data.GenericAPIResponse, error)
+	GetNetworkConfigCalled                          func() (*data.NetworkConfig, error)
 	GetNetworkMetricsCalled                         func(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkStatusSnapshotCalled                  func() (*data.NetworkStatusSnapshot, error)
 	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
 	GetEconomicsDataMetricsCalled                   func() (*data.GenericAPIResponse, error)
 	GetAllIssuedESDTsCalled                         func(tokenType string) (*data.GenericAPIResponse, error)
@@ -20,7 +25,7 @@ type NodeStatusProcessorStub struct {
 }
 
 // GetNetworkConfigMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetConfigMetricsCalled != nil {
 		return stub.GetConfigMetricsCalled()
 	}
@@ -28,8 +33,26 @@ func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPI
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetNetworkConfig --
+func (stub *NodeStatusProcessorStub) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return &data.NetworkConfig{}, nil
+}
+
+// GetNetworkStatusSnapshot --
+func (stub *NodeStatusProcessorStub) GetNetworkStatusSnapshot(_ context.Context) (*data.NetworkStatusSnapshot, error) {
+	if stub.GetNetworkStatusSnapshotCalled != nil {
+		return stub.GetNetworkStatusSnapshotCalled()
+	}
+
+	return &data.NetworkStatusSnapshot{}, nil
+}
+
 // GetNetworkStatusMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetNetworkMetricsCalled != nil {
 		return stub.GetNetworkMetricsCalled(shardID)
 	}
@@ -47,7 +70,7 @@ func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPI
 }
 
 // GetLatestFullySynchronizedHyperblockNonce -
-func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
 	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
 		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
 	}
@@ -56,7 +79,7 @@ func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce()
 }
 
 // GetAllIssuedESDTs -
-func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(_ context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if stub.GetAllIssuedESDTsCalled != nil {
 		return stub.GetAllIssuedESDTsCalled(tokenType)
 	}
@@ -65,7 +88,7 @@ func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.
 }
 
 // GetDirectStakedInfo -
-func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDirectStakedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDirectStakedInfoCalled != nil {
 		return stub.GetDirectStakedInfoCalled()
 	}
@@ -74,7 +97,7 @@ func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResp
 }
 
 // GetDelegatedInfo -
-func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDelegatedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDelegatedInfoCalled != nil {
 		return stub.GetDelegatedInfoCalled()
 	}
@@ -83,7 +106,7 @@ func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIRespons
 }
 
 // GetEnableEpochsMetrics -
-func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetEnableEpochsMetricsCalled != nil {
 		return stub.GetEnableEpochsMetricsCalled()
 	}
@@ -92,7 +115,7 @@ func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIR
 }
 
 // GetRatingsConfig -
-func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetRatingsConfig(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetRatingsConfigCalled != nil {
 		return stub.GetRatingsConfigCalled()
 	}
@@ -101,7 +124,7 @@ func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIRespons
 }
 
 // GetGenesisNodesPubKeys -
-func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGenesisNodesPubKeysCalled != nil {
 		return stub.GetGenesisNodesPubKeysCalled()
 	}
@@ -110,7 +133,7 @@ func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIR
 }
 
 // GetGasConfigs -
-func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGasConfigs(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGasConfigsCalled != nil {
 		return stub.GetGasConfigsCalled()
 	}
@@ -119,7 +142,7 @@ func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse,
 }
 
 // GetEpochStartData -
-func (stub *NodeStatusProcessorStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEpochStartData(_ context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetEpochStartDataCalled != nil {
 		return stub.GetEpochStartDataCalled(epoch, shardID)
 	}
@@ -128,7 +151,7 @@ func (stub *NodeStatusProcessorStub) GetEpochStartData(epoch uint32, shardID uin
 }
 
 // GetTriesStatistics -
-func (stub *NodeStatusProcessorStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetTriesStatistics(_ context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if stub.GetTriesStatisticsCalled != nil {
 		return stub.GetTriesStatisticsCalled(shardID)
 	}
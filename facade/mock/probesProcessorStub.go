@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ProbesProcessorStub -
+type ProbesProcessorStub struct {
+	GetLivenessCalled  func() *data.ProbeStatus
+	GetReadinessCalled func() *data.ProbeStatus
+}
+
+// GetLiveness -
+func (stub *ProbesProcessorStub) GetLiveness() *data.ProbeStatus {
+	if stub.GetLivenessCalled != nil {
+		return stub.GetLivenessCalled()
+	}
+
+	return &data.ProbeStatus{Healthy: true}
+}
+
+// GetReadiness -
+func (stub *ProbesProcessorStub) GetReadiness() *data.ProbeStatus {
+	if stub.GetReadinessCalled != nil {
+		return stub.GetReadinessCalled()
+	}
+
+	return &data.ProbeStatus{Healthy: true}
+}
@@ -1,16 +1,27 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueryCalled              func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteMultiContractQueryCalled func(query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error)
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
+
+// ExecuteMultiContractQuery -
+func (serviceStub *SCQueryServiceStub) ExecuteMultiContractQuery(_ context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+	if serviceStub.ExecuteMultiContractQueryCalled != nil {
+		return serviceStub.ExecuteMultiContractQueryCalled(query, scAddresses)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// SovereignProcessorStub -
+type SovereignProcessorStub struct {
+	GetValidatorsInfoCalled  func(epoch uint32, consensusGroupSize uint32) (*data.SovereignValidatorsInfo, error)
+	GetChainParametersCalled func(networkConfig *data.NetworkConfig) (*data.SovereignChainParameters, error)
+
+	GetNotarizedMainChainHeadersCalled func(from uint64, to uint64) (*data.NotarizedMainChainHeaders, error)
+}
+
+// GetValidatorsInfo -
+func (stub *SovereignProcessorStub) GetValidatorsInfo(_ context.Context, epoch uint32, consensusGroupSize uint32) (*data.SovereignValidatorsInfo, error) {
+	if stub.GetValidatorsInfoCalled != nil {
+		return stub.GetValidatorsInfoCalled(epoch, consensusGroupSize)
+	}
+
+	return nil, nil
+}
+
+// GetChainParameters -
+func (stub *SovereignProcessorStub) GetChainParameters(_ context.Context, networkConfig *data.NetworkConfig) (*data.SovereignChainParameters, error) {
+	if stub.GetChainParametersCalled != nil {
+		return stub.GetChainParametersCalled(networkConfig)
+	}
+
+	return nil, nil
+}
+
+// GetNotarizedMainChainHeaders -
+func (stub *SovereignProcessorStub) GetNotarizedMainChainHeaders(_ context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+	if stub.GetNotarizedMainChainHeadersCalled != nil {
+		return stub.GetNotarizedMainChainHeadersCalled(from, to)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// StakingOverviewProcessorStub -
+type StakingOverviewProcessorStub struct {
+	GetStakingOverviewCalled func() (*data.StakingOverview, error)
+}
+
+// GetStakingOverview -
+func (stub *StakingOverviewProcessorStub) GetStakingOverview(_ context.Context) (*data.StakingOverview, error) {
+	if stub.GetStakingOverviewCalled != nil {
+		return stub.GetStakingOverviewCalled()
+	}
+
+	return &data.StakingOverview{}, nil
+}
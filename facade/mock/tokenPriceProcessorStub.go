@@ -0,0 +1,40 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TokenPriceProcessorStub -
+type TokenPriceProcessorStub struct {
+	IsEnabledCalled     func() bool
+	GetTokenPriceCalled func(token string) (*data.TokenPrice, error)
+	GetUsdValueCalled   func(token string, amount string) (float64, error)
+}
+
+// IsEnabled -
+func (stub *TokenPriceProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetTokenPrice -
+func (stub *TokenPriceProcessorStub) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	if stub.GetTokenPriceCalled != nil {
+		return stub.GetTokenPriceCalled(token)
+	}
+
+	return &data.TokenPrice{}, nil
+}
+
+// GetUsdValue -
+func (stub *TokenPriceProcessorStub) GetUsdValue(_ context.Context, token string, amount string) (float64, error) {
+	if stub.GetUsdValueCalled != nil {
+		return stub.GetUsdValueCalled(token, amount)
+	}
+
+	return 0, nil
+}
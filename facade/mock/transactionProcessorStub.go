@@ -1,9 +1,11 @@
 package mock
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -12,25 +14,43 @@ var errNotImplemented = errors.New("not implemented")
 
 // TransactionProcessorStub -
 type TransactionProcessorStub struct {
-	SendTransactionCalled                       func(tx *data.Transaction) (int, string, error)
+	SendTransactionCalled                       func(tx *data.Transaction) (int, *data.SentTransaction, error)
 	SendMultipleTransactionsCalled              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusCalled         func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionOutcomeCalled                 func(txHash string) (*data.TransactionOutcome, error)
+	GetTransactionJourneyCalled                 func(txHash string) (*data.TransactionJourney, error)
+	ComputeContractAddressCalled                func(deployer string, nonce uint64) (*data.ContractAddress, error)
+	DecodeDataFieldCalled                       func(dataField string) (*data.DecodedDataField, error)
+	CheckTransferReceiverCalled                 func(tx *data.Transaction) (*data.ReceiverCheck, error)
+	GetStuckTransactionsForSenderCalled         func(sender string, accountNonce uint64) (*data.StuckTransactions, error)
+	ValidateTransactionCalled                   func(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult
+	ComputeTransactionFeeCalled                 func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFee, error)
+	BuildESDTTransferCalled                     func(request *data.ESDTTransferBuildRequest, networkConfig *data.NetworkConfig) (*data.ESDTTransferBuildResult, error)
 	GetTransactionCalled                        func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionByHashAndSenderAddressCalled  func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHashCalled                func(tx *data.Transaction) (string, error)
 	GetTransactionsPoolCalled                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardCalled           func(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetTransactionsPoolForSenderCalled          func(sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error)
+	GetAgedTransactionsPoolCalled               func(olderThanSeconds uint64) (*data.AgedTransactionsPool, error)
+	GetTransactionsPoolChunkCalled              func(shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error)
+	StreamTransactionsPoolCalled                func(shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error
+	GetTransactionsPoolForSendersCalled         func(senders []string, fields string) (*data.TransactionsPoolForSenders, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
+	DecodeTransactionOperationCalled            func(tx *transaction.ApiTransactionResult) *data.TransactionOperation
+	VerifyTransactionHashCalled                 func(tx *transaction.ApiTransactionResult) (bool, error)
+	DecodeTransactionEventsCalled               func(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent
+	SetReadOnlyModeCalled                       func(enabled bool)
+	IsReadOnlyModeEnabledCalled                 func() bool
 }
 
 // SimulateTransaction -
-func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tps *TransactionProcessorStub) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	if tps.SimulateTransactionCalled != nil {
 		return tps.SimulateTransactionCalled(tx, checkSignature)
 	}
@@ -39,16 +59,16 @@ func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, c
 }
 
 // SendTransaction -
-func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tps *TransactionProcessorStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, *data.SentTransaction, error) {
 	if tps.SendTransactionCalled != nil {
 		return tps.SendTransactionCalled(tx)
 	}
 
-	return 0, "", errNotImplemented
+	return 0, nil, errNotImplemented
 }
 
 // SendMultipleTransactions -
-func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (tps *TransactionProcessorStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	if tps.SendMultipleTransactionsCalled != nil {
 		return tps.SendMultipleTransactionsCalled(txs)
 	}
@@ -75,7 +95,7 @@ func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.I
 }
 
 // GetTransactionStatus -
-func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tps *TransactionProcessorStub) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	if tps.GetTransactionStatusCalled != nil {
 		return tps.GetTransactionStatusCalled(txHash, sender)
 	}
@@ -84,7 +104,7 @@ func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender
 }
 
 // GetProcessedTransactionStatus -
-func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(_ context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	if tps.GetProcessedTransactionStatusCalled != nil {
 		return tps.GetProcessedTransactionStatusCalled(txHash)
 	}
@@ -92,8 +112,89 @@ func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string
 	return &data.ProcessStatusResponse{}, errNotImplemented
 }
 
+// GetStuckTransactionsForSender -
+func (tps *TransactionProcessorStub) GetStuckTransactionsForSender(_ context.Context, sender string, accountNonce uint64) (*data.StuckTransactions, error) {
+	if tps.GetStuckTransactionsForSenderCalled != nil {
+		return tps.GetStuckTransactionsForSenderCalled(sender, accountNonce)
+	}
+
+	return nil, nil
+}
+
+// ValidateTransaction -
+func (tps *TransactionProcessorStub) ValidateTransaction(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+	if tps.ValidateTransactionCalled != nil {
+		return tps.ValidateTransactionCalled(tx, networkConfig)
+	}
+
+	return &data.TransactionValidationResult{Valid: true}
+}
+
+// ComputeTransactionFee -
+func (tps *TransactionProcessorStub) ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFee, error) {
+	if tps.ComputeTransactionFeeCalled != nil {
+		return tps.ComputeTransactionFeeCalled(tx, networkConfig)
+	}
+
+	return &data.TransactionFee{}, nil
+}
+
+// BuildESDTTransfer -
+func (tps *TransactionProcessorStub) BuildESDTTransfer(request *data.ESDTTransferBuildRequest, networkConfig *data.NetworkConfig) (*data.ESDTTransferBuildResult, error) {
+	if tps.BuildESDTTransferCalled != nil {
+		return tps.BuildESDTTransferCalled(request, networkConfig)
+	}
+
+	return &data.ESDTTransferBuildResult{}, nil
+}
+
+// ComputeContractAddress -
+func (tps *TransactionProcessorStub) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	if tps.ComputeContractAddressCalled != nil {
+		return tps.ComputeContractAddressCalled(deployer, nonce)
+	}
+
+	return nil, nil
+}
+
+// DecodeDataField -
+func (tps *TransactionProcessorStub) DecodeDataField(dataField string) (*data.DecodedDataField, error) {
+	if tps.DecodeDataFieldCalled != nil {
+		return tps.DecodeDataFieldCalled(dataField)
+	}
+
+	return nil, nil
+}
+
+// CheckTransferReceiver -
+func (tps *TransactionProcessorStub) CheckTransferReceiver(_ context.Context, tx *data.Transaction) (*data.ReceiverCheck, error) {
+	if tps.CheckTransferReceiverCalled != nil {
+		return tps.CheckTransferReceiverCalled(tx)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionOutcome -
+func (tps *TransactionProcessorStub) GetTransactionOutcome(_ context.Context, txHash string) (*data.TransactionOutcome, error) {
+	if tps.GetTransactionOutcomeCalled != nil {
+		return tps.GetTransactionOutcomeCalled(txHash)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetTransactionJourney -
+func (tps *TransactionProcessorStub) GetTransactionJourney(_ context.Context, txHash string) (*data.TransactionJourney, error) {
+	if tps.GetTransactionJourneyCalled != nil {
+		return tps.GetTransactionJourneyCalled(txHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetTransaction -
-func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+func (tps *TransactionProcessorStub) GetTransaction(_ context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	if tps.GetTransactionCalled != nil {
 		return tps.GetTransactionCalled(txHash, withEvents)
 	}
@@ -102,7 +203,7 @@ func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bo
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	if tps.GetTransactionByHashAndSenderAddressCalled != nil {
 		return tps.GetTransactionByHashAndSenderAddressCalled(txHash, sndAddr, withEvents)
 	}
@@ -111,7 +212,7 @@ func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash
 }
 
 // TransactionCostRequest -
-func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tps *TransactionProcessorStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	if tps.TransactionCostRequestCalled != nil {
 		return tps.TransactionCostRequestCalled(tx)
 	}
@@ -120,7 +221,7 @@ func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction
 }
 
 // GetTransactionsPool -
-func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
 		return tps.GetTransactionsPoolCalled(fields)
 	}
@@ -129,7 +230,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.T
 }
 
 // GetTransactionsPoolForShard -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolForShardCalled != nil {
 		return tps.GetTransactionsPoolForShardCalled(shardID, fields)
 	}
@@ -138,16 +239,52 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32,
 }
 
 // GetTransactionsPoolForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(_ context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
 	if tps.GetTransactionsPoolForSenderCalled != nil {
-		return tps.GetTransactionsPoolForSenderCalled(sender, fields)
+		return tps.GetTransactionsPoolForSenderCalled(sender, fields, allShards)
 	}
 
 	return nil, errNotImplemented
 }
 
+// GetTransactionsPoolForSenders -
+func (tps *TransactionProcessorStub) GetTransactionsPoolForSenders(_ context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+	if tps.GetTransactionsPoolForSendersCalled != nil {
+		return tps.GetTransactionsPoolForSendersCalled(senders, fields)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetAgedTransactionsPool -
+func (tps *TransactionProcessorStub) GetAgedTransactionsPool(_ context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+	if tps.GetAgedTransactionsPoolCalled != nil {
+		return tps.GetAgedTransactionsPoolCalled(olderThanSeconds)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetTransactionsPoolChunk -
+func (tps *TransactionProcessorStub) GetTransactionsPoolChunk(_ context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+	if tps.GetTransactionsPoolChunkCalled != nil {
+		return tps.GetTransactionsPoolChunkCalled(shardID, fields, from, size)
+	}
+
+	return nil, errNotImplemented
+}
+
+// StreamTransactionsPool -
+func (tps *TransactionProcessorStub) StreamTransactionsPool(_ context.Context, shardID core.OptionalUint32, fields string, chunkSize uint32, handler func(chunk *data.TransactionsPoolChunk) error) error {
+	if tps.StreamTransactionsPoolCalled != nil {
+		return tps.StreamTransactionsPoolCalled(shardID, fields, chunkSize, handler)
+	}
+
+	return errNotImplemented
+}
+
 // GetLastPoolNonceForSender -
-func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if tps.GetLastPoolNonceForSenderCalled != nil {
 		return tps.GetLastPoolNonceForSenderCalled(sender)
 	}
@@ -156,10 +293,53 @@ func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (u
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if tps.GetTransactionsPoolNonceGapsForSenderCalled != nil {
 		return tps.GetTransactionsPoolNonceGapsForSenderCalled(sender)
 	}
 
 	return nil, errNotImplemented
 }
+
+// VerifyTransactionHash -
+func (tps *TransactionProcessorStub) VerifyTransactionHash(_ context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	if tps.VerifyTransactionHashCalled != nil {
+		return tps.VerifyTransactionHashCalled(tx)
+	}
+
+	return false, errNotImplemented
+}
+
+// DecodeTransactionOperation -
+func (tps *TransactionProcessorStub) DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation {
+	if tps.DecodeTransactionOperationCalled != nil {
+		return tps.DecodeTransactionOperationCalled(tx)
+	}
+
+	return nil
+}
+
+// DecodeTransactionEvents -
+func (tps *TransactionProcessorStub) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent {
+	if tps.DecodeTransactionEventsCalled != nil {
+		return tps.DecodeTransactionEventsCalled(tx)
+	}
+
+	return nil
+}
+
+// SetReadOnlyMode -
+func (tps *TransactionProcessorStub) SetReadOnlyMode(enabled bool) {
+	if tps.SetReadOnlyModeCalled != nil {
+		tps.SetReadOnlyModeCalled(enabled)
+	}
+}
+
+// IsReadOnlyModeEnabled -
+func (tps *TransactionProcessorStub) IsReadOnlyModeEnabled() bool {
+	if tps.IsReadOnlyModeEnabledCalled != nil {
+		return tps.IsReadOnlyModeEnabledCalled()
+	}
+
+	return false
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ValidatorKeysProcessorStub -
+type ValidatorKeysProcessorStub struct {
+	GetBLSKeyInfoCalled func(blsKey string) (*data.BLSKeyInfo, error)
+}
+
+// GetBLSKeyInfo -
+func (stub *ValidatorKeysProcessorStub) GetBLSKeyInfo(_ context.Context, blsKey string) (*data.BLSKeyInfo, error) {
+	if stub.GetBLSKeyInfoCalled != nil {
+		return stub.GetBLSKeyInfoCalled(blsKey)
+	}
+
+	return &data.BLSKeyInfo{}, nil
+}
@@ -1,18 +1,32 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ValidatorStatisticsProcessorStub -
 type ValidatorStatisticsProcessorStub struct {
-	GetValidatorStatisticsCalled func() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatisticsCalled         func() (*data.ValidatorStatisticsResponse, error)
+	GetFilteredValidatorStatisticsCalled func(options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error)
 }
 
 // GetValidatorStatistics -
-func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
 	return v.GetValidatorStatisticsCalled()
 }
 
+// GetFilteredValidatorStatistics -
+func (v *ValidatorStatisticsProcessorStub) GetFilteredValidatorStatistics(_ context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+	if v.GetFilteredValidatorStatisticsCalled != nil {
+		return v.GetFilteredValidatorStatisticsCalled(options)
+	}
+
+	return &data.ValidatorStatisticsPage{}, nil
+}
+
 // GetAuctionList -
-func (v *ValidatorStatisticsProcessorStub) GetAuctionList() (*data.AuctionListResponse, error) {
+func (v *ValidatorStatisticsProcessorStub) GetAuctionList(_ context.Context) (*data.AuctionListResponse, error) {
 	return nil, nil
 }
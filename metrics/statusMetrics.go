@@ -9,16 +9,27 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// observerLatencySmoothingFactor is the weight of the newest request when updating the recent latency of an observer
+const observerLatencySmoothingFactor = 0.2
+
+type observerRequestKey struct {
+	address string
+	method  string
+}
+
 // statusMetrics will handle displaying at /status/metrics all collected metrics
 type statusMetrics struct {
 	endpointMetrics        map[string]*data.EndpointMetrics
 	mutEndpointsOperations sync.RWMutex
+	observersLatency       map[observerRequestKey]time.Duration
+	mutObserversLatency    sync.RWMutex
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
-		endpointMetrics: make(map[string]*data.EndpointMetrics),
+		endpointMetrics:  make(map[string]*data.EndpointMetrics),
+		observersLatency: make(map[observerRequestKey]time.Duration),
 	}
 }
 
@@ -47,9 +58,11 @@ func (sm *statusMetrics) AddRequestData(path string, withError bool, duration ti
 		return
 	}
 
+	// the entry might have been created by a deprecated request, before any response time was recorded
+	isFirstRequest := currentData.NumRequests == 0
 	currentData.NumRequests++
 	currentData.NumErrors += withErrorIncrementalStep
-	if duration < currentData.LowestResponseTime {
+	if isFirstRequest || duration < currentData.LowestResponseTime {
 		currentData.LowestResponseTime = duration
 	}
 	if duration > currentData.HighestResponseTime {
@@ -58,6 +71,48 @@ func (sm *statusMetrics) AddRequestData(path string, withError bool, duration ti
 	currentData.TotalResponseTime += duration
 }
 
+// AddDeprecatedRequest counts a request of a deprecated route, including the ones rejected after the route's sunset
+func (sm *statusMetrics) AddDeprecatedRequest(path string) {
+	sm.mutEndpointsOperations.Lock()
+	defer sm.mutEndpointsOperations.Unlock()
+
+	currentData := sm.endpointMetrics[path]
+	if currentData == nil {
+		currentData = &data.EndpointMetrics{}
+		sm.endpointMetrics[path] = currentData
+	}
+
+	currentData.NumDeprecated++
+}
+
+// AddObserverRequestData updates the recent latency of the observer for the provided HTTP method. The recent latency
+// is an exponentially weighted moving average, so that it follows the changes in the observer's behavior
+func (sm *statusMetrics) AddObserverRequestData(address string, method string, duration time.Duration) {
+	key := observerRequestKey{address: address, method: method}
+
+	sm.mutObserversLatency.Lock()
+	defer sm.mutObserversLatency.Unlock()
+
+	currentLatency, exists := sm.observersLatency[key]
+	if !exists {
+		sm.observersLatency[key] = duration
+		return
+	}
+
+	newLatency := observerLatencySmoothingFactor*float64(duration) + (1-observerLatencySmoothingFactor)*float64(currentLatency)
+	sm.observersLatency[key] = time.Duration(newLatency)
+}
+
+// GetObserverLatency returns the recent latency of the observer for the provided HTTP method, if any request was recorded
+func (sm *statusMetrics) GetObserverLatency(address string, method string) (time.Duration, bool) {
+	sm.mutObserversLatency.RLock()
+	defer sm.mutObserversLatency.RUnlock()
+
+	latency, exists := sm.observersLatency[observerRequestKey{address: address, method: method}]
+
+	return latency, exists
+}
+
 // GetAll returns the metrics map
 func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetrics {
 	sm.mutEndpointsOperations.RLock()
@@ -83,7 +138,16 @@ func (sm *statusMetrics) GetMetricsForPrometheus() string {
 		stringBuilder.WriteString(fmt.Sprintf("total_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.TotalResponseTime))
 		stringBuilder.WriteString(fmt.Sprintf("highest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.HighestResponseTime))
 		stringBuilder.WriteString(fmt.Sprintf("lowest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.LowestResponseTime))
+		if endpointData.NumDeprecated > 0 {
+			stringBuilder.WriteString(fmt.Sprintf("num_deprecated_requests{endpoint=\"%s\"} %d\n", endpointPath, endpointData.NumDeprecated))
+		}
+	}
+
+	sm.mutObserversLatency.RLock()
+	for key, latency := range sm.observersLatency {
+		stringBuilder.WriteString(fmt.Sprintf("observer_latency_ns{observer=\"%s\",method=\"%s\"} %d\n", key.address, key.method, latency))
 	}
+	sm.mutObserversLatency.RUnlock()
 
 	return stringBuilder.String()
 }
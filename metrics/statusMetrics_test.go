@@ -129,6 +129,52 @@ lowest_response_time_ns{endpoint="/network/config"} 2000000
 	require.Equal(t, expectedString, res)
 }
 
+func TestStatusMetrics_AddDeprecatedRequest(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+
+	testEndpoint := "/address/:address/nonce"
+	sm.AddDeprecatedRequest(testEndpoint)
+	sm.AddRequestData(testEndpoint, false, 5*time.Millisecond)
+	sm.AddDeprecatedRequest(testEndpoint)
+	sm.AddRequestData(testEndpoint, true, 10*time.Millisecond)
+
+	require.Equal(t, &data.EndpointMetrics{
+		NumRequests:         2,
+		NumErrors:           1,
+		TotalResponseTime:   15 * time.Millisecond,
+		LowestResponseTime:  5 * time.Millisecond,
+		HighestResponseTime: 10 * time.Millisecond,
+		NumDeprecated:       2,
+	}, sm.GetAll()[testEndpoint])
+	require.Contains(t, sm.GetMetricsForPrometheus(), `num_deprecated_requests{endpoint="/address/:address/nonce"} 2`)
+}
+
+func TestStatusMetrics_ObserverLatency(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+
+	_, exists := sm.GetObserverLatency("observer", "GET")
+	require.False(t, exists)
+
+	sm.AddObserverRequestData("observer", "GET", 100*time.Millisecond)
+	sm.AddObserverRequestData("observer", "POST", 10*time.Millisecond)
+	latency, exists := sm.GetObserverLatency("observer", "GET")
+	require.True(t, exists)
+	require.Equal(t, 100*time.Millisecond, latency)
+
+	sm.AddObserverRequestData("observer", "GET", 200*time.Millisecond)
+	latency, _ = sm.GetObserverLatency("observer", "GET")
+	require.Equal(t, 120*time.Millisecond, latency)
+
+	latency, _ = sm.GetObserverLatency("observer", "POST")
+	require.Equal(t, 10*time.Millisecond, latency)
+
+	require.Contains(t, sm.GetMetricsForPrometheus(), `observer_latency_ns{observer="observer",method="POST"} 10000000`)
+}
+
 func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 	t.Parallel()
 
@@ -152,6 +198,9 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 				delete(res, "endpoint_0")
 			case 2:
 				_ = sm.GetMetricsForPrometheus()
+			case 3:
+				sm.AddObserverRequestData("observer", "GET", time.Millisecond*time.Duration(index))
+				_, _ = sm.GetObserverLatency("observer", "GET")
 			}
 
 			wg.Done()
@@ -16,8 +16,10 @@ type baseNodeProvider struct {
 	shardIds              []uint32
 	numOfShards           uint32
 	configurationFilePath string
+	observersCredentials  []data.ObserverCredentials
 	regularNodes          NodesHolder
 	snapshotlessNodes     NodesHolder
+	warmUp                *nodesWarmUp
 }
 
 func (bnp *baseNodeProvider) initNodes(nodes []*data.NodeData) error {
@@ -88,6 +90,10 @@ func (bnp *baseNodeProvider) GetAllNodesWithSyncState() []*data.NodeData {
 	bnp.mutNodes.RLock()
 	defer bnp.mutNodes.RUnlock()
 
+	return bnp.getAllNodesWithSyncStateUnprotected()
+}
+
+func (bnp *baseNodeProvider) getAllNodesWithSyncStateUnprotected() []*data.NodeData {
 	nodesSlice := make([]*data.NodeData, 0)
 	for _, shardID := range bnp.shardIds {
 		nodesSlice = append(nodesSlice, bnp.regularNodes.GetSyncedNodes(shardID)...)
@@ -112,6 +118,20 @@ func (bnp *baseNodeProvider) UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*
 	regularNodes, snapshotlessNodes := splitNodesByDataAvailability(nodesWithSyncStatus)
 	bnp.regularNodes.UpdateNodes(regularNodes)
 	bnp.snapshotlessNodes.UpdateNodes(snapshotlessNodes)
+
+	if bnp.warmUp != nil {
+		bnp.warmUp.updateSyncStates(nodesWithSyncStatus)
+	}
+}
+
+// ApplyWarmUp lowers the traffic share of the nodes which recently recovered, if the warm-up is enabled. It should be
+// called on the final order of the nodes, as any later reordering would undo it
+func (bnp *baseNodeProvider) ApplyWarmUp(nodes []*data.NodeData) []*data.NodeData {
+	if bnp.warmUp == nil {
+		return nodes
+	}
+
+	return bnp.warmUp.applyWarmUp(nodes)
 }
 
 // PrintNodesInShards will only print the nodes in shards
@@ -152,6 +172,19 @@ func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesRelo
 	if nodesType == data.FullHistoryNode {
 		nodes = newConfig.FullHistoryNodes
 	}
+	data.ApplyObserversCredentials(nodes, bnp.observersCredentials)
+
+	// the shards are only detected when the proxy starts or through the admin API, so reloading a node without a
+	// declared shard would route it to shard 0
+	for _, node := range nodes {
+		if node.AutoDetectShard {
+			return data.NodesReloadResponse{
+				OkRequest:   true,
+				Description: "not reloaded",
+				Error:       "cannot reload observer " + node.Address + " without a declared shard",
+			}
+		}
+	}
 
 	newNodes := nodesSliceToShardedMap(nodes)
 
@@ -186,6 +219,100 @@ func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesRelo
 	}
 }
 
+// AddNode will add the provided node to the live list of nodes
+func (bnp *baseNodeProvider) AddNode(node *data.NodeData) error {
+	isMeta := node.ShardId == core.MetachainShardId
+	if !isMeta && node.ShardId >= bnp.numOfShards {
+		return fmt.Errorf("%w for observer %s, provided shard %d, number of shards configured %d",
+			ErrInvalidShard,
+			node.Address,
+			node.ShardId,
+			bnp.numOfShards,
+		)
+	}
+
+	bnp.mutNodes.Lock()
+	defer bnp.mutNodes.Unlock()
+
+	nodes := bnp.getAllNodesWithSyncStateUnprotected()
+	for _, existingNode := range nodes {
+		if existingNode.Address == node.Address {
+			return fmt.Errorf("%w: %s", ErrNodeAlreadyExists, node.Address)
+		}
+	}
+
+	err := bnp.replaceNodesUnprotected(append(nodes, node))
+	if err != nil {
+		return err
+	}
+
+	log.Info("node added", "address", node.Address, "shard", node.ShardId)
+
+	return nil
+}
+
+// RemoveNode will remove the node with the provided address from the live list of nodes
+func (bnp *baseNodeProvider) RemoveNode(address string) error {
+	bnp.mutNodes.Lock()
+	defer bnp.mutNodes.Unlock()
+
+	nodes := bnp.getAllNodesWithSyncStateUnprotected()
+	remainingNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Address != address {
+			remainingNodes = append(remainingNodes, node)
+		}
+	}
+	if len(remainingNodes) == len(nodes) {
+		return fmt.Errorf("%w: %s", ErrNodeNotFound, address)
+	}
+
+	err := bnp.replaceNodesUnprotected(remainingNodes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("node removed", "address", address)
+
+	return nil
+}
+
+func (bnp *baseNodeProvider) replaceNodesUnprotected(nodes []*data.NodeData) error {
+	newNodes := nodesSliceToShardedMap(nodes)
+	for _, shardID := range bnp.shardIds {
+		_, exists := newNodes[shardID]
+		if !exists {
+			return fmt.Errorf("%w for shard %d", ErrLastNodeInShard, shardID)
+		}
+	}
+
+	err := checkNodesInShards(newNodes)
+	if err != nil {
+		return err
+	}
+
+	syncedNodes, syncedFallbackNodes, syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes := initAllNodesSlice(newNodes)
+	regularNodes, err := holder.NewNodesHolder(syncedNodes, syncedFallbackNodes, data.AvailabilityAll)
+	if err != nil {
+		return err
+	}
+	snapshotlessNodes, err := holder.NewNodesHolder(syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes, data.AvailabilityRecent)
+	if err != nil {
+		return err
+	}
+
+	// the new holders consider all nodes as synced, so the known sync state has to be re-applied
+	regularNodesWithSyncState, snapshotlessNodesWithSyncState := splitNodesByDataAvailability(nodes)
+	regularNodes.UpdateNodes(regularNodesWithSyncState)
+	snapshotlessNodes.UpdateNodes(snapshotlessNodesWithSyncState)
+
+	bnp.shardIds = getSortedShardIDsSlice(newNodes)
+	bnp.regularNodes = regularNodes
+	bnp.snapshotlessNodes = snapshotlessNodes
+
+	return nil
+}
+
 func (bnp *baseNodeProvider) getSyncedNodesForShardUnprotected(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 	var syncedNodes []*data.NodeData
 
@@ -111,6 +111,30 @@ func TestBaseNodeProvider_ReloadNodesShouldWork(t *testing.T) {
 		require.True(t, response.OkRequest)
 		require.Empty(t, response.Error)
 	})
+	t.Run("should apply the observers credentials", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := &baseNodeProvider{
+			configurationFilePath: configurationPath,
+			observersCredentials: []data.ObserverCredentials{
+				{Address: "observer-shard-1", Username: "user", Password: "pass"},
+				{Address: "observer-shard-2", BearerToken: "token"},
+			},
+			numOfShards: 3,
+		}
+
+		response := bnp.ReloadNodes(data.Observer)
+		require.Empty(t, response.Error)
+
+		nodesByAddress := make(map[string]*data.NodeData)
+		for _, node := range bnp.GetAllNodesWithSyncState() {
+			nodesByAddress[node.Address] = node
+		}
+		require.False(t, nodesByAddress["observer-shard-0"].HasCredentials())
+		require.Equal(t, "user", nodesByAddress["observer-shard-1"].Username)
+		require.Equal(t, "pass", nodesByAddress["observer-shard-1"].Password)
+		require.Equal(t, "token", nodesByAddress["observer-shard-2"].BearerToken)
+	})
 }
 
 func TestBaseNodeProvider_prepareReloadResponseMessage(t *testing.T) {
@@ -413,3 +437,97 @@ func TestBaseNodeProvider_getSyncedNodesForShardUnprotected(t *testing.T) {
 	require.Equal(t, "addr0-snapshotless", nodes[0].Address)
 	require.False(t, nodes[0].IsSynced)
 }
+
+func TestBaseNodeProvider_AddNode(t *testing.T) {
+	t.Parallel()
+
+	createProvider := func() *baseNodeProvider {
+		bnp := &baseNodeProvider{
+			numOfShards: 2,
+		}
+		err := bnp.initNodes([]*data.NodeData{
+			{Address: "addr0", ShardId: 0},
+			{Address: "addr1", ShardId: 1},
+		})
+		require.NoError(t, err)
+
+		return bnp
+	}
+
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		err := bnp.AddNode(&data.NodeData{Address: "addr2", ShardId: 2})
+		require.True(t, errors.Is(err, ErrInvalidShard))
+	})
+	t.Run("duplicated address should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		err := bnp.AddNode(&data.NodeData{Address: "addr1", ShardId: 1})
+		require.True(t, errors.Is(err, ErrNodeAlreadyExists))
+	})
+	t.Run("should work and keep the sync state of the existing nodes", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		bnp.UpdateNodesBasedOnSyncState([]*data.NodeData{
+			{Address: "addr0", ShardId: 0, IsSynced: false},
+			{Address: "addr1", ShardId: 1, IsSynced: true},
+		})
+
+		err := bnp.AddNode(&data.NodeData{Address: "addr2", ShardId: 0, IsSynced: true})
+		require.NoError(t, err)
+		require.Len(t, bnp.GetAllNodesWithSyncState(), 3)
+
+		syncedNodes, err := bnp.getSyncedNodesForShardUnprotected(0, data.AvailabilityAll)
+		require.NoError(t, err)
+		require.Equal(t, []*data.NodeData{{Address: "addr2", ShardId: 0, IsSynced: true}}, syncedNodes)
+	})
+}
+
+func TestBaseNodeProvider_RemoveNode(t *testing.T) {
+	t.Parallel()
+
+	createProvider := func() *baseNodeProvider {
+		bnp := &baseNodeProvider{
+			numOfShards: 2,
+		}
+		err := bnp.initNodes([]*data.NodeData{
+			{Address: "addr0", ShardId: 0},
+			{Address: "addr1", ShardId: 1},
+			{Address: "addr2", ShardId: 1},
+		})
+		require.NoError(t, err)
+
+		return bnp
+	}
+
+	t.Run("unknown address should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		err := bnp.RemoveNode("addr3")
+		require.True(t, errors.Is(err, ErrNodeNotFound))
+	})
+	t.Run("last node in shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		err := bnp.RemoveNode("addr0")
+		require.True(t, errors.Is(err, ErrLastNodeInShard))
+		require.Len(t, bnp.GetAllNodesWithSyncState(), 3)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createProvider()
+		err := bnp.RemoveNode("addr1")
+		require.NoError(t, err)
+
+		nodes, err := bnp.getSyncedNodesForShardUnprotected(1, data.AvailabilityAll)
+		require.NoError(t, err)
+		require.Equal(t, []*data.NodeData{{Address: "addr2", ShardId: 1, IsSynced: true}}, nodes)
+	})
+}
@@ -1,6 +1,8 @@
 package observer
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/mapCounters"
 )
@@ -16,11 +18,15 @@ type circularQueueNodesProvider struct {
 func NewCircularQueueNodesProvider(
 	observers []*data.NodeData,
 	configurationFilePath string,
+	observersCredentials []data.ObserverCredentials,
 	numberOfShards uint32,
+	warmUpDuration time.Duration,
 ) (*circularQueueNodesProvider, error) {
 	bop := &baseNodeProvider{
 		configurationFilePath: configurationFilePath,
+		observersCredentials:  observersCredentials,
 		numOfShards:           numberOfShards,
+		warmUp:                newNodesWarmUp(warmUpDuration),
 	}
 
 	err := bop.initNodes(observers)
@@ -71,7 +77,7 @@ func (cqnp *circularQueueNodesProvider) GetAllNodes(dataAvailability data.Observ
 
 	sliceToRet := append(allNodes[position:], allNodes[:position]...)
 
-	return sliceToRet, nil
+	return cqnp.ApplyWarmUp(sliceToRet), nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -39,11 +39,26 @@ func (d *disabledNodesProvider) GetAllNodes(_ data.ObserverDataAvailabilityType)
 	return nil, errors.New(d.returnMessage)
 }
 
+// ApplyWarmUp returns the provided nodes as they are
+func (d *disabledNodesProvider) ApplyWarmUp(nodes []*data.NodeData) []*data.NodeData {
+	return nodes
+}
+
 // ReloadNodes return the desired return message as an error
 func (d *disabledNodesProvider) ReloadNodes(_ data.NodeType) data.NodesReloadResponse {
 	return data.NodesReloadResponse{Description: "disabled nodes provider", Error: d.returnMessage}
 }
 
+// AddNode returns the desired return message as an error
+func (d *disabledNodesProvider) AddNode(_ *data.NodeData) error {
+	return errors.New(d.returnMessage)
+}
+
+// RemoveNode returns the desired return message as an error
+func (d *disabledNodesProvider) RemoveNode(_ string) error {
+	return errors.New(d.returnMessage)
+}
+
 // PrintNodesInShards does nothing as it is disabled
 func (d *disabledNodesProvider) PrintNodesInShards() {
 }
@@ -10,3 +10,12 @@ var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in
 
 // ErrInvalidShard signals that an invalid shard has been provided
 var ErrInvalidShard = errors.New("invalid shard")
+
+// ErrNodeAlreadyExists signals that a node with the same address is already registered
+var ErrNodeAlreadyExists = errors.New("node already exists")
+
+// ErrNodeNotFound signals that no node with the provided address is registered
+var ErrNodeNotFound = errors.New("node not found")
+
+// ErrLastNodeInShard signals that the operation would leave a shard without any node
+var ErrLastNodeInShard = errors.New("cannot remove the last node of a shard")
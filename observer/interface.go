@@ -6,9 +6,12 @@ import "github.com/multiversx/mx-chain-proxy-go/data"
 type NodesProviderHandler interface {
 	GetNodesByShardId(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	ApplyWarmUp(nodes []*data.NodeData) []*data.NodeData
 	UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncState() []*data.NodeData
 	ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse
+	AddNode(node *data.NodeData) error
+	RemoveNode(address string) error
 	PrintNodesInShards()
 	IsInterfaceNil() bool
 }
@@ -1,8 +1,11 @@
 package observer
 
 import (
+	"time"
+
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 var log = logger.GetOrCreate("observer")
@@ -11,14 +14,22 @@ var log = logger.GetOrCreate("observer")
 type nodesProviderFactory struct {
 	cfg                   config.Config
 	configurationFilePath string
+	observersCredentials  []data.ObserverCredentials
 	numberOfShards        uint32
 }
 
-// NewNodesProviderFactory returns a new instance of nodesProviderFactory
-func NewNodesProviderFactory(cfg config.Config, configurationFilePath string, numberOfShards uint32) (*nodesProviderFactory, error) {
+// NewNodesProviderFactory returns a new instance of nodesProviderFactory. The provided observers credentials are applied
+// again to the nodes reloaded from the configuration file
+func NewNodesProviderFactory(
+	cfg config.Config,
+	configurationFilePath string,
+	observersCredentials []data.ObserverCredentials,
+	numberOfShards uint32,
+) (*nodesProviderFactory, error) {
 	return &nodesProviderFactory{
 		cfg:                   cfg,
 		configurationFilePath: configurationFilePath,
+		observersCredentials:  observersCredentials,
 		numberOfShards:        numberOfShards,
 	}, nil
 }
@@ -29,13 +40,17 @@ func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error)
 		return NewCircularQueueNodesProvider(
 			npf.cfg.Observers,
 			npf.configurationFilePath,
-			npf.numberOfShards)
+			npf.observersCredentials,
+			npf.numberOfShards,
+			npf.getWarmUpDuration())
 	}
 
 	return NewSimpleNodesProvider(
 		npf.cfg.Observers,
 		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.observersCredentials,
+		npf.numberOfShards,
+		npf.getWarmUpDuration())
 }
 
 // CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on a flag
@@ -44,7 +59,9 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 		nodesProviderHandler, err := NewCircularQueueNodesProvider(
 			npf.cfg.FullHistoryNodes,
 			npf.configurationFilePath,
-			npf.numberOfShards)
+			npf.observersCredentials,
+			npf.numberOfShards,
+			npf.getWarmUpDuration())
 		if err != nil {
 			return getDisabledFullHistoryNodesProviderIfNeeded(err)
 		}
@@ -55,7 +72,9 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	nodesProviderHandler, err := NewSimpleNodesProvider(
 		npf.cfg.FullHistoryNodes,
 		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.observersCredentials,
+		npf.numberOfShards,
+		npf.getWarmUpDuration())
 	if err != nil {
 		return getDisabledFullHistoryNodesProviderIfNeeded(err)
 	}
@@ -63,6 +82,10 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	return nodesProviderHandler, nil
 }
 
+func (npf *nodesProviderFactory) getWarmUpDuration() time.Duration {
+	return time.Duration(npf.cfg.GeneralSettings.ObserverWarmUpDurationSec) * time.Second
+}
+
 func getDisabledFullHistoryNodesProviderIfNeeded(err error) (NodesProviderHandler, error) {
 	if err == ErrEmptyObserversList {
 		log.Warn("no configuration found for full history nodes. Calls to endpoints specific to full history nodes " +
@@ -0,0 +1,110 @@
+package observer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// minWarmUpWeight is the traffic share a recovered node starts with, relative to a warmed up node
+const minWarmUpWeight = 0.1
+
+// nodesWarmUp keeps track of the nodes which recovered from an out of sync state and computes the traffic share they
+// should receive. The share grows linearly from minWarmUpWeight to the full share during the warm-up duration, so
+// that a node which is still syncing its caches is not overwhelmed
+type nodesWarmUp struct {
+	mut            sync.RWMutex
+	duration       time.Duration
+	lastSyncStates map[string]bool
+	recoveredAt    map[string]time.Time
+	getTimeHandler func() time.Time
+	randomHandler  func() float64
+}
+
+func newNodesWarmUp(duration time.Duration) *nodesWarmUp {
+	return &nodesWarmUp{
+		duration:       duration,
+		lastSyncStates: make(map[string]bool),
+		recoveredAt:    make(map[string]time.Time),
+		getTimeHandler: time.Now,
+		randomHandler:  rand.Float64,
+	}
+}
+
+// updateSyncStates starts the warm-up of the nodes which were out of sync and are now synced
+func (nwu *nodesWarmUp) updateSyncStates(nodes []*data.NodeData) {
+	if nwu.duration <= 0 {
+		return
+	}
+
+	nwu.mut.Lock()
+	defer nwu.mut.Unlock()
+
+	now := nwu.getTimeHandler()
+	for address, recoveryTime := range nwu.recoveredAt {
+		if now.Sub(recoveryTime) >= nwu.duration {
+			delete(nwu.recoveredAt, address)
+			log.Info("observer warm-up finished", "address", address)
+		}
+	}
+
+	for _, node := range nodes {
+		wasSynced, isKnown := nwu.lastSyncStates[node.Address]
+		nwu.lastSyncStates[node.Address] = node.IsSynced
+
+		if !node.IsSynced {
+			delete(nwu.recoveredAt, node.Address)
+			continue
+		}
+		if isKnown && !wasSynced {
+			nwu.recoveredAt[node.Address] = now
+			log.Info("observer recovered, starting warm-up", "address", node.Address, "shard", node.ShardId, "duration", nwu.duration)
+		}
+	}
+}
+
+// weight returns the traffic share of the node, between minWarmUpWeight and 1
+func (nwu *nodesWarmUp) weight(address string) float64 {
+	nwu.mut.RLock()
+	recoveryTime, isWarmingUp := nwu.recoveredAt[address]
+	nwu.mut.RUnlock()
+
+	if !isWarmingUp {
+		return 1
+	}
+
+	progress := float64(nwu.getTimeHandler().Sub(recoveryTime)) / float64(nwu.duration)
+	if progress >= 1 {
+		return 1
+	}
+
+	return minWarmUpWeight + (1-minWarmUpWeight)*progress
+}
+
+// applyWarmUp returns the list of nodes in which each warming up node keeps its position with a probability equal to
+// its weight, being moved after the other nodes otherwise. The nodes are never removed, so a warming up node still
+// serves the requests if the other nodes fail
+func (nwu *nodesWarmUp) applyWarmUp(nodes []*data.NodeData) []*data.NodeData {
+	nwu.mut.RLock()
+	numWarmingUp := len(nwu.recoveredAt)
+	nwu.mut.RUnlock()
+
+	if numWarmingUp == 0 || len(nodes) < 2 {
+		return nodes
+	}
+
+	keptNodes := make([]*data.NodeData, 0, len(nodes))
+	demotedNodes := make([]*data.NodeData, 0)
+	for _, node := range nodes {
+		if nwu.randomHandler() >= nwu.weight(node.Address) {
+			demotedNodes = append(demotedNodes, node)
+			continue
+		}
+
+		keptNodes = append(keptNodes, node)
+	}
+
+	return append(keptNodes, demotedNodes...)
+}
@@ -0,0 +1,128 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createWarmUpWithClock(duration time.Duration, now *time.Time) *nodesWarmUp {
+	warmUp := newNodesWarmUp(duration)
+	warmUp.getTimeHandler = func() time.Time {
+		return *now
+	}
+
+	return warmUp
+}
+
+func TestNodesWarmUp_Weight(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled warm-up should keep the full share", func(t *testing.T) {
+		t.Parallel()
+
+		warmUp := newNodesWarmUp(0)
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: false}})
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+
+		assert.Equal(t, float64(1), warmUp.weight("obs0"))
+	})
+	t.Run("node synced from the start should keep the full share", func(t *testing.T) {
+		t.Parallel()
+
+		warmUp := newNodesWarmUp(time.Minute)
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+
+		assert.Equal(t, float64(1), warmUp.weight("obs0"))
+	})
+	t.Run("recovered node should gradually receive the full share", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		warmUp := createWarmUpWithClock(100*time.Second, &now)
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: false}})
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+		assert.InDelta(t, minWarmUpWeight, warmUp.weight("obs0"), 0.0001)
+
+		now = now.Add(50 * time.Second)
+		assert.InDelta(t, 0.55, warmUp.weight("obs0"), 0.0001)
+
+		now = now.Add(50 * time.Second)
+		assert.Equal(t, float64(1), warmUp.weight("obs0"))
+
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+		assert.Empty(t, warmUp.recoveredAt)
+	})
+	t.Run("node going out of sync again should stop the warm-up", func(t *testing.T) {
+		t.Parallel()
+
+		warmUp := newNodesWarmUp(time.Minute)
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: false}})
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: true}})
+		require.Less(t, warmUp.weight("obs0"), float64(1))
+
+		warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: false}})
+		assert.Equal(t, float64(1), warmUp.weight("obs0"))
+	})
+}
+
+func TestNodesWarmUp_ApplyWarmUp(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "obs0", IsSynced: true},
+		{Address: "obs1", IsSynced: true},
+		{Address: "obs2", IsSynced: true},
+	}
+	warmUp := newNodesWarmUp(time.Hour)
+	warmUp.updateSyncStates([]*data.NodeData{{Address: "obs0", IsSynced: false}})
+	warmUp.updateSyncStates(nodes)
+
+	warmUp.randomHandler = func() float64 {
+		return 0.5
+	}
+	result := warmUp.applyWarmUp(nodes)
+	assert.Equal(t, []*data.NodeData{nodes[1], nodes[2], nodes[0]}, result)
+
+	warmUp.randomHandler = func() float64 {
+		return 0.01
+	}
+	result = warmUp.applyWarmUp(nodes)
+	assert.Equal(t, nodes, result)
+}
+
+func TestCircularQueueNodesProvider_WarmUpAfterRecovery(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	cqnp, err := NewCircularQueueNodesProvider(nodes, "path", nil, 1, time.Hour)
+	require.Nil(t, err)
+	cqnp.warmUp.randomHandler = func() float64 {
+		return 0.5
+	}
+
+	cqnp.UpdateNodesBasedOnSyncState([]*data.NodeData{
+		{Address: "obs0", ShardId: 0, IsSynced: false},
+		{Address: "obs1", ShardId: 0, IsSynced: true},
+	})
+	cqnp.UpdateNodesBasedOnSyncState([]*data.NodeData{
+		{Address: "obs0", ShardId: 0, IsSynced: true},
+		{Address: "obs1", ShardId: 0, IsSynced: true},
+	})
+
+	for i := 0; i < 4; i++ {
+		result, errGet := cqnp.GetNodesByShardId(0, data.AvailabilityAll)
+		require.Nil(t, errGet)
+		require.Equal(t, 2, len(result))
+
+		result = cqnp.ApplyWarmUp(result)
+		assert.Equal(t, "obs1", result[0].Address)
+	}
+}
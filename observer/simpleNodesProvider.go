@@ -1,6 +1,8 @@
 package observer
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -14,11 +16,15 @@ type simpleNodesProvider struct {
 func NewSimpleNodesProvider(
 	observers []*data.NodeData,
 	configurationFilePath string,
+	observersCredentials []data.ObserverCredentials,
 	numberOfShards uint32,
+	warmUpDuration time.Duration,
 ) (*simpleNodesProvider, error) {
 	bop := &baseNodeProvider{
 		configurationFilePath: configurationFilePath,
+		observersCredentials:  observersCredentials,
 		numOfShards:           numberOfShards,
+		warmUp:                newNodesWarmUp(warmUpDuration),
 	}
 
 	err := bop.initNodes(observers)
@@ -44,7 +50,12 @@ func (snp *simpleNodesProvider) GetAllNodes(dataAvailability data.ObserverDataAv
 	snp.mutNodes.RLock()
 	defer snp.mutNodes.RUnlock()
 
-	return snp.getSyncedNodesUnprotected(dataAvailability)
+	nodes, err := snp.getSyncedNodesUnprotected(dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return snp.ApplyWarmUp(nodes), nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
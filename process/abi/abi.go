@@ -0,0 +1,150 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	customTypeStruct = "struct"
+	customTypeEnum   = "enum"
+)
+
+// ContractABI holds the parts of a smart contract ABI, as generated by the Rust framework, used to decode the events
+type ContractABI struct {
+	Name   string                     `json:"name"`
+	Events []*EventDefinition         `json:"events"`
+	Types  map[string]*TypeDefinition `json:"types"`
+}
+
+// EventDefinition describes an event logged by the contract. The indexed inputs are found in the topics following the
+// event identifier, while the non-indexed one is found in the data field
+type EventDefinition struct {
+	Identifier string        `json:"identifier"`
+	Inputs     []*EventInput `json:"inputs"`
+}
+
+// EventInput describes a field of an event
+type EventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+// TypeDefinition describes a custom struct or enum type declared by the contract
+type TypeDefinition struct {
+	Type     string               `json:"type"`
+	Fields   []*FieldDefinition   `json:"fields"`
+	Variants []*VariantDefinition `json:"variants"`
+}
+
+// FieldDefinition describes a field of a struct or of an enum variant
+type FieldDefinition struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// VariantDefinition describes a variant of an enum
+type VariantDefinition struct {
+	Name         string             `json:"name"`
+	Discriminant uint8              `json:"discriminant"`
+	Fields       []*FieldDefinition `json:"fields"`
+}
+
+// ParseContractABI parses the JSON ABI of a contract, checking that the events and the custom types are well defined
+func ParseContractABI(abiBytes []byte) (*ContractABI, error) {
+	contractABI := &ContractABI{}
+	err := json.Unmarshal(abiBytes, contractABI)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABI, err.Error())
+	}
+
+	for _, event := range contractABI.Events {
+		if event == nil || len(event.Identifier) == 0 {
+			return nil, fmt.Errorf("%w: event without identifier", ErrInvalidABI)
+		}
+
+		numDataInputs := 0
+		for _, input := range event.Inputs {
+			if input == nil || len(input.Name) == 0 || len(input.Type) == 0 {
+				return nil, fmt.Errorf("%w: event %s has an input without name or type", ErrInvalidABI, event.Identifier)
+			}
+			if !input.Indexed {
+				numDataInputs++
+			}
+		}
+		if numDataInputs > 1 {
+			return nil, fmt.Errorf("%w: event %s has more than one non-indexed input", ErrInvalidABI, event.Identifier)
+		}
+	}
+
+	for name, typeDefinition := range contractABI.Types {
+		if typeDefinition == nil {
+			return nil, fmt.Errorf("%w: type %s is not defined", ErrInvalidABI, name)
+		}
+		if typeDefinition.Type != customTypeStruct && typeDefinition.Type != customTypeEnum {
+			return nil, fmt.Errorf("%w: type %s is neither a struct, nor an enum", ErrInvalidABI, name)
+		}
+	}
+
+	return contractABI, nil
+}
+
+// typeExpression is a parsed ABI type, such as List<Option<BigUint>>
+type typeExpression struct {
+	name      string
+	arguments []*typeExpression
+}
+
+func parseTypeExpression(typeStr string) (*typeExpression, error) {
+	typeStr = strings.TrimSpace(typeStr)
+	openIndex := strings.Index(typeStr, "<")
+	if openIndex < 0 {
+		if len(typeStr) == 0 || strings.ContainsAny(typeStr, ">,") {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, typeStr)
+		}
+
+		return &typeExpression{name: typeStr}, nil
+	}
+	if !strings.HasSuffix(typeStr, ">") || openIndex == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, typeStr)
+	}
+
+	expression := &typeExpression{
+		name: typeStr[:openIndex],
+	}
+	argumentsStr := typeStr[openIndex+1 : len(typeStr)-1]
+	depth := 0
+	argumentStart := 0
+	for idx, c := range argumentsStr {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth != 0 {
+				continue
+			}
+
+			argument, err := parseTypeExpression(argumentsStr[argumentStart:idx])
+			if err != nil {
+				return nil, err
+			}
+			expression.arguments = append(expression.arguments, argument)
+			argumentStart = idx + 1
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, typeStr)
+	}
+
+	argument, err := parseTypeExpression(argumentsStr[argumentStart:])
+	if err != nil {
+		return nil, err
+	}
+	expression.arguments = append(expression.arguments, argument)
+
+	return expression, nil
+}
@@ -0,0 +1,108 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPairABI = `{
+	"name": "Pair",
+	"endpoints": [],
+	"events": [
+		{
+			"identifier": "swap",
+			"inputs": [
+				{"name": "token_in", "type": "TokenIdentifier", "indexed": true},
+				{"name": "caller", "type": "Address", "indexed": true},
+				{"name": "epoch", "type": "u64", "indexed": true},
+				{"name": "swap_event", "type": "SwapEvent"}
+			]
+		},
+		{
+			"identifier": "pause",
+			"inputs": [
+				{"name": "state", "type": "State", "indexed": true}
+			]
+		}
+	],
+	"types": {
+		"SwapEvent": {
+			"type": "struct",
+			"fields": [
+				{"name": "amount_in", "type": "BigUint"},
+				{"name": "amount_out", "type": "BigUint"},
+				{"name": "fee", "type": "Option<BigUint>"},
+				{"name": "path", "type": "List<TokenIdentifier>"},
+				{"name": "state", "type": "State"}
+			]
+		},
+		"State": {
+			"type": "enum",
+			"variants": [
+				{"name": "Inactive", "discriminant": 0},
+				{"name": "Active", "discriminant": 1},
+				{"name": "PartialActive", "discriminant": 2, "fields": [{"name": "0", "type": "u32"}]}
+			]
+		}
+	}
+}`
+
+func TestParseContractABI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid ABIs should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidABIs := []string{
+			`not a json`,
+			`{"events": [{"identifier": ""}]}`,
+			`{"events": [{"identifier": "swap", "inputs": [{"name": "amount"}]}]}`,
+			`{"events": [{"identifier": "swap", "inputs": [{"name": "a", "type": "u8"}, {"name": "b", "type": "u8"}]}]}`,
+			`{"types": {"Pair": {"type": "union"}}}`,
+		}
+		for _, invalidABI := range invalidABIs {
+			contractABI, err := ParseContractABI([]byte(invalidABI))
+			assert.Nil(t, contractABI, invalidABI)
+			assert.True(t, errors.Is(err, ErrInvalidABI), invalidABI)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		contractABI, err := ParseContractABI([]byte(testPairABI))
+		require.NoError(t, err)
+		assert.Equal(t, "Pair", contractABI.Name)
+		require.Len(t, contractABI.Events, 2)
+		assert.Equal(t, "swap", contractABI.Events[0].Identifier)
+		assert.Len(t, contractABI.Events[0].Inputs, 4)
+		assert.Equal(t, customTypeStruct, contractABI.Types["SwapEvent"].Type)
+		assert.Len(t, contractABI.Types["State"].Variants, 3)
+	})
+}
+
+func TestParseTypeExpression(t *testing.T) {
+	t.Parallel()
+
+	expression, err := parseTypeExpression("tuple<List<Option<BigUint>>, u64>")
+	require.NoError(t, err)
+	assert.Equal(t, &typeExpression{
+		name: "tuple",
+		arguments: []*typeExpression{
+			{
+				name: "List",
+				arguments: []*typeExpression{
+					{name: "Option", arguments: []*typeExpression{{name: "BigUint"}}},
+				},
+			},
+			{name: "u64"},
+		},
+	}, expression)
+
+	for _, invalidType := range []string{"", "List<u8", "List<>", "<u8>", "u8>", "tuple<u8,>"} {
+		_, err = parseTypeExpression(invalidType)
+		assert.True(t, errors.Is(err, ErrUnsupportedType), invalidType)
+	}
+}
@@ -0,0 +1,398 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	addressLength   = 32
+	hashLength      = 32
+	lengthPrefixLen = 4
+	maxNestingDepth = 32
+	arrayTypePrefix = "array"
+)
+
+var integerSizes = map[string]int{
+	"u8":    1,
+	"u16":   2,
+	"u32":   4,
+	"u64":   8,
+	"usize": 4,
+	"i8":    1,
+	"i16":   2,
+	"i32":   4,
+	"i64":   8,
+	"isize": 4,
+}
+
+var stringTypes = map[string]struct{}{
+	"TokenIdentifier":           {},
+	"EgldOrEsdtTokenIdentifier": {},
+	"utf-8 string":              {},
+	"String":                    {},
+	"&str":                      {},
+}
+
+var bytesTypes = map[string]struct{}{
+	"bytes":         {},
+	"ManagedBuffer": {},
+	"BoxedBytes":    {},
+}
+
+var listTypes = map[string]struct{}{
+	"List":       {},
+	"vec":        {},
+	"Vec":        {},
+	"ManagedVec": {},
+}
+
+// valueDecoder decodes the values serialized with the codec of the Rust framework into JSON friendly values: the
+// integers which fit 64 bits are returned as numbers, the big integers as decimal strings, the addresses as bech32
+// strings, the buffers as hex strings, the structs as objects and the lists as arrays
+type valueDecoder struct {
+	types           map[string]*TypeDefinition
+	pubKeyConverter core.PubkeyConverter
+}
+
+func (vd *valueDecoder) decodeTopEncoded(typeStr string, buff []byte) (interface{}, error) {
+	expression, err := parseTypeExpression(typeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return vd.decodeTop(expression, buff, 0)
+}
+
+func (vd *valueDecoder) decodeTop(expression *typeExpression, buff []byte, depth int) (interface{}, error) {
+	if depth > maxNestingDepth {
+		return nil, fmt.Errorf("%w: nesting too deep", ErrUnsupportedType)
+	}
+
+	name := expression.name
+	size, isInteger := integerSizes[name]
+	switch {
+	case isInteger:
+		if len(buff) > size {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrInvalidEncodedValue, len(buff), name)
+		}
+		return decodeInteger(name, buff), nil
+	case name == "BigUint":
+		return big.NewInt(0).SetBytes(buff).String(), nil
+	case name == "BigInt":
+		return decodeSignedBigInt(buff).String(), nil
+	case name == "bool":
+		return decodeBool(buff)
+	case name == "Address":
+		return vd.decodeAddress(buff)
+	case name == "H256":
+		if len(buff) != hashLength {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrInvalidEncodedValue, len(buff), name)
+		}
+		return hex.EncodeToString(buff), nil
+	case isStringType(name):
+		return string(buff), nil
+	case isBytesType(name):
+		return hex.EncodeToString(buff), nil
+	case name == "Option":
+		if len(buff) == 0 {
+			return nil, nil
+		}
+		if buff[0] != 1 || len(expression.arguments) != 1 {
+			return nil, fmt.Errorf("%w: invalid option", ErrInvalidEncodedValue)
+		}
+		return vd.decodeNestedFully(expression.arguments[0], buff[1:], depth+1)
+	case isListType(name):
+		if len(expression.arguments) != 1 {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, name)
+		}
+		items := make([]interface{}, 0)
+		for len(buff) > 0 {
+			var item interface{}
+			var err error
+			item, buff, err = vd.decodeNested(expression.arguments[0], buff, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case vd.isEnum(name):
+		// the top encoding of an enum is empty for its first variant, if it has no fields
+		if len(buff) == 0 {
+			return vd.createFieldlessEnumValue(name, 0)
+		}
+		return vd.decodeNestedFully(expression, buff, depth)
+	default:
+		return vd.decodeNestedFully(expression, buff, depth)
+	}
+}
+
+func (vd *valueDecoder) decodeNestedFully(expression *typeExpression, buff []byte, depth int) (interface{}, error) {
+	value, rest, err := vd.decodeNested(expression, buff, depth)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: %d unexpected trailing bytes", ErrInvalidEncodedValue, len(rest))
+	}
+
+	return value, nil
+}
+
+func (vd *valueDecoder) decodeNested(expression *typeExpression, buff []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxNestingDepth {
+		return nil, nil, fmt.Errorf("%w: nesting too deep", ErrUnsupportedType)
+	}
+
+	name := expression.name
+	size, isInteger := integerSizes[name]
+	switch {
+	case isInteger:
+		chunk, rest, err := readBytes(buff, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeInteger(name, chunk), rest, nil
+	case name == "BigUint", name == "BigInt", isStringType(name), isBytesType(name):
+		chunk, rest, err := readLengthPrefixedBytes(buff)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := vd.decodeTop(expression, chunk, depth)
+		return value, rest, err
+	case name == "bool":
+		chunk, rest, err := readBytes(buff, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := decodeBool(chunk)
+		return value, rest, err
+	case name == "Address", name == "H256":
+		chunk, rest, err := readBytes(buff, addressLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := vd.decodeTop(expression, chunk, depth)
+		return value, rest, err
+	case name == "Option":
+		return vd.decodeNestedOption(expression, buff, depth)
+	case isListType(name):
+		if len(expression.arguments) != 1 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, name)
+		}
+		countBytes, rest, err := readBytes(buff, lengthPrefixLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		return vd.decodeNestedSequence(expression.arguments[0], int(binary.BigEndian.Uint32(countBytes)), rest, depth)
+	case name == "tuple":
+		items := make([]interface{}, 0, len(expression.arguments))
+		for _, argument := range expression.arguments {
+			var item interface{}
+			var err error
+			item, buff, err = vd.decodeNested(argument, buff, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, buff, nil
+	case strings.HasPrefix(name, arrayTypePrefix):
+		numItems, err := strconv.Atoi(strings.TrimPrefix(name, arrayTypePrefix))
+		if err != nil || len(expression.arguments) != 1 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, name)
+		}
+		return vd.decodeNestedSequence(expression.arguments[0], numItems, buff, depth)
+	default:
+		return vd.decodeNestedCustomType(name, buff, depth)
+	}
+}
+
+func (vd *valueDecoder) decodeNestedOption(expression *typeExpression, buff []byte, depth int) (interface{}, []byte, error) {
+	if len(expression.arguments) != 1 {
+		return nil, nil, fmt.Errorf("%w: Option", ErrUnsupportedType)
+	}
+
+	tag, rest, err := readBytes(buff, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch tag[0] {
+	case 0:
+		return nil, rest, nil
+	case 1:
+		return vd.decodeNested(expression.arguments[0], rest, depth+1)
+	default:
+		return nil, nil, fmt.Errorf("%w: invalid option", ErrInvalidEncodedValue)
+	}
+}
+
+func (vd *valueDecoder) decodeNestedSequence(itemExpression *typeExpression, numItems int, buff []byte, depth int) (interface{}, []byte, error) {
+	items := make([]interface{}, 0)
+	for idx := 0; idx < numItems; idx++ {
+		var item interface{}
+		var err error
+		item, buff, err = vd.decodeNested(itemExpression, buff, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, buff, nil
+}
+
+func (vd *valueDecoder) decodeNestedCustomType(name string, buff []byte, depth int) (interface{}, []byte, error) {
+	typeDefinition, found := vd.types[name]
+	if !found {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, name)
+	}
+
+	if typeDefinition.Type == customTypeStruct {
+		return vd.decodeNestedFields(typeDefinition.Fields, buff, depth)
+	}
+
+	discriminant, rest, err := readBytes(buff, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	variant := findVariant(typeDefinition, discriminant[0])
+	if variant == nil {
+		return nil, nil, fmt.Errorf("%w: unknown variant %d of %s", ErrInvalidEncodedValue, discriminant[0], name)
+	}
+	if len(variant.Fields) == 0 {
+		return variant.Name, rest, nil
+	}
+
+	fields, rest, err := vd.decodeNestedFields(variant.Fields, rest, depth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return map[string]interface{}{
+		"name":   variant.Name,
+		"fields": fields,
+	}, rest, nil
+}
+
+func (vd *valueDecoder) decodeNestedFields(fields []*FieldDefinition, buff []byte, depth int) (interface{}, []byte, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		expression, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		values[field.Name], buff, err = vd.decodeNested(expression, buff, depth+1)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return values, buff, nil
+}
+
+func (vd *valueDecoder) isEnum(name string) bool {
+	typeDefinition, found := vd.types[name]
+	return found && typeDefinition.Type == customTypeEnum
+}
+
+func (vd *valueDecoder) createFieldlessEnumValue(name string, discriminant uint8) (interface{}, error) {
+	typeDefinition, found := vd.types[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, name)
+	}
+
+	variant := findVariant(typeDefinition, discriminant)
+	if variant == nil || len(variant.Fields) != 0 {
+		return nil, fmt.Errorf("%w: unknown variant %d of %s", ErrInvalidEncodedValue, discriminant, name)
+	}
+
+	return variant.Name, nil
+}
+
+func (vd *valueDecoder) decodeAddress(buff []byte) (interface{}, error) {
+	if len(buff) != vd.pubKeyConverter.Len() {
+		return nil, fmt.Errorf("%w: %d bytes for Address", ErrInvalidEncodedValue, len(buff))
+	}
+
+	return vd.pubKeyConverter.Encode(buff)
+}
+
+func findVariant(typeDefinition *TypeDefinition, discriminant uint8) *VariantDefinition {
+	for _, variant := range typeDefinition.Variants {
+		if variant != nil && variant.Discriminant == discriminant {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+func decodeInteger(name string, buff []byte) interface{} {
+	if strings.HasPrefix(name, "i") {
+		return decodeSignedBigInt(buff).Int64()
+	}
+
+	return big.NewInt(0).SetBytes(buff).Uint64()
+}
+
+// decodeSignedBigInt decodes the big endian two's complement representation of a signed integer
+func decodeSignedBigInt(buff []byte) *big.Int {
+	value := big.NewInt(0).SetBytes(buff)
+	if len(buff) > 0 && buff[0]&0x80 != 0 {
+		value.Sub(value, big.NewInt(0).Lsh(big.NewInt(1), uint(8*len(buff))))
+	}
+
+	return value
+}
+
+func decodeBool(buff []byte) (interface{}, error) {
+	switch {
+	case len(buff) == 0 || (len(buff) == 1 && buff[0] == 0):
+		return false, nil
+	case len(buff) == 1 && buff[0] == 1:
+		return true, nil
+	default:
+		return nil, fmt.Errorf("%w: invalid bool", ErrInvalidEncodedValue)
+	}
+}
+
+func readBytes(buff []byte, length int) ([]byte, []byte, error) {
+	if len(buff) < length {
+		return nil, nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidEncodedValue, length, len(buff))
+	}
+
+	return buff[:length], buff[length:], nil
+}
+
+func readLengthPrefixedBytes(buff []byte) ([]byte, []byte, error) {
+	lengthBytes, rest, err := readBytes(buff, lengthPrefixLen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return readBytes(rest, int(binary.BigEndian.Uint32(lengthBytes)))
+}
+
+func isStringType(name string) bool {
+	_, found := stringTypes[name]
+	return found
+}
+
+func isBytesType(name string) bool {
+	_, found := bytesTypes[name]
+	return found
+}
+
+func isListType(name string) bool {
+	_, found := listTypes[name]
+	return found
+}
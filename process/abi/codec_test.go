@@ -0,0 +1,130 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+const testAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createTestValueDecoder(t *testing.T) *valueDecoder {
+	contractABI, err := ParseContractABI([]byte(testPairABI))
+	require.NoError(t, err)
+
+	return &valueDecoder{
+		types:           contractABI.Types,
+		pubKeyConverter: testPubKeyConverter,
+	}
+}
+
+func lengthPrefixed(buff []byte) []byte {
+	prefix := make([]byte, lengthPrefixLen)
+	binary.BigEndian.PutUint32(prefix, uint32(len(buff)))
+
+	return append(prefix, buff...)
+}
+
+func TestValueDecoder_DecodeTopEncoded(t *testing.T) {
+	t.Parallel()
+
+	vd := createTestValueDecoder(t)
+	addressBytes, _ := testPubKeyConverter.Decode(testAddress)
+
+	t.Run("simple values should work", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			typeStr  string
+			buff     []byte
+			expected interface{}
+		}{
+			{"u64", nil, uint64(0)},
+			{"u32", []byte{0x01, 0x00}, uint64(256)},
+			{"i8", []byte{0xff}, int64(-1)},
+			{"i64", []byte{0x7f}, int64(127)},
+			{"BigUint", []byte{0x03, 0xe8}, "1000"},
+			{"BigInt", []byte{0xfc, 0x18}, "-1000"},
+			{"bool", []byte{0x01}, true},
+			{"bool", nil, false},
+			{"Address", addressBytes, testAddress},
+			{"TokenIdentifier", []byte("WEGLD-bd4d79"), "WEGLD-bd4d79"},
+			{"utf-8 string", []byte("hello"), "hello"},
+			{"bytes", []byte{0xca, 0xfe}, "cafe"},
+			{"Option<u8>", nil, nil},
+			{"Option<u8>", []byte{0x01, 0x07}, uint64(7)},
+			{"List<u16>", []byte{0x00, 0x01, 0x00, 0x02}, []interface{}{uint64(1), uint64(2)}},
+			{"State", nil, "Inactive"},
+			{"State", []byte{0x01}, "Active"},
+		}
+		for _, testCase := range testCases {
+			value, err := vd.decodeTopEncoded(testCase.typeStr, testCase.buff)
+			require.NoError(t, err, testCase.typeStr)
+			assert.Equal(t, testCase.expected, value, testCase.typeStr)
+		}
+	})
+	t.Run("struct should be decoded into an object", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.Join([][]byte{
+			lengthPrefixed([]byte{0x03, 0xe8}),
+			lengthPrefixed([]byte{0x05}),
+			{0x01}, lengthPrefixed([]byte{0x03}),
+			{0x00, 0x00, 0x00, 0x02}, lengthPrefixed([]byte("WEGLD")), lengthPrefixed([]byte("USDC")),
+			{0x02, 0x00, 0x00, 0x00, 0x07},
+		}, nil)
+
+		value, err := vd.decodeTopEncoded("SwapEvent", buff)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"amount_in":  "1000",
+			"amount_out": "5",
+			"fee":        "3",
+			"path":       []interface{}{"WEGLD", "USDC"},
+			"state": map[string]interface{}{
+				"name":   "PartialActive",
+				"fields": map[string]interface{}{"0": uint64(7)},
+			},
+		}, value)
+	})
+	t.Run("tuples and arrays should be decoded into arrays", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := vd.decodeTopEncoded("tuple<u8,bool,array2<u8>>", []byte{0x01, 0x00, 0x02, 0x03})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{uint64(1), false, []interface{}{uint64(2), uint64(3)}}, value)
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			typeStr     string
+			buff        []byte
+			expectedErr error
+		}{
+			{"u8", []byte{0x01, 0x02}, ErrInvalidEncodedValue},
+			{"bool", []byte{0x02}, ErrInvalidEncodedValue},
+			{"Address", []byte{0x01}, ErrInvalidEncodedValue},
+			{"H256", []byte{0x01}, ErrInvalidEncodedValue},
+			{"Option<u8>", []byte{0x02}, ErrInvalidEncodedValue},
+			{"List<u32>", []byte{0x00, 0x01}, ErrInvalidEncodedValue},
+			{"State", []byte{0x09}, ErrInvalidEncodedValue},
+			{"SwapEvent", []byte{0x00}, ErrInvalidEncodedValue},
+			{"tuple<u8>", []byte{0x01, 0x02}, ErrInvalidEncodedValue},
+			{"Unknown", []byte{0x01}, ErrUnsupportedType},
+			{"List<u8", []byte{0x01}, ErrUnsupportedType},
+		}
+		for _, testCase := range testCases {
+			value, err := vd.decodeTopEncoded(testCase.typeStr, testCase.buff)
+			assert.Nil(t, value, testCase.typeStr)
+			assert.True(t, errors.Is(err, testCase.expectedErr), testCase.typeStr)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package abi
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
+
+// ErrInvalidABI signals that a contract ABI could not be parsed
+var ErrInvalidABI = errors.New("invalid contract ABI")
+
+// ErrInvalidContractAddress signals that the address of a registered contract is invalid
+var ErrInvalidContractAddress = errors.New("invalid contract address")
+
+// ErrUnsupportedType signals that a value of a type not handled by the decoder was provided
+var ErrUnsupportedType = errors.New("unsupported ABI type")
+
+// ErrInvalidEncodedValue signals that the bytes of a value do not match its ABI type
+var ErrInvalidEncodedValue = errors.New("invalid encoded value")
@@ -0,0 +1,110 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/abi")
+
+type contractEvents struct {
+	events  map[string]*EventDefinition
+	decoder *valueDecoder
+}
+
+type eventsRegistry struct {
+	contracts map[string]*contractEvents
+}
+
+// NewEventsRegistry creates a registry decoding the events logged by the contracts whose ABIs are provided, indexed by
+// the bech32 address of the contract
+func NewEventsRegistry(contractABIs map[string]*ContractABI, pubKeyConverter core.PubkeyConverter) (*eventsRegistry, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	registry := &eventsRegistry{
+		contracts: make(map[string]*contractEvents, len(contractABIs)),
+	}
+	for address, contractABI := range contractABIs {
+		_, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidContractAddress, address)
+		}
+		if contractABI == nil {
+			return nil, fmt.Errorf("%w: missing ABI for %s", ErrInvalidABI, address)
+		}
+
+		events := make(map[string]*EventDefinition, len(contractABI.Events))
+		for _, event := range contractABI.Events {
+			events[event.Identifier] = event
+		}
+		registry.contracts[address] = &contractEvents{
+			events: events,
+			decoder: &valueDecoder{
+				types:           contractABI.Types,
+				pubKeyConverter: pubKeyConverter,
+			},
+		}
+	}
+
+	return registry, nil
+}
+
+// DecodeEvent returns the named fields of an event declared by the ABI of the contract which logged it, or nil if the
+// contract or the event is not registered. The first topic holds the event identifier, the following ones hold the
+// indexed inputs and the data field holds the non-indexed input. The inputs which cannot be decoded are returned as hex
+func (er *eventsRegistry) DecodeEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	if event == nil || len(event.Topics) == 0 {
+		return nil
+	}
+
+	contract, found := er.contracts[event.Address]
+	if !found {
+		return nil
+	}
+	eventDefinition, found := contract.events[string(event.Topics[0])]
+	if !found {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, len(eventDefinition.Inputs))
+	topicIndex := 1
+	for _, input := range eventDefinition.Inputs {
+		encodedValue := event.Data
+		if input.Indexed {
+			if topicIndex >= len(event.Topics) {
+				fields[input.Name] = nil
+				continue
+			}
+
+			encodedValue = event.Topics[topicIndex]
+			topicIndex++
+		}
+
+		value, err := contract.decoder.decodeTopEncoded(input.Type, encodedValue)
+		if err != nil {
+			log.Trace("cannot decode event input", "event", eventDefinition.Identifier, "input", input.Name, "error", err)
+			value = hex.EncodeToString(encodedValue)
+		}
+		fields[input.Name] = value
+	}
+
+	return &data.TransactionDecodedEvent{
+		Identifier: event.Identifier,
+		Address:    event.Address,
+		Event:      eventDefinition.Identifier,
+		Fields:     fields,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (er *eventsRegistry) IsInterfaceNil() bool {
+	return er == nil
+}
@@ -0,0 +1,124 @@
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractAddress = "erd1qqqqqqqqqqqqqpgqq66xk9gfr4esuhem3jru86wg5hvp33a62jps2fy57p"
+
+func createTestEventsRegistry(t *testing.T) *eventsRegistry {
+	contractABI, err := ParseContractABI([]byte(testPairABI))
+	require.NoError(t, err)
+
+	registry, err := NewEventsRegistry(map[string]*ContractABI{testContractAddress: contractABI}, testPubKeyConverter)
+	require.NoError(t, err)
+
+	return registry
+}
+
+func TestNewEventsRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry, err := NewEventsRegistry(nil, nil)
+		assert.Nil(t, registry)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry, err := NewEventsRegistry(map[string]*ContractABI{"invalid": {}}, testPubKeyConverter)
+		assert.Nil(t, registry)
+		assert.True(t, errors.Is(err, ErrInvalidContractAddress))
+	})
+	t.Run("nil ABI should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry, err := NewEventsRegistry(map[string]*ContractABI{testContractAddress: nil}, testPubKeyConverter)
+		assert.Nil(t, registry)
+		assert.True(t, errors.Is(err, ErrInvalidABI))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry := createTestEventsRegistry(t)
+		assert.False(t, check.IfNil(registry))
+	})
+}
+
+func TestEventsRegistry_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	registry := createTestEventsRegistry(t)
+	callerBytes, _ := testPubKeyConverter.Decode(testAddress)
+
+	t.Run("unknown contracts or events should not be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, registry.DecodeEvent(nil))
+		assert.Nil(t, registry.DecodeEvent(&transaction.Events{Address: testContractAddress}))
+		assert.Nil(t, registry.DecodeEvent(&transaction.Events{Address: testAddress, Topics: [][]byte{[]byte("swap")}}))
+		assert.Nil(t, registry.DecodeEvent(&transaction.Events{Address: testContractAddress, Topics: [][]byte{[]byte("unknown")}}))
+	})
+	t.Run("registered event should be decoded into named fields", func(t *testing.T) {
+		t.Parallel()
+
+		swapEvent := bytes.Join([][]byte{
+			lengthPrefixed([]byte{0x03, 0xe8}),
+			lengthPrefixed([]byte{0x05}),
+			{0x00},
+			{0x00, 0x00, 0x00, 0x00},
+			{0x01},
+		}, nil)
+		decodedEvent := registry.DecodeEvent(&transaction.Events{
+			Address:    testContractAddress,
+			Identifier: "swapTokensFixedInput",
+			Topics:     [][]byte{[]byte("swap"), []byte("WEGLD-bd4d79"), callerBytes, {0x05, 0xdc}},
+			Data:       swapEvent,
+		})
+		assert.Equal(t, &data.TransactionDecodedEvent{
+			Identifier: "swapTokensFixedInput",
+			Address:    testContractAddress,
+			Event:      "swap",
+			Fields: map[string]interface{}{
+				"token_in": "WEGLD-bd4d79",
+				"caller":   testAddress,
+				"epoch":    uint64(1500),
+				"swap_event": map[string]interface{}{
+					"amount_in":  "1000",
+					"amount_out": "5",
+					"fee":        nil,
+					"path":       []interface{}{},
+					"state":      "Active",
+				},
+			},
+		}, decodedEvent)
+	})
+	t.Run("undecodable or missing inputs should be returned as hex or null", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvent := registry.DecodeEvent(&transaction.Events{
+			Address:    testContractAddress,
+			Identifier: "swapTokensFixedInput",
+			Topics:     [][]byte{[]byte("swap"), []byte("WEGLD-bd4d79"), {0xab, 0xcd}},
+			Data:       []byte{0x01},
+		})
+		require.NotNil(t, decodedEvent)
+		assert.Equal(t, map[string]interface{}{
+			"token_in":   "WEGLD-bd4d79",
+			"caller":     "abcd",
+			"epoch":      nil,
+			"swap_event": "01",
+		}, decodedEvent.Fields)
+	})
+}
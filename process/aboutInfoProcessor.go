@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -48,6 +49,7 @@ func (ap *aboutProcessor) GetAboutInfo() *data.GenericAPIResponse {
 	aboutInfo := &data.AboutInfo{
 		AppVersion: ap.appVersion,
 		CommitID:   commit,
+		Shards:     computeShardsNonces(ap.baseProc.GetObserversNonces()),
 	}
 
 	resp := &data.GenericAPIResponse{
@@ -59,8 +61,44 @@ func (ap *aboutProcessor) GetAboutInfo() *data.GenericAPIResponse {
 	return resp
 }
 
+// computeShardsNonces groups the observers' nonces by shard and computes the lag of each observer behind the highest
+// nonce of its shard. The provided nonces are expected to be sorted by shard
+func computeShardsNonces(observersNonces []*data.ObserverNonce) []*data.ShardNonces {
+	if len(observersNonces) == 0 {
+		return nil
+	}
+
+	shardsNonces := make([]*data.ShardNonces, 0)
+	var currentShard *data.ShardNonces
+	for _, observerNonce := range observersNonces {
+		if currentShard == nil || currentShard.ShardID != observerNonce.ShardID {
+			currentShard = &data.ShardNonces{
+				ShardID:   observerNonce.ShardID,
+				Observers: make([]*data.ObserverNonceLag, 0),
+			}
+			shardsNonces = append(shardsNonces, currentShard)
+		}
+
+		if observerNonce.Nonce > currentShard.MaxNonce {
+			currentShard.MaxNonce = observerNonce.Nonce
+		}
+		currentShard.Observers = append(currentShard.Observers, &data.ObserverNonceLag{
+			Address: observerNonce.Address,
+			Nonce:   observerNonce.Nonce,
+		})
+	}
+
+	for _, shardNonces := range shardsNonces {
+		for _, observer := range shardNonces.Observers {
+			observer.Lag = shardNonces.MaxNonce - observer.Nonce
+		}
+	}
+
+	return shardsNonces
+}
+
 // GetNodesVersions will return the versions of the nodes behind proxy
-func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (ap *aboutProcessor) GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error) {
 	versionsMap := make(map[uint32][]string)
 	allObservers, err := ap.baseProc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
@@ -68,7 +106,7 @@ func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
 	}
 
 	for _, observer := range allObservers {
-		nodeVersion, err := ap.getNodeAppVersion(observer.Address)
+		nodeVersion, err := ap.getNodeAppVersion(ctx, observer.Address)
 		if err != nil {
 			return nil, err
 		}
@@ -85,9 +123,20 @@ func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
 	}, nil
 }
 
-func (ap *aboutProcessor) getNodeAppVersion(observerAddress string) (string, error) {
+// GetExcludedObservers will return the observers excluded for running a version below the minimum accepted one
+func (ap *aboutProcessor) GetExcludedObservers() *data.GenericAPIResponse {
+	return &data.GenericAPIResponse{
+		Data: data.ExcludedObserversResponseData{
+			ExcludedObservers: ap.baseProc.GetExcludedObservers(),
+		},
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}
+}
+
+func (ap *aboutProcessor) getNodeAppVersion(ctx context.Context, observerAddress string) (string, error) {
 	var versionResponse data.NodeVersionAPIResponse
-	code, err := ap.baseProc.CallGetRestEndPoint(observerAddress, NodeStatusPath, &versionResponse)
+	code, err := ap.baseProc.CallGetRestEndPoint(ctx, observerAddress, NodeStatusPath, &versionResponse)
 	if code != http.StatusOK {
 		return "", fmt.Errorf("invalid return code %d", code)
 	}
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -75,6 +76,40 @@ func TestAboutInfoProcessor_GetAboutInfo(t *testing.T) {
 		resp := ap.GetAboutInfo()
 		require.Equal(t, expectedResp, resp)
 	})
+	t.Run("should include the nonces lag of the observers", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAboutProcessor(&mock.ProcessorStub{
+			GetObserversNoncesCalled: func() []*data.ObserverNonce {
+				return []*data.ObserverNonce{
+					{Address: "observer0", ShardID: 0, Nonce: 100},
+					{Address: "observer1", ShardID: 0, Nonce: 97},
+					{Address: "observer2", ShardID: 1, Nonce: 55},
+				}
+			},
+		}, "app version", "commitID")
+
+		expectedShards := []*data.ShardNonces{
+			{
+				ShardID:  0,
+				MaxNonce: 100,
+				Observers: []*data.ObserverNonceLag{
+					{Address: "observer0", Nonce: 100, Lag: 0},
+					{Address: "observer1", Nonce: 97, Lag: 3},
+				},
+			},
+			{
+				ShardID:  1,
+				MaxNonce: 55,
+				Observers: []*data.ObserverNonceLag{
+					{Address: "observer2", Nonce: 55, Lag: 0},
+				},
+			},
+		}
+
+		resp := ap.GetAboutInfo()
+		require.Equal(t, expectedShards, resp.Data.(*data.AboutInfo).Shards)
+	})
 }
 
 func TestAboutProcessor_GetNodesVersions(t *testing.T) {
@@ -99,7 +134,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.Empty(t, res)
 		require.Equal(t, "invalid return code 37", err.Error())
 	})
@@ -125,7 +160,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.Empty(t, res)
 		require.Contains(t, err.Error(), expectedErr.Error())
 	})
@@ -170,7 +205,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.NoError(t, err)
 
 		expectedResponse := &data.GenericAPIResponse{
@@ -186,3 +221,20 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		require.EqualValues(t, expectedResponse, res)
 	})
 }
+
+func TestAboutInfoProcessor_GetExcludedObservers(t *testing.T) {
+	t.Parallel()
+
+	excludedObservers := []*data.ExcludedObserver{
+		{Address: "observer0", ShardID: 0, Version: "v1.6.0", MinimumVersion: "v1.7.0"},
+	}
+	ap, _ := process.NewAboutProcessor(&mock.ProcessorStub{
+		GetExcludedObserversCalled: func() []*data.ExcludedObserver {
+			return excludedObservers
+		},
+	}, "app version", "commitID")
+
+	response := ap.GetExcludedObservers()
+	require.Equal(t, data.ReturnCodeSuccess, response.Code)
+	require.Equal(t, data.ExcludedObserversResponseData{ExcludedObservers: excludedObservers}, response.Data)
+}
@@ -0,0 +1,85 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// GetKeyValuePairsDiff returns the keys of the account which were added, changed or removed between the two blocks.
+// Both states are fetched from the full history observers of the shard
+func (ap *AccountProcessor) GetKeyValuePairsDiff(ctx context.Context, address string, options common.AccountKeysDiffQueryOptions) (*data.AccountKeysDiff, error) {
+	fromPairs, err := ap.getKeyValuePairsAtBlock(ctx, address, options.FromBlock, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	toPairs, err := ap.getKeyValuePairsAtBlock(ctx, address, options.ToBlock, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &data.AccountKeysDiff{
+		FromBlock: fromPairs.BlockInfo,
+		ToBlock:   toPairs.BlockInfo,
+		Added:     make(map[string]string),
+		Changed:   make(map[string]data.KeyValueChange),
+		Removed:   make(map[string]string),
+	}
+	for key, toValue := range toPairs.Pairs {
+		fromValue, existed := fromPairs.Pairs[key]
+		switch {
+		case !existed:
+			diff.Added[key] = toValue
+		case fromValue != toValue:
+			diff.Changed[key] = data.KeyValueChange{Before: fromValue, After: toValue}
+		}
+	}
+	for key, fromValue := range fromPairs.Pairs {
+		_, exists := toPairs.Pairs[key]
+		if !exists {
+			diff.Removed[key] = fromValue
+		}
+	}
+
+	return diff, nil
+}
+
+func (ap *AccountProcessor) getKeyValuePairsAtBlock(ctx context.Context, address string, blockNonce uint64, forcedShardID core.OptionalUint32) (*data.AccountKeyValuePairsResponseData, error) {
+	options := common.AccountQueryOptions{
+		BlockNonce:    core.OptionalUint64{Value: blockNonce, HasValue: true},
+		ForcedShardID: forcedShardID,
+	}
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse := data.AccountKeyValuePairsApiResponse{}
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/keys", options)
+	for _, observer := range observers {
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
+		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			log.WithContext(ctx).Info("account get key-value pairs at block",
+				"address", address,
+				"block nonce", blockNonce,
+				"shard ID", observer.ShardId,
+				"observer", observer.Address,
+				"http code", respCode)
+			if apiResponse.Error != "" {
+				return nil, errors.New(apiResponse.Error)
+			}
+
+			return &apiResponse.Data, nil
+		}
+
+		log.WithContext(ctx).Error("account get key-value pairs at block error", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, WrapObserversError(apiResponse.Error)
+}
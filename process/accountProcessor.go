@@ -1,6 +1,8 @@
 package process
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -8,34 +10,64 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
 )
 
-// addressPath defines the address path at which the nodes answer
-const addressPath = "/address/"
+const (
+	// addressPath defines the address path at which the nodes answer
+	addressPath = "/address/"
+
+	defaultTokenHoldersPageSize = 25
+	maxTokenHoldersPageSize     = 1000
+
+	defaultLatestTransactionsSize = 10
+	maxLatestTransactionsSize     = 100
+)
 
 // AccountProcessor is able to process account requests
 type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	singleSigner         crypto.SingleSigner
+	keyGen               crypto.KeyGenerator
+	externalStorage      ExternalStorageConnector
+	dataFreshnessChecker DataFreshnessChecker
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*AccountProcessor, error) {
+func NewAccountProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	externalStorage ExternalStorageConnector,
+	dataFreshnessChecker DataFreshnessChecker,
+) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(externalStorage) {
+		return nil, ErrNilExternalStorageConnector
+	}
+	if check.IfNil(dataFreshnessChecker) {
+		return nil, ErrNilDataFreshnessChecker
+	}
 
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		singleSigner:         getSingleSigner(),
+		keyGen:               signing.NewKeyGenerator(ed25519.NewEd25519()),
+		externalStorage:      externalStorage,
+		dataFreshnessChecker: dataFreshnessChecker,
 	}, nil
 }
 
@@ -49,32 +81,228 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 	return ap.proc.ComputeShardId(addressBytes)
 }
 
-// GetAccount resolves the request by sending the request to the right observer and returns the response
-func (ap *AccountProcessor) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+// VerifyMessageSignature checks if the provided signature of an arbitrary message was produced by the key of the
+// provided address. If no scheme is provided, the message is considered to be signed using the prefixed scheme
+func (ap *AccountProcessor) VerifyMessageSignature(request *data.SignatureVerificationRequest) (*data.SignatureVerification, error) {
+	addressBytes, err := ap.pubKeyConverter.Decode(request.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	signature, err := hex.DecodeString(request.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignatureBytes, err.Error())
+	}
+
+	scheme := request.Scheme
+	if len(scheme) == 0 {
+		scheme = MessageSchemePrefixed
+	}
+	payload, err := computeSignablePayload([]byte(request.Message), scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := ap.keyGen.PublicKeyFromByteArray(addressBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	return &data.SignatureVerification{
+		Address: request.Address,
+		Scheme:  scheme,
+		IsValid: ap.singleSigner.Verify(publicKey, payload, signature) == nil,
+	}, nil
+}
+
+// GetAccount resolves the request by sending the request to the right observer and returns the response. The account's
+// guardians are added only if requested through the options, as they need an extra observer request
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	accountModel, observer, err := ap.getAccount(ctx, address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.WithGuardians {
+		return accountModel, nil
+	}
+
+	ap.addGuardianData(ctx, address, options, &accountModel.Account)
+	if ap.availabilityProvider.AvailabilityForAccountQueryOptions(options) == data.AvailabilityRecent {
+		ap.addGuardianCooldown(ctx, observer, &accountModel.Account)
+	}
+
+	return accountModel, nil
+}
+
+func (ap *AccountProcessor) getAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, *data.NodeData, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	// the historical reads are not checked for freshness, since they target the same block on any observer
+	if ap.dataFreshnessChecker.IsEnabled() && !options.AreHistoricalCoordinatesSet() {
+		return ap.getAccountWithFreshnessCheck(ctx, address, observers, url)
 	}
 
 	responseAccount := data.AccountApiResponse{}
 	for _, observer := range observers {
+		_, err = ap.proc.CallGetRestEndPoint(ctx, observer.Address, url, &responseAccount)
+		if err == nil {
+			log.WithContext(ctx).Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
+			return &responseAccount.Data, observer, nil
+		}
+
+		log.WithContext(ctx).Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, nil, WrapObserversError(responseAccount.Error)
+}
+
+func (ap *AccountProcessor) getAccountWithFreshnessCheck(ctx context.Context, address string, observers []*data.NodeData, url string) (*data.AccountModel, *data.NodeData, error) {
+	accountModel, observer, err := ap.dataFreshnessChecker.GetAccountFromObservers(ctx, observers, url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.WithContext(ctx).Info("account request with freshness check",
+		"address", address,
+		"shard ID", observer.ShardId,
+		"observer", observer.Address,
+		"freshness", accountModel.DataFreshness.Status)
+
+	return accountModel, observer, nil
+}
+
+// GetAddressActivitySummary returns the number of transactions sent and received by the address along with the
+// timestamps of its first and last activity, as computed by the external storage. If no external storage is enabled,
+// the summary is built from the account served by the observers and only holds the number of sent transactions
+func (ap *AccountProcessor) GetAddressActivitySummary(ctx context.Context, address string) (*data.AddressActivitySummary, error) {
+	_, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	if ap.externalStorage.IsEnabled() {
+		return ap.externalStorage.GetAddressActivitySummary(address)
+	}
+
+	account, _, err := ap.getAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
 
-		url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, url, &responseAccount)
+	return &data.AddressActivitySummary{
+		Address:      address,
+		SentTxsCount: account.Account.Nonce,
+		Source:       data.ActivitySourceObservers,
+	}, nil
+}
+
+// GetAddressLatestTransactions returns the most recent transactions sent or received by the address, as indexed by the
+// external storage. The observers do not index the transactions by address, so an error is returned if no external
+// storage is enabled
+func (ap *AccountProcessor) GetAddressLatestTransactions(address string, size uint32) ([]data.DatabaseTransaction, error) {
+	_, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+	if size == 0 {
+		size = defaultLatestTransactionsSize
+	}
+	if size > maxLatestTransactionsSize {
+		return nil, fmt.Errorf("%w: the number of transactions must not exceed %d", ErrInvalidPageSize, maxLatestTransactionsSize)
+	}
+	if !ap.externalStorage.IsEnabled() {
+		return nil, data.ErrNoExternalStorage
+	}
+
+	return ap.externalStorage.GetAddressLatestTransactions(address, int(size))
+}
+
+// GetTokenHolders returns a page of the addresses holding the token along with their balances, as indexed by the
+// external storage. The observers do not index the holders, so an error is returned if no external storage is enabled
+func (ap *AccountProcessor) GetTokenHolders(token string, options common.TokenHoldersQueryOptions) (*data.TokenHoldersPage, error) {
+	if len(token) == 0 {
+		return nil, ErrEmptyToken
+	}
+	size := options.Size
+	if size == 0 {
+		size = defaultTokenHoldersPageSize
+	}
+	if size > maxTokenHoldersPageSize {
+		return nil, fmt.Errorf("%w: the page size must not exceed %d", ErrInvalidPageSize, maxTokenHoldersPageSize)
+	}
+	if !ap.externalStorage.IsEnabled() {
+		return nil, data.ErrNoExternalStorage
+	}
+
+	return ap.externalStorage.GetTokenHolders(token, int(size), options.Cursor)
+}
+
+// addGuardianData merges into the account the guardians served by the observers on the guardian data endpoint, which
+// are missing from the account response. The guardians are optional, so a failure is only logged
+func (ap *AccountProcessor) addGuardianData(ctx context.Context, address string, options common.AccountQueryOptions, account *data.Account) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		log.WithContext(ctx).Warn("cannot get the guardian data of the account", "address", address, "error", err.Error())
+		return
+	}
+
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/guardian-data", options)
+	for _, observer := range observers {
+		apiResponse := data.GuardianDataApiResponse{}
+		_, err = ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil {
-			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data, nil
+			guardianData := apiResponse.Data.GuardianData
+			account.IsGuarded = guardianData.Guarded
+			account.ActiveGuardian = guardianData.ActiveGuardian
+			account.PendingGuardian = guardianData.PendingGuardian
+			return
 		}
 
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Warn("cannot get the guardian data of the account", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+}
+
+// addGuardianCooldown computes, for an account with a pending guardian, the number of epochs left until the guardian
+// becomes active. The cooldown is optional, so a failure is only logged
+func (ap *AccountProcessor) addGuardianCooldown(ctx context.Context, observer *data.NodeData, account *data.Account) {
+	if account.PendingGuardian == nil {
+		return
+	}
+
+	response := data.GenericAPIResponse{}
+	_, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &response)
+	if err != nil {
+		log.WithContext(ctx).Warn("cannot get the current epoch for the guardian cooldown", "observer", observer.Address, "error", err.Error())
+		return
+	}
+
+	epochMetric, ok := getMetric(response.Data, MetricEpochNumber)
+	if !ok {
+		log.WithContext(ctx).Warn("cannot get the current epoch for the guardian cooldown", "observer", observer.Address, "error", ErrCannotParseNodeStatusMetrics.Error())
+		return
 	}
 
-	return nil, WrapObserversError(responseAccount.Error)
+	currentEpoch := uint32(getUint(epochMetric))
+	remainingEpochs := uint32(0)
+	if account.PendingGuardian.ActivationEpoch > currentEpoch {
+		remainingEpochs = account.PendingGuardian.ActivationEpoch - currentEpoch
+	}
+
+	account.GuardianCooldown = &data.GuardianCooldown{
+		CurrentEpoch:    currentEpoch,
+		RemainingEpochs: remainingEpochs,
+	}
 }
 
 // GetAccounts will return data about the provided accounts
-func (ap *AccountProcessor) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (ap *AccountProcessor) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	addressesInShards := make(map[uint32][]string)
 	var shardID uint32
 	var err error
@@ -97,7 +325,7 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	for shID, accounts := range addressesInShards {
 		go func(shID uint32, accounts []string) {
 			defer wg.Done()
-			accountsInShard, errGetAccounts := ap.getAccountsInShard(accounts, shID, options)
+			accountsInShard, errGetAccounts := ap.getAccountsInShard(ctx, accounts, shID, options)
 
 			mut.Lock()
 			defer mut.Unlock()
@@ -124,8 +352,31 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	}, nil
 }
 
-func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
-	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
+// GetAccountsNonces returns the current nonces of the provided addresses. The addresses are grouped by shard and each
+// shard is asked in parallel, through a single bulk request. The addresses unknown to the observers have the nonce 0
+func (ap *AccountProcessor) GetAccountsNonces(ctx context.Context, addresses []string) (*data.AccountsNonces, error) {
+	accounts, err := ap.GetAccounts(ctx, addresses, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := make(map[string]uint64, len(addresses))
+	for _, address := range addresses {
+		nonces[address] = 0
+		account, found := accounts.Accounts[address]
+		if found && account != nil {
+			nonces[address] = account.Nonce
+		}
+	}
+
+	return &data.AccountsNonces{
+		Nonces: nonces,
+	}, nil
+}
+
+func (ap *AccountProcessor) getAccountsInShard(ctx context.Context, addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getNodesInShard(shardID, availability)
 	if err != nil {
 		return nil, err
 	}
@@ -134,9 +385,9 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 	apiPath := addressPath + "bulk"
 	apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
 	for _, observer := range observers {
-		respCode, err := ap.proc.CallPostRestEndPoint(observer.Address, apiPath, addresses, &apiResponse)
+		respCode, err := ap.proc.CallPostRestEndPoint(ctx, observer.Address, apiPath, addresses, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("bulk accounts request",
+			log.WithContext(ctx).Info("bulk accounts request",
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
 				"http code", respCode)
@@ -147,14 +398,14 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 			return apiResponse.Data.Accounts, nil
 		}
 
-		log.Error("bulk accounts request", "observer", observer.Address, "error", err.Error())
+		log.WithContext(ctx).Error("bulk accounts request", "observer", observer.Address, "error", err.Error())
 	}
 
 	return nil, ErrSendingRequest
 }
 
 // GetValueForKey returns the value for the given address and key
-func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (ap *AccountProcessor) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -165,9 +416,9 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/key/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account value for key request",
+			log.WithContext(ctx).Info("account value for key request",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -179,14 +430,14 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 			return apiResponse.Data.Value, nil
 		}
 
-		log.Error("account value for key request", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account value for key request", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return "", WrapObserversError(apiResponse.Error)
 }
 
 // GetESDTTokenData returns the token data for a token with the given name
-func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -197,9 +448,9 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account ESDT token data",
+			log.WithContext(ctx).Info("account ESDT token data",
 				"address", address,
 				"token", key,
 				"shard ID", observer.ShardId,
@@ -212,14 +463,14 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 			return &apiResponse, nil
 		}
 
-		log.Error("account get ESDT token data", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get ESDT token data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetESDTsWithRole returns the token identifiers where the given address has the given role assigned
-func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
 	if err != nil {
@@ -230,9 +481,9 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts-with-role/" + role
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account ESDTs with role",
+			log.WithContext(ctx).Info("account ESDTs with role",
 				"address", address,
 				"role", role,
 				"shard ID", observer.ShardId,
@@ -245,14 +496,14 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 			return &apiResponse, nil
 		}
 
-		log.Error("account get ESDTs with role", "observer", observer.Address, "address", address, "role", role, "error", err.Error())
+		log.WithContext(ctx).Error("account get ESDTs with role", "observer", observer.Address, "address", address, "role", role, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetESDTsRoles returns all the tokens and their roles for a given address
-func (ap *AccountProcessor) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
 	if err != nil {
@@ -263,9 +514,9 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts/roles"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, errGet := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, errGet := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account ESDTs roles",
+			log.WithContext(ctx).Info("account ESDTs roles",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -277,14 +528,14 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 			return &apiResponse, nil
 		}
 
-		log.Error("account get ESDTs roles", "observer", observer.Address, "address", address, "error", errGet.Error())
+		log.WithContext(ctx).Error("account get ESDTs roles", "observer", observer.Address, "address", address, "error", errGet.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	//TODO: refactor the entire proxy so endpoints like this which simply forward the response will use a common
 	// component, as described in task EN-9857.
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
@@ -297,9 +548,9 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/registered-nfts/"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account get owned NFTs",
+			log.WithContext(ctx).Info("account get owned NFTs",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -311,14 +562,14 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 			return &apiResponse, nil
 		}
 
-		log.Error("account get owned NFTs", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get owned NFTs", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetESDTNftTokenData returns the nft token data for a token with the given identifier and nonce
-func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -330,9 +581,9 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 		nonceAsString := fmt.Sprintf("%d", nonce)
 		apiPath := addressPath + address + "/nft/" + key + "/nonce/" + nonceAsString
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account ESDT NFT token data",
+			log.WithContext(ctx).Info("account ESDT NFT token data",
 				"address", address,
 				"token", key,
 				"shard ID", observer.ShardId,
@@ -345,14 +596,14 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 			return &apiResponse, nil
 		}
 
-		log.Error("account get ESDT nft token data", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get ESDT nft token data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetAllESDTTokens returns all the tokens for a given address
-func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -363,9 +614,9 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account all ESDT tokens",
+			log.WithContext(ctx).Info("account all ESDT tokens",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -377,14 +628,14 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 			return &apiResponse, nil
 		}
 
-		log.Error("account get all ESDT tokens", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get all ESDT tokens", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetKeyValuePairs returns all the key-value pairs for a given address
-func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -395,9 +646,9 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/keys"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account get all key-value pairs",
+			log.WithContext(ctx).Info("account get all key-value pairs",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -409,14 +660,14 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 			return &apiResponse, nil
 		}
 
-		log.Error("account get all key-value pairs error", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get all key-value pairs error", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetGuardianData returns the guardian data for the given address
-func (ap *AccountProcessor) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -427,9 +678,9 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/guardian-data"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account get guardian data",
+			log.WithContext(ctx).Info("account get guardian data",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -441,14 +692,14 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 			return &apiResponse, nil
 		}
 
-		log.Error("account get guardian data", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get guardian data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
 // GetCodeHash returns the code hash for a given address
-func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -459,9 +710,9 @@ func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQu
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/code-hash"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account get code hash",
+			log.WithContext(ctx).Info("account get code hash",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -473,7 +724,7 @@ func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQu
 			return &apiResponse, nil
 		}
 
-		log.Error("account get code hash error", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account get code hash error", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
@@ -490,7 +741,7 @@ func (ap *AccountProcessor) getShardIfOdAddress(address string) (uint32, error)
 
 func (ap *AccountProcessor) getObserversForAddress(address string, availability data.ObserverDataAvailabilityType, forcedShardID core.OptionalUint32) ([]*data.NodeData, error) {
 	if forcedShardID.HasValue {
-		return ap.proc.GetObservers(forcedShardID.Value, availability)
+		return ap.getNodesInShard(forcedShardID.Value, availability)
 	}
 
 	addressBytes, err := ap.pubKeyConverter.Decode(address)
@@ -503,6 +754,26 @@ func (ap *AccountProcessor) getObserversForAddress(address string, availability
 		return nil, err
 	}
 
+	observers, err := ap.getNodesInShard(shardID, availability)
+	if err != nil || availability != data.AvailabilityRecent {
+		return observers, err
+	}
+
+	// the real-time reads of a recent sender are first routed to the observer which accepted its transactions, so
+	// that it sees its own writes (such as the incremented nonce) before the other observers catch up
+	return ap.proc.PreferWriteObserver(address, observers), nil
+}
+
+// getNodesInShard routes the real-time queries to the snapshotless observers, if any, and the historical ones to the
+// full history nodes, falling back to the regular observers when no full history node is available
+func (ap *AccountProcessor) getNodesInShard(shardID uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	if availability == data.AvailabilityAll {
+		fullHistoryNodes, err := ap.proc.GetFullHistoryNodes(shardID, availability)
+		if err == nil && len(fullHistoryNodes) > 0 {
+			return fullHistoryNodes, nil
+		}
+	}
+
 	return ap.proc.GetObservers(shardID, availability)
 }
 
@@ -512,8 +783,9 @@ func (ap *AccountProcessor) GetBaseProcessor() Processor {
 }
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options.ForcedShardID)
+func (ap *AccountProcessor) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
 		return nil, err
 	}
@@ -522,9 +794,9 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/is-data-trie-migrated"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("is data trie migrated",
+			log.WithContext(ctx).Info("is data trie migrated",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -536,7 +808,7 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 			return &apiResponse, nil
 		}
 
-		log.Error("account is data trie migrated", "observer", observer.Address, "address", address, "error", err.Error())
+		log.WithContext(ctx).Error("account is data trie migrated", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
 	return nil, WrapObserversError(apiResponse.Error)
@@ -1,14 +1,20 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
@@ -20,7 +26,7 @@ import (
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,16 +35,34 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil)
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
 }
 
+func TestNewAccountProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, &mock.DataFreshnessCheckerStub{})
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilExternalStorageConnector, err)
+}
+
+func TestNewAccountProcessor_NilDataFreshnessCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, nil)
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilDataFreshnessChecker, err)
+}
+
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
@@ -49,8 +73,8 @@ func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -68,9 +92,11 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -90,9 +116,11 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -118,9 +146,11 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -151,21 +181,403 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 				if address == addressFail {
 					return 0, errExpected
 				}
-
 				valRespond := value.(*data.AccountApiResponse)
 				valRespond.Data.Account = respondedAccount.Account
 				return 0, nil
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accountModel, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Equal(t, respondedAccount.Account, accountModel.Account)
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetAccountShouldFirstQueryTheWriteObserverOfTheSender(t *testing.T) {
+	t.Parallel()
+
+	queriedObservers := make([]string, 0)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "address1", ShardId: 0},
+					{Address: "address2", ShardId: 0},
+				}, nil
+			},
+			PreferWriteObserverCalled: func(sender string, observers []*data.NodeData) []*data.NodeData {
+				if sender != "DEADBEEF" {
+					return observers
+				}
+
+				return []*data.NodeData{observers[1], observers[0]}
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				queriedObservers = append(queriedObservers, address)
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
+	)
+
+	_, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+	assert.Nil(t, err)
+	_, err = ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 10, HasValue: true}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"address2", "address1"}, queriedObservers)
+}
+
+func TestAccountProcessor_GetAccountWithDataFreshness(t *testing.T) {
+	t.Parallel()
+
+	regularAccount := data.Account{Address: "regular"}
+	checkedAccount := &data.AccountModel{
+		Account:       data.Account{Address: "checked"},
+		DataFreshness: &data.DataFreshness{Status: data.DataFreshnessFresh},
+	}
+	createAccountProcessor := func(enabled bool, checkedObservers *[]*data.NodeData) *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "address1"}, {Address: "address2"}}, nil
+				},
+				PreferWriteObserverCalled: func(sender string, observers []*data.NodeData) []*data.NodeData {
+					return []*data.NodeData{observers[1], observers[0]}
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					response, ok := value.(*data.AccountApiResponse)
+					if ok {
+						response.Data.Account = regularAccount
+					}
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{
+				IsEnabledCalled: func() bool {
+					return enabled
+				},
+				GetAccountFromObserversCalled: func(observers []*data.NodeData, apiPath string) (*data.AccountModel, *data.NodeData, error) {
+					*checkedObservers = observers
+					return checkedAccount, observers[0], nil
+				},
+			},
+		)
+
+		return ap
+	}
+
+	t.Run("disabled check should read from the observers", func(t *testing.T) {
+		t.Parallel()
+
+		var checkedObservers []*data.NodeData
+		account, err := createAccountProcessor(false, &checkedObservers).GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, regularAccount, account.Account)
+		require.Nil(t, checkedObservers)
+	})
+	t.Run("enabled check should read from the routed observers with the freshness checker", func(t *testing.T) {
+		t.Parallel()
+
+		var checkedObservers []*data.NodeData
+		account, err := createAccountProcessor(true, &checkedObservers).GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{WithGuardians: true})
+		require.Nil(t, err)
+		require.Equal(t, checkedAccount, account)
+		require.Equal(t, []*data.NodeData{{Address: "address2"}, {Address: "address1"}}, checkedObservers)
+	})
+	t.Run("historical read should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		var checkedObservers []*data.NodeData
+		options := common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 10, HasValue: true}}
+		account, err := createAccountProcessor(true, &checkedObservers).GetAccount(context.Background(), "DEADBEEF", options)
+		require.Nil(t, err)
+		require.Equal(t, regularAccount, account.Account)
+		require.Nil(t, checkedObservers)
+	})
+}
+
+func TestAccountProcessor_GetAccountWithGuardians(t *testing.T) {
+	t.Parallel()
+
+	// the payloads served by the observers on the account and guardian data endpoints
+	accountPayload := `{"data":{"account":{"address":"erd1addr","nonce":7,"balance":"1000","username":"","code":"","codeHash":null,"rootHash":null,"codeMetadata":null,"developerReward":"0","ownerAddress":""},"blockInfo":{"nonce":120,"hash":"aa","rootHash":"bb"}},"error":"","code":"successful"}`
+	guardedPayload := `{"data":{"blockInfo":{"nonce":120,"hash":"aa","rootHash":"bb"},"guardianData":{"activeGuardian":{"address":"erd1active","activationEpoch":10,"serviceUID":"service"},"pendingGuardian":{"address":"erd1pending","activationEpoch":50,"serviceUID":"service"},"guarded":true}},"error":"","code":"successful"}`
+	notGuardedPayload := `{"data":{"blockInfo":{"nonce":120,"hash":"aa","rootHash":"bb"},"guardianData":{"activeGuardian":{"address":"erd1active","activationEpoch":10,"serviceUID":"service"},"guarded":true}},"error":"","code":"successful"}`
+
+	createProcessor := func(guardianDataPayload string, guardianDataErr error, statusErr error, queriedPaths *[]string) *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					*queriedPaths = append(*queriedPaths, path)
+
+					switch {
+					case path == process.NetworkStatusPath:
+						if statusErr != nil {
+							return 0, statusErr
+						}
+
+						value.(*data.GenericAPIResponse).Data = map[string]interface{}{
+							"metrics": map[string]interface{}{
+								process.MetricEpochNumber: float64(30),
+							},
+						}
+						return http.StatusOK, nil
+					case strings.Contains(path, "/guardian-data"):
+						if guardianDataErr != nil {
+							return 0, guardianDataErr
+						}
+
+						return http.StatusOK, json.Unmarshal([]byte(guardianDataPayload), value)
+					default:
+						return http.StatusOK, json.Unmarshal([]byte(accountPayload), value)
+					}
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		return ap
+	}
+	activeGuardian := &data.GuardianInfo{Address: "erd1active", ActivationEpoch: 10, ServiceUID: "service"}
+	pendingGuardian := &data.GuardianInfo{Address: "erd1pending", ActivationEpoch: 50, ServiceUID: "service"}
+
+	t.Run("guardians not requested should not query the guardian data", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(guardedPayload, nil, nil, &queriedPaths)
+
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), accountModel.Account.Nonce)
+		assert.False(t, accountModel.Account.IsGuarded)
+		assert.Nil(t, accountModel.Account.ActiveGuardian)
+		assert.Nil(t, accountModel.Account.PendingGuardian)
+		assert.Equal(t, []string{"/address/DEADBEEF"}, queriedPaths)
+	})
+	t.Run("account without pending guardian should not request the current epoch", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(notGuardedPayload, nil, nil, &queriedPaths)
+
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{WithGuardians: true})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), accountModel.Account.Nonce)
+		assert.True(t, accountModel.Account.IsGuarded)
+		assert.Equal(t, activeGuardian, accountModel.Account.ActiveGuardian)
+		assert.Nil(t, accountModel.Account.PendingGuardian)
+		assert.Nil(t, accountModel.Account.GuardianCooldown)
+		assert.Equal(t, []string{"/address/DEADBEEF", "/address/DEADBEEF/guardian-data"}, queriedPaths)
+	})
+	t.Run("pending guardian should compute the cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(guardedPayload, nil, nil, &queriedPaths)
+
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{WithGuardians: true})
+		require.Nil(t, err)
+		assert.True(t, accountModel.Account.IsGuarded)
+		assert.Equal(t, activeGuardian, accountModel.Account.ActiveGuardian)
+		assert.Equal(t, pendingGuardian, accountModel.Account.PendingGuardian)
+		assert.Equal(t, &data.GuardianCooldown{CurrentEpoch: 30, RemainingEpochs: 20}, accountModel.Account.GuardianCooldown)
+		assert.Contains(t, queriedPaths, process.NetworkStatusPath)
+	})
+	t.Run("guardian data request failure should still return the account", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(guardedPayload, errors.New("guardian data error"), nil, &queriedPaths)
+
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{WithGuardians: true})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), accountModel.Account.Nonce)
+		assert.False(t, accountModel.Account.IsGuarded)
+		assert.Nil(t, accountModel.Account.PendingGuardian)
+		assert.Nil(t, accountModel.Account.GuardianCooldown)
+	})
+	t.Run("current epoch request failure should still return the account", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(guardedPayload, nil, errors.New("status error"), &queriedPaths)
+
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{WithGuardians: true})
+		require.Nil(t, err)
+		assert.Equal(t, pendingGuardian, accountModel.Account.PendingGuardian)
+		assert.Nil(t, accountModel.Account.GuardianCooldown)
+	})
+	t.Run("historical query should request the guardians at the same block and not compute the cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap := createProcessor(guardedPayload, nil, nil, &queriedPaths)
+
+		options := common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 5, HasValue: true}, WithGuardians: true}
+		accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", options)
+		require.Nil(t, err)
+		assert.Equal(t, pendingGuardian, accountModel.Account.PendingGuardian)
+		assert.Nil(t, accountModel.Account.GuardianCooldown)
+		assert.Equal(t, []string{"/address/DEADBEEF?blockNonce=5", "/address/DEADBEEF/guardian-data?blockNonce=5"}, queriedPaths)
+	})
+}
+
+func TestAccountProcessor_GetKeyValuePairsDiff(t *testing.T) {
+	t.Parallel()
+
+	pairsAtBlock := map[string]map[string]string{
+		"blockNonce=10": {"aa": "01", "bb": "02", "cc": "03"},
+		"blockNonce=20": {"aa": "01", "bb": "04", "dd": "05"},
+	}
+	createProcessor := func(queriedPaths *[]string) process.Processor {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "full history", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*queriedPaths = append(*queriedPaths, address+path)
+
+				query := path[strings.Index(path, "?")+1:]
+				pairs, found := pairsAtBlock[query]
+				if !found {
+					response := value.(*data.AccountKeyValuePairsApiResponse)
+					response.Error = "block not found"
+					return http.StatusInternalServerError, errors.New("block not found")
+				}
+
+				response := value.(*data.AccountKeyValuePairsApiResponse)
+				response.Data.Pairs = pairs
+				response.Data.BlockInfo.Nonce = 10
+				if query == "blockNonce=20" {
+					response.Data.BlockInfo.Nonce = 20
+				}
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("should compute the diff from the full history nodes", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap, _ := process.NewAccountProcessor(createProcessor(&queriedPaths), &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+		diff, err := ap.GetKeyValuePairsDiff(context.Background(), "DEADBEEF", common.AccountKeysDiffQueryOptions{FromBlock: 10, ToBlock: 20})
+		require.Nil(t, err)
+		assert.Equal(t, &data.AccountKeysDiff{
+			FromBlock: data.BlockInfo{Nonce: 10},
+			ToBlock:   data.BlockInfo{Nonce: 20},
+			Added:     map[string]string{"dd": "05"},
+			Changed:   map[string]data.KeyValueChange{"bb": {Before: "02", After: "04"}},
+			Removed:   map[string]string{"cc": "03"},
+		}, diff)
+		assert.Equal(t, []string{
+			"full history/address/DEADBEEF/keys?blockNonce=10",
+			"full history/address/DEADBEEF/keys?blockNonce=20",
+		}, queriedPaths)
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPaths := make([]string, 0)
+		ap, _ := process.NewAccountProcessor(createProcessor(&queriedPaths), &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+		diff, err := ap.GetKeyValuePairsDiff(context.Background(), "DEADBEEF", common.AccountKeysDiffQueryOptions{FromBlock: 10, ToBlock: 30})
+		require.Nil(t, diff)
+		require.Equal(t, "block not found", err.Error())
+	})
+}
+
+func TestAccountProcessor_GetAccountRoutesByDataAvailability(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(fullHistoryNodes []*data.NodeData, queriedNodes *[]string, requestedAvailability *data.ObserverDataAvailabilityType) process.Processor {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				*requestedAvailability = dataAvailability
+				return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return fullHistoryNodes, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if !strings.Contains(path, "/guardian-data") {
+					*queriedNodes = append(*queriedNodes, address)
+				}
+				return 0, nil
+			},
+		}
+	}
+
+	t.Run("real-time query should use the snapshotless observers", func(t *testing.T) {
+		t.Parallel()
+
+		queriedNodes := make([]string, 0)
+		requestedAvailability := data.ObserverDataAvailabilityType("")
+		fullHistoryNodes := []*data.NodeData{{Address: "full history", ShardId: 0}}
+		ap, _ := process.NewAccountProcessor(createProcessor(fullHistoryNodes, &queriedNodes, &requestedAvailability), &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+		_, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"observer"}, queriedNodes)
+		assert.Equal(t, data.AvailabilityRecent, requestedAvailability)
+	})
+	t.Run("historical query should use the full history nodes", func(t *testing.T) {
+		t.Parallel()
+
+		queriedNodes := make([]string, 0)
+		requestedAvailability := data.ObserverDataAvailabilityType("")
+		fullHistoryNodes := []*data.NodeData{{Address: "full history", ShardId: 0}}
+		ap, _ := process.NewAccountProcessor(createProcessor(fullHistoryNodes, &queriedNodes, &requestedAvailability), &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+		_, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 37, HasValue: true}})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"full history"}, queriedNodes)
+		assert.Empty(t, requestedAvailability)
+	})
+	t.Run("historical query without full history nodes should use the regular observers", func(t *testing.T) {
+		t.Parallel()
+
+		queriedNodes := make([]string, 0)
+		requestedAvailability := data.ObserverDataAvailabilityType("")
+		ap, _ := process.NewAccountProcessor(createProcessor(nil, &queriedNodes, &requestedAvailability), &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+		_, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 37, HasValue: true}})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"observer"}, queriedNodes)
+		assert.Equal(t, data.AvailabilityAll, requestedAvailability)
+	})
+}
+
 func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -187,11 +599,13 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, expectedValue, value)
 }
@@ -215,11 +629,13 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Equal(t, "", value)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
 }
@@ -248,6 +664,8 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 			},
 		},
 		bech32C,
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -270,6 +688,8 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -288,9 +708,11 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -315,9 +737,11 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -343,9 +767,11 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
+	response, err := ap.GetESDTsWithRole(context.Background(), address, "role", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -361,9 +787,11 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -388,9 +816,11 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -416,9 +846,11 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
+	response, err := ap.GetESDTsRoles(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -443,9 +875,11 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ExternalStorageConnectorStub{},
+		&mock.DataFreshnessCheckerStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetCodeHash(address, common.AccountQueryOptions{})
+	response, err := ap.GetCodeHash(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "code-hash", response.Data.([]string)[0])
 }
@@ -461,9 +895,11 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("address", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "address", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -488,9 +924,11 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -517,9 +955,11 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.NoError(t, err)
 		require.True(t, result.Data.(bool))
 	})
@@ -563,9 +1003,11 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.Equal(t, expectedError, err.Error())
 		require.Empty(t, result)
 	})
@@ -608,9 +1050,11 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, map[string]*data.Account{
@@ -625,3 +1069,334 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 		}, result.Accounts)
 	})
 }
+
+func TestAccountProcessor_GetAccountsNonces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error if a shard returns error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := "expected error message"
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID}}, nil
+				},
+				CallPostRestEndPointCalled: func(_ string, _ string, _ interface{}, value interface{}) (int, error) {
+					response := value.(*data.AccountsApiResponse)
+					response.Error = expectedError
+					return 0, nil
+				},
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		result, err := ap.GetAccountsNonces(context.Background(), []string{"aabb"})
+		require.Equal(t, expectedError, err.Error())
+		require.Nil(t, result)
+	})
+
+	t.Run("should return the nonces of all the addresses", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID}}, nil
+				},
+				CallPostRestEndPointCalled: func(obsAddr string, _ string, _ interface{}, value interface{}) (int, error) {
+					response := value.(*data.AccountsApiResponse)
+					if obsAddr == "observer0" {
+						response.Data.Accounts = map[string]*data.Account{
+							"aabb": {Address: "aabb", Nonce: 7},
+						}
+					}
+					return 0, nil
+				},
+				ComputeShardIdCalled: func(addr []byte) (uint32, error) {
+					if hex.EncodeToString(addr) == "aabb" {
+						return 0, nil
+					}
+
+					return 1, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		result, err := ap.GetAccountsNonces(context.Background(), []string{"aabb", "bbaa"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]uint64{"aabb": 7, "bbaa": 0}, result.Nonces)
+	})
+}
+
+func TestAccountProcessor_VerifyMessageSignature(t *testing.T) {
+	t.Parallel()
+
+	privKey, address := createFaucetSenderKey(t)
+	_, otherAddress := createFaucetSenderKey(t)
+	message := "login token"
+	signer := &ed25519SingleSigner.Ed25519Signer{}
+
+	prefixedPayload := keccak.NewKeccak().Compute("\x17Elrond Signed Message:\n" + "11" + message)
+	prefixedSignature, _ := signer.Sign(privKey, prefixedPayload)
+	rawSignature, _ := signer.Sign(privKey, []byte(message))
+
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+
+	t.Run("prefixed scheme should be used by default", func(t *testing.T) {
+		t.Parallel()
+
+		verification, err := ap.VerifyMessageSignature(&data.SignatureVerificationRequest{
+			Address:   address,
+			Message:   message,
+			Signature: hex.EncodeToString(prefixedSignature),
+		})
+		require.Nil(t, err)
+		assert.True(t, verification.IsValid)
+		assert.Equal(t, process.MessageSchemePrefixed, verification.Scheme)
+	})
+	t.Run("raw scheme should verify the message as it is", func(t *testing.T) {
+		t.Parallel()
+
+		verification, err := ap.VerifyMessageSignature(&data.SignatureVerificationRequest{
+			Address:   address,
+			Message:   message,
+			Signature: hex.EncodeToString(rawSignature),
+			Scheme:    process.MessageSchemeRaw,
+		})
+		require.Nil(t, err)
+		assert.True(t, verification.IsValid)
+
+		verification, err = ap.VerifyMessageSignature(&data.SignatureVerificationRequest{
+			Address:   address,
+			Message:   message,
+			Signature: hex.EncodeToString(prefixedSignature),
+			Scheme:    process.MessageSchemeRaw,
+		})
+		require.Nil(t, err)
+		assert.False(t, verification.IsValid)
+	})
+	t.Run("signature of another address should not be valid", func(t *testing.T) {
+		t.Parallel()
+
+		verification, err := ap.VerifyMessageSignature(&data.SignatureVerificationRequest{
+			Address:   otherAddress,
+			Message:   message,
+			Signature: hex.EncodeToString(prefixedSignature),
+		})
+		require.Nil(t, err)
+		assert.False(t, verification.IsValid)
+	})
+	t.Run("invalid inputs should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ap.VerifyMessageSignature(&data.SignatureVerificationRequest{Address: "invalid", Signature: "aa"})
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+
+		_, err = ap.VerifyMessageSignature(&data.SignatureVerificationRequest{Address: address, Signature: "not hex"})
+		assert.True(t, errors.Is(err, process.ErrInvalidSignatureBytes))
+
+		_, err = ap.VerifyMessageSignature(&data.SignatureVerificationRequest{Address: address, Signature: "aa", Scheme: "other"})
+		assert.Equal(t, process.ErrUnknownSignatureScheme, err)
+	})
+}
+
+func TestAccountProcessor_GetAddressActivitySummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+		summary, err := ap.GetAddressActivitySummary(context.Background(), "invalid")
+		assert.Nil(t, summary)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("enabled external storage should be used", func(t *testing.T) {
+		t.Parallel()
+
+		receivedTxsCount := uint64(3)
+		expectedSummary := &data.AddressActivitySummary{
+			Address:          "DEADBEEF",
+			SentTxsCount:     2,
+			ReceivedTxsCount: &receivedTxsCount,
+			Source:           data.ActivitySourceExternalStorage,
+		}
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					require.Fail(t, "should have not been called")
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{
+				IsEnabledCalled: func() bool {
+					return true
+				},
+				GetAddressActivitySummaryCalled: func(address string) (*data.AddressActivitySummary, error) {
+					return expectedSummary, nil
+				},
+			},
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		summary, err := ap.GetAddressActivitySummary(context.Background(), "DEADBEEF")
+		require.Nil(t, err)
+		assert.Equal(t, expectedSummary, summary)
+	})
+	t.Run("disabled external storage should fall back to the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					response := value.(*data.AccountApiResponse)
+					response.Data.Account.Nonce = 7
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			&mock.ExternalStorageConnectorStub{},
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		summary, err := ap.GetAddressActivitySummary(context.Background(), "DEADBEEF")
+		require.Nil(t, err)
+		expectedSummary := &data.AddressActivitySummary{
+			Address:      "DEADBEEF",
+			SentTxsCount: 7,
+			Source:       data.ActivitySourceObservers,
+		}
+		assert.Equal(t, expectedSummary, summary)
+	})
+}
+
+func TestAccountProcessor_GetAddressLatestTransactions(t *testing.T) {
+	t.Parallel()
+
+	enabledStorage := func(handler func(address string, size int) ([]data.DatabaseTransaction, error)) *mock.ExternalStorageConnectorStub {
+		return &mock.ExternalStorageConnectorStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetAddressLatestTransactionsCalled: handler,
+		}
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, enabledStorage(nil), &mock.DataFreshnessCheckerStub{})
+		txs, err := ap.GetAddressLatestTransactions("invalid", 10)
+		assert.Nil(t, txs)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("too many transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, enabledStorage(nil), &mock.DataFreshnessCheckerStub{})
+		txs, err := ap.GetAddressLatestTransactions("DEADBEEF", 101)
+		assert.Nil(t, txs)
+		assert.True(t, errors.Is(err, process.ErrInvalidPageSize))
+	})
+	t.Run("disabled external storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+		txs, err := ap.GetAddressLatestTransactions("DEADBEEF", 10)
+		assert.Nil(t, txs)
+		assert.Equal(t, data.ErrNoExternalStorage, err)
+	})
+	t.Run("missing size should use the default one", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxs := []data.DatabaseTransaction{{Hash: "hash"}}
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{},
+			&mock.PubKeyConverterMock{},
+			enabledStorage(func(address string, size int) ([]data.DatabaseTransaction, error) {
+				assert.Equal(t, "DEADBEEF", address)
+				assert.Equal(t, 10, size)
+				return expectedTxs, nil
+			}),
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		txs, err := ap.GetAddressLatestTransactions("DEADBEEF", 0)
+		require.Nil(t, err)
+		assert.Equal(t, expectedTxs, txs)
+	})
+}
+
+func TestAccountProcessor_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	enabledStorage := func(handler func(token string, size int, cursor string) (*data.TokenHoldersPage, error)) *mock.ExternalStorageConnectorStub {
+		return &mock.ExternalStorageConnectorStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetTokenHoldersCalled: handler,
+		}
+	}
+
+	t.Run("empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, enabledStorage(nil), &mock.DataFreshnessCheckerStub{})
+		page, err := ap.GetTokenHolders("", common.TokenHoldersQueryOptions{})
+		assert.Nil(t, page)
+		assert.Equal(t, process.ErrEmptyToken, err)
+	})
+	t.Run("too large page should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, enabledStorage(nil), &mock.DataFreshnessCheckerStub{})
+		page, err := ap.GetTokenHolders("TKN-abcdef", common.TokenHoldersQueryOptions{Size: 1001})
+		assert.Nil(t, page)
+		assert.True(t, errors.Is(err, process.ErrInvalidPageSize))
+	})
+	t.Run("disabled external storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, testPubkeyConverter, &mock.ExternalStorageConnectorStub{}, &mock.DataFreshnessCheckerStub{})
+		page, err := ap.GetTokenHolders("TKN-abcdef", common.TokenHoldersQueryOptions{})
+		assert.Nil(t, page)
+		assert.Equal(t, data.ErrNoExternalStorage, err)
+	})
+	t.Run("should use the default page size", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPage := &data.TokenHoldersPage{Token: "TKN-abcdef"}
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{},
+			testPubkeyConverter,
+			enabledStorage(func(token string, size int, cursor string) (*data.TokenHoldersPage, error) {
+				assert.Equal(t, "TKN-abcdef", token)
+				assert.Equal(t, 25, size)
+				assert.Equal(t, "cursor", cursor)
+				return expectedPage, nil
+			}),
+			&mock.DataFreshnessCheckerStub{},
+		)
+
+		page, err := ap.GetTokenHolders("TKN-abcdef", common.TokenHoldersQueryOptions{Cursor: "cursor"})
+		require.Nil(t, err)
+		assert.Equal(t, expectedPage, page)
+	})
+}
@@ -6,23 +6,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 )
 
-var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
+var log = common.NewRequestIDLogger(logger.GetOrCreate("process"))
 
 const (
 	nodeSyncedNonceDifferenceThreshold = 10
@@ -32,17 +34,35 @@ const (
 
 // BaseProcessor represents an implementation of CoreProcessor that helps to process requests
 type BaseProcessor struct {
+	lastNodesStateCheck            int64
 	mutState                       sync.RWMutex
 	shardCoordinator               common.Coordinator
 	observersProvider              observer.NodesProviderHandler
 	fullHistoryNodesProvider       observer.NodesProviderHandler
 	pubKeyConverter                core.PubkeyConverter
+	shardIDCache                   ShardIDCacher
+	minObserverVersion             string
+	parsedMinObserverVersion       appVersion
+	excludedObservers              map[string]*proxyData.ExcludedObserver
+	mutExcludedObservers           sync.RWMutex
+	observersNonces                map[string]*proxyData.ObserverNonce
+	mutObserversNonces             sync.RWMutex
 	shardIDs                       []uint32
 	nodeStatusFetcher              func(url string) (*proxyData.NodeStatusAPIResponse, int, error)
+	networkConfigFetcher           func(url string) (*proxyData.NetworkConfig, int, error)
 	chanTriggerNodesState          chan struct{}
 	delayForCheckingNodesSyncState time.Duration
 	cancelFunc                     func()
 	noStatusCheck                  bool
+	observersRanker                ObserversRanker
+	knownObservers                 map[string]*proxyData.NodeData
+	mutKnownObservers              sync.RWMutex
+	expectedChainID                string
+	expectedMinTransactionVersion  uint32
+	localZone                      string
+	responseCompression            bool
+	observerEventsNotifier         ObserverEventsNotifier
+	observersAffinityCache         ObserversAffinityCacher
 
 	httpClient *http.Client
 }
@@ -54,7 +74,17 @@ func NewBaseProcessor(
 	observersProvider observer.NodesProviderHandler,
 	fullHistoryNodesProvider observer.NodesProviderHandler,
 	pubKeyConverter core.PubkeyConverter,
+	shardIDCache ShardIDCacher,
+	minObserverVersion string,
 	noStatusCheck bool,
+	observersRanker ObserversRanker,
+	expectedChainID string,
+	expectedMinTransactionVersion uint32,
+	localZone string,
+	responseCompression bool,
+	observerEventsNotifier ObserverEventsNotifier,
+	observersAffinityCache ObserversAffinityCacher,
+	staticObserverHeaders []config.StaticHeaderConfig,
 ) (*BaseProcessor, error) {
 	if check.IfNil(shardCoord) {
 		return nil, ErrNilShardCoordinator
@@ -71,11 +101,31 @@ func NewBaseProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(shardIDCache) {
+		return nil, ErrNilShardIDCache
+	}
+	if check.IfNil(observersRanker) {
+		return nil, ErrNilObserversRanker
+	}
+	if check.IfNil(observerEventsNotifier) {
+		return nil, ErrNilObserverEventsNotifier
+	}
+	if check.IfNil(observersAffinityCache) {
+		return nil, ErrNilObserversAffinityCache
+	}
+
+	httpClient, err := newObserversHttpClient(requestTimeoutSec, staticObserverHeaders)
+	if err != nil {
+		return nil, err
+	}
 
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
+	var parsedMinObserverVersion appVersion
+	if len(minObserverVersion) > 0 {
+		parsedMinObserverVersion, err = parseAppVersion(minObserverVersion)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the minimum observer version", err)
+		}
+	}
 
 	bp := &BaseProcessor{
 		shardCoordinator:               shardCoord,
@@ -83,16 +133,40 @@ func NewBaseProcessor(
 		fullHistoryNodesProvider:       fullHistoryNodesProvider,
 		httpClient:                     httpClient,
 		pubKeyConverter:                pubKeyConverter,
+		shardIDCache:                   shardIDCache,
+		minObserverVersion:             minObserverVersion,
+		parsedMinObserverVersion:       parsedMinObserverVersion,
+		excludedObservers:              make(map[string]*proxyData.ExcludedObserver),
+		observersNonces:                make(map[string]*proxyData.ObserverNonce),
 		shardIDs:                       computeShardIDs(shardCoord),
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
 		chanTriggerNodesState:          make(chan struct{}),
 		noStatusCheck:                  noStatusCheck,
+		observersRanker:                observersRanker,
+		expectedChainID:                expectedChainID,
+		expectedMinTransactionVersion:  expectedMinTransactionVersion,
+		localZone:                      localZone,
+		responseCompression:            responseCompression,
+		observerEventsNotifier:         observerEventsNotifier,
+		observersAffinityCache:         observersAffinityCache,
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
+	bp.networkConfigFetcher = bp.getNetworkConfigFromAPI
 
 	if noStatusCheck {
 		log.Info("Proxy started with no status check! The provided observers will always be considered synced!")
 	}
+	if len(localZone) > 0 {
+		log.Info("observers in the same zone as the proxy will be preferred", "zone", localZone)
+	}
+	if len(minObserverVersion) > 0 {
+		log.Info("observers running a version below the minimum one will be excluded", "minimum version", minObserverVersion)
+	}
+	if bp.isNetworkVerificationEnabled() {
+		log.Info("observers connected to another network will be excluded",
+			"expected chain ID", expectedChainID,
+			"expected min transaction version", expectedMinTransactionVersion)
+	}
 
 	return bp, nil
 }
@@ -117,17 +191,198 @@ func (bp *BaseProcessor) GetShardIDs() []uint32 {
 
 // ReloadObservers will call the nodes reloading from the observers provider
 func (bp *BaseProcessor) ReloadObservers() proxyData.NodesReloadResponse {
-	return bp.observersProvider.ReloadNodes(proxyData.Observer)
+	response := bp.observersProvider.ReloadNodes(proxyData.Observer)
+	bp.refreshKnownObservers()
+
+	return response
 }
 
 // ReloadFullHistoryObservers will call the nodes reloading from the full history observers provider
 func (bp *BaseProcessor) ReloadFullHistoryObservers() proxyData.NodesReloadResponse {
-	return bp.fullHistoryNodesProvider.ReloadNodes(proxyData.FullHistoryNode)
+	response := bp.fullHistoryNodesProvider.ReloadNodes(proxyData.FullHistoryNode)
+	bp.refreshKnownObservers()
+
+	return response
+}
+
+// AddObserver will probe the provided observer and, if it reports the declared shard, add it to the live observers list.
+// The shard of an observer declared without one is the shard it reports
+func (bp *BaseProcessor) AddObserver(node *proxyData.NodeData) error {
+	// the credentials are needed for the probe itself, while the zone is needed as soon as the node serves requests
+	bp.setKnownObserver(node)
+	err := bp.probeAndAddObserver(node)
+	if err != nil {
+		bp.refreshKnownObservers()
+	}
+
+	return wrapObserverUpdateError(err)
+}
+
+func (bp *BaseProcessor) probeAndAddObserver(node *proxyData.NodeData) error {
+	nodeStatusResponse, httpCode, err := bp.nodeStatusFetcher(node.Address)
+	if err != nil {
+		return fmt.Errorf("%w for observer %s: %s", ErrObserverProbeFailed, node.Address, err.Error())
+	}
+	if httpCode != http.StatusOK {
+		return fmt.Errorf("%w for observer %s: responded with code %d", ErrObserverProbeFailed, node.Address, httpCode)
+	}
+
+	if !bp.isVersionAccepted(nodeStatusResponse.Data.Metrics.AppVersion) {
+		return fmt.Errorf("%w for observer %s: version %s, minimum version %s",
+			ErrObserverVersionTooLow,
+			node.Address,
+			nodeStatusResponse.Data.Metrics.AppVersion,
+			bp.minObserverVersion,
+		)
+	}
+
+	networkMismatch, err := bp.checkObserverNetwork(node)
+	if err != nil {
+		return fmt.Errorf("%w for observer %s: %s", ErrObserverProbeFailed, node.Address, err.Error())
+	}
+	if networkMismatch != nil {
+		return fmt.Errorf("%w for observer %s: chain ID %s, min transaction version %d",
+			ErrObserverNetworkMismatch,
+			node.Address,
+			networkMismatch.ChainID,
+			networkMismatch.MinTransactionVersion,
+		)
+	}
+
+	reportedShardID := nodeStatusResponse.Data.Metrics.ShardID
+	if node.AutoDetectShard {
+		log.Info("detected the shard of the observer", "address", node.Address, "shard", reportedShardID)
+		node.ShardId = reportedShardID
+		node.AutoDetectShard = false
+	}
+	if reportedShardID != node.ShardId {
+		return fmt.Errorf("%w for observer %s: declared shard %d, reported shard %d",
+			ErrObserverShardMismatch,
+			node.Address,
+			node.ShardId,
+			reportedShardID,
+		)
+	}
+
+	// the observer has just responded, so it is considered synced until the next nodes state check
+	node.IsSynced = true
+
+	return bp.observersProvider.AddNode(node)
+}
+
+// RemoveObserver will remove the observer with the provided address from the live observers list. The address can
+// be provided with or without the scheme
+func (bp *BaseProcessor) RemoveObserver(address string) error {
+	for _, node := range bp.observersProvider.GetAllNodesWithSyncState() {
+		if node.Address == address || stripScheme(node.Address) == address {
+			bp.removeExcludedObserver(node.Address)
+			err := bp.observersProvider.RemoveNode(node.Address)
+			bp.refreshKnownObservers()
+
+			return wrapObserverUpdateError(err)
+		}
+	}
+
+	return wrapObserverUpdateError(bp.observersProvider.RemoveNode(address))
+}
+
+// wrapObserverUpdateError marks the errors caused by the provided observer, and not by the proxy, so that the API can
+// respond with the matching status
+func wrapObserverUpdateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, observer.ErrNodeNotFound):
+		return fmt.Errorf("%w: %w", proxyData.ErrObserverNotFound, err)
+	case errors.Is(err, ErrObserverVersionTooLow),
+		errors.Is(err, ErrObserverNetworkMismatch),
+		errors.Is(err, ErrObserverShardMismatch),
+		errors.Is(err, observer.ErrInvalidShard),
+		errors.Is(err, observer.ErrNodeAlreadyExists),
+		errors.Is(err, observer.ErrLastNodeInShard):
+		return fmt.Errorf("%w: %w", proxyData.ErrInvalidObserver, err)
+	default:
+		return err
+	}
 }
 
-// GetObservers returns the registered observers on a shard
+func stripScheme(address string) string {
+	_, addressWithoutScheme, found := strings.Cut(address, "://")
+	if !found {
+		return address
+	}
+
+	return addressWithoutScheme
+}
+
+// GetObservers returns the registered observers on a shard, ranked for reads, the ones in the proxy's zone first
 func (bp *BaseProcessor) GetObservers(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
+	observers, err := bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	rankedObservers := bp.observersProvider.ApplyWarmUp(bp.observersRanker.RankForReads(observers))
+
+	return bp.preferLocalZone(rankedObservers), nil
+}
+
+// GetObserversForWrite returns the registered observers on a shard, ranked for writes, the ones in the proxy's zone first
+func (bp *BaseProcessor) GetObserversForWrite(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
+	observers, err := bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions are never relayed to an observer of another network, even if it is the only one left in the shard
+	verifiedObservers := make([]*proxyData.NodeData, 0, len(observers))
+	for _, node := range observers {
+		if bp.isExcludedForNetworkMismatch(node.Address) {
+			continue
+		}
+
+		verifiedObservers = append(verifiedObservers, node)
+	}
+	if len(verifiedObservers) == 0 {
+		return nil, ErrNoVerifiedObserverForWrite
+	}
+
+	rankedObservers := bp.observersProvider.ApplyWarmUp(bp.observersRanker.RankForWrites(verifiedObservers))
+
+	return bp.preferLocalZone(rankedObservers), nil
+}
+
+// RecordWriteObserver remembers the observer which accepted the transactions of the sender, so that the sender's reads
+// issued shortly after are served by the same observer
+func (bp *BaseProcessor) RecordWriteObserver(sender string, observerAddress string) {
+	if len(sender) == 0 || len(observerAddress) == 0 {
+		return
+	}
+
+	bp.observersAffinityCache.Put(sender, observerAddress)
+}
+
+// PreferWriteObserver moves in front of the provided observers the one which recently accepted the transactions of the
+// sender, if any, so that the sender reads its own writes (such as the incremented nonce) before all the observers
+// catch up. The provided slice is not altered
+func (bp *BaseProcessor) PreferWriteObserver(sender string, observers []*proxyData.NodeData) []*proxyData.NodeData {
+	observerAddress, found := bp.observersAffinityCache.Get(sender)
+	if !found {
+		return observers
+	}
+
+	for idx, node := range observers {
+		if node.Address != observerAddress {
+			continue
+		}
+
+		preferredObservers := make([]*proxyData.NodeData, 0, len(observers))
+		preferredObservers = append(preferredObservers, node)
+		preferredObservers = append(preferredObservers, observers[:idx]...)
+		return append(preferredObservers, observers[idx+1:]...)
+	}
+
+	return observers
 }
 
 // GetAllObservers will return all the observers, regardless of shard ID
@@ -137,12 +392,20 @@ func (bp *BaseProcessor) GetAllObservers(dataAvailability proxyData.ObserverData
 
 // GetObserversOnePerShard will return a slice containing an observer for each shard
 func (bp *BaseProcessor) GetObserversOnePerShard(dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.observersProvider.GetNodesByShardId, dataAvailability)
+	return bp.getNodesOnePerShard(bp.observersProvider, dataAvailability)
 }
 
-// GetFullHistoryNodes returns the registered full history nodes on a shard
+// GetFullHistoryNodes returns the registered full history nodes on a shard, ranked for reads, the ones in the proxy's
+// zone first
 func (bp *BaseProcessor) GetFullHistoryNodes(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetNodesByShardId(shardID, dataAvailability)
+	nodes, err := bp.fullHistoryNodesProvider.GetNodesByShardId(shardID, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	rankedNodes := bp.fullHistoryNodesProvider.ApplyWarmUp(bp.observersRanker.RankForReads(nodes))
+
+	return bp.preferLocalZone(rankedNodes), nil
 }
 
 // GetAllFullHistoryNodes will return all the full history nodes, regardless of shard ID
@@ -152,27 +415,29 @@ func (bp *BaseProcessor) GetAllFullHistoryNodes(dataAvailability proxyData.Obser
 
 // GetFullHistoryNodesOnePerShard will return a slice containing a full history node for each shard
 func (bp *BaseProcessor) GetFullHistoryNodesOnePerShard(dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.fullHistoryNodesProvider.GetNodesByShardId, dataAvailability)
+	return bp.getNodesOnePerShard(bp.fullHistoryNodesProvider, dataAvailability)
 }
 
 func (bp *BaseProcessor) getNodesOnePerShard(
-	observersInShardGetter func(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error),
+	nodesProvider observer.NodesProviderHandler,
 	dataAvailability proxyData.ObserverDataAvailabilityType,
 ) ([]*proxyData.NodeData, error) {
 	numShards := bp.shardCoordinator.NumberOfShards()
 	sliceToReturn := make([]*proxyData.NodeData, 0)
 
 	for shardID := uint32(0); shardID < numShards; shardID++ {
-		observersInShard, err := observersInShardGetter(shardID, dataAvailability)
+		observersInShard, err := nodesProvider.GetNodesByShardId(shardID, dataAvailability)
 		if err != nil || len(observersInShard) < 1 {
 			continue
 		}
 
+		observersInShard = nodesProvider.ApplyWarmUp(observersInShard)
 		sliceToReturn = append(sliceToReturn, observersInShard[0])
 	}
 
-	observersInShardMeta, err := observersInShardGetter(core.MetachainShardId, dataAvailability)
+	observersInShardMeta, err := nodesProvider.GetNodesByShardId(core.MetachainShardId, dataAvailability)
 	if err == nil && len(observersInShardMeta) > 0 {
+		observersInShardMeta = nodesProvider.ApplyWarmUp(observersInShardMeta)
 		sliceToReturn = append(sliceToReturn, observersInShardMeta[0])
 	}
 
@@ -185,20 +450,31 @@ func (bp *BaseProcessor) getNodesOnePerShard(
 
 // ComputeShardId computes the shard id in which the account resides
 func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
+	shardID, found := bp.shardIDCache.Get(addressBuff)
+	if found {
+		return shardID, nil
+	}
+
 	bp.mutState.RLock()
-	defer bp.mutState.RUnlock()
+	shardID = bp.shardCoordinator.ComputeId(addressBuff)
+	bp.mutState.RUnlock()
+
+	bp.shardIDCache.Put(addressBuff, shardID)
 
-	return bp.shardCoordinator.ComputeId(addressBuff), nil
+	return shardID, nil
 }
 
-// CallGetRestEndPoint calls an external end point (sends a request on a node)
+// CallGetRestEndPoint calls an external end point (sends a request on a node). The context is the one of the request
+// being served, so its identifier and forwarded headers are sent to the node and the call is aborted if the client
+// goes away
 func (bp *BaseProcessor) CallGetRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	value interface{},
 ) (int, error) {
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", address+path, nil)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -206,9 +482,19 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	userAgent := "Multiversx Proxy / 1.0.0 <Requesting data from nodes>"
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
+	bp.setAcceptEncodingHeader(req)
+	setRequestIDHeader(ctx, req)
+	bp.setAuthorizationHeader(req, address)
 
+	requestStartTime := time.Now()
 	resp, err := bp.httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			// the client went away, the observer is not to blame
+			return http.StatusRequestTimeout, err
+		}
+
+		bp.observersRanker.RecordRequest(address, http.MethodGet, time.Since(requestStartTime), false)
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -220,11 +506,15 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	defer func() {
 		errNotCritical := resp.Body.Close()
 		if errNotCritical != nil {
-			log.Warn("base process GET: close body", "error", errNotCritical.Error())
+			log.WithContext(ctx).Warn("base process GET: close body", "error", errNotCritical.Error())
 		}
 	}()
 
-	responseBodyBytes, err := io.ReadAll(resp.Body)
+	responseBodyBytes, err := readResponseBody(resp)
+	isSuccessfulRequest := err == nil && resp.StatusCode < http.StatusInternalServerError
+	if ctx.Err() == nil {
+		bp.observersRanker.RecordRequest(address, http.MethodGet, time.Since(requestStartTime), isSuccessfulRequest)
+	}
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -234,6 +524,8 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 		return http.StatusInternalServerError, err
 	}
 
+	bp.recordObserverZone(ctx, address)
+
 	responseStatusCode := resp.StatusCode
 	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
 		return responseStatusCode, nil
@@ -243,8 +535,11 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	return responseStatusCode, errors.New(string(responseBodyBytes))
 }
 
-// CallPostRestEndPoint calls an external end point (sends a request on a node)
+// CallPostRestEndPoint calls an external end point (sends a request on a node). The context is the one of the request
+// being served, so its identifier and forwarded headers are sent to the node and the call is aborted if the client
+// goes away
 func (bp *BaseProcessor) CallPostRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	data interface{},
@@ -256,7 +551,7 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	req, err := http.NewRequestWithContext(ctx, "POST", address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -265,9 +560,19 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
+	bp.setAcceptEncodingHeader(req)
+	setRequestIDHeader(ctx, req)
+	bp.setAuthorizationHeader(req, address)
 
+	requestStartTime := time.Now()
 	resp, err := bp.httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			// the client went away, the observer is not to blame
+			return http.StatusRequestTimeout, err
+		}
+
+		bp.observersRanker.RecordRequest(address, http.MethodPost, time.Since(requestStartTime), false)
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -279,15 +584,21 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	defer func() {
 		errNotCritical := resp.Body.Close()
 		if errNotCritical != nil {
-			log.Warn("base process POST: close body", "error", errNotCritical.Error())
+			log.WithContext(ctx).Warn("base process POST: close body", "error", errNotCritical.Error())
 		}
 	}()
 
-	responseBodyBytes, err := io.ReadAll(resp.Body)
+	responseBodyBytes, err := readResponseBody(resp)
+	isSuccessfulRequest := err == nil && resp.StatusCode < http.StatusInternalServerError
+	if ctx.Err() == nil {
+		bp.observersRanker.RecordRequest(address, http.MethodPost, time.Since(requestStartTime), isSuccessfulRequest)
+	}
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
+	bp.recordObserverZone(ctx, address)
+
 	responseStatusCode := resp.StatusCode
 	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
 		return responseStatusCode, json.Unmarshal(responseBodyBytes, response)
@@ -362,7 +673,7 @@ func (bp *BaseProcessor) handleOutOfSyncNodes(ctx context.Context) {
 		case <-timer.C:
 		case <-bp.chanTriggerNodesState:
 		case <-ctx.Done():
-			log.Info("finishing BaseProcessor nodes state update...")
+			log.WithContext(ctx).Info("finishing BaseProcessor nodes state update...")
 			return
 		}
 
@@ -370,9 +681,24 @@ func (bp *BaseProcessor) handleOutOfSyncNodes(ctx context.Context) {
 	}
 }
 
+// GetLastNodesStateCheckTime returns the time when the sync state of the nodes was last checked, or the zero time if
+// no check has completed yet
+func (bp *BaseProcessor) GetLastNodesStateCheckTime() time.Time {
+	lastCheck := atomic.LoadInt64(&bp.lastNodesStateCheck)
+	if lastCheck == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, lastCheck)
+}
+
 func (bp *BaseProcessor) handleNodes() {
 	// if proxy is started with no-status-check flag, only print the observers.
 	// they are already initialized by default as synced.
+	defer func() {
+		atomic.StoreInt64(&bp.lastNodesStateCheck, time.Now().UnixNano())
+	}()
+
 	if bp.noStatusCheck {
 		bp.observersProvider.PrintNodesInShards()
 		bp.fullHistoryNodesProvider.PrintNodesInShards()
@@ -383,13 +709,117 @@ func (bp *BaseProcessor) handleNodes() {
 }
 
 func (bp *BaseProcessor) updateNodesWithSync() {
+	bp.refreshKnownObservers()
+
 	observers := bp.observersProvider.GetAllNodesWithSyncState()
+	previousObserversSyncState := getSyncStateByAddress(observers)
 	observersWithSyncStatus := bp.getNodesWithSyncStatus(observers)
 	bp.observersProvider.UpdateNodesBasedOnSyncState(observersWithSyncStatus)
 
 	fullHistoryNodes := bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()
+	previousFullHistoryNodesSyncState := getSyncStateByAddress(fullHistoryNodes)
 	fullHistoryNodesWithSyncStatus := bp.getNodesWithSyncStatus(fullHistoryNodes)
 	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodesWithSyncStatus)
+
+	bp.pruneExcludedObservers(observers, fullHistoryNodes)
+	bp.pruneObserversNonces(observers, fullHistoryNodes)
+
+	events := computeObserverEvents(proxyData.Observer, previousObserversSyncState, observersWithSyncStatus)
+	events = append(events, computeObserverEvents(proxyData.FullHistoryNode, previousFullHistoryNodesSyncState, fullHistoryNodesWithSyncStatus)...)
+	if len(events) > 0 {
+		bp.observerEventsNotifier.NotifyObserverEvents(events)
+	}
+}
+
+func getSyncStateByAddress(nodes []*proxyData.NodeData) map[string]bool {
+	syncStateByAddress := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		syncStateByAddress[node.Address] = node.IsSynced
+	}
+
+	return syncStateByAddress
+}
+
+// computeObserverEvents returns the events of the nodes whose sync state changed since the previous check, along with
+// the events of the shards which lost all their synced nodes or got back some
+func computeObserverEvents(
+	nodeType proxyData.NodeType,
+	previousSyncState map[string]bool,
+	nodesWithSyncStatus []*proxyData.NodeData,
+) []*proxyData.ObserverEvent {
+	timestamp := time.Now().Unix()
+	events := make([]*proxyData.ObserverEvent, 0)
+	previousSyncedNodesInShard := make(map[uint32]int)
+	syncedNodesInShard := make(map[uint32]int)
+	shardIDs := make([]uint32, 0)
+	handledAddresses := make(map[string]struct{}, len(nodesWithSyncStatus))
+	for _, node := range nodesWithSyncStatus {
+		_, handled := handledAddresses[node.Address]
+		if handled {
+			continue
+		}
+		handledAddresses[node.Address] = struct{}{}
+
+		_, knownShard := syncedNodesInShard[node.ShardId]
+		if !knownShard {
+			shardIDs = append(shardIDs, node.ShardId)
+			syncedNodesInShard[node.ShardId] = 0
+		}
+
+		wasSynced := previousSyncState[node.Address]
+		if wasSynced {
+			previousSyncedNodesInShard[node.ShardId]++
+		}
+		if node.IsSynced {
+			syncedNodesInShard[node.ShardId]++
+		}
+
+		switch {
+		case wasSynced && !node.IsSynced:
+			events = append(events, &proxyData.ObserverEvent{
+				Type:      proxyData.ObserverQuarantined,
+				NodeType:  nodeType,
+				ShardID:   node.ShardId,
+				Address:   node.Address,
+				Message:   fmt.Sprintf("%s %s of shard %d is out of sync and no longer receives requests", nodeType, node.Address, node.ShardId),
+				Timestamp: timestamp,
+			})
+		case !wasSynced && node.IsSynced:
+			events = append(events, &proxyData.ObserverEvent{
+				Type:      proxyData.ObserverRestored,
+				NodeType:  nodeType,
+				ShardID:   node.ShardId,
+				Address:   node.Address,
+				Message:   fmt.Sprintf("%s %s of shard %d is back in sync and receives requests again", nodeType, node.Address, node.ShardId),
+				Timestamp: timestamp,
+			})
+		}
+	}
+
+	for _, shardID := range shardIDs {
+		hadSyncedNodes := previousSyncedNodesInShard[shardID] > 0
+		hasSyncedNodes := syncedNodesInShard[shardID] > 0
+		switch {
+		case hadSyncedNodes && !hasSyncedNodes:
+			events = append(events, &proxyData.ObserverEvent{
+				Type:      proxyData.ShardWithoutObservers,
+				NodeType:  nodeType,
+				ShardID:   shardID,
+				Message:   fmt.Sprintf("shard %d has no synced %s left", shardID, nodeType),
+				Timestamp: timestamp,
+			})
+		case !hadSyncedNodes && hasSyncedNodes:
+			events = append(events, &proxyData.ObserverEvent{
+				Type:      proxyData.ShardObserversRestored,
+				NodeType:  nodeType,
+				ShardID:   shardID,
+				Message:   fmt.Sprintf("shard %d has synced %s again", shardID, nodeType),
+				Timestamp: timestamp,
+			})
+		}
+	}
+
+	return events
 }
 
 func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*proxyData.NodeData {
@@ -399,6 +829,7 @@ func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*
 		if err != nil {
 			log.Warn("cannot get node status. will mark as inactive", "address", node.Address, "error", err)
 			isSynced = false
+			bp.removeObserverNonce(node.Address)
 		}
 
 		node.IsSynced = isSynced
@@ -416,6 +847,42 @@ func (bp *BaseProcessor) isNodeSynced(node *proxyData.NodeData) (bool, error) {
 	if httpCode != http.StatusOK {
 		return false, fmt.Errorf("observer %s responded with code %d", node.Address, httpCode)
 	}
+	bp.setObserverNonce(node, nodeStatusResponse.Data.Metrics.Nonce)
+
+	nodeVersion := nodeStatusResponse.Data.Metrics.AppVersion
+	if !bp.isVersionAccepted(nodeVersion) {
+		log.Warn("observer excluded as it runs a version below the minimum one",
+			"address", node.Address,
+			"shard", node.ShardId,
+			"version", nodeVersion,
+			"minimum version", bp.minObserverVersion)
+		bp.addExcludedObserver(&proxyData.ExcludedObserver{
+			Address:        node.Address,
+			ShardID:        node.ShardId,
+			Reason:         proxyData.ExclusionReasonVersion,
+			Version:        nodeVersion,
+			MinimumVersion: bp.minObserverVersion,
+		})
+		return false, nil
+	}
+
+	networkMismatch, err := bp.checkObserverNetwork(node)
+	if err != nil {
+		return false, err
+	}
+	if networkMismatch != nil {
+		log.Error("observer excluded as it is connected to another network",
+			"address", node.Address,
+			"shard", node.ShardId,
+			"chain ID", networkMismatch.ChainID,
+			"expected chain ID", networkMismatch.ExpectedChainID,
+			"min transaction version", networkMismatch.MinTransactionVersion,
+			"expected min transaction version", networkMismatch.ExpectedMinTransactionVersion)
+		networkMismatch.Version = nodeVersion
+		bp.addExcludedObserver(networkMismatch)
+		return false, nil
+	}
+	bp.removeExcludedObserver(node.Address)
 
 	nonce := nodeStatusResponse.Data.Metrics.Nonce
 	probableHighestNonce := nodeStatusResponse.Data.Metrics.ProbableHighestNonce
@@ -448,6 +915,133 @@ func (bp *BaseProcessor) isNodeSynced(node *proxyData.NodeData) (bool, error) {
 	return isNodeSynced, nil
 }
 
+func (bp *BaseProcessor) isVersionAccepted(version string) bool {
+	if len(bp.minObserverVersion) == 0 {
+		return true
+	}
+
+	nodeVersion, err := parseAppVersion(version)
+	if err != nil {
+		return false
+	}
+
+	return !nodeVersion.isLowerThan(bp.parsedMinObserverVersion)
+}
+
+func (bp *BaseProcessor) addExcludedObserver(excludedObserver *proxyData.ExcludedObserver) {
+	bp.mutExcludedObservers.Lock()
+	bp.excludedObservers[excludedObserver.Address] = excludedObserver
+	bp.mutExcludedObservers.Unlock()
+}
+
+func (bp *BaseProcessor) isExcludedForNetworkMismatch(address string) bool {
+	bp.mutExcludedObservers.RLock()
+	defer bp.mutExcludedObservers.RUnlock()
+
+	excludedObserver, found := bp.excludedObservers[address]
+
+	return found && excludedObserver.Reason == proxyData.ExclusionReasonNetworkMismatch
+}
+
+func (bp *BaseProcessor) removeExcludedObserver(address string) {
+	bp.mutExcludedObservers.Lock()
+	delete(bp.excludedObservers, address)
+	bp.mutExcludedObservers.Unlock()
+}
+
+// pruneExcludedObservers removes the exclusions of the nodes that are no longer configured
+func (bp *BaseProcessor) pruneExcludedObservers(nodesLists ...[]*proxyData.NodeData) {
+	configuredAddresses := make(map[string]struct{})
+	for _, nodes := range nodesLists {
+		for _, node := range nodes {
+			configuredAddresses[node.Address] = struct{}{}
+		}
+	}
+
+	bp.mutExcludedObservers.Lock()
+	for address := range bp.excludedObservers {
+		if _, found := configuredAddresses[address]; !found {
+			delete(bp.excludedObservers, address)
+		}
+	}
+	bp.mutExcludedObservers.Unlock()
+}
+
+func (bp *BaseProcessor) setObserverNonce(node *proxyData.NodeData, nonce uint64) {
+	bp.mutObserversNonces.Lock()
+	bp.observersNonces[node.Address] = &proxyData.ObserverNonce{
+		Address: node.Address,
+		ShardID: node.ShardId,
+		Nonce:   nonce,
+	}
+	bp.mutObserversNonces.Unlock()
+}
+
+func (bp *BaseProcessor) removeObserverNonce(address string) {
+	bp.mutObserversNonces.Lock()
+	delete(bp.observersNonces, address)
+	bp.mutObserversNonces.Unlock()
+}
+
+// pruneObserversNonces removes the nonces of the nodes that are no longer configured
+func (bp *BaseProcessor) pruneObserversNonces(nodesLists ...[]*proxyData.NodeData) {
+	configuredAddresses := make(map[string]struct{})
+	for _, nodes := range nodesLists {
+		for _, node := range nodes {
+			configuredAddresses[node.Address] = struct{}{}
+		}
+	}
+
+	bp.mutObserversNonces.Lock()
+	for address := range bp.observersNonces {
+		if _, found := configuredAddresses[address]; !found {
+			delete(bp.observersNonces, address)
+		}
+	}
+	bp.mutObserversNonces.Unlock()
+}
+
+// GetObserversNonces returns the nonces reported by the observers and the full history nodes during the last nodes
+// sync state check, sorted by shard and address. The nodes which could not be reached are not included
+func (bp *BaseProcessor) GetObserversNonces() []*proxyData.ObserverNonce {
+	bp.mutObserversNonces.RLock()
+	defer bp.mutObserversNonces.RUnlock()
+
+	observersNonces := make([]*proxyData.ObserverNonce, 0, len(bp.observersNonces))
+	for _, observerNonce := range bp.observersNonces {
+		observerNonceCopy := *observerNonce
+		observersNonces = append(observersNonces, &observerNonceCopy)
+	}
+
+	sort.Slice(observersNonces, func(i, j int) bool {
+		if observersNonces[i].ShardID != observersNonces[j].ShardID {
+			return observersNonces[i].ShardID < observersNonces[j].ShardID
+		}
+		return observersNonces[i].Address < observersNonces[j].Address
+	})
+
+	return observersNonces
+}
+
+// GetExcludedObservers returns the observers excluded for running a version below the minimum accepted one or for
+// being connected to another network
+func (bp *BaseProcessor) GetExcludedObservers() []*proxyData.ExcludedObserver {
+	bp.mutExcludedObservers.RLock()
+	defer bp.mutExcludedObservers.RUnlock()
+
+	excludedObservers := make([]*proxyData.ExcludedObserver, 0, len(bp.excludedObservers))
+	for _, excludedObserver := range bp.excludedObservers {
+		excludedObserverCopy := *excludedObserver
+		excludedObservers = append(excludedObservers, &excludedObserverCopy)
+	}
+
+	sort.Slice(excludedObservers, func(i, j int) bool {
+		return excludedObservers[i].Address < excludedObservers[j].Address
+	})
+
+	return excludedObservers
+}
+
 func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.NodeStatusAPIResponse, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutDurationForNodeStatus)
 	defer cancel()
@@ -456,6 +1050,8 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
+	bp.setAcceptEncodingHeader(req)
+	bp.setAuthorizationHeader(req, url)
 
 	resp, err := bp.httpClient.Do(req)
 	if err != nil {
@@ -472,7 +1068,7 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 		return nil, resp.StatusCode, nil
 	}
 
-	responseBodyBytes, err := io.ReadAll(resp.Body)
+	responseBodyBytes, err := readResponseBody(resp)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
@@ -504,3 +1100,12 @@ func (bp *BaseProcessor) Close() error {
 
 	return nil
 }
+
+// setRequestIDHeader forwards the identifier of the request being served, if any, so the observer logs can be
+// correlated with the proxy ones
+func setRequestIDHeader(ctx context.Context, req *http.Request) {
+	requestID := common.GetRequestID(ctx)
+	if len(requestID) > 0 {
+		req.Header.Set(common.RequestIDHeader, requestID)
+	}
+}
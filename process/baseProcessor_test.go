@@ -2,19 +2,26 @@ package process_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,7 +60,17 @@ func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.Nil(t, bp)
@@ -69,7 +86,17 @@ func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.Nil(t, bp)
@@ -85,7 +112,17 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.Nil(t, bp)
@@ -101,13 +138,75 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithNilShardIDCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		nil,
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilShardIDCache, err)
+}
+
+func TestNewBaseProcessor_WithInvalidMinObserverVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"latest",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.True(t, errors.Is(err, process.ErrInvalidAppVersion))
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -117,7 +216,17 @@ func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.NotNil(t, bp)
@@ -140,7 +249,17 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 	observers, err := bp.GetObservers(0, data.AvailabilityAll)
 
@@ -148,6 +267,211 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 	assert.Equal(t, observersSlice, observers)
 }
 
+func TestNewBaseProcessor_WithNilObserversRankerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		nil,
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilObserversRanker, err)
+}
+
+func TestNewBaseProcessor_WithNilObserverEventsNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		nil,
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilObserverEventsNotifier, err)
+}
+
+func TestNewBaseProcessor_WithNilObserversAffinityCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		nil,
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilObserversAffinityCache, err)
+}
+
+func TestBaseProcessor_PreferWriteObserver(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "observer0"},
+		{Address: "observer1"},
+		{Address: "observer2"},
+	}
+	affinityCache, _ := cache.NewObserversAffinityCache(10, time.Minute)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		affinityCache,
+		nil,
+	)
+
+	assert.Equal(t, observers, bp.PreferWriteObserver("sender", observers))
+
+	bp.RecordWriteObserver("sender", "observer2")
+	preferredObservers := bp.PreferWriteObserver("sender", observers)
+	assert.Equal(t, []*data.NodeData{observers[2], observers[0], observers[1]}, preferredObservers)
+	assert.Equal(t, "observer0", observers[0].Address, "the provided observers should not be altered")
+	assert.Equal(t, observers, bp.PreferWriteObserver("another sender", observers))
+
+	bp.RecordWriteObserver("sender", "removed observer")
+	assert.Equal(t, observers, bp.PreferWriteObserver("sender", observers))
+}
+
+func TestBaseProcessor_GetObserversShouldUseSeparateRankings(t *testing.T) {
+	t.Parallel()
+
+	observersSlice := []*data.NodeData{{Address: "addr0"}, {Address: "addr1"}}
+	latencies := map[string]map[string]time.Duration{
+		http.MethodGet:  {"addr0": time.Millisecond, "addr1": time.Second},
+		http.MethodPost: {"addr0": time.Second, "addr1": time.Millisecond},
+	}
+	ranker, _ := process.NewObserversLatencyRanker(createLatencyProvider(latencies), 2)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return observersSlice, nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		ranker,
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	observers, err := bp.GetObservers(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, "addr0", observers[0].Address)
+
+	observers, err = bp.GetObserversForWrite(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, "addr1", observers[0].Address)
+}
+
+func TestBaseProcessor_GetObserversShouldApplyWarmUpAfterRanking(t *testing.T) {
+	t.Parallel()
+
+	observersSlice := []*data.NodeData{{Address: "addr0"}, {Address: "addr1"}}
+	latencies := map[string]map[string]time.Duration{
+		http.MethodGet:  {"addr0": time.Second, "addr1": time.Millisecond},
+		http.MethodPost: {"addr0": time.Second, "addr1": time.Millisecond},
+	}
+	ranker, _ := process.NewObserversLatencyRanker(createLatencyProvider(latencies), 2)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return observersSlice, nil
+			},
+			ApplyWarmUpCalled: func(nodes []*data.NodeData) []*data.NodeData {
+				// addr1 is warming up, so it is always moved last
+				require.Equal(t, "addr1", nodes[0].Address, "warm-up should receive the ranked observers")
+				return []*data.NodeData{nodes[1], nodes[0]}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		ranker,
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	observers, err := bp.GetObservers(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, "addr0", observers[0].Address)
+
+	observers, err = bp.GetObserversForWrite(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, "addr0", observers[0].Address)
+}
+
 //------- ComputeShardId
 
 func TestBaseProcessor_ComputeShardId(t *testing.T) {
@@ -175,7 +499,17 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	//there are 2 shards, compute ID should correctly process
@@ -190,6 +524,43 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 	assert.Equal(t, uint32(1), shardID)
 }
 
+func TestBaseProcessor_ComputeShardIdShouldUseCache(t *testing.T) {
+	t.Parallel()
+
+	msc, _ := sharding.NewMultiShardCoordinator(3, 0)
+	shardIDCache, _ := cache.NewShardIDLRUCache(10)
+	bp, _ := process.NewBaseProcessor(
+		5,
+		msc,
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		shardIDCache,
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	addressInShard1 := []byte{1}
+	for i := 0; i < 3; i++ {
+		shardID, err := bp.ComputeShardId(addressInShard1)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1), shardID)
+	}
+
+	metrics := shardIDCache.GetMetrics()
+	assert.Equal(t, 1, metrics.Size)
+	assert.Equal(t, uint64(2), metrics.Hits)
+	assert.Equal(t, uint64(1), metrics.Misses)
+}
+
 //------- Calls
 
 func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
@@ -210,53 +581,70 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
-	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", tsRecovered)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecovered)
 }
 
-func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
-	ts := &testStruct{
-		Nonce: 10000,
-		Name:  "a test struct to be sent and received",
-	}
-	response, _ := json.Marshal(ts)
-
+func TestBaseProcessor_CallRestEndPointsShouldForwardTheRequestID(t *testing.T) {
+	receivedRequestIDs := make([]string, 0)
 	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		time.Sleep(1200 * time.Millisecond)
-		_, _ = rw.Write(response)
+		receivedRequestIDs = append(receivedRequestIDs, req.Header.Get(common.RequestIDHeader))
+		_, _ = rw.Write([]byte("{}"))
 	}))
-	fmt.Printf("Server: %s\n", testServer.URL)
 	defer testServer.Close()
 
-	tsRecovered := &testStruct{}
 	bp, _ := process.NewBaseProcessor(
-		1,
+		5,
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
-	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
 
-	assert.NotEqual(t, ts.Name, tsRecovered.Name)
-	assert.NotNil(t, err)
+	response := &testStruct{}
+	_, _ = bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", response)
+
+	ctx := common.ContextWithRequestID(context.Background(), "request-id")
+	_, _ = bp.CallGetRestEndPoint(ctx, testServer.URL, "/some/path", response)
+	_, _ = bp.CallPostRestEndPoint(ctx, testServer.URL, "/some/path", response, response)
+
+	assert.Equal(t, []string{"", "request-id", "request-id"}, receivedRequestIDs)
 }
 
-func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
-	ts := &testStruct{
-		Nonce: 10000,
-		Name:  "a test struct to be sent",
-	}
-	tsRecv := &testStruct{}
+func TestBaseProcessor_CallRestEndPointsShouldAbortWhenTheClientGoesAway(t *testing.T) {
+	t.Parallel()
 
-	server := createTestHttpServer("/some/path", nil)
-	fmt.Printf("Server: %s\n", server.URL)
-	defer server.Close()
+	numCalls := uint32(0)
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddUint32(&numCalls, 1)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
 
 	bp, _ := process.NewBaseProcessor(
 		5,
@@ -264,61 +652,498 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
-	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
 
-	assert.Nil(t, err)
-	assert.Equal(t, ts, tsRecv)
-	assert.Equal(t, http.StatusOK, rc)
+	canceledCtx, cancel := context.WithCancel(common.ContextWithRequestID(context.Background(), "request-id"))
+	cancel()
+
+	response := &testStruct{}
+	respCode, err := bp.CallGetRestEndPoint(canceledCtx, testServer.URL, "/some/path", response)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, http.StatusRequestTimeout, respCode)
+
+	respCode, err = bp.CallPostRestEndPoint(canceledCtx, testServer.URL, "/some/path", response, response)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, http.StatusRequestTimeout, respCode)
+
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalls))
 }
 
-func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
-	ts := &testStruct{
-		Nonce: 10000,
-		Name:  "a test struct to be sent",
-	}
-	tsRecv := &testStruct{}
+func TestBaseProcessor_CallRestEndPointsShouldForwardTheClientHeadersFromAllGoroutines(t *testing.T) {
+	t.Parallel()
 
+	mutReceived := sync.Mutex{}
+	receivedTenants := make([]string, 0)
+	receivedRequestIDs := make([]string, 0)
 	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		time.Sleep(1200 * time.Millisecond)
-		tsBytes, _ := json.Marshal(ts)
-		_, _ = rw.Write(tsBytes)
+		mutReceived.Lock()
+		receivedTenants = append(receivedTenants, req.Header.Get("X-Tenant-ID"))
+		receivedRequestIDs = append(receivedRequestIDs, req.Header.Get(common.RequestIDHeader))
+		mutReceived.Unlock()
+		_, _ = rw.Write([]byte("{}"))
 	}))
-
-	fmt.Printf("Server: %s\n", testServer.URL)
 	defer testServer.Close()
 
 	bp, _ := process.NewBaseProcessor(
-		1,
+		5,
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
-	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
 
-	assert.NotEqual(t, tsRecv.Name, ts.Name)
-	assert.NotNil(t, err)
-	assert.Equal(t, http.StatusRequestTimeout, rc)
-}
+	ctx := common.ContextWithRequestID(context.Background(), "request-id")
+	ctx = common.ContextWithForwardedHeaders(ctx, http.Header{"X-Tenant-Id": []string{"tenant"}})
 
-func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
-	t.Parallel()
+	// the requests are fanned out the same way as the broadcasts and the multi-shard queries
+	numCalls := 5
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
 
-	statusResponse := data.StatusResponse{
-		Message: "",
-		Error:   "",
-		Running: true,
+			response := &testStruct{}
+			_, _ = bp.CallPostRestEndPoint(ctx, testServer.URL, "/some/path", response, response)
+		}()
 	}
+	wg.Wait()
 
-	statusResponseBytes, err := json.Marshal(statusResponse)
-	assert.Nil(t, err)
+	assert.Equal(t, []string{"tenant", "tenant", "tenant", "tenant", "tenant"}, receivedTenants)
+	assert.Equal(t, []string{"request-id", "request-id", "request-id", "request-id", "request-id"}, receivedRequestIDs)
+}
 
-	server := createTestHttpServer("/node/status", statusResponseBytes)
-	fmt.Printf("Server: %s\n", server.URL)
-	defer server.Close()
+func TestBaseProcessor_CallRestEndPointsShouldHandleCompressedResponses(t *testing.T) {
+	t.Parallel()
+
+	expected := &testStruct{Nonce: 37, Name: "compressed"}
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		buff, _ := json.Marshal(expected)
+		if req.Header.Get("Accept-Encoding") != "gzip" {
+			_, _ = rw.Write(buff)
+			return
+		}
+
+		rw.Header().Set("Content-Encoding", "gzip")
+		gzipWriter := gzip.NewWriter(rw)
+		_, _ = gzipWriter.Write(buff)
+		_ = gzipWriter.Close()
+	}))
+	defer testServer.Close()
+
+	createBaseProcessor := func(responseCompression bool) *process.BaseProcessor {
+		bp, _ := process.NewBaseProcessor(
+			5,
+			&mock.ShardCoordinatorMock{},
+			&mock.ObserversProviderStub{},
+			&mock.ObserversProviderStub{},
+			&mock.PubKeyConverterMock{},
+			&disabled.ShardIDCache{},
+			"",
+			false,
+			&disabled.ObserversRanker{},
+			"",
+			0,
+			"",
+			responseCompression,
+			&disabled.ObserverEventsNotifier{},
+			&disabled.ObserversAffinityCache{},
+			nil,
+		)
+		return bp
+	}
+
+	t.Run("compression enabled should decompress the responses", func(t *testing.T) {
+		bp := createBaseProcessor(true)
+
+		response := &testStruct{}
+		_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", response)
+		require.Nil(t, err)
+		assert.Equal(t, expected, response)
+
+		response = &testStruct{}
+		_, err = bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/some/path", expected, response)
+		require.Nil(t, err)
+		assert.Equal(t, expected, response)
+	})
+	t.Run("compression disabled should receive plain responses", func(t *testing.T) {
+		bp := createBaseProcessor(false)
+
+		response := &testStruct{}
+		_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", response)
+		require.Nil(t, err)
+		assert.Equal(t, expected, response)
+	})
+}
+
+func TestBaseProcessor_CallRestEndPointsShouldSendTheObserverCredentials(t *testing.T) {
+	t.Parallel()
+
+	createAuthServer := func(isAuthorized func(req *http.Request) bool) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if !isAuthorized(req) {
+				rw.WriteHeader(http.StatusUnauthorized)
+				_, _ = rw.Write([]byte(`{"error":"unauthorized"}`))
+				return
+			}
+
+			_, _ = rw.Write([]byte("{}"))
+		}))
+	}
+	basicAuthServer := createAuthServer(func(req *http.Request) bool {
+		username, password, ok := req.BasicAuth()
+		return ok && username == "user" && password == "pass"
+	})
+	defer basicAuthServer.Close()
+	bearerServer := createAuthServer(func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer token"
+	})
+	defer bearerServer.Close()
+	noAuthServer := createAuthServer(func(req *http.Request) bool {
+		return len(req.Header.Get("Authorization")) == 0
+	})
+	defer noAuthServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: basicAuthServer.URL, Username: "user", Password: "pass"},
+					{Address: noAuthServer.URL},
+				}
+			},
+		},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: bearerServer.URL, BearerToken: "token"}}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	for _, address := range []string{basicAuthServer.URL, bearerServer.URL, noAuthServer.URL} {
+		response := &testStruct{}
+		code, err := bp.CallGetRestEndPoint(context.Background(), address, "/some/path", response)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		code, err = bp.CallPostRestEndPoint(context.Background(), address, "/some/path", response, response)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, code)
+	}
+}
+
+func TestBaseProcessor_ShouldPreferNodesInTheLocalZone(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "address1", Zone: "us-east"},
+		{Address: "address2"},
+		{Address: "address3", Zone: "eu-west"},
+		{Address: "address4", Zone: "us-east"},
+		{Address: "address5", Zone: "eu-west"},
+	}
+	providerStub := &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return nodes, nil
+		},
+	}
+	createProcessor := func(localZone string) *process.BaseProcessor {
+		bp, err := process.NewBaseProcessor(
+			5,
+			&mock.ShardCoordinatorMock{},
+			providerStub,
+			providerStub,
+			&mock.PubKeyConverterMock{},
+			&disabled.ShardIDCache{},
+			"",
+			false,
+			&disabled.ObserversRanker{},
+			"",
+			0,
+			localZone,
+			false,
+			&disabled.ObserverEventsNotifier{},
+			&disabled.ObserversAffinityCache{},
+			nil,
+		)
+		require.Nil(t, err)
+
+		return bp
+	}
+	getAddresses := func(nodes []*data.NodeData) []string {
+		addresses := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			addresses = append(addresses, node.Address)
+		}
+
+		return addresses
+	}
+
+	t.Run("local zone not set should keep the order", func(t *testing.T) {
+		t.Parallel()
+
+		observers, err := createProcessor("").GetObservers(0, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, nodes, observers)
+	})
+	t.Run("nodes in the local zone should come first", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createProcessor("eu-west")
+		expectedAddresses := []string{"address3", "address5", "address1", "address2", "address4"}
+
+		observers, err := bp.GetObservers(0, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, expectedAddresses, getAddresses(observers))
+
+		observers, err = bp.GetObserversForWrite(0, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, expectedAddresses, getAddresses(observers))
+
+		fullHistoryNodes, err := bp.GetFullHistoryNodes(0, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, expectedAddresses, getAddresses(fullHistoryNodes))
+	})
+	t.Run("no node in the local zone should keep the order", func(t *testing.T) {
+		t.Parallel()
+
+		observers, err := createProcessor("ap-south").GetObservers(0, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, nodes, observers)
+	})
+}
+
+func TestBaseProcessor_CallRestEndPointsShouldRecordTheObserverZone(t *testing.T) {
+	t.Parallel()
+
+	server := createTestHttpServer("/some/path", []byte("{}"))
+	defer server.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte(`{"error":"failure"}`))
+	}))
+	defer failingServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: server.URL, Zone: "eu-west"},
+					{Address: failingServer.URL, Zone: "us-east"},
+				}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"eu-west",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	response := &testStruct{}
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", response)
+	require.Nil(t, err)
+	assert.Empty(t, common.GetObserverZone(context.Background()), "no zone should be recorded outside of a request")
+
+	ctx := common.ContextWithRequestID(context.Background(), "request")
+	_, err = bp.CallGetRestEndPoint(ctx, server.URL, "/some/path", response)
+	require.Nil(t, err)
+	assert.Equal(t, "eu-west", common.GetObserverZone(ctx))
+
+	_, err = bp.CallPostRestEndPoint(ctx, failingServer.URL, "/some/path", response, response)
+	require.NotNil(t, err)
+	assert.Equal(t, "us-east", common.GetObserverZone(ctx))
+
+	// the zone recorded by the goroutines fanning out the observer calls is exposed for the request being served
+	fanOutCtx := common.ContextWithRequestID(context.Background(), "fan-out request")
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		_, _ = bp.CallGetRestEndPoint(fanOutCtx, server.URL, "/some/path", &testStruct{})
+	}()
+	wg.Wait()
+	assert.Equal(t, "eu-west", common.GetObserverZone(fanOutCtx))
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent and received",
+	}
+	response, _ := json.Marshal(ts)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(1200 * time.Millisecond)
+		_, _ = rw.Write(response)
+	}))
+	fmt.Printf("Server: %s\n", testServer.URL)
+	defer testServer.Close()
+
+	tsRecovered := &testStruct{}
+	bp, _ := process.NewBaseProcessor(
+		1,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", tsRecovered)
+
+	assert.NotEqual(t, ts.Name, tsRecovered.Name)
+	assert.NotNil(t, err)
+}
+
+func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent",
+	}
+	tsRecv := &testStruct{}
+
+	server := createTestHttpServer("/some/path", nil)
+	fmt.Printf("Server: %s\n", server.URL)
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", ts, tsRecv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ts, tsRecv)
+	assert.Equal(t, http.StatusOK, rc)
+}
+
+func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent",
+	}
+	tsRecv := &testStruct{}
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(1200 * time.Millisecond)
+		tsBytes, _ := json.Marshal(ts)
+		_, _ = rw.Write(tsBytes)
+	}))
+
+	fmt.Printf("Server: %s\n", testServer.URL)
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		1,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/some/path", ts, tsRecv)
+
+	assert.NotEqual(t, tsRecv.Name, ts.Name)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusRequestTimeout, rc)
+}
+
+func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
+	t.Parallel()
+
+	statusResponse := data.StatusResponse{
+		Message: "",
+		Error:   "",
+		Running: true,
+	}
+
+	statusResponseBytes, err := json.Marshal(statusResponse)
+	assert.Nil(t, err)
+
+	server := createTestHttpServer("/node/status", statusResponseBytes)
+	fmt.Printf("Server: %s\n", server.URL)
+	defer server.Close()
 
 	var observersList []*data.NodeData
 	observersList = append(observersList, &data.NodeData{
@@ -336,7 +1161,17 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	assert.Nil(t, err)
@@ -387,7 +1222,17 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -436,7 +1281,17 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -485,7 +1340,17 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
 		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
@@ -538,7 +1403,17 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard(data.AvailabilityAll)
@@ -559,7 +1434,17 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
@@ -587,7 +1472,17 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -648,7 +1543,17 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -710,7 +1615,17 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -727,7 +1642,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 	go func() {
 		// trigger a HTTP error that will trigger the nodes sync state checks
 		time.Sleep(90 * time.Millisecond)
-		_, _ = bp.CallGetRestEndPoint("address1", "/node/status", nil)
+		_, _ = bp.CallGetRestEndPoint(context.Background(), "address1", "/node/status", nil)
 	}()
 
 	time.Sleep(300 * time.Millisecond)
@@ -759,7 +1674,17 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -819,7 +1744,17 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -869,7 +1804,17 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
 		true,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -888,6 +1833,475 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestBaseProcessor_AddObserver(t *testing.T) {
+	t.Parallel()
+
+	createBaseProcessor := func(providerStub *mock.ObserversProviderStub, shardID uint32, httpCode int, fetchErr error) *process.BaseProcessor {
+		bp, _ := process.NewBaseProcessor(
+			5,
+			&mock.ShardCoordinatorMock{},
+			providerStub,
+			&mock.ObserversProviderStub{},
+			&mock.PubKeyConverterMock{},
+			&disabled.ShardIDCache{},
+			"",
+			false,
+			&disabled.ObserversRanker{},
+			"",
+			0,
+			"",
+			false,
+			&disabled.ObserverEventsNotifier{},
+			&disabled.ObserversAffinityCache{},
+			nil,
+		)
+		bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+			response := getResponseForNodeStatus(true, "true")
+			response.Data.Metrics.ShardID = shardID
+			return response, httpCode, fetchErr
+		})
+
+		return bp
+	}
+
+	t.Run("probe fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		providerStub := &mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		bp := createBaseProcessor(providerStub, 0, http.StatusNotFound, errors.New("connection refused"))
+
+		err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 0})
+		require.True(t, errors.Is(err, process.ErrObserverProbeFailed))
+	})
+	t.Run("probe returns error code should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createBaseProcessor(&mock.ObserversProviderStub{}, 0, http.StatusInternalServerError, nil)
+
+		err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 0})
+		require.True(t, errors.Is(err, process.ErrObserverProbeFailed))
+	})
+	t.Run("shard mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createBaseProcessor(&mock.ObserversProviderStub{}, 1, http.StatusOK, nil)
+
+		err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 0})
+		require.True(t, errors.Is(err, process.ErrObserverShardMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var addedNode *data.NodeData
+		providerStub := &mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				addedNode = node
+				return nil
+			},
+		}
+		bp := createBaseProcessor(providerStub, 1, http.StatusOK, nil)
+
+		err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 1})
+		require.NoError(t, err)
+		require.Equal(t, &data.NodeData{Address: "address0", ShardId: 1, IsSynced: true}, addedNode)
+	})
+	t.Run("omitted shard should be detected", func(t *testing.T) {
+		t.Parallel()
+
+		var addedNode *data.NodeData
+		providerStub := &mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				addedNode = node
+				return nil
+			},
+		}
+		bp := createBaseProcessor(providerStub, 1, http.StatusOK, nil)
+
+		err := bp.AddObserver(&data.NodeData{Address: "address0", AutoDetectShard: true})
+		require.NoError(t, err)
+		require.Equal(t, &data.NodeData{Address: "address0", ShardId: 1, IsSynced: true}, addedNode)
+	})
+	t.Run("should probe with the observer credentials", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.Header.Get("Authorization") != "Bearer token" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			responseBytes, _ := json.Marshal(getResponseForNodeStatus(true, "true"))
+			_, _ = rw.Write(responseBytes)
+		}))
+		defer testServer.Close()
+
+		bp, _ := process.NewBaseProcessor(
+			5,
+			&mock.ShardCoordinatorMock{},
+			&mock.ObserversProviderStub{},
+			&mock.ObserversProviderStub{},
+			&mock.PubKeyConverterMock{},
+			&disabled.ShardIDCache{},
+			"",
+			false,
+			&disabled.ObserversRanker{},
+			"",
+			0,
+			"",
+			false,
+			&disabled.ObserverEventsNotifier{},
+			&disabled.ObserversAffinityCache{},
+			nil,
+		)
+
+		err := bp.AddObserver(&data.NodeData{Address: testServer.URL, ShardId: 0})
+		require.True(t, errors.Is(err, process.ErrObserverProbeFailed))
+
+		err = bp.AddObserver(&data.NodeData{Address: testServer.URL, ShardId: 0, BearerToken: "token"})
+		require.NoError(t, err)
+	})
+}
+
+func TestBaseProcessor_RemoveObserver(t *testing.T) {
+	t.Parallel()
+
+	removedAddress := ""
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: "http://127.0.0.1:8080", ShardId: 0},
+					{Address: "http://127.0.0.1:8081", ShardId: 1},
+				}
+			},
+			RemoveNodeCalled: func(address string) error {
+				removedAddress = address
+				return nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	err := bp.RemoveObserver("127.0.0.1:8081")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8081", removedAddress)
+
+	err = bp.RemoveObserver("http://127.0.0.1:8080")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8080", removedAddress)
+}
+
+func TestBaseProcessor_RemoveObserverShouldMarkTheErrors(t *testing.T) {
+	t.Parallel()
+
+	removeNodeErr := observer.ErrNodeNotFound
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			RemoveNodeCalled: func(address string) error {
+				return removeNodeErr
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+
+	err := bp.RemoveObserver("127.0.0.1:8081")
+	require.True(t, errors.Is(err, data.ErrObserverNotFound))
+	require.True(t, errors.Is(err, observer.ErrNodeNotFound))
+
+	removeNodeErr = observer.ErrLastNodeInShard
+	err = bp.RemoveObserver("127.0.0.1:8081")
+	require.True(t, errors.Is(err, data.ErrInvalidObserver))
+
+	removeNodeErr = errors.New("nodes holder error")
+	err = bp.RemoveObserver("127.0.0.1:8081")
+	require.Equal(t, removeNodeErr, err)
+}
+
+func TestBaseProcessor_HandleNodesSyncStateShouldExcludeObserversBelowMinVersion(t *testing.T) {
+	t.Parallel()
+
+	nodeVersions := map[string]string{
+		"address0": "v1.6.18-0-g3f4ad46/go1.20.7/linux-amd64",
+		"address1": "v1.7.2-0-g3f4ad46/go1.20.7/linux-amd64",
+		"address2": "v1.10.0",
+		"address3": "undefined",
+	}
+	mutUpdatedNodes := sync.Mutex{}
+	var updatedNodes []*data.NodeData
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: "address0", ShardId: 0},
+					{Address: "address1", ShardId: 0},
+					{Address: "address2", ShardId: 1},
+					{Address: "address3", ShardId: 1},
+				}
+			},
+			UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+				mutUpdatedNodes.Lock()
+				updatedNodes = nodesWithSyncStatus
+				mutUpdatedNodes.Unlock()
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"v1.7.0",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.AppVersion = nodeVersions[url]
+		return response, http.StatusOK, nil
+	})
+
+	bp.SetDelayForCheckingNodesSyncState(time.Hour)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		mutUpdatedNodes.Lock()
+		defer mutUpdatedNodes.Unlock()
+
+		return len(updatedNodes) == 4
+	}, time.Second, 5*time.Millisecond)
+
+	mutUpdatedNodes.Lock()
+	assert.False(t, updatedNodes[0].IsSynced)
+	assert.True(t, updatedNodes[1].IsSynced)
+	assert.True(t, updatedNodes[2].IsSynced)
+	assert.False(t, updatedNodes[3].IsSynced)
+	mutUpdatedNodes.Unlock()
+
+	expectedExcludedObservers := []*data.ExcludedObserver{
+		{Address: "address0", ShardID: 0, Reason: data.ExclusionReasonVersion, Version: nodeVersions["address0"], MinimumVersion: "v1.7.0"},
+		{Address: "address3", ShardID: 1, Reason: data.ExclusionReasonVersion, Version: "undefined", MinimumVersion: "v1.7.0"},
+	}
+	assert.Equal(t, expectedExcludedObservers, bp.GetExcludedObservers())
+}
+
+func createNetworkConfigFetcher(chainIDs map[string]string) func(url string) (*data.NetworkConfig, int, error) {
+	return func(url string) (*data.NetworkConfig, int, error) {
+		networkConfig := &data.NetworkConfig{}
+		networkConfig.Config.ChainID = chainIDs[url]
+		networkConfig.Config.MinTransactionVersion = 1
+		return networkConfig, http.StatusOK, nil
+	}
+}
+
+func TestBaseProcessor_HandleNodesSyncStateShouldExcludeObserversOfAnotherNetwork(t *testing.T) {
+	t.Parallel()
+
+	chainIDs := map[string]string{
+		"address0": "1",
+		"address1": "D",
+		"address2": "1",
+	}
+	nodes := []*data.NodeData{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 1},
+	}
+	mutUpdatedNodes := sync.Mutex{}
+	var updatedNodes []*data.NodeData
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return nodes
+			},
+			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if dataAvailability == data.AvailabilityRecent {
+					// only the observer of the other network is left
+					return nodes[1:2], nil
+				}
+
+				return nodes[:2], nil
+			},
+			UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+				mutUpdatedNodes.Lock()
+				updatedNodes = nodesWithSyncStatus
+				mutUpdatedNodes.Unlock()
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"1",
+		1,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+	bp.SetNetworkConfigFetcher(createNetworkConfigFetcher(chainIDs))
+
+	bp.SetDelayForCheckingNodesSyncState(time.Hour)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		mutUpdatedNodes.Lock()
+		defer mutUpdatedNodes.Unlock()
+
+		return len(updatedNodes) == 3
+	}, time.Second, 5*time.Millisecond)
+
+	mutUpdatedNodes.Lock()
+	assert.True(t, updatedNodes[0].IsSynced)
+	assert.False(t, updatedNodes[1].IsSynced)
+	assert.True(t, updatedNodes[2].IsSynced)
+	mutUpdatedNodes.Unlock()
+
+	expectedExcludedObservers := []*data.ExcludedObserver{
+		{
+			Address:                       "address1",
+			ShardID:                       0,
+			Reason:                        data.ExclusionReasonNetworkMismatch,
+			ChainID:                       "D",
+			ExpectedChainID:               "1",
+			MinTransactionVersion:         1,
+			ExpectedMinTransactionVersion: 1,
+		},
+	}
+	assert.Equal(t, expectedExcludedObservers, bp.GetExcludedObservers())
+
+	observers, err := bp.GetObserversForWrite(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{nodes[0]}, observers)
+
+	observers, err = bp.GetObserversForWrite(0, data.AvailabilityRecent)
+	require.Nil(t, observers)
+	assert.Equal(t, process.ErrNoVerifiedObserverForWrite, err)
+}
+
+func TestBaseProcessor_AddObserverOfAnotherNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"1",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+	bp.SetNetworkConfigFetcher(createNetworkConfigFetcher(map[string]string{"address0": "D"}))
+
+	err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 0})
+	require.True(t, errors.Is(err, process.ErrObserverNetworkMismatch))
+}
+
+func TestBaseProcessor_AddObserverBelowMinVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"v1.7.0",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.AppVersion = "v1.6.0"
+		return response, http.StatusOK, nil
+	})
+
+	err := bp.AddObserver(&data.NodeData{Address: "address0", ShardId: 0})
+	require.True(t, errors.Is(err, process.ErrObserverVersionTooLow))
+}
+
 func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeStatusAPIResponse {
 	nonce, probableHighestNonce := uint64(10), uint64(11)
 	if !synced {
@@ -906,3 +2320,124 @@ func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeS
 
 	return &obj
 }
+
+func TestBaseProcessor_HandleNodesSyncStateShouldNotifyTheObserverEvents(t *testing.T) {
+	observers := []*data.NodeData{
+		{Address: "address0", ShardId: 0, IsSynced: true},
+		{Address: "address1", ShardId: 1, IsSynced: true},
+	}
+	isAddress1Synced := atomic.Value{}
+	isAddress1Synced.Store(false)
+	chanEvents := make(chan []*data.ObserverEvent, 10)
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&mock.ObserverEventsNotifierStub{
+			NotifyObserverEventsCalled: func(events []*data.ObserverEvent) {
+				chanEvents <- events
+			},
+		},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		if url == "address1" {
+			return getResponseForNodeStatus(isAddress1Synced.Load().(bool), "true"), http.StatusOK, nil
+		}
+
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+	bp.SetDelayForCheckingNodesSyncState(5 * time.Millisecond)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	events := <-chanEvents
+	require.Len(t, events, 2)
+	assert.Equal(t, data.ObserverQuarantined, events[0].Type)
+	assert.Equal(t, data.Observer, events[0].NodeType)
+	assert.Equal(t, "address1", events[0].Address)
+	assert.Equal(t, uint32(1), events[0].ShardID)
+	assert.Equal(t, data.ShardWithoutObservers, events[1].Type)
+	assert.Equal(t, uint32(1), events[1].ShardID)
+	assert.Empty(t, events[1].Address)
+
+	isAddress1Synced.Store(true)
+	events = <-chanEvents
+	require.Len(t, events, 2)
+	assert.Equal(t, data.ObserverRestored, events[0].Type)
+	assert.Equal(t, "address1", events[0].Address)
+	assert.Equal(t, data.ShardObserversRestored, events[1].Type)
+	assert.Equal(t, uint32(1), events[1].ShardID)
+}
+
+func TestBaseProcessor_GetObserversNonces(t *testing.T) {
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: "address2", ShardId: 1, IsSynced: true},
+					{Address: "address1", ShardId: 0, IsSynced: true},
+					{Address: "address0", ShardId: 0, IsSynced: true},
+				}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.ShardIDCache{},
+		"",
+		false,
+		&disabled.ObserversRanker{},
+		"",
+		0,
+		"",
+		false,
+		&disabled.ObserverEventsNotifier{},
+		&disabled.ObserversAffinityCache{},
+		nil,
+	)
+	require.Empty(t, bp.GetObserversNonces())
+
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		switch url {
+		case "address0":
+			return getResponseForNodeStatus(true, "true"), 200, nil
+		case "address2":
+			response := getResponseForNodeStatus(false, "true")
+			response.Data.Metrics.Nonce = 7
+			return response, 200, nil
+		default:
+			return nil, 0, errors.New("observer is down")
+		}
+	})
+
+	bp.SetDelayForCheckingNodesSyncState(5 * time.Millisecond)
+	bp.StartNodesSyncStateChecks()
+	time.Sleep(50 * time.Millisecond)
+	_ = bp.Close()
+
+	expectedNonces := []*data.ObserverNonce{
+		{Address: "address0", ShardID: 0, Nonce: 10},
+		{Address: "address2", ShardID: 1, Nonce: 7},
+	}
+	require.Equal(t, expectedNonces, bp.GetObserversNonces())
+}
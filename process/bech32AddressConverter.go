@@ -0,0 +1,47 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+)
+
+// bech32AddressConverter is the bech32 converter of the addresses whose decoding errors mention the address prefix of
+// the chain, as the chains may use different prefixes
+type bech32AddressConverter struct {
+	core.PubkeyConverter
+	hrp string
+}
+
+// NewBech32AddressConverter creates a bech32 converter of the addresses, using the provided human readable part
+func NewBech32AddressConverter(addressLength int, hrp string) (*bech32AddressConverter, error) {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLength, hrp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bech32AddressConverter{
+		PubkeyConverter: converter,
+		hrp:             hrp,
+	}, nil
+}
+
+// Decode converts the provided bech32 address into its bytes
+func (bac *bech32AddressConverter) Decode(humanReadable string) ([]byte, error) {
+	decoded, err := bac.PubkeyConverter.Decode(humanReadable)
+	if err == nil {
+		return decoded, nil
+	}
+	if errors.Is(err, pubkeyConverter.ErrInvalidErdAddress) {
+		err = ErrInvalidAddressPrefix
+	}
+
+	return nil, fmt.Errorf("%w, expected a bech32 address starting with %s1", err, bac.hrp)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bac *bech32AddressConverter) IsInterfaceNil() bool {
+	return bac == nil
+}
@@ -0,0 +1,58 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBech32AddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := process.NewBech32AddressConverter(0, "sov")
+	assert.Nil(t, converter)
+	assert.NotNil(t, err)
+
+	converter, err = process.NewBech32AddressConverter(32, "sov")
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(converter))
+}
+
+func TestBech32AddressConverter_Decode(t *testing.T) {
+	t.Parallel()
+
+	converter, err := process.NewBech32AddressConverter(32, "sov")
+	require.Nil(t, err)
+
+	addressBytes, _ := testPubkeyConverter.Decode(validationSender)
+	sovereignAddress, err := converter.Encode(addressBytes)
+	require.Nil(t, err)
+	assert.Equal(t, "sov1", sovereignAddress[:4])
+
+	t.Run("address of the chain should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := converter.Decode(sovereignAddress)
+		require.Nil(t, err)
+		assert.Equal(t, addressBytes, decoded)
+	})
+	t.Run("address with another prefix should mention the expected one", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := converter.Decode(validationSender)
+		assert.Nil(t, decoded)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddressPrefix))
+		assert.Contains(t, err.Error(), "expected a bech32 address starting with sov1")
+	})
+	t.Run("malformed address should mention the expected prefix", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := converter.Decode("not an address")
+		assert.Nil(t, decoded)
+		assert.Contains(t, err.Error(), "expected a bech32 address starting with sov1")
+	})
+}
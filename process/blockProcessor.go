@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +28,8 @@ const (
 	internalStartOfEpochMetaBlockPath      = "/internal/%s/startofepoch/metablock/by-epoch/%d"
 	internalStartOfEpochValidatorsInfoPath = "/internal/json/startofepoch/validators/by-epoch/%d"
 
+	epochStartDataPath = "/node/epoch-start/%d"
+
 	alteredAccountByBlockNonce = "/block/altered-accounts/by-nonce"
 	alteredAccountByBlockHash  = "/block/altered-accounts/by-hash"
 )
@@ -35,24 +39,46 @@ const (
 	rawPathStr  = "raw"
 )
 
+const maxParallelBlocksByHashesLookups = 16
+
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc Processor
+	proc                        Processor
+	maxBlocksInMultiHashRequest int
+	hyperblocksCache            HyperblocksCacher
+	reorgDetector               ReorgDetector
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(
+	proc Processor,
+	maxBlocksInMultiHashRequest int,
+	hyperblocksCache HyperblocksCacher,
+	reorgDetector ReorgDetector,
+) (*BlockProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if maxBlocksInMultiHashRequest <= 0 {
+		return nil, ErrInvalidMaxBlocksInMultiHashRequest
+	}
+	if check.IfNil(hyperblocksCache) {
+		return nil, ErrNilHyperblocksCache
+	}
+	if check.IfNil(reorgDetector) {
+		return nil, ErrNilReorgDetector
+	}
 
 	return &BlockProcessor{
-		proc: proc,
+		proc:                        proc,
+		maxBlocksInMultiHashRequest: maxBlocksInMultiHashRequest,
+		hyperblocksCache:            hyperblocksCache,
+		reorgDetector:               reorgDetector,
 	}, nil
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -63,13 +89,14 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		log.WithContext(ctx).Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		bp.recordServedBlock(shardID, &response)
 		return &response, nil
 
 	}
@@ -77,8 +104,73 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	return nil, WrapObserversError(response.Error)
 }
 
+// recordServedBlock passes the hash of the served block to the reorg detector, so that a different hash served later for
+// the same nonce is flagged
+func (bp *BlockProcessor) recordServedBlock(shardID uint32, response *data.BlockApiResponse) {
+	if len(response.Error) > 0 {
+		return
+	}
+
+	bp.reorgDetector.RecordBlock(shardID, response.Data.Block.Nonce, response.Data.Block.Hash)
+}
+
+// GetBlocksByHashes will return the blocks of the requested shards and hashes, fetched concurrently. The results keep
+// the order of the requests, a block which could not be fetched being returned along with the error
+func (bp *BlockProcessor) GetBlocksByHashes(ctx context.Context, requests []*data.BlockByHashRequest, options common.BlockQueryOptions) ([]*data.BlockByHashResult, error) {
+	if len(requests) == 0 {
+		return nil, ErrNoBlocksRequested
+	}
+	if len(requests) > bp.maxBlocksInMultiHashRequest {
+		return nil, fmt.Errorf("%w: %d, maximum %d", ErrTooManyBlocksRequested, len(requests), bp.maxBlocksInMultiHashRequest)
+	}
+	for _, request := range requests {
+		if request == nil || len(request.Hash) == 0 {
+			return nil, ErrEmptyBlockHash
+		}
+	}
+
+	results := make([]*data.BlockByHashResult, len(requests))
+	throttler := make(chan struct{}, maxParallelBlocksByHashesLookups)
+	wg := sync.WaitGroup{}
+	wg.Add(len(requests))
+	for idx, request := range requests {
+		throttler <- struct{}{}
+		go func(idx int, request *data.BlockByHashRequest) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			results[idx] = bp.getBlockByHashResult(ctx, request, options)
+		}(idx, request)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (bp *BlockProcessor) getBlockByHashResult(ctx context.Context, request *data.BlockByHashRequest, options common.BlockQueryOptions) *data.BlockByHashResult {
+	result := &data.BlockByHashResult{
+		Shard: request.Shard,
+		Hash:  request.Hash,
+	}
+
+	response, err := bp.GetBlockByHash(ctx, request.Shard, request.Hash, options)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if len(response.Error) > 0 {
+		result.Error = response.Error
+		return result
+	}
+
+	result.Block = &response.Data.Block
+	return result
+}
+
 // GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -89,13 +181,14 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+		log.WithContext(ctx).Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+		bp.recordServedBlock(shardID, &response)
 		return &response, nil
 
 	}
@@ -103,6 +196,40 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	return nil, WrapObserversError(response.Error)
 }
 
+// GetEpochStartBlock will return the block which started the provided epoch in the provided shard. On metachain, it
+// holds the economics data of the epoch start
+func (bp *BlockProcessor) GetEpochStartBlock(ctx context.Context, shardID uint32, epoch uint32, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	nonce, err := bp.getEpochStartBlockNonce(ctx, shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.GetBlockByNonce(ctx, shardID, nonce, options)
+}
+
+func (bp *BlockProcessor) getEpochStartBlockNonce(ctx context.Context, shardID uint32, epoch uint32) (uint64, error) {
+	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	path := fmt.Sprintf(epochStartDataPath, epoch)
+	response := data.EpochStartDataApiResponse{}
+	for _, observer := range observers {
+
+		_, err = bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("epoch start block request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		log.WithContext(ctx).Info("epoch start block request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
+		return response.Data.EpochStart.Nonce, nil
+	}
+
+	return 0, WrapObserversError(response.Error)
+}
+
 func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*data.NodeData, error) {
 	fullHistoryNodes, err := bp.proc.GetFullHistoryNodes(shardID, data.AvailabilityAll)
 	if err == nil {
@@ -113,7 +240,7 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 }
 
 // GetHyperBlockByHash returns the hyperblock by hash
-func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -122,7 +249,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByHash(ctx, core.MetachainShardId, hash, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +257,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -139,19 +266,19 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	return data.NewHyperblockApiResponse(hyperblock), nil
 }
 
-func (bp *BlockProcessor) addShardBlocks(
+func (bp *BlockProcessor) addShardBlocks(ctx context.Context,
 	metaBlock api.Block,
 	builder *hyperblockBuilder,
 	options common.HyperblockQueryOptions,
 	blockQueryOptions common.BlockQueryOptions,
 ) error {
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.GetBlockByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return err
 		}
 
-		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
+		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(ctx, options, notarizedBlock)
 		if err != nil {
 			return err
 		}
@@ -165,13 +292,13 @@ func (bp *BlockProcessor) addShardBlocks(
 	return nil
 }
 
-func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
+func (bp *BlockProcessor) getAlteredAccountsIfNeeded(ctx context.Context, options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
 	ret := make([]*alteredAccount.AlteredAccount, 0)
 	if !options.WithAlteredAccounts {
 		return ret, nil
 	}
 
-	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
+	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -179,8 +306,15 @@ func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQu
 	return alteredAccountsApiResponse.Data.Accounts, nil
 }
 
-// GetHyperBlockByNonce returns the hyperblock by nonce
-func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+// GetHyperBlockByNonce returns the hyperblock by nonce. The recently requested hyperblocks are served from the cache, as
+// the indexers following the chain head request the same ones
+func (bp *BlockProcessor) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	cacheKey := getHyperblockCacheKey(nonce, options)
+	cachedHyperblock, found := bp.hyperblocksCache.Get(cacheKey)
+	if found {
+		return cachedHyperblock, nil
+	}
+
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -189,7 +323,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByNonce(ctx, core.MetachainShardId, nonce, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -197,17 +331,32 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	response := data.NewHyperblockApiResponse(hyperblock)
+	bp.hyperblocksCache.Put(cacheKey, response)
+
+	return response, nil
+}
+
+// getHyperblockCacheKey returns the key of the hyperblock in the cache, which also holds the query options as they
+// change the content of the hyperblock
+func getHyperblockCacheKey(nonce uint64, options common.HyperblockQueryOptions) string {
+	return fmt.Sprintf("%d_%t_%t_%t_%s",
+		nonce,
+		options.WithLogs,
+		options.NotarizedAtSource,
+		options.WithAlteredAccounts,
+		options.AlteredAccountsOptions.TokensFilter,
+	)
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
-func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -221,13 +370,13 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("internal block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		log.WithContext(ctx).Info("internal block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -253,7 +402,7 @@ func getInternalBlockByHashPath(shardID uint32, format common.OutputFormat, hash
 }
 
 // GetInternalBlockByNonce will return the internal block based on its nonce
-func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -267,13 +416,13 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("internal block request", "shard id", observer.ShardId, "round", nonce, "observer", observer.Address)
+		log.WithContext(ctx).Info("internal block request", "shard id", observer.ShardId, "round", nonce, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -299,28 +448,27 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 }
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
-func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
 	}
 
-	outputStr, err := getOutputFormat(format)
+	path, err := buildInternalMiniBlockPath(hash, epoch, format)
 	if err != nil {
 		return nil, err
 	}
-	path := fmt.Sprintf(internalMiniBlockByHashPath, outputStr, hash, epoch)
 
 	response := data.InternalMiniBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("miniblock request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("miniblock request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("miniblock request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		log.WithContext(ctx).Info("miniblock request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -328,6 +476,15 @@ func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string
 	return nil, WrapObserversError(response.Error)
 }
 
+func buildInternalMiniBlockPath(hash string, epoch uint32, format common.OutputFormat) (string, error) {
+	outputStr, err := getOutputFormat(format)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(internalMiniBlockByHashPath, outputStr, hash, epoch), nil
+}
+
 func getOutputFormat(format common.OutputFormat) (string, error) {
 	var outputStr string
 
@@ -344,7 +501,7 @@ func getOutputFormat(format common.OutputFormat) (string, error) {
 }
 
 // GetInternalStartOfEpochMetaBlock will return the internal start of epoch meta block based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -360,13 +517,13 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("internal block request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
+		log.WithContext(ctx).Info("internal block request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -375,7 +532,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 }
 
 // GetInternalStartOfEpochValidatorsInfo will return the internal start of epoch validators info based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -386,13 +543,13 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 	response := data.ValidatorsInfoApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("internal validators info request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("internal validators info request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("internal validators info request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
+		log.WithContext(ctx).Info("internal validators info request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -401,7 +558,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 }
 
 // GetAlteredAccountsByNonce will return altered accounts by block nonce
-func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -411,13 +568,13 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("altered accounts request by nonce", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("altered accounts request by nonce", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("altered accounts request by nonce", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+		log.WithContext(ctx).Info("altered accounts request by nonce", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -426,7 +583,7 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 }
 
 // GetAlteredAccountsByHash will return altered accounts by block hash
-func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -436,16 +593,21 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
-			log.Error("altered accounts request by hash", "observer", observer.Address, "hash", hash, "error", err.Error())
+			log.WithContext(ctx).Error("altered accounts request by hash", "observer", observer.Address, "hash", hash, "error", err.Error())
 			continue
 		}
 
-		log.Info("altered accounts request by hash", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		log.WithContext(ctx).Info("altered accounts request by hash", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
 		return &response, nil
 
 	}
 
 	return nil, WrapObserversError(response.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *BlockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
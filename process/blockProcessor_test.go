@@ -1,8 +1,10 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -13,6 +15,8 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,15 +25,39 @@ import (
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil)
+	bp, err := process.NewBlockProcessor(nil, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_InvalidMaxBlocksInMultiHashRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, 0, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrInvalidMaxBlocksInMultiHashRequest, err)
+}
+
+func TestNewBlockProcessor_NilHyperblocksCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, 100, nil, &disabled.ReorgDetector{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilHyperblocksCache, err)
+}
+
+func TestNewBlockProcessor_NilReorgDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, 100, &disabled.HyperblocksCache{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilReorgDetector, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -51,10 +79,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -77,10 +105,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -99,10 +127,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -120,10 +148,10 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -143,10 +171,10 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -171,10 +199,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -200,10 +228,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -226,10 +254,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -248,10 +276,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -269,10 +297,10 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -292,10 +320,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -303,6 +331,41 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 	require.Equal(t, nonce, block.Nonce)
 }
 
+func TestBlockProcessor_GetBlockByNonceShouldRecordTheServedBlock(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(37)
+	responseError := ""
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			valResp := value.(*data.BlockApiResponse)
+			valResp.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Hash: "hash"}}
+			valResp.Error = responseError
+			return 200, nil
+		},
+	}
+	recordedBlocks := make([]string, 0)
+	reorgDetector := &mock.ReorgDetectorStub{
+		RecordBlockCalled: func(shardID uint32, nonce uint64, hash string) {
+			recordedBlocks = append(recordedBlocks, fmt.Sprintf("%d_%d_%s", shardID, nonce, hash))
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, reorgDetector)
+
+	_, err := bp.GetBlockByNonce(context.Background(), 1, nonce, common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1_37_hash"}, recordedBlocks)
+
+	responseError = "block not found"
+	_, err = bp.GetBlockByNonce(context.Background(), 1, nonce, common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1_37_hash"}, recordedBlocks)
+}
+
 func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T) {
 	t.Parallel()
 
@@ -320,10 +383,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -332,6 +395,62 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 	require.True(t, isAddressCorrect)
 }
 
+func TestBlockProcessor_GetEpochStartBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("epoch start data request fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.EpochStartDataApiResponse)
+				response.Error = "epoch start not found"
+				return http.StatusNotFound, errors.New("not found")
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		res, err := bp.GetEpochStartBlock(context.Background(), core.MetachainShardId, 10, common.BlockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Contains(t, err.Error(), "epoch start not found")
+	})
+	t.Run("should return the block of the epoch start nonce", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				requestedPaths = append(requestedPaths, path)
+				switch response := value.(type) {
+				case *data.EpochStartDataApiResponse:
+					response.Data.EpochStart = data.EpochStartData{Nonce: 1234, Epoch: 10, Shard: core.MetachainShardId}
+				case *data.BlockApiResponse:
+					response.Data.Block = api.Block{
+						Nonce:          1234,
+						Epoch:          10,
+						EpochStartInfo: &api.EpochStartInfo{TotalSupply: "20000000"},
+					}
+				}
+				return http.StatusOK, nil
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		res, err := bp.GetEpochStartBlock(context.Background(), core.MetachainShardId, 10, common.BlockQueryOptions{WithTransactions: true})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1234), res.Data.Block.Nonce)
+		require.Equal(t, "20000000", res.Data.Block.EpochStartInfo.TotalSupply)
+		require.Equal(t, []string{"/node/epoch-start/10", "/block/by-nonce/1234?withTxs=true"}, requestedPaths)
+	})
+}
+
 func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	t.Parallel()
 
@@ -359,12 +478,12 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(proc)
+	processor, err := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
 	numGetBlockCalled = 0
-	response, err := processor.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{})
+	response, err := processor.GetHyperBlockByHash(context.Background(), "abcd", common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -372,7 +491,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 
 	numGetBlockCalled = 0
-	response, err = processor.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+	response, err = processor.GetHyperBlockByNonce(context.Background(), 42, common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -391,10 +510,10 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
+	blk, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -416,10 +535,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -442,10 +561,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -464,10 +583,10 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -485,10 +604,10 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -514,15 +633,15 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, nonce, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByNonce(core.MetachainShardId, nonce, common.Proto)
+	res, err = bp.GetInternalBlockByNonce(context.Background(), core.MetachainShardId, nonce, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -539,10 +658,10 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
+	blk, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -564,10 +683,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -590,10 +709,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -612,10 +731,10 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -633,10 +752,10 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -661,15 +780,15 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByHash(core.MetachainShardId, "aaaa", common.Proto)
+	res, err = bp.GetInternalBlockByHash(context.Background(), core.MetachainShardId, "aaaa", common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -686,10 +805,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
+	blk, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -711,10 +830,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -737,10 +856,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -759,10 +878,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -780,10 +899,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -808,15 +927,15 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalMiniBlockByHash(core.MetachainShardId, "aaaa", 1, common.Proto)
+	res, err = bp.GetInternalMiniBlockByHash(context.Background(), core.MetachainShardId, "aaaa", 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -833,10 +952,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
+	blk, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -858,10 +977,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -884,10 +1003,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -906,10 +1025,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -928,10 +1047,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -956,15 +1075,15 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalStartOfEpochMetaBlock(1, common.Proto)
+	res, err = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -986,8 +1105,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1015,8 +1134,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1044,8 +1163,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1073,8 +1192,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1102,8 +1221,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1131,8 +1250,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1226,9 +1345,9 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 
-	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1343,9 +1462,9 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 
-	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByHash(context.Background(), "abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1400,11 +1519,117 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
+	res, err := bp.GetInternalStartOfEpochValidatorsInfo(context.Background(), 1)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_GetBlocksByHashes(t *testing.T) {
+	t.Parallel()
+
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: fmt.Sprintf("addr%d", shardId)}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			if strings.Contains(path, "missing") {
+				return 404, errors.New("block not found")
+			}
+
+			assert.True(t, strings.Contains(path, "withTxs=true"))
+			valResp := value.(*data.BlockApiResponse)
+			valResp.Data.Block = api.Block{Hash: path[strings.LastIndex(path, "/")+1 : strings.Index(path, "?")], Shard: uint32(address[len(address)-1] - '0')}
+			return 200, nil
+		},
+	}
+	bp, _ := process.NewBlockProcessor(proc, 3, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+	options := common.BlockQueryOptions{WithTransactions: true}
+
+	t.Run("no block requested should error", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := bp.GetBlocksByHashes(context.Background(), nil, options)
+		require.Nil(t, results)
+		require.Equal(t, process.ErrNoBlocksRequested, err)
+	})
+	t.Run("too many blocks requested should error", func(t *testing.T) {
+		t.Parallel()
+
+		requests := make([]*data.BlockByHashRequest, 4)
+		for idx := range requests {
+			requests[idx] = &data.BlockByHashRequest{Hash: "hash"}
+		}
+		results, err := bp.GetBlocksByHashes(context.Background(), requests, options)
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, process.ErrTooManyBlocksRequested))
+	})
+	t.Run("empty hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := bp.GetBlocksByHashes(context.Background(), []*data.BlockByHashRequest{{Shard: 0, Hash: ""}}, options)
+		require.Nil(t, results)
+		require.Equal(t, process.ErrEmptyBlockHash, err)
+	})
+	t.Run("should return the blocks in the requested order", func(t *testing.T) {
+		t.Parallel()
+
+		requests := []*data.BlockByHashRequest{
+			{Shard: 1, Hash: "hash1"},
+			{Shard: 0, Hash: "missing"},
+			{Shard: 2, Hash: "hash2"},
+		}
+		results, err := bp.GetBlocksByHashes(context.Background(), requests, options)
+		require.Nil(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, uint32(1), results[0].Shard)
+		assert.Equal(t, "hash1", results[0].Block.Hash)
+		assert.Equal(t, uint32(1), results[0].Block.Shard)
+		assert.Empty(t, results[0].Error)
+
+		assert.Equal(t, "missing", results[1].Hash)
+		assert.Nil(t, results[1].Block)
+		assert.NotEmpty(t, results[1].Error)
+
+		assert.Equal(t, "hash2", results[2].Block.Hash)
+		assert.Equal(t, uint32(2), results[2].Block.Shard)
+	})
+}
+
+func TestBlockProcessor_GetHyperBlockByNonceShouldUseTheCache(t *testing.T) {
+	t.Parallel()
+
+	numMetaBlockRequests := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "observerAddress"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			if strings.HasPrefix(path, "/block/by-nonce/") {
+				numMetaBlockRequests++
+			}
+
+			ret := value.(*data.BlockApiResponse)
+			ret.Code = data.ReturnCodeSuccess
+			ret.Data.Block = api.Block{Nonce: 4}
+			return http.StatusOK, nil
+		},
+	}
+	hyperblocksCache, _ := cache.NewHyperblocksLRUCache(10)
+	bp, _ := process.NewBlockProcessor(proc, 100, hyperblocksCache, &disabled.ReorgDetector{})
+
+	firstResponse, err := bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{})
+	require.NoError(t, err)
+	secondResponse, err := bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, firstResponse, secondResponse)
+	require.Equal(t, 1, numMetaBlockRequests)
+
+	_, err = bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{WithLogs: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, numMetaBlockRequests)
+}
@@ -0,0 +1,305 @@
+package process
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// BlocksExportFormatJSON is the export format in which each line of the file holds a block in JSON format
+	BlocksExportFormatJSON = "json"
+	// BlocksExportFormatProto is the export format in which the file holds the protobuf encoded blocks, each one being
+	// prefixed by its length as an unsigned varint
+	BlocksExportFormatProto = "proto"
+
+	// BlocksExportStatusRunning is the status of an export job which is still fetching blocks
+	BlocksExportStatusRunning = "running"
+	// BlocksExportStatusCompleted is the status of an export job which wrote all the blocks
+	BlocksExportStatusCompleted = "completed"
+	// BlocksExportStatusFailed is the status of an export job which stopped because of an error
+	BlocksExportStatusFailed = "failed"
+
+	partialExportFileSuffix = ".part"
+)
+
+// BlocksExporter exports ranges of blocks, fetched one by one from the observers, to files in the configured
+// directory. Only one export job runs at a time; the status of the jobs is kept in memory
+type BlocksExporter struct {
+	blocksSource    BlocksExportSource
+	directory       string
+	maxBlocksPerJob uint64
+	jobs            map[string]*data.BlocksExportJob
+	numJobs         uint64
+	isJobRunning    bool
+	mutJobs         sync.RWMutex
+	ctx             context.Context
+	cancelFunc      func()
+}
+
+// NewBlocksExporter creates a new instance of BlocksExporter
+func NewBlocksExporter(blocksSource BlocksExportSource, directory string, maxBlocksPerJob uint64) (*BlocksExporter, error) {
+	if check.IfNil(blocksSource) {
+		return nil, ErrNilBlocksExportSource
+	}
+	if len(directory) == 0 {
+		return nil, ErrEmptyBlocksExportDirectory
+	}
+	if maxBlocksPerJob == 0 {
+		return nil, ErrInvalidMaxBlocksPerExport
+	}
+
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &BlocksExporter{
+		blocksSource:    blocksSource,
+		directory:       directory,
+		maxBlocksPerJob: maxBlocksPerJob,
+		jobs:            make(map[string]*data.BlocksExportJob),
+		ctx:             ctx,
+		cancelFunc:      cancelFunc,
+	}, nil
+}
+
+// StartExport validates the request and starts the export job in background, returning its initial status
+func (be *BlocksExporter) StartExport(ctx context.Context, request *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+	format, err := be.checkExportRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	be.mutJobs.Lock()
+	defer be.mutJobs.Unlock()
+
+	if be.isJobRunning {
+		return nil, ErrBlocksExportAlreadyRunning
+	}
+
+	be.numJobs++
+	jobID := fmt.Sprintf("%d-%d", time.Now().Unix(), be.numJobs)
+	job := &data.BlocksExportJob{
+		ID:          jobID,
+		Shard:       request.Shard,
+		FromNonce:   request.FromNonce,
+		ToNonce:     request.ToNonce,
+		Format:      format,
+		Hyperblocks: request.Hyperblocks,
+		Status:      BlocksExportStatusRunning,
+		File:        filepath.Join(be.directory, computeExportFileName(jobID, request, format)),
+		StartTime:   time.Now().Unix(),
+	}
+	be.jobs[jobID] = job
+	be.isJobRunning = true
+
+	go be.runExport(ctx, job)
+
+	jobCopy := *job
+	return &jobCopy, nil
+}
+
+func (be *BlocksExporter) checkExportRequest(request *data.BlocksExportRequest) (string, error) {
+	format := request.Format
+	if len(format) == 0 {
+		format = BlocksExportFormatJSON
+	}
+	if format != BlocksExportFormatJSON && format != BlocksExportFormatProto {
+		return "", fmt.Errorf("%w: %s", ErrInvalidBlocksExportFormat, format)
+	}
+	if request.Hyperblocks {
+		if request.Shard != core.MetachainShardId {
+			return "", ErrHyperblocksExportNotOnMetachain
+		}
+		if format != BlocksExportFormatJSON {
+			return "", fmt.Errorf("%w: hyperblocks can only be exported as %s", ErrInvalidBlocksExportFormat, BlocksExportFormatJSON)
+		}
+	}
+	if request.FromNonce > request.ToNonce {
+		return "", ErrInvalidBlocksExportRange
+	}
+	if request.ToNonce-request.FromNonce+1 > be.maxBlocksPerJob {
+		return "", fmt.Errorf("%w: at most %d blocks can be exported by a job", ErrInvalidBlocksExportRange, be.maxBlocksPerJob)
+	}
+
+	return format, nil
+}
+
+func computeExportFileName(jobID string, request *data.BlocksExportRequest, format string) string {
+	kind := "blocks"
+	if request.Hyperblocks {
+		kind = "hyperblocks"
+	}
+	extension := "ndjson"
+	if format == BlocksExportFormatProto {
+		extension = "pb"
+	}
+
+	return fmt.Sprintf("%s-shard-%d-%d-%d-%s.%s", kind, request.Shard, request.FromNonce, request.ToNonce, jobID, extension)
+}
+
+func (be *BlocksExporter) runExport(ctx context.Context, job *data.BlocksExportJob) {
+	err := be.exportBlocks(ctx, job)
+
+	be.mutJobs.Lock()
+	defer be.mutJobs.Unlock()
+
+	be.isJobRunning = false
+	job.EndTime = time.Now().Unix()
+	if err != nil {
+		job.Status = BlocksExportStatusFailed
+		job.Error = err.Error()
+		log.WithContext(ctx).Warn("blocks export failed", "job", job.ID, "exported", job.NumExported, "error", err.Error())
+		return
+	}
+
+	job.Status = BlocksExportStatusCompleted
+	log.WithContext(ctx).Info("blocks export completed", "job", job.ID, "file", job.File, "exported", job.NumExported)
+}
+
+func (be *BlocksExporter) exportBlocks(ctx context.Context, job *data.BlocksExportJob) error {
+	partialFile := job.File + partialExportFileSuffix
+	file, err := os.Create(partialFile)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	err = be.writeBlocks(ctx, job, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(partialFile)
+		return err
+	}
+
+	return os.Rename(partialFile, job.File)
+}
+
+func (be *BlocksExporter) writeBlocks(ctx context.Context, job *data.BlocksExportJob, writer *bufio.Writer) error {
+	for nonce := job.FromNonce; nonce <= job.ToNonce; nonce++ {
+		select {
+		case <-be.ctx.Done():
+			return ErrBlocksExportInterrupted
+		default:
+		}
+
+		blockBytes, err := be.fetchBlock(ctx, job, nonce)
+		if err != nil {
+			return fmt.Errorf("%w for nonce %d", err, nonce)
+		}
+
+		err = writeExportedBlock(writer, job.Format, blockBytes)
+		if err != nil {
+			return err
+		}
+
+		be.mutJobs.Lock()
+		job.NumExported++
+		be.mutJobs.Unlock()
+
+		if nonce == job.ToNonce {
+			// avoids the overflow of the nonce when exporting up to the maximum value
+			break
+		}
+	}
+
+	return nil
+}
+
+func (be *BlocksExporter) fetchBlock(ctx context.Context, job *data.BlocksExportJob, nonce uint64) ([]byte, error) {
+	if job.Hyperblocks {
+		response, err := be.blocksSource.GetHyperBlockByNonce(ctx, nonce, common.HyperblockQueryOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(response.Data.Hyperblock)
+	}
+
+	if job.Format == BlocksExportFormatProto {
+		response, err := be.blocksSource.GetInternalBlockByNonce(ctx, job.Shard, nonce, common.Proto)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedBlock, ok := response.Data.Block.(string)
+		if !ok {
+			return nil, ErrInvalidExportedBlock
+		}
+
+		return base64.StdEncoding.DecodeString(encodedBlock)
+	}
+
+	response, err := be.blocksSource.GetBlockByNonce(ctx, job.Shard, nonce, common.BlockQueryOptions{WithTransactions: true, WithLogs: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(response.Data.Block)
+}
+
+func writeExportedBlock(writer *bufio.Writer, format string, blockBytes []byte) error {
+	if format == BlocksExportFormatProto {
+		lengthPrefix := binary.AppendUvarint(nil, uint64(len(blockBytes)))
+		_, err := writer.Write(lengthPrefix)
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(blockBytes)
+		return err
+	}
+
+	_, err := writer.Write(blockBytes)
+	if err != nil {
+		return err
+	}
+
+	return writer.WriteByte('\n')
+}
+
+// GetExportJob returns the status of the export job with the provided ID
+func (be *BlocksExporter) GetExportJob(jobID string) (*data.BlocksExportJob, error) {
+	be.mutJobs.RLock()
+	defer be.mutJobs.RUnlock()
+
+	job, found := be.jobs[jobID]
+	if !found {
+		return nil, ErrBlocksExportJobNotFound
+	}
+
+	jobCopy := *job
+	return &jobCopy, nil
+}
+
+// Close interrupts the running export job, if any
+func (be *BlocksExporter) Close() error {
+	be.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (be *BlocksExporter) IsInterfaceNil() bool {
+	return be == nil
+}
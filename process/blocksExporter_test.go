@@ -0,0 +1,198 @@
+package process_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForExportJob(t *testing.T, be *process.BlocksExporter, jobID string) *data.BlocksExportJob {
+	for i := 0; i < 100; i++ {
+		job, err := be.GetExportJob(jobID)
+		require.Nil(t, err)
+		if job.Status != process.BlocksExportStatusRunning {
+			return job
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "export job did not finish in time")
+	return nil
+}
+
+func TestNewBlocksExporter(t *testing.T) {
+	t.Parallel()
+
+	be, err := process.NewBlocksExporter(nil, t.TempDir(), 10)
+	assert.Nil(t, be)
+	assert.Equal(t, process.ErrNilBlocksExportSource, err)
+
+	be, err = process.NewBlocksExporter(&mock.BlocksExportSourceStub{}, "", 10)
+	assert.Nil(t, be)
+	assert.Equal(t, process.ErrEmptyBlocksExportDirectory, err)
+
+	be, err = process.NewBlocksExporter(&mock.BlocksExportSourceStub{}, t.TempDir(), 0)
+	assert.Nil(t, be)
+	assert.Equal(t, process.ErrInvalidMaxBlocksPerExport, err)
+
+	be, err = process.NewBlocksExporter(&mock.BlocksExportSourceStub{}, t.TempDir(), 10)
+	assert.NotNil(t, be)
+	assert.Nil(t, err)
+}
+
+func TestBlocksExporter_StartExportInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	be, _ := process.NewBlocksExporter(&mock.BlocksExportSourceStub{}, t.TempDir(), 10)
+
+	_, err := be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 2, Format: "xml"})
+	assert.True(t, errors.Is(err, process.ErrInvalidBlocksExportFormat))
+
+	_, err = be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 3, ToNonce: 2})
+	assert.Equal(t, process.ErrInvalidBlocksExportRange, err)
+
+	_, err = be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 11})
+	assert.True(t, errors.Is(err, process.ErrInvalidBlocksExportRange))
+
+	_, err = be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 2, Hyperblocks: true})
+	assert.Equal(t, process.ErrHyperblocksExportNotOnMetachain, err)
+
+	_, err = be.StartExport(context.Background(), &data.BlocksExportRequest{Shard: core.MetachainShardId, FromNonce: 1, ToNonce: 2, Hyperblocks: true, Format: process.BlocksExportFormatProto})
+	assert.True(t, errors.Is(err, process.ErrInvalidBlocksExportFormat))
+
+	_, err = be.GetExportJob("missing")
+	assert.Equal(t, process.ErrBlocksExportJobNotFound, err)
+}
+
+func TestBlocksExporter_ExportJSON(t *testing.T) {
+	t.Parallel()
+
+	be, _ := process.NewBlocksExporter(&mock.BlocksExportSourceStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			assert.Equal(t, uint32(1), shardID)
+			assert.True(t, options.WithTransactions)
+			return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Shard: shardID}}}, nil
+		},
+	}, t.TempDir(), 10)
+
+	job, err := be.StartExport(context.Background(), &data.BlocksExportRequest{Shard: 1, FromNonce: 5, ToNonce: 7})
+	require.Nil(t, err)
+	assert.Equal(t, process.BlocksExportFormatJSON, job.Format)
+
+	job = waitForExportJob(t, be, job.ID)
+	require.Equal(t, process.BlocksExportStatusCompleted, job.Status)
+	assert.Equal(t, uint64(3), job.NumExported)
+
+	content, err := os.ReadFile(job.File)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Equal(t, 3, len(lines))
+	for i, line := range lines {
+		block := api.Block{}
+		require.Nil(t, json.Unmarshal([]byte(line), &block))
+		assert.Equal(t, uint64(5+i), block.Nonce)
+	}
+}
+
+func TestBlocksExporter_ExportProto(t *testing.T) {
+	t.Parallel()
+
+	be, _ := process.NewBlocksExporter(&mock.BlocksExportSourceStub{
+		GetInternalBlockByNonceCalled: func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+			assert.Equal(t, common.Proto, format)
+			encodedBlock := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{byte(nonce)}, int(nonce)))
+			return &data.InternalBlockApiResponse{Data: data.InternalBlockApiResponsePayload{Block: encodedBlock}}, nil
+		},
+	}, t.TempDir(), 10)
+
+	job, err := be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 3, Format: process.BlocksExportFormatProto})
+	require.Nil(t, err)
+
+	job = waitForExportJob(t, be, job.ID)
+	require.Equal(t, process.BlocksExportStatusCompleted, job.Status)
+
+	file, err := os.Open(job.File)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	for nonce := 1; nonce <= 3; nonce++ {
+		length, errRead := binary.ReadUvarint(reader)
+		require.Nil(t, errRead)
+		require.Equal(t, uint64(nonce), length)
+
+		block := make([]byte, length)
+		_, errRead = reader.Read(block)
+		require.Nil(t, errRead)
+		assert.Equal(t, bytes.Repeat([]byte{byte(nonce)}, nonce), block)
+	}
+}
+
+func TestBlocksExporter_ExportFailureShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("observers down")
+	be, _ := process.NewBlocksExporter(&mock.BlocksExportSourceStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			if nonce == 2 {
+				return nil, expectedErr
+			}
+			return &data.HyperblockApiResponse{}, nil
+		},
+	}, t.TempDir(), 10)
+
+	job, err := be.StartExport(context.Background(), &data.BlocksExportRequest{Shard: core.MetachainShardId, FromNonce: 1, ToNonce: 3, Hyperblocks: true})
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(job.File, "hyperblocks"))
+
+	job = waitForExportJob(t, be, job.ID)
+	assert.Equal(t, process.BlocksExportStatusFailed, job.Status)
+	assert.Equal(t, uint64(1), job.NumExported)
+	assert.True(t, strings.Contains(job.Error, expectedErr.Error()))
+
+	_, err = os.Stat(job.File)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestBlocksExporter_OnlyOneJobShouldRun(t *testing.T) {
+	t.Parallel()
+
+	chanRelease := make(chan struct{})
+	be, _ := process.NewBlocksExporter(&mock.BlocksExportSourceStub{
+		GetBlockByNonceCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			<-chanRelease
+			return &data.BlockApiResponse{}, nil
+		},
+	}, t.TempDir(), 10)
+
+	job, err := be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 1})
+	require.Nil(t, err)
+
+	_, err = be.StartExport(context.Background(), &data.BlocksExportRequest{FromNonce: 1, ToNonce: 1})
+	assert.Equal(t, process.ErrBlocksExportAlreadyRunning, err)
+
+	close(chanRelease)
+	job = waitForExportJob(t, be, job.ID)
+	assert.Equal(t, process.BlocksExportStatusCompleted, job.Status)
+	_ = be.Close()
+}
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestBlocksProcessor_GetBlocksByRound_InvalidObservers_ExpectError(t *testin
 
 	bp, _ := process.NewBlocksProcessor(proc)
 
-	ret, actualErr := bp.GetBlocksByRound(0, common.BlockQueryOptions{})
+	ret, actualErr := bp.GetBlocksByRound(context.Background(), 0, common.BlockQueryOptions{})
 
 	require.Equal(t, err, actualErr)
 	require.Equal(t, (*data.BlocksApiResponse)(nil), ret)
@@ -77,7 +78,7 @@ func TestBlocksProcessor_GetBlocksByRound_InvalidCallGetRestEndPoint_ExpectZeroF
 
 	bp, _ := process.NewBlocksProcessor(proc)
 
-	ret, actualErr := bp.GetBlocksByRound(0, common.BlockQueryOptions{})
+	ret, actualErr := bp.GetBlocksByRound(context.Background(), 0, common.BlockQueryOptions{})
 	expectedRet := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
 			Blocks: make([]*api.Block, 0, 2),
@@ -145,7 +146,7 @@ func TestBlocksProcessor_GetBlocksByRound_TwoBlocks_ThreeObservers_OneObserverGe
 	}
 
 	bp, _ := process.NewBlocksProcessor(proc)
-	ret, err := bp.GetBlocksByRound(0, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := bp.GetBlocksByRound(context.Background(), 0, common.BlockQueryOptions{WithTransactions: true})
 
 	expectedApiResp := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
@@ -0,0 +1,237 @@
+package process
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const tokenNonceSeparator = "-"
+
+// DecodeTransactionOperation decodes the built-in function called by the transaction, if any, such as the token
+// transfers or the guardian settings. It returns nil if the data field does not hold a known built-in function call
+func (tp *TransactionProcessor) DecodeTransactionOperation(tx *transaction.ApiTransactionResult) *data.TransactionOperation {
+	if tx == nil || len(tx.Data) == 0 {
+		return nil
+	}
+
+	function, arguments := splitDataField(string(tx.Data))
+	for _, argument := range arguments {
+		_, err := hex.DecodeString(argument)
+		if err != nil {
+			return nil
+		}
+	}
+
+	decoder := &builtInFunctionDecoder{
+		tp:        tp,
+		function:  function,
+		arguments: arguments,
+		receiver:  tx.Receiver,
+	}
+
+	return decoder.decode()
+}
+
+// builtInFunctionDecoder decodes the hex encoded arguments of a built-in function call
+type builtInFunctionDecoder struct {
+	tp        *TransactionProcessor
+	function  string
+	arguments []string
+	receiver  string
+}
+
+func (d *builtInFunctionDecoder) decode() *data.TransactionOperation {
+	switch d.function {
+	case core.BuiltInFunctionESDTTransfer:
+		return d.decodeESDTTransfer()
+	case core.BuiltInFunctionESDTNFTTransfer:
+		return d.decodeESDTNFTTransfer()
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		return d.decodeMultiESDTNFTTransfer()
+	case core.BuiltInFunctionESDTLocalMint, core.BuiltInFunctionESDTLocalBurn, core.BuiltInFunctionESDTBurn:
+		return d.decodeFungibleTokenOperation()
+	case core.BuiltInFunctionESDTNFTBurn, core.BuiltInFunctionESDTNFTAddQuantity:
+		return d.decodeNonFungibleTokenOperation()
+	case core.BuiltInFunctionSetGuardian:
+		return d.decodeSetGuardian()
+	case core.BuiltInFunctionChangeOwnerAddress:
+		return d.decodeChangeOwnerAddress()
+	case core.BuiltInFunctionGuardAccount, core.BuiltInFunctionUnGuardAccount, core.BuiltInFunctionClaimDeveloperRewards:
+		return &data.TransactionOperation{Function: d.function}
+	default:
+		return nil
+	}
+}
+
+// decodeESDTTransfer decodes ESDTTransfer@<token>@<amount>[@<function>@<arguments>...]
+func (d *builtInFunctionDecoder) decodeESDTTransfer() *data.TransactionOperation {
+	if len(d.arguments) < 2 {
+		return nil
+	}
+
+	operation := &data.TransactionOperation{
+		Function: d.function,
+		Receiver: d.receiver,
+		Tokens:   []*data.TransactionOperationToken{d.token(d.arguments[0], "", d.arguments[1])},
+	}
+	d.setCalledFunction(operation, 2)
+
+	return operation
+}
+
+// decodeESDTNFTTransfer decodes ESDTNFTTransfer@<collection>@<nonce>@<quantity>@<destination>[@<function>@<arguments>...],
+// the transaction being sent by the owner to itself
+func (d *builtInFunctionDecoder) decodeESDTNFTTransfer() *data.TransactionOperation {
+	if len(d.arguments) < 4 {
+		return nil
+	}
+
+	operation := &data.TransactionOperation{
+		Function: d.function,
+		Receiver: d.address(d.arguments[3]),
+		Tokens:   []*data.TransactionOperationToken{d.token(d.arguments[0], d.arguments[1], d.arguments[2])},
+	}
+	d.setCalledFunction(operation, 4)
+
+	return operation
+}
+
+// decodeMultiESDTNFTTransfer decodes MultiESDTNFTTransfer@<destination>@<number of transfers>
+// [@<token>@<nonce>@<amount>]...[@<function>@<arguments>...], the transaction being sent by the owner to itself
+func (d *builtInFunctionDecoder) decodeMultiESDTNFTTransfer() *data.TransactionOperation {
+	if len(d.arguments) < 2 {
+		return nil
+	}
+
+	numTransfers := d.uint64(d.arguments[1])
+	firstCallArgument := 2 + 3*numTransfers
+	if numTransfers == 0 || firstCallArgument > uint64(len(d.arguments)) {
+		return nil
+	}
+
+	operation := &data.TransactionOperation{
+		Function: d.function,
+		Receiver: d.address(d.arguments[0]),
+		Tokens:   make([]*data.TransactionOperationToken, 0, numTransfers),
+	}
+	for idx := uint64(2); idx < firstCallArgument; idx += 3 {
+		operation.Tokens = append(operation.Tokens, d.token(d.arguments[idx], d.arguments[idx+1], d.arguments[idx+2]))
+	}
+	d.setCalledFunction(operation, int(firstCallArgument))
+
+	return operation
+}
+
+// decodeFungibleTokenOperation decodes <function>@<token>@<amount>
+func (d *builtInFunctionDecoder) decodeFungibleTokenOperation() *data.TransactionOperation {
+	if len(d.arguments) < 2 {
+		return nil
+	}
+
+	return &data.TransactionOperation{
+		Function: d.function,
+		Tokens:   []*data.TransactionOperationToken{d.token(d.arguments[0], "", d.arguments[1])},
+	}
+}
+
+// decodeNonFungibleTokenOperation decodes <function>@<collection>@<nonce>@<quantity>
+func (d *builtInFunctionDecoder) decodeNonFungibleTokenOperation() *data.TransactionOperation {
+	if len(d.arguments) < 3 {
+		return nil
+	}
+
+	return &data.TransactionOperation{
+		Function: d.function,
+		Tokens:   []*data.TransactionOperationToken{d.token(d.arguments[0], d.arguments[1], d.arguments[2])},
+	}
+}
+
+// decodeSetGuardian decodes SetGuardian@<guardian>@<service ID>
+func (d *builtInFunctionDecoder) decodeSetGuardian() *data.TransactionOperation {
+	if len(d.arguments) < 2 {
+		return nil
+	}
+
+	return &data.TransactionOperation{
+		Function:  d.function,
+		Guardian:  d.address(d.arguments[0]),
+		ServiceID: d.string(d.arguments[1]),
+	}
+}
+
+// decodeChangeOwnerAddress decodes ChangeOwnerAddress@<new owner>
+func (d *builtInFunctionDecoder) decodeChangeOwnerAddress() *data.TransactionOperation {
+	if len(d.arguments) < 1 {
+		return nil
+	}
+
+	return &data.TransactionOperation{
+		Function: d.function,
+		Receiver: d.receiver,
+		NewOwner: d.address(d.arguments[0]),
+	}
+}
+
+// setCalledFunction sets the smart contract function called along with the transfer, if any
+func (d *builtInFunctionDecoder) setCalledFunction(operation *data.TransactionOperation, functionIndex int) {
+	if functionIndex >= len(d.arguments) {
+		return
+	}
+
+	operation.CalledFunction = d.string(d.arguments[functionIndex])
+	if functionIndex+1 < len(d.arguments) {
+		operation.CalledArguments = d.arguments[functionIndex+1:]
+	}
+}
+
+func (d *builtInFunctionDecoder) token(identifierArgument string, nonceArgument string, amountArgument string) *data.TransactionOperationToken {
+	return newTransactionOperationToken(d.string(identifierArgument), d.uint64(nonceArgument), d.bigInt(amountArgument))
+}
+
+func (d *builtInFunctionDecoder) address(argument string) string {
+	addressBytes, _ := hex.DecodeString(argument)
+	if len(addressBytes) != d.tp.pubKeyConverter.Len() {
+		return argument
+	}
+
+	return d.tp.encodeAddress(addressBytes)
+}
+
+func (d *builtInFunctionDecoder) string(argument string) string {
+	value, _ := hex.DecodeString(argument)
+	return string(value)
+}
+
+func (d *builtInFunctionDecoder) uint64(argument string) uint64 {
+	value, _ := hex.DecodeString(argument)
+	return big.NewInt(0).SetBytes(value).Uint64()
+}
+
+func (d *builtInFunctionDecoder) bigInt(argument string) string {
+	value, _ := hex.DecodeString(argument)
+	return big.NewInt(0).SetBytes(value).String()
+}
+
+func newTransactionOperationToken(collection string, nonce uint64, amount string) *data.TransactionOperationToken {
+	if nonce == 0 {
+		return &data.TransactionOperationToken{
+			Identifier: collection,
+			Amount:     amount,
+		}
+	}
+
+	return &data.TransactionOperationToken{
+		Identifier: collection + tokenNonceSeparator + encodeUint64Argument(nonce),
+		Collection: collection,
+		Nonce:      nonce,
+		Amount:     amount,
+	}
+}
+
+func (tp *TransactionProcessor) encodeAddress(addressBytes []byte) string {
+	return tp.pubKeyConverter.SilentEncode(addressBytes, log)
+}
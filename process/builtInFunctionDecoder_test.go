@@ -0,0 +1,131 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hexArgument(value string) string {
+	return hex.EncodeToString([]byte(value))
+}
+
+func hexAddress(address string) string {
+	addressBytes, _ := testPubkeyConverter.Decode(address)
+	return hex.EncodeToString(addressBytes)
+}
+
+func TestTransactionProcessor_DecodeTransactionOperation(t *testing.T) {
+	t.Parallel()
+
+	tp := createValidationTransactionProcessor(t)
+	decode := func(dataField string) *data.TransactionOperation {
+		return tp.DecodeTransactionOperation(&transaction.ApiTransactionResult{
+			Sender:   validationSender,
+			Receiver: validationReceiver,
+			Data:     []byte(dataField),
+		})
+	}
+
+	t.Run("nil or without data transaction should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, tp.DecodeTransactionOperation(nil))
+		assert.Nil(t, decode(""))
+	})
+	t.Run("unknown function or malformed arguments should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, decode("transfer@"+hexArgument("WEGLD-bd4d79")+"@0a"))
+		assert.Nil(t, decode("ESDTTransfer@not hex@0a"))
+		assert.Nil(t, decode("ESDTTransfer@"+hexArgument("WEGLD-bd4d79")))
+		assert.Nil(t, decode("MultiESDTNFTTransfer@"+hexAddress(validationReceiver)+"@02@"+hexArgument("WEGLD-bd4d79")+"@@0a"))
+	})
+	t.Run("ESDTTransfer with smart contract call", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("ESDTTransfer@" + hexArgument("WEGLD-bd4d79") + "@0de0b6b3a7640000@" + hexArgument("swap") + "@01@02")
+		require.NotNil(t, operation)
+		assert.Equal(t, &data.TransactionOperation{
+			Function: "ESDTTransfer",
+			Receiver: validationReceiver,
+			Tokens: []*data.TransactionOperationToken{
+				{Identifier: "WEGLD-bd4d79", Amount: "1000000000000000000"},
+			},
+			CalledFunction:  "swap",
+			CalledArguments: []string{"01", "02"},
+		}, operation)
+	})
+	t.Run("ESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("ESDTNFTTransfer@" + hexArgument("NFT-abcdef") + "@0a@01@" + hexAddress(validationSender))
+		require.NotNil(t, operation)
+		assert.Equal(t, &data.TransactionOperation{
+			Function: "ESDTNFTTransfer",
+			Receiver: validationSender,
+			Tokens: []*data.TransactionOperationToken{
+				{Identifier: "NFT-abcdef-0a", Collection: "NFT-abcdef", Nonce: 10, Amount: "1"},
+			},
+		}, operation)
+	})
+	t.Run("MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("MultiESDTNFTTransfer@" + hexAddress(validationSender) + "@02" +
+			"@" + hexArgument("WEGLD-bd4d79") + "@@0a" +
+			"@" + hexArgument("NFT-abcdef") + "@0100@01" +
+			"@" + hexArgument("buy"))
+		require.NotNil(t, operation)
+		assert.Equal(t, &data.TransactionOperation{
+			Function: "MultiESDTNFTTransfer",
+			Receiver: validationSender,
+			Tokens: []*data.TransactionOperationToken{
+				{Identifier: "WEGLD-bd4d79", Amount: "10"},
+				{Identifier: "NFT-abcdef-0100", Collection: "NFT-abcdef", Nonce: 256, Amount: "1"},
+			},
+			CalledFunction: "buy",
+		}, operation)
+	})
+	t.Run("SetGuardian", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("SetGuardian@" + hexAddress(validationSender) + "@" + hexArgument("MultiversXTCSService"))
+		require.NotNil(t, operation)
+		assert.Equal(t, &data.TransactionOperation{
+			Function:  "SetGuardian",
+			Guardian:  validationSender,
+			ServiceID: "MultiversXTCSService",
+		}, operation)
+	})
+	t.Run("ChangeOwnerAddress and GuardAccount", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("ChangeOwnerAddress@" + hexAddress(validationSender))
+		require.NotNil(t, operation)
+		assert.Equal(t, &data.TransactionOperation{
+			Function: "ChangeOwnerAddress",
+			Receiver: validationReceiver,
+			NewOwner: validationSender,
+		}, operation)
+
+		assert.Equal(t, &data.TransactionOperation{Function: "GuardAccount"}, decode("GuardAccount"))
+	})
+	t.Run("ESDTLocalBurn and ESDTNFTAddQuantity", func(t *testing.T) {
+		t.Parallel()
+
+		operation := decode("ESDTLocalBurn@" + hexArgument("WEGLD-bd4d79") + "@64")
+		require.NotNil(t, operation)
+		assert.Equal(t, []*data.TransactionOperationToken{{Identifier: "WEGLD-bd4d79", Amount: "100"}}, operation.Tokens)
+
+		operation = decode("ESDTNFTAddQuantity@" + hexArgument("SFT-abcdef") + "@02@05")
+		require.NotNil(t, operation)
+		assert.Equal(t, []*data.TransactionOperationToken{
+			{Identifier: "SFT-abcdef-02", Collection: "SFT-abcdef", Nonce: 2, Amount: "5"},
+		}, operation.Tokens)
+	})
+}
@@ -19,3 +19,43 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidShardIDCacheSize signals that an invalid size was provided for the shard IDs cache
+var ErrInvalidShardIDCacheSize = errors.New("invalid shard IDs cache size")
+
+// ErrInvalidTxStatusCacheSize signals that an invalid size was provided for the transaction statuses cache
+var ErrInvalidTxStatusCacheSize = errors.New("invalid transaction statuses cache size")
+
+// ErrInvalidTxStatusCacheTerminalTTL signals that an invalid TTL was provided for the terminal transaction statuses
+var ErrInvalidTxStatusCacheTerminalTTL = errors.New("invalid terminal transaction statuses TTL")
+
+// ErrInvalidHyperblocksCacheSize signals that an invalid size was provided for the hyperblocks cache
+var ErrInvalidHyperblocksCacheSize = errors.New("invalid hyperblocks cache size")
+
+// ErrInvalidSentTxsDeduplicationWindow signals that an invalid deduplication window was provided for the sent
+// transactions cache
+var ErrInvalidSentTxsDeduplicationWindow = errors.New("invalid sent transactions deduplication window")
+
+// ErrInvalidObserversAffinityCacheSize signals that an invalid size was provided for the observers affinity cache
+var ErrInvalidObserversAffinityCacheSize = errors.New("invalid observers affinity cache size")
+
+// ErrInvalidObserversAffinityWindow signals that an invalid affinity window was provided for the observers affinity cache
+var ErrInvalidObserversAffinityWindow = errors.New("invalid observers affinity window")
+
+// ErrNilStorer signals that a nil storer was provided for the cache snapshots
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidMaxSnapshotAge signals that an invalid maximum age was provided for the cache snapshots
+var ErrInvalidMaxSnapshotAge = errors.New("invalid maximum cache snapshot age")
+
+// ErrInvalidReorgDetectionWindow signals that an invalid window was provided for reporting the detected reorgs
+var ErrInvalidReorgDetectionWindow = errors.New("invalid reorg detection window")
+
+// ErrInvalidMaxTrackedNoncesPerShard signals that an invalid number of tracked nonces per shard was provided
+var ErrInvalidMaxTrackedNoncesPerShard = errors.New("invalid maximum number of tracked nonces per shard")
+
+// ErrInvalidESDTMetadataCacheSize signals that an invalid size was provided for the ESDT metadata cache
+var ErrInvalidESDTMetadataCacheSize = errors.New("invalid ESDT metadata cache size")
+
+// ErrInvalidESDTMetadataCacheValidity signals that an invalid validity duration was provided for the ESDT metadata cache
+var ErrInvalidESDTMetadataCacheValidity = errors.New("invalid ESDT metadata cache validity")
@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type esdtMetadataEntry struct {
+	properties         *data.Collection
+	propertiesCachedAt time.Time
+	roles              map[string][]string
+	rolesCachedAt      time.Time
+	hasProperties      bool
+	hasRoles           bool
+}
+
+// esdtMetadataCache will hold the metadata of the recently requested tokens: their properties, decimals and roles. The
+// entries are invalidated when a change of the token is observed on chain, while the validity duration bounds the time
+// a change missed by the follower is served stale
+type esdtMetadataCache struct {
+	validity       time.Duration
+	entries        *lruCache[string, *esdtMetadataEntry]
+	getTimeHandler func() time.Time
+	mutMetadata    sync.Mutex
+}
+
+// NewESDTMetadataCache will return a new instance of esdtMetadataCache able to hold the metadata of the provided number
+// of tokens for the provided validity duration
+func NewESDTMetadataCache(capacity int, validity time.Duration) (*esdtMetadataCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidESDTMetadataCacheSize
+	}
+	if validity <= 0 {
+		return nil, ErrInvalidESDTMetadataCacheValidity
+	}
+
+	return &esdtMetadataCache{
+		validity:       validity,
+		entries:        newLRUCache[string, *esdtMetadataEntry](capacity),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// GetTokenProperties returns a copy of the cached properties of the provided token, if found and still valid
+func (emc *esdtMetadataCache) GetTokenProperties(token string) (*data.Collection, bool) {
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	entry, found := emc.getEntry(token)
+	if !found || !entry.hasProperties || emc.isExpired(entry.propertiesCachedAt) {
+		return nil, false
+	}
+
+	return copyTokenProperties(entry.properties), true
+}
+
+// PutTokenProperties will store a copy of the properties of the provided token
+func (emc *esdtMetadataCache) PutTokenProperties(token string, properties *data.Collection) {
+	if properties == nil {
+		return
+	}
+
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	entry := emc.getOrCreateEntry(token)
+	entry.properties = copyTokenProperties(properties)
+	entry.propertiesCachedAt = emc.getTimeHandler()
+	entry.hasProperties = true
+}
+
+// GetTokenRoles returns a copy of the cached roles of the provided token, by address, if found and still valid
+func (emc *esdtMetadataCache) GetTokenRoles(token string) (map[string][]string, bool) {
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	entry, found := emc.getEntry(token)
+	if !found || !entry.hasRoles || emc.isExpired(entry.rolesCachedAt) {
+		return nil, false
+	}
+
+	return copyTokenRoles(entry.roles), true
+}
+
+// PutTokenRoles will store a copy of the roles of the provided token, by address
+func (emc *esdtMetadataCache) PutTokenRoles(token string, roles map[string][]string) {
+	if roles == nil {
+		return
+	}
+
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	entry := emc.getOrCreateEntry(token)
+	entry.roles = copyTokenRoles(roles)
+	entry.rolesCachedAt = emc.getTimeHandler()
+	entry.hasRoles = true
+}
+
+// Invalidate will remove the cached metadata of the provided token
+func (emc *esdtMetadataCache) Invalidate(token string) {
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	emc.entries.remove(token)
+}
+
+// InvalidateAll will remove the cached metadata of all the tokens
+func (emc *esdtMetadataCache) InvalidateAll() {
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	emc.entries.clear()
+}
+
+func (emc *esdtMetadataCache) getEntry(token string) (*esdtMetadataEntry, bool) {
+	return emc.entries.get(token, time.Time{})
+}
+
+// getOrCreateEntry returns the entry of the provided token, creating it if missing and evicting the least recently
+// used one if the cache is full
+func (emc *esdtMetadataCache) getOrCreateEntry(token string) *esdtMetadataEntry {
+	entry, found := emc.getEntry(token)
+	if found {
+		return entry
+	}
+
+	entry = &esdtMetadataEntry{}
+	emc.entries.put(token, entry, time.Time{})
+
+	return entry
+}
+
+func (emc *esdtMetadataCache) isExpired(cachedAt time.Time) bool {
+	return emc.getTimeHandler().Sub(cachedAt) > emc.validity
+}
+
+// the cached values are copied in and out, as the callers complete the returned properties with more data
+func copyTokenProperties(properties *data.Collection) *data.Collection {
+	propertiesCopy := *properties
+	propertiesCopy.Properties = make(map[string]bool, len(properties.Properties))
+	for name, value := range properties.Properties {
+		propertiesCopy.Properties[name] = value
+	}
+	propertiesCopy.Roles = copyTokenRoles(properties.Roles)
+
+	return &propertiesCopy
+}
+
+func copyTokenRoles(roles map[string][]string) map[string][]string {
+	if roles == nil {
+		return nil
+	}
+
+	rolesCopy := make(map[string][]string, len(roles))
+	for address, addressRoles := range roles {
+		rolesCopy[address] = append([]string{}, addressRoles...)
+	}
+
+	return rolesCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (emc *esdtMetadataCache) IsInterfaceNil() bool {
+	return emc == nil
+}
@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createTokenProperties(token string) *data.Collection {
+	return &data.Collection{
+		Identifier: token,
+		Name:       "Token",
+		Type:       "FungibleESDT",
+		Decimals:   18,
+		Properties: map[string]bool{"canUpgrade": true},
+	}
+}
+
+func TestNewESDTMetadataCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		emc, err := NewESDTMetadataCache(0, time.Minute)
+		require.Nil(t, emc)
+		require.Equal(t, ErrInvalidESDTMetadataCacheSize, err)
+	})
+	t.Run("invalid validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		emc, err := NewESDTMetadataCache(10, 0)
+		require.Nil(t, emc)
+		require.Equal(t, ErrInvalidESDTMetadataCacheValidity, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		emc, err := NewESDTMetadataCache(10, time.Minute)
+		require.NoError(t, err)
+		require.False(t, emc.IsInterfaceNil())
+	})
+}
+
+func TestESDTMetadataCache_PutAndGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("properties and roles should be cached separately", func(t *testing.T) {
+		t.Parallel()
+
+		emc, _ := NewESDTMetadataCache(10, time.Minute)
+
+		_, found := emc.GetTokenProperties("TKN-abcdef")
+		require.False(t, found)
+
+		emc.PutTokenProperties("TKN-abcdef", createTokenProperties("TKN-abcdef"))
+		properties, found := emc.GetTokenProperties("TKN-abcdef")
+		require.True(t, found)
+		require.Equal(t, createTokenProperties("TKN-abcdef"), properties)
+
+		_, found = emc.GetTokenRoles("TKN-abcdef")
+		require.False(t, found)
+
+		emc.PutTokenRoles("TKN-abcdef", map[string][]string{"address": {"ESDTRoleLocalMint"}})
+		roles, found := emc.GetTokenRoles("TKN-abcdef")
+		require.True(t, found)
+		require.Equal(t, map[string][]string{"address": {"ESDTRoleLocalMint"}}, roles)
+		require.Equal(t, 1, emc.Len())
+	})
+	t.Run("cached values should not be altered by the callers", func(t *testing.T) {
+		t.Parallel()
+
+		emc, _ := NewESDTMetadataCache(10, time.Minute)
+
+		properties := createTokenProperties("TKN-abcdef")
+		emc.PutTokenProperties("TKN-abcdef", properties)
+		properties.Properties["canUpgrade"] = false
+
+		cachedProperties, _ := emc.GetTokenProperties("TKN-abcdef")
+		cachedProperties.Roles = map[string][]string{"address": {"ESDTRoleLocalMint"}}
+
+		cachedProperties, _ = emc.GetTokenProperties("TKN-abcdef")
+		require.Equal(t, createTokenProperties("TKN-abcdef"), cachedProperties)
+	})
+	t.Run("expired values should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		emc, _ := NewESDTMetadataCache(10, time.Minute)
+		emc.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		emc.PutTokenProperties("TKN-abcdef", createTokenProperties("TKN-abcdef"))
+		now = now.Add(30 * time.Second)
+		emc.PutTokenRoles("TKN-abcdef", map[string][]string{})
+
+		now = now.Add(45 * time.Second)
+		_, found := emc.GetTokenProperties("TKN-abcdef")
+		require.False(t, found)
+		_, found = emc.GetTokenRoles("TKN-abcdef")
+		require.True(t, found)
+	})
+	t.Run("least recently used token should be evicted when full", func(t *testing.T) {
+		t.Parallel()
+
+		emc, _ := NewESDTMetadataCache(2, time.Minute)
+
+		emc.PutTokenProperties("A-abcdef", createTokenProperties("A-abcdef"))
+		emc.PutTokenProperties("B-abcdef", createTokenProperties("B-abcdef"))
+		_, _ = emc.GetTokenProperties("A-abcdef")
+		emc.PutTokenProperties("C-abcdef", createTokenProperties("C-abcdef"))
+
+		require.Equal(t, 2, emc.Len())
+		_, found := emc.GetTokenProperties("A-abcdef")
+		require.True(t, found)
+		_, found = emc.GetTokenProperties("B-abcdef")
+		require.False(t, found)
+		_, found = emc.GetTokenProperties("C-abcdef")
+		require.True(t, found)
+	})
+}
+
+func TestESDTMetadataCache_Invalidate(t *testing.T) {
+	t.Parallel()
+
+	emc, _ := NewESDTMetadataCache(10, time.Minute)
+	emc.PutTokenProperties("A-abcdef", createTokenProperties("A-abcdef"))
+	emc.PutTokenRoles("A-abcdef", map[string][]string{})
+	emc.PutTokenProperties("B-abcdef", createTokenProperties("B-abcdef"))
+
+	emc.Invalidate("A-abcdef")
+	emc.Invalidate("missing")
+	_, found := emc.GetTokenProperties("A-abcdef")
+	require.False(t, found)
+	_, found = emc.GetTokenRoles("A-abcdef")
+	require.False(t, found)
+	_, found = emc.GetTokenProperties("B-abcdef")
+	require.True(t, found)
+
+	emc.InvalidateAll()
+	require.Equal(t, 0, emc.Len())
+	_, found = emc.GetTokenProperties("B-abcdef")
+	require.False(t, found)
+}
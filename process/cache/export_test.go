@@ -1,6 +1,10 @@
 package cache
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 func (hmc *HeartbeatMemoryCacher) GetStoredHbts() []data.PubKeyHeartbeat {
 	hmc.mutHeartbeats.RLock()
@@ -40,3 +44,41 @@ func (garmc *genericApiResponseMemoryCacher) SetGenericApiResponse(response *dat
 	garmc.storedResponse = response
 	garmc.mutGenericApiResponse.Unlock()
 }
+
+func (oac *observersAffinityCache) SetGetTimeHandler(handler func() time.Time) {
+	oac.mutAffinities.Lock()
+	oac.getTimeHandler = handler
+	oac.mutAffinities.Unlock()
+}
+
+func (oac *observersAffinityCache) Len() int {
+	oac.mutAffinities.Lock()
+	defer oac.mutAffinities.Unlock()
+
+	return oac.affinities.len()
+}
+
+func (rd *reorgDetector) SetGetTimeHandler(handler func() time.Time) {
+	rd.mutReorgs.Lock()
+	rd.getTimeHandler = handler
+	rd.mutReorgs.Unlock()
+}
+
+func (emc *esdtMetadataCache) SetGetTimeHandler(handler func() time.Time) {
+	emc.mutMetadata.Lock()
+	emc.getTimeHandler = handler
+	emc.mutMetadata.Unlock()
+}
+
+func (emc *esdtMetadataCache) Len() int {
+	emc.mutMetadata.Lock()
+	defer emc.mutMetadata.Unlock()
+
+	return emc.entries.len()
+}
+
+func (tsc *txStatusLRUCache) SetGetTimeHandler(handler func() time.Time) {
+	tsc.mutStatuses.Lock()
+	tsc.getTimeHandler = handler
+	tsc.mutStatuses.Unlock()
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// hyperblocksLRUCache will hold the most recently requested hyperblocks. The blocks are immutable, so the hyperblocks
+// are never invalidated, being only evicted when the cache is full
+type hyperblocksLRUCache struct {
+	hyperblocks    *lruCache[string, *data.HyperblockApiResponse]
+	mutHyperblocks sync.Mutex
+}
+
+// NewHyperblocksLRUCache will return a new instance of hyperblocksLRUCache able to hold the provided number of hyperblocks
+func NewHyperblocksLRUCache(capacity int) (*hyperblocksLRUCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidHyperblocksCacheSize
+	}
+
+	return &hyperblocksLRUCache{
+		hyperblocks: newLRUCache[string, *data.HyperblockApiResponse](capacity),
+	}, nil
+}
+
+// Get returns the cached hyperblock stored under the provided key, if found
+func (hc *hyperblocksLRUCache) Get(key string) (*data.HyperblockApiResponse, bool) {
+	hc.mutHyperblocks.Lock()
+	defer hc.mutHyperblocks.Unlock()
+
+	return hc.hyperblocks.get(key, time.Time{})
+}
+
+// Put will store the hyperblock under the provided key, evicting the least recently used one if the cache is full
+func (hc *hyperblocksLRUCache) Put(key string, hyperblock *data.HyperblockApiResponse) {
+	if hyperblock == nil {
+		return
+	}
+
+	hc.mutHyperblocks.Lock()
+	defer hc.mutHyperblocks.Unlock()
+
+	hc.hyperblocks.put(key, hyperblock, time.Time{})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hc *hyperblocksLRUCache) IsInterfaceNil() bool {
+	return hc == nil
+}
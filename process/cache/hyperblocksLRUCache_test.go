@@ -0,0 +1,82 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHyperblockResponse(nonce uint64) *data.HyperblockApiResponse {
+	return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce})
+}
+
+func TestNewHyperblocksLRUCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		hc, err := cache.NewHyperblocksLRUCache(0)
+		assert.Nil(t, hc)
+		assert.Equal(t, cache.ErrInvalidHyperblocksCacheSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hc, err := cache.NewHyperblocksLRUCache(10)
+		assert.NoError(t, err)
+		assert.False(t, hc.IsInterfaceNil())
+	})
+}
+
+func TestHyperblocksLRUCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := cache.NewHyperblocksLRUCache(10)
+		hyperblock, found := hc.Get("1")
+		assert.False(t, found)
+		assert.Nil(t, hyperblock)
+	})
+	t.Run("nil hyperblock should not be stored", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := cache.NewHyperblocksLRUCache(10)
+		hc.Put("1", nil)
+
+		_, found := hc.Get("1")
+		assert.False(t, found)
+	})
+	t.Run("stored hyperblock should be found", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := cache.NewHyperblocksLRUCache(10)
+		expectedHyperblock := createHyperblockResponse(1)
+		hc.Put("1", expectedHyperblock)
+
+		hyperblock, found := hc.Get("1")
+		assert.True(t, found)
+		assert.Equal(t, expectedHyperblock, hyperblock)
+	})
+	t.Run("least recently used hyperblock should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		hc, _ := cache.NewHyperblocksLRUCache(2)
+		hc.Put("1", createHyperblockResponse(1))
+		hc.Put("2", createHyperblockResponse(2))
+		_, _ = hc.Get("1")
+		hc.Put("3", createHyperblockResponse(3))
+
+		_, found := hc.Get("1")
+		assert.True(t, found)
+		_, found = hc.Get("2")
+		assert.False(t, found)
+		_, found = hc.Get("3")
+		assert.True(t, found)
+	})
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// lruCache is the least recently used cache the specialized caches of this package are built on. Each entry can have
+// an expiry time, a zero one meaning the entry never expires. The cache is not concurrent safe, the callers being the
+// ones guarding it
+type lruCache[K comparable, V any] struct {
+	capacity  int
+	evictList *list.List
+	items     map[K]*list.Element
+}
+
+func newLRUCache[K comparable, V any](capacity int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		capacity:  capacity,
+		evictList: list.New(),
+		items:     make(map[K]*list.Element, capacity),
+	}
+}
+
+// get returns the value stored under the provided key, if found and not expired, marking it as the most recently used
+func (lc *lruCache[K, V]) get(key K, now time.Time) (V, bool) {
+	element, found := lc.getElement(key, now)
+	if !found {
+		var value V
+		return value, false
+	}
+
+	lc.evictList.MoveToFront(element)
+
+	return element.Value.(*lruEntry[K, V]).value, true
+}
+
+// peek returns the value stored under the provided key, if found and not expired, without changing its position, so
+// that the entries are kept in the order they were stored
+func (lc *lruCache[K, V]) peek(key K, now time.Time) (V, bool) {
+	element, found := lc.getElement(key, now)
+	if !found {
+		var value V
+		return value, false
+	}
+
+	return element.Value.(*lruEntry[K, V]).value, true
+}
+
+func (lc *lruCache[K, V]) getElement(key K, now time.Time) (*list.Element, bool) {
+	element, found := lc.items[key]
+	if !found {
+		return nil, false
+	}
+
+	if isExpiredLRUEntry(element.Value.(*lruEntry[K, V]), now) {
+		lc.removeElement(element)
+		return nil, false
+	}
+
+	return element, true
+}
+
+// put will store the value under the provided key as the most recently used one, evicting the least recently used
+// entry if the cache is full
+func (lc *lruCache[K, V]) put(key K, value V, expiresAt time.Time) {
+	element, found := lc.items[key]
+	if found {
+		entry := element.Value.(*lruEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		lc.evictList.MoveToFront(element)
+		return
+	}
+
+	lc.items[key] = lc.evictList.PushFront(&lruEntry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+	if lc.evictList.Len() <= lc.capacity {
+		return
+	}
+
+	lc.removeElement(lc.evictList.Back())
+}
+
+// removeExpiredOldest will remove the expired entries starting with the least recently used one, stopping at the first
+// entry which is still valid. All the expired entries are removed only if the entries share the same lifetime and are
+// read with peek
+func (lc *lruCache[K, V]) removeExpiredOldest(now time.Time) {
+	for oldest := lc.evictList.Back(); oldest != nil; oldest = lc.evictList.Back() {
+		if !isExpiredLRUEntry(oldest.Value.(*lruEntry[K, V]), now) {
+			return
+		}
+
+		lc.removeElement(oldest)
+	}
+}
+
+// remove will remove the entry stored under the provided key, if any
+func (lc *lruCache[K, V]) remove(key K) {
+	element, found := lc.items[key]
+	if !found {
+		return
+	}
+
+	lc.removeElement(element)
+}
+
+// clear will remove all the entries
+func (lc *lruCache[K, V]) clear() {
+	lc.evictList.Init()
+	lc.items = make(map[K]*list.Element, lc.capacity)
+}
+
+// len returns the number of stored entries, including the expired ones not removed yet
+func (lc *lruCache[K, V]) len() int {
+	return lc.evictList.Len()
+}
+
+func (lc *lruCache[K, V]) removeElement(element *list.Element) {
+	lc.evictList.Remove(element)
+	delete(lc.items, element.Value.(*lruEntry[K, V]).key)
+}
+
+func isExpiredLRUEntry[K comparable, V any](entry *lruEntry[K, V], now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
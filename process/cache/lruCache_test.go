@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache_ShouldEvictLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	lc := newLRUCache[string, int](2)
+	lc.put("key0", 0, time.Time{})
+	lc.put("key1", 1, time.Time{})
+
+	_, found := lc.get("key0", time.Now())
+	require.True(t, found)
+
+	lc.put("key2", 2, time.Time{})
+
+	_, found = lc.get("key1", time.Now())
+	assert.False(t, found)
+	value, found := lc.get("key0", time.Now())
+	assert.True(t, found)
+	assert.Equal(t, 0, value)
+	value, found = lc.get("key2", time.Now())
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 2, lc.len())
+}
+
+func TestLRUCache_PeekShouldNotChangeTheOrder(t *testing.T) {
+	t.Parallel()
+
+	lc := newLRUCache[string, int](2)
+	lc.put("key0", 0, time.Time{})
+	lc.put("key1", 1, time.Time{})
+
+	_, found := lc.peek("key0", time.Now())
+	require.True(t, found)
+
+	lc.put("key2", 2, time.Time{})
+
+	_, found = lc.peek("key0", time.Now())
+	assert.False(t, found)
+	_, found = lc.peek("key1", time.Now())
+	assert.True(t, found)
+}
+
+func TestLRUCache_ShouldExpireEntries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	lc := newLRUCache[string, int](10)
+	lc.put("key0", 0, now.Add(time.Second))
+	lc.put("key1", 1, now.Add(2*time.Second))
+	lc.put("key2", 2, time.Time{})
+
+	_, found := lc.get("key0", now)
+	assert.True(t, found)
+	_, found = lc.get("key0", now.Add(time.Second))
+	assert.False(t, found)
+	assert.Equal(t, 2, lc.len())
+
+	lc.removeExpiredOldest(now.Add(time.Hour))
+	assert.Equal(t, 1, lc.len())
+	_, found = lc.get("key2", now.Add(time.Hour))
+	assert.True(t, found)
+}
+
+func TestLRUCache_RemoveAndClear(t *testing.T) {
+	t.Parallel()
+
+	lc := newLRUCache[string, int](10)
+	lc.put("key0", 0, time.Time{})
+	lc.put("key1", 1, time.Time{})
+
+	lc.remove("key0")
+	lc.remove("missing")
+	_, found := lc.get("key0", time.Now())
+	assert.False(t, found)
+	assert.Equal(t, 1, lc.len())
+
+	lc.clear()
+	assert.Zero(t, lc.len())
+	_, found = lc.get("key1", time.Now())
+	assert.False(t, found)
+}
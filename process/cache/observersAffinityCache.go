@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// observersAffinityCache will hold, for each sender, the observer which accepted its last transactions during the
+// affinity window, so that the sender's reads are served by the same observer and reflect its own writes. All the
+// entries share the same window, so they expire in the order they were recorded
+type observersAffinityCache struct {
+	window         time.Duration
+	affinities     *lruCache[string, string]
+	getTimeHandler func() time.Time
+	mutAffinities  sync.Mutex
+}
+
+// NewObserversAffinityCache will return a new instance of observersAffinityCache able to hold the provided number of
+// senders for the provided affinity window
+func NewObserversAffinityCache(capacity int, window time.Duration) (*observersAffinityCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidObserversAffinityCacheSize
+	}
+	if window <= 0 {
+		return nil, ErrInvalidObserversAffinityWindow
+	}
+
+	return &observersAffinityCache{
+		window:         window,
+		affinities:     newLRUCache[string, string](capacity),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Put will store the observer which accepted the last transactions of the sender, evicting the oldest sender if the
+// cache is full
+func (oac *observersAffinityCache) Put(sender string, observer string) {
+	oac.mutAffinities.Lock()
+	defer oac.mutAffinities.Unlock()
+
+	now := oac.getTimeHandler()
+	oac.affinities.removeExpiredOldest(now)
+	oac.affinities.put(sender, observer, now.Add(oac.window))
+}
+
+// Get returns the observer which accepted the last transactions of the sender during the affinity window, if any
+func (oac *observersAffinityCache) Get(sender string) (string, bool) {
+	oac.mutAffinities.Lock()
+	defer oac.mutAffinities.Unlock()
+
+	now := oac.getTimeHandler()
+	oac.affinities.removeExpiredOldest(now)
+
+	return oac.affinities.peek(sender, now)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oac *observersAffinityCache) IsInterfaceNil() bool {
+	return oac == nil
+}
@@ -0,0 +1,133 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewObserversAffinityCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		oac, err := cache.NewObserversAffinityCache(0, time.Second)
+		assert.Nil(t, oac)
+		assert.Equal(t, cache.ErrInvalidObserversAffinityCacheSize, err)
+	})
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		oac, err := cache.NewObserversAffinityCache(10, 0)
+		assert.Nil(t, oac)
+		assert.Equal(t, cache.ErrInvalidObserversAffinityWindow, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		oac, err := cache.NewObserversAffinityCache(10, time.Second)
+		assert.NoError(t, err)
+		assert.False(t, oac.IsInterfaceNil())
+	})
+}
+
+func TestObserversAffinityCache_PutGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("affinities should expire after the window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		oac, _ := cache.NewObserversAffinityCache(10, time.Minute)
+		oac.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		_, found := oac.Get("sender0")
+		assert.False(t, found)
+		oac.Put("sender0", "observer0")
+		now = now.Add(30 * time.Second)
+		oac.Put("sender1", "observer1")
+
+		observer, found := oac.Get("sender0")
+		assert.True(t, found)
+		assert.Equal(t, "observer0", observer)
+		observer, found = oac.Get("sender1")
+		assert.True(t, found)
+		assert.Equal(t, "observer1", observer)
+
+		now = now.Add(30 * time.Second)
+		_, found = oac.Get("sender0")
+		assert.False(t, found)
+		assert.Equal(t, 1, oac.Len())
+
+		now = now.Add(30 * time.Second)
+		_, found = oac.Get("sender1")
+		assert.False(t, found)
+		assert.Zero(t, oac.Len())
+	})
+	t.Run("putting again should replace the observer and restart the window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		oac, _ := cache.NewObserversAffinityCache(10, time.Minute)
+		oac.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		oac.Put("sender0", "observer0")
+		now = now.Add(40 * time.Second)
+		oac.Put("sender0", "observer1")
+		now = now.Add(40 * time.Second)
+
+		observer, found := oac.Get("sender0")
+		assert.True(t, found)
+		assert.Equal(t, "observer1", observer)
+		assert.Equal(t, 1, oac.Len())
+	})
+	t.Run("full cache should evict the oldest sender", func(t *testing.T) {
+		t.Parallel()
+
+		oac, _ := cache.NewObserversAffinityCache(2, time.Minute)
+		oac.Put("sender0", "observer0")
+		oac.Put("sender1", "observer1")
+		oac.Put("sender2", "observer2")
+
+		_, found := oac.Get("sender0")
+		assert.False(t, found)
+		_, found = oac.Get("sender1")
+		assert.True(t, found)
+		_, found = oac.Get("sender2")
+		assert.True(t, found)
+	})
+	t.Run("concurrent operations should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			r := recover()
+			assert.Nil(t, r)
+		}()
+
+		oac, _ := cache.NewObserversAffinityCache(100, time.Millisecond)
+		numOperations := 1000
+		wg := sync.WaitGroup{}
+		wg.Add(numOperations)
+		for i := 0; i < numOperations; i++ {
+			go func(idx int) {
+				sender := fmt.Sprintf("sender%d", idx%200)
+				if idx%2 == 0 {
+					oac.Put(sender, "observer")
+				} else {
+					_, _ = oac.Get(sender)
+				}
+				wg.Done()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// shardBlockHashes holds the hashes of the served blocks of a shard, by nonce
+type shardBlockHashes struct {
+	hashes       map[uint64]string
+	highestNonce uint64
+}
+
+// reorgDetector will hold the hashes of the recently served blocks of each shard and will flag a reorg whenever a
+// different hash is served for an already served nonce. Only the hashes of the highest served nonces are tracked, as
+// the reorgs only happen close to the chain head
+type reorgDetector struct {
+	window                   time.Duration
+	maxTrackedNoncesPerShard uint64
+	shards                   map[uint32]*shardBlockHashes
+	recentReorgs             []*data.ChainReorg
+	numReorgsPerShard        map[uint32]uint64
+	lastReorgTimestamp       int64
+	getTimeHandler           func() time.Time
+	mutReorgs                sync.Mutex
+}
+
+// NewReorgDetector will return a new instance of reorgDetector tracking the provided number of nonces in each shard and
+// reporting the detected reorgs for the provided window
+func NewReorgDetector(window time.Duration, maxTrackedNoncesPerShard int) (*reorgDetector, error) {
+	if window <= 0 {
+		return nil, ErrInvalidReorgDetectionWindow
+	}
+	if maxTrackedNoncesPerShard <= 0 {
+		return nil, ErrInvalidMaxTrackedNoncesPerShard
+	}
+
+	return &reorgDetector{
+		window:                   window,
+		maxTrackedNoncesPerShard: uint64(maxTrackedNoncesPerShard),
+		shards:                   make(map[uint32]*shardBlockHashes),
+		recentReorgs:             make([]*data.ChainReorg, 0),
+		numReorgsPerShard:        make(map[uint32]uint64),
+		getTimeHandler:           time.Now,
+	}, nil
+}
+
+// RecordBlock will store the hash of the served block, flagging a reorg if a different hash was served before for the
+// same shard and nonce
+func (rd *reorgDetector) RecordBlock(shardID uint32, nonce uint64, hash string) {
+	if len(hash) == 0 {
+		return
+	}
+
+	rd.mutReorgs.Lock()
+	defer rd.mutReorgs.Unlock()
+
+	shard, found := rd.shards[shardID]
+	if !found {
+		shard = &shardBlockHashes{
+			hashes: make(map[uint64]string),
+		}
+		rd.shards[shardID] = shard
+	}
+	if nonce+rd.maxTrackedNoncesPerShard <= shard.highestNonce {
+		return
+	}
+
+	previousHash, found := shard.hashes[nonce]
+	shard.hashes[nonce] = hash
+	if found && previousHash != hash {
+		rd.addReorg(shardID, nonce, previousHash, hash)
+	}
+	if nonce <= shard.highestNonce {
+		return
+	}
+
+	shard.highestNonce = nonce
+	if uint64(len(shard.hashes)) > rd.maxTrackedNoncesPerShard {
+		rd.removeOldNonces(shard)
+	}
+}
+
+func (rd *reorgDetector) addReorg(shardID uint32, nonce uint64, previousHash string, hash string) {
+	now := rd.getTimeHandler()
+	log.Warn("chain reorg detected", "shard", shardID, "nonce", nonce, "previous hash", previousHash, "hash", hash)
+
+	rd.recentReorgs = append(rd.recentReorgs, &data.ChainReorg{
+		Shard:        shardID,
+		Nonce:        nonce,
+		PreviousHash: previousHash,
+		Hash:         hash,
+		DetectedAt:   now.Unix(),
+	})
+	rd.numReorgsPerShard[shardID]++
+	rd.lastReorgTimestamp = now.Unix()
+}
+
+func (rd *reorgDetector) removeOldNonces(shard *shardBlockHashes) {
+	for nonce := range shard.hashes {
+		if nonce+rd.maxTrackedNoncesPerShard <= shard.highestNonce {
+			delete(shard.hashes, nonce)
+		}
+	}
+}
+
+// GetRecentReorgs returns the reorgs detected during the window, the oldest first
+func (rd *reorgDetector) GetRecentReorgs() []*data.ChainReorg {
+	rd.mutReorgs.Lock()
+	defer rd.mutReorgs.Unlock()
+
+	rd.removeExpiredReorgs()
+	reorgs := make([]*data.ChainReorg, len(rd.recentReorgs))
+	copy(reorgs, rd.recentReorgs)
+
+	return reorgs
+}
+
+func (rd *reorgDetector) removeExpiredReorgs() {
+	expiryTimestamp := rd.getTimeHandler().Add(-rd.window).Unix()
+	numExpired := 0
+	for _, reorg := range rd.recentReorgs {
+		if reorg.DetectedAt > expiryTimestamp {
+			break
+		}
+		numExpired++
+	}
+
+	rd.recentReorgs = rd.recentReorgs[numExpired:]
+}
+
+// GetMetrics returns the number of reorgs detected on each shard since the start, along with the time of the last one
+func (rd *reorgDetector) GetMetrics() data.ReorgDetectorMetrics {
+	rd.mutReorgs.Lock()
+	defer rd.mutReorgs.Unlock()
+
+	numReorgsPerShard := make(map[uint32]uint64, len(rd.numReorgsPerShard))
+	for shardID, numReorgs := range rd.numReorgsPerShard {
+		numReorgsPerShard[shardID] = numReorgs
+	}
+	numTrackedBlocks := 0
+	for _, shard := range rd.shards {
+		numTrackedBlocks += len(shard.hashes)
+	}
+
+	return data.ReorgDetectorMetrics{
+		NumReorgsPerShard:  numReorgsPerShard,
+		LastReorgTimestamp: rd.lastReorgTimestamp,
+		NumTrackedBlocks:   numTrackedBlocks,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rd *reorgDetector) IsInterfaceNil() bool {
+	return rd == nil
+}
@@ -0,0 +1,151 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReorgDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		rd, err := cache.NewReorgDetector(0, 10)
+		assert.Nil(t, rd)
+		assert.Equal(t, cache.ErrInvalidReorgDetectionWindow, err)
+	})
+	t.Run("invalid max tracked nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		rd, err := cache.NewReorgDetector(time.Minute, 0)
+		assert.Nil(t, rd)
+		assert.Equal(t, cache.ErrInvalidMaxTrackedNoncesPerShard, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rd, err := cache.NewReorgDetector(time.Minute, 10)
+		assert.NoError(t, err)
+		assert.False(t, rd.IsInterfaceNil())
+	})
+}
+
+func TestReorgDetector_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same hash served again should not flag a reorg", func(t *testing.T) {
+		t.Parallel()
+
+		rd, _ := cache.NewReorgDetector(time.Minute, 10)
+		rd.RecordBlock(0, 5, "hash5")
+		rd.RecordBlock(0, 5, "hash5")
+		rd.RecordBlock(1, 5, "otherHash5")
+
+		assert.Empty(t, rd.GetRecentReorgs())
+		assert.Empty(t, rd.GetMetrics().NumReorgsPerShard)
+		assert.Equal(t, 2, rd.GetMetrics().NumTrackedBlocks)
+	})
+	t.Run("different hash for the same nonce should flag a reorg", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		rd, _ := cache.NewReorgDetector(time.Minute, 10)
+		rd.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		rd.RecordBlock(0, 5, "hash5")
+		rd.RecordBlock(0, 6, "hash6")
+		rd.RecordBlock(0, 6, "newHash6")
+
+		expectedReorg := &data.ChainReorg{
+			Shard:        0,
+			Nonce:        6,
+			PreviousHash: "hash6",
+			Hash:         "newHash6",
+			DetectedAt:   now.Unix(),
+		}
+		assert.Equal(t, []*data.ChainReorg{expectedReorg}, rd.GetRecentReorgs())
+		metrics := rd.GetMetrics()
+		assert.Equal(t, map[uint32]uint64{0: 1}, metrics.NumReorgsPerShard)
+		assert.Equal(t, now.Unix(), metrics.LastReorgTimestamp)
+
+		// switching back is a reorg as well
+		rd.RecordBlock(0, 6, "hash6")
+		assert.Len(t, rd.GetRecentReorgs(), 2)
+		assert.Equal(t, map[uint32]uint64{0: 2}, rd.GetMetrics().NumReorgsPerShard)
+	})
+	t.Run("reorgs should expire after the window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		rd, _ := cache.NewReorgDetector(time.Minute, 10)
+		rd.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		rd.RecordBlock(0, 5, "hash5")
+		rd.RecordBlock(0, 5, "newHash5")
+		now = now.Add(30 * time.Second)
+		rd.RecordBlock(1, 7, "hash7")
+		rd.RecordBlock(1, 7, "newHash7")
+		assert.Len(t, rd.GetRecentReorgs(), 2)
+
+		now = now.Add(30 * time.Second)
+		recentReorgs := rd.GetRecentReorgs()
+		assert.Len(t, recentReorgs, 1)
+		assert.Equal(t, uint32(1), recentReorgs[0].Shard)
+
+		now = now.Add(30 * time.Second)
+		assert.Empty(t, rd.GetRecentReorgs())
+		assert.Equal(t, map[uint32]uint64{0: 1, 1: 1}, rd.GetMetrics().NumReorgsPerShard)
+	})
+	t.Run("only the highest nonces should be tracked", func(t *testing.T) {
+		t.Parallel()
+
+		rd, _ := cache.NewReorgDetector(time.Minute, 3)
+		for nonce := uint64(1); nonce <= 10; nonce++ {
+			rd.RecordBlock(0, nonce, fmt.Sprintf("hash%d", nonce))
+		}
+		assert.Equal(t, 3, rd.GetMetrics().NumTrackedBlocks)
+
+		rd.RecordBlock(0, 2, "newHash2")
+		assert.Empty(t, rd.GetRecentReorgs())
+
+		rd.RecordBlock(0, 8, "newHash8")
+		assert.Len(t, rd.GetRecentReorgs(), 1)
+	})
+	t.Run("empty hash should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		rd, _ := cache.NewReorgDetector(time.Minute, 10)
+		rd.RecordBlock(0, 5, "hash5")
+		rd.RecordBlock(0, 5, "")
+
+		assert.Empty(t, rd.GetRecentReorgs())
+	})
+	t.Run("concurrent operations should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		rd, _ := cache.NewReorgDetector(time.Minute, 100)
+		wg := sync.WaitGroup{}
+		wg.Add(100)
+		for i := 0; i < 100; i++ {
+			go func(idx int) {
+				defer wg.Done()
+
+				rd.RecordBlock(uint32(idx%3), uint64(idx%10), fmt.Sprintf("hash%d", idx))
+				_ = rd.GetRecentReorgs()
+				_ = rd.GetMetrics()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
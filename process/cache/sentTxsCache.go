@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+const sentTxsStorageKeyPrefix = "sent-txs/"
+
+// sentTxsCache will hold the hashes of the transactions relayed during the deduplication window, so that the identical
+// signed transactions re-submitted by retrying clients are not broadcast again. The hashes are kept in the proxy
+// storage, each one expiring after the window, so that a Redis storage shares them between all the proxies
+type sentTxsCache struct {
+	storer storage.Storer
+	window time.Duration
+}
+
+// NewSentTxsCache will return a new instance of sentTxsCache holding the transaction hashes in the provided storage for
+// the provided deduplication window
+func NewSentTxsCache(storer storage.Storer, window time.Duration) (*sentTxsCache, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+	if window <= 0 {
+		return nil, ErrInvalidSentTxsDeduplicationWindow
+	}
+
+	return &sentTxsCache{
+		storer: storer,
+		window: window,
+	}, nil
+}
+
+// TryMarkSent will mark the provided transaction hash as sent, returning false if it was already marked during the
+// deduplication window. The check and the mark are a single storage operation, so only one of the concurrent identical
+// transactions is relayed. If the storage fails, the transaction is considered not sent, so that it is still relayed
+func (stc *sentTxsCache) TryMarkSent(txHash string) bool {
+	isMarked, err := stc.storer.PutIfAbsent(sentTxsStorageKeyPrefix+txHash, []byte(txHash), stc.window)
+	if err != nil {
+		log.Warn("cannot mark the transaction as sent", "hash", txHash, "error", err.Error())
+		return true
+	}
+
+	return isMarked
+}
+
+// UnmarkSent will remove the sent mark of the provided transaction hash, so that the transaction which could not be
+// relayed can be submitted again
+func (stc *sentTxsCache) UnmarkSent(txHash string) {
+	err := stc.storer.Remove(sentTxsStorageKeyPrefix + txHash)
+	if err != nil {
+		log.Warn("cannot remove the sent mark of the transaction", "hash", txHash, "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (stc *sentTxsCache) IsInterfaceNil() bool {
+	return stc == nil
+}
@@ -0,0 +1,127 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type storerStub struct {
+	storage.Storer
+	PutIfAbsentCalled func(key string, value []byte, ttl time.Duration) (bool, error)
+	RemoveCalled      func(key string) error
+}
+
+func (stub *storerStub) PutIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	return stub.PutIfAbsentCalled(key, value, ttl)
+}
+
+func (stub *storerStub) Remove(key string) error {
+	return stub.RemoveCalled(key)
+}
+
+func (stub *storerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewSentTxsCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		stc, err := cache.NewSentTxsCache(nil, time.Second)
+		assert.Nil(t, stc)
+		assert.Equal(t, cache.ErrNilStorer, err)
+	})
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		stc, err := cache.NewSentTxsCache(storage.NewMemoryStorer(), 0)
+		assert.Nil(t, stc)
+		assert.Equal(t, cache.ErrInvalidSentTxsDeduplicationWindow, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stc, err := cache.NewSentTxsCache(storage.NewMemoryStorer(), time.Second)
+		assert.NoError(t, err)
+		assert.False(t, stc.IsInterfaceNil())
+	})
+}
+
+func TestSentTxsCache_TryMarkSent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should mark each hash once", func(t *testing.T) {
+		t.Parallel()
+
+		stc, _ := cache.NewSentTxsCache(storage.NewMemoryStorer(), time.Minute)
+		assert.True(t, stc.TryMarkSent("hash0"))
+		assert.False(t, stc.TryMarkSent("hash0"))
+		assert.True(t, stc.TryMarkSent("hash1"))
+
+		stc.UnmarkSent("hash0")
+		assert.True(t, stc.TryMarkSent("hash0"))
+	})
+	t.Run("should store the hashes with the window as TTL", func(t *testing.T) {
+		t.Parallel()
+
+		var storedKey string
+		var storedTTL time.Duration
+		stc, _ := cache.NewSentTxsCache(&storerStub{
+			PutIfAbsentCalled: func(key string, value []byte, ttl time.Duration) (bool, error) {
+				storedKey = key
+				storedTTL = ttl
+				return true, nil
+			},
+		}, time.Minute)
+
+		assert.True(t, stc.TryMarkSent("hash0"))
+		assert.Equal(t, "sent-txs/hash0", storedKey)
+		assert.Equal(t, time.Minute, storedTTL)
+	})
+	t.Run("storage error should let the transaction be relayed", func(t *testing.T) {
+		t.Parallel()
+
+		stc, _ := cache.NewSentTxsCache(&storerStub{
+			PutIfAbsentCalled: func(key string, value []byte, ttl time.Duration) (bool, error) {
+				return false, errors.New("storage down")
+			},
+			RemoveCalled: func(key string) error {
+				return errors.New("storage down")
+			},
+		}, time.Minute)
+
+		assert.True(t, stc.TryMarkSent("hash0"))
+		assert.True(t, stc.TryMarkSent("hash0"))
+		stc.UnmarkSent("hash0")
+	})
+	t.Run("concurrent identical transactions should be marked once", func(t *testing.T) {
+		t.Parallel()
+
+		stc, _ := cache.NewSentTxsCache(storage.NewMemoryStorer(), time.Minute)
+		numOperations := 1000
+		numMarked := uint32(0)
+		wg := sync.WaitGroup{}
+		wg.Add(numOperations)
+		for i := 0; i < numOperations; i++ {
+			go func(idx int) {
+				if stc.TryMarkSent(fmt.Sprintf("hash%d", idx%10)) {
+					atomic.AddUint32(&numMarked, 1)
+				}
+				wg.Done()
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, uint32(10), atomic.LoadUint32(&numMarked))
+	})
+}
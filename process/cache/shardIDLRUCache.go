@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// shardIDLRUCache will hold the most recently computed shard IDs, keyed by the address bytes
+type shardIDLRUCache struct {
+	capacity    int
+	shardIDs    *lruCache[string, uint32]
+	hits        uint64
+	misses      uint64
+	mutShardIDs sync.Mutex
+}
+
+// NewShardIDLRUCache will return a new instance of shardIDLRUCache able to hold the provided number of shard IDs
+func NewShardIDLRUCache(capacity int) (*shardIDLRUCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidShardIDCacheSize
+	}
+
+	return &shardIDLRUCache{
+		capacity: capacity,
+		shardIDs: newLRUCache[string, uint32](capacity),
+	}, nil
+}
+
+// Get returns the cached shard ID of the provided address, if found
+func (sc *shardIDLRUCache) Get(addressBuff []byte) (uint32, bool) {
+	sc.mutShardIDs.Lock()
+	defer sc.mutShardIDs.Unlock()
+
+	shardID, found := sc.shardIDs.get(string(addressBuff), time.Time{})
+	if !found {
+		sc.misses++
+		return 0, false
+	}
+
+	sc.hits++
+
+	return shardID, true
+}
+
+// Put will store the shard ID of the provided address, evicting the least recently used one if the cache is full
+func (sc *shardIDLRUCache) Put(addressBuff []byte, shardID uint32) {
+	sc.mutShardIDs.Lock()
+	defer sc.mutShardIDs.Unlock()
+
+	sc.shardIDs.put(string(addressBuff), shardID, time.Time{})
+}
+
+// GetMetrics returns the cache usage statistics
+func (sc *shardIDLRUCache) GetMetrics() data.ShardIDCacheMetrics {
+	sc.mutShardIDs.Lock()
+	defer sc.mutShardIDs.Unlock()
+
+	hitRate := float64(0)
+	numRequests := sc.hits + sc.misses
+	if numRequests > 0 {
+		hitRate = float64(sc.hits) / float64(numRequests)
+	}
+
+	return data.ShardIDCacheMetrics{
+		Capacity: sc.capacity,
+		Size:     sc.shardIDs.len(),
+		Hits:     sc.hits,
+		Misses:   sc.misses,
+		HitRate:  hitRate,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *shardIDLRUCache) IsInterfaceNil() bool {
+	return sc == nil
+}
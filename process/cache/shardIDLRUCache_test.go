@@ -0,0 +1,95 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShardIDLRUCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := cache.NewShardIDLRUCache(0)
+		assert.Nil(t, sc)
+		assert.Equal(t, cache.ErrInvalidShardIDCacheSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := cache.NewShardIDLRUCache(10)
+		assert.NoError(t, err)
+		assert.False(t, sc.IsInterfaceNil())
+	})
+}
+
+func TestShardIDLRUCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := cache.NewShardIDLRUCache(10)
+
+	_, found := sc.Get([]byte("addr"))
+	assert.False(t, found)
+
+	sc.Put([]byte("addr"), 2)
+	shardID, found := sc.Get([]byte("addr"))
+	assert.True(t, found)
+	assert.Equal(t, uint32(2), shardID)
+
+	metrics := sc.GetMetrics()
+	assert.Equal(t, 10, metrics.Capacity)
+	assert.Equal(t, 1, metrics.Size)
+	assert.Equal(t, uint64(1), metrics.Hits)
+	assert.Equal(t, uint64(1), metrics.Misses)
+	assert.Equal(t, 0.5, metrics.HitRate)
+}
+
+func TestShardIDLRUCache_ShouldEvictLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := cache.NewShardIDLRUCache(2)
+	sc.Put([]byte("addr0"), 0)
+	sc.Put([]byte("addr1"), 1)
+
+	_, found := sc.Get([]byte("addr0"))
+	require.True(t, found)
+
+	sc.Put([]byte("addr2"), 2)
+
+	_, found = sc.Get([]byte("addr1"))
+	assert.False(t, found)
+	_, found = sc.Get([]byte("addr0"))
+	assert.True(t, found)
+	_, found = sc.Get([]byte("addr2"))
+	assert.True(t, found)
+	assert.Equal(t, 2, sc.GetMetrics().Size)
+}
+
+func TestShardIDLRUCache_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	sc, _ := cache.NewShardIDLRUCache(5)
+	numOperations := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			address := []byte{byte(idx % 10)}
+			sc.Put(address, uint32(idx%3))
+			_, _ = sc.Get(address)
+			_ = sc.GetMetrics()
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+}
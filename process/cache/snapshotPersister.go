@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+const snapshotsStorageKeyPrefix = "cache-snapshots/"
+
+type cacheSnapshot struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// snapshotPersister saves the snapshots of the cached data as JSON values in a storage, so they can be loaded back
+// after a restart
+type snapshotPersister struct {
+	storer         storage.Storer
+	maxSnapshotAge time.Duration
+}
+
+// NewSnapshotPersister will return a new instance of snapshotPersister. The snapshots older than maxSnapshotAge are
+// ignored when loaded
+func NewSnapshotPersister(storer storage.Storer, maxSnapshotAge time.Duration) (*snapshotPersister, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+	if maxSnapshotAge <= 0 {
+		return nil, ErrInvalidMaxSnapshotAge
+	}
+
+	return &snapshotPersister{
+		storer:         storer,
+		maxSnapshotAge: maxSnapshotAge,
+	}, nil
+}
+
+// SaveSnapshot writes the provided value as the snapshot of the given key
+func (sp *snapshotPersister) SaveSnapshot(key string, value interface{}) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	snapshotBytes, err := json.Marshal(&cacheSnapshot{
+		SavedAt: time.Now(),
+		Data:    valueBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return sp.storer.Put(snapshotsStorageKeyPrefix+key, snapshotBytes)
+}
+
+// LoadSnapshot reads the snapshot of the given key into the provided value and returns the time it was saved at. It
+// returns false if there is no usable snapshot, either missing, unreadable or older than the maximum snapshot age
+func (sp *snapshotPersister) LoadSnapshot(key string, value interface{}) (time.Time, bool) {
+	snapshotBytes, err := sp.storer.Get(snapshotsStorageKeyPrefix + key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return time.Time{}, false
+	}
+	if err != nil {
+		log.Warn("cache snapshot: cannot read", "key", key, "error", err.Error())
+		return time.Time{}, false
+	}
+
+	snapshot := &cacheSnapshot{}
+	err = json.Unmarshal(snapshotBytes, snapshot)
+	if err != nil {
+		log.Warn("cache snapshot: cannot decode", "key", key, "error", err.Error())
+		return time.Time{}, false
+	}
+
+	age := time.Since(snapshot.SavedAt)
+	if age > sp.maxSnapshotAge {
+		log.Info("cache snapshot: ignored as too old", "key", key, "age", age)
+		return time.Time{}, false
+	}
+
+	err = json.Unmarshal(snapshot.Data, value)
+	if err != nil {
+		log.Warn("cache snapshot: cannot decode data", "key", key, "error", err.Error())
+		return time.Time{}, false
+	}
+
+	return snapshot.SavedAt, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *snapshotPersister) IsInterfaceNil() bool {
+	return sp == nil
+}
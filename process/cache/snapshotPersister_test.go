@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSnapshotPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSnapshotPersister(nil, time.Hour)
+		require.Nil(t, sp)
+		require.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("invalid max snapshot age should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSnapshotPersister(storage.NewMemoryStorer(), 0)
+		require.Nil(t, sp)
+		require.Equal(t, ErrInvalidMaxSnapshotAge, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSnapshotPersister(storage.NewMemoryStorer(), time.Hour)
+		require.NoError(t, err)
+		require.False(t, sp.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotPersister_SaveAndLoadSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing snapshot should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSnapshotPersister(storage.NewMemoryStorer(), time.Hour)
+
+		valStats := make(map[string]*data.ValidatorApiResponse)
+		_, found := sp.LoadSnapshot("validatorStatistics", &valStats)
+		require.False(t, found)
+	})
+	t.Run("saved snapshot should be loaded", func(t *testing.T) {
+		t.Parallel()
+
+		storer := storage.NewMemoryStorer()
+		sp, _ := NewSnapshotPersister(storer, time.Hour)
+
+		savedValStats := map[string]*data.ValidatorApiResponse{
+			"pubkey": {TempRating: 50, ShardId: 1},
+		}
+		beforeSave := time.Now()
+		err := sp.SaveSnapshot("validatorStatistics", savedValStats)
+		require.NoError(t, err)
+		keys, _ := storer.Keys("")
+		require.Equal(t, []string{"cache-snapshots/validatorStatistics"}, keys)
+
+		loadedValStats := make(map[string]*data.ValidatorApiResponse)
+		savedAt, found := sp.LoadSnapshot("validatorStatistics", &loadedValStats)
+		require.True(t, found)
+		require.Equal(t, savedValStats, loadedValStats)
+		require.False(t, savedAt.Before(beforeSave.Truncate(time.Second)))
+	})
+	t.Run("too old snapshot should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSnapshotPersister(storage.NewMemoryStorer(), time.Millisecond)
+
+		err := sp.SaveSnapshot("economicMetrics", &data.GenericAPIResponse{Code: data.ReturnCodeSuccess})
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		_, found := sp.LoadSnapshot("economicMetrics", &data.GenericAPIResponse{})
+		require.False(t, found)
+	})
+	t.Run("corrupted snapshot should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		storer := storage.NewMemoryStorer()
+		sp, _ := NewSnapshotPersister(storer, time.Hour)
+
+		err := storer.Put("cache-snapshots/networkConfig", []byte("not json"))
+		require.NoError(t, err)
+
+		_, found := sp.LoadSnapshot("networkConfig", &data.NetworkConfig{})
+		require.False(t, found)
+	})
+}
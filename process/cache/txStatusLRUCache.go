@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// txStatusLRUCache will hold the most recently requested transaction statuses, keyed by the transaction hash. The
+// pending statuses expire after a short TTL, while the terminal ones are kept longer, though not forever, as a chain
+// reorg can still change the status of a transaction whose block was not final yet
+type txStatusLRUCache struct {
+	pendingTTL     time.Duration
+	terminalTTL    time.Duration
+	statuses       *lruCache[string, transaction.TxStatus]
+	getTimeHandler func() time.Time
+	mutStatuses    sync.Mutex
+}
+
+// NewTxStatusLRUCache will return a new instance of txStatusLRUCache able to hold the provided number of statuses.
+// If the provided pending TTL is not positive, the pending statuses won't be cached
+func NewTxStatusLRUCache(capacity int, pendingTTL time.Duration, terminalTTL time.Duration) (*txStatusLRUCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidTxStatusCacheSize
+	}
+	if terminalTTL <= 0 {
+		return nil, ErrInvalidTxStatusCacheTerminalTTL
+	}
+
+	return &txStatusLRUCache{
+		pendingTTL:     pendingTTL,
+		terminalTTL:    terminalTTL,
+		statuses:       newLRUCache[string, transaction.TxStatus](capacity),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Get returns the cached status of the provided transaction hash, if found and not expired
+func (tsc *txStatusLRUCache) Get(txHash string) (transaction.TxStatus, bool) {
+	tsc.mutStatuses.Lock()
+	defer tsc.mutStatuses.Unlock()
+
+	return tsc.statuses.get(txHash, tsc.getTimeHandler())
+}
+
+// Put will store the status of the provided transaction hash, evicting the least recently used one if the cache is full
+func (tsc *txStatusLRUCache) Put(txHash string, status transaction.TxStatus) {
+	ttl := tsc.terminalTTL
+	if !isTerminalTxStatus(status) {
+		if status != transaction.TxStatusPending || tsc.pendingTTL <= 0 {
+			return
+		}
+
+		ttl = tsc.pendingTTL
+	}
+
+	tsc.mutStatuses.Lock()
+	defer tsc.mutStatuses.Unlock()
+
+	tsc.statuses.put(txHash, status, tsc.getTimeHandler().Add(ttl))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsc *txStatusLRUCache) IsInterfaceNil() bool {
+	return tsc == nil
+}
+
+func isTerminalTxStatus(status transaction.TxStatus) bool {
+	switch status {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return true
+	default:
+		return false
+	}
+}
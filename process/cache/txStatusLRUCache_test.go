@@ -0,0 +1,144 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTxStatusLRUCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, err := cache.NewTxStatusLRUCache(0, time.Second, time.Minute)
+		assert.Nil(t, tsc)
+		assert.Equal(t, cache.ErrInvalidTxStatusCacheSize, err)
+	})
+	t.Run("invalid terminal TTL should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, err := cache.NewTxStatusLRUCache(10, time.Second, 0)
+		assert.Nil(t, tsc)
+		assert.Equal(t, cache.ErrInvalidTxStatusCacheTerminalTTL, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, err := cache.NewTxStatusLRUCache(10, time.Second, time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, tsc.IsInterfaceNil())
+	})
+}
+
+func TestTxStatusLRUCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("terminal statuses should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := cache.NewTxStatusLRUCache(10, time.Nanosecond, time.Minute)
+		tsc.Put("hash0", transaction.TxStatusSuccess)
+		tsc.Put("hash1", transaction.TxStatusFail)
+		tsc.Put("hash2", transaction.TxStatusInvalid)
+		time.Sleep(time.Millisecond)
+
+		status, found := tsc.Get("hash0")
+		assert.True(t, found)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+		status, found = tsc.Get("hash1")
+		assert.True(t, found)
+		assert.Equal(t, transaction.TxStatusFail, status)
+		status, found = tsc.Get("hash2")
+		assert.True(t, found)
+		assert.Equal(t, transaction.TxStatusInvalid, status)
+	})
+	t.Run("pending status should expire", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := cache.NewTxStatusLRUCache(10, 50*time.Millisecond, time.Minute)
+		tsc.Put("hash0", transaction.TxStatusPending)
+
+		status, found := tsc.Get("hash0")
+		require.True(t, found)
+		assert.Equal(t, transaction.TxStatusPending, status)
+
+		time.Sleep(60 * time.Millisecond)
+		_, found = tsc.Get("hash0")
+		assert.False(t, found)
+	})
+	t.Run("pending status should be replaced by the terminal one", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := cache.NewTxStatusLRUCache(10, 50*time.Millisecond, time.Minute)
+		tsc.Put("hash0", transaction.TxStatusPending)
+		tsc.Put("hash0", transaction.TxStatusSuccess)
+		time.Sleep(60 * time.Millisecond)
+
+		status, found := tsc.Get("hash0")
+		assert.True(t, found)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+	})
+	t.Run("pending status should not be cached without TTL", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := cache.NewTxStatusLRUCache(10, 0, time.Minute)
+		tsc.Put("hash0", transaction.TxStatusPending)
+
+		_, found := tsc.Get("hash0")
+		assert.False(t, found)
+	})
+	t.Run("terminal status should expire", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		tsc, _ := cache.NewTxStatusLRUCache(10, time.Second, time.Minute)
+		tsc.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+		tsc.Put("hash0", transaction.TxStatusSuccess)
+
+		now = now.Add(59 * time.Second)
+		status, found := tsc.Get("hash0")
+		require.True(t, found)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+
+		now = now.Add(time.Second)
+		_, found = tsc.Get("hash0")
+		assert.False(t, found)
+	})
+	t.Run("unknown status should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := cache.NewTxStatusLRUCache(10, time.Hour, time.Hour)
+		tsc.Put("hash0", "unknown")
+
+		_, found := tsc.Get("hash0")
+		assert.False(t, found)
+	})
+}
+
+func TestTxStatusLRUCache_ShouldEvictLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	tsc, _ := cache.NewTxStatusLRUCache(2, time.Hour, time.Hour)
+	tsc.Put("hash0", transaction.TxStatusSuccess)
+	tsc.Put("hash1", transaction.TxStatusSuccess)
+
+	_, found := tsc.Get("hash0")
+	require.True(t, found)
+
+	tsc.Put("hash2", transaction.TxStatusSuccess)
+
+	_, found = tsc.Get("hash1")
+	assert.False(t, found)
+	_, found = tsc.Get("hash0")
+	assert.True(t, found)
+	_, found = tsc.Get("hash2")
+	assert.True(t, found)
+}
@@ -0,0 +1,17 @@
+package process
+
+import "time"
+
+// computeFirstCacheUpdateDelay returns the delay of the first update of a cache warm-loaded from a snapshot saved at
+// the given time. A fresh snapshot, younger than the cache validity duration, is only refreshed once it expires, while
+// a stale one is served until refreshed right away
+func computeFirstCacheUpdateDelay(cacheName string, savedAt time.Time, cacheValidityDuration time.Duration) time.Duration {
+	age := time.Since(savedAt)
+	if age >= cacheValidityDuration {
+		log.Info("cache warm-loaded from a stale snapshot", "cache", cacheName, "age", age)
+		return 0
+	}
+
+	log.Info("cache warm-loaded from a fresh snapshot", "cache", cacheName, "age", age)
+	return cacheValidityDuration - age
+}
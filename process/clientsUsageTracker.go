@@ -0,0 +1,355 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+const (
+	clientsUsageStorageKeyPrefix = "clients-usage/"
+	clientsUsageBucketDuration   = time.Hour
+
+	// the API keys are sent by the clients, so their length and the number of distinct keys tracked between two flushes
+	// are bounded, to protect the memory and the storage from the requests holding random keys
+	maxClientApiKeyLength      = 128
+	maxPendingClientsUsageKeys = 10000
+)
+
+type clientUsageBucketKey struct {
+	apiKey    string
+	timestamp int64
+}
+
+// ArgsClientsUsageTracker holds the arguments needed to create a ClientsUsageTracker
+type ArgsClientsUsageTracker struct {
+	Storer        Storer
+	FlushInterval time.Duration
+	Retention     time.Duration
+}
+
+// ClientsUsageTracker aggregates the requests made by each client, identified by its API key, in hourly buckets holding
+// the number of requests, of errors and the bandwidth. The buckets are kept in memory and added to the ones in the
+// provided storage at each flush, so that the usage is kept across restarts and summed over the proxies sharing the storage
+type ClientsUsageTracker struct {
+	storer             Storer
+	flushInterval      time.Duration
+	retention          time.Duration
+	pending            map[clientUsageBucketKey]*data.ClientUsageBucket
+	mutPending         sync.Mutex
+	mutFlush           sync.Mutex
+	lastPruneTimestamp int64
+	getTimeHandler     func() time.Time
+	cancelFunc         func()
+}
+
+// NewClientsUsageTracker creates a new instance of ClientsUsageTracker and starts flushing the usage periodically
+func NewClientsUsageTracker(args ArgsClientsUsageTracker) (*ClientsUsageTracker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if args.FlushInterval <= 0 {
+		return nil, ErrInvalidClientsUsageFlushInterval
+	}
+	if args.Retention <= 0 {
+		return nil, ErrInvalidClientsUsageRetention
+	}
+
+	cut := &ClientsUsageTracker{
+		storer:         args.Storer,
+		flushInterval:  args.FlushInterval,
+		retention:      args.Retention,
+		pending:        make(map[clientUsageBucketKey]*data.ClientUsageBucket),
+		getTimeHandler: time.Now,
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	cut.cancelFunc = cancelFunc
+	go cut.flushPeriodically(ctx)
+
+	return cut, nil
+}
+
+// AddRequest adds a request of the client to the usage of the current hour. The requests without an API key are ignored
+func (cut *ClientsUsageTracker) AddRequest(apiKey string, withError bool, bytesReceived uint64, bytesSent uint64) {
+	if len(apiKey) == 0 || len(apiKey) > maxClientApiKeyLength {
+		return
+	}
+
+	key := clientUsageBucketKey{
+		apiKey:    apiKey,
+		timestamp: cut.getTimeHandler().Truncate(clientsUsageBucketDuration).Unix(),
+	}
+
+	cut.mutPending.Lock()
+	defer cut.mutPending.Unlock()
+
+	bucket, found := cut.pending[key]
+	if !found {
+		if len(cut.pending) >= maxPendingClientsUsageKeys {
+			log.Debug("clients usage: too many clients tracked since the last flush, request not counted")
+			return
+		}
+
+		bucket = &data.ClientUsageBucket{Timestamp: key.timestamp}
+		cut.pending[key] = bucket
+	}
+
+	bucket.Requests++
+	if withError {
+		bucket.Errors++
+	}
+	bucket.BytesReceived += bytesReceived
+	bucket.BytesSent += bytesSent
+}
+
+// GetUsage returns the usage of the client during the hours overlapping the provided interval, including the one not
+// flushed yet
+func (cut *ClientsUsageTracker) GetUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error) {
+	if len(apiKey) == 0 {
+		return nil, ErrEmptyClientApiKey
+	}
+	if to.Before(from) {
+		return nil, ErrInvalidClientsUsageInterval
+	}
+
+	// a flush in progress holds the usage taken out of the pending buckets and not yet stored
+	cut.mutFlush.Lock()
+	defer cut.mutFlush.Unlock()
+
+	buckets, err := cut.getStoredBuckets(apiKey, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	cut.mutPending.Lock()
+	for key, pendingBucket := range cut.pending {
+		if key.apiKey != apiKey || !isClientUsageBucketInInterval(key.timestamp, from, to) {
+			continue
+		}
+
+		bucket, found := buckets[key.timestamp]
+		if !found {
+			bucket = &data.ClientUsageBucket{Timestamp: key.timestamp}
+			buckets[key.timestamp] = bucket
+		}
+		addClientUsage(bucket, pendingBucket)
+	}
+	cut.mutPending.Unlock()
+
+	return createClientUsageReport(apiKey, from, to, buckets), nil
+}
+
+func (cut *ClientsUsageTracker) getStoredBuckets(apiKey string, from time.Time, to time.Time) (map[int64]*data.ClientUsageBucket, error) {
+	keys, err := cut.storer.Keys(getClientUsageStorageKeyPrefix(apiKey))
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make(map[int64]*data.ClientUsageBucket)
+	for _, key := range keys {
+		timestamp, ok := getClientUsageBucketTimestamp(key)
+		if !ok || !isClientUsageBucketInInterval(timestamp, from, to) {
+			continue
+		}
+
+		bucket, errGet := cut.getStoredBucket(key)
+		if errors.Is(errGet, storage.ErrKeyNotFound) {
+			// pruned meanwhile
+			continue
+		}
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		bucket.Timestamp = timestamp
+		buckets[timestamp] = bucket
+	}
+
+	return buckets, nil
+}
+
+func (cut *ClientsUsageTracker) getStoredBucket(key string) (*data.ClientUsageBucket, error) {
+	bucketBytes, err := cut.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := &data.ClientUsageBucket{}
+	err = json.Unmarshal(bucketBytes, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+func (cut *ClientsUsageTracker) flushPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(cut.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cut.flush()
+		}
+	}
+}
+
+// flush adds the pending usage to the stored one and removes the buckets older than the retention duration. The usage
+// which could not be stored is kept pending until the next flush
+func (cut *ClientsUsageTracker) flush() {
+	cut.mutFlush.Lock()
+	defer cut.mutFlush.Unlock()
+
+	cut.mutPending.Lock()
+	pending := cut.pending
+	cut.pending = make(map[clientUsageBucketKey]*data.ClientUsageBucket)
+	cut.mutPending.Unlock()
+
+	for key, bucket := range pending {
+		err := cut.addToStoredBucket(key, bucket)
+		if err != nil {
+			log.Warn("clients usage: cannot save", "timestamp", key.timestamp, "error", err.Error())
+			cut.restorePending(key, bucket)
+		}
+	}
+
+	cut.pruneExpiredBuckets()
+}
+
+func (cut *ClientsUsageTracker) addToStoredBucket(key clientUsageBucketKey, bucket *data.ClientUsageBucket) error {
+	storageKey := getClientUsageStorageKeyPrefix(key.apiKey) + strconv.FormatInt(key.timestamp, 10)
+	storedBucket, err := cut.getStoredBucket(storageKey)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		storedBucket, err = &data.ClientUsageBucket{Timestamp: key.timestamp}, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	addClientUsage(storedBucket, bucket)
+	bucketBytes, err := json.Marshal(storedBucket)
+	if err != nil {
+		return err
+	}
+
+	return cut.storer.Put(storageKey, bucketBytes)
+}
+
+func (cut *ClientsUsageTracker) restorePending(key clientUsageBucketKey, bucket *data.ClientUsageBucket) {
+	cut.mutPending.Lock()
+	defer cut.mutPending.Unlock()
+
+	pendingBucket, found := cut.pending[key]
+	if !found {
+		cut.pending[key] = bucket
+		return
+	}
+
+	addClientUsage(pendingBucket, bucket)
+}
+
+// pruneExpiredBuckets removes the stored buckets older than the retention duration. The storage is only scanned once
+// per hour, as a bucket expires at most once per hour
+func (cut *ClientsUsageTracker) pruneExpiredBuckets() {
+	now := cut.getTimeHandler()
+	currentTimestamp := now.Truncate(clientsUsageBucketDuration).Unix()
+	if currentTimestamp == cut.lastPruneTimestamp {
+		return
+	}
+
+	keys, err := cut.storer.Keys(clientsUsageStorageKeyPrefix)
+	if err != nil {
+		log.Warn("clients usage: cannot list the stored usage", "error", err.Error())
+		return
+	}
+
+	oldestTimestamp := now.Add(-cut.retention).Unix()
+	for _, key := range keys {
+		timestamp, ok := getClientUsageBucketTimestamp(key)
+		if !ok || timestamp >= oldestTimestamp {
+			continue
+		}
+
+		err = cut.storer.Remove(key)
+		if err != nil {
+			log.Warn("clients usage: cannot remove the expired usage", "timestamp", timestamp, "error", err.Error())
+			return
+		}
+	}
+
+	cut.lastPruneTimestamp = currentTimestamp
+}
+
+func getClientUsageStorageKeyPrefix(apiKey string) string {
+	return clientsUsageStorageKeyPrefix + hex.EncodeToString([]byte(apiKey)) + "/"
+}
+
+func getClientUsageBucketTimestamp(storageKey string) (int64, bool) {
+	separatorIndex := strings.LastIndex(storageKey, "/")
+	timestamp, err := strconv.ParseInt(storageKey[separatorIndex+1:], 10, 64)
+
+	return timestamp, err == nil
+}
+
+// isClientUsageBucketInInterval returns true if the hour starting at the provided timestamp overlaps the interval
+func isClientUsageBucketInInterval(timestamp int64, from time.Time, to time.Time) bool {
+	return timestamp+int64(clientsUsageBucketDuration/time.Second) > from.Unix() && timestamp <= to.Unix()
+}
+
+func addClientUsage(destination *data.ClientUsageBucket, source *data.ClientUsageBucket) {
+	destination.Requests += source.Requests
+	destination.Errors += source.Errors
+	destination.BytesReceived += source.BytesReceived
+	destination.BytesSent += source.BytesSent
+}
+
+func createClientUsageReport(apiKey string, from time.Time, to time.Time, buckets map[int64]*data.ClientUsageBucket) *data.ClientUsageReport {
+	report := &data.ClientUsageReport{
+		ApiKey: apiKey,
+		From:   from.Unix(),
+		To:     to.Unix(),
+		Hourly: make([]*data.ClientUsageBucket, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		report.Requests += bucket.Requests
+		report.Errors += bucket.Errors
+		report.BytesReceived += bucket.BytesReceived
+		report.BytesSent += bucket.BytesSent
+		report.Hourly = append(report.Hourly, bucket)
+	}
+	sort.Slice(report.Hourly, func(i, j int) bool {
+		return report.Hourly[i].Timestamp < report.Hourly[j].Timestamp
+	})
+	if report.Requests > 0 {
+		report.ErrorRate = float64(report.Errors) / float64(report.Requests)
+	}
+
+	return report
+}
+
+// Close stops the periodic flush and flushes the pending usage. Must be called before closing the storage
+func (cut *ClientsUsageTracker) Close() error {
+	cut.cancelFunc()
+	cut.flush()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cut *ClientsUsageTracker) IsInterfaceNil() bool {
+	return cut == nil
+}
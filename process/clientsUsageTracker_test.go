@@ -0,0 +1,230 @@
+package process_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type failingPutStorer struct {
+	storage.Storer
+	shouldFail bool
+	mut        sync.Mutex
+}
+
+func (fps *failingPutStorer) Put(key string, value []byte) error {
+	fps.mut.Lock()
+	shouldFail := fps.shouldFail
+	fps.mut.Unlock()
+	if shouldFail {
+		return errors.New("storage down")
+	}
+
+	return fps.Storer.Put(key, value)
+}
+
+func createArgsClientsUsageTracker() process.ArgsClientsUsageTracker {
+	return process.ArgsClientsUsageTracker{
+		Storer:        storage.NewMemoryStorer(),
+		FlushInterval: time.Hour,
+		Retention:     48 * time.Hour,
+	}
+}
+
+func TestNewClientsUsageTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsClientsUsageTracker()
+		args.Storer = nil
+		cut, err := process.NewClientsUsageTracker(args)
+		require.Nil(t, cut)
+		require.Equal(t, process.ErrNilStorer, err)
+	})
+	t.Run("invalid flush interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsClientsUsageTracker()
+		args.FlushInterval = 0
+		cut, err := process.NewClientsUsageTracker(args)
+		require.Nil(t, cut)
+		require.Equal(t, process.ErrInvalidClientsUsageFlushInterval, err)
+	})
+	t.Run("invalid retention should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsClientsUsageTracker()
+		args.Retention = 0
+		cut, err := process.NewClientsUsageTracker(args)
+		require.Nil(t, cut)
+		require.Equal(t, process.ErrInvalidClientsUsageRetention, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cut, err := process.NewClientsUsageTracker(createArgsClientsUsageTracker())
+		require.NoError(t, err)
+		require.False(t, cut.IsInterfaceNil())
+		require.NoError(t, cut.Close())
+	})
+}
+
+func TestClientsUsageTracker_GetUsage(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Unix(1700000000, 0).Truncate(time.Hour)
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		cut, _ := process.NewClientsUsageTracker(createArgsClientsUsageTracker())
+		defer func() {
+			_ = cut.Close()
+		}()
+
+		report, err := cut.GetUsage("", startTime, startTime)
+		require.Nil(t, report)
+		require.Equal(t, process.ErrEmptyClientApiKey, err)
+
+		report, err = cut.GetUsage("client", startTime, startTime.Add(-time.Second))
+		require.Nil(t, report)
+		require.Equal(t, process.ErrInvalidClientsUsageInterval, err)
+	})
+	t.Run("stored and pending usage should be summed per hour", func(t *testing.T) {
+		t.Parallel()
+
+		now := startTime.Add(10 * time.Minute)
+		cut, _ := process.NewClientsUsageTracker(createArgsClientsUsageTracker())
+		defer func() {
+			_ = cut.Close()
+		}()
+		cut.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		cut.AddRequest("client", false, 100, 1000)
+		cut.AddRequest("client", true, 10, 20)
+		cut.AddRequest("other", false, 1, 1)
+		cut.AddRequest("", false, 1, 1)
+		cut.Flush()
+		cut.AddRequest("client", false, 100, 1000)
+
+		now = now.Add(time.Hour)
+		cut.AddRequest("client", false, 50, 500)
+
+		report, err := cut.GetUsage("client", startTime.Add(30*time.Minute), now)
+		require.NoError(t, err)
+		require.Equal(t, &data.ClientUsageReport{
+			ApiKey:        "client",
+			From:          startTime.Add(30 * time.Minute).Unix(),
+			To:            now.Unix(),
+			Requests:      4,
+			Errors:        1,
+			ErrorRate:     0.25,
+			BytesReceived: 260,
+			BytesSent:     2520,
+			Hourly: []*data.ClientUsageBucket{
+				{Timestamp: startTime.Unix(), Requests: 3, Errors: 1, BytesReceived: 210, BytesSent: 2020},
+				{Timestamp: startTime.Add(time.Hour).Unix(), Requests: 1, BytesReceived: 50, BytesSent: 500},
+			},
+		}, report)
+
+		report, _ = cut.GetUsage("client", startTime.Add(time.Hour), now)
+		require.Equal(t, uint64(1), report.Requests)
+
+		report, _ = cut.GetUsage("unknown", startTime, now)
+		require.Equal(t, uint64(0), report.Requests)
+		require.Empty(t, report.Hourly)
+	})
+	t.Run("usage should be kept across restarts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsClientsUsageTracker()
+		cut, _ := process.NewClientsUsageTracker(args)
+		cut.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+		cut.AddRequest("client", false, 1, 2)
+		require.NoError(t, cut.Close())
+
+		cut, _ = process.NewClientsUsageTracker(args)
+		defer func() {
+			_ = cut.Close()
+		}()
+		cut.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+		cut.AddRequest("client", false, 1, 2)
+		cut.Flush()
+
+		report, err := cut.GetUsage("client", startTime, startTime)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), report.Requests)
+		require.Equal(t, uint64(4), report.BytesSent)
+	})
+	t.Run("usage not saved should be kept pending", func(t *testing.T) {
+		t.Parallel()
+
+		storer := &failingPutStorer{Storer: storage.NewMemoryStorer(), shouldFail: true}
+		args := createArgsClientsUsageTracker()
+		args.Storer = storer
+		cut, _ := process.NewClientsUsageTracker(args)
+		defer func() {
+			_ = cut.Close()
+		}()
+		cut.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+
+		cut.AddRequest("client", false, 1, 2)
+		cut.Flush()
+		report, _ := cut.GetUsage("client", startTime, startTime)
+		require.Equal(t, uint64(1), report.Requests)
+
+		storer.mut.Lock()
+		storer.shouldFail = false
+		storer.mut.Unlock()
+		cut.Flush()
+
+		keys, _ := storer.Keys("")
+		require.Len(t, keys, 1)
+		report, _ = cut.GetUsage("client", startTime, startTime)
+		require.Equal(t, uint64(1), report.Requests)
+	})
+	t.Run("expired usage should be removed", func(t *testing.T) {
+		t.Parallel()
+
+		now := startTime
+		cut, _ := process.NewClientsUsageTracker(createArgsClientsUsageTracker())
+		defer func() {
+			_ = cut.Close()
+		}()
+		cut.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		cut.AddRequest("client", false, 1, 2)
+		cut.Flush()
+		now = now.Add(24 * time.Hour)
+		cut.AddRequest("client", false, 1, 2)
+		cut.Flush()
+
+		report, _ := cut.GetUsage("client", startTime, now)
+		require.Equal(t, uint64(2), report.Requests)
+
+		now = now.Add(25 * time.Hour)
+		cut.Flush()
+
+		report, _ = cut.GetUsage("client", startTime, now)
+		require.Equal(t, uint64(1), report.Requests)
+		require.Equal(t, now.Add(-25*time.Hour).Unix(), report.Hourly[0].Timestamp)
+	})
+}
@@ -0,0 +1,466 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	tokenPropertiesFunc = "getTokenProperties"
+	specialRolesFunc    = "getSpecialRoles"
+
+	fungibleESDTType         = "FungibleESDT"
+	numDecimalsProperty      = "NumDecimals"
+	tokenPropertySeparator   = "-"
+	specialRolesSeparator    = ":"
+	specialRolesListSep      = ","
+	minTokenPropertiesLength = 5
+
+	defaultCollectionsPageSize    = 25
+	maxCollectionsPageSize        = 100
+	maxParallelCollectionsLookups = 8
+)
+
+// CollectionsProcessor resolves the NFT, SFT and MetaESDT collections by combining the metachain and the shards data
+type CollectionsProcessor struct {
+	proc              Processor
+	scQueryProc       SCQueryService
+	pubKeyConverter   core.PubkeyConverter
+	esdtMetadataCache ESDTMetadataCache
+}
+
+// NewCollectionsProcessor creates a new instance of CollectionsProcessor
+func NewCollectionsProcessor(
+	proc Processor,
+	scQueryProc SCQueryService,
+	pubKeyConverter core.PubkeyConverter,
+	esdtMetadataCache ESDTMetadataCache,
+) (*CollectionsProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(esdtMetadataCache) {
+		return nil, ErrNilESDTMetadataCache
+	}
+
+	return &CollectionsProcessor{
+		proc:              proc,
+		scQueryProc:       scQueryProc,
+		pubKeyConverter:   pubKeyConverter,
+		esdtMetadataCache: esdtMetadataCache,
+	}, nil
+}
+
+// GetCollection returns the properties, the roles and the number of issued NFTs of the provided collection
+func (cp *CollectionsProcessor) GetCollection(ctx context.Context, collection string) (*data.Collection, error) {
+	result, err := cp.getCollectionProperties(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Roles, err = cp.getCollectionRoles(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	result.NumIssuedNFTs, err = cp.getNumIssuedNFTs(ctx, collection, result.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCollectionsForAddress returns a page of the collections registered by the address or on which the address has
+// roles. The page is taken from the sorted tokens of the address, the fungible ones being skipped afterwards
+func (cp *CollectionsProcessor) GetCollectionsForAddress(ctx context.Context, address string, options common.CollectionsQueryOptions) ([]*data.Collection, error) {
+	_, err := cp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+	size := options.Size
+	if size == 0 {
+		size = defaultCollectionsPageSize
+	}
+	if size > maxCollectionsPageSize {
+		return nil, fmt.Errorf("%w: the page size must not exceed %d", ErrInvalidPageSize, maxCollectionsPageSize)
+	}
+
+	tokens, err := cp.getTokensOfAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.getCollections(ctx, getTokensPage(tokens, options.From, size))
+}
+
+// getCollections looks up the collections in parallel, keeping their order
+func (cp *CollectionsProcessor) getCollections(ctx context.Context, tokens []string) ([]*data.Collection, error) {
+	results := make([]*data.Collection, len(tokens))
+	errs := make([]error, len(tokens))
+	throttler := make(chan struct{}, maxParallelCollectionsLookups)
+	wg := sync.WaitGroup{}
+	wg.Add(len(tokens))
+	for idx, token := range tokens {
+		throttler <- struct{}{}
+		go func(idx int, token string) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			results[idx], errs[idx] = cp.GetCollection(ctx, token)
+		}(idx, token)
+	}
+	wg.Wait()
+
+	collections := make([]*data.Collection, 0, len(results))
+	for idx, collection := range results {
+		if errors.Is(errs[idx], ErrNotACollection) {
+			continue
+		}
+		if errs[idx] != nil {
+			return nil, errs[idx]
+		}
+
+		collections = append(collections, collection)
+	}
+
+	return collections, nil
+}
+
+// GetTokensIssuedByAddress returns the tokens and collections owned by the address, along with the roles it holds on
+// each of them. As the metachain does not index the fungible tokens by owner, the candidates are the collections
+// registered by the address and the tokens on which it has roles
+func (cp *CollectionsProcessor) GetTokensIssuedByAddress(ctx context.Context, address string) ([]*data.IssuedToken, error) {
+	_, err := cp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	tokens, roles, err := cp.getTokensAndRolesOfAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	issuedTokens := make([]*data.IssuedToken, 0, len(tokens))
+	for _, token := range tokens {
+		properties, errGet := cp.getTokenProperties(ctx, token)
+		if errors.Is(errGet, ErrNotACollection) {
+			continue
+		}
+		if errGet != nil {
+			return nil, errGet
+		}
+		if properties.Owner != address {
+			continue
+		}
+
+		issuedTokens = append(issuedTokens, &data.IssuedToken{
+			Identifier: properties.Identifier,
+			Name:       properties.Name,
+			Type:       properties.Type,
+			Decimals:   properties.Decimals,
+			Properties: properties.Properties,
+			Roles:      roles[token],
+		})
+	}
+
+	return issuedTokens, nil
+}
+
+func (cp *CollectionsProcessor) getTokensOfAddress(ctx context.Context, address string) ([]string, error) {
+	tokens, _, err := cp.getTokensAndRolesOfAddress(ctx, address)
+
+	return tokens, err
+}
+
+func (cp *CollectionsProcessor) getTokensAndRolesOfAddress(ctx context.Context, address string) ([]string, map[string][]string, error) {
+	registeredNFTs := data.RegisteredNFTsResponse{}
+	err := cp.getFromMetachain(ctx, addressPath+address+"/registered-nfts", &registeredNFTs, &registeredNFTs.Error)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := data.ESDTRolesResponse{}
+	err = cp.getFromMetachain(ctx, addressPath+address+"/esdts/roles", &roles, &roles.Error)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uniqueTokens := make(map[string]struct{})
+	for _, token := range registeredNFTs.Data.Tokens {
+		uniqueTokens[token] = struct{}{}
+	}
+	for token := range roles.Data.Roles {
+		uniqueTokens[token] = struct{}{}
+	}
+
+	tokens := make([]string, 0, len(uniqueTokens))
+	for token := range uniqueTokens {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	return tokens, roles.Data.Roles, nil
+}
+
+func (cp *CollectionsProcessor) getFromMetachain(ctx context.Context, path string, response interface{}, responseError *string) error {
+	observers, err := cp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
+	if err != nil {
+		return err
+	}
+
+	for _, observer := range observers {
+		respCode, errGet := cp.proc.CallGetRestEndPoint(ctx, observer.Address, path, response)
+		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			log.WithContext(ctx).Info("collections request", "path", path, "observer", observer.Address, "http code", respCode)
+			if *responseError != "" {
+				return errors.New(*responseError)
+			}
+
+			return nil
+		}
+
+		log.WithContext(ctx).Error("collections request", "path", path, "observer", observer.Address, "error", errGet.Error())
+	}
+
+	return WrapObserversError(*responseError)
+}
+
+func (cp *CollectionsProcessor) getCollectionProperties(ctx context.Context, collection string) (*data.Collection, error) {
+	result, err := cp.getTokenProperties(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	if result.Type == fungibleESDTType {
+		return nil, fmt.Errorf("%w: %s", ErrNotACollection, collection)
+	}
+
+	return result, nil
+}
+
+func (cp *CollectionsProcessor) getTokenProperties(ctx context.Context, token string) (*data.Collection, error) {
+	properties, found := cp.esdtMetadataCache.GetTokenProperties(token)
+	if found {
+		return properties, nil
+	}
+
+	properties, err := cp.fetchTokenProperties(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	cp.esdtMetadataCache.PutTokenProperties(token, properties)
+
+	return properties, nil
+}
+
+func (cp *CollectionsProcessor) fetchTokenProperties(ctx context.Context, token string) (*data.Collection, error) {
+	returnData, err := cp.queryESDTSystemSC(ctx, tokenPropertiesFunc, token)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) < minTokenPropertiesLength {
+		return nil, fmt.Errorf("%w: unexpected token properties for %s", ErrNotACollection, token)
+	}
+
+	result := &data.Collection{
+		Identifier: token,
+		Name:       string(returnData[0]),
+		Type:       string(returnData[1]),
+		Owner:      cp.encodeOwner(returnData[2]),
+		Properties: make(map[string]bool),
+	}
+
+	// the properties following the name, type, owner, minted and burnt values are of form Name-value
+	for _, property := range returnData[minTokenPropertiesLength:] {
+		name, value, found := strings.Cut(string(property), tokenPropertySeparator)
+		if !found {
+			continue
+		}
+
+		if name == numDecimalsProperty {
+			decimals, errParse := strconv.ParseUint(value, 10, 32)
+			if errParse == nil {
+				result.Decimals = uint32(decimals)
+			}
+			continue
+		}
+
+		// only the flags are kept, the numeric properties like NumWiped are skipped
+		if value != "true" && value != "false" {
+			continue
+		}
+		result.Properties[lowerFirst(name)] = value == "true"
+	}
+
+	return result, nil
+}
+
+func (cp *CollectionsProcessor) encodeOwner(owner []byte) string {
+	// older nodes return the owner as raw bytes, newer ones as an already encoded address
+	if len(owner) == cp.pubKeyConverter.Len() {
+		return cp.pubKeyConverter.SilentEncode(owner, log)
+	}
+
+	return string(owner)
+}
+
+func (cp *CollectionsProcessor) getCollectionRoles(ctx context.Context, collection string) (map[string][]string, error) {
+	roles, found := cp.esdtMetadataCache.GetTokenRoles(collection)
+	if found {
+		return roles, nil
+	}
+
+	roles, err := cp.fetchCollectionRoles(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	cp.esdtMetadataCache.PutTokenRoles(collection, roles)
+
+	return roles, nil
+}
+
+func (cp *CollectionsProcessor) fetchCollectionRoles(ctx context.Context, collection string) (map[string][]string, error) {
+	returnData, err := cp.queryESDTSystemSC(ctx, specialRolesFunc, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string][]string)
+	for _, addressRoles := range returnData {
+		address, rolesList, found := strings.Cut(string(addressRoles), specialRolesSeparator)
+		if !found || len(rolesList) == 0 {
+			continue
+		}
+
+		roles[address] = strings.Split(rolesList, specialRolesListSep)
+	}
+
+	return roles, nil
+}
+
+func (cp *CollectionsProcessor) getNumIssuedNFTs(ctx context.Context, collection string, roles map[string][]string) (uint64, error) {
+	latestNonceKey := hex.EncodeToString([]byte(core.ProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier + collection))
+
+	// the latest nonce is stored on the account holding the create role, which can be transferred
+	numIssuedNFTs := uint64(0)
+	for address, addressRoles := range roles {
+		if !containsRole(addressRoles, core.ESDTRoleNFTCreate) {
+			continue
+		}
+
+		latestNonce, err := cp.getLatestNonce(ctx, address, latestNonceKey)
+		if err != nil {
+			return 0, err
+		}
+		if latestNonce > numIssuedNFTs {
+			numIssuedNFTs = latestNonce
+		}
+	}
+
+	return numIssuedNFTs, nil
+}
+
+func (cp *CollectionsProcessor) getLatestNonce(ctx context.Context, address string, key string) (uint64, error) {
+	addressBytes, err := cp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return 0, err
+	}
+	shardID, err := cp.proc.ComputeShardId(addressBytes)
+	if err != nil {
+		return 0, err
+	}
+	observers, err := cp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	response := data.AccountKeyValueResponse{}
+	for _, observer := range observers {
+		_, errGet := cp.proc.CallGetRestEndPoint(ctx, observer.Address, addressPath+address+"/key/"+key, &response)
+		if errGet != nil {
+			log.WithContext(ctx).Error("collection latest nonce request", "address", address, "observer", observer.Address, "error", errGet.Error())
+			continue
+		}
+
+		valueBytes, errDecode := hex.DecodeString(response.Data.Value)
+		if errDecode != nil {
+			return 0, errDecode
+		}
+
+		return big.NewInt(0).SetBytes(valueBytes).Uint64(), nil
+	}
+
+	return 0, WrapObserversError(response.Error)
+}
+
+func (cp *CollectionsProcessor) queryESDTSystemSC(ctx context.Context, function string, collection string) ([][]byte, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: encodeSystemAddress(cp.pubKeyConverter, common.ESDTContractAddressHex),
+		FuncName:  function,
+		Arguments: [][]byte{[]byte(collection)},
+	}
+
+	vmOutput, _, err := cp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func getTokensPage(tokens []string, from uint32, size uint32) []string {
+	if uint64(from) >= uint64(len(tokens)) {
+		return nil
+	}
+
+	end := uint64(from) + uint64(size)
+	if end > uint64(len(tokens)) {
+		end = uint64(len(tokens))
+	}
+
+	return tokens[from:end]
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lowerFirst(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *CollectionsProcessor) IsInterfaceNil() bool {
+	return cp == nil
+}
@@ -0,0 +1,302 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	collectionOwner   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	collectionCreator = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+func createCollectionsSCQueryStub() *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			token := string(query.Arguments[0])
+			if token == "MISSING-abcdef" {
+				return nil, data.BlockInfo{}, errors.New("no ticker with given name")
+			}
+
+			if query.FuncName == "getSpecialRoles" {
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{
+						[]byte(collectionCreator + ":ESDTRoleNFTCreate,ESDTRoleNFTBurn"),
+						[]byte(collectionOwner + ":ESDTRoleNFTAddQuantity"),
+					},
+				}, data.BlockInfo{}, nil
+			}
+
+			tokenType := "NonFungibleESDT"
+			if strings.HasPrefix(token, "FUNG") {
+				tokenType = "FungibleESDT"
+			}
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{
+					[]byte("Collection"),
+					[]byte(tokenType),
+					[]byte(collectionOwner),
+					[]byte("0"),
+					[]byte("0"),
+					[]byte("NumDecimals-0"),
+					[]byte("IsPaused-false"),
+					[]byte("CanUpgrade-true"),
+					[]byte("NumWiped-0"),
+				},
+			}, data.BlockInfo{}, nil
+		},
+	}
+}
+
+func createCollectionsProcessorStub(latestNonceValue string) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return 1, nil
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			switch {
+			case strings.HasSuffix(path, "/registered-nfts"):
+				response := value.(*data.RegisteredNFTsResponse)
+				response.Data.Tokens = []string{"COLB-abcdef", "COLA-abcdef"}
+			case strings.HasSuffix(path, "/esdts/roles"):
+				response := value.(*data.ESDTRolesResponse)
+				response.Data.Roles = map[string][]string{
+					"COLA-abcdef": {"ESDTRoleNFTAddQuantity"},
+					"FUNG-abcdef": {"ESDTRoleLocalMint"},
+				}
+			default:
+				expectedKey := hex.EncodeToString([]byte(core.ProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier))
+				if !strings.Contains(path, collectionCreator+"/key/"+expectedKey) {
+					return http.StatusBadRequest, errors.New("unexpected path " + path)
+				}
+				response := value.(*data.AccountKeyValueResponse)
+				response.Data.Value = latestNonceValue
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+}
+
+func TestNewCollectionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := process.NewCollectionsProcessor(nil, &mock.SCQueryServiceStub{}, testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		require.Nil(t, cp)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := process.NewCollectionsProcessor(&mock.ProcessorStub{}, nil, testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		require.Nil(t, cp)
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := process.NewCollectionsProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, nil, &disabled.ESDTMetadataCache{})
+		require.Nil(t, cp)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil ESDT metadata cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := process.NewCollectionsProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, nil)
+		require.Nil(t, cp)
+		require.Equal(t, process.ErrNilESDTMetadataCache, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := process.NewCollectionsProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		require.NoError(t, err)
+		require.False(t, cp.IsInterfaceNil())
+	})
+}
+
+func TestCollectionsProcessor_GetCollection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collection, err := cp.GetCollection(context.Background(), "MISSING-abcdef")
+		require.Nil(t, collection)
+		require.Error(t, err)
+	})
+	t.Run("fungible token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collection, err := cp.GetCollection(context.Background(), "FUNG-abcdef")
+		require.Nil(t, collection)
+		require.True(t, errors.Is(err, process.ErrNotACollection))
+	})
+	t.Run("should combine the metachain and the shard data", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collection, err := cp.GetCollection(context.Background(), "COLA-abcdef")
+		require.NoError(t, err)
+		require.Equal(t, &data.Collection{
+			Identifier: "COLA-abcdef",
+			Name:       "Collection",
+			Type:       "NonFungibleESDT",
+			Owner:      collectionOwner,
+			Decimals:   0,
+			Properties: map[string]bool{
+				"isPaused":   false,
+				"canUpgrade": true,
+			},
+			Roles: map[string][]string{
+				collectionCreator: {"ESDTRoleNFTCreate", "ESDTRoleNFTBurn"},
+				collectionOwner:   {"ESDTRoleNFTAddQuantity"},
+			},
+			NumIssuedNFTs: 42,
+		}, collection)
+	})
+	t.Run("metadata should be served from cache until invalidated", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		scQueryStub := createCollectionsSCQueryStub()
+		executeQuery := scQueryStub.ExecuteQueryCalled
+		scQueryStub.ExecuteQueryCalled = func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			numQueries++
+			return executeQuery(query)
+		}
+		metadataCache, _ := cache.NewESDTMetadataCache(10, time.Hour)
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), scQueryStub, testPubkeyConverter, metadataCache)
+
+		collection, err := cp.GetCollection(context.Background(), "COLA-abcdef")
+		require.NoError(t, err)
+		require.Equal(t, 2, numQueries)
+
+		cachedCollection, err := cp.GetCollection(context.Background(), "COLA-abcdef")
+		require.NoError(t, err)
+		require.Equal(t, collection, cachedCollection)
+		require.Equal(t, 2, numQueries)
+
+		metadataCache.Invalidate("COLA-abcdef")
+		_, err = cp.GetCollection(context.Background(), "COLA-abcdef")
+		require.NoError(t, err)
+		require.Equal(t, 4, numQueries)
+	})
+}
+
+func TestCollectionsProcessor_GetCollectionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collections, err := cp.GetCollectionsForAddress(context.Background(), "invalid", common.CollectionsQueryOptions{})
+		require.Nil(t, collections)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("should return the registered collections and the ones with roles", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub(""), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collections, err := cp.GetCollectionsForAddress(context.Background(), collectionOwner, common.CollectionsQueryOptions{})
+		require.NoError(t, err)
+		require.Len(t, collections, 2)
+		require.Equal(t, "COLA-abcdef", collections[0].Identifier)
+		require.Equal(t, "COLB-abcdef", collections[1].Identifier)
+		require.Zero(t, collections[0].NumIssuedNFTs)
+	})
+	t.Run("page size too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub(""), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collections, err := cp.GetCollectionsForAddress(context.Background(), collectionOwner, common.CollectionsQueryOptions{Size: 101})
+		require.Nil(t, collections)
+		require.True(t, errors.Is(err, process.ErrInvalidPageSize))
+	})
+	t.Run("should return the requested page", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub(""), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		collections, err := cp.GetCollectionsForAddress(context.Background(), collectionOwner, common.CollectionsQueryOptions{From: 1, Size: 1})
+		require.NoError(t, err)
+		require.Len(t, collections, 1)
+		require.Equal(t, "COLB-abcdef", collections[0].Identifier)
+
+		// the third token of the address is a fungible one, so its page holds no collection
+		collections, err = cp.GetCollectionsForAddress(context.Background(), collectionOwner, common.CollectionsQueryOptions{From: 2, Size: 1})
+		require.NoError(t, err)
+		require.Empty(t, collections)
+
+		collections, err = cp.GetCollectionsForAddress(context.Background(), collectionOwner, common.CollectionsQueryOptions{From: 10})
+		require.NoError(t, err)
+		require.Empty(t, collections)
+	})
+}
+
+func TestCollectionsProcessor_GetTokensIssuedByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub("2a"), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		tokens, err := cp.GetTokensIssuedByAddress(context.Background(), "invalid")
+		require.Nil(t, tokens)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("should return the owned collections and fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub(""), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		tokens, err := cp.GetTokensIssuedByAddress(context.Background(), collectionOwner)
+		require.NoError(t, err)
+		require.Len(t, tokens, 3)
+		require.Equal(t, "COLA-abcdef", tokens[0].Identifier)
+		require.Equal(t, []string{"ESDTRoleNFTAddQuantity"}, tokens[0].Roles)
+		require.Equal(t, "COLB-abcdef", tokens[1].Identifier)
+		require.Empty(t, tokens[1].Roles)
+		require.Equal(t, &data.IssuedToken{
+			Identifier: "FUNG-abcdef",
+			Name:       "Collection",
+			Type:       "FungibleESDT",
+			Properties: map[string]bool{
+				"isPaused":   false,
+				"canUpgrade": true,
+			},
+			Roles: []string{"ESDTRoleLocalMint"},
+		}, tokens[2])
+	})
+	t.Run("tokens owned by other addresses should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(createCollectionsProcessorStub(""), createCollectionsSCQueryStub(), testPubkeyConverter, &disabled.ESDTMetadataCache{})
+		tokens, err := cp.GetTokensIssuedByAddress(context.Background(), collectionCreator)
+		require.NoError(t, err)
+		require.Empty(t, tokens)
+	})
+}
@@ -0,0 +1,42 @@
+package process
+
+import (
+	"encoding/binary"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+)
+
+const (
+	// numInitCharactersForScAddress is the number of leading bytes of a contract address holding the zero prefix and the VM type
+	numInitCharactersForScAddress = 10
+	shardIdentifierLen            = 2
+	nonceBytesLen                 = 8
+)
+
+// wasmVMType is the VM type of the contracts deployed on the WASM VM
+var wasmVMType = []byte{5, 0}
+
+// computeContractAddress derives the address of a contract deployed by the provided address with the provided nonce,
+// the same way the protocol does: keccak(deployer | nonce) with the first bytes replaced by the zero prefix followed
+// by the VM type and the last bytes replaced by the deployer's shard identifier
+func computeContractAddress(deployer []byte, nonce uint64) []byte {
+	nonceBytes := make([]byte, nonceBytesLen)
+	binary.LittleEndian.PutUint64(nonceBytes, nonce)
+
+	addressAndNonce := make([]byte, 0, len(deployer)+nonceBytesLen)
+	addressAndNonce = append(addressAndNonce, deployer...)
+	addressAndNonce = append(addressAndNonce, nonceBytes...)
+
+	contractAddress := keccak.NewKeccak().Compute(string(addressAndNonce))
+
+	prefix := make([]byte, numInitCharactersForScAddress)
+	copy(prefix[numInitCharactersForScAddress-len(wasmVMType):], wasmVMType)
+	copy(contractAddress[:numInitCharactersForScAddress], prefix)
+
+	if len(deployer) >= shardIdentifierLen {
+		shardIdentifier := deployer[len(deployer)-shardIdentifierLen:]
+		copy(contractAddress[len(contractAddress)-shardIdentifierLen:], shardIdentifier)
+	}
+
+	return contractAddress
+}
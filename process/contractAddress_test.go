@@ -0,0 +1,27 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	require.NoError(t, err)
+
+	deployer, err := converter.Decode("erd1j0hxzs7dcyxw08c4k2nv9tfcaxmqy8rj59meq505w92064x0h40qcxh3ap")
+	require.NoError(t, err)
+
+	contractAddress, err := converter.Encode(computeContractAddress(deployer, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqhdjjyq8dr7v5yq9tv6v5vt9tfvd00vg7h40q6779zn", contractAddress)
+
+	contractAddress, err = converter.Encode(computeContractAddress(deployer, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqde8eqjywyu6zlxjxuxqfg5kgtmn3setxh40qen8egy", contractAddress)
+}
@@ -0,0 +1,66 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DecodeDataField splits the provided transaction data field into the called function and its arguments, using the
+// same tokenizer as the results parser. Each argument is returned hex encoded along with its interpretations as a
+// number, as an address if it has the length of one and as a text if it is printable
+func (tp *TransactionProcessor) DecodeDataField(dataField string) (*data.DecodedDataField, error) {
+	if len(dataField) == 0 {
+		return nil, ErrEmptyDataField
+	}
+
+	function, encodedArguments := splitDataField(dataField)
+	arguments := make([]*data.DecodedDataArgument, 0, len(encodedArguments))
+	for index, encodedArgument := range encodedArguments {
+		argument, err := hex.DecodeString(encodedArgument)
+		if err != nil {
+			return nil, fmt.Errorf("%w: argument %d: %s", ErrInvalidDataFieldArgument, index, err.Error())
+		}
+
+		arguments = append(arguments, tp.decodeDataArgument(argument))
+	}
+
+	return &data.DecodedDataField{
+		Function:  function,
+		Arguments: arguments,
+	}, nil
+}
+
+func (tp *TransactionProcessor) decodeDataArgument(argument []byte) *data.DecodedDataArgument {
+	decodedArgument := &data.DecodedDataArgument{
+		Hex:     hex.EncodeToString(argument),
+		Decimal: big.NewInt(0).SetBytes(argument).String(),
+	}
+
+	if len(argument) == tp.pubKeyConverter.Len() {
+		decodedArgument.Address = tp.pubKeyConverter.SilentEncode(argument, log)
+	}
+	if isPrintableText(argument) {
+		decodedArgument.Text = string(argument)
+	}
+
+	return decodedArgument
+}
+
+func isPrintableText(buff []byte) bool {
+	if len(buff) == 0 || !utf8.Valid(buff) {
+		return false
+	}
+
+	for _, r := range string(buff) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
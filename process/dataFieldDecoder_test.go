@@ -0,0 +1,65 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionProcessor_DecodeDataField(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.NoError(t, err)
+
+	t.Run("empty data field should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := tp.DecodeDataField("")
+		require.Nil(t, decoded)
+		require.Equal(t, process.ErrEmptyDataField, err)
+	})
+	t.Run("argument not hex encoded should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := tp.DecodeDataField("transfer@01@zz")
+		require.Nil(t, decoded)
+		require.True(t, errors.Is(err, process.ErrInvalidDataFieldArgument))
+		require.Contains(t, err.Error(), "argument 1")
+	})
+	t.Run("function without arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := tp.DecodeDataField("claim")
+		require.NoError(t, err)
+		require.Equal(t, &data.DecodedDataField{
+			Function:  "claim",
+			Arguments: []*data.DecodedDataArgument{},
+		}, decoded)
+	})
+	t.Run("arguments should be interpreted", func(t *testing.T) {
+		t.Parallel()
+
+		addressBytes := make([]byte, testPubkeyConverter.Len())
+		addressBytes[len(addressBytes)-1] = 1
+		dataField := "ESDTTransfer@" + hex.EncodeToString([]byte("TKN-abcdef")) + "@0DE0B6B3A7640000@@" + hex.EncodeToString(addressBytes)
+
+		decoded, err := tp.DecodeDataField(dataField)
+		require.NoError(t, err)
+		require.Equal(t, &data.DecodedDataField{
+			Function: "ESDTTransfer",
+			Arguments: []*data.DecodedDataArgument{
+				{Hex: hex.EncodeToString([]byte("TKN-abcdef")), Decimal: "398067923632362526893414", Text: "TKN-abcdef"},
+				{Hex: "0de0b6b3a7640000", Decimal: "1000000000000000000"},
+				{Hex: "", Decimal: "0"},
+				{Hex: hex.EncodeToString(addressBytes), Decimal: "1", Address: testPubkeyConverter.SilentEncode(addressBytes, nil)},
+			},
+		}, decoded)
+	})
+}
@@ -0,0 +1,201 @@
+package process
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// numObserversForFreshnessCheck represents the number of observers a read is performed against
+const numObserversForFreshnessCheck = 2
+
+type observerRead struct {
+	observer *data.NodeData
+	nonce    uint64
+	response interface{}
+}
+
+// DataFreshnessProcessor performs the account and network status reads against two observers of the shard and
+// returns the freshest response, along with an indicator derived from the nonce delta between the observers
+type DataFreshnessProcessor struct {
+	proc          Processor
+	enabled       bool
+	maxNonceDelta uint64
+}
+
+// NewDataFreshnessProcessor creates a new instance of DataFreshnessProcessor
+func NewDataFreshnessProcessor(proc Processor, enabled bool, maxNonceDelta uint64) (*DataFreshnessProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+
+	return &DataFreshnessProcessor{
+		proc:          proc,
+		enabled:       enabled,
+		maxNonceDelta: maxNonceDelta,
+	}, nil
+}
+
+// IsEnabled returns true if the reads should be checked for freshness
+func (dfp *DataFreshnessProcessor) IsEnabled() bool {
+	return dfp.enabled
+}
+
+// GetAccountFromObservers returns the account fetched from the most up-to-date of the first observers able to answer,
+// taken in the provided order, along with the observer it was fetched from
+func (dfp *DataFreshnessProcessor) GetAccountFromObservers(ctx context.Context, observers []*data.NodeData, apiPath string) (*data.AccountModel, *data.NodeData, error) {
+	reads, lastResponseError := dfp.readFromObservers(observers, func(observer *data.NodeData) (*observerRead, string, error) {
+		response := &data.AccountApiResponse{}
+		_, errCall := dfp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, response)
+		if errCall != nil {
+			return nil, response.Error, errCall
+		}
+
+		return &observerRead{
+			observer: observer,
+			nonce:    response.Data.BlockInfo.Nonce,
+			response: &response.Data,
+		}, "", nil
+	})
+	if len(reads) == 0 {
+		return nil, nil, WrapObserversError(lastResponseError)
+	}
+
+	freshestRead, freshness := dfp.computeFreshness(reads)
+	account := freshestRead.response.(*data.AccountModel)
+	account.DataFreshness = freshness
+
+	return account, freshestRead.observer, nil
+}
+
+// GetNetworkStatusMetrics returns the network status metrics fetched from the most up-to-date of the compared observers
+func (dfp *DataFreshnessProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	observers, err := dfp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	reads, lastResponseError := dfp.readFromObservers(observers, func(observer *data.NodeData) (*observerRead, string, error) {
+		response := &data.GenericAPIResponse{}
+		_, errCall := dfp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, response)
+		if errCall != nil {
+			return nil, response.Error, errCall
+		}
+
+		nonceMetric, ok := getMetric(response.Data, MetricNonce)
+		if !ok {
+			return nil, "", ErrCannotParseNodeStatusMetrics
+		}
+
+		return &observerRead{
+			observer: observer,
+			nonce:    getUint(nonceMetric),
+			response: response,
+		}, "", nil
+	})
+	if len(reads) == 0 {
+		return nil, WrapObserversError(lastResponseError)
+	}
+
+	freshestRead, freshness := dfp.computeFreshness(reads)
+	response := freshestRead.response.(*data.GenericAPIResponse)
+	responseData, ok := response.Data.(map[string]interface{})
+	if ok {
+		responseData["dataFreshness"] = freshness
+	}
+
+	log.WithContext(ctx).Info("network metrics request with freshness check",
+		"shard ID", shardID,
+		"observer", freshestRead.observer.Address,
+		"freshness", freshness.Status)
+
+	return response, nil
+}
+
+// readFromObservers performs the read against the first observers in parallel and, if some of them fail, continues
+// with the next ones until enough successful reads are gathered or there are no more observers to try
+func (dfp *DataFreshnessProcessor) readFromObservers(
+	observers []*data.NodeData,
+	read func(observer *data.NodeData) (*observerRead, string, error),
+) ([]*observerRead, string) {
+	reads := make([]*observerRead, 0, numObserversForFreshnessCheck)
+	lastResponseError := ""
+	mutReads := sync.Mutex{}
+
+	for len(observers) > 0 && len(reads) < numObserversForFreshnessCheck {
+		numToRead := numObserversForFreshnessCheck - len(reads)
+		if numToRead > len(observers) {
+			numToRead = len(observers)
+		}
+
+		wg := sync.WaitGroup{}
+		wg.Add(numToRead)
+		for _, observer := range observers[:numToRead] {
+			go func(observer *data.NodeData) {
+				defer wg.Done()
+
+				result, responseError, err := read(observer)
+
+				mutReads.Lock()
+				defer mutReads.Unlock()
+
+				if err != nil {
+					log.Error("read with freshness check", "observer", observer.Address, "error", err.Error())
+					if len(responseError) > 0 {
+						lastResponseError = responseError
+					}
+					return
+				}
+
+				reads = append(reads, result)
+			}(observer)
+		}
+		wg.Wait()
+
+		observers = observers[numToRead:]
+	}
+
+	return reads, lastResponseError
+}
+
+func (dfp *DataFreshnessProcessor) computeFreshness(reads []*observerRead) (*observerRead, *data.DataFreshness) {
+	freshestRead := reads[0]
+	laggingRead := reads[0]
+	for _, read := range reads[1:] {
+		if read.nonce > freshestRead.nonce {
+			freshestRead = read
+		}
+		if read.nonce < laggingRead.nonce {
+			laggingRead = read
+		}
+	}
+
+	freshness := &data.DataFreshness{
+		Status:               data.DataFreshnessUnverified,
+		Nonce:                freshestRead.nonce,
+		NonceDelta:           freshestRead.nonce - laggingRead.nonce,
+		NumComparedObservers: len(reads),
+	}
+	if len(reads) < numObserversForFreshnessCheck {
+		return freshestRead, freshness
+	}
+
+	freshness.Status = data.DataFreshnessFresh
+	if freshness.NonceDelta > dfp.maxNonceDelta {
+		freshness.Status = data.DataFreshnessStale
+		log.Warn("observer lags behind",
+			"shard ID", laggingRead.observer.ShardId,
+			"observer", laggingRead.observer.Address,
+			"nonce", laggingRead.nonce,
+			"highest nonce", freshestRead.nonce)
+	}
+
+	return freshestRead, freshness
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dfp *DataFreshnessProcessor) IsInterfaceNil() bool {
+	return dfp == nil
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createObserversForFreshnessCheck(addresses ...string) []*data.NodeData {
+	observers := make([]*data.NodeData, 0, len(addresses))
+	for _, address := range addresses {
+		observers = append(observers, &data.NodeData{Address: address, ShardId: 0})
+	}
+
+	return observers
+}
+
+func createAccountReadingProcessor(nonces map[string]uint64) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			nonce, found := nonces[address]
+			if !found {
+				return 0, errors.New("observer offline")
+			}
+
+			response := value.(*data.AccountApiResponse)
+			response.Data.Account.Address = address
+			response.Data.BlockInfo.Nonce = nonce
+			return 0, nil
+		},
+	}
+}
+
+func TestNewDataFreshnessProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		dfp, err := process.NewDataFreshnessProcessor(nil, true, 1)
+		require.Nil(t, dfp)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dfp, err := process.NewDataFreshnessProcessor(&mock.ProcessorStub{}, true, 1)
+		require.Nil(t, err)
+		require.False(t, dfp.IsInterfaceNil())
+		require.True(t, dfp.IsEnabled())
+	})
+}
+
+func TestDataFreshnessProcessor_GetAccountFromObservers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers in sync should return fresh data", func(t *testing.T) {
+		t.Parallel()
+
+		observers := createObserversForFreshnessCheck("obs0", "obs1", "obs2")
+		nonces := map[string]uint64{"obs0": 100, "obs1": 101, "obs2": 500}
+		dfp, _ := process.NewDataFreshnessProcessor(createAccountReadingProcessor(nonces), true, 1)
+
+		account, observer, err := dfp.GetAccountFromObservers(context.Background(), observers, "/address/DEADBEEF")
+		require.Nil(t, err)
+		assert.Equal(t, "obs1", account.Account.Address)
+		assert.Equal(t, observers[1], observer)
+		assert.Equal(t, &data.DataFreshness{
+			Status:               data.DataFreshnessFresh,
+			Nonce:                101,
+			NonceDelta:           1,
+			NumComparedObservers: 2,
+		}, account.DataFreshness)
+	})
+	t.Run("lagging observer should return stale data from the freshest observer", func(t *testing.T) {
+		t.Parallel()
+
+		observers := createObserversForFreshnessCheck("obs0", "obs1")
+		nonces := map[string]uint64{"obs0": 110, "obs1": 100}
+		dfp, _ := process.NewDataFreshnessProcessor(createAccountReadingProcessor(nonces), true, 2)
+
+		account, _, err := dfp.GetAccountFromObservers(context.Background(), observers, "/address/DEADBEEF")
+		require.Nil(t, err)
+		assert.Equal(t, "obs0", account.Account.Address)
+		assert.Equal(t, data.DataFreshnessStale, account.DataFreshness.Status)
+		assert.Equal(t, uint64(10), account.DataFreshness.NonceDelta)
+	})
+	t.Run("failing observer should be replaced by the next one", func(t *testing.T) {
+		t.Parallel()
+
+		observers := createObserversForFreshnessCheck("obs0", "obs1", "obs2")
+		nonces := map[string]uint64{"obs1": 100, "obs2": 100}
+		dfp, _ := process.NewDataFreshnessProcessor(createAccountReadingProcessor(nonces), true, 1)
+
+		account, _, err := dfp.GetAccountFromObservers(context.Background(), observers, "/address/DEADBEEF")
+		require.Nil(t, err)
+		assert.Equal(t, data.DataFreshnessFresh, account.DataFreshness.Status)
+		assert.Equal(t, 2, account.DataFreshness.NumComparedObservers)
+	})
+	t.Run("single observer should return unverified data", func(t *testing.T) {
+		t.Parallel()
+
+		observers := createObserversForFreshnessCheck("obs0", "obs1")
+		nonces := map[string]uint64{"obs1": 100}
+		dfp, _ := process.NewDataFreshnessProcessor(createAccountReadingProcessor(nonces), true, 1)
+
+		account, _, err := dfp.GetAccountFromObservers(context.Background(), observers, "/address/DEADBEEF")
+		require.Nil(t, err)
+		assert.Equal(t, "obs1", account.Account.Address)
+		assert.Equal(t, data.DataFreshnessUnverified, account.DataFreshness.Status)
+		assert.Equal(t, 1, account.DataFreshness.NumComparedObservers)
+	})
+	t.Run("all observers failing should error", func(t *testing.T) {
+		t.Parallel()
+
+		observers := createObserversForFreshnessCheck("obs0", "obs1", "obs2")
+		dfp, _ := process.NewDataFreshnessProcessor(createAccountReadingProcessor(nil), true, 1)
+
+		account, observer, err := dfp.GetAccountFromObservers(context.Background(), observers, "/address/DEADBEEF")
+		require.Nil(t, account)
+		require.Nil(t, observer)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("should call the provided path", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPath := ""
+		mutRequestedPath := sync.Mutex{}
+		proc := createAccountReadingProcessor(map[string]uint64{"obs0": 1})
+		proc.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			mutRequestedPath.Lock()
+			requestedPath = path
+			mutRequestedPath.Unlock()
+
+			return 0, nil
+		}
+		dfp, _ := process.NewDataFreshnessProcessor(proc, true, 1)
+
+		_, _, err := dfp.GetAccountFromObservers(context.Background(), createObserversForFreshnessCheck("obs0"), "/address/DEADBEEF?onFinalBlock=true")
+		require.Nil(t, err)
+		assert.Equal(t, "/address/DEADBEEF?onFinalBlock=true", requestedPath)
+	})
+}
+
+func TestDataFreshnessProcessor_GetNetworkStatusMetrics(t *testing.T) {
+	t.Parallel()
+
+	nonces := map[string]uint64{"obs0": 100, "obs1": 95}
+	dfp, _ := process.NewDataFreshnessProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return createObserversForFreshnessCheck("obs0", "obs1", "obs2"), nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			require.True(t, strings.HasSuffix(path, process.NetworkStatusPath))
+
+			response := value.(*data.GenericAPIResponse)
+			response.Data = map[string]interface{}{
+				"metrics": map[string]interface{}{
+					process.MetricNonce: float64(nonces[address]),
+					"observer":          address,
+				},
+			}
+			return 0, nil
+		},
+	}, true, 1)
+
+	response, err := dfp.GetNetworkStatusMetrics(context.Background(), 0)
+	require.Nil(t, err)
+
+	responseData := response.Data.(map[string]interface{})
+	assert.Equal(t, "obs0", responseData["metrics"].(map[string]interface{})["observer"])
+	assert.Equal(t, &data.DataFreshness{
+		Status:               data.DataFreshnessStale,
+		Nonce:                100,
+		NonceDelta:           5,
+		NumComparedObservers: 2,
+	}, responseData["dataFreshness"])
+}
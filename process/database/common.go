@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
@@ -53,3 +54,114 @@ func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error)
 	}
 	return txs, nil
 }
+
+func convertObjectToActivitySummary(address string, obj object) (*data.AddressActivitySummary, error) {
+	aggregations, ok := obj["aggregations"].(object)
+	if !ok {
+		return nil, errCannotGetActivityFromBody
+	}
+
+	sent, okSent := getAggregation(aggregations, "sent")["doc_count"].(float64)
+	received, okReceived := getAggregation(aggregations, "received")["doc_count"].(float64)
+	if !okSent || !okReceived {
+		return nil, errCannotGetActivityFromBody
+	}
+
+	receivedTxsCount := uint64(received)
+	summary := &data.AddressActivitySummary{
+		Address:          address,
+		SentTxsCount:     uint64(sent),
+		ReceivedTxsCount: &receivedTxsCount,
+		Source:           data.ActivitySourceExternalStorage,
+	}
+
+	// the min and max aggregations hold a null value if the address has no transaction
+	firstActivity, ok := getAggregation(aggregations, "firstActivity")["value"].(float64)
+	if ok {
+		firstActivityTimestamp := uint64(firstActivity)
+		summary.FirstActivityTimestamp = &firstActivityTimestamp
+	}
+	lastActivity, ok := getAggregation(aggregations, "lastActivity")["value"].(float64)
+	if ok {
+		lastActivityTimestamp := uint64(lastActivity)
+		summary.LastActivityTimestamp = &lastActivityTimestamp
+	}
+
+	return summary, nil
+}
+
+func getAggregation(aggregations object, name string) object {
+	aggregation, _ := aggregations[name].(object)
+	return aggregation
+}
+
+func convertObjectToTokenHoldersPage(token string, size int, obj object) (*data.TokenHoldersPage, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetTokenHoldersFromBody
+	}
+	documents, ok := hits["hits"].([]interface{})
+	if !ok {
+		return nil, errCannotGetTokenHoldersFromBody
+	}
+
+	page := &data.TokenHoldersPage{
+		Token:   token,
+		Holders: make([]*data.TokenHolder, 0, len(documents)),
+	}
+	var lastSortValues []interface{}
+	for _, document := range documents {
+		hit, _ := document.(object)
+		source, isObject := hit["_source"].(object)
+		if !isObject {
+			return nil, errCannotGetTokenHoldersFromBody
+		}
+
+		address, _ := source["address"].(string)
+		balance, _ := source["balance"].(string)
+		page.Holders = append(page.Holders, &data.TokenHolder{
+			Address: address,
+			Balance: balance,
+		})
+		lastSortValues, _ = hit["sort"].([]interface{})
+	}
+
+	// a full page means there might be more holders, to be requested after the last returned one
+	if len(documents) == size && len(lastSortValues) > 0 {
+		cursor, err := encodeCursor(lastSortValues)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = cursor
+	}
+
+	return page, nil
+}
+
+func encodeCursor(sortValues []interface{}) (string, error) {
+	sortValuesBytes, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(sortValuesBytes), nil
+}
+
+func decodeCursor(cursor string) ([]interface{}, error) {
+	if len(cursor) == 0 {
+		return nil, nil
+	}
+
+	sortValuesBytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var sortValues []interface{}
+	err = json.Unmarshal(sortValuesBytes, &sortValues)
+	if err != nil || len(sortValues) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return sortValues, nil
+}
@@ -0,0 +1,35 @@
+package database
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+type disabledElasticSearchConnector struct{}
+
+// NewDisabledElasticSearchConnector creates a connector to be used when no Elasticsearch cluster is configured
+func NewDisabledElasticSearchConnector() *disabledElasticSearchConnector {
+	return &disabledElasticSearchConnector{}
+}
+
+// IsEnabled returns false
+func (desc *disabledElasticSearchConnector) IsEnabled() bool {
+	return false
+}
+
+// GetAddressActivitySummary returns the disabled connector error
+func (desc *disabledElasticSearchConnector) GetAddressActivitySummary(_ string) (*data.AddressActivitySummary, error) {
+	return nil, ErrDisabledConnector
+}
+
+// GetAddressLatestTransactions returns the disabled connector error
+func (desc *disabledElasticSearchConnector) GetAddressLatestTransactions(_ string, _ int) ([]data.DatabaseTransaction, error) {
+	return nil, ErrDisabledConnector
+}
+
+// GetTokenHolders returns the disabled connector error
+func (desc *disabledElasticSearchConnector) GetTokenHolders(_ string, _ int, _ string) (*data.TokenHoldersPage, error) {
+	return nil, ErrDisabledConnector
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (desc *disabledElasticSearchConnector) IsInterfaceNil() bool {
+	return desc == nil
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	transactionsSearchPath = "/transactions/_search"
+	accountsESDTSearchPath = "/accountsesdt/_search"
+)
+
+type elasticSearchConnector struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewElasticSearchConnector creates a connector which reads the data indexed by the indexer from an Elasticsearch
+// cluster
+func NewElasticSearchConnector(url string, username string, password string, requestTimeout time.Duration) (*elasticSearchConnector, error) {
+	if len(url) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if requestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &elasticSearchConnector{
+		url:        strings.TrimSuffix(url, "/"),
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// IsEnabled returns true
+func (esc *elasticSearchConnector) IsEnabled() bool {
+	return true
+}
+
+// GetAddressActivitySummary counts the transactions sent and received by the address and returns the timestamps of
+// its first and last transactions
+func (esc *elasticSearchConnector) GetAddressActivitySummary(address string) (*data.AddressActivitySummary, error) {
+	decodedResponse, err := esc.search(transactionsSearchPath, addressActivityQuery(address))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToActivitySummary(address, decodedResponse)
+}
+
+// GetAddressLatestTransactions returns the most recent transactions sent or received by the address, the newest first
+func (esc *elasticSearchConnector) GetAddressLatestTransactions(address string, size int) ([]data.DatabaseTransaction, error) {
+	decodedResponse, err := esc.search(transactionsSearchPath, addressLatestTransactionsQuery(address, size))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTransactions(decodedResponse)
+}
+
+// GetTokenHolders returns a page of the addresses holding the token, sorted by their balance in descending order. The
+// cursor returned along with a page is used to request the next one
+func (esc *elasticSearchConnector) GetTokenHolders(token string, size int, cursor string) (*data.TokenHoldersPage, error) {
+	searchAfter, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResponse, err := esc.search(accountsESDTSearchPath, tokenHoldersQuery(token, size, searchAfter))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTokenHoldersPage(token, size, decodedResponse)
+}
+
+func (esc *elasticSearchConnector) search(path string, query object) (object, error) {
+	encodedQuery, err := encodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, esc.url+path, &encodedQuery)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(esc.username) > 0 {
+		request.SetBasicAuth(esc.username, esc.password)
+	}
+
+	response, err := esc.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d %s", errCannotQueryDatabase, response.StatusCode, string(responseBytes))
+	}
+
+	var decodedResponse object
+	err = json.Unmarshal(responseBytes, &decodedResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResponse, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esc *elasticSearchConnector) IsInterfaceNil() bool {
+	return esc == nil
+}
@@ -0,0 +1,253 @@
+package database
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	connector, err := NewElasticSearchConnector("", "", "", time.Second)
+	assert.Nil(t, connector)
+	assert.Equal(t, ErrEmptyURL, err)
+
+	connector, err = NewElasticSearchConnector("http://127.0.0.1:9200", "", "", 0)
+	assert.Nil(t, connector)
+	assert.Equal(t, ErrInvalidRequestTimeout, err)
+
+	connector, err = NewElasticSearchConnector("http://127.0.0.1:9200/", "", "", time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, "http://127.0.0.1:9200", connector.url)
+	assert.True(t, connector.IsEnabled())
+}
+
+func TestElasticSearchConnector_GetAddressActivitySummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the aggregated activity", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, transactionsSearchPath, r.URL.Path)
+			username, password, _ := r.BasicAuth()
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+
+			query := object{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+			assert.Equal(t, normalize(t, addressActivityQuery("erd1address")["aggs"]), query["aggs"])
+
+			_, _ = w.Write([]byte(`{"aggregations":{"sent":{"doc_count":4},"received":{"doc_count":6},` +
+				`"firstActivity":{"value":1600000000},"lastActivity":{"value":1700000000}}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "user", "pass", time.Second)
+		summary, err := connector.GetAddressActivitySummary("erd1address")
+		require.Nil(t, err)
+
+		receivedTxsCount, firstActivity, lastActivity := uint64(6), uint64(1600000000), uint64(1700000000)
+		expectedSummary := &data.AddressActivitySummary{
+			Address:                "erd1address",
+			SentTxsCount:           4,
+			ReceivedTxsCount:       &receivedTxsCount,
+			FirstActivityTimestamp: &firstActivity,
+			LastActivityTimestamp:  &lastActivity,
+			Source:                 data.ActivitySourceExternalStorage,
+		}
+		assert.Equal(t, expectedSummary, summary)
+	})
+	t.Run("address without transactions should not have activity timestamps", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"aggregations":{"sent":{"doc_count":0},"received":{"doc_count":0},` +
+				`"firstActivity":{"value":null},"lastActivity":{"value":null}}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		summary, err := connector.GetAddressActivitySummary("erd1address")
+		require.Nil(t, err)
+		assert.Zero(t, summary.SentTxsCount)
+		assert.Zero(t, *summary.ReceivedTxsCount)
+		assert.Nil(t, summary.FirstActivityTimestamp)
+		assert.Nil(t, summary.LastActivityTimestamp)
+	})
+	t.Run("error status should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"index_not_found_exception"}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		summary, err := connector.GetAddressActivitySummary("erd1address")
+		assert.Nil(t, summary)
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "index_not_found_exception"))
+	})
+	t.Run("response without aggregations should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"hits":{}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		summary, err := connector.GetAddressActivitySummary("erd1address")
+		assert.Nil(t, summary)
+		assert.Equal(t, errCannotGetActivityFromBody, err)
+	})
+}
+
+func TestElasticSearchConnector_GetAddressLatestTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, transactionsSearchPath, r.URL.Path)
+
+			query := object{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+			assert.Equal(t, normalize(t, addressLatestTransactionsQuery("erd1address", 2)), query)
+
+			_, _ = w.Write([]byte(`{"hits":{"hits":[` +
+				`{"_id":"hash1","_source":{"sender":"erd1address","gasPrice":10,"gasUsed":5,"timestamp":1700000001}},` +
+				`{"_id":"hash0","_source":{"receiver":"erd1address","timestamp":1700000000}}]}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := connector.GetAddressLatestTransactions("erd1address", 2)
+		require.Nil(t, err)
+		require.Len(t, txs, 2)
+		assert.Equal(t, "hash1", txs[0].Hash)
+		assert.Equal(t, "50", txs[0].Fee)
+		assert.Equal(t, "erd1address", txs[0].Sender)
+		assert.Equal(t, "hash0", txs[1].Hash)
+		assert.Equal(t, "erd1address", txs[1].Receiver)
+	})
+	t.Run("response without hits should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"aggregations":{}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		txs, err := connector.GetAddressLatestTransactions("erd1address", 2)
+		assert.Nil(t, txs)
+		assert.Equal(t, errCannotGetTxsFromBody, err)
+	})
+}
+
+func TestElasticSearchConnector_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		connector, _ := NewElasticSearchConnector("http://127.0.0.1:9200", "", "", time.Second)
+		holders, err := connector.GetTokenHolders("TKN-abcdef", 2, "not a cursor")
+		assert.Nil(t, holders)
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+	t.Run("full page should return the next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		chanQueries := make(chan object, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, accountsESDTSearchPath, r.URL.Path)
+
+			query := object{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+			chanQueries <- query
+
+			_, hasSearchAfter := query["search_after"]
+			if hasSearchAfter {
+				_, _ = w.Write([]byte(`{"hits":{"hits":[` +
+					`{"_source":{"address":"erd1carol","balance":"5"},"sort":[5,"erd1carol"]}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"hits":{"hits":[` +
+				`{"_source":{"address":"erd1alice","balance":"1000"},"sort":[1000,"erd1alice"]},` +
+				`{"_source":{"address":"erd1bob","balance":"10"},"sort":[10,"erd1bob"]}]}}`))
+		}))
+		defer server.Close()
+
+		connector, _ := NewElasticSearchConnector(server.URL, "", "", time.Second)
+		firstPage, err := connector.GetTokenHolders("TKN-abcdef", 2, "")
+		require.Nil(t, err)
+		assert.Equal(t, "TKN-abcdef", firstPage.Token)
+		assert.Equal(t, []*data.TokenHolder{
+			{Address: "erd1alice", Balance: "1000"},
+			{Address: "erd1bob", Balance: "10"},
+		}, firstPage.Holders)
+		require.NotEmpty(t, firstPage.NextCursor)
+
+		query := <-chanQueries
+		assert.Equal(t, float64(2), query["size"])
+		assert.Equal(t, object{"term": object{"token": "TKN-abcdef"}}, query["query"])
+
+		secondPage, err := connector.GetTokenHolders("TKN-abcdef", 2, firstPage.NextCursor)
+		require.Nil(t, err)
+		assert.Equal(t, []*data.TokenHolder{{Address: "erd1carol", Balance: "5"}}, secondPage.Holders)
+		assert.Empty(t, secondPage.NextCursor)
+
+		query = <-chanQueries
+		assert.Equal(t, []interface{}{float64(10), "erd1bob"}, query["search_after"])
+	})
+	t.Run("NFT identifier should query the identifier field", func(t *testing.T) {
+		t.Parallel()
+
+		query := tokenHoldersQuery("NFT-abcdef-0a", 10, nil)
+		assert.Equal(t, object{"term": object{"identifier": "NFT-abcdef-0a"}}, query["query"])
+		_, hasSearchAfter := query["search_after"]
+		assert.False(t, hasSearchAfter)
+	})
+}
+
+func TestDisabledElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	connector := NewDisabledElasticSearchConnector()
+	assert.False(t, connector.IsEnabled())
+
+	summary, err := connector.GetAddressActivitySummary("erd1address")
+	assert.Nil(t, summary)
+	assert.Equal(t, ErrDisabledConnector, err)
+
+	txs, err := connector.GetAddressLatestTransactions("erd1address", 10)
+	assert.Nil(t, txs)
+	assert.Equal(t, ErrDisabledConnector, err)
+
+	holders, err := connector.GetTokenHolders("TKN-abcdef", 10, "")
+	assert.Nil(t, holders)
+	assert.Equal(t, ErrDisabledConnector, err)
+}
+
+func normalize(t *testing.T, value interface{}) interface{} {
+	buff, err := json.Marshal(value)
+	require.Nil(t, err)
+
+	var expected object
+	require.Nil(t, json.Unmarshal(buff, &expected))
+
+	return expected
+}
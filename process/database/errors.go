@@ -5,3 +5,18 @@ import "errors"
 var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errCannotQueryDatabase = errors.New("cannot query database")
+var errCannotGetActivityFromBody = errors.New("cannot get address activity from decoded body")
+var errCannotGetTokenHoldersFromBody = errors.New("cannot get token holders from decoded body")
+
+// ErrEmptyURL signals that an empty database URL has been provided
+var ErrEmptyURL = errors.New("empty database URL")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrDisabledConnector signals that the database connector is disabled
+var ErrDisabledConnector = errors.New("database connector is disabled")
+
+// ErrInvalidCursor signals that an invalid pagination cursor has been provided
+var ErrInvalidCursor = errors.New("invalid cursor")
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type object = map[string]interface{}
 
+const tokenIdentifierSeparator = "-"
+
 func encodeQuery(query object) (bytes.Buffer, error) {
 	var buff bytes.Buffer
 	if err := json.NewEncoder(&buff).Encode(query); err != nil {
@@ -57,3 +60,68 @@ func txsByMiniblockHashQuery(hash string) object {
 		},
 	}
 }
+
+func addressActivityQuery(address string) object {
+	isSender := object{"term": object{"sender": address}}
+	isReceiver := object{"term": object{"receiver": address}}
+
+	return object{
+		"size": 0,
+		"query": object{
+			"bool": object{
+				"should":               []interface{}{isSender, isReceiver},
+				"minimum_should_match": 1,
+			},
+		},
+		"aggs": object{
+			"sent":          object{"filter": isSender},
+			"received":      object{"filter": isReceiver},
+			"firstActivity": object{"min": object{"field": "timestamp"}},
+			"lastActivity":  object{"max": object{"field": "timestamp"}},
+		},
+	}
+}
+
+// addressLatestTransactionsQuery searches the most recent transactions sent or received by the address
+func addressLatestTransactionsQuery(address string, size int) object {
+	return object{
+		"size": size,
+		"query": object{
+			"bool": object{
+				"should": []interface{}{
+					object{"term": object{"sender": address}},
+					object{"term": object{"receiver": address}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": "desc"}},
+		},
+	}
+}
+
+// tokenHoldersQuery searches the accounts holding a fungible token or a collection, or the ones holding a given NFT or
+// SFT if the identifier holds the nonce, the ties between the balances being broken by the address
+func tokenHoldersQuery(token string, size int, searchAfter []interface{}) object {
+	tokenField := "token"
+	if strings.Count(token, tokenIdentifierSeparator) > 1 {
+		tokenField = "identifier"
+	}
+
+	query := object{
+		"size": size,
+		"query": object{
+			"term": object{tokenField: token},
+		},
+		"sort": []interface{}{
+			object{"balanceNum": object{"order": "desc"}},
+			object{"address": object{"order": "asc"}},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
@@ -0,0 +1,183 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	getAllContractAddressesFunc = "getAllContractAddresses"
+	getContractConfigFunc       = "getContractConfig"
+	getNumUsersFunc             = "getNumUsers"
+	getTotalActiveStakeFunc     = "getTotalActiveStake"
+	getMetaDataFunc             = "getMetaData"
+
+	numContractConfigValues = 10
+	numMetaDataValues       = 3
+	serviceFeeDenominator   = 100
+
+	maxParallelDelegationProvidersLookups = 10
+)
+
+// DelegationProcessor is able to decode the data of the delegation contracts by querying the delegation manager and the
+// delegation contracts themselves
+type DelegationProcessor struct {
+	scQueryProc     SCQueryService
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewDelegationProcessor creates a new instance of DelegationProcessor
+func NewDelegationProcessor(scQueryProc SCQueryService, pubKeyConverter core.PubkeyConverter) (*DelegationProcessor, error) {
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &DelegationProcessor{
+		scQueryProc:     scQueryProc,
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// GetDelegationProviders returns all the delegation contracts created by the delegation manager, fetched concurrently.
+// A contract which could not be queried is returned along with the error
+func (dp *DelegationProcessor) GetDelegationProviders(ctx context.Context) ([]*data.DelegationProvider, error) {
+	contractAddresses, err := dp.executeQuery(ctx,
+		encodeSystemAddress(dp.pubKeyConverter, common.DelegationManagerContractAddressHex),
+		getAllContractAddressesFunc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]*data.DelegationProvider, len(contractAddresses))
+	throttler := make(chan struct{}, maxParallelDelegationProvidersLookups)
+	wg := sync.WaitGroup{}
+	wg.Add(len(contractAddresses))
+	for idx, contractAddress := range contractAddresses {
+		throttler <- struct{}{}
+		go func(idx int, contractAddress []byte) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			address := dp.pubKeyConverter.SilentEncode(contractAddress, log)
+			provider, errGet := dp.getDelegationProvider(ctx, address)
+			if errGet != nil {
+				provider = &data.DelegationProvider{
+					Address: address,
+					Error:   errGet.Error(),
+				}
+			}
+			providers[idx] = provider
+		}(idx, contractAddress)
+	}
+	wg.Wait()
+
+	return providers, nil
+}
+
+// GetDelegationProvider returns the decoded configuration, the number of delegators, the total active stake and the
+// metadata of the provided delegation contract
+func (dp *DelegationProcessor) GetDelegationProvider(ctx context.Context, address string) (*data.DelegationProvider, error) {
+	_, err := dp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	return dp.getDelegationProvider(ctx, address)
+}
+
+func (dp *DelegationProcessor) getDelegationProvider(ctx context.Context, address string) (*data.DelegationProvider, error) {
+	contractConfig, err := dp.executeQuery(ctx, address, getContractConfigFunc)
+	if err != nil {
+		return nil, err
+	}
+	if len(contractConfig) < numContractConfigValues {
+		return nil, fmt.Errorf("%w for %s: %d values, expected %d",
+			ErrInvalidDelegationContractConfig, getContractConfigFunc, len(contractConfig), numContractConfigValues)
+	}
+
+	serviceFee := big.NewInt(0).SetBytes(contractConfig[1]).Uint64()
+	provider := &data.DelegationProvider{
+		Address:              address,
+		Owner:                dp.pubKeyConverter.SilentEncode(contractConfig[0], log),
+		ServiceFee:           float64(serviceFee) / serviceFeeDenominator,
+		MaxDelegationCap:     big.NewInt(0).SetBytes(contractConfig[2]).String(),
+		InitialOwnerFunds:    big.NewInt(0).SetBytes(contractConfig[3]).String(),
+		AutomaticActivation:  string(contractConfig[4]) == "true",
+		WithDelegationCap:    string(contractConfig[5]) == "true",
+		ChangeableServiceFee: string(contractConfig[6]) == "true",
+		CheckCapOnRedelegate: string(contractConfig[7]) == "true",
+		CreatedNonce:         big.NewInt(0).SetBytes(contractConfig[8]).Uint64(),
+		UnBondPeriodInEpochs: big.NewInt(0).SetBytes(contractConfig[9]).Uint64(),
+	}
+
+	numUsers, err := dp.executeQuery(ctx, address, getNumUsersFunc)
+	if err != nil {
+		return nil, err
+	}
+	if len(numUsers) > 0 {
+		provider.NumDelegators = big.NewInt(0).SetBytes(numUsers[0]).Uint64()
+	}
+
+	totalActiveStake, err := dp.executeQuery(ctx, address, getTotalActiveStakeFunc)
+	if err != nil {
+		return nil, err
+	}
+	provider.TotalActiveStake = "0"
+	if len(totalActiveStake) > 0 {
+		provider.TotalActiveStake = big.NewInt(0).SetBytes(totalActiveStake[0]).String()
+	}
+
+	provider.Metadata = dp.getMetadata(ctx, address)
+
+	return provider, nil
+}
+
+// getMetadata returns the name, the website and the identifier of the provider, if the owner has set them
+func (dp *DelegationProcessor) getMetadata(ctx context.Context, address string) *data.DelegationProviderMetadata {
+	metadata, err := dp.executeQuery(ctx, address, getMetaDataFunc)
+	if err != nil {
+		log.WithContext(ctx).Debug("delegation provider metadata", "address", address, "error", err.Error())
+		return nil
+	}
+	if len(metadata) < numMetaDataValues {
+		return nil
+	}
+
+	return &data.DelegationProviderMetadata{
+		Name:       string(metadata[0]),
+		Website:    string(metadata[1]),
+		Identifier: string(metadata[2]),
+	}
+}
+
+func (dp *DelegationProcessor) executeQuery(ctx context.Context, address string, function string) ([][]byte, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: address,
+		FuncName:  function,
+	}
+
+	res, _, err := dp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, function)
+	}
+
+	return res.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *DelegationProcessor) IsInterfaceNil() bool {
+	return dp == nil
+}
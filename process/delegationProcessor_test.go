@@ -0,0 +1,240 @@
+package process_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const delegationManagerAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+
+func TestNewDelegationProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(nil, testPubkeyConverter)
+		require.Nil(t, dp)
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, nil)
+		require.Nil(t, dp)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter)
+		require.NoError(t, err)
+		require.False(t, dp.IsInterfaceNil())
+	})
+}
+
+func TestDelegationProcessor_GetDelegationProvider(t *testing.T) {
+	t.Parallel()
+
+	providerBytes := bytes.Repeat([]byte{1}, 32)
+	ownerBytes := bytes.Repeat([]byte{2}, 32)
+	provider := testPubkeyConverter.SilentEncode(providerBytes, nil)
+	owner := testPubkeyConverter.SilentEncode(ownerBytes, nil)
+
+	contractConfig := [][]byte{
+		ownerBytes,
+		big.NewInt(1250).Bytes(),
+		big.NewInt(5000).Bytes(),
+		big.NewInt(1000).Bytes(),
+		[]byte("true"),
+		[]byte("true"),
+		[]byte("false"),
+		[]byte("true"),
+		big.NewInt(42).Bytes(),
+		big.NewInt(10).Bytes(),
+	}
+	createSCQueryService := func(returnData map[string][][]byte) *mock.SCQueryServiceStub {
+		return &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, provider, query.ScAddress)
+
+				values, found := returnData[query.FuncName]
+				if !found {
+					return nil, data.BlockInfo{}, errors.New("function not found")
+				}
+
+				return &vm.VMOutputApi{ReturnData: values}, data.BlockInfo{}, nil
+			},
+		}
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(createSCQueryService(nil), testPubkeyConverter)
+
+		result, err := dp.GetDelegationProvider(context.Background(), "invalid")
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(createSCQueryService(nil), testPubkeyConverter)
+
+		result, err := dp.GetDelegationProvider(context.Background(), provider)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "getContractConfig")
+	})
+	t.Run("incomplete contract config should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(createSCQueryService(map[string][][]byte{
+			"getContractConfig": contractConfig[:5],
+		}), testPubkeyConverter)
+
+		result, err := dp.GetDelegationProvider(context.Background(), provider)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidDelegationContractConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(createSCQueryService(map[string][][]byte{
+			"getContractConfig":   contractConfig,
+			"getNumUsers":         {big.NewInt(120).Bytes()},
+			"getTotalActiveStake": {big.NewInt(7500).Bytes()},
+			"getMetaData":         {[]byte("provider"), []byte("provider.com"), []byte("prv")},
+		}), testPubkeyConverter)
+
+		result, err := dp.GetDelegationProvider(context.Background(), provider)
+		require.NoError(t, err)
+		require.Equal(t, &data.DelegationProvider{
+			Address:              provider,
+			Owner:                owner,
+			ServiceFee:           12.5,
+			MaxDelegationCap:     "5000",
+			InitialOwnerFunds:    "1000",
+			AutomaticActivation:  true,
+			WithDelegationCap:    true,
+			ChangeableServiceFee: false,
+			CheckCapOnRedelegate: true,
+			CreatedNonce:         42,
+			UnBondPeriodInEpochs: 10,
+			NumDelegators:        120,
+			TotalActiveStake:     "7500",
+			Metadata: &data.DelegationProviderMetadata{
+				Name:       "provider",
+				Website:    "provider.com",
+				Identifier: "prv",
+			},
+		}, result)
+	})
+	t.Run("missing metadata should work without it", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(createSCQueryService(map[string][][]byte{
+			"getContractConfig":   contractConfig,
+			"getNumUsers":         {big.NewInt(0).Bytes()},
+			"getTotalActiveStake": {},
+		}), testPubkeyConverter)
+
+		result, err := dp.GetDelegationProvider(context.Background(), provider)
+		require.NoError(t, err)
+		require.Nil(t, result.Metadata)
+		require.Equal(t, uint64(0), result.NumDelegators)
+		require.Equal(t, "0", result.TotalActiveStake)
+	})
+}
+
+func TestDelegationProcessor_GetDelegationProviders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delegation manager query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryService := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryService, testPubkeyConverter)
+
+		providers, err := dp.GetDelegationProviders(context.Background())
+		require.Nil(t, providers)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should keep the order of the contracts and return the errors of each contract", func(t *testing.T) {
+		t.Parallel()
+
+		workingProviderBytes := bytes.Repeat([]byte{1}, 32)
+		failingProviderBytes := bytes.Repeat([]byte{3}, 32)
+		workingProvider := testPubkeyConverter.SilentEncode(workingProviderBytes, nil)
+		failingProvider := testPubkeyConverter.SilentEncode(failingProviderBytes, nil)
+
+		mutQueriedFunctions := sync.Mutex{}
+		queriedFunctions := make(map[string]int)
+		scQueryService := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				mutQueriedFunctions.Lock()
+				queriedFunctions[query.FuncName]++
+				mutQueriedFunctions.Unlock()
+
+				switch query.ScAddress {
+				case delegationManagerAddress:
+					require.Equal(t, "getAllContractAddresses", query.FuncName)
+					return &vm.VMOutputApi{ReturnData: [][]byte{failingProviderBytes, workingProviderBytes}}, data.BlockInfo{}, nil
+				case failingProvider:
+					return nil, data.BlockInfo{}, errors.New("contract not found")
+				}
+
+				switch query.FuncName {
+				case "getContractConfig":
+					return &vm.VMOutputApi{ReturnData: [][]byte{
+						bytes.Repeat([]byte{2}, 32),
+						big.NewInt(1000).Bytes(),
+						{},
+						big.NewInt(1000).Bytes(),
+						[]byte("false"),
+						[]byte("false"),
+						[]byte("true"),
+						[]byte("false"),
+						big.NewInt(1).Bytes(),
+						big.NewInt(10).Bytes(),
+					}}, data.BlockInfo{}, nil
+				case "getNumUsers":
+					return &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(5).Bytes()}}, data.BlockInfo{}, nil
+				default:
+					return &vm.VMOutputApi{}, data.BlockInfo{}, nil
+				}
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryService, testPubkeyConverter)
+
+		providers, err := dp.GetDelegationProviders(context.Background())
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+
+		require.Equal(t, failingProvider, providers[0].Address)
+		require.Equal(t, "contract not found for getContractConfig", providers[0].Error)
+
+		require.Equal(t, workingProvider, providers[1].Address)
+		require.Empty(t, providers[1].Error)
+		require.Equal(t, float64(10), providers[1].ServiceFee)
+		require.Equal(t, "0", providers[1].MaxDelegationCap)
+		require.Equal(t, uint64(5), providers[1].NumDelegators)
+		require.Nil(t, providers[1].Metadata)
+		require.Equal(t, 1, queriedFunctions["getAllContractAddresses"])
+	})
+}
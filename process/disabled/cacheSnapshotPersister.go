@@ -0,0 +1,22 @@
+package disabled
+
+import "time"
+
+// CacheSnapshotPersister represents a disabled struct that implements the CacheSnapshotPersister interface
+type CacheSnapshotPersister struct {
+}
+
+// SaveSnapshot won't do anything as this is a disabled component
+func (c *CacheSnapshotPersister) SaveSnapshot(_ string, _ interface{}) error {
+	return nil
+}
+
+// LoadSnapshot returns false as this is a disabled component
+func (c *CacheSnapshotPersister) LoadSnapshot(_ string, _ interface{}) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *CacheSnapshotPersister) IsInterfaceNil() bool {
+	return c == nil
+}
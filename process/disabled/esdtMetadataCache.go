@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ESDTMetadataCache represents a disabled struct that implements the ESDTMetadataCache interface
+type ESDTMetadataCache struct {
+}
+
+// GetTokenProperties returns nil and false as this is a disabled component
+func (emc *ESDTMetadataCache) GetTokenProperties(_ string) (*data.Collection, bool) {
+	return nil, false
+}
+
+// PutTokenProperties won't do anything as this is a disabled component
+func (emc *ESDTMetadataCache) PutTokenProperties(_ string, _ *data.Collection) {
+}
+
+// GetTokenRoles returns nil and false as this is a disabled component
+func (emc *ESDTMetadataCache) GetTokenRoles(_ string) (map[string][]string, bool) {
+	return nil, false
+}
+
+// PutTokenRoles won't do anything as this is a disabled component
+func (emc *ESDTMetadataCache) PutTokenRoles(_ string, _ map[string][]string) {
+}
+
+// Invalidate won't do anything as this is a disabled component
+func (emc *ESDTMetadataCache) Invalidate(_ string) {
+}
+
+// InvalidateAll won't do anything as this is a disabled component
+func (emc *ESDTMetadataCache) InvalidateAll() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (emc *ESDTMetadataCache) IsInterfaceNil() bool {
+	return emc == nil
+}
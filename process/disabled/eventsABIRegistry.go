@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// EventsABIRegistry represents a disabled struct that implements the EventsABIRegistry interface
+type EventsABIRegistry struct {
+}
+
+// DecodeEvent returns nil as this is a disabled component
+func (e *EventsABIRegistry) DecodeEvent(_ *transaction.Events) *data.TransactionDecodedEvent {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *EventsABIRegistry) IsInterfaceNil() bool {
+	return e == nil
+}
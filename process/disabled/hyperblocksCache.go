@@ -0,0 +1,23 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblocksCache represents a disabled struct that implements the HyperblocksCacher interface
+type HyperblocksCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (h *HyperblocksCache) Get(_ string) (*data.HyperblockApiResponse, bool) {
+	return nil, false
+}
+
+// Put won't do anything as this is a disabled component
+func (h *HyperblocksCache) Put(_ string, _ *data.HyperblockApiResponse) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *HyperblocksCache) IsInterfaceNil() bool {
+	return h == nil
+}
@@ -0,0 +1,18 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ObserverEventsNotifier represents a disabled struct that implements the ObserverEventsNotifier interface
+type ObserverEventsNotifier struct {
+}
+
+// NotifyObserverEvents won't do anything as this is a disabled component
+func (oen *ObserverEventsNotifier) NotifyObserverEvents(_ []*data.ObserverEvent) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oen *ObserverEventsNotifier) IsInterfaceNil() bool {
+	return oen == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+// ObserversAffinityCache represents a disabled struct that implements the ObserversAffinityCacher interface
+type ObserversAffinityCache struct {
+}
+
+// Put won't do anything as this is a disabled component
+func (o *ObserversAffinityCache) Put(_ string, _ string) {
+}
+
+// Get returns false as this is a disabled component
+func (o *ObserversAffinityCache) Get(_ string) (string, bool) {
+	return "", false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (o *ObserversAffinityCache) IsInterfaceNil() bool {
+	return o == nil
+}
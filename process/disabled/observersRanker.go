@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ObserversRanker represents a disabled struct that implements the ObserversRanker interface
+type ObserversRanker struct {
+}
+
+// RecordRequest won't do anything as this is a disabled component
+func (or *ObserversRanker) RecordRequest(_ string, _ string, _ time.Duration, _ bool) {
+}
+
+// RankForReads returns the nodes unchanged as this is a disabled component
+func (or *ObserversRanker) RankForReads(nodes []*data.NodeData) []*data.NodeData {
+	return nodes
+}
+
+// RankForWrites returns the nodes unchanged as this is a disabled component
+func (or *ObserversRanker) RankForWrites(nodes []*data.NodeData) []*data.NodeData {
+	return nodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (or *ObserversRanker) IsInterfaceNil() bool {
+	return or == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ReorgDetector represents a disabled struct that implements the ReorgDetector interface
+type ReorgDetector struct {
+}
+
+// RecordBlock won't do anything as this is a disabled component
+func (rd *ReorgDetector) RecordBlock(_ uint32, _ uint64, _ string) {
+}
+
+// GetRecentReorgs returns nil as this is a disabled component
+func (rd *ReorgDetector) GetRecentReorgs() []*data.ChainReorg {
+	return nil
+}
+
+// GetMetrics returns empty metrics as this is a disabled component
+func (rd *ReorgDetector) GetMetrics() data.ReorgDetectorMetrics {
+	return data.ReorgDetectorMetrics{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rd *ReorgDetector) IsInterfaceNil() bool {
+	return rd == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+// SentTxsCache represents a disabled struct that implements the SentTxsCacher interface
+type SentTxsCache struct {
+}
+
+// TryMarkSent returns true as this is a disabled component
+func (s *SentTxsCache) TryMarkSent(_ string) bool {
+	return true
+}
+
+// UnmarkSent won't do anything as this is a disabled component
+func (s *SentTxsCache) UnmarkSent(_ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *SentTxsCache) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ShardIDCache represents a disabled struct that implements the ShardIDCacher interface
+type ShardIDCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (s *ShardIDCache) Get(_ []byte) (uint32, bool) {
+	return 0, false
+}
+
+// Put won't do anything as this is a disabled component
+func (s *ShardIDCache) Put(_ []byte, _ uint32) {
+}
+
+// GetMetrics returns empty metrics as this is a disabled component
+func (s *ShardIDCache) GetMetrics() data.ShardIDCacheMetrics {
+	return data.ShardIDCacheMetrics{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *ShardIDCache) IsInterfaceNil() bool {
+	return s == nil
+}
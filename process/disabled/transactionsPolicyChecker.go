@@ -0,0 +1,17 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TransactionsPolicyChecker represents a disabled struct that implements the TransactionsPolicyChecker interface
+type TransactionsPolicyChecker struct {
+}
+
+// CheckTransaction returns nil as this is a disabled component
+func (t *TransactionsPolicyChecker) CheckTransaction(_ *data.Transaction) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *TransactionsPolicyChecker) IsInterfaceNil() bool {
+	return t == nil
+}
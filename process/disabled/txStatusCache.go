@@ -0,0 +1,23 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// TxStatusCache represents a disabled struct that implements the TxStatusCacher interface
+type TxStatusCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (t *TxStatusCache) Get(_ string) (transaction.TxStatus, bool) {
+	return "", false
+}
+
+// Put won't do anything as this is a disabled component
+func (t *TxStatusCache) Put(_ string, _ transaction.TxStatus) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *TxStatusCache) IsInterfaceNil() bool {
+	return t == nil
+}
@@ -2,9 +2,11 @@ package process
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -13,74 +15,100 @@ const EconomicsDataPath = "/network/economics"
 
 const thresholdCountConsecutiveFails = 10
 
+const economicMetricsSnapshotKey = "economicMetrics"
+
+const (
+	metricTotalSupply                           = "erd_total_supply"
+	metricTotalSupplyDenominated                = "erd_total_supply_denominated"
+	metricLockedSupplyDenominated               = "erd_locked_supply_denominated"
+	metricInflationRatePerEpoch                 = "erd_inflation_rate_per_epoch"
+	metricProjectedNextEpochEmission            = "erd_projected_next_epoch_emission"
+	metricProjectedNextEpochEmissionDenominated = "erd_projected_next_epoch_emission_denominated"
+)
+
 // GetEconomicsDataMetrics will return the economic metrics from cache
 func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
 	return nsp.economicMetricsCacher.Load()
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	metaObservers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
 	}
 
-	return nsp.getEconomicsDataMetrics(metaObservers)
+	return nsp.getEconomicsDataMetrics(ctx, metaObservers)
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(observers []*data.NodeData) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(ctx context.Context, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EconomicsDataPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EconomicsDataPath, &responseNetworkMetrics)
 		if err != nil {
-			log.Error("economics data request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("economics data request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("economics data request", "shard id", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("economics data request", "shard id", observer.ShardId, "observer", observer.Address)
 		return &responseNetworkMetrics, nil
 	}
 
 	return nil, WrapObserversError(responseNetworkMetrics.Error)
 }
 
-// StartCacheUpdate will update the economic metrics cache at a given time
+// StartCacheUpdate will update the economic metrics cache at a given time. The economic metrics and the network config
+// are first warm-loaded from the saved snapshots, if any, the first update being delayed while the economic metrics
+// snapshot is still fresh
 func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 	if nsp.cancelFunc != nil {
 		log.Error("NodeStatusProcessor - cache update already started")
 		return
 	}
 
+	nsp.warmLoadNetworkConfig()
+	firstUpdateDelay := nsp.warmLoadEconomicMetrics()
+
 	var ctx context.Context
 	ctx, nsp.cancelFunc = context.WithCancel(context.Background())
 
 	go func(ctx context.Context) {
-		timer := time.NewTimer(nsp.cacheValidityDuration)
+		timer := time.NewTimer(firstUpdateDelay)
 		defer timer.Stop()
 
 		countConsecutiveFails := 0
-		nsp.handleCacheUpdate(&countConsecutiveFails)
-
 		for {
-			timer.Reset(nsp.cacheValidityDuration)
-
 			select {
 			case <-timer.C:
-				nsp.handleCacheUpdate(&countConsecutiveFails)
+				nsp.handleCacheUpdate(ctx, &countConsecutiveFails)
 
 			case <-ctx.Done():
-				log.Debug("finishing NodeStatusProcessor cache update...")
+				log.WithContext(ctx).Debug("finishing NodeStatusProcessor cache update...")
 				return
 			}
+
+			timer.Reset(nsp.cacheValidityDuration)
 		}
 	}(ctx)
 }
 
-func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
-	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi()
+func (nsp *NodeStatusProcessor) warmLoadEconomicMetrics() time.Duration {
+	economicMetrics := &data.GenericAPIResponse{}
+	savedAt, found := nsp.snapshotPersister.LoadSnapshot(economicMetricsSnapshotKey, economicMetrics)
+	if !found {
+		return 0
+	}
+
+	nsp.economicMetricsCacher.Store(economicMetrics)
+
+	return computeFirstCacheUpdateDelay("economic metrics", savedAt, nsp.cacheValidityDuration)
+}
+
+func (nsp *NodeStatusProcessor) handleCacheUpdate(ctx context.Context, countConsecutiveFails *int) {
+	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi(ctx)
 	if err != nil {
 		*countConsecutiveFails++
-		log.Warn("economic metrics: get from API", "error", err.Error())
+		log.WithContext(ctx).Warn("economic metrics: get from API", "error", err.Error())
 	}
 
 	if *countConsecutiveFails >= thresholdCountConsecutiveFails {
@@ -89,8 +117,82 @@ func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
 
 	if economicMetrics != nil {
 		*countConsecutiveFails = 0
+		nsp.addDerivedEconomicsMetrics(ctx, economicMetrics)
 		nsp.economicMetricsCacher.Store(economicMetrics)
+
+		err = nsp.snapshotPersister.SaveSnapshot(economicMetricsSnapshotKey, economicMetrics)
+		if err != nil {
+			log.WithContext(ctx).Warn("economic metrics: save snapshot", "error", err.Error())
+		}
+	}
+}
+
+// addDerivedEconomicsMetrics adds the values derived from the raw economics metrics, so that the dashboards do not have
+// to compute them: the denominated total supply and locked (staked) supply, the inflation rate of the current epoch and
+// the emission projected for the next epoch. The denominated values are skipped if the network config is not available
+func (nsp *NodeStatusProcessor) addDerivedEconomicsMetrics(ctx context.Context, economicMetrics *data.GenericAPIResponse) {
+	responseData, ok := economicMetrics.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+	metrics, ok := responseData["metrics"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	totalSupply, okTotalSupply := getBigIntMetric(metrics, metricTotalSupply)
+	totalStaked, okTotalStaked := getBigIntMetric(metrics, metricTotalStakedValue)
+	inflation, okInflation := getBigIntMetric(metrics, metricInflation)
+
+	var projectedEmission *big.Int
+	if okTotalSupply && okInflation {
+		// the inflation of the current epoch was minted on top of the previous epoch's supply, with the rate
+		// kept for the whole year, so the next epoch's emission is the same rate applied on the current supply
+		previousSupply := big.NewInt(0).Sub(totalSupply, inflation)
+		if previousSupply.Sign() > 0 {
+			inflationRate := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(inflation), big.NewFloat(0).SetInt(previousSupply))
+			metrics[metricInflationRatePerEpoch], _ = inflationRate.Float64()
+
+			projectedEmission = big.NewInt(0).Mul(totalSupply, inflation)
+			projectedEmission.Quo(projectedEmission, previousSupply)
+			metrics[metricProjectedNextEpochEmission] = projectedEmission.String()
+		}
+	}
+
+	networkConfig, err := nsp.GetNetworkConfig(ctx)
+	if err != nil {
+		log.WithContext(ctx).Debug("economic metrics: cannot get the denomination", "error", err.Error())
+		return
+	}
+
+	numDecimals := networkConfig.Config.Denomination
+	if okTotalSupply {
+		addDenominatedMetric(metrics, metricTotalSupplyDenominated, totalSupply, numDecimals)
+	}
+	if okTotalStaked {
+		addDenominatedMetric(metrics, metricLockedSupplyDenominated, totalStaked, numDecimals)
 	}
+	if projectedEmission != nil {
+		addDenominatedMetric(metrics, metricProjectedNextEpochEmissionDenominated, projectedEmission, numDecimals)
+	}
+}
+
+func getBigIntMetric(metrics map[string]interface{}, metric string) (*big.Int, bool) {
+	valueStr, ok := metrics[metric].(string)
+	if !ok {
+		return nil, false
+	}
+
+	return big.NewInt(0).SetString(valueStr, 10)
+}
+
+func addDenominatedMetric(metrics map[string]interface{}, metric string, value *big.Int, numDecimals int) {
+	denominated, err := common.DenominateValue(value.String(), numDecimals)
+	if err != nil {
+		return
+	}
+
+	metrics[metric] = denominated
 }
 
 // Close will handle the closing of the cache update go routine
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/json"
 	"sync/atomic"
 	"testing"
@@ -9,7 +10,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +27,7 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldReturnDataFromCacher(t
 	}
 
 	cacher := &mock.GenericApiResponseCacherMock{Data: respInCache}
-	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, &disabled.CacheSnapshotPersister{})
 	assert.Nil(t, err)
 
 	res, err := hp.GetEconomicsDataMetrics()
@@ -47,7 +51,8 @@ func TestNodeStatusProcessor_CacheShouldUpdate(t *testing.T) {
 		},
 	},
 		cacher,
-		25*time.Millisecond)
+		25*time.Millisecond,
+		&disabled.CacheSnapshotPersister{})
 
 	assert.Nil(t, err)
 	hp.StartCacheUpdate()
@@ -92,6 +97,7 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 			Data: &data.GenericAPIResponse{Data: "default response"},
 		},
 		time.Millisecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
 	time.Sleep(2 * time.Millisecond)
@@ -104,3 +110,102 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, *expectedResponse, *actualResponse)
 }
+
+func TestNodeStatusProcessor_StartCacheUpdateWithSnapshots(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := cache.NewSnapshotPersister(storage.NewMemoryStorer(), time.Hour)
+	snapshotMetrics := &data.GenericAPIResponse{Data: map[string]interface{}{"erd_total_supply": "100"}, Code: data.ReturnCodeSuccess}
+	_ = persister.SaveSnapshot("economicMetrics", snapshotMetrics)
+	snapshotNetworkConfig := &data.NetworkConfig{}
+	snapshotNetworkConfig.Config.ChainID = "T"
+	_ = persister.SaveSnapshot("networkConfig", snapshotNetworkConfig)
+
+	numOfTimesHttpWasCalled := int32(0)
+	cacher := &mock.GenericApiResponseCacherMock{}
+	hp, _ := process.NewNodeStatusProcessor(&mock.ProcessorStub{
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		cacher,
+		time.Hour,
+		persister)
+	hp.StartCacheUpdate()
+	defer func() {
+		_ = hp.Close()
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	economicMetrics, err := hp.GetEconomicsDataMetrics()
+	require.NoError(t, err)
+	assert.Equal(t, "100", economicMetrics.Data.(map[string]interface{})["erd_total_supply"])
+
+	networkConfig, err := hp.GetNetworkConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "T", networkConfig.Config.ChainID)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
+
+func TestNodeStatusProcessor_CacheUpdateShouldAddDerivedEconomicsMetrics(t *testing.T) {
+	t.Parallel()
+
+	economicsResponse := &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"metrics": map[string]interface{}{
+				"erd_total_supply":             "1010000000000000000000",
+				"erd_total_staked_value":       "250500000000000000000",
+				"erd_inflation":                "10000000000000000000",
+				"erd_epoch_for_economics_data": 7,
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	networkConfigResponse := &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"config": map[string]interface{}{
+				"erd_denomination": 18,
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+
+	nodeStatusProc, _ := process.NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "address_meta", ShardId: core.MetachainShardId}}, nil
+		},
+		GetAllObserversCalled: func(_ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "address_meta", ShardId: core.MetachainShardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ string, path string, value interface{}) (int, error) {
+			response := economicsResponse
+			if path == process.NetworkConfigPath {
+				response = networkConfigResponse
+			}
+
+			responseBytes, _ := json.Marshal(response)
+			return 200, json.Unmarshal(responseBytes, value)
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		time.Hour,
+		&disabled.CacheSnapshotPersister{},
+	)
+	nodeStatusProc.StartCacheUpdate()
+	defer func() {
+		_ = nodeStatusProc.Close()
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	economicMetrics, err := nodeStatusProc.GetEconomicsDataMetrics()
+	require.NoError(t, err)
+	metrics := economicMetrics.Data.(map[string]interface{})["metrics"].(map[string]interface{})
+	require.Equal(t, "1010", metrics["erd_total_supply_denominated"])
+	require.Equal(t, "250.5", metrics["erd_locked_supply_denominated"])
+	require.Equal(t, 0.01, metrics["erd_inflation_rate_per_epoch"])
+	require.Equal(t, "10100000000000000000", metrics["erd_projected_next_epoch_emission"])
+	require.Equal(t, "10.1", metrics["erd_projected_next_epoch_emission_denominated"])
+	require.Equal(t, "1010000000000000000000", metrics["erd_total_supply"])
+}
@@ -41,6 +41,12 @@ var ErrValidatorStatisticsNotAvailable = errors.New("validator statistics data n
 // ErrAuctionListNotAvailable signals that the auction list data is not found
 var ErrAuctionListNotAvailable = errors.New("auction list data not found on any observer")
 
+// ErrInvalidRatingRange signals that the provided minimum rating is greater than the maximum one
+var ErrInvalidRatingRange = errors.New("the minimum rating is greater than the maximum rating")
+
+// ErrInvalidLeaderSuccessRange signals that the provided minimum number of leader successes is greater than the maximum one
+var ErrInvalidLeaderSuccessRange = errors.New("the minimum leader success is greater than the maximum leader success")
+
 // ErrInvalidCacheValidityDuration signals that the given validity duration for cache data is invalid
 var ErrInvalidCacheValidityDuration = errors.New("invalid cache validity duration")
 
@@ -59,6 +65,9 @@ var ErrNilNodesProvider = errors.New("nil nodes provider")
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
 
+// ErrTransactionRejectedByObserver signals that the observer did not accept a transaction from a batch
+var ErrTransactionRejectedByObserver = errors.New("transaction rejected by the observer")
+
 // ErrNoValidTransactionToSend signals that no valid transaction were received
 var ErrNoValidTransactionToSend = errors.New("no valid transaction to send")
 
@@ -113,5 +122,335 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
 
+// ErrInvalidTxBroadcastFanout signals that an invalid number of observers to broadcast the transactions to has been provided
+var ErrInvalidTxBroadcastFanout = errors.New("invalid transaction broadcast fanout")
+
+// ErrNilHyperblocksCache signals that a nil hyperblocks cache has been provided
+var ErrNilHyperblocksCache = errors.New("nil hyperblocks cache")
+
+// ErrNilReorgDetector signals that a nil reorg detector has been provided
+var ErrNilReorgDetector = errors.New("nil reorg detector")
+
+// ErrNilESDTMetadataCache signals that a nil ESDT metadata cache has been provided
+var ErrNilESDTMetadataCache = errors.New("nil ESDT metadata cache")
+
+// ErrNilBlockByNonceProvider signals that a nil provider of the blocks by nonce has been provided
+var ErrNilBlockByNonceProvider = errors.New("nil block by nonce provider")
+
+// ErrInvalidMaxBlocksPerPoll signals that an invalid maximum number of blocks processed on each poll has been provided
+var ErrInvalidMaxBlocksPerPoll = errors.New("invalid maximum number of blocks per poll")
+
+// ErrNilTxStatusCache signals that a nil transaction statuses cache has been provided
+var ErrNilTxStatusCache = errors.New("nil transaction statuses cache")
+
+// ErrNilSentTxsCache signals that a nil sent transactions cache has been provided
+var ErrNilSentTxsCache = errors.New("nil sent transactions cache")
+
 // ErrNilTxNotarizationCheckerHandler signals that nil tx notarization checker handler has been provided
 var ErrNilTxNotarizationCheckerHandler = errors.New("nil tx notarization checker handler has been provided")
+
+// ErrObserverProbeFailed signals that the observer could not be probed before registration
+var ErrObserverProbeFailed = errors.New("observer probe failed")
+
+// ErrObserverVersionTooLow signals that the observer runs a version below the minimum accepted one
+var ErrObserverVersionTooLow = errors.New("observer version too low")
+
+// ErrInvalidAppVersion signals that an app version could not be parsed
+var ErrInvalidAppVersion = errors.New("invalid app version")
+
+// ErrObserverShardMismatch signals that the shard reported by the observer differs from the declared one
+var ErrObserverShardMismatch = errors.New("observer shard mismatch")
+
+// ErrObserverShardDetectionFailed signals that the shard of an observer declared without one could not be detected
+var ErrObserverShardDetectionFailed = errors.New("observer shard detection failed")
+
+// ErrObserverNetworkMismatch signals that the observer reports a chain ID or a min transaction version different from
+// the expected ones
+var ErrObserverNetworkMismatch = errors.New("observer network mismatch")
+
+// ErrNoVerifiedObserverForWrite signals that all the observers of the shard are connected to another network
+var ErrNoVerifiedObserverForWrite = errors.New("no observer connected to the expected network")
+
+// ErrInvalidGasPriceStrategy signals that an invalid gas price suggestion strategy has been provided
+var ErrInvalidGasPriceStrategy = errors.New("invalid gas price suggestion strategy")
+
+// ErrInvalidCongestionThreshold signals that an invalid transactions pool congestion threshold has been provided
+var ErrInvalidCongestionThreshold = errors.New("invalid transactions pool congestion threshold")
+
+// ErrInvalidGasPriceMultiplier signals that an invalid gas price multiplier has been provided
+var ErrInvalidGasPriceMultiplier = errors.New("invalid gas price multiplier, should be at least 1")
+
+// ErrNoTransactionsPoolAvailable signals that the transactions pool could not be fetched from any shard
+var ErrNoTransactionsPoolAvailable = errors.New("transactions pool not available on any shard")
+
+// ErrEmptyReturnData signals that a smart contract query returned no data
+var ErrEmptyReturnData = errors.New("empty return data")
+
+// ErrNilShardIDCache signals that a nil shard IDs cache has been provided
+var ErrNilShardIDCache = errors.New("nil shard IDs cache")
+
+// ErrShardBlockNotNotarized signals that no block of a shard was found notarized up to the requested hyperblock
+var ErrShardBlockNotNotarized = errors.New("no shard block notarized up to the requested hyperblock")
+
+// ErrEmptyContractsList signals that an empty list of contracts has been provided
+var ErrEmptyContractsList = errors.New("empty contracts list")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrInvalidShardID signals that an invalid shard ID has been provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrNetworkStatusStreamerClosed signals that the network status streamer has been closed
+var ErrNetworkStatusStreamerClosed = errors.New("network status streamer closed")
+
+// ErrEndpointNotAllowed signals that the requested node endpoint is not in the passthrough allowlist
+var ErrEndpointNotAllowed = errors.New("endpoint not allowed")
+
+// ErrInvalidPassthroughEndpoint signals that an invalid endpoint has been provided in the passthrough allowlist
+var ErrInvalidPassthroughEndpoint = errors.New("invalid passthrough endpoint")
+
+// ErrInvalidPassthroughBody signals that the body of a passthrough request is not a valid JSON
+var ErrInvalidPassthroughBody = errors.New("invalid passthrough request body")
+
+// ErrNotACollection signals that the provided token is not an NFT, SFT or MetaESDT collection
+var ErrNotACollection = errors.New("token is not a collection")
+
+// ErrInsufficientGasLimit signals that the gas limit of a transaction does not cover its move balance cost
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrInvalidGasPriceModifier signals that the gas price modifier of the network config is invalid
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrNoExternalSignerURL signals that no URL of the external signer has been provided
+var ErrNoExternalSignerURL = errors.New("no external signer URL provided")
+
+// ErrNoFaucetSenderAddress signals that no faucet sender address has been provided
+var ErrNoFaucetSenderAddress = errors.New("no faucet sender address provided")
+
+// ErrExternalSignerUnavailable signals that none of the external signer instances could sign the transaction
+var ErrExternalSignerUnavailable = errors.New("external signer unavailable")
+
+// ErrInvalidExternalSignature signals that the signature returned by the external signer does not match the transaction
+var ErrInvalidExternalSignature = errors.New("invalid signature returned by the external signer")
+
+// ErrNilNetworkConfig signals that a nil network config has been provided
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrUnknownSignatureScheme signals that an unknown message signature scheme has been provided
+var ErrUnknownSignatureScheme = errors.New("unknown signature scheme")
+
+// ErrNilObserversLatencyProvider signals that a nil observers latency provider has been provided
+var ErrNilObserversLatencyProvider = errors.New("nil observers latency provider")
+
+// ErrInvalidSlowObserverFactor signals that an invalid slow observer factor has been provided
+var ErrInvalidSlowObserverFactor = errors.New("invalid slow observer factor, it should be greater than 1")
+
+// ErrNilObserversRanker signals that a nil observers ranker has been provided
+var ErrNilObserversRanker = errors.New("nil observers ranker")
+
+// ErrNilBlocksExportSource signals that a nil blocks export source has been provided
+var ErrNilBlocksExportSource = errors.New("nil blocks export source")
+
+// ErrEmptyBlocksExportDirectory signals that an empty blocks export directory has been provided
+var ErrEmptyBlocksExportDirectory = errors.New("empty blocks export directory")
+
+// ErrInvalidMaxBlocksPerExport signals that an invalid maximum number of blocks per export job has been provided
+var ErrInvalidMaxBlocksPerExport = errors.New("invalid maximum number of blocks per export job")
+
+// ErrInvalidMaxBlocksInMultiHashRequest signals that an invalid maximum number of blocks per multi-hash request has
+// been provided
+var ErrInvalidMaxBlocksInMultiHashRequest = errors.New("invalid maximum number of blocks per multi-hash request")
+
+// ErrNoBlocksRequested signals that no block has been requested
+var ErrNoBlocksRequested = errors.New("no block requested")
+
+// ErrEmptyBlockHash signals that a block has been requested with an empty hash
+var ErrEmptyBlockHash = errors.New("empty block hash")
+
+// ErrTooManyBlocksRequested signals that more blocks than allowed have been requested at once
+var ErrTooManyBlocksRequested = errors.New("too many blocks requested")
+
+// ErrInvalidBlocksExportFormat signals that an invalid blocks export format has been provided
+var ErrInvalidBlocksExportFormat = errors.New("invalid blocks export format")
+
+// ErrHyperblocksExportNotOnMetachain signals that the export of hyperblocks was requested for a shard other than the metachain
+var ErrHyperblocksExportNotOnMetachain = errors.New("hyperblocks can only be exported for the metachain")
+
+// ErrInvalidNotarizedHeadersRange signals that an invalid range of sovereign blocks has been provided
+var ErrInvalidNotarizedHeadersRange = errors.New("invalid notarized headers range")
+
+// ErrInvalidBlocksExportRange signals that an invalid range of blocks to be exported has been provided
+var ErrInvalidBlocksExportRange = errors.New("invalid blocks export range")
+
+// ErrBlocksExportAlreadyRunning signals that a blocks export job is already running
+var ErrBlocksExportAlreadyRunning = errors.New("a blocks export job is already running")
+
+// ErrBlocksExportInterrupted signals that the blocks export job has been interrupted
+var ErrBlocksExportInterrupted = errors.New("blocks export interrupted")
+
+// ErrInvalidExportedBlock signals that an observer returned a block which cannot be exported
+var ErrInvalidExportedBlock = errors.New("invalid block received for export")
+
+// ErrBlocksExportJobNotFound signals that the requested blocks export job does not exist
+var ErrBlocksExportJobNotFound = errors.New("blocks export job not found")
+
+// ErrNilExternalStorageConnector signals that a nil external storage connector has been provided
+var ErrNilExternalStorageConnector = errors.New("nil external storage connector")
+
+// ErrNoESDTTransfers signals that an ESDT transfer was requested without any token transfer
+var ErrNoESDTTransfers = errors.New("no ESDT transfers provided")
+
+// ErrInvalidESDTTransfer signals that an invalid token transfer has been provided
+var ErrInvalidESDTTransfer = errors.New("invalid ESDT transfer")
+
+// ErrMiniBlockNotFound signals that the requested miniblock was not found
+var ErrMiniBlockNotFound = errors.New("miniblock not found")
+
+// ErrNilTokenPriceProvider signals that a nil token price provider has been provided
+var ErrNilTokenPriceProvider = errors.New("nil token price provider")
+
+// ErrEmptyToken signals that an empty token identifier has been provided
+var ErrEmptyToken = errors.New("empty token identifier")
+
+// ErrInvalidAmount signals that an invalid amount has been provided
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// ErrUnknownTokenPriceProviderType signals that an unknown token price provider type has been configured
+var ErrUnknownTokenPriceProviderType = errors.New("unknown token price provider type")
+
+// ErrNilNodesStateChecker signals that a nil nodes state checker has been provided
+var ErrNilNodesStateChecker = errors.New("nil nodes state checker")
+
+// ErrInvalidMaxNodesStateCheckDelay signals that an invalid maximum delay of the nodes state checks has been provided
+var ErrInvalidMaxNodesStateCheckDelay = errors.New("invalid maximum delay of the nodes state checks")
+
+// ErrNilObserverEventsNotifier signals that a nil observer events notifier has been provided
+var ErrNilObserverEventsNotifier = errors.New("nil observer events notifier")
+
+// ErrNilObserversAffinityCache signals that a nil observers affinity cache has been provided
+var ErrNilObserversAffinityCache = errors.New("nil observers affinity cache")
+
+// ErrNoWebhookURL signals that no webhook URL has been provided
+var ErrNoWebhookURL = errors.New("no webhook URL provided")
+
+// ErrInvalidWebhookFormat signals that an invalid webhook format has been provided
+var ErrInvalidWebhookFormat = errors.New("invalid webhook format")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrNilTransactionsPolicyChecker signals that a nil transactions policy checker has been provided
+var ErrNilTransactionsPolicyChecker = errors.New("nil transactions policy checker")
+
+// ErrInvalidMaxTransactionValue signals that an invalid maximum transaction value has been provided
+var ErrInvalidMaxTransactionValue = errors.New("invalid maximum transaction value")
+
+// ErrInvalidMaxDataFieldSize signals that an invalid maximum data field size has been provided
+var ErrInvalidMaxDataFieldSize = errors.New("invalid maximum data field size")
+
+// ErrNilEventsABIRegistry signals that a nil events ABI registry has been provided
+var ErrNilEventsABIRegistry = errors.New("nil events ABI registry")
+
+// ErrInvalidBLSKey signals that an invalid BLS key has been provided
+var ErrInvalidBLSKey = errors.New("invalid BLS key")
+
+// ErrNilValidatorStatisticsProvider signals that a nil validator statistics provider has been provided
+var ErrNilValidatorStatisticsProvider = errors.New("nil validator statistics provider")
+
+// ErrNilFaucetTransactionsGenerator signals that a nil faucet transactions generator has been provided
+var ErrNilFaucetTransactionsGenerator = errors.New("nil faucet transactions generator")
+
+// ErrNilAccountProvider signals that a nil account provider has been provided
+var ErrNilAccountProvider = errors.New("nil account provider")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilTransactionSender signals that a nil transaction sender has been provided
+var ErrNilTransactionSender = errors.New("nil transaction sender")
+
+// ErrInvalidMaxPendingFaucetRequests signals that an invalid maximum number of pending faucet requests has been provided
+var ErrInvalidMaxPendingFaucetRequests = errors.New("invalid maximum number of pending faucet requests")
+
+// ErrInvalidFaucetRequestMaxAttempts signals that an invalid maximum number of attempts for a faucet request has been
+// provided
+var ErrInvalidFaucetRequestMaxAttempts = errors.New("invalid maximum number of attempts for a faucet request")
+
+// ErrInvalidFaucetRequestRetryDelay signals that an invalid retry delay for the faucet requests has been provided
+var ErrInvalidFaucetRequestRetryDelay = errors.New("invalid retry delay for the faucet requests")
+
+// ErrInvalidFaucetRequestsRetention signals that an invalid retention duration for the faucet requests has been provided
+var ErrInvalidFaucetRequestsRetention = errors.New("invalid retention duration for the faucet requests")
+
+// ErrFaucetQueueFull signals that the faucet request cannot be queued because too many requests are pending
+var ErrFaucetQueueFull = errors.New("too many pending faucet requests, try again later")
+
+// ErrFaucetRequestNotFound signals that the requested faucet request does not exist
+var ErrFaucetRequestNotFound = errors.New("faucet request not found")
+
+// ErrInvalidTransactionsPoolChunkSize signals that an invalid size of the transactions pool chunks has been provided
+var ErrInvalidTransactionsPoolChunkSize = errors.New("invalid transactions pool chunk size")
+
+// ErrNilCacheSnapshotPersister signals that a nil cache snapshot persister has been provided
+var ErrNilCacheSnapshotPersister = errors.New("nil cache snapshot persister")
+
+// ErrNilHttpTransport signals that a nil http transport has been provided
+var ErrNilHttpTransport = errors.New("nil http transport")
+
+// ErrEmptyObserverHeaderName signals that a header to be added to the observer requests has an empty name
+var ErrEmptyObserverHeaderName = errors.New("empty observer header name")
+
+// ErrTransactionHashNotVerifiable signals that the hash of a transaction can not be computed from its returned fields
+var ErrTransactionHashNotVerifiable = errors.New("the hash of the transaction can not be verified")
+
+// ErrInvalidAddressPrefix signals that the provided address has a different prefix than the one of the chain
+var ErrInvalidAddressPrefix = errors.New("invalid address prefix")
+
+// ErrUnknownPubkeyConverterType signals that an unknown type of public keys converter has been provided
+var ErrUnknownPubkeyConverterType = errors.New("unknown public keys converter type")
+
+// ErrNilEconomicsMetricsProvider signals that a nil economics metrics provider has been provided
+var ErrNilEconomicsMetricsProvider = errors.New("nil economics metrics provider")
+
+// ErrMissingEconomicsMetric signals that a metric is missing from the economics metrics
+var ErrMissingEconomicsMetric = errors.New("missing economics metric")
+
+// ErrInvalidDelegationContractConfig signals that the configuration returned by a delegation contract is invalid
+var ErrInvalidDelegationContractConfig = errors.New("invalid delegation contract config")
+
+// ErrEmptyRegistryURL signals that no uptime registry URL has been provided
+var ErrEmptyRegistryURL = errors.New("empty uptime registry URL")
+
+// ErrNilPayloadSigner signals that a nil payload signer has been provided
+var ErrNilPayloadSigner = errors.New("nil payload signer")
+
+// ErrInvalidHeartbeatInterval signals that an invalid interval between the uptime heartbeats has been provided
+var ErrInvalidHeartbeatInterval = errors.New("invalid uptime heartbeat interval")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyDataField signals that an empty transaction data field has been provided
+var ErrEmptyDataField = errors.New("empty data field")
+
+// ErrInvalidDataFieldArgument signals that an argument of a transaction data field is not hex encoded
+var ErrInvalidDataFieldArgument = errors.New("invalid data field argument")
+
+// ErrInvalidClientsUsageFlushInterval signals that an invalid flush interval of the clients usage has been provided
+var ErrInvalidClientsUsageFlushInterval = errors.New("invalid clients usage flush interval")
+
+// ErrInvalidClientsUsageRetention signals that an invalid retention duration of the clients usage has been provided
+var ErrInvalidClientsUsageRetention = errors.New("invalid clients usage retention duration")
+
+// ErrEmptyClientApiKey signals that an empty client API key has been provided
+var ErrEmptyClientApiKey = errors.New("empty client API key")
+
+// ErrInvalidClientsUsageInterval signals that the end of the requested usage interval is before its start
+var ErrInvalidClientsUsageInterval = errors.New("invalid clients usage interval: the end is before the start")
+
+// ErrNilDataFreshnessChecker signals that a nil data freshness checker has been provided
+var ErrNilDataFreshnessChecker = errors.New("nil data freshness checker")
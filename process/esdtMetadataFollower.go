@@ -0,0 +1,250 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// the functions of the ESDT system smart contract issuing a new token, whose identifier is only known from the logs
+var esdtIssuanceFunctions = map[string]struct{}{
+	"issue":                         {},
+	"issueSemiFungible":             {},
+	"issueNonFungible":              {},
+	"registerMetaESDT":              {},
+	"registerAndSetAllRoles":        {},
+	"registerDynamic":               {},
+	"registerAndSetAllRolesDynamic": {},
+}
+
+// the events emitted when the roles of an account change, holding the token identifier as first topic. They are only seen
+// for the accounts of the followed shard, the calls to the ESDT system smart contract covering the others
+var esdtRolesEvents = map[string]struct{}{
+	core.BuiltInFunctionSetESDTRole:               {},
+	core.BuiltInFunctionUnSetESDTRole:             {},
+	core.BuiltInFunctionESDTNFTCreateRoleTransfer: {},
+}
+
+// ArgsESDTMetadataFollower holds the arguments needed to create an ESDTMetadataFollower
+type ArgsESDTMetadataFollower struct {
+	Proc              Processor
+	BlocksSource      BlockByNonceProvider
+	ESDTMetadataCache ESDTMetadataCache
+	PollInterval      time.Duration
+	MaxBlocksPerPoll  uint64
+}
+
+// ESDTMetadataFollower follows the blocks of the shard holding the ESDT system smart contract and invalidates the cached
+// metadata of the tokens which are issued, upgraded or whose roles change
+type ESDTMetadataFollower struct {
+	proc              Processor
+	blocksSource      BlockByNonceProvider
+	esdtMetadataCache ESDTMetadataCache
+	pollInterval      time.Duration
+	maxBlocksPerPoll  uint64
+	esdtAddress       string
+	esdtShardID       uint32
+	lastNonce         uint64
+	isSynced          bool
+	cancelFunc        func()
+}
+
+// NewESDTMetadataFollower creates a new instance of ESDTMetadataFollower and starts following the blocks from the
+// current chain head
+func NewESDTMetadataFollower(args ArgsESDTMetadataFollower) (*ESDTMetadataFollower, error) {
+	err := checkESDTMetadataFollowerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtAddressBytes, _ := hex.DecodeString(common.ESDTContractAddressHex)
+	esdtShardID, err := args.Proc.ComputeShardId(esdtAddressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	emf := &ESDTMetadataFollower{
+		proc:              args.Proc,
+		blocksSource:      args.BlocksSource,
+		esdtMetadataCache: args.ESDTMetadataCache,
+		pollInterval:      args.PollInterval,
+		maxBlocksPerPoll:  args.MaxBlocksPerPoll,
+		esdtAddress:       encodeSystemAddress(args.Proc.GetPubKeyConverter(), common.ESDTContractAddressHex),
+		esdtShardID:       esdtShardID,
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	emf.cancelFunc = cancelFunc
+	go emf.followBlocks(ctx)
+
+	return emf, nil
+}
+
+func checkESDTMetadataFollowerArgs(args ArgsESDTMetadataFollower) error {
+	if check.IfNil(args.Proc) {
+		return ErrNilCoreProcessor
+	}
+	if check.IfNil(args.BlocksSource) {
+		return ErrNilBlockByNonceProvider
+	}
+	if check.IfNil(args.ESDTMetadataCache) {
+		return ErrNilESDTMetadataCache
+	}
+	if args.PollInterval <= 0 {
+		return ErrInvalidPollInterval
+	}
+	if args.MaxBlocksPerPoll == 0 {
+		return ErrInvalidMaxBlocksPerPoll
+	}
+
+	return nil
+}
+
+func (emf *ESDTMetadataFollower) followBlocks(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			log.WithContext(ctx).Debug("ESDT metadata follower: stopped")
+			return
+		}
+
+		emf.processNewBlocks(ctx)
+		timer.Reset(emf.pollInterval)
+	}
+}
+
+// processNewBlocks invalidates the tokens changed in the blocks produced since the previous poll. If the follower is
+// too far behind, as after the observers were unreachable for a while, the whole cache is invalidated instead
+func (emf *ESDTMetadataFollower) processNewBlocks(ctx context.Context) {
+	latestNonce, err := emf.fetchLatestNonce(ctx)
+	if err != nil {
+		log.WithContext(ctx).Debug("ESDT metadata follower: cannot fetch the latest nonce", "shard", emf.esdtShardID, "error", err)
+		return
+	}
+
+	if !emf.isSynced || latestNonce > emf.lastNonce+emf.maxBlocksPerPoll {
+		log.WithContext(ctx).Debug("ESDT metadata follower: invalidating all the tokens", "shard", emf.esdtShardID, "nonce", latestNonce)
+		emf.esdtMetadataCache.InvalidateAll()
+		emf.lastNonce = latestNonce
+		emf.isSynced = true
+		return
+	}
+
+	for nonce := emf.lastNonce + 1; nonce <= latestNonce; nonce++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err = emf.processBlock(ctx, nonce)
+		if err != nil {
+			// the block is processed again on the next poll
+			log.WithContext(ctx).Debug("ESDT metadata follower: cannot fetch the block", "shard", emf.esdtShardID, "nonce", nonce, "error", err)
+			return
+		}
+
+		emf.lastNonce = nonce
+	}
+}
+
+func (emf *ESDTMetadataFollower) fetchLatestNonce(ctx context.Context) (uint64, error) {
+	observers, err := emf.proc.GetObservers(emf.esdtShardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	response := data.NetworkStatusApiResponse{}
+	for _, observer := range observers {
+		_, err = emf.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &response)
+		if err != nil {
+			log.WithContext(ctx).Debug("ESDT metadata follower network status request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return response.Data.Status.Nonce, nil
+	}
+
+	return 0, WrapObserversError(response.Error)
+}
+
+func (emf *ESDTMetadataFollower) processBlock(ctx context.Context, nonce uint64) error {
+	response, err := emf.blocksSource.GetBlockByNonce(ctx, emf.esdtShardID, nonce, common.BlockQueryOptions{
+		WithTransactions: true,
+		WithLogs:         true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, miniBlock := range response.Data.Block.MiniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		for _, tx := range miniBlock.Transactions {
+			for _, token := range emf.getChangedTokens(tx) {
+				log.WithContext(ctx).Debug("ESDT metadata follower: invalidating token", "token", token, "nonce", nonce)
+				emf.esdtMetadataCache.Invalidate(token)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getChangedTokens returns the tokens changed by the provided transaction: the one passed as first argument of a call
+// to the ESDT system smart contract and the ones in the events emitted by the contract or by the roles changes
+func (emf *ESDTMetadataFollower) getChangedTokens(tx *transaction.ApiTransactionResult) []string {
+	if tx == nil {
+		return nil
+	}
+
+	tokens := make([]string, 0)
+	if tx.Receiver == emf.esdtAddress {
+		function, arguments, _ := strings.Cut(string(tx.Data), "@")
+		_, isIssuance := esdtIssuanceFunctions[function]
+		firstArgument, _, _ := strings.Cut(arguments, "@")
+		token, err := hex.DecodeString(firstArgument)
+		if !isIssuance && err == nil && len(token) > 0 {
+			tokens = append(tokens, string(token))
+		}
+	}
+
+	if tx.Logs == nil {
+		return tokens
+	}
+	for _, event := range tx.Logs.Events {
+		if event == nil || len(event.Topics) == 0 || len(event.Topics[0]) == 0 {
+			continue
+		}
+
+		_, isRolesEvent := esdtRolesEvents[event.Identifier]
+		if event.Address == emf.esdtAddress || isRolesEvent {
+			tokens = append(tokens, string(event.Topics[0]))
+		}
+	}
+
+	return tokens
+}
+
+// Close stops following the blocks
+func (emf *ESDTMetadataFollower) Close() error {
+	emf.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (emf *ESDTMetadataFollower) IsInterfaceNil() bool {
+	return emf == nil
+}
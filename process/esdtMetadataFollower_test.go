@@ -0,0 +1,280 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type esdtMetadataInvalidations struct {
+	tokens           []string
+	numInvalidateAll int
+	mut              sync.Mutex
+}
+
+func (emi *esdtMetadataInvalidations) get() ([]string, int) {
+	emi.mut.Lock()
+	defer emi.mut.Unlock()
+
+	return append([]string{}, emi.tokens...), emi.numInvalidateAll
+}
+
+func createESDTMetadataCacheStub(invalidations *esdtMetadataInvalidations) *mock.ESDTMetadataCacheStub {
+	return &mock.ESDTMetadataCacheStub{
+		InvalidateCalled: func(token string) {
+			invalidations.mut.Lock()
+			invalidations.tokens = append(invalidations.tokens, token)
+			invalidations.mut.Unlock()
+		},
+		InvalidateAllCalled: func() {
+			invalidations.mut.Lock()
+			invalidations.numInvalidateAll++
+			invalidations.mut.Unlock()
+		},
+	}
+}
+
+func createESDTMetadataFollowerProcessorStub(getLatestNonce func() uint64) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return core.MetachainShardId, nil
+		},
+		GetPubKeyConverterCalled: func() core.PubkeyConverter {
+			return testPubkeyConverter
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			response := value.(*data.NetworkStatusApiResponse)
+			response.Data.Status.Nonce = getLatestNonce()
+			return 200, nil
+		},
+	}
+}
+
+func createArgsESDTMetadataFollower() process.ArgsESDTMetadataFollower {
+	return process.ArgsESDTMetadataFollower{
+		Proc: createESDTMetadataFollowerProcessorStub(func() uint64 {
+			return 10
+		}),
+		BlocksSource:      &mock.BlocksExportSourceStub{},
+		ESDTMetadataCache: &mock.ESDTMetadataCacheStub{},
+		PollInterval:      5 * time.Millisecond,
+		MaxBlocksPerPoll:  10,
+	}
+}
+
+func TestNewESDTMetadataFollower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsESDTMetadataFollower()
+		args.Proc = nil
+		emf, err := process.NewESDTMetadataFollower(args)
+		require.Nil(t, emf)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil blocks source should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsESDTMetadataFollower()
+		args.BlocksSource = nil
+		emf, err := process.NewESDTMetadataFollower(args)
+		require.Nil(t, emf)
+		require.Equal(t, process.ErrNilBlockByNonceProvider, err)
+	})
+	t.Run("nil ESDT metadata cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsESDTMetadataFollower()
+		args.ESDTMetadataCache = nil
+		emf, err := process.NewESDTMetadataFollower(args)
+		require.Nil(t, emf)
+		require.Equal(t, process.ErrNilESDTMetadataCache, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsESDTMetadataFollower()
+		args.PollInterval = 0
+		emf, err := process.NewESDTMetadataFollower(args)
+		require.Nil(t, emf)
+		require.Equal(t, process.ErrInvalidPollInterval, err)
+	})
+	t.Run("invalid max blocks per poll should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsESDTMetadataFollower()
+		args.MaxBlocksPerPoll = 0
+		emf, err := process.NewESDTMetadataFollower(args)
+		require.Nil(t, emf)
+		require.Equal(t, process.ErrInvalidMaxBlocksPerPoll, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		emf, err := process.NewESDTMetadataFollower(createArgsESDTMetadataFollower())
+		require.NoError(t, err)
+		require.False(t, emf.IsInterfaceNil())
+		require.NoError(t, emf.Close())
+	})
+}
+
+func TestESDTMetadataFollower_ShouldInvalidateTheChangedTokens(t *testing.T) {
+	t.Parallel()
+
+	esdtAddressBytes, _ := hex.DecodeString("000000000000000000010000000000000000000000000000000000000002ffff")
+	esdtAddress := testPubkeyConverter.SilentEncode(esdtAddressBytes, nil)
+
+	blocks := map[uint64][]*transaction.ApiTransactionResult{
+		11: {
+			{Receiver: esdtAddress, Data: []byte("setSpecialRole@" + hex.EncodeToString([]byte("ROLE-abcdef")) + "@01@02")},
+			{Receiver: "erd1other", Data: []byte("setSpecialRole@" + hex.EncodeToString([]byte("OTHER-abcdef")))},
+		},
+		12: {
+			{
+				Receiver: esdtAddress,
+				Data:     []byte("issue@" + hex.EncodeToString([]byte("Token")) + "@" + hex.EncodeToString([]byte("TKN"))),
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Address: esdtAddress, Identifier: "issue", Topics: [][]byte{[]byte("TKN-abcdef"), []byte("Token")}},
+						{Address: "erd1other", Identifier: "transfer", Topics: [][]byte{[]byte("IGNORED-abcdef")}},
+					},
+				},
+			},
+		},
+		13: {
+			{
+				Receiver: "erd1account",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Address: "erd1account", Identifier: core.BuiltInFunctionSetESDTRole, Topics: [][]byte{[]byte("SHARD-abcdef")}},
+					},
+				},
+			},
+		},
+	}
+
+	var mutNonce sync.Mutex
+	latestNonce := uint64(10)
+	getLatestNonce := func() uint64 {
+		mutNonce.Lock()
+		defer mutNonce.Unlock()
+
+		return latestNonce
+	}
+
+	requestedNonces := make(map[uint64]int)
+	failedOnce := false
+	invalidations := &esdtMetadataInvalidations{}
+	args := createArgsESDTMetadataFollower()
+	args.Proc = createESDTMetadataFollowerProcessorStub(getLatestNonce)
+	args.ESDTMetadataCache = createESDTMetadataCacheStub(invalidations)
+	args.BlocksSource = &mock.BlocksExportSourceStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			require.Equal(t, core.MetachainShardId, shardID)
+			require.True(t, options.WithTransactions)
+			require.True(t, options.WithLogs)
+
+			invalidations.mut.Lock()
+			requestedNonces[nonce]++
+			shouldFail := nonce == 12 && !failedOnce
+			failedOnce = failedOnce || shouldFail
+			invalidations.mut.Unlock()
+			if shouldFail {
+				return nil, errors.New("observers down")
+			}
+
+			response := &data.BlockApiResponse{}
+			response.Data.Block = api.Block{
+				Nonce:      nonce,
+				MiniBlocks: []*api.MiniBlock{{Transactions: blocks[nonce]}},
+			}
+			return response, nil
+		},
+	}
+
+	emf, _ := process.NewESDTMetadataFollower(args)
+	defer func() {
+		_ = emf.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		_, numInvalidateAll := invalidations.get()
+		return numInvalidateAll == 1
+	}, time.Second, time.Millisecond)
+
+	mutNonce.Lock()
+	latestNonce = 13
+	mutNonce.Unlock()
+
+	require.Eventually(t, func() bool {
+		tokens, _ := invalidations.get()
+		return len(tokens) == 3
+	}, time.Second, time.Millisecond)
+
+	tokens, numInvalidateAll := invalidations.get()
+	require.Equal(t, []string{"ROLE-abcdef", "TKN-abcdef", "SHARD-abcdef"}, tokens)
+	require.Equal(t, 1, numInvalidateAll)
+
+	invalidations.mut.Lock()
+	require.Equal(t, map[uint64]int{11: 1, 12: 2, 13: 1}, requestedNonces)
+	invalidations.mut.Unlock()
+}
+
+func TestESDTMetadataFollower_TooManyNewBlocksShouldInvalidateAll(t *testing.T) {
+	t.Parallel()
+
+	var mutNonce sync.Mutex
+	latestNonce := uint64(10)
+	getLatestNonce := func() uint64 {
+		mutNonce.Lock()
+		defer mutNonce.Unlock()
+
+		return latestNonce
+	}
+
+	invalidations := &esdtMetadataInvalidations{}
+	args := createArgsESDTMetadataFollower()
+	args.Proc = createESDTMetadataFollowerProcessorStub(getLatestNonce)
+	args.ESDTMetadataCache = createESDTMetadataCacheStub(invalidations)
+	args.BlocksSource = &mock.BlocksExportSourceStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			require.Fail(t, "no block should be requested")
+			return nil, nil
+		},
+	}
+
+	emf, _ := process.NewESDTMetadataFollower(args)
+	defer func() {
+		_ = emf.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		_, numInvalidateAll := invalidations.get()
+		return numInvalidateAll == 1
+	}, time.Second, time.Millisecond)
+
+	mutNonce.Lock()
+	latestNonce = 21
+	mutNonce.Unlock()
+
+	require.Eventually(t, func() bool {
+		_, numInvalidateAll := invalidations.get()
+		return numInvalidateAll == 2
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,101 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// gasPerMultiESDTNFTTransfer is the gas needed by the built-in function for each transferred token
+	gasPerMultiESDTNFTTransfer = 200000
+	// extraGasForMultiESDTNFTTransfer covers the execution of the built-in function on the destination shard
+	extraGasForMultiESDTNFTTransfer = 800000
+	// zeroTransferValue is the EGLD value of an ESDT transfer, the tokens being moved by the built-in function
+	zeroTransferValue = "0"
+)
+
+// BuildESDTTransfer builds the unsigned transaction which transfers the provided tokens from the sender to the
+// receiver. The tokens are moved with the MultiESDTNFTTransfer built-in function, so the transaction is sent to the
+// sender itself and the receiver is encoded in the data field. The gas limit covers the data field and the cost of
+// the built-in function for each transfer, while the gas price defaults to the minimum one of the network
+func (tp *TransactionProcessor) BuildESDTTransfer(request *data.ESDTTransferBuildRequest, networkConfig *data.NetworkConfig) (*data.ESDTTransferBuildResult, error) {
+	_, err := tp.pubKeyConverter.Decode(request.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender: %s", ErrInvalidAddress, err.Error())
+	}
+	receiver, err := tp.pubKeyConverter.Decode(request.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver: %s", ErrInvalidAddress, err.Error())
+	}
+
+	dataField, err := buildMultiESDTNFTTransferData(receiver, request.Transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := request.GasPrice
+	if gasPrice == 0 {
+		gasPrice = networkConfig.Config.MinGasPrice
+	}
+
+	tx := &data.Transaction{
+		Nonce:    request.Nonce,
+		Value:    zeroTransferValue,
+		Receiver: request.Sender,
+		Sender:   request.Sender,
+		GasPrice: gasPrice,
+		Data:     []byte(dataField),
+		ChainID:  networkConfig.Config.ChainID,
+		Version:  networkConfig.Config.MinTransactionVersion,
+	}
+	tx.GasLimit = computeMoveBalanceGas(tx, networkConfig) +
+		gasPerMultiESDTNFTTransfer*uint64(len(request.Transfers)) + extraGasForMultiESDTNFTTransfer
+
+	return &data.ESDTTransferBuildResult{
+		Transaction: tx,
+		Data:        dataField,
+	}, nil
+}
+
+// buildMultiESDTNFTTransferData encodes the data field of the built-in function: the destination and the number of
+// transfers, followed by the token identifier, nonce and amount of each transfer, all hex encoded
+func buildMultiESDTNFTTransferData(receiver []byte, transfers []*data.ESDTTransfer) (string, error) {
+	if len(transfers) == 0 {
+		return "", ErrNoESDTTransfers
+	}
+
+	arguments := []string{
+		core.BuiltInFunctionMultiESDTNFTTransfer,
+		hex.EncodeToString(receiver),
+		encodeUint64Argument(uint64(len(transfers))),
+	}
+	for idx, transfer := range transfers {
+		if transfer == nil || len(transfer.Token) == 0 {
+			return "", fmt.Errorf("%w: transfer %d has no token", ErrInvalidESDTTransfer, idx)
+		}
+
+		amount, ok := big.NewInt(0).SetString(transfer.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return "", fmt.Errorf("%w: transfer %d has an invalid amount %q", ErrInvalidESDTTransfer, idx, transfer.Amount)
+		}
+
+		arguments = append(arguments,
+			hex.EncodeToString([]byte(transfer.Token)),
+			encodeUint64Argument(transfer.Nonce),
+			hex.EncodeToString(amount.Bytes()),
+		)
+	}
+
+	return strings.Join(arguments, "@"), nil
+}
+
+// encodeUint64Argument hex encodes the value using the minimum number of bytes, a zero value being encoded as an
+// empty argument
+func encodeUint64Argument(value uint64) string {
+	return hex.EncodeToString(big.NewInt(0).SetUint64(value).Bytes())
+}
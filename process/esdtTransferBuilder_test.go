@@ -0,0 +1,112 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createESDTTransferBuildRequest() *data.ESDTTransferBuildRequest {
+	return &data.ESDTTransferBuildRequest{
+		Sender:   validationSender,
+		Receiver: validationReceiver,
+		Nonce:    7,
+		Transfers: []*data.ESDTTransfer{
+			{Token: "WEGLD-bd4d79", Amount: "1000000000000000000"},
+			{Token: "NFT-abcdef", Nonce: 5, Amount: "1"},
+		},
+	}
+}
+
+func TestTransactionProcessor_BuildESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	tp := createValidationTransactionProcessor(t)
+
+	t.Run("should build a valid multi transfer", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createValidationNetworkConfig()
+		result, err := tp.BuildESDTTransfer(createESDTTransferBuildRequest(), networkConfig)
+		require.Nil(t, err)
+
+		receiver, _ := testPubkeyConverter.Decode(validationReceiver)
+		expectedData := "MultiESDTNFTTransfer@" + hex.EncodeToString(receiver) + "@02" +
+			"@" + hex.EncodeToString([]byte("WEGLD-bd4d79")) + "@@0de0b6b3a7640000" +
+			"@" + hex.EncodeToString([]byte("NFT-abcdef")) + "@05@01"
+		assert.Equal(t, expectedData, result.Data)
+		assert.Equal(t, []byte(expectedData), result.Transaction.Data)
+
+		expectedGasLimit := uint64(50000+1500*len(expectedData)) + 2*200000 + 800000
+		assert.Equal(t, expectedGasLimit, result.Transaction.GasLimit)
+		assert.Equal(t, validationSender, result.Transaction.Sender)
+		assert.Equal(t, validationSender, result.Transaction.Receiver)
+		assert.Equal(t, "0", result.Transaction.Value)
+		assert.Equal(t, uint64(7), result.Transaction.Nonce)
+		assert.Equal(t, networkConfig.Config.MinGasPrice, result.Transaction.GasPrice)
+		assert.Equal(t, "T", result.Transaction.ChainID)
+		assert.Equal(t, uint32(1), result.Transaction.Version)
+		assert.Empty(t, result.Transaction.Signature)
+
+		// the built transaction should pass the static validation, apart from the missing signature
+		result.Transaction.Signature = createValidTransaction().Signature
+		validation := tp.ValidateTransaction(result.Transaction, networkConfig)
+		assert.True(t, validation.Valid, validation.Problems)
+	})
+	t.Run("provided gas price should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		request := createESDTTransferBuildRequest()
+		request.GasPrice = 2000000000
+		result, err := tp.BuildESDTTransfer(request, createValidationNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2000000000), result.Transaction.GasPrice)
+	})
+	t.Run("invalid addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := createESDTTransferBuildRequest()
+		request.Sender = "invalid"
+		result, err := tp.BuildESDTTransfer(request, createValidationNetworkConfig())
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+
+		request = createESDTTransferBuildRequest()
+		request.Receiver = "invalid"
+		result, err = tp.BuildESDTTransfer(request, createValidationNetworkConfig())
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("no transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := createESDTTransferBuildRequest()
+		request.Transfers = nil
+		result, err := tp.BuildESDTTransfer(request, createValidationNetworkConfig())
+		assert.Nil(t, result)
+		assert.Equal(t, process.ErrNoESDTTransfers, err)
+	})
+	t.Run("invalid transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidTransfers := []*data.ESDTTransfer{
+			nil,
+			{Amount: "1"},
+			{Token: "WEGLD-bd4d79", Amount: "0"},
+			{Token: "WEGLD-bd4d79", Amount: "-1"},
+			{Token: "WEGLD-bd4d79", Amount: "1.5"},
+		}
+		for _, transfer := range invalidTransfers {
+			request := createESDTTransferBuildRequest()
+			request.Transfers = append(request.Transfers, transfer)
+			result, err := tp.BuildESDTTransfer(request, createValidationNetworkConfig())
+			assert.Nil(t, result)
+			assert.True(t, errors.Is(err, process.ErrInvalidESDTTransfer))
+		}
+	})
+}
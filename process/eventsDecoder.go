@@ -0,0 +1,125 @@
+package process
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DecodeTransactionEvents decodes the topics of the well-known events logged by the transaction and by its smart
+// contract results, such as the token transfers, the contract deployments or the smart contract errors, along with
+// the events declared by the registered contract ABIs. The events are only available if the transaction was fetched
+// along with its results
+func (tp *TransactionProcessor) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) []*data.TransactionDecodedEvent {
+	if tx == nil {
+		return nil
+	}
+
+	allLogs := []*transaction.ApiLogs{tx.Logs}
+	for _, scr := range tx.SmartContractResults {
+		allLogs = append(allLogs, scr.Logs)
+	}
+
+	decodedEvents := make([]*data.TransactionDecodedEvent, 0)
+	for _, logs := range allLogs {
+		if logs == nil {
+			continue
+		}
+
+		for _, event := range logs.Events {
+			decodedEvent := tp.decodeEvent(event)
+			if decodedEvent != nil {
+				decodedEvents = append(decodedEvents, decodedEvent)
+			}
+		}
+	}
+
+	return decodedEvents
+}
+
+func (tp *TransactionProcessor) decodeEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	if event == nil {
+		return nil
+	}
+
+	abiDecodedEvent := tp.eventsABIRegistry.DecodeEvent(event)
+	if abiDecodedEvent != nil {
+		return abiDecodedEvent
+	}
+
+	switch event.Identifier {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		return tp.decodeTransferEvent(event)
+	case core.SCDeployIdentifier:
+		return tp.decodeSCDeployEvent(event)
+	case core.SignalErrorOperation:
+		return tp.decodeSignalErrorEvent(event)
+	default:
+		return nil
+	}
+}
+
+// decodeTransferEvent decodes the topics [<token>@<nonce>@<amount>]...@<receiver>
+func (tp *TransactionProcessor) decodeTransferEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	numTopics := len(event.Topics)
+	if numTopics < 4 || (numTopics-1)%3 != 0 {
+		return nil
+	}
+
+	decodedEvent := &data.TransactionDecodedEvent{
+		Identifier: event.Identifier,
+		Address:    event.Address,
+		Sender:     event.Address,
+		Receiver:   tp.decodeAddressTopic(event.Topics[numTopics-1]),
+		Tokens:     make([]*data.TransactionOperationToken, 0, numTopics/3),
+	}
+	for idx := 0; idx < numTopics-1; idx += 3 {
+		token := newTransactionOperationToken(
+			string(event.Topics[idx]),
+			big.NewInt(0).SetBytes(event.Topics[idx+1]).Uint64(),
+			big.NewInt(0).SetBytes(event.Topics[idx+2]).String(),
+		)
+		decodedEvent.Tokens = append(decodedEvent.Tokens, token)
+	}
+
+	return decodedEvent
+}
+
+// decodeSCDeployEvent decodes the topics <contract address>@<deployer>[@<code hash>]
+func (tp *TransactionProcessor) decodeSCDeployEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	if len(event.Topics) < 2 {
+		return nil
+	}
+
+	return &data.TransactionDecodedEvent{
+		Identifier:      event.Identifier,
+		Address:         event.Address,
+		ContractAddress: tp.decodeAddressTopic(event.Topics[0]),
+		Deployer:        tp.decodeAddressTopic(event.Topics[1]),
+	}
+}
+
+// decodeSignalErrorEvent decodes the topics <sender>@<error message>
+func (tp *TransactionProcessor) decodeSignalErrorEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	if len(event.Topics) < 2 {
+		return nil
+	}
+
+	return &data.TransactionDecodedEvent{
+		Identifier: event.Identifier,
+		Address:    event.Address,
+		Sender:     tp.decodeAddressTopic(event.Topics[0]),
+		Message:    string(event.Topics[1]),
+	}
+}
+
+func (tp *TransactionProcessor) decodeAddressTopic(topic []byte) string {
+	if len(topic) != tp.pubKeyConverter.Len() {
+		return hex.EncodeToString(topic)
+	}
+
+	return tp.encodeAddress(topic)
+}
@@ -0,0 +1,157 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addressBytes(address string) []byte {
+	decoded, _ := testPubkeyConverter.Decode(address)
+	return decoded
+}
+
+func TestTransactionProcessor_DecodeTransactionEvents(t *testing.T) {
+	t.Parallel()
+
+	tp := createValidationTransactionProcessor(t)
+
+	t.Run("nil or without logs transaction should return no events", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, tp.DecodeTransactionEvents(nil))
+		assert.Empty(t, tp.DecodeTransactionEvents(&transaction.ApiTransactionResult{}))
+	})
+	t.Run("unknown or malformed events should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvents := tp.DecodeTransactionEvents(&transaction.ApiTransactionResult{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					nil,
+					{Identifier: "writeLog", Topics: [][]byte{[]byte("topic")}},
+					{Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("WEGLD-bd4d79"), {}, {10}}},
+					{Identifier: "SCDeploy", Topics: [][]byte{addressBytes(validationReceiver)}},
+					{Identifier: "signalError"},
+				},
+			},
+		})
+		assert.Empty(t, decodedEvents)
+	})
+	t.Run("well-known events of the transaction and its results should be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		decodedEvents := tp.DecodeTransactionEvents(&transaction.ApiTransactionResult{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Address:    validationSender,
+						Identifier: "ESDTTransfer",
+						Topics:     [][]byte{[]byte("WEGLD-bd4d79"), {}, {0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, addressBytes(validationReceiver)},
+					},
+					{
+						Address:    validationReceiver,
+						Identifier: "SCDeploy",
+						Topics:     [][]byte{addressBytes(validationReceiver), addressBytes(validationSender), []byte("code hash")},
+					},
+				},
+			},
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{},
+				{
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{
+							{
+								Address:    validationReceiver,
+								Identifier: "MultiESDTNFTTransfer",
+								Topics: [][]byte{
+									[]byte("NFT-123456"), {0x0a}, {0x01},
+									[]byte("USDC-c76f1f"), {}, {0x64},
+									addressBytes(validationSender),
+								},
+							},
+							{
+								Address:    validationReceiver,
+								Identifier: "signalError",
+								Topics:     [][]byte{addressBytes(validationSender), []byte("insufficient funds")},
+								Data:       []byte("@75736572206572726f72"),
+							},
+						},
+					},
+				},
+			},
+		})
+
+		require.Len(t, decodedEvents, 4)
+		assert.Equal(t, &data.TransactionDecodedEvent{
+			Identifier: "ESDTTransfer",
+			Address:    validationSender,
+			Sender:     validationSender,
+			Receiver:   validationReceiver,
+			Tokens: []*data.TransactionOperationToken{
+				{Identifier: "WEGLD-bd4d79", Amount: "1000000000000000000"},
+			},
+		}, decodedEvents[0])
+		assert.Equal(t, &data.TransactionDecodedEvent{
+			Identifier:      "SCDeploy",
+			Address:         validationReceiver,
+			ContractAddress: validationReceiver,
+			Deployer:        validationSender,
+		}, decodedEvents[1])
+		assert.Equal(t, &data.TransactionDecodedEvent{
+			Identifier: "MultiESDTNFTTransfer",
+			Address:    validationReceiver,
+			Sender:     validationReceiver,
+			Receiver:   validationSender,
+			Tokens: []*data.TransactionOperationToken{
+				{Identifier: "NFT-123456-0a", Collection: "NFT-123456", Nonce: 10, Amount: "1"},
+				{Identifier: "USDC-c76f1f", Amount: "100"},
+			},
+		}, decodedEvents[2])
+		assert.Equal(t, &data.TransactionDecodedEvent{
+			Identifier: "signalError",
+			Address:    validationReceiver,
+			Sender:     validationSender,
+			Message:    "insufficient funds",
+		}, decodedEvents[3])
+	})
+	t.Run("events decoded by the ABI registry should take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		abiDecodedEvent := &data.TransactionDecodedEvent{
+			Identifier: "swapTokensFixedInput",
+			Address:    validationReceiver,
+			Event:      "swap",
+			Fields:     map[string]interface{}{"caller": validationSender},
+		}
+		registry := &mock.EventsABIRegistryStub{
+			DecodeEventCalled: func(event *transaction.Events) *data.TransactionDecodedEvent {
+				if event.Identifier == "swapTokensFixedInput" {
+					return abiDecodedEvent
+				}
+
+				return nil
+			},
+		}
+		tpWithRegistry, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, registry, 0)
+		require.NoError(t, err)
+
+		decodedEvents := tpWithRegistry.DecodeTransactionEvents(&transaction.ApiTransactionResult{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{Address: validationReceiver, Identifier: "swapTokensFixedInput", Topics: [][]byte{[]byte("swap")}},
+					{Address: validationReceiver, Identifier: "signalError", Topics: [][]byte{addressBytes(validationSender), []byte("slippage exceeded")}},
+				},
+			},
+		})
+		require.Len(t, decodedEvents, 2)
+		assert.Equal(t, abiDecodedEvent, decodedEvents[0])
+		assert.Equal(t, "slippage exceeded", decodedEvents[1].Message)
+	})
+}
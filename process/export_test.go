@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -17,6 +18,11 @@ func (bp *BaseProcessor) SetNodeStatusFetcher(fetcher func(url string) (*proxyDa
 	bp.nodeStatusFetcher = fetcher
 }
 
+// SetNetworkConfigFetcher -
+func (bp *BaseProcessor) SetNetworkConfigFetcher(fetcher func(url string) (*proxyData.NetworkConfig, int, error)) {
+	bp.networkConfigFetcher = fetcher
+}
+
 // ComputeTokenStorageKey -
 func ComputeTokenStorageKey(tokenID string, nonce uint64) string {
 	return computeTokenStorageKey(tokenID, nonce)
@@ -29,10 +35,30 @@ func GetShortHashSize() int {
 
 // ComputeTransactionStatus -
 func (tp *TransactionProcessor) ComputeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *proxyData.ProcessStatusResponse {
-	return tp.computeTransactionStatus(tx, withResults)
+	return tp.computeTransactionStatus(context.Background(), tx, withResults)
 }
 
 // CheckIfFailed -
 func CheckIfFailed(logs []*transaction.ApiLogs) (bool, string) {
 	return checkIfFailed(logs)
 }
+
+// SetRandomHandler -
+func (ohr *ObserversHealthWeightedRanker) SetRandomHandler(handler func() float64) {
+	ohr.randomHandler = handler
+}
+
+// SetGetTimeHandler -
+func (cut *ClientsUsageTracker) SetGetTimeHandler(handler func() time.Time) {
+	cut.getTimeHandler = handler
+}
+
+// SetGetTimeHandler -
+func (sp *SovereignProcessor) SetGetTimeHandler(handler func() time.Time) {
+	sp.getTimeHandler = handler
+}
+
+// Flush -
+func (cut *ClientsUsageTracker) Flush() {
+	cut.flush()
+}
@@ -0,0 +1,246 @@
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ExternalSignerSignPath represents the path where an external signer exposes the signing of a message
+const ExternalSignerSignPath = "/sign"
+
+type externalSignerRequest struct {
+	Address     string            `json:"address"`
+	Message     string            `json:"message"`
+	Transaction *data.Transaction `json:"transaction"`
+}
+
+type externalSignerResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error"`
+}
+
+// ExternalSignerFaucetProcessor will handle the faucet operation, the transactions being signed by an external
+// service which holds the keys of the faucet accounts, so that no key is stored on the proxy host
+type ExternalSignerFaucetProcessor struct {
+	baseProc           Processor
+	pubKeyConverter    core.PubkeyConverter
+	defaultFaucetValue *big.Int
+	signerURLs         []string
+	sendersByShard     map[uint32][]string
+	authorizationToken string
+	httpClient         *http.Client
+	singleSigner       crypto.SingleSigner
+	keyGen             crypto.KeyGenerator
+}
+
+// NewExternalSignerFaucetProcessor will return a new instance of ExternalSignerFaucetProcessor
+func NewExternalSignerFaucetProcessor(
+	baseProc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	defaultFaucetValue *big.Int,
+	signerURLs []string,
+	senderAddresses []string,
+	authorizationToken string,
+	requestTimeout time.Duration,
+) (*ExternalSignerFaucetProcessor, error) {
+	if check.IfNil(baseProc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if defaultFaucetValue == nil {
+		return nil, ErrNilDefaultFaucetValue
+	}
+	if defaultFaucetValue.Cmp(big.NewInt(0)) <= 0 {
+		return nil, ErrInvalidDefaultFaucetValue
+	}
+	if len(signerURLs) == 0 {
+		return nil, ErrNoExternalSignerURL
+	}
+	if len(senderAddresses) == 0 {
+		return nil, ErrNoFaucetSenderAddress
+	}
+	if requestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	sendersByShard := make(map[uint32][]string)
+	for _, senderAddress := range senderAddresses {
+		senderBytes, err := pubKeyConverter.Decode(senderAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w for faucet sender address %s", err, senderAddress)
+		}
+
+		shardID, err := baseProc.ComputeShardId(senderBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		sendersByShard[shardID] = append(sendersByShard[shardID], senderAddress)
+	}
+
+	urls := make([]string, 0, len(signerURLs))
+	for _, signerURL := range signerURLs {
+		urls = append(urls, strings.TrimSuffix(signerURL, "/"))
+	}
+
+	return &ExternalSignerFaucetProcessor{
+		baseProc:           baseProc,
+		pubKeyConverter:    pubKeyConverter,
+		defaultFaucetValue: defaultFaucetValue,
+		signerURLs:         urls,
+		sendersByShard:     sendersByShard,
+		authorizationToken: authorizationToken,
+		httpClient:         &http.Client{Timeout: requestTimeout},
+		singleSigner:       getSingleSigner(),
+		keyGen:             signing.NewKeyGenerator(ed25519.NewEd25519()),
+	}, nil
+}
+
+// IsEnabled returns true
+func (esfp *ExternalSignerFaucetProcessor) IsEnabled() bool {
+	return true
+}
+
+// SenderDetailsFromPem will return a faucet sender in the same shard with the receiver. As the key is held by the
+// external signer, the returned private key is always nil
+func (esfp *ExternalSignerFaucetProcessor) SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error) {
+	receiverBytes, err := esfp.pubKeyConverter.Decode(receiver)
+	if err != nil {
+		return nil, "", err
+	}
+
+	receiverShardID, err := esfp.baseProc.ComputeShardId(receiverBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	senders := esfp.sendersByShard[receiverShardID]
+	if len(senders) == 0 {
+		return nil, "", ErrNoFaucetAccountForGivenShard
+	}
+
+	return nil, senders[rand.Intn(len(senders))], nil
+}
+
+// GenerateTxForSendUserFunds generates the faucet transaction and has it signed by the external signer
+func (esfp *ExternalSignerFaucetProcessor) GenerateTxForSendUserFunds(
+	_ crypto.PrivateKey,
+	senderPk string,
+	senderNonce uint64,
+	receiver string,
+	value *big.Int,
+	networkConfig *data.NetworkConfig,
+) (*data.Transaction, error) {
+	if value == nil {
+		value = esfp.defaultFaucetValue
+	}
+
+	tx := &data.Transaction{
+		Nonce:     senderNonce,
+		Value:     value.String(),
+		Receiver:  receiver,
+		Sender:    senderPk,
+		Data:      []byte(""),
+		Signature: "",
+		ChainID:   networkConfig.Config.ChainID,
+		Version:   networkConfig.Config.MinTransactionVersion,
+		GasPrice:  networkConfig.Config.MinGasPrice,
+		GasLimit:  networkConfig.Config.MinGasLimit,
+	}
+
+	message, err := marshalTxForSigning(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	senderPubKey, err := esfp.getPublicKey(senderPk)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, signerURL := range esfp.signerURLs {
+		signature, errSign := esfp.requestSignature(signerURL, tx, message)
+		if errSign == nil {
+			errSign = esfp.singleSigner.Verify(senderPubKey, message, signature)
+			if errSign != nil {
+				errSign = fmt.Errorf("%w: %s", ErrInvalidExternalSignature, errSign.Error())
+			}
+		}
+		if errSign != nil {
+			log.Warn("faucet external signer request", "signer", signerURL, "sender", senderPk, "error", errSign.Error())
+			lastErr = errSign
+			continue
+		}
+
+		log.Info("faucet external signer request", "signer", signerURL, "sender", senderPk)
+		tx.Signature = hex.EncodeToString(signature)
+		return tx, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrExternalSignerUnavailable, lastErr.Error())
+}
+
+func (esfp *ExternalSignerFaucetProcessor) requestSignature(signerURL string, tx *data.Transaction, message []byte) ([]byte, error) {
+	requestBody, err := json.Marshal(&externalSignerRequest{
+		Address:     tx.Sender,
+		Message:     hex.EncodeToString(message),
+		Transaction: tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, signerURL+ExternalSignerSignPath, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(esfp.authorizationToken) > 0 {
+		request.Header.Set("Authorization", "Bearer "+esfp.authorizationToken)
+	}
+
+	response, err := esfp.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	signerResponse := &externalSignerResponse{}
+	err = json.NewDecoder(response.Body).Decode(signerResponse)
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signer returned status %d %s", response.StatusCode, signerResponse.Error)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(signerResponse.Signature)
+}
+
+func (esfp *ExternalSignerFaucetProcessor) getPublicKey(address string) (crypto.PublicKey, error) {
+	pubKeyBytes, err := esfp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return esfp.keyGen.PublicKeyFromByteArray(pubKeyBytes)
+}
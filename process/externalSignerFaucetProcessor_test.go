@@ -0,0 +1,255 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const externalSignerToken = "token"
+
+func createFaucetSenderKey(t *testing.T) (crypto.PrivateKey, string) {
+	privKey, pubKey := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	pubKeyBytes, err := pubKey.ToByteArray()
+	require.Nil(t, err)
+
+	return privKey, testPubkeyConverter.SilentEncode(pubKeyBytes, nil)
+}
+
+func createExternalSigner(t *testing.T, privKey crypto.PrivateKey, numCalls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*numCalls++
+		require.Equal(t, process.ExternalSignerSignPath, r.URL.Path)
+		require.Equal(t, "Bearer "+externalSignerToken, r.Header.Get("Authorization"))
+
+		request := struct {
+			Address string `json:"address"`
+			Message string `json:"message"`
+		}{}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+
+		message, _ := hex.DecodeString(request.Message)
+		signature, _ := (&ed25519SingleSigner.Ed25519Signer{}).Sign(privKey, message)
+		_, _ = w.Write([]byte(`{"signature":"` + hex.EncodeToString(signature) + `"}`))
+	}))
+}
+
+func createFailingExternalSigner(numCalls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*numCalls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":"vault sealed"}`))
+	}))
+}
+
+func createExternalSignerFaucetProcessor(t *testing.T, signerURLs []string, senderAddresses []string) *process.ExternalSignerFaucetProcessor {
+	esfp, err := process.NewExternalSignerFaucetProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+		},
+		testPubkeyConverter,
+		big.NewInt(10),
+		signerURLs,
+		senderAddresses,
+		externalSignerToken,
+		time.Second,
+	)
+	require.Nil(t, err)
+
+	return esfp
+}
+
+func createFaucetNetworkConfig() *data.NetworkConfig {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.MinTransactionVersion = 1
+
+	return networkConfig
+}
+
+func mustDecodeAddress(address string) []byte {
+	addressBytes, _ := testPubkeyConverter.Decode(address)
+	return addressBytes
+}
+
+func TestNewExternalSignerFaucetProcessor(t *testing.T) {
+	t.Parallel()
+
+	_, senderAddress := createFaucetSenderKey(t)
+	testCases := map[string]struct {
+		signerURLs      []string
+		senderAddresses []string
+		timeout         time.Duration
+		expectedErr     error
+	}{
+		"no signer URL should error": {
+			senderAddresses: []string{senderAddress},
+			timeout:         time.Second,
+			expectedErr:     process.ErrNoExternalSignerURL,
+		},
+		"no sender address should error": {
+			signerURLs:  []string{"http://signer"},
+			timeout:     time.Second,
+			expectedErr: process.ErrNoFaucetSenderAddress,
+		},
+		"invalid timeout should error": {
+			signerURLs:      []string{"http://signer"},
+			senderAddresses: []string{senderAddress},
+			expectedErr:     process.ErrInvalidRequestTimeout,
+		},
+	}
+
+	for name, testCase := range testCases {
+		esfp, err := process.NewExternalSignerFaucetProcessor(
+			&mock.ProcessorStub{},
+			testPubkeyConverter,
+			big.NewInt(10),
+			testCase.signerURLs,
+			testCase.senderAddresses,
+			"",
+			testCase.timeout,
+		)
+		assert.Nil(t, esfp, name)
+		assert.Equal(t, testCase.expectedErr, err, name)
+	}
+
+	esfp, err := process.NewExternalSignerFaucetProcessor(
+		&mock.ProcessorStub{},
+		testPubkeyConverter,
+		big.NewInt(10),
+		[]string{"http://signer"},
+		[]string{"invalid address"},
+		"",
+		time.Second,
+	)
+	assert.Nil(t, esfp)
+	assert.NotNil(t, err)
+}
+
+func TestExternalSignerFaucetProcessor_SenderDetailsFromPem(t *testing.T) {
+	t.Parallel()
+
+	_, senderAddress := createFaucetSenderKey(t)
+	_, receiverAddress := createFaucetSenderKey(t)
+
+	t.Run("sender in the receiver shard should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		esfp := createExternalSignerFaucetProcessor(t, []string{"http://signer"}, []string{senderAddress})
+		privKey, sender, err := esfp.SenderDetailsFromPem(receiverAddress)
+		require.Nil(t, err)
+		assert.Nil(t, privKey)
+		assert.Equal(t, senderAddress, sender)
+	})
+	t.Run("no sender in the receiver shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		esfp, _ := process.NewExternalSignerFaucetProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					if string(addressBuff) == string(mustDecodeAddress(senderAddress)) {
+						return 0, nil
+					}
+					return 1, nil
+				},
+			},
+			testPubkeyConverter,
+			big.NewInt(10),
+			[]string{"http://signer"},
+			[]string{senderAddress},
+			"",
+			time.Second,
+		)
+
+		_, _, err := esfp.SenderDetailsFromPem(receiverAddress)
+		assert.Equal(t, process.ErrNoFaucetAccountForGivenShard, err)
+	})
+}
+
+func TestExternalSignerFaucetProcessor_GenerateTxForSendUserFunds(t *testing.T) {
+	t.Parallel()
+
+	senderKey, senderAddress := createFaucetSenderKey(t)
+	_, receiverAddress := createFaucetSenderKey(t)
+
+	t.Run("should sign with the external signer", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		signer := createExternalSigner(t, senderKey, &numCalls)
+		defer signer.Close()
+
+		esfp := createExternalSignerFaucetProcessor(t, []string{signer.URL + "/"}, []string{senderAddress})
+		tx, err := esfp.GenerateTxForSendUserFunds(nil, senderAddress, 7, receiverAddress, nil, createFaucetNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, "10", tx.Value)
+		assert.Equal(t, "T", tx.ChainID)
+		assert.Equal(t, 128, len(tx.Signature))
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("failing signer should fail over to the next one", func(t *testing.T) {
+		t.Parallel()
+
+		numFailingCalls, numCalls := 0, 0
+		failingSigner := createFailingExternalSigner(&numFailingCalls)
+		defer failingSigner.Close()
+		signer := createExternalSigner(t, senderKey, &numCalls)
+		defer signer.Close()
+
+		esfp := createExternalSignerFaucetProcessor(t, []string{failingSigner.URL, signer.URL}, []string{senderAddress})
+		tx, err := esfp.GenerateTxForSendUserFunds(nil, senderAddress, 7, receiverAddress, big.NewInt(5), createFaucetNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, "5", tx.Value)
+		assert.Equal(t, 1, numFailingCalls)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("signature of another key should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		otherKey, _ := createFaucetSenderKey(t)
+		numCalls := 0
+		signer := createExternalSigner(t, otherKey, &numCalls)
+		defer signer.Close()
+
+		esfp := createExternalSignerFaucetProcessor(t, []string{signer.URL}, []string{senderAddress})
+		tx, err := esfp.GenerateTxForSendUserFunds(nil, senderAddress, 7, receiverAddress, nil, createFaucetNetworkConfig())
+		require.Nil(t, tx)
+		assert.True(t, errors.Is(err, process.ErrExternalSignerUnavailable))
+		assert.True(t, strings.Contains(err.Error(), process.ErrInvalidExternalSignature.Error()))
+	})
+	t.Run("all signers failing should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		failingSigner := createFailingExternalSigner(&numCalls)
+		defer failingSigner.Close()
+
+		esfp := createExternalSignerFaucetProcessor(t, []string{failingSigner.URL, failingSigner.URL}, []string{senderAddress})
+		tx, err := esfp.GenerateTxForSendUserFunds(nil, senderAddress, 7, receiverAddress, nil, createFaucetNetworkConfig())
+		require.Nil(t, tx)
+		assert.True(t, errors.Is(err, process.ErrExternalSignerUnavailable))
+		assert.True(t, strings.Contains(err.Error(), "vault sealed"))
+		assert.Equal(t, 2, numCalls)
+	})
+}
@@ -0,0 +1,34 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+const (
+	bech32PubkeyConverterType = "bech32"
+	hexPubkeyConverterType    = "hex"
+	defaultAddressHrp         = "erd"
+)
+
+// CreateAddressPubkeyConverter creates the converter of the addresses, as set in the config. The bech32 addresses use
+// the configured human readable part, which defaults to "erd" if not set
+func CreateAddressPubkeyConverter(cfg config.PubkeyConfig) (core.PubkeyConverter, error) {
+	switch cfg.Type {
+	case bech32PubkeyConverterType:
+		hrp := cfg.Hrp
+		if len(hrp) == 0 {
+			hrp = defaultAddressHrp
+		}
+
+		return process.NewBech32AddressConverter(cfg.Length, hrp)
+	case hexPubkeyConverterType:
+		return pubkeyConverter.NewHexPubkeyConverter(cfg.Length)
+	default:
+		return nil, fmt.Errorf("%w: %s", process.ErrUnknownPubkeyConverterType, cfg.Type)
+	}
+}
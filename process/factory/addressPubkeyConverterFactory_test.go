@@ -0,0 +1,52 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAddressPubkeyConverter(t *testing.T) {
+	t.Parallel()
+
+	addressBytes := make([]byte, 32)
+
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := CreateAddressPubkeyConverter(config.PubkeyConfig{Length: 32, Type: "base64"})
+		assert.Nil(t, converter)
+		assert.True(t, errors.Is(err, process.ErrUnknownPubkeyConverterType))
+	})
+	t.Run("bech32 without prefix should use the default one", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := CreateAddressPubkeyConverter(config.PubkeyConfig{Length: 32, Type: "bech32"})
+		require.Nil(t, err)
+		address, err := converter.Encode(addressBytes)
+		require.Nil(t, err)
+		assert.Equal(t, "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu", address)
+	})
+	t.Run("bech32 with prefix should use it", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := CreateAddressPubkeyConverter(config.PubkeyConfig{Length: 32, Type: "bech32", Hrp: "sov"})
+		require.Nil(t, err)
+		address, err := converter.Encode(addressBytes)
+		require.Nil(t, err)
+		assert.Equal(t, "sov1", address[:4])
+	})
+	t.Run("hex should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := CreateAddressPubkeyConverter(config.PubkeyConfig{Length: 32, Type: "hex"})
+		require.Nil(t, err)
+		address, err := converter.Encode(addressBytes)
+		require.Nil(t, err)
+		assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", address)
+	})
+}
@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateBlocksExporter will return the blocks exporter needed for current settings
+func CreateBlocksExporter(blocksSource process.BlocksExportSource, exportConfig config.BlocksExportConfig) (facade.BlocksExporter, error) {
+	if !exportConfig.Enabled {
+		log.Info("blocks export is disabled")
+		return &disabledBlocksExporter{}, nil
+	}
+
+	log.Info("blocks export is enabled", "directory", exportConfig.Directory, "max blocks per job", exportConfig.MaxBlocksPerJob)
+	return process.NewBlocksExporter(blocksSource, exportConfig.Directory, exportConfig.MaxBlocksPerJob)
+}
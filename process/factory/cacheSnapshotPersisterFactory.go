@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+// CreateCacheSnapshotPersister will return the cache snapshot persister needed for current settings
+func CreateCacheSnapshotPersister(persistenceConfig config.CachePersistenceConfig, storer storage.Storer) (process.CacheSnapshotPersister, error) {
+	if !persistenceConfig.Enabled {
+		log.Info("cache persistence is disabled")
+		return &disabled.CacheSnapshotPersister{}, nil
+	}
+
+	log.Info("cache persistence is enabled", "max snapshot age in seconds", persistenceConfig.MaxSnapshotAgeSec)
+	return cache.NewSnapshotPersister(storer, time.Duration(persistenceConfig.MaxSnapshotAgeSec)*time.Second)
+}
@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateClientsUsageTracker will return the clients usage tracker needed for current settings
+func CreateClientsUsageTracker(usageConfig config.ClientsUsageConfig, storer process.Storer) (process.ClientsUsageHandler, error) {
+	if !usageConfig.Enabled {
+		log.Info("clients usage tracking is disabled")
+		return &disabledClientsUsageTracker{}, nil
+	}
+
+	log.Info("clients usage tracking is enabled", "API key header", usageConfig.ApiKeyHeader, "retention days", usageConfig.RetentionDays)
+	return process.NewClientsUsageTracker(process.ArgsClientsUsageTracker{
+		Storer:        storer,
+		FlushInterval: time.Duration(usageConfig.FlushIntervalSec) * time.Second,
+		Retention:     time.Duration(usageConfig.RetentionDays) * 24 * time.Hour,
+	})
+}
@@ -0,0 +1,28 @@
+package factory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var errBlocksExportNotEnabled = errors.New("blocks export not enabled")
+
+type disabledBlocksExporter struct {
+}
+
+// StartExport will return an error that signals that the blocks export is not enabled
+func (d *disabledBlocksExporter) StartExport(_ context.Context, _ *data.BlocksExportRequest) (*data.BlocksExportJob, error) {
+	return nil, errBlocksExportNotEnabled
+}
+
+// GetExportJob will return an error that signals that the blocks export is not enabled
+func (d *disabledBlocksExporter) GetExportJob(_ string) (*data.BlocksExportJob, error) {
+	return nil, errBlocksExportNotEnabled
+}
+
+// Close does nothing
+func (d *disabledBlocksExporter) Close() error {
+	return nil
+}
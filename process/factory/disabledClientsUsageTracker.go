@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledClientsUsageTracker struct {
+}
+
+// AddRequest does nothing
+func (d *disabledClientsUsageTracker) AddRequest(_ string, _ bool, _ uint64, _ uint64) {
+}
+
+// GetUsage will return an error that signals that the clients usage is not tracked
+func (d *disabledClientsUsageTracker) GetUsage(_ string, _ time.Time, _ time.Time) (*data.ClientUsageReport, error) {
+	return nil, data.ErrClientsUsageTrackingDisabled
+}
+
+// Close does nothing
+func (d *disabledClientsUsageTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledClientsUsageTracker) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var errFaucetQueueNotEnabled = errors.New("faucet queue not enabled")
+
+type disabledFaucetRequestsQueue struct {
+}
+
+// IsEnabled returns false, as the faucet requests are sent synchronously
+func (d *disabledFaucetRequestsQueue) IsEnabled() bool {
+	return false
+}
+
+// Enqueue will return an error that signals that the faucet queue is not enabled
+func (d *disabledFaucetRequestsQueue) Enqueue(_ string, _ *big.Int) (*data.FaucetRequest, error) {
+	return nil, errFaucetQueueNotEnabled
+}
+
+// GetRequest will return an error that signals that the faucet queue is not enabled
+func (d *disabledFaucetRequestsQueue) GetRequest(_ string) (*data.FaucetRequest, error) {
+	return nil, errFaucetQueueNotEnabled
+}
+
+// Close does nothing
+func (d *disabledFaucetRequestsQueue) Close() error {
+	return nil
+}
@@ -0,0 +1,52 @@
+package factory
+
+import (
+	"io"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateESDTMetadataCache will return the ESDT metadata cache needed for current settings, along with the component
+// following the blocks to invalidate it
+func CreateESDTMetadataCache(
+	cfg config.ESDTMetadataCacheConfig,
+	proc process.Processor,
+	blocksSource process.BlockByNonceProvider,
+) (process.ESDTMetadataCache, io.Closer, error) {
+	if !cfg.Enabled {
+		log.Info("ESDT metadata cache is disabled")
+		return &disabled.ESDTMetadataCache{}, &disabledESDTMetadataFollower{}, nil
+	}
+
+	validity := time.Duration(cfg.CacheValiditySec) * time.Second
+	log.Info("ESDT metadata cache is enabled", "size", cfg.CacheSize, "validity", validity)
+	esdtMetadataCache, err := cache.NewESDTMetadataCache(cfg.CacheSize, validity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	follower, err := process.NewESDTMetadataFollower(process.ArgsESDTMetadataFollower{
+		Proc:              proc,
+		BlocksSource:      blocksSource,
+		ESDTMetadataCache: esdtMetadataCache,
+		PollInterval:      time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		MaxBlocksPerPoll:  cfg.MaxBlocksPerPoll,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return esdtMetadataCache, follower, nil
+}
+
+type disabledESDTMetadataFollower struct {
+}
+
+// Close does nothing
+func (d *disabledESDTMetadataFollower) Close() error {
+	return nil
+}
@@ -0,0 +1,38 @@
+package factory
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/abi"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateEventsABIRegistry will create the registry decoding the events of the configured contracts, or a disabled
+// component if the feature is disabled
+func CreateEventsABIRegistry(cfg config.ContractABIsConfig, pubKeyConverter core.PubkeyConverter) (process.EventsABIRegistry, error) {
+	if !cfg.Enabled {
+		return &disabled.EventsABIRegistry{}, nil
+	}
+
+	contractABIs := make(map[string]*abi.ContractABI, len(cfg.Contracts))
+	for _, contract := range cfg.Contracts {
+		abiBytes, err := os.ReadFile(contract.ABIFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the ABI of %s: %w", contract.Address, err)
+		}
+
+		contractABI, err := abi.ParseContractABI(abiBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for contract %s", err, contract.Address)
+		}
+
+		contractABIs[contract.Address] = contractABI
+	}
+
+	log.Info("contract ABIs events decoding is enabled", "num contracts", len(contractABIs))
+	return abi.NewEventsRegistry(contractABIs, pubKeyConverter)
+}
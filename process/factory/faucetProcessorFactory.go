@@ -2,10 +2,13 @@ package factory
 
 import (
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/faucet"
 	"github.com/multiversx/mx-chain-proxy-go/process"
@@ -20,12 +23,27 @@ func CreateFaucetProcessor(
 	defaultFaucetValue *big.Int,
 	pubKeyConverter core.PubkeyConverter,
 	pemFileLocation string,
+	externalSignerConfig config.FaucetExternalSignerConfig,
+	externalSignerAuthorizationToken string,
 ) (facade.FaucetProcessor, error) {
 	if defaultFaucetValue.Cmp(big.NewInt(0)) == 0 {
 		log.Info("faucet is disabled")
 		return &disabledFaucetProcessor{}, nil
 	}
 
+	if externalSignerConfig.Enabled {
+		log.Info("faucet is enabled", "external signers", strings.Join(externalSignerConfig.URLs, ", "))
+		return process.NewExternalSignerFaucetProcessor(
+			baseProc,
+			pubKeyConverter,
+			defaultFaucetValue,
+			externalSignerConfig.URLs,
+			externalSignerConfig.SenderAddresses,
+			externalSignerAuthorizationToken,
+			time.Duration(externalSignerConfig.RequestTimeoutSec)*time.Second,
+		)
+	}
+
 	log.Info("faucet is enabled", "pem file location", pemFileLocation)
 	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoordinator, pemFileLocation, pubKeyConverter)
 	if err != nil {
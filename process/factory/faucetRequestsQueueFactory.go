@@ -0,0 +1,37 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateFaucetRequestsQueue will return the faucet requests queue needed for current settings
+func CreateFaucetRequestsQueue(
+	faucetProc facade.FaucetProcessor,
+	accountProc process.AccountProvider,
+	networkConfigProvider process.NetworkConfigProvider,
+	txSender process.TransactionSender,
+	queueConfig config.FaucetQueueConfig,
+	storer process.Storer,
+) (facade.FaucetRequestsQueue, error) {
+	if !queueConfig.Enabled || !faucetProc.IsEnabled() {
+		log.Info("faucet queue is disabled")
+		return &disabledFaucetRequestsQueue{}, nil
+	}
+
+	log.Info("faucet queue is enabled", "max pending requests", queueConfig.MaxPendingRequests)
+	return process.NewFaucetRequestsQueue(process.ArgsFaucetRequestsQueue{
+		FaucetProc:            faucetProc,
+		AccountProc:           accountProc,
+		NetworkConfigProvider: networkConfigProvider,
+		TxSender:              txSender,
+		Storer:                storer,
+		MaxPendingRequests:    queueConfig.MaxPendingRequests,
+		MaxAttempts:           queueConfig.MaxAttempts,
+		RetryDelay:            time.Duration(queueConfig.RetryDelaySec) * time.Second,
+		RequestsRetention:     time.Duration(queueConfig.RequestsRetentionSec) * time.Second,
+	})
+}
@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateHyperblocksCache will return the hyperblocks cache needed for current settings
+func CreateHyperblocksCache(cacheSize int) (process.HyperblocksCacher, error) {
+	if cacheSize == 0 {
+		log.Info("hyperblocks cache is disabled")
+		return &disabled.HyperblocksCache{}, nil
+	}
+
+	log.Info("hyperblocks cache is enabled", "size", cacheSize)
+	return cache.NewHyperblocksLRUCache(cacheSize)
+}
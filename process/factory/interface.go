@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -11,12 +13,13 @@ import (
 // Processor defines what a processor should be able to do
 type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetObserversOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	GetFullHistoryNodesOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetObservers(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetObserversForWrite(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllObservers(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetFullHistoryNodes(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllFullHistoryNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
@@ -24,6 +27,10 @@ type Processor interface {
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetExcludedObservers() []*data.ExcludedObserver
+	GetObserversNonces() []*data.ObserverNonce
+	RecordWriteObserver(sender string, observerAddress string)
+	PreferWriteObserver(sender string, observers []*data.NodeData) []*data.NodeData
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,28 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateObserverEventsNotifier will return the observer events notifier needed for current settings
+func CreateObserverEventsNotifier(webhooksConfig config.FailoverWebhooksConfig) (process.ObserverEventsNotifier, error) {
+	if !webhooksConfig.Enabled {
+		log.Info("failover webhooks are disabled")
+		return &disabled.ObserverEventsNotifier{}, nil
+	}
+
+	webhooks := make([]process.Webhook, 0, len(webhooksConfig.Webhooks))
+	for _, webhookConfig := range webhooksConfig.Webhooks {
+		webhooks = append(webhooks, process.Webhook{
+			URL:    webhookConfig.URL,
+			Format: webhookConfig.Format,
+		})
+	}
+
+	log.Info("failover webhooks are enabled", "num webhooks", len(webhooks))
+	return process.NewObserverEventsWebhookNotifier(webhooks, time.Duration(webhooksConfig.RequestTimeoutSec)*time.Second)
+}
@@ -0,0 +1,20 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateObserversAffinityCache will return the observers affinity cache needed for current settings
+func CreateObserversAffinityCache(cacheSize int, window time.Duration) (process.ObserversAffinityCacher, error) {
+	if cacheSize == 0 || window == 0 {
+		log.Info("read-your-writes observers affinity is disabled")
+		return &disabled.ObserversAffinityCache{}, nil
+	}
+
+	log.Info("read-your-writes observers affinity is enabled", "size", cacheSize, "window", window)
+	return cache.NewObserversAffinityCache(cacheSize, window)
+}
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateObserversRanker will return the observers ranker needed for current settings
+func CreateObserversRanker(
+	isLatencyAwareRoutingEnabled bool,
+	isDeterministicObserversOrderEnabled bool,
+	latencyProvider process.ObserversLatencyProvider,
+	slowObserverFactor float64,
+) (process.ObserversRanker, error) {
+	if isLatencyAwareRoutingEnabled {
+		log.Info("latency aware routing is enabled", "slow observer factor", slowObserverFactor)
+		return process.NewObserversLatencyRanker(latencyProvider, slowObserverFactor)
+	}
+
+	if isDeterministicObserversOrderEnabled {
+		log.Info("observers are chosen in the deterministic order of the nodes providers")
+		return &disabled.ObserversRanker{}, nil
+	}
+
+	log.Info("observers are chosen randomly, weighted by their recent success rate and latency")
+	return process.NewObserversHealthWeightedRanker(), nil
+}
@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateReorgDetector will return the reorg detector needed for current settings
+func CreateReorgDetector(cfg config.ReorgDetectionConfig) (process.ReorgDetector, error) {
+	if !cfg.Enabled {
+		log.Info("reorg detection is disabled")
+		return &disabled.ReorgDetector{}, nil
+	}
+
+	window := time.Duration(cfg.WindowSec) * time.Second
+	log.Info("reorg detection is enabled", "window", window, "max tracked nonces per shard", cfg.MaxTrackedNoncesPerShard)
+	return cache.NewReorgDetector(window, cfg.MaxTrackedNoncesPerShard)
+}
@@ -0,0 +1,21 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+// CreateSentTxsCache will return the sent transactions cache needed for current settings
+func CreateSentTxsCache(deduplicationWindow time.Duration, storer storage.Storer) (process.SentTxsCacher, error) {
+	if deduplicationWindow == 0 {
+		log.Info("sent transactions deduplication is disabled")
+		return &disabled.SentTxsCache{}, nil
+	}
+
+	log.Info("sent transactions deduplication is enabled", "window", deduplicationWindow)
+	return cache.NewSentTxsCache(storer, deduplicationWindow)
+}
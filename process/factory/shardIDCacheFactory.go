@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateShardIDCache will return the computed shard IDs cache needed for current settings
+func CreateShardIDCache(cacheSize int) (process.ShardIDCacher, error) {
+	if cacheSize == 0 {
+		log.Info("shard IDs cache is disabled")
+		return &disabled.ShardIDCache{}, nil
+	}
+
+	log.Info("shard IDs cache is enabled", "size", cacheSize)
+	return cache.NewShardIDLRUCache(cacheSize)
+}
@@ -0,0 +1,42 @@
+package factory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/price"
+)
+
+// HTTPTokenPriceProviderType is the type of the token price provider reading the prices from an external HTTP API
+const HTTPTokenPriceProviderType = "http"
+
+// CreateTokenPriceProvider will return the token price provider selected in the config, its prices being cached
+func CreateTokenPriceProvider(priceConfig config.TokenPriceConfig, requestTimeout time.Duration) (process.TokenPriceProvider, error) {
+	if !priceConfig.Enabled {
+		log.Info("token price provider is disabled")
+		return price.NewDisabledPriceProvider(), nil
+	}
+
+	var provider price.PriceProvider
+	var err error
+	switch priceConfig.Type {
+	case HTTPTokenPriceProviderType:
+		provider, err = price.NewHTTPPriceProvider(price.ArgsHTTPPriceProvider{
+			Name:           priceConfig.Name,
+			URL:            priceConfig.URL,
+			PriceField:     priceConfig.PriceField,
+			RequestTimeout: requestTimeout,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", process.ErrUnknownTokenPriceProviderType, priceConfig.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("token price provider is enabled", "type", priceConfig.Type, "name", priceConfig.Name,
+		"cache validity in seconds", priceConfig.CacheValiditySec)
+	return price.NewCachedPriceProvider(provider, time.Duration(priceConfig.CacheValiditySec)*time.Second)
+}
@@ -20,6 +20,12 @@ func CreateTransactionProcessor(
 	marshalizer marshal.Marshalizer,
 	allowEntireTxPoolFetch bool,
 	runTypeComponents factory.RunTypeComponentsHolder,
+	txStatusCache process.TxStatusCacher,
+	sentTxsCache process.SentTxsCacher,
+	txsPolicyChecker process.TransactionsPolicyChecker,
+	txBroadcastFanout int,
+	eventsABIRegistry process.EventsABIRegistry,
+	nonceGapWarningThreshold uint64,
 ) (facade.TransactionProcessor, error) {
 	newTxCostProcessor := func() (process.TransactionCostHandler, error) {
 		return txcost.NewTransactionCostProcessor(
@@ -42,5 +48,11 @@ func CreateTransactionProcessor(
 		logsMerger,
 		allowEntireTxPoolFetch,
 		runTypeComponents.TxNotarizationCheckerHandlerCreator(),
+		txStatusCache,
+		sentTxsCache,
+		txsPolicyChecker,
+		txBroadcastFanout,
+		eventsABIRegistry,
+		nonceGapWarningThreshold,
 	)
 }
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateTransactionsPolicyChecker will return the transactions policy checker needed for current settings
+func CreateTransactionsPolicyChecker(
+	cfg config.TransactionsPolicyConfig,
+	pubKeyConverter core.PubkeyConverter,
+) (process.TransactionsPolicyChecker, error) {
+	if !cfg.Enabled {
+		return &disabled.TransactionsPolicyChecker{}, nil
+	}
+
+	log.Info("transactions policy is enabled",
+		"max gas limit", cfg.MaxGasLimit,
+		"max value", cfg.MaxValue,
+		"max data field size", cfg.MaxDataFieldSize,
+		"num allowed receivers", len(cfg.AllowedReceivers),
+		"num denied receivers", len(cfg.DeniedReceivers),
+	)
+	return process.NewTransactionsPolicyChecker(cfg, pubKeyConverter)
+}
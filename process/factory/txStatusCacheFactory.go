@@ -0,0 +1,21 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateTxStatusCache will return the transaction statuses cache needed for current settings
+func CreateTxStatusCache(cacheSize int, pendingTTL time.Duration, terminalTTL time.Duration) (process.TxStatusCacher, error) {
+	if cacheSize == 0 {
+		log.Info("transaction statuses cache is disabled")
+		return &disabled.TxStatusCache{}, nil
+	}
+
+	log.Info("transaction statuses cache is enabled", "size", cacheSize, "pending statuses TTL", pendingTTL,
+		"terminal statuses TTL", terminalTTL)
+	return cache.NewTxStatusLRUCache(cacheSize, pendingTTL, terminalTTL)
+}
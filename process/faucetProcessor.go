@@ -139,7 +139,7 @@ func (fp *FaucetProcessor) GenerateTxForSendUserFunds(
 }
 
 func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.PrivateKey) (*data.Transaction, error) {
-	marshalizedTxBeforeSigning, err := fp.marshalTxForSigning(tx)
+	marshalizedTxBeforeSigning, err := marshalTxForSigning(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +155,7 @@ func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.Priv
 	return tx, nil
 }
 
-func (fp *FaucetProcessor) marshalTxForSigning(tx *data.Transaction) ([]byte, error) {
+func marshalTxForSigning(tx *data.Transaction) ([]byte, error) {
 	erdTx := erdTransaction{
 		Nonce:    tx.Nonce,
 		Value:    tx.Value,
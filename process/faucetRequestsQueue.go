@@ -0,0 +1,440 @@
+package process
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+)
+
+const (
+	// FaucetRequestStatusPending is the status of a faucet request waiting to be sent, either for the first time or
+	// after a failed attempt
+	FaucetRequestStatusPending = "pending"
+	// FaucetRequestStatusCompleted is the status of a faucet request whose transaction was accepted by an observer
+	FaucetRequestStatusCompleted = "completed"
+	// FaucetRequestStatusFailed is the status of a faucet request which failed on all its attempts
+	FaucetRequestStatusFailed = "failed"
+
+	faucetRequestsStorageKey = "faucet/requests"
+	faucetRequestIDLength    = 16
+
+	// the nonce of a sender is tracked locally for a while after each sent transaction, since the observers might not
+	// have executed it yet when the next request is processed
+	faucetSenderNonceValidity = time.Minute
+)
+
+type queuedFaucetRequest struct {
+	data.FaucetRequest
+	NextAttemptTimeMs int64 `json:"nextAttemptTimeMs"`
+}
+
+type faucetSenderNonce struct {
+	nonce  uint64
+	sentAt time.Time
+}
+
+// ArgsFaucetRequestsQueue holds the arguments needed to create a FaucetRequestsQueue
+type ArgsFaucetRequestsQueue struct {
+	FaucetProc            FaucetTransactionsGenerator
+	AccountProc           AccountProvider
+	NetworkConfigProvider NetworkConfigProvider
+	TxSender              TransactionSender
+	Storer                Storer
+	MaxPendingRequests    int
+	MaxAttempts           int
+	RetryDelay            time.Duration
+	RequestsRetention     time.Duration
+}
+
+// FaucetRequestsQueue processes the send-user-funds requests asynchronously. The requests are sent one at a time, in
+// the order they were queued, and retried after a delay if they fail. The queue is saved in the provided storage after
+// each change, so that the pending requests survive a restart if the storage is persistent
+type FaucetRequestsQueue struct {
+	faucetProc            FaucetTransactionsGenerator
+	accountProc           AccountProvider
+	networkConfigProvider NetworkConfigProvider
+	txSender              TransactionSender
+	storer                Storer
+	maxPendingRequests    int
+	maxAttempts           int
+	retryDelay            time.Duration
+	requestsRetention     time.Duration
+	requests              map[string]*queuedFaucetRequest
+	mutRequests           sync.RWMutex
+	sendersNonces         map[string]*faucetSenderNonce
+	newRequestChan        chan struct{}
+	cancelFunc            func()
+}
+
+// NewFaucetRequestsQueue creates a new instance of FaucetRequestsQueue and starts processing the requests, including
+// the pending ones loaded from the provided storage
+func NewFaucetRequestsQueue(args ArgsFaucetRequestsQueue) (*FaucetRequestsQueue, error) {
+	err := checkFaucetRequestsQueueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	frq := &FaucetRequestsQueue{
+		faucetProc:            args.FaucetProc,
+		accountProc:           args.AccountProc,
+		networkConfigProvider: args.NetworkConfigProvider,
+		txSender:              args.TxSender,
+		storer:                args.Storer,
+		maxPendingRequests:    args.MaxPendingRequests,
+		maxAttempts:           args.MaxAttempts,
+		retryDelay:            args.RetryDelay,
+		requestsRetention:     args.RequestsRetention,
+		requests:              make(map[string]*queuedFaucetRequest),
+		sendersNonces:         make(map[string]*faucetSenderNonce),
+		newRequestChan:        make(chan struct{}, 1),
+	}
+
+	frq.loadRequests()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	frq.cancelFunc = cancelFunc
+	go frq.processRequests(ctx)
+
+	return frq, nil
+}
+
+func checkFaucetRequestsQueueArgs(args ArgsFaucetRequestsQueue) error {
+	if args.FaucetProc == nil {
+		return ErrNilFaucetTransactionsGenerator
+	}
+	if args.AccountProc == nil {
+		return ErrNilAccountProvider
+	}
+	if args.NetworkConfigProvider == nil {
+		return ErrNilNetworkConfigProvider
+	}
+	if args.TxSender == nil {
+		return ErrNilTransactionSender
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if args.MaxPendingRequests <= 0 {
+		return ErrInvalidMaxPendingFaucetRequests
+	}
+	if args.MaxAttempts <= 0 {
+		return ErrInvalidFaucetRequestMaxAttempts
+	}
+	if args.RetryDelay <= 0 {
+		return ErrInvalidFaucetRequestRetryDelay
+	}
+	if args.RequestsRetention <= 0 {
+		return ErrInvalidFaucetRequestsRetention
+	}
+
+	return nil
+}
+
+// IsEnabled returns true, as the faucet requests are queued
+func (frq *FaucetRequestsQueue) IsEnabled() bool {
+	return true
+}
+
+// Enqueue validates the receiver and queues the request, returning its initial status. A nil value means that the
+// default faucet value is sent
+func (frq *FaucetRequestsQueue) Enqueue(receiver string, value *big.Int) (*data.FaucetRequest, error) {
+	// the sender details are resolved here only to reject early the requests which could never be sent
+	_, _, err := frq.faucetProc.SenderDetailsFromPem(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID, err := generateFaucetRequestID()
+	if err != nil {
+		return nil, err
+	}
+
+	valueString := ""
+	if value != nil {
+		valueString = value.String()
+	}
+
+	frq.mutRequests.Lock()
+	defer frq.mutRequests.Unlock()
+
+	if frq.getNumPendingRequests() >= frq.maxPendingRequests {
+		return nil, ErrFaucetQueueFull
+	}
+
+	now := time.Now()
+	request := &queuedFaucetRequest{
+		FaucetRequest: data.FaucetRequest{
+			ID:        requestID,
+			Receiver:  receiver,
+			Value:     valueString,
+			Status:    FaucetRequestStatusPending,
+			CreatedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		},
+		NextAttemptTimeMs: now.UnixMilli(),
+	}
+	frq.requests[requestID] = request
+	frq.saveRequests()
+
+	select {
+	case frq.newRequestChan <- struct{}{}:
+	default:
+	}
+
+	requestCopy := request.FaucetRequest
+	return &requestCopy, nil
+}
+
+func generateFaucetRequestID() (string, error) {
+	buff := make([]byte, faucetRequestIDLength)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buff), nil
+}
+
+func (frq *FaucetRequestsQueue) getNumPendingRequests() int {
+	numPending := 0
+	for _, request := range frq.requests {
+		if request.Status == FaucetRequestStatusPending {
+			numPending++
+		}
+	}
+
+	return numPending
+}
+
+// GetRequest returns the status of the faucet request with the given id
+func (frq *FaucetRequestsQueue) GetRequest(id string) (*data.FaucetRequest, error) {
+	frq.mutRequests.RLock()
+	defer frq.mutRequests.RUnlock()
+
+	request, found := frq.requests[id]
+	if !found {
+		return nil, ErrFaucetRequestNotFound
+	}
+
+	requestCopy := request.FaucetRequest
+	return &requestCopy, nil
+}
+
+func (frq *FaucetRequestsQueue) processRequests(ctx context.Context) {
+	for {
+		frq.processDueRequests(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-frq.newRequestChan:
+		case <-time.After(frq.retryDelay):
+		}
+	}
+}
+
+func (frq *FaucetRequestsQueue) processDueRequests(ctx context.Context) {
+	frq.pruneFinishedRequests()
+
+	for _, request := range frq.getDueRequests() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		txHash, err := frq.sendRequest(ctx, request)
+		if err != nil {
+			log.WithContext(ctx).Debug("faucet request failed", "id", request.ID, "receiver", request.Receiver, "error", err.Error())
+		}
+		frq.updateRequest(request.ID, txHash, err)
+	}
+}
+
+// getDueRequests returns copies of the pending requests whose next attempt is due, oldest first
+func (frq *FaucetRequestsQueue) getDueRequests() []data.FaucetRequest {
+	frq.mutRequests.RLock()
+	defer frq.mutRequests.RUnlock()
+
+	nowMs := time.Now().UnixMilli()
+	dueRequests := make([]data.FaucetRequest, 0)
+	for _, request := range frq.requests {
+		if request.Status == FaucetRequestStatusPending && request.NextAttemptTimeMs <= nowMs {
+			dueRequests = append(dueRequests, request.FaucetRequest)
+		}
+	}
+
+	sort.Slice(dueRequests, func(i, j int) bool {
+		if dueRequests[i].CreatedAt != dueRequests[j].CreatedAt {
+			return dueRequests[i].CreatedAt < dueRequests[j].CreatedAt
+		}
+		return dueRequests[i].ID < dueRequests[j].ID
+	})
+
+	return dueRequests
+}
+
+func (frq *FaucetRequestsQueue) sendRequest(ctx context.Context, request data.FaucetRequest) (string, error) {
+	var value *big.Int
+	if len(request.Value) > 0 {
+		var ok bool
+		value, ok = big.NewInt(0).SetString(request.Value, 10)
+		if !ok {
+			return "", ErrInvalidTransactionValueField
+		}
+	}
+
+	senderSk, senderPk, err := frq.faucetProc.SenderDetailsFromPem(request.Receiver)
+	if err != nil {
+		return "", err
+	}
+
+	senderAccount, err := frq.accountProc.GetAccount(ctx, senderPk, common.AccountQueryOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	networkConfig, err := frq.networkConfigProvider.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := frq.getSenderNonce(senderPk, senderAccount.Account.Nonce)
+	tx, err := frq.faucetProc.GenerateTxForSendUserFunds(senderSk, senderPk, nonce, request.Receiver, value, networkConfig)
+	if err != nil {
+		return "", err
+	}
+
+	_, sentTx, err := frq.txSender.SendTransaction(ctx, tx)
+	if err != nil {
+		// the nonce is fetched again on the next attempt, in case the tracked one was wrong
+		delete(frq.sendersNonces, senderPk)
+		return "", err
+	}
+
+	frq.sendersNonces[senderPk] = &faucetSenderNonce{
+		nonce:  nonce + 1,
+		sentAt: time.Now(),
+	}
+	if sentTx == nil {
+		return "", nil
+	}
+
+	return sentTx.TxHash, nil
+}
+
+// getSenderNonce returns the nonce of the account, unless a higher one is tracked for a recently sent transaction
+func (frq *FaucetRequestsQueue) getSenderNonce(sender string, accountNonce uint64) uint64 {
+	senderNonce, found := frq.sendersNonces[sender]
+	if !found || time.Since(senderNonce.sentAt) > faucetSenderNonceValidity {
+		return accountNonce
+	}
+	if senderNonce.nonce > accountNonce {
+		return senderNonce.nonce
+	}
+
+	return accountNonce
+}
+
+func (frq *FaucetRequestsQueue) updateRequest(id string, txHash string, sendErr error) {
+	frq.mutRequests.Lock()
+	defer frq.mutRequests.Unlock()
+
+	request, found := frq.requests[id]
+	if !found {
+		return
+	}
+
+	now := time.Now()
+	request.Attempts++
+	request.UpdatedAt = now.Unix()
+
+	switch {
+	case sendErr == nil:
+		request.Status = FaucetRequestStatusCompleted
+		request.TxHash = txHash
+		request.Error = ""
+	case request.Attempts >= frq.maxAttempts:
+		request.Status = FaucetRequestStatusFailed
+		request.Error = sendErr.Error()
+	default:
+		request.Error = sendErr.Error()
+		request.NextAttemptTimeMs = now.Add(frq.retryDelay).UnixMilli()
+	}
+
+	frq.saveRequests()
+}
+
+func (frq *FaucetRequestsQueue) pruneFinishedRequests() {
+	frq.mutRequests.Lock()
+	defer frq.mutRequests.Unlock()
+
+	oldestKept := time.Now().Add(-frq.requestsRetention).Unix()
+	numPruned := 0
+	for id, request := range frq.requests {
+		if request.Status != FaucetRequestStatusPending && request.UpdatedAt < oldestKept {
+			delete(frq.requests, id)
+			numPruned++
+		}
+	}
+
+	if numPruned > 0 {
+		frq.saveRequests()
+	}
+}
+
+// saveRequests writes the queue to the storage. Must be called under mutex protection
+func (frq *FaucetRequestsQueue) saveRequests() {
+	requestsBytes, err := json.Marshal(frq.requests)
+	if err != nil {
+		log.Warn("faucet requests queue: cannot encode", "error", err.Error())
+		return
+	}
+
+	err = frq.storer.Put(faucetRequestsStorageKey, requestsBytes)
+	if err != nil {
+		log.Warn("faucet requests queue: cannot save", "error", err.Error())
+	}
+}
+
+func (frq *FaucetRequestsQueue) loadRequests() {
+	requestsBytes, err := frq.storer.Get(faucetRequestsStorageKey)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return
+	}
+	if err != nil {
+		log.Warn("faucet requests queue: cannot read", "error", err.Error())
+		return
+	}
+
+	requests := make(map[string]*queuedFaucetRequest)
+	err = json.Unmarshal(requestsBytes, &requests)
+	if err != nil {
+		log.Warn("faucet requests queue: cannot decode", "error", err.Error())
+		return
+	}
+
+	frq.requests = requests
+	log.Info("faucet requests queue: loaded", "num requests", len(requests), "num pending", frq.getNumPendingRequests())
+}
+
+// Close stops processing the requests. The pending ones remain saved, if the storage is persistent
+func (frq *FaucetRequestsQueue) Close() error {
+	frq.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (frq *FaucetRequestsQueue) IsInterfaceNil() bool {
+	return frq == nil
+}
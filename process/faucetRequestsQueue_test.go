@@ -0,0 +1,349 @@
+package process_test
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFaucetRequestsQueue() process.ArgsFaucetRequestsQueue {
+	return process.ArgsFaucetRequestsQueue{
+		FaucetProc: &mock.FaucetTransactionsGeneratorStub{
+			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
+				return nil, "sender", nil
+			},
+		},
+		AccountProc:           &mock.AccountProviderStub{},
+		NetworkConfigProvider: &mock.NetworkConfigProviderStub{},
+		TxSender:              &mock.TransactionSenderStub{},
+		Storer:                storage.NewMemoryStorer(),
+		MaxPendingRequests:    10,
+		MaxAttempts:           3,
+		RetryDelay:            10 * time.Millisecond,
+		RequestsRetention:     time.Hour,
+	}
+}
+
+func waitForFaucetRequestStatus(t *testing.T, frq *process.FaucetRequestsQueue, id string, status string) *data.FaucetRequest {
+	var request *data.FaucetRequest
+	require.Eventually(t, func() bool {
+		var err error
+		request, err = frq.GetRequest(id)
+		require.NoError(t, err)
+
+		return request.Status == status
+	}, time.Second, 5*time.Millisecond)
+
+	return request
+}
+
+func TestNewFaucetRequestsQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil faucet transactions generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.FaucetProc = nil
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrNilFaucetTransactionsGenerator, err)
+	})
+	t.Run("nil account provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.AccountProc = nil
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrNilAccountProvider, err)
+	})
+	t.Run("nil network config provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.NetworkConfigProvider = nil
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrNilNetworkConfigProvider, err)
+	})
+	t.Run("nil transaction sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.TxSender = nil
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrNilTransactionSender, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.Storer = nil
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrNilStorer, err)
+	})
+	t.Run("invalid max pending requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.MaxPendingRequests = 0
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrInvalidMaxPendingFaucetRequests, err)
+	})
+	t.Run("invalid max attempts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.MaxAttempts = 0
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrInvalidFaucetRequestMaxAttempts, err)
+	})
+	t.Run("invalid retry delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.RetryDelay = 0
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrInvalidFaucetRequestRetryDelay, err)
+	})
+	t.Run("invalid requests retention should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.RequestsRetention = 0
+		frq, err := process.NewFaucetRequestsQueue(args)
+		require.Nil(t, frq)
+		require.Equal(t, process.ErrInvalidFaucetRequestsRetention, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		frq, err := process.NewFaucetRequestsQueue(createMockArgsFaucetRequestsQueue())
+		require.NoError(t, err)
+		require.False(t, frq.IsInterfaceNil())
+		require.True(t, frq.IsEnabled())
+		require.NoError(t, frq.Close())
+	})
+}
+
+func TestFaucetRequestsQueue_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid receiver")
+		args := createMockArgsFaucetRequestsQueue()
+		args.FaucetProc = &mock.FaucetTransactionsGeneratorStub{
+			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
+				return nil, "", expectedErr
+			},
+		}
+		frq, _ := process.NewFaucetRequestsQueue(args)
+		defer func() {
+			_ = frq.Close()
+		}()
+
+		request, err := frq.Enqueue("receiver", nil)
+		require.Nil(t, request)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("queue full should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequestsQueue()
+		args.MaxPendingRequests = 1
+		args.AccountProc = &mock.AccountProviderStub{
+			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return nil, errors.New("observers down")
+			},
+		}
+		args.RetryDelay = time.Hour
+		frq, _ := process.NewFaucetRequestsQueue(args)
+		defer func() {
+			_ = frq.Close()
+		}()
+
+		_, err := frq.Enqueue("receiver", nil)
+		require.NoError(t, err)
+
+		request, err := frq.Enqueue("receiver", nil)
+		require.Nil(t, request)
+		require.Equal(t, process.ErrFaucetQueueFull, err)
+	})
+	t.Run("should send the requests with increasing nonces", func(t *testing.T) {
+		t.Parallel()
+
+		mutSentTxs := sync.Mutex{}
+		sentTxs := make([]*data.Transaction, 0)
+		args := createMockArgsFaucetRequestsQueue()
+		args.AccountProc = &mock.AccountProviderStub{
+			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				require.Equal(t, "sender", address)
+				// the observers did not execute the sent transactions yet
+				return &data.AccountModel{Account: data.Account{Nonce: 5}}, nil
+			},
+		}
+		args.FaucetProc = &mock.FaucetTransactionsGeneratorStub{
+			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
+				return nil, "sender", nil
+			},
+			GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, networkConfig *data.NetworkConfig) (*data.Transaction, error) {
+				valueString := ""
+				if value != nil {
+					valueString = value.String()
+				}
+				return &data.Transaction{Nonce: senderNonce, Receiver: receiver, Value: valueString}, nil
+			},
+		}
+		args.TxSender = &mock.TransactionSenderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+				mutSentTxs.Lock()
+				sentTxs = append(sentTxs, tx)
+				mutSentTxs.Unlock()
+
+				return 200, &data.SentTransaction{TxHash: tx.Receiver + "-hash"}, nil
+			},
+		}
+		frq, _ := process.NewFaucetRequestsQueue(args)
+		defer func() {
+			_ = frq.Close()
+		}()
+
+		firstRequest, err := frq.Enqueue("receiver1", big.NewInt(7))
+		require.NoError(t, err)
+		require.Equal(t, process.FaucetRequestStatusPending, firstRequest.Status)
+		require.Equal(t, "7", firstRequest.Value)
+		firstRequest = waitForFaucetRequestStatus(t, frq, firstRequest.ID, process.FaucetRequestStatusCompleted)
+		require.Equal(t, "receiver1-hash", firstRequest.TxHash)
+		require.Equal(t, 1, firstRequest.Attempts)
+
+		secondRequest, err := frq.Enqueue("receiver2", nil)
+		require.NoError(t, err)
+		require.NotEqual(t, firstRequest.ID, secondRequest.ID)
+		waitForFaucetRequestStatus(t, frq, secondRequest.ID, process.FaucetRequestStatusCompleted)
+
+		mutSentTxs.Lock()
+		defer mutSentTxs.Unlock()
+		require.Equal(t, []*data.Transaction{
+			{Nonce: 5, Receiver: "receiver1", Value: "7"},
+			{Nonce: 6, Receiver: "receiver2"},
+		}, sentTxs)
+	})
+	t.Run("should retry and mark the request as failed", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		mutNumSent := sync.Mutex{}
+		args := createMockArgsFaucetRequestsQueue()
+		args.TxSender = &mock.TransactionSenderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+				mutNumSent.Lock()
+				numSent++
+				mutNumSent.Unlock()
+
+				return 500, nil, errors.New("observers down")
+			},
+		}
+		frq, _ := process.NewFaucetRequestsQueue(args)
+		defer func() {
+			_ = frq.Close()
+		}()
+
+		request, err := frq.Enqueue("receiver", nil)
+		require.NoError(t, err)
+
+		request = waitForFaucetRequestStatus(t, frq, request.ID, process.FaucetRequestStatusFailed)
+		require.Equal(t, 3, request.Attempts)
+		require.Equal(t, "observers down", request.Error)
+
+		mutNumSent.Lock()
+		defer mutNumSent.Unlock()
+		require.Equal(t, 3, numSent)
+	})
+}
+
+func TestFaucetRequestsQueue_GetRequestNotFoundShouldError(t *testing.T) {
+	t.Parallel()
+
+	frq, _ := process.NewFaucetRequestsQueue(createMockArgsFaucetRequestsQueue())
+	defer func() {
+		_ = frq.Close()
+	}()
+
+	request, err := frq.GetRequest("missing")
+	require.Nil(t, request)
+	require.Equal(t, process.ErrFaucetRequestNotFound, err)
+}
+
+func TestFaucetRequestsQueue_PendingRequestsShouldBeLoadedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := storage.NewBoltStorer(filepath.Join(t.TempDir(), "proxy.db"))
+	defer func() {
+		_ = storer.Close()
+	}()
+	args := createMockArgsFaucetRequestsQueue()
+	args.Storer = storer
+	args.TxSender = &mock.TransactionSenderStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return 500, nil, errors.New("observers down")
+		},
+	}
+	args.RetryDelay = time.Hour
+	frq, _ := process.NewFaucetRequestsQueue(args)
+
+	request, err := frq.Enqueue("receiver", big.NewInt(10))
+	require.NoError(t, err)
+	waitForFaucetRequestAttempts(t, frq, request.ID, 1)
+	require.NoError(t, frq.Close())
+
+	args = createMockArgsFaucetRequestsQueue()
+	args.Storer = storer
+	args.RetryDelay = 10 * time.Millisecond
+	args.TxSender = &mock.TransactionSenderStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, *data.SentTransaction, error) {
+			return 200, &data.SentTransaction{TxHash: "hash"}, nil
+		},
+	}
+	restartedQueue, _ := process.NewFaucetRequestsQueue(args)
+	defer func() {
+		_ = restartedQueue.Close()
+	}()
+
+	loadedRequest, err := restartedQueue.GetRequest(request.ID)
+	require.NoError(t, err)
+	require.Equal(t, "receiver", loadedRequest.Receiver)
+	require.Equal(t, "10", loadedRequest.Value)
+
+	// the retry delay set before the restart still applies
+	time.Sleep(50 * time.Millisecond)
+	loadedRequest, _ = restartedQueue.GetRequest(request.ID)
+	require.Equal(t, process.FaucetRequestStatusPending, loadedRequest.Status)
+}
+
+func waitForFaucetRequestAttempts(t *testing.T, frq *process.FaucetRequestsQueue, id string, numAttempts int) {
+	require.Eventually(t, func() bool {
+		request, err := frq.GetRequest(id)
+		require.NoError(t, err)
+
+		return request.Attempts == numAttempts
+	}, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,85 @@
+package process
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// FinalityProcessor decides whether the blocks and the transactions are deep enough below the chain tip to be reported
+// as final, by comparing their nonces against the current nonce of the shard
+type FinalityProcessor struct {
+	proc          Processor
+	finalityDepth uint64
+}
+
+// NewFinalityProcessor creates a new instance of FinalityProcessor. A block is final once at least finalityDepth blocks
+// were committed on top of it, a depth of 0 meaning that every committed block is final
+func NewFinalityProcessor(proc Processor, finalityDepth uint64) (*FinalityProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+
+	return &FinalityProcessor{
+		proc:          proc,
+		finalityDepth: finalityDepth,
+	}, nil
+}
+
+// IsBlockFinal returns true if the block with the provided nonce is at least finalityDepth blocks below the current
+// nonce of the shard
+func (fp *FinalityProcessor) IsBlockFinal(ctx context.Context, shardID uint32, nonce uint64) (bool, error) {
+	currentNonce, err := fp.getCurrentNonce(ctx, shardID)
+	if err != nil {
+		return false, err
+	}
+
+	return nonce+fp.finalityDepth <= currentNonce, nil
+}
+
+// IsTransactionFinal returns true if the block which included the transaction is final and, for the cross-shard
+// transactions notarized by the metachain, if the metachain block notarizing it in the destination shard is final too
+func (fp *FinalityProcessor) IsTransactionFinal(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	if tx == nil || tx.BlockNonce == 0 {
+		return false, nil
+	}
+
+	isFinal, err := fp.IsBlockFinal(ctx, tx.SourceShard, tx.BlockNonce)
+	if err != nil || !isFinal {
+		return false, err
+	}
+
+	isNotarizedByMetachain := tx.SourceShard != tx.DestinationShard && tx.NotarizedAtDestinationInMetaNonce > 0
+	if !isNotarizedByMetachain {
+		return true, nil
+	}
+
+	return fp.IsBlockFinal(ctx, core.MetachainShardId, tx.NotarizedAtDestinationInMetaNonce)
+}
+
+func (fp *FinalityProcessor) getCurrentNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	observers, err := fp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	nodeStatusResponse := data.GenericAPIResponse{}
+	for _, observer := range observers {
+		_, err = fp.proc.CallGetRestEndPoint(ctx, observer.Address, NodeStatusPath, &nodeStatusResponse)
+		if err != nil {
+			log.WithContext(ctx).Debug("cannot get the current nonce", "shard", shardID, "observer", observer.Address, "error", err)
+			continue
+		}
+
+		nonce, ok := getMetric(nodeStatusResponse.Data, MetricNonce)
+		if !ok {
+			return 0, ErrCannotParseNodeStatusMetrics
+		}
+
+		return getUint(nonce), nil
+	}
+
+	return 0, WrapObserversError(nodeStatusResponse.Error)
+}
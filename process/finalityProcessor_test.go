@@ -0,0 +1,178 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createProcessorWithCurrentNonces(currentNonces map[uint32]uint64) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			for shardID, nonce := range currentNonces {
+				if address != fmt.Sprintf("observer%d", shardID) || path != process.NodeStatusPath {
+					continue
+				}
+
+				response := value.(*data.GenericAPIResponse)
+				response.Data = map[string]interface{}{
+					"metrics": map[string]interface{}{
+						process.MetricNonce: float64(nonce),
+					},
+				}
+				return 0, nil
+			}
+
+			return 0, errors.New("observer offline")
+		},
+	}
+}
+
+func TestNewFinalityProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp, err := process.NewFinalityProcessor(nil, 3)
+		require.Nil(t, fp)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fp, err := process.NewFinalityProcessor(&mock.ProcessorStub{}, 3)
+		require.Nil(t, err)
+		require.NotNil(t, fp)
+	})
+}
+
+func TestFinalityProcessor_IsBlockFinal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fp, _ := process.NewFinalityProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, expectedErr
+			},
+		}, 3)
+
+		isFinal, err := fp.IsBlockFinal(context.Background(), 0, 10)
+		require.Equal(t, expectedErr, err)
+		require.False(t, isFinal)
+	})
+	t.Run("all observers offline should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := process.NewFinalityProcessor(createProcessorWithCurrentNonces(nil), 3)
+
+		isFinal, err := fp.IsBlockFinal(context.Background(), 0, 10)
+		require.ErrorIs(t, err, process.ErrSendingRequest)
+		require.False(t, isFinal)
+	})
+	t.Run("missing nonce metric should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := process.NewFinalityProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 0, nil
+			},
+		}, 3)
+
+		isFinal, err := fp.IsBlockFinal(context.Background(), 0, 10)
+		require.Equal(t, process.ErrCannotParseNodeStatusMetrics, err)
+		require.False(t, isFinal)
+	})
+	t.Run("should compare against the current nonce", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := process.NewFinalityProcessor(createProcessorWithCurrentNonces(map[uint32]uint64{0: 13}), 3)
+
+		isFinal, err := fp.IsBlockFinal(context.Background(), 0, 10)
+		require.Nil(t, err)
+		require.True(t, isFinal)
+
+		isFinal, err = fp.IsBlockFinal(context.Background(), 0, 11)
+		require.Nil(t, err)
+		require.False(t, isFinal)
+	})
+	t.Run("zero depth should consider the current block final", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := process.NewFinalityProcessor(createProcessorWithCurrentNonces(map[uint32]uint64{0: 13}), 0)
+
+		isFinal, err := fp.IsBlockFinal(context.Background(), 0, 13)
+		require.Nil(t, err)
+		require.True(t, isFinal)
+	})
+}
+
+func TestFinalityProcessor_IsTransactionFinal(t *testing.T) {
+	t.Parallel()
+
+	currentNonces := map[uint32]uint64{0: 20, 1: 20, core.MetachainShardId: 50}
+	fp, _ := process.NewFinalityProcessor(createProcessorWithCurrentNonces(currentNonces), 5)
+
+	t.Run("transaction not included in a block should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		isFinal, err := fp.IsTransactionFinal(context.Background(), &transaction.ApiTransactionResult{})
+		require.Nil(t, err)
+		require.False(t, isFinal)
+	})
+	t.Run("intra-shard transaction in a recent block should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		isFinal, err := fp.IsTransactionFinal(context.Background(), &transaction.ApiTransactionResult{BlockNonce: 16})
+		require.Nil(t, err)
+		require.False(t, isFinal)
+	})
+	t.Run("intra-shard transaction in a deep block should be final", func(t *testing.T) {
+		t.Parallel()
+
+		isFinal, err := fp.IsTransactionFinal(context.Background(), &transaction.ApiTransactionResult{BlockNonce: 15})
+		require.Nil(t, err)
+		require.True(t, isFinal)
+	})
+	t.Run("cross-shard transaction recently notarized by the metachain should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		isFinal, err := fp.IsTransactionFinal(context.Background(), &transaction.ApiTransactionResult{
+			SourceShard:                       0,
+			DestinationShard:                  1,
+			BlockNonce:                        10,
+			NotarizedAtDestinationInMetaNonce: 46,
+		})
+		require.Nil(t, err)
+		require.False(t, isFinal)
+	})
+	t.Run("cross-shard transaction notarized deep in the metachain should be final", func(t *testing.T) {
+		t.Parallel()
+
+		isFinal, err := fp.IsTransactionFinal(context.Background(), &transaction.ApiTransactionResult{
+			SourceShard:                       0,
+			DestinationShard:                  1,
+			BlockNonce:                        10,
+			NotarizedAtDestinationInMetaNonce: 45,
+		})
+		require.Nil(t, err)
+		require.True(t, isFinal)
+	})
+}
@@ -0,0 +1,183 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// GasPriceStrategyMinimum will always suggest the minimum gas price
+	GasPriceStrategyMinimum = "minimum"
+
+	// GasPriceStrategyLinear will increase the minimum gas price linearly with the pool size, up to the max multiplier
+	GasPriceStrategyLinear = "linear"
+
+	// GasPriceStrategyStep will suggest the maximum gas price once the pool size reaches the congestion threshold
+	GasPriceStrategyStep = "step"
+
+	poolSizeFields = "hash"
+
+	// poolSizeValidity is the duration for which a fetched pool size is reused, as fetching it means downloading the
+	// hashes of all the transactions in the pool, while the pool barely changes within a round
+	poolSizeValidity = 6 * time.Second
+)
+
+type fetchedPoolSize struct {
+	size      uint64
+	fetchedAt time.Time
+}
+
+// GasPriceProcessor is able to compute gas price suggestions based on the transactions pool congestion
+type GasPriceProcessor struct {
+	proc                Processor
+	strategy            string
+	congestionThreshold uint64
+	maxMultiplier       float64
+	poolSizes           map[uint32]*fetchedPoolSize
+	mutPoolSizes        sync.RWMutex
+}
+
+// NewGasPriceProcessor creates a new instance of GasPriceProcessor
+func NewGasPriceProcessor(
+	proc Processor,
+	strategy string,
+	congestionThreshold uint64,
+	maxMultiplier float64,
+) (*GasPriceProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	switch strategy {
+	case GasPriceStrategyMinimum, GasPriceStrategyLinear, GasPriceStrategyStep:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGasPriceStrategy, strategy)
+	}
+	if congestionThreshold == 0 {
+		return nil, ErrInvalidCongestionThreshold
+	}
+	if maxMultiplier < 1 {
+		return nil, ErrInvalidGasPriceMultiplier
+	}
+
+	return &GasPriceProcessor{
+		proc:                proc,
+		strategy:            strategy,
+		congestionThreshold: congestionThreshold,
+		maxMultiplier:       maxMultiplier,
+		poolSizes:           make(map[uint32]*fetchedPoolSize),
+	}, nil
+}
+
+// GetGasPriceSuggestion returns the gas price suggestion for each shard, based on the size of its transactions pool.
+// The overall suggestion is the highest one, so it can be used regardless of the sender's shard
+func (gpp *GasPriceProcessor) GetGasPriceSuggestion(ctx context.Context, minGasPrice uint64) (*data.GasPriceSuggestion, error) {
+	suggestion := &data.GasPriceSuggestion{
+		MinGasPrice:       minGasPrice,
+		SuggestedGasPrice: minGasPrice,
+		Strategy:          gpp.strategy,
+		Shards:            make([]*data.ShardGasPriceSuggestion, 0),
+	}
+
+	for _, shardID := range gpp.proc.GetShardIDs() {
+		// transactions are never sent from the metachain, so its pool is not relevant for the senders
+		if shardID == core.MetachainShardId {
+			continue
+		}
+
+		poolSize, err := gpp.getPoolSize(ctx, shardID)
+		if err != nil {
+			log.WithContext(ctx).Warn("cannot get transactions pool size", "shard", shardID, "error", err)
+			continue
+		}
+
+		shardSuggestion := &data.ShardGasPriceSuggestion{
+			ShardID:           shardID,
+			PoolSize:          poolSize,
+			SuggestedGasPrice: gpp.computeGasPrice(minGasPrice, poolSize),
+		}
+		suggestion.Shards = append(suggestion.Shards, shardSuggestion)
+
+		if shardSuggestion.SuggestedGasPrice > suggestion.SuggestedGasPrice {
+			suggestion.SuggestedGasPrice = shardSuggestion.SuggestedGasPrice
+		}
+	}
+
+	if len(suggestion.Shards) == 0 {
+		return nil, ErrNoTransactionsPoolAvailable
+	}
+
+	return suggestion, nil
+}
+
+// getPoolSize returns the size of the shard's transactions pool, fetched again from its observers only once the
+// previously fetched one is older than poolSizeValidity
+func (gpp *GasPriceProcessor) getPoolSize(ctx context.Context, shardID uint32) (uint64, error) {
+	gpp.mutPoolSizes.RLock()
+	poolSize, found := gpp.poolSizes[shardID]
+	gpp.mutPoolSizes.RUnlock()
+	if found && time.Since(poolSize.fetchedAt) < poolSizeValidity {
+		return poolSize.size, nil
+	}
+
+	size, err := gpp.fetchPoolSize(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	gpp.mutPoolSizes.Lock()
+	gpp.poolSizes[shardID] = &fetchedPoolSize{
+		size:      size,
+		fetchedAt: time.Now(),
+	}
+	gpp.mutPoolSizes.Unlock()
+
+	return size, nil
+}
+
+func (gpp *GasPriceProcessor) fetchPoolSize(ctx context.Context, shardID uint32) (uint64, error) {
+	observers, err := gpp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	apiPath := TransactionsPoolPath + fieldsParam + poolSizeFields
+	for _, observer := range observers {
+		response := &data.TransactionsPoolApiResponse{}
+		_, err = gpp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, response)
+		if err != nil {
+			log.WithContext(ctx).Error("transactions pool request", "shard ID", observer.ShardId, "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return uint64(len(response.Data.Transactions.RegularTransactions)), nil
+	}
+
+	return 0, ErrSendingRequest
+}
+
+func (gpp *GasPriceProcessor) computeGasPrice(minGasPrice uint64, poolSize uint64) uint64 {
+	switch gpp.strategy {
+	case GasPriceStrategyLinear:
+		congestionRatio := math.Min(float64(poolSize)/float64(gpp.congestionThreshold), 1)
+		return uint64(float64(minGasPrice) * (1 + congestionRatio*(gpp.maxMultiplier-1)))
+	case GasPriceStrategyStep:
+		if poolSize >= gpp.congestionThreshold {
+			return uint64(float64(minGasPrice) * gpp.maxMultiplier)
+		}
+		return minGasPrice
+	default:
+		return minGasPrice
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpp *GasPriceProcessor) IsInterfaceNil() bool {
+	return gpp == nil
+}
@@ -0,0 +1,168 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasPriceProcessorStub(poolSizes map[string]int) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1, core.MetachainShardId}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			switch shardId {
+			case 0:
+				return []*data.NodeData{{Address: "shard0", ShardId: 0}}, nil
+			case 1:
+				return []*data.NodeData{{Address: "shard1", ShardId: 1}}, nil
+			default:
+				return []*data.NodeData{{Address: "meta", ShardId: core.MetachainShardId}}, nil
+			}
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			poolSize, ok := poolSizes[address]
+			if !ok {
+				return 0, errors.New("observer unavailable")
+			}
+
+			response := value.(*data.TransactionsPoolApiResponse)
+			response.Data.Transactions.RegularTransactions = make([]data.WrappedTransaction, poolSize)
+			return 0, nil
+		},
+	}
+}
+
+func TestNewGasPriceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		gpp, err := process.NewGasPriceProcessor(nil, process.GasPriceStrategyLinear, 100, 2)
+		assert.Nil(t, gpp)
+		assert.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("invalid strategy should error", func(t *testing.T) {
+		t.Parallel()
+
+		gpp, err := process.NewGasPriceProcessor(&mock.ProcessorStub{}, "exponential", 100, 2)
+		assert.Nil(t, gpp)
+		assert.True(t, errors.Is(err, process.ErrInvalidGasPriceStrategy))
+	})
+	t.Run("invalid congestion threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		gpp, err := process.NewGasPriceProcessor(&mock.ProcessorStub{}, process.GasPriceStrategyLinear, 0, 2)
+		assert.Nil(t, gpp)
+		assert.Equal(t, process.ErrInvalidCongestionThreshold, err)
+	})
+	t.Run("invalid multiplier should error", func(t *testing.T) {
+		t.Parallel()
+
+		gpp, err := process.NewGasPriceProcessor(&mock.ProcessorStub{}, process.GasPriceStrategyLinear, 100, 0.5)
+		assert.Nil(t, gpp)
+		assert.Equal(t, process.ErrInvalidGasPriceMultiplier, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gpp, err := process.NewGasPriceProcessor(&mock.ProcessorStub{}, process.GasPriceStrategyStep, 100, 2)
+		assert.NoError(t, err)
+		assert.False(t, gpp.IsInterfaceNil())
+	})
+}
+
+func TestGasPriceProcessor_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	minGasPrice := uint64(1000000000)
+	t.Run("minimum strategy should always suggest the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{"shard0": 500, "shard1": 50})
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyMinimum, 100, 2)
+
+		suggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, minGasPrice, suggestion.MinGasPrice)
+		assert.Equal(t, minGasPrice, suggestion.SuggestedGasPrice)
+		assert.Equal(t, process.GasPriceStrategyMinimum, suggestion.Strategy)
+		require.Len(t, suggestion.Shards, 2)
+	})
+	t.Run("linear strategy should scale with the pool size", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{"shard0": 50, "shard1": 500, "meta": 10000})
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyLinear, 100, 2)
+
+		suggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		require.Len(t, suggestion.Shards, 2)
+		assert.Equal(t, &data.ShardGasPriceSuggestion{ShardID: 0, PoolSize: 50, SuggestedGasPrice: 1500000000}, suggestion.Shards[0])
+		assert.Equal(t, &data.ShardGasPriceSuggestion{ShardID: 1, PoolSize: 500, SuggestedGasPrice: 2000000000}, suggestion.Shards[1])
+		assert.Equal(t, uint64(2000000000), suggestion.SuggestedGasPrice)
+	})
+	t.Run("step strategy should jump once the threshold is reached", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{"shard0": 99, "shard1": 100})
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyStep, 100, 3)
+
+		suggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		require.Len(t, suggestion.Shards, 2)
+		assert.Equal(t, minGasPrice, suggestion.Shards[0].SuggestedGasPrice)
+		assert.Equal(t, 3*minGasPrice, suggestion.Shards[1].SuggestedGasPrice)
+		assert.Equal(t, 3*minGasPrice, suggestion.SuggestedGasPrice)
+	})
+	t.Run("unavailable shard should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{"shard1": 0})
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyLinear, 100, 2)
+
+		suggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		require.Len(t, suggestion.Shards, 1)
+		assert.Equal(t, uint32(1), suggestion.Shards[0].ShardID)
+		assert.Equal(t, minGasPrice, suggestion.SuggestedGasPrice)
+	})
+	t.Run("pool sizes should be reused for the following requests", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{"shard0": 50, "shard1": 500})
+		numPoolRequests := 0
+		callGetRestEndPoint := proc.CallGetRestEndPointCalled
+		proc.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			numPoolRequests++
+			return callGetRestEndPoint(address, path, value)
+		}
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyLinear, 100, 2)
+
+		firstSuggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		secondSuggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, firstSuggestion, secondSuggestion)
+		assert.Equal(t, 2, numPoolRequests)
+	})
+	t.Run("no shard available should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := createGasPriceProcessorStub(map[string]int{})
+		gpp, _ := process.NewGasPriceProcessor(proc, process.GasPriceStrategyLinear, 100, 2)
+
+		suggestion, err := gpp.GetGasPriceSuggestion(context.Background(), minGasPrice)
+		assert.Nil(t, suggestion)
+		assert.Equal(t, process.ErrNoTransactionsPoolAvailable, err)
+	})
+}
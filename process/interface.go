@@ -1,7 +1,10 @@
 package process
 
 import (
+	"context"
+	"math/big"
 	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -16,6 +19,7 @@ import (
 // Processor defines what a processor should be able to do
 type Processor interface {
 	GetObservers(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetObserversForWrite(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllObservers(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetObserversOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetFullHistoryNodesOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
@@ -23,12 +27,22 @@ type Processor interface {
 	GetAllFullHistoryNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinator() common.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetExcludedObservers() []*data.ExcludedObserver
+	GetObserversNonces() []*data.ObserverNonce
+	RecordWriteObserver(sender string, observerAddress string)
+	PreferWriteObserver(sender string, observers []*data.NodeData) []*data.NodeData
+	IsInterfaceNil() bool
+}
+
+// NodesStateChecker defines what a component which periodically checks the sync state of the observers should do
+type NodesStateChecker interface {
+	GetLastNodesStateCheckTime() time.Time
 	IsInterfaceNil() bool
 }
 
@@ -44,6 +58,40 @@ type HeartbeatCacheHandler interface {
 	IsInterfaceNil() bool
 }
 
+// FaucetTransactionsGenerator defines what a component able to generate the signed faucet transactions should do
+type FaucetTransactionsGenerator interface {
+	SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error)
+	GenerateTxForSendUserFunds(
+		senderSk crypto.PrivateKey,
+		senderPk string,
+		senderNonce uint64,
+		receiver string,
+		value *big.Int,
+		networkConfig *data.NetworkConfig,
+	) (*data.Transaction, error)
+}
+
+// AccountProvider defines what a component able to fetch the accounts should do
+type AccountProvider interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// NetworkConfigProvider defines what a component able to provide the network config should do
+type NetworkConfigProvider interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+}
+
+// TransactionSender defines what a component able to send the transactions to the observers should do
+type TransactionSender interface {
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, *data.SentTransaction, error)
+}
+
+// ValidatorStatisticsProvider defines what a component able to provide the validator statistics should do
+type ValidatorStatisticsProvider interface {
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
+	IsInterfaceNil() bool
+}
+
 // ValidatorStatisticsCacheHandler will define what a real validator statistics cacher should do
 type ValidatorStatisticsCacheHandler interface {
 	LoadValStats() (map[string]*data.ValidatorApiResponse, error)
@@ -60,7 +108,7 @@ type GenericApiResponseCacheHandler interface {
 
 // TransactionCostHandler will define what a real transaction cost handler should do
 type TransactionCostHandler interface {
-	ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	ResolveCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // LogsMergerHandler will define what a real merge logs handler should do
@@ -71,7 +119,130 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	IsInterfaceNil() bool
+}
+
+// ShardIDCacher defines what a computed shard IDs cache should be able to do
+type ShardIDCacher interface {
+	Get(addressBuff []byte) (uint32, bool)
+	Put(addressBuff []byte, shardID uint32)
+	GetMetrics() data.ShardIDCacheMetrics
+	IsInterfaceNil() bool
+}
+
+// TxStatusCacher defines what a transaction statuses cache should be able to do
+type TxStatusCacher interface {
+	Get(txHash string) (transaction.TxStatus, bool)
+	Put(txHash string, status transaction.TxStatus)
+	IsInterfaceNil() bool
+}
+
+// HyperblocksCacher defines what a cache of the recently requested hyperblocks should be able to do
+type HyperblocksCacher interface {
+	Get(key string) (*data.HyperblockApiResponse, bool)
+	Put(key string, hyperblock *data.HyperblockApiResponse)
+	IsInterfaceNil() bool
+}
+
+// ReorgDetector defines what a component detecting the chain reorganizations from the served blocks should do
+type ReorgDetector interface {
+	RecordBlock(shardID uint32, nonce uint64, hash string)
+	GetRecentReorgs() []*data.ChainReorg
+	GetMetrics() data.ReorgDetectorMetrics
+	IsInterfaceNil() bool
+}
+
+// ESDTMetadataCache defines what a cache of the tokens metadata, invalidated when the tokens change on chain, should do
+type ESDTMetadataCache interface {
+	GetTokenProperties(token string) (*data.Collection, bool)
+	PutTokenProperties(token string, properties *data.Collection)
+	GetTokenRoles(token string) (map[string][]string, bool)
+	PutTokenRoles(token string, roles map[string][]string)
+	Invalidate(token string)
+	InvalidateAll()
+	IsInterfaceNil() bool
+}
+
+// SentTxsCacher defines what a cache of the recently sent transactions should be able to do
+type SentTxsCacher interface {
+	TryMarkSent(txHash string) bool
+	UnmarkSent(txHash string)
+	IsInterfaceNil() bool
+}
+
+// EventsABIRegistry defines what a component which decodes the events of the contracts with registered ABIs should
+// be able to do
+type EventsABIRegistry interface {
+	DecodeEvent(event *transaction.Events) *data.TransactionDecodedEvent
+	IsInterfaceNil() bool
+}
+
+// TransactionsPolicyChecker defines what a component which enforces the operator's policy on the relayed transactions
+// should be able to do
+type TransactionsPolicyChecker interface {
+	CheckTransaction(tx *data.Transaction) error
+	IsInterfaceNil() bool
+}
+
+// ObserversAffinityCacher defines what a cache of the observers which accepted the recent transactions of each sender
+// should be able to do
+type ObserversAffinityCacher interface {
+	Put(sender string, observer string)
+	Get(sender string) (string, bool)
+	IsInterfaceNil() bool
+}
+
+// CacheSnapshotPersister defines what a component which saves the snapshots of the cached data, so they are warm-loaded
+// after a restart, should be able to do
+type CacheSnapshotPersister interface {
+	SaveSnapshot(key string, value interface{}) error
+	LoadSnapshot(key string, value interface{}) (time.Time, bool)
+	IsInterfaceNil() bool
+}
+
+// Storer defines what a key-value storage persisting the proxy-side data, such as the faucet requests or the cache
+// snapshots, should be able to do
+type Storer interface {
+	Put(key string, value []byte) error
+	Get(key string) ([]byte, error)
+	Remove(key string) error
+	Keys(prefix string) ([]string, error)
+	IsInterfaceNil() bool
+}
+
+// ObserversLatencyProvider defines what a component which tracks the recent latency of the observers should do
+type ObserversLatencyProvider interface {
+	AddObserverRequestData(address string, method string, duration time.Duration)
+	GetObserverLatency(address string, method string) (time.Duration, bool)
+	IsInterfaceNil() bool
+}
+
+// ObserversRanker defines what a component able to order the observers for reads and writes should do
+type ObserversRanker interface {
+	RecordRequest(address string, method string, duration time.Duration, success bool)
+	RankForReads(nodes []*data.NodeData) []*data.NodeData
+	RankForWrites(nodes []*data.NodeData) []*data.NodeData
+	IsInterfaceNil() bool
+}
+
+// ObserverEventsNotifier defines what a component notifying the observers failover events should do
+type ObserverEventsNotifier interface {
+	NotifyObserverEvents(events []*data.ObserverEvent)
+	IsInterfaceNil() bool
+}
+
+// BlockByNonceProvider defines what a component able to fetch the blocks by nonce should do
+type BlockByNonceProvider interface {
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// BlocksExportSource defines what a component providing the blocks to be exported should do
+type BlocksExportSource interface {
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -82,6 +253,21 @@ type StatusMetricsProvider interface {
 	IsInterfaceNil() bool
 }
 
+// DataFreshnessChecker defines what a component reading the accounts from multiple observers, in order to check the
+// freshness of the returned data, should do
+type DataFreshnessChecker interface {
+	IsEnabled() bool
+	GetAccountFromObservers(ctx context.Context, observers []*data.NodeData, apiPath string) (*data.AccountModel, *data.NodeData, error)
+	IsInterfaceNil() bool
+}
+
+// PayloadSigner defines what a component signing a payload along with the time it was produced at should do
+type PayloadSigner interface {
+	SignPayload(timestamp int64, payload []byte) ([]byte, error)
+	SignerID() string
+	IsInterfaceNil() bool
+}
+
 // HttpClient defines an interface for the http client
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -92,3 +278,43 @@ type TxNotarizationCheckerHandler interface {
 	IsNotarized(tx transaction.ApiTransactionResult) bool
 	IsInterfaceNil() bool
 }
+
+// TokenPriceProvider defines what a source of token prices, such as an external price API, should be able to do
+type TokenPriceProvider interface {
+	IsEnabled() bool
+	GetTokenPrice(token string) (*data.TokenPrice, error)
+	IsInterfaceNil() bool
+}
+
+// ExternalStorageConnector defines what a connector to an external storage, such as the Elasticsearch cluster fed by
+// the indexer, should be able to do
+type ExternalStorageConnector interface {
+	IsEnabled() bool
+	GetAddressActivitySummary(address string) (*data.AddressActivitySummary, error)
+	GetAddressLatestTransactions(address string, size int) ([]data.DatabaseTransaction, error)
+	GetTokenHolders(token string, size int, cursor string) (*data.TokenHoldersPage, error)
+	IsInterfaceNil() bool
+}
+
+// EconomicsMetricsProvider defines what a component able to provide the economics metrics along with the network
+// config should do
+type EconomicsMetricsProvider interface {
+	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+}
+
+// ValidatorsDataProvider defines what a component able to provide the validator statistics and the auction list
+// should do
+type ValidatorsDataProvider interface {
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
+	GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ClientsUsageHandler defines what a component tracking the requests made by each client should do
+type ClientsUsageHandler interface {
+	AddRequest(apiKey string, withError bool, bytesReceived uint64, bytesSent uint64)
+	GetUsage(apiKey string, from time.Time, to time.Time) (*data.ClientUsageReport, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// setObserverAuthorizationHeader attaches the credentials of the observer, if any, to the request. The bearer token
+// takes precedence over the basic auth credentials
+func setObserverAuthorizationHeader(req *http.Request, node *proxyData.NodeData) {
+	if node == nil {
+		return
+	}
+	if len(node.BearerToken) > 0 {
+		req.Header.Set("Authorization", "Bearer "+node.BearerToken)
+		return
+	}
+	if len(node.Username) > 0 {
+		req.SetBasicAuth(node.Username, node.Password)
+	}
+}
+
+// isIndexedNode returns true if the node holds details that are needed when calling it by address: its credentials
+// or its zone
+func isIndexedNode(node *proxyData.NodeData) bool {
+	return node.HasCredentials() || len(node.Zone) > 0
+}
+
+// refreshKnownObservers rebuilds the index of the nodes holding credentials or a zone from the nodes currently known
+// by the providers, so that reloaded, added or removed nodes are reflected
+func (bp *BaseProcessor) refreshKnownObservers() {
+	nodes := bp.observersProvider.GetAllNodesWithSyncState()
+	nodes = append(nodes, bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()...)
+
+	knownObservers := make(map[string]*proxyData.NodeData)
+	for _, node := range nodes {
+		if isIndexedNode(node) {
+			knownObservers[node.Address] = node
+		}
+	}
+
+	bp.mutKnownObservers.Lock()
+	bp.knownObservers = knownObservers
+	bp.mutKnownObservers.Unlock()
+}
+
+func (bp *BaseProcessor) setKnownObserver(node *proxyData.NodeData) {
+	bp.getKnownObservers()
+
+	bp.mutKnownObservers.Lock()
+	defer bp.mutKnownObservers.Unlock()
+
+	if !isIndexedNode(node) {
+		delete(bp.knownObservers, node.Address)
+		return
+	}
+
+	bp.knownObservers[node.Address] = node
+}
+
+// getKnownObservers returns the index of the nodes holding credentials or a zone, building it on first use so that no
+// node provider is queried before it is needed
+func (bp *BaseProcessor) getKnownObservers() map[string]*proxyData.NodeData {
+	bp.mutKnownObservers.RLock()
+	knownObservers := bp.knownObservers
+	bp.mutKnownObservers.RUnlock()
+	if knownObservers != nil {
+		return knownObservers
+	}
+
+	bp.refreshKnownObservers()
+
+	bp.mutKnownObservers.RLock()
+	defer bp.mutKnownObservers.RUnlock()
+
+	return bp.knownObservers
+}
+
+func (bp *BaseProcessor) getKnownObserver(address string) *proxyData.NodeData {
+	knownObservers := bp.getKnownObservers()
+
+	bp.mutKnownObservers.RLock()
+	defer bp.mutKnownObservers.RUnlock()
+
+	return knownObservers[address]
+}
+
+func (bp *BaseProcessor) setAuthorizationHeader(req *http.Request, address string) {
+	setObserverAuthorizationHeader(req, bp.getKnownObserver(address))
+}
+
+// recordObserverZone records the zone of the observer which answered in the context of the request being served, so it
+// can be exposed to the client. The goroutines fanning out the observer calls share that context, so their answers are
+// recorded as well
+func (bp *BaseProcessor) recordObserverZone(ctx context.Context, address string) {
+	node := bp.getKnownObserver(address)
+	if node != nil {
+		common.SetObserverZone(ctx, node.Zone)
+	}
+}
+
+// preferLocalZone moves the nodes in the proxy's zone in front of the others, keeping the order within each group.
+// The nodes in other zones remain available, so the requests fail over to them when all the local ones are down
+func (bp *BaseProcessor) preferLocalZone(nodes []*proxyData.NodeData) []*proxyData.NodeData {
+	if len(bp.localZone) == 0 || len(nodes) < 2 {
+		return nodes
+	}
+
+	localNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	remoteNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Zone == bp.localZone {
+			localNodes = append(localNodes, node)
+			continue
+		}
+
+		remoteNodes = append(remoteNodes, node)
+	}
+
+	return append(localNodes, remoteNodes...)
+}
@@ -0,0 +1,30 @@
+package process
+
+import (
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+)
+
+const (
+	// MessageSchemePrefixed is the scheme used by the wallets for signing arbitrary messages: the signed payload is
+	// keccak(prefix | message length | message)
+	MessageSchemePrefixed = "prefixed"
+	// MessageSchemeRaw is the scheme in which the message bytes are signed as they are
+	MessageSchemeRaw = "raw"
+
+	signedMessagePrefix = "\x17Elrond Signed Message:\n"
+)
+
+// computeSignablePayload returns the bytes that were signed for the provided message and scheme
+func computeSignablePayload(message []byte, scheme string) ([]byte, error) {
+	switch scheme {
+	case MessageSchemePrefixed:
+		payload := signedMessagePrefix + strconv.Itoa(len(message)) + string(message)
+		return keccak.NewKeccak().Compute(payload), nil
+	case MessageSchemeRaw:
+		return message, nil
+	default:
+		return nil, ErrUnknownSignatureScheme
+	}
+}
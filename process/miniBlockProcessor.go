@@ -0,0 +1,168 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const maxParallelMiniBlockTxsLookups = 16
+
+type miniBlockApiResponse struct {
+	Data struct {
+		MiniBlock *block.MiniBlock `json:"miniblock"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// GetMiniBlockByHash returns the miniblock with the provided hash, as stored by the given shard. If requested, its
+// transactions hashes are hydrated into full transactions, looked up in parallel on the miniblock's shards
+func (bp *BlockProcessor) GetMiniBlockByHash(ctx context.Context, shardID uint32, hash string, options common.MiniBlockQueryOptions) (*data.MiniBlock, error) {
+	epoch := options.Epoch.Value
+	if !options.Epoch.HasValue {
+		currentEpoch, err := bp.getCurrentEpoch(ctx, shardID)
+		if err != nil {
+			return nil, err
+		}
+		epoch = currentEpoch
+	}
+
+	miniBlock, err := bp.getMiniBlockFromObservers(ctx, shardID, hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &data.MiniBlock{
+		Hash:             hash,
+		Type:             miniBlock.Type.String(),
+		SourceShard:      miniBlock.SenderShardID,
+		DestinationShard: miniBlock.ReceiverShardID,
+		Epoch:            epoch,
+		TxHashes:         make([]string, 0, len(miniBlock.TxHashes)),
+	}
+	for _, txHash := range miniBlock.TxHashes {
+		result.TxHashes = append(result.TxHashes, hex.EncodeToString(txHash))
+	}
+	if !options.WithTransactions {
+		return result, nil
+	}
+
+	// the queried shard is tried first, the cross shard transactions being also stored by the other miniblock's shard
+	lookupShards := []uint32{shardID}
+	if miniBlock.SenderShardID != shardID {
+		lookupShards = append(lookupShards, miniBlock.SenderShardID)
+	}
+	if miniBlock.ReceiverShardID != shardID && miniBlock.ReceiverShardID != miniBlock.SenderShardID {
+		lookupShards = append(lookupShards, miniBlock.ReceiverShardID)
+	}
+	result.Transactions, result.MissingTxHashes = bp.getMiniBlockTransactions(ctx, result.TxHashes, lookupShards)
+
+	return result, nil
+}
+
+func (bp *BlockProcessor) getCurrentEpoch(ctx context.Context, shardID uint32) (uint32, error) {
+	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	response := data.NetworkStatusApiResponse{}
+	for _, observer := range observers {
+		_, err = bp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("network status request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return response.Data.Status.EpochNumber, nil
+	}
+
+	return 0, WrapObserversError(response.Error)
+}
+
+func (bp *BlockProcessor) getMiniBlockFromObservers(ctx context.Context, shardID uint32, hash string, epoch uint32) (*block.MiniBlock, error) {
+	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	path, _ := buildInternalMiniBlockPath(hash, epoch, common.Internal)
+	response := miniBlockApiResponse{}
+	for _, observer := range observers {
+		_, err = bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("miniblock request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+		if response.Data.MiniBlock == nil {
+			return nil, ErrMiniBlockNotFound
+		}
+
+		log.WithContext(ctx).Info("miniblock request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		return response.Data.MiniBlock, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+// getMiniBlockTransactions looks up the transactions in parallel, keeping their order in the miniblock
+func (bp *BlockProcessor) getMiniBlockTransactions(ctx context.Context, txHashes []string, lookupShards []uint32) ([]*transaction.ApiTransactionResult, []string) {
+	txs := make([]*transaction.ApiTransactionResult, len(txHashes))
+	throttler := make(chan struct{}, maxParallelMiniBlockTxsLookups)
+	wg := sync.WaitGroup{}
+	wg.Add(len(txHashes))
+	for idx, txHash := range txHashes {
+		throttler <- struct{}{}
+		go func(idx int, txHash string) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			txs[idx] = bp.getMiniBlockTransaction(ctx, txHash, lookupShards)
+		}(idx, txHash)
+	}
+	wg.Wait()
+
+	foundTxs := make([]*transaction.ApiTransactionResult, 0, len(txs))
+	missingTxHashes := make([]string, 0)
+	for idx, tx := range txs {
+		if tx == nil {
+			missingTxHashes = append(missingTxHashes, txHashes[idx])
+			continue
+		}
+
+		foundTxs = append(foundTxs, tx)
+	}
+
+	return foundTxs, missingTxHashes
+}
+
+func (bp *BlockProcessor) getMiniBlockTransaction(ctx context.Context, txHash string, lookupShards []uint32) *transaction.ApiTransactionResult {
+	for _, shardID := range lookupShards {
+		observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+		if err != nil {
+			continue
+		}
+
+		for _, observer := range observers {
+			response := &data.GetTransactionResponse{}
+			respCode, errGet := bp.proc.CallGetRestEndPoint(ctx, observer.Address, TransactionPath+txHash, response)
+			if errGet != nil || respCode != http.StatusOK {
+				log.WithContext(ctx).Trace("cannot get miniblock transaction", "observer", observer.Address, "hash", txHash, "http code", respCode)
+				continue
+			}
+
+			return &response.Data.Transaction
+		}
+	}
+
+	return nil
+}
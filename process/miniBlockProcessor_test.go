@@ -0,0 +1,147 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMiniBlockProcessorStub(miniBlock *block.MiniBlock, txsByShard map[uint32][]string) (*mock.ProcessorStub, *sync.Map) {
+	calledPaths := &sync.Map{}
+	processorStub := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return nil, errors.New("no full history node")
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: fmt.Sprintf("shard%d", shardId), ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			calledPaths.Store(address+path, struct{}{})
+			var response interface{}
+			switch {
+			case path == process.NetworkStatusPath:
+				response = map[string]interface{}{"data": map[string]interface{}{"status": data.NetworkStatusMetrics{EpochNumber: 7}}}
+			case strings.HasPrefix(path, "/internal/json/miniblock/by-hash/"):
+				response = map[string]interface{}{"data": map[string]interface{}{"miniblock": miniBlock}}
+			case strings.HasPrefix(path, process.TransactionPath):
+				txHash := strings.TrimPrefix(path, process.TransactionPath)
+				shardTxs := txsByShard[shardIDFromAddress(address)]
+				found := false
+				for _, shardTx := range shardTxs {
+					found = found || shardTx == txHash
+				}
+				if !found {
+					return http.StatusNotFound, errors.New("transaction not found")
+				}
+				response = map[string]interface{}{"data": map[string]interface{}{"transaction": transaction.ApiTransactionResult{Hash: txHash}}}
+			default:
+				return http.StatusNotFound, errors.New("unexpected path")
+			}
+
+			buff, _ := json.Marshal(response)
+			return http.StatusOK, json.Unmarshal(buff, value)
+		},
+	}
+
+	return processorStub, calledPaths
+}
+
+func shardIDFromAddress(address string) uint32 {
+	var shardID uint32
+	_, _ = fmt.Sscanf(address, "shard%d", &shardID)
+
+	return shardID
+}
+
+func TestBlockProcessor_GetMiniBlockByHash(t *testing.T) {
+	t.Parallel()
+
+	txHashes := [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")}
+	miniBlock := &block.MiniBlock{
+		TxHashes:        txHashes,
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	hexTxHashes := []string{hex.EncodeToString(txHashes[0]), hex.EncodeToString(txHashes[1]), hex.EncodeToString(txHashes[2])}
+
+	t.Run("without transactions should only return the hashes", func(t *testing.T) {
+		t.Parallel()
+
+		processorStub, calledPaths := createMiniBlockProcessorStub(miniBlock, nil)
+		bp, _ := process.NewBlockProcessor(processorStub, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		options := common.MiniBlockQueryOptions{}
+		result, err := bp.GetMiniBlockByHash(context.Background(), 1, "aabb", options)
+		require.Nil(t, err)
+
+		expectedResult := &data.MiniBlock{
+			Hash:             "aabb",
+			Type:             "TxBlock",
+			SourceShard:      0,
+			DestinationShard: 1,
+			Epoch:            7,
+			TxHashes:         hexTxHashes,
+		}
+		require.Equal(t, expectedResult, result)
+		_, called := calledPaths.Load("shard1/internal/json/miniblock/by-hash/aabb/epoch/7")
+		require.True(t, called)
+	})
+	t.Run("provided epoch should be used", func(t *testing.T) {
+		t.Parallel()
+
+		processorStub, calledPaths := createMiniBlockProcessorStub(miniBlock, nil)
+		bp, _ := process.NewBlockProcessor(processorStub, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		options := common.MiniBlockQueryOptions{Epoch: core.OptionalUint32{Value: 3, HasValue: true}}
+		result, err := bp.GetMiniBlockByHash(context.Background(), 1, "aabb", options)
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), result.Epoch)
+		_, called := calledPaths.Load("shard1" + process.NetworkStatusPath)
+		require.False(t, called)
+	})
+	t.Run("with transactions should hydrate them from the miniblock's shards", func(t *testing.T) {
+		t.Parallel()
+
+		txsByShard := map[uint32][]string{
+			1: {hexTxHashes[0]},
+			0: {hexTxHashes[0], hexTxHashes[2]},
+		}
+		processorStub, _ := createMiniBlockProcessorStub(miniBlock, txsByShard)
+		bp, _ := process.NewBlockProcessor(processorStub, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		options := common.MiniBlockQueryOptions{WithTransactions: true}
+		result, err := bp.GetMiniBlockByHash(context.Background(), 1, "aabb", options)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(result.Transactions))
+		require.Equal(t, hexTxHashes[0], result.Transactions[0].Hash)
+		require.Equal(t, hexTxHashes[2], result.Transactions[1].Hash)
+		require.Equal(t, []string{hexTxHashes[1]}, result.MissingTxHashes)
+	})
+	t.Run("missing miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		processorStub, _ := createMiniBlockProcessorStub(nil, nil)
+		bp, _ := process.NewBlockProcessor(processorStub, 100, &disabled.HyperblocksCache{}, &disabled.ReorgDetector{})
+
+		result, err := bp.GetMiniBlockByHash(context.Background(), 1, "aabb", common.MiniBlockQueryOptions{})
+		require.Nil(t, result)
+		require.Equal(t, process.ErrMiniBlockNotFound, err)
+	})
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountProviderStub -
+type AccountProviderStub struct {
+	GetAccountCalled func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// GetAccount -
+func (stub *AccountProviderStub) GetAccount(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	if stub.GetAccountCalled != nil {
+		return stub.GetAccountCalled(address, options)
+	}
+
+	return &data.AccountModel{}, nil
+}
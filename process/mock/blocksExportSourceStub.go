@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlocksExportSourceStub -
+type BlocksExportSourceStub struct {
+	GetBlockByNonceCalled         func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByNonceCalled    func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetInternalBlockByNonceCalled func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+}
+
+// GetBlockByNonce -
+func (stub *BlocksExportSourceStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if stub.GetBlockByNonceCalled != nil {
+		return stub.GetBlockByNonceCalled(shardID, nonce, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+// GetHyperBlockByNonce -
+func (stub *BlocksExportSourceStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByNonceCalled != nil {
+		return stub.GetHyperBlockByNonceCalled(nonce, options)
+	}
+
+	return &data.HyperblockApiResponse{}, nil
+}
+
+// GetInternalBlockByNonce -
+func (stub *BlocksExportSourceStub) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	if stub.GetInternalBlockByNonceCalled != nil {
+		return stub.GetInternalBlockByNonceCalled(shardID, nonce, format)
+	}
+
+	return &data.InternalBlockApiResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlocksExportSourceStub) IsInterfaceNil() bool {
+	return stub == nil
+}
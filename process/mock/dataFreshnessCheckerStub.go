@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DataFreshnessCheckerStub -
+type DataFreshnessCheckerStub struct {
+	IsEnabledCalled               func() bool
+	GetAccountFromObserversCalled func(observers []*data.NodeData, apiPath string) (*data.AccountModel, *data.NodeData, error)
+}
+
+// IsEnabled -
+func (stub *DataFreshnessCheckerStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetAccountFromObservers -
+func (stub *DataFreshnessCheckerStub) GetAccountFromObservers(_ context.Context, observers []*data.NodeData, apiPath string) (*data.AccountModel, *data.NodeData, error) {
+	if stub.GetAccountFromObserversCalled != nil {
+		return stub.GetAccountFromObserversCalled(observers, apiPath)
+	}
+
+	return &data.AccountModel{}, &data.NodeData{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *DataFreshnessCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
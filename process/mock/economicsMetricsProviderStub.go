@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// EconomicsMetricsProviderStub -
+type EconomicsMetricsProviderStub struct {
+	GetEconomicsDataMetricsCalled func() (*data.GenericAPIResponse, error)
+	GetNetworkConfigCalled        func() (*data.NetworkConfig, error)
+}
+
+// GetEconomicsDataMetrics -
+func (stub *EconomicsMetricsProviderStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+	if stub.GetEconomicsDataMetricsCalled != nil {
+		return stub.GetEconomicsDataMetricsCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetNetworkConfig -
+func (stub *EconomicsMetricsProviderStub) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return &data.NetworkConfig{}, nil
+}
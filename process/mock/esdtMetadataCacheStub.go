@@ -0,0 +1,64 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ESDTMetadataCacheStub -
+type ESDTMetadataCacheStub struct {
+	GetTokenPropertiesCalled func(token string) (*data.Collection, bool)
+	PutTokenPropertiesCalled func(token string, properties *data.Collection)
+	GetTokenRolesCalled      func(token string) (map[string][]string, bool)
+	PutTokenRolesCalled      func(token string, roles map[string][]string)
+	InvalidateCalled         func(token string)
+	InvalidateAllCalled      func()
+}
+
+// GetTokenProperties -
+func (stub *ESDTMetadataCacheStub) GetTokenProperties(token string) (*data.Collection, bool) {
+	if stub.GetTokenPropertiesCalled != nil {
+		return stub.GetTokenPropertiesCalled(token)
+	}
+
+	return nil, false
+}
+
+// PutTokenProperties -
+func (stub *ESDTMetadataCacheStub) PutTokenProperties(token string, properties *data.Collection) {
+	if stub.PutTokenPropertiesCalled != nil {
+		stub.PutTokenPropertiesCalled(token, properties)
+	}
+}
+
+// GetTokenRoles -
+func (stub *ESDTMetadataCacheStub) GetTokenRoles(token string) (map[string][]string, bool) {
+	if stub.GetTokenRolesCalled != nil {
+		return stub.GetTokenRolesCalled(token)
+	}
+
+	return nil, false
+}
+
+// PutTokenRoles -
+func (stub *ESDTMetadataCacheStub) PutTokenRoles(token string, roles map[string][]string) {
+	if stub.PutTokenRolesCalled != nil {
+		stub.PutTokenRolesCalled(token, roles)
+	}
+}
+
+// Invalidate -
+func (stub *ESDTMetadataCacheStub) Invalidate(token string) {
+	if stub.InvalidateCalled != nil {
+		stub.InvalidateCalled(token)
+	}
+}
+
+// InvalidateAll -
+func (stub *ESDTMetadataCacheStub) InvalidateAll() {
+	if stub.InvalidateAllCalled != nil {
+		stub.InvalidateAllCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ESDTMetadataCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// EventsABIRegistryStub -
+type EventsABIRegistryStub struct {
+	DecodeEventCalled func(event *transaction.Events) *data.TransactionDecodedEvent
+}
+
+// DecodeEvent -
+func (stub *EventsABIRegistryStub) DecodeEvent(event *transaction.Events) *data.TransactionDecodedEvent {
+	if stub.DecodeEventCalled != nil {
+		return stub.DecodeEventCalled(event)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EventsABIRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,52 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ExternalStorageConnectorStub -
+type ExternalStorageConnectorStub struct {
+	IsEnabledCalled                    func() bool
+	GetAddressActivitySummaryCalled    func(address string) (*data.AddressActivitySummary, error)
+	GetTokenHoldersCalled              func(token string, size int, cursor string) (*data.TokenHoldersPage, error)
+	GetAddressLatestTransactionsCalled func(address string, size int) ([]data.DatabaseTransaction, error)
+}
+
+// IsEnabled -
+func (stub *ExternalStorageConnectorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetAddressActivitySummary -
+func (stub *ExternalStorageConnectorStub) GetAddressActivitySummary(address string) (*data.AddressActivitySummary, error) {
+	if stub.GetAddressActivitySummaryCalled != nil {
+		return stub.GetAddressActivitySummaryCalled(address)
+	}
+
+	return &data.AddressActivitySummary{}, nil
+}
+
+// GetAddressLatestTransactions -
+func (stub *ExternalStorageConnectorStub) GetAddressLatestTransactions(address string, size int) ([]data.DatabaseTransaction, error) {
+	if stub.GetAddressLatestTransactionsCalled != nil {
+		return stub.GetAddressLatestTransactionsCalled(address, size)
+	}
+
+	return make([]data.DatabaseTransaction, 0), nil
+}
+
+// GetTokenHolders -
+func (stub *ExternalStorageConnectorStub) GetTokenHolders(token string, size int, cursor string) (*data.TokenHoldersPage, error) {
+	if stub.GetTokenHoldersCalled != nil {
+		return stub.GetTokenHoldersCalled(token, size, cursor)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ExternalStorageConnectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"math/big"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// FaucetTransactionsGeneratorStub -
+type FaucetTransactionsGeneratorStub struct {
+	SenderDetailsFromPemCalled       func(receiver string) (crypto.PrivateKey, string, error)
+	GenerateTxForSendUserFundsCalled func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, networkConfig *data.NetworkConfig) (*data.Transaction, error)
+}
+
+// SenderDetailsFromPem -
+func (stub *FaucetTransactionsGeneratorStub) SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error) {
+	if stub.SenderDetailsFromPemCalled != nil {
+		return stub.SenderDetailsFromPemCalled(receiver)
+	}
+
+	return nil, "", nil
+}
+
+// GenerateTxForSendUserFunds -
+func (stub *FaucetTransactionsGeneratorStub) GenerateTxForSendUserFunds(
+	senderSk crypto.PrivateKey,
+	senderPk string,
+	senderNonce uint64,
+	receiver string,
+	value *big.Int,
+	networkConfig *data.NetworkConfig,
+) (*data.Transaction, error) {
+	if stub.GenerateTxForSendUserFundsCalled != nil {
+		return stub.GenerateTxForSendUserFundsCalled(senderSk, senderPk, senderNonce, receiver, value, networkConfig)
+	}
+
+	return &data.Transaction{}, nil
+}
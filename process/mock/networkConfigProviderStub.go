@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NetworkConfigProviderStub -
+type NetworkConfigProviderStub struct {
+	GetNetworkConfigCalled func() (*data.NetworkConfig, error)
+}
+
+// GetNetworkConfig -
+func (stub *NetworkConfigProviderStub) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return &data.NetworkConfig{}, nil
+}
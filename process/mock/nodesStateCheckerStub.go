@@ -0,0 +1,22 @@
+package mock
+
+import "time"
+
+// NodesStateCheckerStub -
+type NodesStateCheckerStub struct {
+	GetLastNodesStateCheckTimeCalled func() time.Time
+}
+
+// GetLastNodesStateCheckTime -
+func (stub *NodesStateCheckerStub) GetLastNodesStateCheckTime() time.Time {
+	if stub.GetLastNodesStateCheckTimeCalled != nil {
+		return stub.GetLastNodesStateCheckTimeCalled()
+	}
+
+	return time.Time{}
+}
+
+// IsInterfaceNil -
+func (stub *NodesStateCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
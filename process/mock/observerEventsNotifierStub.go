@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ObserverEventsNotifierStub -
+type ObserverEventsNotifierStub struct {
+	NotifyObserverEventsCalled func(events []*data.ObserverEvent)
+}
+
+// NotifyObserverEvents -
+func (stub *ObserverEventsNotifierStub) NotifyObserverEvents(events []*data.ObserverEvent) {
+	if stub.NotifyObserverEventsCalled != nil {
+		stub.NotifyObserverEventsCalled(events)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ObserverEventsNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
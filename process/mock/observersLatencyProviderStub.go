@@ -0,0 +1,30 @@
+package mock
+
+import "time"
+
+// ObserversLatencyProviderStub -
+type ObserversLatencyProviderStub struct {
+	AddObserverRequestDataCalled func(address string, method string, duration time.Duration)
+	GetObserverLatencyCalled     func(address string, method string) (time.Duration, bool)
+}
+
+// AddObserverRequestData -
+func (stub *ObserversLatencyProviderStub) AddObserverRequestData(address string, method string, duration time.Duration) {
+	if stub.AddObserverRequestDataCalled != nil {
+		stub.AddObserverRequestDataCalled(address, method, duration)
+	}
+}
+
+// GetObserverLatency -
+func (stub *ObserversLatencyProviderStub) GetObserverLatency(address string, method string) (time.Duration, bool) {
+	if stub.GetObserverLatencyCalled != nil {
+		return stub.GetObserverLatencyCalled(address, method)
+	}
+
+	return 0, false
+}
+
+// IsInterfaceNil -
+func (stub *ObserversLatencyProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
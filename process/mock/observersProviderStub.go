@@ -8,7 +8,10 @@ import (
 type ObserversProviderStub struct {
 	GetNodesByShardIdCalled           func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllNodesCalled                 func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	ApplyWarmUpCalled                 func(nodes []*data.NodeData) []*data.NodeData
 	ReloadNodesCalled                 func(nodesType data.NodeType) data.NodesReloadResponse
+	AddNodeCalled                     func(node *data.NodeData) error
+	RemoveNodeCalled                  func(address string) error
 	UpdateNodesBasedOnSyncStateCalled func(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncStateCalled    func() []*data.NodeData
 	PrintNodesInShardsCalled          func()
@@ -42,6 +45,15 @@ func (ops *ObserversProviderStub) GetAllNodes(dataAvailability data.ObserverData
 	}, nil
 }
 
+// ApplyWarmUp -
+func (ops *ObserversProviderStub) ApplyWarmUp(nodes []*data.NodeData) []*data.NodeData {
+	if ops.ApplyWarmUpCalled != nil {
+		return ops.ApplyWarmUpCalled(nodes)
+	}
+
+	return nodes
+}
+
 // UpdateNodesBasedOnSyncState -
 func (ops *ObserversProviderStub) UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData) {
 	if ops.UpdateNodesBasedOnSyncStateCalled != nil {
@@ -67,6 +79,24 @@ func (ops *ObserversProviderStub) ReloadNodes(nodesType data.NodeType) data.Node
 	return data.NodesReloadResponse{}
 }
 
+// AddNode -
+func (ops *ObserversProviderStub) AddNode(node *data.NodeData) error {
+	if ops.AddNodeCalled != nil {
+		return ops.AddNodeCalled(node)
+	}
+
+	return nil
+}
+
+// RemoveNode -
+func (ops *ObserversProviderStub) RemoveNode(address string) error {
+	if ops.RemoveNodeCalled != nil {
+		return ops.RemoveNodeCalled(address)
+	}
+
+	return nil
+}
+
 // PrintNodesInShards -
 func (ops *ObserversProviderStub) PrintNodesInShards() {
 	if ops.PrintNodesInShardsCalled != nil {
@@ -0,0 +1,30 @@
+package mock
+
+// PayloadSignerStub -
+type PayloadSignerStub struct {
+	SignPayloadCalled func(timestamp int64, payload []byte) ([]byte, error)
+	SignerIDCalled    func() string
+}
+
+// SignPayload -
+func (stub *PayloadSignerStub) SignPayload(timestamp int64, payload []byte) ([]byte, error) {
+	if stub.SignPayloadCalled != nil {
+		return stub.SignPayloadCalled(timestamp, payload)
+	}
+
+	return nil, nil
+}
+
+// SignerID -
+func (stub *PayloadSignerStub) SignerID() string {
+	if stub.SignerIDCalled != nil {
+		return stub.SignerIDCalled()
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (stub *PayloadSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
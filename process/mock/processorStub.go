@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -14,6 +15,7 @@ var errNotImplemented = errors.New("not implemented")
 type ProcessorStub struct {
 	ApplyConfigCalled                    func(cfg *config.Config) error
 	GetObserversCalled                   func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetObserversForWriteCalled           func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllObserversCalled                func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetObserversOnePerShardCalled        func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetFullHistoryNodesOnePerShardCalled func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
@@ -27,6 +29,10 @@ type ProcessorStub struct {
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
 	GetObserverProviderCalled            func() observer.NodesProviderHandler
 	GetFullHistoryNodesProviderCalled    func() observer.NodesProviderHandler
+	GetExcludedObserversCalled           func() []*data.ExcludedObserver
+	GetObserversNoncesCalled             func() []*data.ObserverNonce
+	RecordWriteObserverCalled            func(sender string, observerAddress string)
+	PreferWriteObserverCalled            func(sender string, observers []*data.NodeData) []*data.NodeData
 }
 
 // GetShardCoordinator -
@@ -65,6 +71,40 @@ func (ps *ProcessorStub) GetFullHistoryNodesProvider() observer.NodesProviderHan
 	return &ObserversProviderStub{}
 }
 
+// GetExcludedObservers -
+func (ps *ProcessorStub) GetExcludedObservers() []*data.ExcludedObserver {
+	if ps.GetExcludedObserversCalled != nil {
+		return ps.GetExcludedObserversCalled()
+	}
+
+	return make([]*data.ExcludedObserver, 0)
+}
+
+// GetObserversNonces -
+func (ps *ProcessorStub) GetObserversNonces() []*data.ObserverNonce {
+	if ps.GetObserversNoncesCalled != nil {
+		return ps.GetObserversNoncesCalled()
+	}
+
+	return make([]*data.ObserverNonce, 0)
+}
+
+// RecordWriteObserver -
+func (ps *ProcessorStub) RecordWriteObserver(sender string, observerAddress string) {
+	if ps.RecordWriteObserverCalled != nil {
+		ps.RecordWriteObserverCalled(sender, observerAddress)
+	}
+}
+
+// PreferWriteObserver -
+func (ps *ProcessorStub) PreferWriteObserver(sender string, observers []*data.NodeData) []*data.NodeData {
+	if ps.PreferWriteObserverCalled != nil {
+		return ps.PreferWriteObserverCalled(sender, observers)
+	}
+
+	return observers
+}
+
 // ApplyConfig will call the ApplyConfigCalled handler if not nil
 func (ps *ProcessorStub) ApplyConfig(cfg *config.Config) error {
 	if ps.ApplyConfigCalled != nil {
@@ -83,6 +123,15 @@ func (ps *ProcessorStub) GetObservers(shardID uint32, dataAvailability data.Obse
 	return nil, errNotImplemented
 }
 
+// GetObserversForWrite will call the GetObserversForWriteCalled handler if not nil, falling back to GetObservers
+func (ps *ProcessorStub) GetObserversForWrite(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	if ps.GetObserversForWriteCalled != nil {
+		return ps.GetObserversForWriteCalled(shardID, dataAvailability)
+	}
+
+	return ps.GetObservers(shardID, dataAvailability)
+}
+
 // ComputeShardId will call the ComputeShardIdCalled if not nil
 func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 	if ps.ComputeShardIdCalled != nil {
@@ -93,7 +142,7 @@ func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 }
 
 // CallGetRestEndPoint will call the CallGetRestEndPointCalled if not nil
-func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value interface{}) (int, error) {
+func (ps *ProcessorStub) CallGetRestEndPoint(_ context.Context, address string, path string, value interface{}) (int, error) {
 	if ps.CallGetRestEndPointCalled != nil {
 		return ps.CallGetRestEndPointCalled(address, path, value)
 	}
@@ -102,7 +151,7 @@ func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value
 }
 
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
-func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
+func (ps *ProcessorStub) CallPostRestEndPoint(_ context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
 		return ps.CallPostRestEndPointCalled(address, path, data, response)
 	}
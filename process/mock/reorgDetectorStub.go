@@ -0,0 +1,40 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ReorgDetectorStub -
+type ReorgDetectorStub struct {
+	RecordBlockCalled     func(shardID uint32, nonce uint64, hash string)
+	GetRecentReorgsCalled func() []*data.ChainReorg
+	GetMetricsCalled      func() data.ReorgDetectorMetrics
+}
+
+// RecordBlock -
+func (stub *ReorgDetectorStub) RecordBlock(shardID uint32, nonce uint64, hash string) {
+	if stub.RecordBlockCalled != nil {
+		stub.RecordBlockCalled(shardID, nonce, hash)
+	}
+}
+
+// GetRecentReorgs -
+func (stub *ReorgDetectorStub) GetRecentReorgs() []*data.ChainReorg {
+	if stub.GetRecentReorgsCalled != nil {
+		return stub.GetRecentReorgsCalled()
+	}
+
+	return nil
+}
+
+// GetMetrics -
+func (stub *ReorgDetectorStub) GetMetrics() data.ReorgDetectorMetrics {
+	if stub.GetMetricsCalled != nil {
+		return stub.GetMetricsCalled()
+	}
+
+	return data.ReorgDetectorMetrics{}
+}
+
+// IsInterfaceNil -
+func (stub *ReorgDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,40 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ShardIDCacheStub -
+type ShardIDCacheStub struct {
+	GetCalled        func(addressBuff []byte) (uint32, bool)
+	PutCalled        func(addressBuff []byte, shardID uint32)
+	GetMetricsCalled func() data.ShardIDCacheMetrics
+}
+
+// Get -
+func (stub *ShardIDCacheStub) Get(addressBuff []byte) (uint32, bool) {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(addressBuff)
+	}
+
+	return 0, false
+}
+
+// Put -
+func (stub *ShardIDCacheStub) Put(addressBuff []byte, shardID uint32) {
+	if stub.PutCalled != nil {
+		stub.PutCalled(addressBuff, shardID)
+	}
+}
+
+// GetMetrics -
+func (stub *ShardIDCacheStub) GetMetrics() data.ShardIDCacheMetrics {
+	if stub.GetMetricsCalled != nil {
+		return stub.GetMetricsCalled()
+	}
+
+	return data.ShardIDCacheMetrics{}
+}
+
+// IsInterfaceNil -
+func (stub *ShardIDCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TokenPriceProviderStub -
+type TokenPriceProviderStub struct {
+	IsEnabledCalled     func() bool
+	GetTokenPriceCalled func(token string) (*data.TokenPrice, error)
+}
+
+// IsEnabled -
+func (stub *TokenPriceProviderStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetTokenPrice -
+func (stub *TokenPriceProviderStub) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	if stub.GetTokenPriceCalled != nil {
+		return stub.GetTokenPriceCalled(token)
+	}
+
+	return &data.TokenPrice{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *TokenPriceProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
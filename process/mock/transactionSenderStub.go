@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionSenderStub -
+type TransactionSenderStub struct {
+	SendTransactionCalled func(tx *data.Transaction) (int, *data.SentTransaction, error)
+}
+
+// SendTransaction -
+func (stub *TransactionSenderStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, *data.SentTransaction, error) {
+	if stub.SendTransactionCalled != nil {
+		return stub.SendTransactionCalled(tx)
+	}
+
+	return 0, &data.SentTransaction{}, nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ValidatorStatisticsProviderStub -
+type ValidatorStatisticsProviderStub struct {
+	GetValidatorStatisticsCalled func() (*data.ValidatorStatisticsResponse, error)
+}
+
+// GetValidatorStatistics -
+func (stub *ValidatorStatisticsProviderStub) GetValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
+	if stub.GetValidatorStatisticsCalled != nil {
+		return stub.GetValidatorStatisticsCalled()
+	}
+
+	return &data.ValidatorStatisticsResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ValidatorStatisticsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
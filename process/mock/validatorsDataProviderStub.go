@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ValidatorsDataProviderStub -
+type ValidatorsDataProviderStub struct {
+	GetValidatorStatisticsCalled func() (*data.ValidatorStatisticsResponse, error)
+	GetAuctionListCalled         func() (*data.AuctionListResponse, error)
+}
+
+// GetValidatorStatistics -
+func (stub *ValidatorsDataProviderStub) GetValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
+	if stub.GetValidatorStatisticsCalled != nil {
+		return stub.GetValidatorStatisticsCalled()
+	}
+
+	return &data.ValidatorStatisticsResponse{}, nil
+}
+
+// GetAuctionList -
+func (stub *ValidatorsDataProviderStub) GetAuctionList(_ context.Context) (*data.AuctionListResponse, error) {
+	if stub.GetAuctionListCalled != nil {
+		return stub.GetAuctionListCalled()
+	}
+
+	return &data.AuctionListResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ValidatorsDataProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
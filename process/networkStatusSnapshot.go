@@ -0,0 +1,120 @@
+package process
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// GetNetworkStatusSnapshot fetches the status of all the shards concurrently and returns them as a single snapshot,
+// making the rounds and the metachain nonces skew between shards visible. A shard which cannot be queried is included
+// along with its error, the call failing only if no shard answered
+func (nsp *NodeStatusProcessor) GetNetworkStatusSnapshot(ctx context.Context) (*data.NetworkStatusSnapshot, error) {
+	shardsIDs, err := nsp.getShardsIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	shardsStatuses := make([]*data.ShardNetworkStatus, 0, len(shardsIDs))
+	mutShardsStatuses := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(shardsIDs))
+	for shardID := range shardsIDs {
+		go func(shardID uint32) {
+			defer wg.Done()
+
+			shardStatus := nsp.getShardNetworkStatus(ctx, shardID)
+			mutShardsStatuses.Lock()
+			shardsStatuses = append(shardsStatuses, shardStatus)
+			mutShardsStatuses.Unlock()
+		}(shardID)
+	}
+	wg.Wait()
+
+	sort.Slice(shardsStatuses, func(i, j int) bool {
+		return shardsStatuses[i].ShardID < shardsStatuses[j].ShardID
+	})
+
+	return computeNetworkStatusSnapshot(shardsStatuses, time.Now().Unix())
+}
+
+func (nsp *NodeStatusProcessor) getShardNetworkStatus(ctx context.Context, shardID uint32) *data.ShardNetworkStatus {
+	shardStatus := &data.ShardNetworkStatus{
+		ShardID: shardID,
+	}
+
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
+	if err != nil {
+		shardStatus.Error = err.Error()
+		return shardStatus
+	}
+
+	nonce, okNonce := getMetric(nodeStatusResponse.Data, MetricNonce)
+	round, okRound := getMetric(nodeStatusResponse.Data, MetricCurrentRound)
+	epoch, okEpoch := getMetric(nodeStatusResponse.Data, MetricEpochNumber)
+	if !okNonce || !okRound || !okEpoch {
+		shardStatus.Error = ErrCannotParseNodeStatusMetrics.Error()
+		return shardStatus
+	}
+
+	shardStatus.Nonce = getUint(nonce)
+	shardStatus.Round = getUint(round)
+	shardStatus.Epoch = uint32(getUint(epoch))
+	if shardID == core.MetachainShardId {
+		return shardStatus
+	}
+
+	crossCheckMetaNonce, ok := getNonceFromShardStatus(nodeStatusResponse.Data)
+	if ok {
+		shardStatus.CrossCheckMetaNonce = &crossCheckMetaNonce
+	}
+
+	return shardStatus
+}
+
+func computeNetworkStatusSnapshot(shardsStatuses []*data.ShardNetworkStatus, timestamp int64) (*data.NetworkStatusSnapshot, error) {
+	snapshot := &data.NetworkStatusSnapshot{
+		Timestamp: timestamp,
+		Shards:    shardsStatuses,
+		SameEpoch: true,
+	}
+
+	var metaStatus *data.ShardNetworkStatus
+	answeredShards := make([]*data.ShardNetworkStatus, 0, len(shardsStatuses))
+	for _, shardStatus := range shardsStatuses {
+		if len(shardStatus.Error) > 0 {
+			continue
+		}
+
+		answeredShards = append(answeredShards, shardStatus)
+		if shardStatus.ShardID == core.MetachainShardId {
+			metaStatus = shardStatus
+		}
+	}
+	if len(answeredShards) == 0 {
+		return nil, ErrMissingObserver
+	}
+
+	minRound, maxRound := answeredShards[0].Round, answeredShards[0].Round
+	for _, shardStatus := range answeredShards {
+		minRound = core.MinUint64(minRound, shardStatus.Round)
+		maxRound = core.MaxUint64(maxRound, shardStatus.Round)
+		snapshot.SameEpoch = snapshot.SameEpoch && shardStatus.Epoch == answeredShards[0].Epoch
+
+		if metaStatus == nil || shardStatus.CrossCheckMetaNonce == nil {
+			continue
+		}
+		metaNonceLag := uint64(0)
+		if metaStatus.Nonce > *shardStatus.CrossCheckMetaNonce {
+			metaNonceLag = metaStatus.Nonce - *shardStatus.CrossCheckMetaNonce
+		}
+		shardStatus.MetaNonceLag = &metaNonceLag
+	}
+	snapshot.MaxRoundSkew = maxRound - minRound
+
+	return snapshot, nil
+}
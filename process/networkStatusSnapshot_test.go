@@ -0,0 +1,123 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeStatusProcessorWithShardsMetrics(shardsMetrics map[uint32]map[string]interface{}) *NodeStatusProcessor {
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetAllObserversCalled: func(_ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			observers := make([]*data.NodeData, 0, len(shardsMetrics))
+			for shardID := range shardsMetrics {
+				observers = append(observers, &data.NodeData{Address: fmt.Sprintf("address%d", shardID), ShardId: shardID})
+			}
+			return observers, nil
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: fmt.Sprintf("address%d", shardId), ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			for shardID, metrics := range shardsMetrics {
+				if address != fmt.Sprintf("address%d", shardID) {
+					continue
+				}
+				if metrics == nil {
+					return 0, errors.New("observer down")
+				}
+
+				genRespBytes, _ := json.Marshal(&data.GenericAPIResponse{Data: map[string]interface{}{"metrics": metrics}})
+				return 0, json.Unmarshal(genRespBytes, value)
+			}
+
+			return 0, errors.New("unknown observer")
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
+	)
+
+	return nodeStatusProc
+}
+
+func TestNodeStatusProcessor_GetNetworkStatusSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should aggregate the shards statuses", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc := createNodeStatusProcessorWithShardsMetrics(map[uint32]map[string]interface{}{
+			0:                     {MetricNonce: 500, MetricCurrentRound: 1010, MetricEpochNumber: 5, MetricCrossCheckBlockHeight: "meta 120"},
+			1:                     {MetricNonce: 498, MetricCurrentRound: 1012, MetricEpochNumber: 5, MetricCrossCheckBlockHeight: "meta 123"},
+			core.MetachainShardId: {MetricNonce: 123, MetricCurrentRound: 1011, MetricEpochNumber: 5},
+		})
+
+		snapshot, err := nodeStatusProc.GetNetworkStatusSnapshot(context.Background())
+		require.Nil(t, err)
+		require.NotZero(t, snapshot.Timestamp)
+		require.Equal(t, uint64(2), snapshot.MaxRoundSkew)
+		require.True(t, snapshot.SameEpoch)
+
+		crossCheckShard0, lagShard0 := uint64(120), uint64(3)
+		crossCheckShard1, lagShard1 := uint64(123), uint64(0)
+		expectedShards := []*data.ShardNetworkStatus{
+			{ShardID: 0, Nonce: 500, Round: 1010, Epoch: 5, CrossCheckMetaNonce: &crossCheckShard0, MetaNonceLag: &lagShard0},
+			{ShardID: 1, Nonce: 498, Round: 1012, Epoch: 5, CrossCheckMetaNonce: &crossCheckShard1, MetaNonceLag: &lagShard1},
+			{ShardID: core.MetachainShardId, Nonce: 123, Round: 1011, Epoch: 5},
+		}
+		require.Equal(t, expectedShards, snapshot.Shards)
+	})
+	t.Run("shards which cannot be queried should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc := createNodeStatusProcessorWithShardsMetrics(map[uint32]map[string]interface{}{
+			0:                     {MetricNonce: 500, MetricCurrentRound: 1010, MetricEpochNumber: 6, MetricCrossCheckBlockHeight: "meta 120"},
+			1:                     {MetricNonce: 498},
+			core.MetachainShardId: nil,
+		})
+
+		snapshot, err := nodeStatusProc.GetNetworkStatusSnapshot(context.Background())
+		require.Nil(t, err)
+		require.Zero(t, snapshot.MaxRoundSkew)
+		require.True(t, snapshot.SameEpoch)
+		require.Equal(t, 3, len(snapshot.Shards))
+		require.Nil(t, snapshot.Shards[0].MetaNonceLag)
+		require.Equal(t, ErrCannotParseNodeStatusMetrics.Error(), snapshot.Shards[1].Error)
+		require.NotEmpty(t, snapshot.Shards[2].Error)
+	})
+	t.Run("different epochs should be signaled", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc := createNodeStatusProcessorWithShardsMetrics(map[uint32]map[string]interface{}{
+			0:                     {MetricNonce: 500, MetricCurrentRound: 1010, MetricEpochNumber: 6},
+			core.MetachainShardId: {MetricNonce: 123, MetricCurrentRound: 1010, MetricEpochNumber: 5},
+		})
+
+		snapshot, err := nodeStatusProc.GetNetworkStatusSnapshot(context.Background())
+		require.Nil(t, err)
+		require.False(t, snapshot.SameEpoch)
+	})
+	t.Run("no shard answering should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeStatusProc := createNodeStatusProcessorWithShardsMetrics(map[uint32]map[string]interface{}{
+			0:                     nil,
+			core.MetachainShardId: nil,
+		})
+
+		snapshot, err := nodeStatusProc.GetNetworkStatusSnapshot(context.Background())
+		require.Nil(t, snapshot)
+		require.Equal(t, ErrMissingObserver, err)
+	})
+}
@@ -0,0 +1,218 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxNetworkStatusStreamSubscribers limits the number of concurrent subscribers to the network status stream
+const maxNetworkStatusStreamSubscribers = 1000
+
+type networkStatusSubscriber chan *data.NetworkStatusUpdate
+
+type shardStatusPoller struct {
+	subscribers map[networkStatusSubscriber]struct{}
+	lastUpdate  *data.NetworkStatusUpdate
+	cancelFunc  func()
+}
+
+// NetworkStatusStreamer polls the network status of the shards that have subscribers and pushes the round, nonce
+// and epoch changes to them
+type NetworkStatusStreamer struct {
+	proc           Processor
+	pollInterval   time.Duration
+	pollers        map[uint32]*shardStatusPoller
+	numSubscribers int
+	isClosed       bool
+	mutPollers     sync.Mutex
+}
+
+// NewNetworkStatusStreamer creates a new instance of NetworkStatusStreamer
+func NewNetworkStatusStreamer(proc Processor, pollInterval time.Duration) (*NetworkStatusStreamer, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if pollInterval <= 0 {
+		return nil, ErrInvalidPollInterval
+	}
+
+	return &NetworkStatusStreamer{
+		proc:         proc,
+		pollInterval: pollInterval,
+		pollers:      make(map[uint32]*shardStatusPoller),
+	}, nil
+}
+
+// Subscribe returns a channel receiving the network status updates of the provided shard. The returned function has
+// to be called once the updates are no longer needed. The shard is only polled while it has subscribers
+func (nss *NetworkStatusStreamer) Subscribe(shardID uint32) (<-chan *data.NetworkStatusUpdate, func(), error) {
+	if !nss.isKnownShard(shardID) {
+		return nil, nil, fmt.Errorf("%w: %d", ErrInvalidShardID, shardID)
+	}
+
+	nss.mutPollers.Lock()
+	defer nss.mutPollers.Unlock()
+
+	if nss.isClosed {
+		return nil, nil, ErrNetworkStatusStreamerClosed
+	}
+	if nss.numSubscribers >= maxNetworkStatusStreamSubscribers {
+		return nil, nil, ErrTooManySubscribers
+	}
+
+	poller, found := nss.pollers[shardID]
+	if !found {
+		var ctx context.Context
+		poller = &shardStatusPoller{
+			subscribers: make(map[networkStatusSubscriber]struct{}),
+		}
+		ctx, poller.cancelFunc = context.WithCancel(context.Background())
+		nss.pollers[shardID] = poller
+
+		go nss.pollShardStatus(ctx, shardID, poller)
+	}
+
+	// the channel holds only the latest update, so a slow subscriber never blocks the poller
+	subscriber := make(networkStatusSubscriber, 1)
+	if poller.lastUpdate != nil {
+		subscriber <- poller.lastUpdate
+	}
+	poller.subscribers[subscriber] = struct{}{}
+	nss.numSubscribers++
+
+	unsubscribe := func() {
+		nss.unsubscribe(shardID, subscriber)
+	}
+
+	return subscriber, unsubscribe, nil
+}
+
+func (nss *NetworkStatusStreamer) isKnownShard(shardID uint32) bool {
+	for _, knownShardID := range nss.proc.GetShardIDs() {
+		if knownShardID == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (nss *NetworkStatusStreamer) unsubscribe(shardID uint32, subscriber networkStatusSubscriber) {
+	nss.mutPollers.Lock()
+	defer nss.mutPollers.Unlock()
+
+	poller, found := nss.pollers[shardID]
+	if !found {
+		return
+	}
+	if _, isSubscribed := poller.subscribers[subscriber]; !isSubscribed {
+		return
+	}
+
+	delete(poller.subscribers, subscriber)
+	nss.numSubscribers--
+	if len(poller.subscribers) > 0 {
+		return
+	}
+
+	poller.cancelFunc()
+	delete(nss.pollers, shardID)
+}
+
+func (nss *NetworkStatusStreamer) pollShardStatus(ctx context.Context, shardID uint32, poller *shardStatusPoller) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			log.WithContext(ctx).Debug("network status stream: stopped polling", "shard", shardID)
+			return
+		}
+
+		update, err := nss.fetchNetworkStatus(ctx, shardID)
+		if err != nil {
+			log.WithContext(ctx).Debug("network status stream: cannot fetch the network status", "shard", shardID, "error", err)
+		} else {
+			nss.notifySubscribers(poller, update)
+		}
+
+		timer.Reset(nss.pollInterval)
+	}
+}
+
+func (nss *NetworkStatusStreamer) fetchNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatusUpdate, error) {
+	observers, err := nss.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.NetworkStatusApiResponse{}
+	for _, observer := range observers {
+		_, err = nss.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &response)
+		if err != nil {
+			log.WithContext(ctx).Debug("network status stream request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return &data.NetworkStatusUpdate{
+			ShardID: shardID,
+			Round:   response.Data.Status.CurrentRound,
+			Nonce:   response.Data.Status.Nonce,
+			Epoch:   response.Data.Status.EpochNumber,
+		}, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+func (nss *NetworkStatusStreamer) notifySubscribers(poller *shardStatusPoller, update *data.NetworkStatusUpdate) {
+	nss.mutPollers.Lock()
+	defer nss.mutPollers.Unlock()
+
+	if poller.lastUpdate != nil && *poller.lastUpdate == *update {
+		return
+	}
+	poller.lastUpdate = update
+
+	for subscriber := range poller.subscribers {
+		// drop the stale update not yet consumed, if any, so the subscriber always receives the latest one
+		select {
+		case <-subscriber:
+		default:
+		}
+
+		subscriber <- update
+	}
+}
+
+// Close stops all the pollers and closes the channels of the subscribers, so that their streams end
+func (nss *NetworkStatusStreamer) Close() error {
+	nss.mutPollers.Lock()
+	defer nss.mutPollers.Unlock()
+
+	for shardID, poller := range nss.pollers {
+		poller.cancelFunc()
+		// the subscribers are removed along with their channels, so a poll in progress does not notify them anymore
+		for subscriber := range poller.subscribers {
+			close(subscriber)
+			delete(poller.subscribers, subscriber)
+		}
+		delete(nss.pollers, shardID)
+	}
+	nss.numSubscribers = 0
+	nss.isClosed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nss *NetworkStatusStreamer) IsInterfaceNil() bool {
+	return nss == nil
+}
@@ -0,0 +1,134 @@
+package process_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetworkStatusProcessorStub(numCalls *uint64) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1}
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "address", ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			call := atomic.AddUint64(numCalls, 1)
+			response := value.(*data.NetworkStatusApiResponse)
+			response.Data.Status = data.NetworkStatusMetrics{
+				Nonce:        call / 2,
+				CurrentRound: call / 2,
+				EpochNumber:  1,
+			}
+
+			return 200, nil
+		},
+	}
+}
+
+func TestNewNetworkStatusStreamer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		nss, err := process.NewNetworkStatusStreamer(nil, time.Second)
+		require.Nil(t, nss)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		nss, err := process.NewNetworkStatusStreamer(&mock.ProcessorStub{}, 0)
+		require.Nil(t, nss)
+		require.Equal(t, process.ErrInvalidPollInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nss, err := process.NewNetworkStatusStreamer(&mock.ProcessorStub{}, time.Second)
+		require.NoError(t, err)
+		require.False(t, nss.IsInterfaceNil())
+	})
+}
+
+func TestNetworkStatusStreamer_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint64(0)
+		nss, _ := process.NewNetworkStatusStreamer(createNetworkStatusProcessorStub(&numCalls), time.Millisecond)
+
+		updates, unsubscribe, err := nss.Subscribe(5)
+		require.True(t, errors.Is(err, process.ErrInvalidShardID))
+		require.Nil(t, updates)
+		require.Nil(t, unsubscribe)
+	})
+	t.Run("should receive the updates and stop polling after unsubscribe", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint64(0)
+		nss, _ := process.NewNetworkStatusStreamer(createNetworkStatusProcessorStub(&numCalls), time.Millisecond)
+
+		updates, unsubscribe, err := nss.Subscribe(1)
+		require.NoError(t, err)
+
+		var lastUpdate *data.NetworkStatusUpdate
+		for i := 0; i < 2; i++ {
+			select {
+			case update := <-updates:
+				require.Equal(t, uint32(1), update.ShardID)
+				require.Equal(t, uint32(1), update.Epoch)
+				if lastUpdate != nil {
+					require.NotEqual(t, *lastUpdate, *update)
+				}
+				lastUpdate = update
+			case <-time.After(time.Second):
+				require.Fail(t, "timeout waiting for the network status update")
+			}
+		}
+
+		unsubscribe()
+		time.Sleep(50 * time.Millisecond)
+		callsAfterUnsubscribe := atomic.LoadUint64(&numCalls)
+		time.Sleep(50 * time.Millisecond)
+		require.Equal(t, callsAfterUnsubscribe, atomic.LoadUint64(&numCalls))
+
+		require.NoError(t, nss.Close())
+	})
+	t.Run("close should end the subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint64(0)
+		nss, _ := process.NewNetworkStatusStreamer(createNetworkStatusProcessorStub(&numCalls), time.Millisecond)
+
+		updates, unsubscribe, err := nss.Subscribe(1)
+		require.NoError(t, err)
+
+		require.NoError(t, nss.Close())
+		timeout := time.After(time.Second)
+		for isOpen := true; isOpen; {
+			select {
+			case _, isOpen = <-updates:
+			case <-timeout:
+				require.Fail(t, "timeout waiting for the subscription to end")
+			}
+		}
+		unsubscribe()
+
+		updates, unsubscribe, err = nss.Subscribe(1)
+		require.Nil(t, updates)
+		require.Nil(t, unsubscribe)
+		require.Equal(t, process.ErrNetworkStatusStreamerClosed, err)
+	})
+}
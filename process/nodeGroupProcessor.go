@@ -12,6 +12,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -20,7 +21,6 @@ const (
 	heartbeatPath = "/node/heartbeatstatus"
 	// waitingEpochsLeftPath represents the path where an observer the number of epochs left in waiting state for a key
 	waitingEpochsLeftPath = "/node/waiting-epochs-left/%s"
-	systemAccountAddress  = "erd1lllllllllllllllllllllllllllllllllllllllllllllllllllsckry7t"
 )
 
 // NodeGroupProcessor is able to process transaction requests
@@ -56,13 +56,14 @@ func NewNodeGroupProcessor(
 }
 
 // IsOldStorageForToken returns true if the token is stored in the old fashion
-func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (ngp *NodeGroupProcessor) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	observers, err := ngp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return false, err
 	}
 
 	tokenStorageKey := computeTokenStorageKey(tokenID, nonce)
+	systemAccountAddress := encodeSystemAddress(ngp.proc.GetPubKeyConverter(), common.SystemAccountAddressHex)
 
 	for _, observer := range observers {
 		if observer.ShardId == core.MetachainShardId {
@@ -71,9 +72,9 @@ func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64
 
 		apiResponse := data.AccountKeyValueResponse{}
 		apiPath := addressPath + systemAccountAddress + "/key/" + tokenStorageKey
-		respCode, err := ngp.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ngp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account value for key request",
+			log.WithContext(ctx).Info("account value for key request",
 				"address", systemAccountAddress,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -82,7 +83,7 @@ func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64
 				return false, errors.New(apiResponse.Error)
 			}
 
-			log.Info("load token from system account", "token", tokenID, "nonce", nonce, "shard ID", observer.ShardId, "value length", len(apiResponse.Data.Value))
+			log.WithContext(ctx).Info("load token from system account", "token", tokenID, "nonce", nonce, "shard ID", observer.ShardId, "value length", len(apiResponse.Data.Value))
 			if len(apiResponse.Data.Value) > 0 {
 				return false, nil
 			}
@@ -108,32 +109,32 @@ func computeTokenStorageKey(tokenID string, nonce uint64) string {
 }
 
 // GetHeartbeatData will simply forward the heartbeat status from an observer
-func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	heartbeatsToReturn, err := ngp.cacher.LoadHeartbeats()
 	if err == nil {
 		return heartbeatsToReturn, nil
 	}
 
-	log.Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
+	log.WithContext(ctx).Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return ngp.getHeartbeatsFromApi()
+	return ngp.getHeartbeatsFromApi(ctx)
 }
 
-func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) getHeartbeatsFromApi(ctx context.Context) (*data.HeartbeatResponse, error) {
 	shardIDs := ngp.proc.GetShardIDs()
 
 	responseMap := make(map[string]data.PubKeyHeartbeat)
 	for _, shard := range shardIDs {
 		observers, err := ngp.proc.GetObservers(shard, data.AvailabilityRecent)
 		if err != nil {
-			log.Error("could not get observers", "shard", shard, "error", err.Error())
+			log.WithContext(ctx).Error("could not get observers", "shard", shard, "error", err.Error())
 			continue
 		}
 
 		errorsCount := 0
 		var response data.HeartbeatApiResponse
 		for _, observer := range observers {
-			_, err = ngp.proc.CallGetRestEndPoint(observer.Address, heartbeatPath, &response)
+			_, err = ngp.proc.CallGetRestEndPoint(ctx, observer.Address, heartbeatPath, &response)
 			heartbeats := response.Data.Heartbeats
 			if err == nil && len(heartbeats) > 0 {
 				ngp.addMessagesToMap(responseMap, heartbeats, shard)
@@ -145,12 +146,12 @@ func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse,
 			if err != nil {
 				errorMsg = err.Error()
 			}
-			log.Error("heartbeat", "observer", observer.Address, "shard", shard, "error", errorMsg)
+			log.WithContext(ctx).Error("heartbeat", "observer", observer.Address, "shard", shard, "error", errorMsg)
 		}
 
 		// If no observer responded from a specific shard, log and return error
 		if errorsCount == len(observers) {
-			log.Error("heartbeat", "error", ErrHeartbeatNotAvailable.Error(), "shard", shard)
+			log.WithContext(ctx).Error("heartbeat", "error", ErrHeartbeatNotAvailable.Error(), "shard", shard)
 			return nil, ErrHeartbeatNotAvailable
 		}
 	}
@@ -212,38 +213,38 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 		timer := time.NewTimer(ngp.cacheValidityDuration)
 		defer timer.Stop()
 
-		ngp.handleHeartbeatCacheUpdate()
+		ngp.handleHeartbeatCacheUpdate(ctx)
 
 		for {
 			timer.Reset(ngp.cacheValidityDuration)
 
 			select {
 			case <-timer.C:
-				ngp.handleHeartbeatCacheUpdate()
+				ngp.handleHeartbeatCacheUpdate(ctx)
 			case <-ctx.Done():
-				log.Debug("finishing NodeGroupProcessor cache update...")
+				log.WithContext(ctx).Debug("finishing NodeGroupProcessor cache update...")
 				return
 			}
 		}
 	}(ctx)
 }
 
-func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
-	hbts, err := ngp.getHeartbeatsFromApi()
+func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate(ctx context.Context) {
+	hbts, err := ngp.getHeartbeatsFromApi(ctx)
 	if err != nil {
-		log.Warn("heartbeat: get from API", "error", err.Error())
+		log.WithContext(ctx).Warn("heartbeat: get from API", "error", err.Error())
 	}
 
 	if hbts != nil {
 		err = ngp.cacher.StoreHeartbeats(hbts)
 		if err != nil {
-			log.Warn("heartbeat: store in cache", "error", err.Error())
+			log.WithContext(ctx).Warn("heartbeat: store in cache", "error", err.Error())
 		}
 	}
 }
 
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
-func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if len(publicKey) == 0 {
 		return nil, ErrEmptyPubKey
 	}
@@ -257,13 +258,13 @@ func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string
 	responseWaitingEpochsLeft := data.WaitingEpochsLeftApiResponse{}
 	path := fmt.Sprintf(waitingEpochsLeftPath, publicKey)
 	for _, observer := range observers {
-		_, lastErr = ngp.proc.CallGetRestEndPoint(observer.Address, path, &responseWaitingEpochsLeft)
+		_, lastErr = ngp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseWaitingEpochsLeft)
 		if lastErr != nil {
-			log.Error("waiting epochs left request", "observer", observer.Address, "public key", publicKey, "error", lastErr.Error())
+			log.WithContext(ctx).Error("waiting epochs left request", "observer", observer.Address, "public key", publicKey, "error", lastErr.Error())
 			continue
 		}
 
-		log.Info("waiting epochs left request", "shard ID", observer.ShardId, "observer", observer.Address, "public key", publicKey)
+		log.WithContext(ctx).Info("waiting epochs left request", "shard ID", observer.ShardId, "observer", observer.Address, "public key", publicKey)
 		return &responseWaitingEpochsLeft, nil
 
 	}
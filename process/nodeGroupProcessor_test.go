@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -59,7 +60,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataWrongValuesShouldErr(t *testing.T) {
 	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -152,7 +153,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataOkValuesShouldPass(t *testing.T) {
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 
@@ -234,7 +235,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetHeartbeatData()
+	_, err = hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -367,7 +368,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 	)
 	assert.Nil(t, err)
 
-	heartbeats, err := hp.GetHeartbeatData()
+	heartbeats, err := hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 	assert.Equal(t, expectedHeartbeats, heartbeats)
@@ -390,7 +391,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromCacher(t *testin
 	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, *res, hbtsResp)
@@ -512,7 +513,7 @@ func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 	)
 	assert.Nil(t, err)
 
-	messages, err := hp.GetHeartbeatData()
+	messages, err := hp.GetHeartbeatData(context.Background())
 	assert.Equal(t, process.ErrHeartbeatNotAvailable, err)
 	assert.Nil(t, messages)
 }
@@ -539,7 +540,7 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			10,
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
 
@@ -565,7 +566,7 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			10,
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
 
@@ -590,7 +591,7 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			10,
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.False(t, isOldStorage)
 		require.NoError(t, err)
 	})
@@ -616,7 +617,7 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 			10,
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, isOldStorage)
 		require.NoError(t, err)
 	})
@@ -646,7 +647,7 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			10,
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "")
 		require.Nil(t, response)
 		require.Equal(t, process.ErrEmptyPubKey, err)
 	})
@@ -663,7 +664,7 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			10,
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.Nil(t, response)
 		require.Equal(t, expectedErr, err)
 	})
@@ -686,7 +687,7 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			10,
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.Nil(t, response)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 	})
@@ -712,7 +713,7 @@ func TestNodeGroupProcessor_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			10,
 		)
 
-		response, err := proc.GetWaitingEpochsLeftForPublicKey("key")
+		response, err := proc.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 		require.NoError(t, err)
 		require.Equal(t, providedEpochsLeft, response.Data.EpochsLeft)
 	})
@@ -0,0 +1,130 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const passthroughWildcardSuffix = "/*"
+
+// NodePassthroughProcessor forwards the requests of the allowed node endpoints to the observers, as they are
+type NodePassthroughProcessor struct {
+	proc             Processor
+	allowedEndpoints map[string]struct{}
+	allowedPrefixes  []string
+}
+
+// NewNodePassthroughProcessor creates a new instance of NodePassthroughProcessor. An allowed endpoint ending in /*
+// allows all the endpoints under it
+func NewNodePassthroughProcessor(proc Processor, allowedEndpoints []string) (*NodePassthroughProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+
+	npp := &NodePassthroughProcessor{
+		proc:             proc,
+		allowedEndpoints: make(map[string]struct{}),
+		allowedPrefixes:  make([]string, 0),
+	}
+	for _, endpoint := range allowedEndpoints {
+		prefix, isWildcard := strings.CutSuffix(endpoint, passthroughWildcardSuffix)
+		isValid := strings.HasPrefix(endpoint, "/") && path.Clean(prefix) == prefix && !strings.Contains(prefix, "*")
+		if !isValid {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPassthroughEndpoint, endpoint)
+		}
+
+		if isWildcard {
+			npp.allowedPrefixes = append(npp.allowedPrefixes, prefix+"/")
+			continue
+		}
+		npp.allowedEndpoints[endpoint] = struct{}{}
+	}
+
+	return npp, nil
+}
+
+// ForwardRequest sends the request to an observer of the given shard and returns the raw response of the first
+// observer that answered, along with its status code
+func (npp *NodePassthroughProcessor) ForwardRequest(ctx context.Context,
+	shardID uint32,
+	method string,
+	endpoint string,
+	rawQuery string,
+	body []byte,
+) (int, json.RawMessage, error) {
+	if !npp.isAllowed(endpoint) {
+		return http.StatusForbidden, nil, fmt.Errorf("%w: %s", ErrEndpointNotAllowed, endpoint)
+	}
+	if method != http.MethodGet && method != http.MethodPost {
+		return http.StatusMethodNotAllowed, nil, fmt.Errorf("%w: %s", ErrEndpointNotAllowed, method)
+	}
+
+	var requestBody json.RawMessage
+	if len(body) > 0 {
+		if !json.Valid(body) {
+			return http.StatusBadRequest, nil, ErrInvalidPassthroughBody
+		}
+		requestBody = body
+	}
+
+	observers, err := npp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	nodePath := endpoint
+	if len(rawQuery) > 0 {
+		nodePath += "?" + rawQuery
+	}
+
+	for _, observer := range observers {
+		var response json.RawMessage
+		var statusCode int
+		if method == http.MethodGet {
+			statusCode, err = npp.proc.CallGetRestEndPoint(ctx, observer.Address, nodePath, &response)
+		} else {
+			statusCode, err = npp.proc.CallPostRestEndPoint(ctx, observer.Address, nodePath, requestBody, &response)
+		}
+
+		// any answer of the observer is forwarded, including the errors, only the unreachable observers are skipped
+		if len(response) > 0 {
+			log.WithContext(ctx).Info("node passthrough request", "shard", shardID, "observer", observer.Address, "endpoint", endpoint, "status", statusCode)
+			return statusCode, response, nil
+		}
+
+		log.WithContext(ctx).Error("node passthrough request", "shard", shardID, "observer", observer.Address, "endpoint", endpoint, "error", err)
+	}
+
+	return http.StatusInternalServerError, nil, ErrSendingRequest
+}
+
+func (npp *NodePassthroughProcessor) isAllowed(endpoint string) bool {
+	if path.Clean(endpoint) != endpoint {
+		return false
+	}
+
+	_, found := npp.allowedEndpoints[endpoint]
+	if found {
+		return true
+	}
+
+	for _, prefix := range npp.allowedPrefixes {
+		if strings.HasPrefix(endpoint, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (npp *NodePassthroughProcessor) IsInterfaceNil() bool {
+	return npp == nil
+}
@@ -0,0 +1,138 @@
+package process_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodePassthroughProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		npp, err := process.NewNodePassthroughProcessor(nil, nil)
+		require.Nil(t, npp)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("invalid endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, endpoint := range []string{"node/peerinfo", "/node/../admin", "/node/", "/debug/*/x"} {
+			npp, err := process.NewNodePassthroughProcessor(&mock.ProcessorStub{}, []string{endpoint})
+			require.Nil(t, npp, endpoint)
+			require.True(t, errors.Is(err, process.ErrInvalidPassthroughEndpoint), endpoint)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		npp, err := process.NewNodePassthroughProcessor(&mock.ProcessorStub{}, []string{"/node/peerinfo", "/debug/*"})
+		require.NoError(t, err)
+		require.False(t, npp.IsInterfaceNil())
+	})
+}
+
+func TestNodePassthroughProcessor_ForwardRequest(t *testing.T) {
+	t.Parallel()
+
+	allowedEndpoints := []string{"/node/peerinfo", "/debug/*"}
+	observers := []*data.NodeData{{Address: "observer0", ShardId: 1}, {Address: "observer1", ShardId: 1}}
+
+	t.Run("not allowed endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		npp, _ := process.NewNodePassthroughProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}, allowedEndpoints)
+
+		for _, endpoint := range []string{"/node/status", "/debug", "/debug/../node/status", "/node/peerinfo/"} {
+			statusCode, response, err := npp.ForwardRequest(context.Background(), 1, http.MethodGet, endpoint, "", nil)
+			require.Equal(t, http.StatusForbidden, statusCode, endpoint)
+			require.Nil(t, response, endpoint)
+			require.True(t, errors.Is(err, process.ErrEndpointNotAllowed), endpoint)
+		}
+	})
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		npp, _ := process.NewNodePassthroughProcessor(&mock.ProcessorStub{}, allowedEndpoints)
+		statusCode, _, err := npp.ForwardRequest(context.Background(), 1, http.MethodPost, "/debug/query", "", []byte("{invalid"))
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrInvalidPassthroughBody, err)
+	})
+	t.Run("all observers down should error", func(t *testing.T) {
+		t.Parallel()
+
+		npp, _ := process.NewNodePassthroughProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return observers, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return http.StatusNotFound, errors.New("connection refused")
+			},
+		}, allowedEndpoints)
+
+		statusCode, _, err := npp.ForwardRequest(context.Background(), 1, http.MethodGet, "/node/peerinfo", "", nil)
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.Equal(t, process.ErrSendingRequest, err)
+	})
+	t.Run("get should forward to the first observer answering", func(t *testing.T) {
+		t.Parallel()
+
+		calledAddresses := make([]string, 0)
+		npp, _ := process.NewNodePassthroughProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				require.Equal(t, uint32(1), shardId)
+				return observers, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				calledAddresses = append(calledAddresses, address)
+				if address == "observer0" {
+					return http.StatusNotFound, errors.New("connection refused")
+				}
+
+				require.Equal(t, "/node/peerinfo?pid=abc", path)
+				*value.(*json.RawMessage) = json.RawMessage(`{"error":"peer not found"}`)
+				return http.StatusBadRequest, errors.New("peer not found")
+			},
+		}, allowedEndpoints)
+
+		statusCode, response, err := npp.ForwardRequest(context.Background(), 1, http.MethodGet, "/node/peerinfo", "pid=abc", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, json.RawMessage(`{"error":"peer not found"}`), response)
+		require.Equal(t, []string{"observer0", "observer1"}, calledAddresses)
+	})
+	t.Run("post should forward the body", func(t *testing.T) {
+		t.Parallel()
+
+		npp, _ := process.NewNodePassthroughProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return observers, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Equal(t, "/debug/query", path)
+				require.Equal(t, json.RawMessage(`{"key":"value"}`), value)
+				*response.(*json.RawMessage) = json.RawMessage(`{"data":"ok"}`)
+				return http.StatusOK, nil
+			},
+		}, allowedEndpoints)
+
+		statusCode, response, err := npp.ForwardRequest(context.Background(), 1, http.MethodPost, "/debug/query", "", []byte(`{"key":"value"}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, json.RawMessage(`{"data":"ok"}`), response)
+	})
+}
@@ -1,11 +1,14 @@
 package process
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -14,6 +17,8 @@ import (
 )
 
 const (
+	networkConfigSnapshotKey = "networkConfig"
+
 	// NetworkStatusPath represents the path where an observer exposes his network metrics
 	NetworkStatusPath = "/network/status"
 
@@ -55,6 +60,12 @@ const (
 
 	// MetricNonce is the metric for monitoring the nonce of a node
 	MetricNonce = "erd_nonce"
+
+	// MetricEpochNumber is the metric for monitoring the epoch of a node
+	MetricEpochNumber = "erd_epoch_number"
+
+	// MetricCurrentRound is the metric for monitoring the current round of a node
+	MetricCurrentRound = "erd_current_round"
 )
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
@@ -62,7 +73,12 @@ type NodeStatusProcessor struct {
 	proc                  Processor
 	economicMetricsCacher GenericApiResponseCacheHandler
 	cacheValidityDuration time.Duration
+	snapshotPersister     CacheSnapshotPersister
 	cancelFunc            func()
+
+	mutNetworkConfig       sync.RWMutex
+	networkConfig          *data.NetworkConfig
+	networkConfigFetchTime time.Time
 }
 
 // NewNodeStatusProcessor creates a new instance of NodeStatusProcessor
@@ -70,6 +86,7 @@ func NewNodeStatusProcessor(
 	processor Processor,
 	economicMetricsCacher GenericApiResponseCacheHandler,
 	cacheValidityDuration time.Duration,
+	snapshotPersister CacheSnapshotPersister,
 ) (*NodeStatusProcessor, error) {
 	if check.IfNil(processor) {
 		return nil, ErrNilCoreProcessor
@@ -80,16 +97,20 @@ func NewNodeStatusProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if check.IfNil(snapshotPersister) {
+		return nil, ErrNilCacheSnapshotPersister
+	}
 
 	return &NodeStatusProcessor{
 		proc:                  processor,
 		economicMetricsCacher: economicMetricsCacher,
 		cacheValidityDuration: cacheValidityDuration,
+		snapshotPersister:     snapshotPersister,
 	}, nil
 }
 
 // GetNetworkStatusMetrics will simply forward the network status metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -98,13 +119,13 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &responseNetworkMetrics)
 		if err != nil {
-			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("network metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("network metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseNetworkMetrics, nil
 
 	}
@@ -113,7 +134,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 }
 
 // GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -122,13 +143,13 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkConfigPath, &responseNetworkMetrics)
 		if err != nil {
-			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("network metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("network metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseNetworkMetrics, nil
 
 	}
@@ -136,8 +157,66 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	return nil, WrapObserversError(responseNetworkMetrics.Error)
 }
 
+// GetNetworkConfig returns the parsed network config, which is fetched from an observer at most once per cache
+// validity duration
+func (nsp *NodeStatusProcessor) GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	nsp.mutNetworkConfig.RLock()
+	networkConfig := nsp.networkConfig
+	isValid := time.Since(nsp.networkConfigFetchTime) < nsp.cacheValidityDuration
+	nsp.mutNetworkConfig.RUnlock()
+
+	if networkConfig != nil && isValid {
+		return networkConfig, nil
+	}
+
+	response, err := nsp.GetNetworkConfigMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfigBytes, err := json.Marshal(&response.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig = &data.NetworkConfig{}
+	err = json.Unmarshal(networkConfigBytes, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	nsp.mutNetworkConfig.Lock()
+	nsp.networkConfig = networkConfig
+	nsp.networkConfigFetchTime = time.Now()
+	nsp.mutNetworkConfig.Unlock()
+
+	err = nsp.snapshotPersister.SaveSnapshot(networkConfigSnapshotKey, networkConfig)
+	if err != nil {
+		log.WithContext(ctx).Warn("network config: save snapshot", "error", err.Error())
+	}
+
+	return networkConfig, nil
+}
+
+// warmLoadNetworkConfig sets the network config from the saved snapshot, if any. The snapshot keeps its original fetch
+// time, so it is only served while it is younger than the cache validity duration
+func (nsp *NodeStatusProcessor) warmLoadNetworkConfig() {
+	networkConfig := &data.NetworkConfig{}
+	savedAt, found := nsp.snapshotPersister.LoadSnapshot(networkConfigSnapshotKey, networkConfig)
+	if !found {
+		return
+	}
+
+	nsp.mutNetworkConfig.Lock()
+	nsp.networkConfig = networkConfig
+	nsp.networkConfigFetchTime = savedAt
+	nsp.mutNetworkConfig.Unlock()
+
+	log.Info("network config warm-loaded from snapshot", "age", time.Since(savedAt))
+}
+
 // GetEnableEpochsMetrics will simply forward the activation epochs config metrics from an observer
-func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -146,13 +225,13 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 	responseEnableEpochsMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
 		if err != nil {
-			log.Error("enable epochs metrics request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("enable epochs metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("enable epochs metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("enable epochs metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseEnableEpochsMetrics, nil
 	}
 
@@ -160,7 +239,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 }
 
 // GetAllIssuedESDTs will forward the issued ESDTs based on the provided type
-func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if !data.IsValidEsdtPath(tokenType) && tokenType != "" {
 		return nil, ErrInvalidTokenType
 	}
@@ -177,13 +256,13 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 		if tokenType != "" {
 			path = fmt.Sprintf("%s/%s", NetworkEsdtTokensPrefix, tokenType)
 		}
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseAllIssuedESDTs)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseAllIssuedESDTs)
 		if err != nil {
-			log.Error("all issued esdts request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("all issued esdts request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("all issued esdts request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("all issued esdts request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseAllIssuedESDTs, nil
 
 	}
@@ -192,7 +271,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 }
 
 // GetDelegatedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -201,13 +280,13 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 	delegatedInfoResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
 		if err != nil {
-			log.Error("network delegated info request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("network delegated info request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("network delegated info request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("network delegated info request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &delegatedInfoResponse, nil
 
 	}
@@ -216,7 +295,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 }
 
 // GetDirectStakedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -225,13 +304,13 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 	directStakedResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DirectStakedPath, &directStakedResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DirectStakedPath, &directStakedResponse)
 		if err != nil {
-			log.Error("network direct staked request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("network direct staked request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("network direct staked request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("network direct staked request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &directStakedResponse, nil
 
 	}
@@ -240,7 +319,7 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 }
 
 // GetRatingsConfig will simply forward the ratings configuration from an observer
-func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -249,13 +328,13 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	responseRatingsConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, RatingsConfigPath, &responseRatingsConfig)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, RatingsConfigPath, &responseRatingsConfig)
 		if err != nil {
-			log.Error("ratings metrics request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("ratings metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("ratings metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("ratings metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseRatingsConfig, nil
 
 	}
@@ -263,7 +342,7 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	return nil, WrapObserversError(responseRatingsConfig.Error)
 }
 
-func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getNodeStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -272,13 +351,13 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NodeStatusPath, &responseNetworkMetrics)
 		if err != nil {
-			log.Error("node status metrics request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("node status metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("node status metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("node status metrics request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseNetworkMetrics, nil
 
 	}
@@ -287,7 +366,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 }
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
-func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -295,7 +374,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 
 	nonces := make([]uint64, 0)
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+		nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 		if err != nil {
 			return 0, err
 		}
@@ -322,8 +401,8 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 }
 
 // GetTriesStatistics will return trie statistics
-func (nsp *NodeStatusProcessor) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+func (nsp *NodeStatusProcessor) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +524,7 @@ func getUint(value interface{}) uint64 {
 }
 
 // GetGenesisNodesPubKeys will return genesis nodes public keys
-func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -454,13 +533,13 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 	response := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, GenesisNodesConfigPath, &response)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GenesisNodesConfigPath, &response)
 		if err != nil {
-			log.Error("genesis nodes request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("genesis nodes request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("genesis nodes request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("genesis nodes request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &response, nil
 
 	}
@@ -469,7 +548,7 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 }
 
 // GetGasConfigs will return gas configs
-func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -478,13 +557,13 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 	responseGenesisNodesConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
 		if err != nil {
-			log.Error("gas configs request", "observer", observer.Address, "error", err.Error())
+			log.WithContext(ctx).Error("gas configs request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("gas configs request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("gas configs request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseGenesisNodesConfig, nil
 
 	}
@@ -493,7 +572,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 }
 
 // GetEpochStartData will return the epoch-start data for the given epoch and shard
-func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -503,13 +582,13 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 	path := fmt.Sprintf("/node/epoch-start/%d", epoch)
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseEpochStartData)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseEpochStartData)
 		if err != nil {
-			log.Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
+			log.WithContext(ctx).Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
 			continue
 		}
 
-		log.Info("epoch start data request", "shard ID", observer.ShardId, "observer", observer.Address)
+		log.WithContext(ctx).Info("epoch start data request", "shard ID", observer.ShardId, "observer", observer.Address)
 		return &responseEpochStartData, nil
 	}
 
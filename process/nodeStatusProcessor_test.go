@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +17,7 @@ import (
 func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second, &disabled.CacheSnapshotPersister{})
 
 	require.Equal(t, ErrNilCoreProcessor, err)
 	require.Nil(t, nodeStatusProc)
@@ -24,7 +26,7 @@ func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second, &disabled.CacheSnapshotPersister{})
 
 	require.Equal(t, ErrNilEconomicMetricsCacher, err)
 	require.Nil(t, nodeStatusProc)
@@ -33,12 +35,21 @@ func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 func TestNewNodeStatusProcessor_InvalidCacheValidityDuration(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second, &disabled.CacheSnapshotPersister{})
 
 	require.Equal(t, ErrInvalidCacheValidityDuration, err)
 	require.Nil(t, nodeStatusProc)
 }
 
+func TestNewNodeStatusProcessor_NilSnapshotPersister(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, time.Second, nil)
+
+	require.Equal(t, ErrNilCacheSnapshotPersister, err)
+	require.Nil(t, nodeStatusProc)
+}
+
 func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T) {
 	t.Parallel()
 
@@ -55,9 +66,10 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkConfigMetrics()
+	status, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -83,9 +95,10 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
+	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -98,6 +111,61 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 
 }
 
+func TestNodeStatusProcessor_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(cacheValidityDuration time.Duration, numCalls *int) *NodeStatusProcessor {
+		nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+			GetAllObserversCalled: func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "address1", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*numCalls++
+				require.Equal(t, NetworkConfigPath, path)
+
+				genRespBytes := []byte(`{"data":{"config":{"erd_chain_id":"T","erd_min_gas_limit":50000,"erd_gas_price_modifier":"0.01","erd_denomination":18}}}`)
+				return 0, json.Unmarshal(genRespBytes, value)
+			},
+		},
+			&mock.GenericApiResponseCacherMock{},
+			cacheValidityDuration,
+			&disabled.CacheSnapshotPersister{},
+		)
+
+		return nodeStatusProc
+	}
+
+	t.Run("should parse the config and cache it", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		nodeStatusProc := createProcessor(time.Hour, &numCalls)
+
+		networkConfig, err := nodeStatusProc.GetNetworkConfig(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, "T", networkConfig.Config.ChainID)
+		require.Equal(t, uint64(50000), networkConfig.Config.MinGasLimit)
+		require.Equal(t, json.Number("0.01"), networkConfig.Config.GasPriceModifier)
+		require.Equal(t, 18, networkConfig.Config.Denomination)
+
+		_, _ = nodeStatusProc.GetNetworkConfig(context.Background())
+		require.Equal(t, 1, numCalls)
+	})
+	t.Run("expired cache should be refreshed", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		nodeStatusProc := createProcessor(time.Nanosecond, &numCalls)
+
+		_, _ = nodeStatusProc.GetNetworkConfig(context.Background())
+		time.Sleep(time.Millisecond)
+		_, _ = nodeStatusProc.GetNetworkConfig(context.Background())
+		require.Equal(t, 2, numCalls)
+	})
+}
+
 func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -109,9 +177,10 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -132,9 +201,10 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -160,9 +230,10 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -221,9 +292,10 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
 }
@@ -239,9 +311,10 @@ func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -262,9 +335,10 @@ func TestNodeStatusProcessor_GetAllIssuedESDTsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -288,9 +362,10 @@ func TestNodeStatusProcessor_GetAllIssuedESDTs(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs("")
+	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -325,9 +400,10 @@ func TestNodeStatusProcessor_ApiPathIsCorrect(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	_, err := nodeStatusProc.GetAllIssuedESDTs(data.SemiFungibleTokens)
+	_, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), data.SemiFungibleTokens)
 	require.Nil(t, err)
 }
 
@@ -342,9 +418,10 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -365,9 +442,10 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -390,9 +468,10 @@ func TestNodeStatusProcessor_GetDelegatedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetDelegatedInfo()
+	actualResponse, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -408,9 +487,10 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetObserversFailedShouldErr(t *t
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -431,9 +511,10 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetRestEndPointError(t *testing.
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -456,9 +537,10 @@ func TestNodeStatusProcessor_GetDirectStakedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetDirectStakedInfo()
+	actualResponse, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -479,9 +561,10 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetEndpointErr(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodesStatusProc.GetEnableEpochsMetrics()
+	status, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -509,9 +592,10 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics()
+	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -534,9 +618,10 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetEnableEpochsMetrics()
+	status, err := nodeStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -552,9 +637,10 @@ func TestNodeStatusProcessor_GetRatingsConfigGetAllObserversShouldFail(t *testin
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	status, err := nodeStatusProc.GetRatingsConfig()
+	status, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -577,9 +663,10 @@ func TestNodeStatusProcessor_GetRatingsConfig(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetRatingsConfig()
+	actualResponse, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -602,9 +689,10 @@ func TestNodeStatusProcessor_GetGenesisNodesPubKeys(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		&disabled.CacheSnapshotPersister{},
 	)
 
-	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys()
+	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -627,9 +715,10 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -652,9 +741,10 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -678,9 +768,10 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Second,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -706,9 +797,10 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Second,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.Equal(t, ErrCannotParseNodeStatusMetrics, err)
 	})
@@ -737,9 +829,10 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, err)
 		require.Equal(t, providedNumNodes, response.Data.AccountsSnapshotNumNodes)
 	})
@@ -763,9 +856,10 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -788,9 +882,10 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			&disabled.CacheSnapshotPersister{},
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
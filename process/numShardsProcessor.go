@@ -11,6 +11,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 var errTimeIsOut = errors.New("time is out")
@@ -30,14 +31,14 @@ type networkConfigResponse struct {
 // ArgNumShardsProcessor is the DTO used to create a new instance of numShardsProcessor
 type ArgNumShardsProcessor struct {
 	HttpClient                    HttpClient
-	Observers                     []string
+	Observers                     []*data.NodeData
 	TimeBetweenNodesRequestsInSec int
 	NumShardsTimeoutInSec         int
 	RequestTimeoutInSec           int
 }
 
 type numShardsProcessor struct {
-	observers                []string
+	observers                []*data.NodeData
 	httpClient               HttpClient
 	timeBetweenNodesRequests time.Duration
 	numShardsTimeout         time.Duration
@@ -82,36 +83,37 @@ func checkArgs(args ArgNumShardsProcessor) error {
 
 // GetNetworkNumShards tries to get the number of shards from the network
 func (processor *numShardsProcessor) GetNetworkNumShards(ctx context.Context) (uint32, error) {
-	log.Info("getting the number of shards from observers...")
+	log.WithContext(ctx).Info("getting the number of shards from observers...")
 
 	waitNodeTicker := time.NewTicker(processor.timeBetweenNodesRequests)
 	for {
 		select {
 		case <-waitNodeTicker.C:
-			for _, observerAddress := range processor.observers {
-				numShards, httpStatus := processor.tryGetnumShardsFromObserver(observerAddress)
+			for _, observer := range processor.observers {
+				numShards, httpStatus := processor.tryGetnumShardsFromObserver(observer)
 				if httpStatus == http.StatusOK {
-					log.Info("fetched the number of shards", "shards", numShards)
+					log.WithContext(ctx).Info("fetched the number of shards", "shards", numShards)
 					return numShards, nil
 				}
 			}
 		case <-time.After(processor.numShardsTimeout):
 			return 0, fmt.Errorf("%w, no observer online", errTimeIsOut)
 		case <-ctx.Done():
-			log.Debug("closing the getNetworkNumShards loop due to context done...")
+			log.WithContext(ctx).Debug("closing the getNetworkNumShards loop due to context done...")
 			return 0, errTimeIsOut
 		}
 	}
 }
 
-func (processor *numShardsProcessor) tryGetnumShardsFromObserver(observerAddress string) (uint32, int) {
+func (processor *numShardsProcessor) tryGetnumShardsFromObserver(observer *data.NodeData) (uint32, int) {
 	ctx, cancel := context.WithTimeout(context.Background(), processor.requestTimeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, observerAddress+NetworkConfigPath, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, observer.Address+NetworkConfigPath, nil)
 	if err != nil {
 		return 0, http.StatusNotFound
 	}
+	setObserverAuthorizationHeader(req, observer)
 
 	resp, err := processor.httpClient.Do(req)
 	if err != nil {
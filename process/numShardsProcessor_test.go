@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -19,7 +20,7 @@ import (
 func createMockArgNumShardsProcessor() ArgNumShardsProcessor {
 	return ArgNumShardsProcessor{
 		HttpClient:                    &mock.HttpClientMock{},
-		Observers:                     []string{"obs1, obs2"},
+		Observers:                     []*data.NodeData{{Address: "obs1"}, {Address: "obs2"}},
 		TimeBetweenNodesRequestsInSec: 2,
 		NumShardsTimeoutInSec:         10,
 		RequestTimeoutInSec:           5,
@@ -43,7 +44,7 @@ func TestNewNumShardsProcessor(t *testing.T) {
 		t.Parallel()
 
 		args := createMockArgNumShardsProcessor()
-		args.Observers = []string{}
+		args.Observers = []*data.NodeData{}
 
 		proc, err := NewNumShardsProcessor(args)
 		require.True(t, errors.Is(err, core.ErrInvalidValue))
@@ -173,4 +174,29 @@ func TestNumShardsProcessor_GetNetworkNumShards(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, uint32(2), numShards)
 	})
+	t.Run("should send the observer credentials", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgNumShardsProcessor()
+		args.TimeBetweenNodesRequestsInSec = 1
+		args.Observers = []*data.NodeData{{Address: "obs1", BearerToken: "token"}}
+		args.HttpClient = &mock.HttpClientMock{
+			DoCalled: func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("Authorization") != "Bearer token" {
+					return &http.Response{StatusCode: http.StatusUnauthorized}, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data":{"config":{"erd_num_shards_without_meta":3}}}`)),
+				}, nil
+			},
+		}
+
+		proc, err := NewNumShardsProcessor(args)
+		require.NoError(t, err)
+		numShards, err := proc.GetNetworkNumShards(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), numShards)
+	})
 }
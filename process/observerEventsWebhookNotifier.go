@@ -0,0 +1,123 @@
+package process
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// WebhookFormatJSON is the format of the webhooks receiving the observer events as they are
+	WebhookFormatJSON = "json"
+
+	// WebhookFormatSlack is the format of the Slack compatible incoming webhooks, receiving the events as a text message
+	WebhookFormatSlack = "slack"
+)
+
+// Webhook holds the URL where the observer events are posted and the format of the posted payload
+type Webhook struct {
+	URL    string
+	Format string
+}
+
+type observerEventsPayload struct {
+	Events []*data.ObserverEvent `json:"events"`
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// ObserverEventsWebhookNotifier posts the observers failover events to the configured webhooks, so that the operators
+// are alerted as soon as an observer is quarantined or restored, or a shard loses all its synced observers
+type ObserverEventsWebhookNotifier struct {
+	webhooks   []Webhook
+	httpClient *http.Client
+}
+
+// NewObserverEventsWebhookNotifier creates a new instance of ObserverEventsWebhookNotifier
+func NewObserverEventsWebhookNotifier(webhooks []Webhook, requestTimeout time.Duration) (*ObserverEventsWebhookNotifier, error) {
+	if len(webhooks) == 0 {
+		return nil, ErrNoWebhookURL
+	}
+	for _, webhook := range webhooks {
+		if len(webhook.URL) == 0 {
+			return nil, ErrNoWebhookURL
+		}
+		if webhook.Format != WebhookFormatJSON && webhook.Format != WebhookFormatSlack {
+			return nil, fmt.Errorf("%w: %s for %s", ErrInvalidWebhookFormat, webhook.Format, webhook.URL)
+		}
+	}
+	if requestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &ObserverEventsWebhookNotifier{
+		webhooks:   webhooks,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// NotifyObserverEvents posts the events to all the webhooks, in the background, so that the nodes sync state checks are
+// not delayed by slow webhooks
+func (oewn *ObserverEventsWebhookNotifier) NotifyObserverEvents(events []*data.ObserverEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	for _, webhook := range oewn.webhooks {
+		go oewn.notifyWebhook(webhook, events)
+	}
+}
+
+func (oewn *ObserverEventsWebhookNotifier) notifyWebhook(webhook Webhook, events []*data.ObserverEvent) {
+	err := oewn.postEvents(webhook, events)
+	if err != nil {
+		log.Warn("cannot notify the observer events to the webhook", "url", webhook.URL, "error", err)
+	}
+}
+
+func (oewn *ObserverEventsWebhookNotifier) postEvents(webhook Webhook, events []*data.ObserverEvent) error {
+	var payload interface{} = &observerEventsPayload{Events: events}
+	if webhook.Format == WebhookFormatSlack {
+		payload = createSlackPayload(events)
+	}
+
+	requestBody, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	response, err := oewn.httpClient.Post(webhook.URL, "application/json", bytes.NewReader(requestBody))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with code %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func createSlackPayload(events []*data.ObserverEvent) *slackPayload {
+	lines := make([]string, 0, len(events))
+	for _, event := range events {
+		lines = append(lines, fmt.Sprintf("[%s] %s", event.Type, event.Message))
+	}
+
+	return &slackPayload{Text: strings.Join(lines, "\n")}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oewn *ObserverEventsWebhookNotifier) IsInterfaceNil() bool {
+	return oewn == nil
+}
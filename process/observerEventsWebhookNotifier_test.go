@@ -0,0 +1,110 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserverEventsWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := process.NewObserverEventsWebhookNotifier(nil, time.Second)
+	assert.Nil(t, notifier)
+	assert.Equal(t, process.ErrNoWebhookURL, err)
+
+	notifier, err = process.NewObserverEventsWebhookNotifier([]process.Webhook{{Format: process.WebhookFormatJSON}}, time.Second)
+	assert.Nil(t, notifier)
+	assert.Equal(t, process.ErrNoWebhookURL, err)
+
+	notifier, err = process.NewObserverEventsWebhookNotifier([]process.Webhook{{URL: "http://hook", Format: "xml"}}, time.Second)
+	assert.Nil(t, notifier)
+	assert.True(t, errors.Is(err, process.ErrInvalidWebhookFormat))
+
+	notifier, err = process.NewObserverEventsWebhookNotifier([]process.Webhook{{URL: "http://hook", Format: process.WebhookFormatSlack}}, 0)
+	assert.Nil(t, notifier)
+	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
+
+	notifier, err = process.NewObserverEventsWebhookNotifier([]process.Webhook{{URL: "http://hook", Format: process.WebhookFormatJSON}}, time.Second)
+	require.Nil(t, err)
+	assert.False(t, notifier.IsInterfaceNil())
+}
+
+func TestObserverEventsWebhookNotifier_NotifyObserverEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []*data.ObserverEvent{
+		{
+			Type:      data.ObserverQuarantined,
+			NodeType:  data.Observer,
+			ShardID:   1,
+			Address:   "http://observer1",
+			Message:   "observer http://observer1 of shard 1 is out of sync and no longer receives requests",
+			Timestamp: 1700000000,
+		},
+		{
+			Type:      data.ShardWithoutObservers,
+			NodeType:  data.Observer,
+			ShardID:   1,
+			Message:   "shard 1 has no synced observer left",
+			Timestamp: 1700000000,
+		},
+	}
+
+	chanJSONPayloads := make(chan map[string]interface{}, 1)
+	jsonServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		payload := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		chanJSONPayloads <- payload
+	}))
+	defer jsonServer.Close()
+
+	chanSlackPayloads := make(chan map[string]interface{}, 1)
+	slackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		chanSlackPayloads <- payload
+	}))
+	defer slackServer.Close()
+
+	notifier, err := process.NewObserverEventsWebhookNotifier(
+		[]process.Webhook{
+			{URL: jsonServer.URL, Format: process.WebhookFormatJSON},
+			{URL: slackServer.URL, Format: process.WebhookFormatSlack},
+		},
+		time.Second,
+	)
+	require.Nil(t, err)
+
+	notifier.NotifyObserverEvents(nil)
+	notifier.NotifyObserverEvents(events)
+
+	jsonPayload := <-chanJSONPayloads
+	postedEvents := jsonPayload["events"].([]interface{})
+	require.Len(t, postedEvents, 2)
+	assert.Equal(t, map[string]interface{}{
+		"type":      "observerQuarantined",
+		"nodeType":  "observer",
+		"shardId":   float64(1),
+		"address":   "http://observer1",
+		"message":   "observer http://observer1 of shard 1 is out of sync and no longer receives requests",
+		"timestamp": float64(1700000000),
+	}, postedEvents[0])
+
+	slackPayload := <-chanSlackPayloads
+	assert.Equal(t, map[string]interface{}{
+		"text": "[observerQuarantined] observer http://observer1 of shard 1 is out of sync and no longer receives requests\n" +
+			"[shardWithoutObservers] shard 1 has no synced observer left",
+	}, slackPayload)
+}
@@ -0,0 +1,63 @@
+package process
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+type observerHeadersTransport struct {
+	transport     http.RoundTripper
+	staticHeaders http.Header
+}
+
+// NewObserverHeadersTransport wraps the provided transport so that the configured static headers and the client
+// headers forwarded for the request being served are added to every request sent to the observers. The headers already
+// set by the proxy itself are never overridden
+func NewObserverHeadersTransport(transport http.RoundTripper, staticHeaders []config.StaticHeaderConfig) (http.RoundTripper, error) {
+	if transport == nil {
+		return nil, ErrNilHttpTransport
+	}
+
+	headers := make(http.Header)
+	for _, header := range staticHeaders {
+		if len(strings.TrimSpace(header.Name)) == 0 {
+			return nil, ErrEmptyObserverHeaderName
+		}
+		headers.Add(header.Name, header.Value)
+	}
+
+	return &observerHeadersTransport{
+		transport:     transport,
+		staticHeaders: headers,
+	}, nil
+}
+
+// RoundTrip adds the extra headers on a copy of the request, then sends it through the wrapped transport. The headers
+// forwarded for the request being served are read from the context of the request sent to the observer
+func (oht *observerHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	forwardedHeaders := common.GetForwardedHeaders(req.Context())
+	if len(oht.staticHeaders) == 0 && len(forwardedHeaders) == 0 {
+		return oht.transport.RoundTrip(req)
+	}
+
+	newReq := req.Clone(req.Context())
+	addMissingHeaders(newReq.Header, oht.staticHeaders)
+	addMissingHeaders(newReq.Header, forwardedHeaders)
+
+	return oht.transport.RoundTrip(newReq)
+}
+
+// addMissingHeaders copies the extra headers which are not already set on the destination
+func addMissingHeaders(destination http.Header, extraHeaders http.Header) {
+	for name, values := range extraHeaders {
+		if len(destination.Values(name)) > 0 {
+			continue
+		}
+		for _, value := range values {
+			destination.Add(name, value)
+		}
+	}
+}
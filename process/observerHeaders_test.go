@@ -0,0 +1,74 @@
+package process
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserverHeadersTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transport should error", func(t *testing.T) {
+		t.Parallel()
+
+		transport, err := NewObserverHeadersTransport(nil, nil)
+		require.Nil(t, transport)
+		require.Equal(t, ErrNilHttpTransport, err)
+	})
+	t.Run("empty header name should error", func(t *testing.T) {
+		t.Parallel()
+
+		transport, err := NewObserverHeadersTransport(http.DefaultTransport, []config.StaticHeaderConfig{{Name: " ", Value: "value"}})
+		require.Nil(t, transport)
+		require.Equal(t, ErrEmptyObserverHeaderName, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		transport, err := NewObserverHeadersTransport(http.DefaultTransport, []config.StaticHeaderConfig{{Name: "X-Tenant-ID", Value: "tenant"}})
+		require.Nil(t, err)
+		require.NotNil(t, transport)
+	})
+}
+
+func TestObserverHeadersTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	receivedHeaders := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeaders <- r.Header
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	staticHeaders := []config.StaticHeaderConfig{
+		{Name: "X-Tenant-ID", Value: "static tenant"},
+		{Name: "Content-Type", Value: "text/plain"},
+	}
+	transport, err := NewObserverHeadersTransport(http.DefaultTransport, staticHeaders)
+	require.Nil(t, err)
+	client := &http.Client{Transport: transport}
+
+	ctx := common.ContextWithForwardedHeaders(context.Background(), http.Header{
+		"X-Tenant-Id":    []string{"forwarded tenant"},
+		"X-Client-Token": []string{"token"},
+	})
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	headers := <-receivedHeaders
+	require.Equal(t, "application/json", headers.Get("Content-Type"))
+	require.Equal(t, []string{"static tenant"}, headers.Values("X-Tenant-ID"))
+	require.Equal(t, "token", headers.Get("X-Client-Token"))
+	require.Empty(t, req.Header.Get("X-Tenant-ID"))
+}
@@ -0,0 +1,95 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type networkConfigAPIResponse struct {
+	Data  proxyData.NetworkConfig `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func (bp *BaseProcessor) isNetworkVerificationEnabled() bool {
+	return len(bp.expectedChainID) > 0 || bp.expectedMinTransactionVersion > 0
+}
+
+// checkObserverNetwork fetches the network config of the observer and returns the exclusion details if the observer
+// reports a chain ID or a min transaction version different from the expected ones. A nil exclusion is returned if the
+// observer is connected to the expected network or if the verification is disabled
+func (bp *BaseProcessor) checkObserverNetwork(node *proxyData.NodeData) (*proxyData.ExcludedObserver, error) {
+	if !bp.isNetworkVerificationEnabled() {
+		return nil, nil
+	}
+
+	networkConfig, httpCode, err := bp.networkConfigFetcher(node.Address)
+	if err != nil {
+		return nil, err
+	}
+	if httpCode != http.StatusOK {
+		return nil, fmt.Errorf("observer %s responded with code %d on %s", node.Address, httpCode, NetworkConfigPath)
+	}
+
+	chainID := networkConfig.Config.ChainID
+	minTransactionVersion := networkConfig.Config.MinTransactionVersion
+	isChainIDMismatch := len(bp.expectedChainID) > 0 && chainID != bp.expectedChainID
+	isMinTransactionVersionMismatch := bp.expectedMinTransactionVersion > 0 && minTransactionVersion != bp.expectedMinTransactionVersion
+	if !isChainIDMismatch && !isMinTransactionVersionMismatch {
+		return nil, nil
+	}
+
+	return &proxyData.ExcludedObserver{
+		Address:                       node.Address,
+		ShardID:                       node.ShardId,
+		Reason:                        proxyData.ExclusionReasonNetworkMismatch,
+		ChainID:                       chainID,
+		ExpectedChainID:               bp.expectedChainID,
+		MinTransactionVersion:         minTransactionVersion,
+		ExpectedMinTransactionVersion: bp.expectedMinTransactionVersion,
+	}, nil
+}
+
+func (bp *BaseProcessor) getNetworkConfigFromAPI(url string) (*proxyData.NetworkConfig, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutDurationForNodeStatus)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+NetworkConfigPath, nil)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	bp.setAcceptEncodingHeader(req)
+	bp.setAuthorizationHeader(req, url)
+
+	resp, err := bp.httpClient.Do(req)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+
+	defer func() {
+		if resp != nil && resp.Body != nil {
+			log.LogIfError(resp.Body.Close())
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, nil
+	}
+
+	responseBodyBytes, err := readResponseBody(resp)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	var response networkConfigAPIResponse
+	err = json.Unmarshal(responseBodyBytes, &response)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return &response.Data, resp.StatusCode, nil
+}
@@ -0,0 +1,43 @@
+package process
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+const numAppVersionComponents = 3
+
+// appVersionRegex matches the release part of a node's app version, such as v1.7.13 in v1.7.13-0-g3f4ad46/go1.20.7/linux-amd64
+var appVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+type appVersion [numAppVersionComponents]uint64
+
+func parseAppVersion(version string) (appVersion, error) {
+	matches := appVersionRegex.FindStringSubmatch(version)
+	if len(matches) != numAppVersionComponents+1 {
+		return appVersion{}, fmt.Errorf("%w: %s", ErrInvalidAppVersion, version)
+	}
+
+	parsedVersion := appVersion{}
+	for i := 0; i < numAppVersionComponents; i++ {
+		component, err := strconv.ParseUint(matches[i+1], 10, 64)
+		if err != nil {
+			return appVersion{}, fmt.Errorf("%w: %s", ErrInvalidAppVersion, version)
+		}
+
+		parsedVersion[i] = component
+	}
+
+	return parsedVersion, nil
+}
+
+func (version appVersion) isLowerThan(other appVersion) bool {
+	for i := 0; i < numAppVersionComponents; i++ {
+		if version[i] != other[i] {
+			return version[i] < other[i]
+		}
+	}
+
+	return false
+}
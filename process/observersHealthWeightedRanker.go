@@ -0,0 +1,137 @@
+package process
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// healthSmoothingFactor is the weight of the latest request in the recent success rate and latency of an observer
+	healthSmoothingFactor = 0.2
+
+	// minHealthWeight is the lowest selection weight of an observer, relative to the healthiest one, so that a failing
+	// observer is still probed from time to time and its recovery noticed
+	minHealthWeight = 0.01
+)
+
+type observerHealth struct {
+	successRate float64
+	latency     time.Duration
+}
+
+// ObserversHealthWeightedRanker orders the observers randomly, each position being filled by one of the remaining
+// observers with a probability proportional to its weight, computed from its recent success rate and latency. The
+// equivalent observers thus share the load evenly, instead of all the proxies of a fleet choosing the same first node.
+// Reads and writes use separate statistics, built from the GET and POST requests respectively
+type ObserversHealthWeightedRanker struct {
+	mut           sync.RWMutex
+	health        map[string]*observerHealth
+	randomHandler func() float64
+}
+
+// NewObserversHealthWeightedRanker creates a new instance of ObserversHealthWeightedRanker
+func NewObserversHealthWeightedRanker() *ObserversHealthWeightedRanker {
+	return &ObserversHealthWeightedRanker{
+		health:        make(map[string]*observerHealth),
+		randomHandler: rand.Float64,
+	}
+}
+
+// RecordRequest updates the recent success rate and latency of the observer with the outcome of a request
+func (ohr *ObserversHealthWeightedRanker) RecordRequest(address string, method string, duration time.Duration, success bool) {
+	successValue := 0.0
+	if success {
+		successValue = 1
+	}
+
+	ohr.mut.Lock()
+	defer ohr.mut.Unlock()
+
+	key := method + address
+	health, found := ohr.health[key]
+	if !found {
+		ohr.health[key] = &observerHealth{
+			successRate: successValue,
+			latency:     duration,
+		}
+		return
+	}
+
+	health.successRate += healthSmoothingFactor * (successValue - health.successRate)
+	health.latency += time.Duration(healthSmoothingFactor * float64(duration-health.latency))
+}
+
+// RankForReads returns the nodes in a random order weighted by their recent GET requests health
+func (ohr *ObserversHealthWeightedRanker) RankForReads(nodes []*data.NodeData) []*data.NodeData {
+	return ohr.rank(nodes, http.MethodGet)
+}
+
+// RankForWrites returns the nodes in a random order weighted by their recent POST requests health
+func (ohr *ObserversHealthWeightedRanker) RankForWrites(nodes []*data.NodeData) []*data.NodeData {
+	return ohr.rank(nodes, http.MethodPost)
+}
+
+// rank computes a weighted random permutation of the nodes by assigning each node an exponentially distributed key
+// with the rate equal to its weight and sorting the nodes ascending by their keys
+func (ohr *ObserversHealthWeightedRanker) rank(nodes []*data.NodeData, method string) []*data.NodeData {
+	if len(nodes) < 2 {
+		return nodes
+	}
+
+	weights := ohr.getWeights(nodes, method)
+	keys := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		keys[node.Address] = -math.Log(1-ohr.randomHandler()) / weights[node.Address]
+	}
+
+	rankedNodes := make([]*data.NodeData, len(nodes))
+	copy(rankedNodes, nodes)
+	sort.SliceStable(rankedNodes, func(i, j int) bool {
+		return keys[rankedNodes[i].Address] < keys[rankedNodes[j].Address]
+	})
+
+	return rankedNodes
+}
+
+// getWeights returns the weight of each node as its recent success rate scaled down by how many times its recent
+// latency exceeds the lowest one. The nodes without any recorded request get the full weight, so that they get measured
+func (ohr *ObserversHealthWeightedRanker) getWeights(nodes []*data.NodeData, method string) map[string]float64 {
+	ohr.mut.RLock()
+	defer ohr.mut.RUnlock()
+
+	lowestLatency := time.Duration(0)
+	for _, node := range nodes {
+		health, found := ohr.health[method+node.Address]
+		if found && health.latency > 0 && (lowestLatency == 0 || health.latency < lowestLatency) {
+			lowestLatency = health.latency
+		}
+	}
+
+	weights := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		health, found := ohr.health[method+node.Address]
+		if !found {
+			weights[node.Address] = 1
+			continue
+		}
+
+		weight := health.successRate
+		if lowestLatency > 0 && health.latency > lowestLatency {
+			weight *= float64(lowestLatency) / float64(health.latency)
+		}
+		weights[node.Address] = math.Max(weight, minHealthWeight)
+	}
+
+	return weights
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ohr *ObserversHealthWeightedRanker) IsInterfaceNil() bool {
+	return ohr == nil
+}
@@ -0,0 +1,137 @@
+package process_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSequenceRandomHandler(values ...float64) func() float64 {
+	index := 0
+	return func() float64 {
+		value := values[index%len(values)]
+		index++
+		return value
+	}
+}
+
+func TestNewObserversHealthWeightedRanker(t *testing.T) {
+	t.Parallel()
+
+	ohr := process.NewObserversHealthWeightedRanker()
+	assert.False(t, ohr.IsInterfaceNil())
+}
+
+func TestObserversHealthWeightedRanker_Rank(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "obs0"},
+		{Address: "obs1"},
+		{Address: "obs2"},
+	}
+	addresses := func(rankedNodes []*data.NodeData) []string {
+		result := make([]string, 0, len(rankedNodes))
+		for _, node := range rankedNodes {
+			result = append(result, node.Address)
+		}
+		return result
+	}
+
+	t.Run("less than two nodes should not change", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		assert.Equal(t, nodes[:1], ohr.RankForReads(nodes[:1]))
+		assert.Equal(t, nodes[:1], ohr.RankForWrites(nodes[:1]))
+	})
+	t.Run("equivalent nodes should be ordered randomly", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		ohr.SetRandomHandler(createSequenceRandomHandler(0.9, 0.1, 0.5))
+
+		assert.Equal(t, []string{"obs1", "obs2", "obs0"}, addresses(ohr.RankForReads(nodes)))
+		assert.Equal(t, []string{"obs0", "obs1", "obs2"}, addresses(nodes))
+	})
+	t.Run("failing node should be tried last", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		ohr.SetRandomHandler(createSequenceRandomHandler(0.5))
+		ohr.RecordRequest("obs0", http.MethodGet, time.Millisecond, false)
+		ohr.RecordRequest("obs1", http.MethodGet, time.Millisecond, true)
+		ohr.RecordRequest("obs2", http.MethodGet, time.Millisecond, true)
+
+		assert.Equal(t, []string{"obs1", "obs2", "obs0"}, addresses(ohr.RankForReads(nodes)))
+	})
+	t.Run("slow node should be tried last", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		ohr.SetRandomHandler(createSequenceRandomHandler(0.5))
+		ohr.RecordRequest("obs0", http.MethodPost, 10*time.Millisecond, true)
+		ohr.RecordRequest("obs1", http.MethodPost, 40*time.Millisecond, true)
+		ohr.RecordRequest("obs2", http.MethodPost, 10*time.Millisecond, true)
+
+		assert.Equal(t, []string{"obs0", "obs2", "obs1"}, addresses(ohr.RankForWrites(nodes)))
+	})
+	t.Run("reads and writes should use separate statistics", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		ohr.SetRandomHandler(createSequenceRandomHandler(0.5))
+		ohr.RecordRequest("obs0", http.MethodPost, time.Millisecond, false)
+
+		assert.Equal(t, []string{"obs0", "obs1", "obs2"}, addresses(ohr.RankForReads(nodes)))
+		assert.Equal(t, []string{"obs1", "obs2", "obs0"}, addresses(ohr.RankForWrites(nodes)))
+	})
+	t.Run("recovered node should regain its share", func(t *testing.T) {
+		t.Parallel()
+
+		ohr := process.NewObserversHealthWeightedRanker()
+		ohr.SetRandomHandler(createSequenceRandomHandler(0.1, 0.5, 0.5))
+		ohr.RecordRequest("obs0", http.MethodGet, time.Millisecond, false)
+		ohr.RecordRequest("obs1", http.MethodGet, time.Millisecond, true)
+		ohr.RecordRequest("obs2", http.MethodGet, time.Millisecond, true)
+		assert.Equal(t, "obs1", ohr.RankForReads(nodes)[0].Address)
+
+		for i := 0; i < 20; i++ {
+			ohr.RecordRequest("obs0", http.MethodGet, time.Millisecond, true)
+		}
+		assert.Equal(t, "obs0", ohr.RankForReads(nodes)[0].Address)
+	})
+}
+
+func TestObserversHealthWeightedRanker_ShouldSpreadTheLoad(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "obs0"},
+		{Address: "obs1"},
+		{Address: "obs2"},
+		{Address: "obs3"},
+	}
+	ohr := process.NewObserversHealthWeightedRanker()
+	for _, node := range nodes[:3] {
+		ohr.RecordRequest(node.Address, http.MethodGet, 10*time.Millisecond, true)
+	}
+	ohr.RecordRequest("obs3", http.MethodGet, 10*time.Millisecond, false)
+
+	numRankings := 30000
+	numFirst := make(map[string]int)
+	for i := 0; i < numRankings; i++ {
+		numFirst[ohr.RankForReads(nodes)[0].Address]++
+	}
+
+	for _, node := range nodes[:3] {
+		share := float64(numFirst[node.Address]) / float64(numRankings)
+		require.InDelta(t, 0.33, share, 0.03, node.Address)
+	}
+	require.Less(t, float64(numFirst["obs3"])/float64(numRankings), 0.01)
+}
@@ -0,0 +1,106 @@
+package process
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ObserversLatencyRanker orders the observers based on their recent latency, as recorded by the metrics subsystem.
+// Reads and writes use separate rankings, built from the GET and POST latencies respectively, so that an observer
+// which is slow on one kind of requests does not degrade the other
+type ObserversLatencyRanker struct {
+	latencyProvider    ObserversLatencyProvider
+	slowObserverFactor float64
+}
+
+// NewObserversLatencyRanker creates a new instance of ObserversLatencyRanker
+func NewObserversLatencyRanker(latencyProvider ObserversLatencyProvider, slowObserverFactor float64) (*ObserversLatencyRanker, error) {
+	if check.IfNil(latencyProvider) {
+		return nil, ErrNilObserversLatencyProvider
+	}
+	if slowObserverFactor <= 1 {
+		return nil, ErrInvalidSlowObserverFactor
+	}
+
+	return &ObserversLatencyRanker{
+		latencyProvider:    latencyProvider,
+		slowObserverFactor: slowObserverFactor,
+	}, nil
+}
+
+// RecordRequest records the duration of a request sent to an observer, regardless of its outcome
+func (olr *ObserversLatencyRanker) RecordRequest(address string, method string, duration time.Duration, _ bool) {
+	olr.latencyProvider.AddObserverRequestData(address, method, duration)
+}
+
+// RankForReads keeps the order of the nodes, so that the reads are still balanced between them, but moves at the end
+// the nodes whose recent GET latency exceeds the lowest one by more than the slow observer factor
+func (olr *ObserversLatencyRanker) RankForReads(nodes []*data.NodeData) []*data.NodeData {
+	if len(nodes) < 2 {
+		return nodes
+	}
+
+	latencies := olr.getLatencies(nodes, http.MethodGet)
+	lowestLatency := time.Duration(0)
+	for _, latency := range latencies {
+		if latency > 0 && (lowestLatency == 0 || latency < lowestLatency) {
+			lowestLatency = latency
+		}
+	}
+	if lowestLatency == 0 {
+		return nodes
+	}
+
+	threshold := time.Duration(float64(lowestLatency) * olr.slowObserverFactor)
+	fastNodes := make([]*data.NodeData, 0, len(nodes))
+	slowNodes := make([]*data.NodeData, 0)
+	for _, node := range nodes {
+		if latencies[node.Address] > threshold {
+			slowNodes = append(slowNodes, node)
+			continue
+		}
+
+		fastNodes = append(fastNodes, node)
+	}
+	sort.SliceStable(slowNodes, func(i, j int) bool {
+		return latencies[slowNodes[i].Address] < latencies[slowNodes[j].Address]
+	})
+
+	return append(fastNodes, slowNodes...)
+}
+
+// RankForWrites orders the nodes ascending by their recent POST latency. The nodes without any recorded POST request
+// come first, so that their latency gets measured
+func (olr *ObserversLatencyRanker) RankForWrites(nodes []*data.NodeData) []*data.NodeData {
+	if len(nodes) < 2 {
+		return nodes
+	}
+
+	latencies := olr.getLatencies(nodes, http.MethodPost)
+	rankedNodes := make([]*data.NodeData, len(nodes))
+	copy(rankedNodes, nodes)
+	sort.SliceStable(rankedNodes, func(i, j int) bool {
+		return latencies[rankedNodes[i].Address] < latencies[rankedNodes[j].Address]
+	})
+
+	return rankedNodes
+}
+
+func (olr *ObserversLatencyRanker) getLatencies(nodes []*data.NodeData, method string) map[string]time.Duration {
+	latencies := make(map[string]time.Duration, len(nodes))
+	for _, node := range nodes {
+		latency, _ := olr.latencyProvider.GetObserverLatency(node.Address, method)
+		latencies[node.Address] = latency
+	}
+
+	return latencies
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (olr *ObserversLatencyRanker) IsInterfaceNil() bool {
+	return olr == nil
+}
@@ -0,0 +1,99 @@
+package process_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLatencyProvider(latencies map[string]map[string]time.Duration) *mock.ObserversLatencyProviderStub {
+	return &mock.ObserversLatencyProviderStub{
+		GetObserverLatencyCalled: func(address string, method string) (time.Duration, bool) {
+			latency, exists := latencies[method][address]
+			return latency, exists
+		},
+	}
+}
+
+func TestNewObserversLatencyRanker(t *testing.T) {
+	t.Parallel()
+
+	olr, err := process.NewObserversLatencyRanker(nil, 2)
+	assert.Nil(t, olr)
+	assert.Equal(t, process.ErrNilObserversLatencyProvider, err)
+
+	olr, err = process.NewObserversLatencyRanker(&mock.ObserversLatencyProviderStub{}, 1)
+	assert.Nil(t, olr)
+	assert.Equal(t, process.ErrInvalidSlowObserverFactor, err)
+
+	olr, err = process.NewObserversLatencyRanker(&mock.ObserversLatencyProviderStub{}, 2)
+	assert.NotNil(t, olr)
+	assert.Nil(t, err)
+}
+
+func TestObserversLatencyRanker_RecordRequest(t *testing.T) {
+	t.Parallel()
+
+	recorded := make(map[string]time.Duration)
+	olr, _ := process.NewObserversLatencyRanker(&mock.ObserversLatencyProviderStub{
+		AddObserverRequestDataCalled: func(address string, method string, duration time.Duration) {
+			recorded[method+address] = duration
+		},
+	}, 2)
+
+	olr.RecordRequest("obs0", http.MethodPost, time.Second, true)
+	assert.Equal(t, map[string]time.Duration{http.MethodPost + "obs0": time.Second}, recorded)
+}
+
+func TestObserversLatencyRanker_Rank(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "obs0"},
+		{Address: "obs1"},
+		{Address: "obs2"},
+		{Address: "obs3"},
+	}
+	latencies := map[string]map[string]time.Duration{
+		http.MethodGet: {
+			"obs0": 500 * time.Millisecond,
+			"obs1": 10 * time.Millisecond,
+			"obs2": 15 * time.Millisecond,
+			"obs3": 100 * time.Millisecond,
+		},
+		http.MethodPost: {
+			"obs0": 20 * time.Millisecond,
+			"obs1": 300 * time.Millisecond,
+			"obs2": 10 * time.Millisecond,
+		},
+	}
+	olr, _ := process.NewObserversLatencyRanker(createLatencyProvider(latencies), 2)
+
+	t.Run("reads should demote the slow nodes only", func(t *testing.T) {
+		t.Parallel()
+
+		ranked := olr.RankForReads(nodes)
+		require.Equal(t, 4, len(ranked))
+		assert.Equal(t, []*data.NodeData{nodes[1], nodes[2], nodes[3], nodes[0]}, ranked)
+	})
+	t.Run("writes should be ordered by latency", func(t *testing.T) {
+		t.Parallel()
+
+		ranked := olr.RankForWrites(nodes)
+		assert.Equal(t, []*data.NodeData{nodes[3], nodes[2], nodes[0], nodes[1]}, ranked)
+		assert.Equal(t, "obs0", nodes[0].Address)
+	})
+	t.Run("no recorded latency should keep the order", func(t *testing.T) {
+		t.Parallel()
+
+		emptyRanker, _ := process.NewObserversLatencyRanker(&mock.ObserversLatencyProviderStub{}, 2)
+		assert.Equal(t, nodes, emptyRanker.RankForReads(nodes))
+		assert.Equal(t, nodes, emptyRanker.RankForWrites(nodes))
+	})
+}
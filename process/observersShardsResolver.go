@@ -0,0 +1,125 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ArgObserversShardsResolver is the DTO used to create a new instance of observersShardsResolver
+type ArgObserversShardsResolver struct {
+	HttpClient             HttpClient
+	RequestTimeoutInSec    int
+	ValidateDeclaredShards bool
+}
+
+type observersShardsResolver struct {
+	httpClient             HttpClient
+	requestTimeout         time.Duration
+	validateDeclaredShards bool
+}
+
+// NewObserversShardsResolver returns a new instance of observersShardsResolver
+func NewObserversShardsResolver(args ArgObserversShardsResolver) (*observersShardsResolver, error) {
+	if check.IfNilReflect(args.HttpClient) {
+		return nil, ErrNilHttpClient
+	}
+	if args.RequestTimeoutInSec <= 0 {
+		return nil, fmt.Errorf("%w for RequestTimeoutInSec, %d provided", core.ErrInvalidValue, args.RequestTimeoutInSec)
+	}
+
+	return &observersShardsResolver{
+		httpClient:             args.HttpClient,
+		requestTimeout:         time.Second * time.Duration(args.RequestTimeoutInSec),
+		validateDeclaredShards: args.ValidateDeclaredShards,
+	}, nil
+}
+
+// ResolveShards sets the shard of the nodes declared without one to the shard they report and, if enabled, checks that
+// the other nodes report their declared shard, so that a misconfigured node is not silently receiving the requests of
+// another shard. The nodes declared with a shard which cannot be reached are kept as they are, the nodes state checks
+// being the ones to handle them
+func (resolver *observersShardsResolver) ResolveShards(nodes []*data.NodeData) error {
+	for _, node := range nodes {
+		if !node.AutoDetectShard && !resolver.validateDeclaredShards {
+			continue
+		}
+
+		reportedShardID, err := resolver.getReportedShardID(node)
+		if err != nil && node.AutoDetectShard {
+			return fmt.Errorf("%w for observer %s: %s", ErrObserverShardDetectionFailed, node.Address, err.Error())
+		}
+		if err != nil {
+			log.Warn("cannot validate the shard of the observer", "address", node.Address, "error", err.Error())
+			continue
+		}
+
+		if node.AutoDetectShard {
+			log.Info("detected the shard of the observer", "address", node.Address, "shard", reportedShardID)
+			node.ShardId = reportedShardID
+			node.AutoDetectShard = false
+			continue
+		}
+		if reportedShardID != node.ShardId {
+			return fmt.Errorf("%w for observer %s: declared shard %d, reported shard %d",
+				ErrObserverShardMismatch,
+				node.Address,
+				node.ShardId,
+				reportedShardID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (resolver *observersShardsResolver) getReportedShardID(node *data.NodeData) (uint32, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), resolver.requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, node.Address+NodeStatusPath, nil)
+	if err != nil {
+		return 0, err
+	}
+	setObserverAuthorizationHeader(req, node)
+
+	resp, err := resolver.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if resp != nil && resp.Body != nil {
+			log.LogIfError(resp.Body.Close())
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("responded with code %d", resp.StatusCode)
+	}
+
+	responseBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	var response data.NodeStatusAPIResponse
+	err = json.Unmarshal(responseBodyBytes, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Data.Metrics.ShardID, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (resolver *observersShardsResolver) IsInterfaceNil() bool {
+	return resolver == nil
+}
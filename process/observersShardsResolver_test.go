@@ -0,0 +1,127 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createShardReportingServer(shardID uint32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.ShardID = shardID
+		responseBytes, _ := json.Marshal(response)
+		_, _ = rw.Write(responseBytes)
+	}))
+}
+
+func createObserversShardsResolver(validateDeclaredShards bool) interface {
+	ResolveShards(nodes []*data.NodeData) error
+} {
+	resolver, _ := process.NewObserversShardsResolver(process.ArgObserversShardsResolver{
+		HttpClient:             http.DefaultClient,
+		RequestTimeoutInSec:    2,
+		ValidateDeclaredShards: validateDeclaredShards,
+	})
+
+	return resolver
+}
+
+func TestNewObserversShardsResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http client should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := process.NewObserversShardsResolver(process.ArgObserversShardsResolver{
+			RequestTimeoutInSec: 2,
+		})
+		require.Nil(t, resolver)
+		require.Equal(t, process.ErrNilHttpClient, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := process.NewObserversShardsResolver(process.ArgObserversShardsResolver{
+			HttpClient: http.DefaultClient,
+		})
+		require.Nil(t, resolver)
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := process.NewObserversShardsResolver(process.ArgObserversShardsResolver{
+			HttpClient:          http.DefaultClient,
+			RequestTimeoutInSec: 2,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resolver)
+	})
+}
+
+func TestObserversShardsResolver_ResolveShards(t *testing.T) {
+	t.Parallel()
+
+	t.Run("omitted shard should be detected", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := createShardReportingServer(1)
+		defer testServer.Close()
+
+		node := &data.NodeData{Address: testServer.URL, AutoDetectShard: true}
+		err := createObserversShardsResolver(false).ResolveShards([]*data.NodeData{node})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), node.ShardId)
+		require.False(t, node.AutoDetectShard)
+	})
+	t.Run("unreachable node without a shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := createShardReportingServer(1)
+		testServer.Close()
+
+		node := &data.NodeData{Address: testServer.URL, AutoDetectShard: true}
+		err := createObserversShardsResolver(false).ResolveShards([]*data.NodeData{node})
+		require.True(t, errors.Is(err, process.ErrObserverShardDetectionFailed))
+	})
+	t.Run("declared shard mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := createShardReportingServer(1)
+		defer testServer.Close()
+
+		node := &data.NodeData{Address: testServer.URL, ShardId: 0}
+		err := createObserversShardsResolver(true).ResolveShards([]*data.NodeData{node})
+		require.True(t, errors.Is(err, process.ErrObserverShardMismatch))
+	})
+	t.Run("declared shard should not be validated if disabled", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := createShardReportingServer(1)
+		defer testServer.Close()
+
+		node := &data.NodeData{Address: testServer.URL, ShardId: 0}
+		err := createObserversShardsResolver(false).ResolveShards([]*data.NodeData{node})
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), node.ShardId)
+	})
+	t.Run("unreachable node with a declared shard should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		testServer := createShardReportingServer(1)
+		testServer.Close()
+
+		node := &data.NodeData{Address: testServer.URL, ShardId: 0}
+		err := createObserversShardsResolver(true).ResolveShards([]*data.NodeData{node})
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), node.ShardId)
+	})
+}
@@ -0,0 +1,94 @@
+package price
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxCachedPrices bounds the number of cached prices, as the tokens are provided by the clients
+const maxCachedPrices = 10000
+
+type cachedPrice struct {
+	price      *data.TokenPrice
+	expiryTime time.Time
+}
+
+type cachedPriceProvider struct {
+	provider      PriceProvider
+	cacheValidity time.Duration
+	mutPrices     sync.RWMutex
+	prices        map[string]*cachedPrice
+	getTime       func() time.Time
+}
+
+// NewCachedPriceProvider creates a price provider which keeps the prices returned by the wrapped provider for the
+// given duration, so the price API is not called on each request
+func NewCachedPriceProvider(provider PriceProvider, cacheValidity time.Duration) (*cachedPriceProvider, error) {
+	if check.IfNil(provider) {
+		return nil, ErrNilPriceProvider
+	}
+	if cacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidity
+	}
+
+	return &cachedPriceProvider{
+		provider:      provider,
+		cacheValidity: cacheValidity,
+		prices:        make(map[string]*cachedPrice),
+		getTime:       time.Now,
+	}, nil
+}
+
+// IsEnabled returns true if the wrapped provider is enabled
+func (cpp *cachedPriceProvider) IsEnabled() bool {
+	return cpp.provider.IsEnabled()
+}
+
+// GetTokenPrice returns the cached price of the token, fetching it from the wrapped provider if it is missing or
+// expired. The errors are not cached
+func (cpp *cachedPriceProvider) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	now := cpp.getTime()
+
+	cpp.mutPrices.RLock()
+	cached, found := cpp.prices[token]
+	cpp.mutPrices.RUnlock()
+	if found && now.Before(cached.expiryTime) {
+		return cached.price, nil
+	}
+
+	price, err := cpp.provider.GetTokenPrice(token)
+	if err != nil {
+		return nil, err
+	}
+
+	cpp.mutPrices.Lock()
+	if len(cpp.prices) >= maxCachedPrices {
+		cpp.removeExpiredPrices(now)
+	}
+	if len(cpp.prices) < maxCachedPrices {
+		cpp.prices[token] = &cachedPrice{
+			price:      price,
+			expiryTime: now.Add(cpp.cacheValidity),
+		}
+	}
+	cpp.mutPrices.Unlock()
+
+	return price, nil
+}
+
+// removeExpiredPrices should be called under the mutex
+func (cpp *cachedPriceProvider) removeExpiredPrices(now time.Time) {
+	for token, cached := range cpp.prices {
+		if !now.Before(cached.expiryTime) {
+			delete(cpp.prices, token)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cpp *cachedPriceProvider) IsInterfaceNil() bool {
+	return cpp == nil
+}
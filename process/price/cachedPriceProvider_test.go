@@ -0,0 +1,95 @@
+package price
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type priceProviderStub struct {
+	numCalls int
+	err      error
+}
+
+func (pps *priceProviderStub) IsEnabled() bool {
+	return true
+}
+
+func (pps *priceProviderStub) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	pps.numCalls++
+	if pps.err != nil {
+		return nil, pps.err
+	}
+
+	return &data.TokenPrice{Token: token, Price: float64(pps.numCalls)}, nil
+}
+
+func (pps *priceProviderStub) IsInterfaceNil() bool {
+	return pps == nil
+}
+
+func TestNewCachedPriceProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewCachedPriceProvider(nil, time.Minute)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrNilPriceProvider, err)
+
+	provider, err = NewCachedPriceProvider(&priceProviderStub{}, 0)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrInvalidCacheValidity, err)
+
+	provider, err = NewCachedPriceProvider(&priceProviderStub{}, time.Minute)
+	require.Nil(t, err)
+	assert.True(t, provider.IsEnabled())
+	assert.False(t, provider.IsInterfaceNil())
+
+	assert.False(t, NewDisabledPriceProvider().IsEnabled())
+}
+
+func TestCachedPriceProvider_GetTokenPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("price should be cached until it expires", func(t *testing.T) {
+		t.Parallel()
+
+		stub := &priceProviderStub{}
+		provider, _ := NewCachedPriceProvider(stub, time.Minute)
+		now := time.Unix(1700000000, 0)
+		provider.getTime = func() time.Time {
+			return now
+		}
+
+		tokenPrice, err := provider.GetTokenPrice("EGLD")
+		require.Nil(t, err)
+		assert.Equal(t, 1.0, tokenPrice.Price)
+
+		now = now.Add(59 * time.Second)
+		tokenPrice, _ = provider.GetTokenPrice("EGLD")
+		assert.Equal(t, 1.0, tokenPrice.Price)
+
+		tokenPrice, _ = provider.GetTokenPrice("TKN-abcdef")
+		assert.Equal(t, 2.0, tokenPrice.Price)
+
+		now = now.Add(time.Second)
+		tokenPrice, _ = provider.GetTokenPrice("EGLD")
+		assert.Equal(t, 3.0, tokenPrice.Price)
+	})
+	t.Run("errors should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("price API down")
+		stub := &priceProviderStub{err: expectedErr}
+		provider, _ := NewCachedPriceProvider(stub, time.Minute)
+
+		_, err := provider.GetTokenPrice("EGLD")
+		assert.Equal(t, expectedErr, err)
+		_, err = provider.GetTokenPrice("EGLD")
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 2, stub.numCalls)
+	})
+}
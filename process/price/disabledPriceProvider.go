@@ -0,0 +1,25 @@
+package price
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+type disabledPriceProvider struct{}
+
+// NewDisabledPriceProvider creates a price provider to be used when no price API is configured
+func NewDisabledPriceProvider() *disabledPriceProvider {
+	return &disabledPriceProvider{}
+}
+
+// IsEnabled returns false
+func (dpp *disabledPriceProvider) IsEnabled() bool {
+	return false
+}
+
+// GetTokenPrice returns the disabled provider error
+func (dpp *disabledPriceProvider) GetTokenPrice(_ string) (*data.TokenPrice, error) {
+	return nil, ErrDisabledPriceProvider
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *disabledPriceProvider) IsInterfaceNil() bool {
+	return dpp == nil
+}
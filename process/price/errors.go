@@ -0,0 +1,26 @@
+package price
+
+import "errors"
+
+var errCannotGetPrice = errors.New("cannot get the token price")
+
+// ErrEmptyURL signals that an empty price API URL has been provided
+var ErrEmptyURL = errors.New("empty price API URL")
+
+// ErrMissingTokenPlaceholder signals that the price API URL does not contain the token placeholder
+var ErrMissingTokenPlaceholder = errors.New("the price API URL does not contain the " + TokenPlaceholder + " placeholder")
+
+// ErrEmptyPriceField signals that an empty price field has been provided
+var ErrEmptyPriceField = errors.New("empty price field")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidCacheValidity signals that an invalid cache validity has been provided
+var ErrInvalidCacheValidity = errors.New("invalid cache validity")
+
+// ErrNilPriceProvider signals that a nil price provider has been provided
+var ErrNilPriceProvider = errors.New("nil price provider")
+
+// ErrDisabledPriceProvider signals that no token price provider is configured
+var ErrDisabledPriceProvider = errors.New("token price provider is disabled")
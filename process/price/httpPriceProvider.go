@@ -0,0 +1,139 @@
+package price
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TokenPlaceholder is the placeholder replaced with the token identifier in the price API URL
+const TokenPlaceholder = "{token}"
+
+const priceFieldSeparator = "."
+
+// ArgsHTTPPriceProvider holds the arguments needed to create a price provider backed by an external price API
+type ArgsHTTPPriceProvider struct {
+	Name           string
+	URL            string
+	PriceField     string
+	RequestTimeout time.Duration
+}
+
+type httpPriceProvider struct {
+	name        string
+	url         string
+	priceFields []string
+	httpClient  *http.Client
+	getTime     func() time.Time
+}
+
+// NewHTTPPriceProvider creates a price provider which reads the token prices from an external API. The URL holds the
+// {token} placeholder, such as https://api.example.com/tokens/{token}, and the price is read from the JSON field
+// given by its dotted path, such as data.price
+func NewHTTPPriceProvider(args ArgsHTTPPriceProvider) (*httpPriceProvider, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if !strings.Contains(args.URL, TokenPlaceholder) {
+		return nil, ErrMissingTokenPlaceholder
+	}
+	if len(args.PriceField) == 0 {
+		return nil, ErrEmptyPriceField
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &httpPriceProvider{
+		name:        args.Name,
+		url:         args.URL,
+		priceFields: strings.Split(args.PriceField, priceFieldSeparator),
+		httpClient:  &http.Client{Timeout: args.RequestTimeout},
+		getTime:     time.Now,
+	}, nil
+}
+
+// IsEnabled returns true
+func (hpp *httpPriceProvider) IsEnabled() bool {
+	return true
+}
+
+// GetTokenPrice fetches the USD price of the token from the price API
+func (hpp *httpPriceProvider) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	requestURL := strings.ReplaceAll(hpp.url, TokenPlaceholder, url.PathEscape(token))
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := hpp.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w for %s: status %d %s", errCannotGetPrice, token, response.StatusCode, string(responseBytes))
+	}
+
+	var decodedResponse interface{}
+	err = json.Unmarshal(responseBytes, &decodedResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := hpp.extractPrice(decodedResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s: %s", errCannotGetPrice, token, err.Error())
+	}
+
+	return &data.TokenPrice{
+		Token:     token,
+		Price:     price,
+		Source:    hpp.name,
+		Timestamp: hpp.getTime().Unix(),
+	}, nil
+}
+
+func (hpp *httpPriceProvider) extractPrice(decodedResponse interface{}) (float64, error) {
+	value := decodedResponse
+	for _, field := range hpp.priceFields {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return 0, fmt.Errorf("field %s not found", field)
+		}
+
+		value, ok = object[field]
+		if !ok {
+			return 0, fmt.Errorf("field %s not found", field)
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, nil
+	case string:
+		return strconv.ParseFloat(typedValue, 64)
+	default:
+		return 0, fmt.Errorf("unexpected price value %v", value)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hpp *httpPriceProvider) IsInterfaceNil() bool {
+	return hpp == nil
+}
@@ -0,0 +1,102 @@
+package price
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestArgsHTTPPriceProvider(url string) ArgsHTTPPriceProvider {
+	return ArgsHTTPPriceProvider{
+		Name:           "price-api",
+		URL:            url + "/tokens/{token}",
+		PriceField:     "data.price",
+		RequestTimeout: time.Second,
+	}
+}
+
+func TestNewHTTPPriceProvider(t *testing.T) {
+	t.Parallel()
+
+	args := createTestArgsHTTPPriceProvider("http://127.0.0.1")
+	args.URL = ""
+	provider, err := NewHTTPPriceProvider(args)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrEmptyURL, err)
+
+	args = createTestArgsHTTPPriceProvider("http://127.0.0.1")
+	args.URL = "http://127.0.0.1/tokens"
+	provider, err = NewHTTPPriceProvider(args)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrMissingTokenPlaceholder, err)
+
+	args = createTestArgsHTTPPriceProvider("http://127.0.0.1")
+	args.PriceField = ""
+	provider, err = NewHTTPPriceProvider(args)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrEmptyPriceField, err)
+
+	args = createTestArgsHTTPPriceProvider("http://127.0.0.1")
+	args.RequestTimeout = 0
+	provider, err = NewHTTPPriceProvider(args)
+	assert.Nil(t, provider)
+	assert.Equal(t, ErrInvalidRequestTimeout, err)
+
+	provider, err = NewHTTPPriceProvider(createTestArgsHTTPPriceProvider("http://127.0.0.1"))
+	require.Nil(t, err)
+	assert.True(t, provider.IsEnabled())
+	assert.False(t, provider.IsInterfaceNil())
+}
+
+func TestHTTPPriceProvider_GetTokenPrice(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tokens/EGLD":
+			_, _ = w.Write([]byte(`{"data":{"price":31.5}}`))
+		case "/tokens/TKN-abcdef":
+			_, _ = w.Write([]byte(`{"data":{"price":"0.25"}}`))
+		case "/tokens/NOPRICE-abcdef":
+			_, _ = w.Write([]byte(`{"data":{"name":"NoPrice"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"token not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, _ := NewHTTPPriceProvider(createTestArgsHTTPPriceProvider(server.URL))
+	provider.getTime = func() time.Time {
+		return time.Unix(1700000000, 0)
+	}
+
+	t.Run("numeric price should work", func(t *testing.T) {
+		tokenPrice, err := provider.GetTokenPrice("EGLD")
+		require.Nil(t, err)
+		assert.Equal(t, &data.TokenPrice{Token: "EGLD", Price: 31.5, Source: "price-api", Timestamp: 1700000000}, tokenPrice)
+	})
+	t.Run("string price should work", func(t *testing.T) {
+		tokenPrice, err := provider.GetTokenPrice("TKN-abcdef")
+		require.Nil(t, err)
+		assert.Equal(t, 0.25, tokenPrice.Price)
+	})
+	t.Run("missing price field should error", func(t *testing.T) {
+		tokenPrice, err := provider.GetTokenPrice("NOPRICE-abcdef")
+		assert.Nil(t, tokenPrice)
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "field price not found"))
+	})
+	t.Run("error status should error", func(t *testing.T) {
+		tokenPrice, err := provider.GetTokenPrice("MISSING-abcdef")
+		assert.Nil(t, tokenPrice)
+		require.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "token not found"))
+	})
+}
@@ -0,0 +1,10 @@
+package price
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// PriceProvider defines what a source of token prices should do
+type PriceProvider interface {
+	IsEnabled() bool
+	GetTokenPrice(token string) (*data.TokenPrice, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,197 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	probeCheckNodesStateChecks = "nodesStateChecks"
+	probeCheckConfig           = "config"
+	probeCheckObservers        = "observers"
+	probeCheckCaches           = "caches"
+)
+
+// ProbesProcessor computes the liveness and the readiness of the proxy, as reported to the orchestrators such as the
+// Kubernetes probes, from the periodic sync state checks of the observers and the state of the caches
+type ProbesProcessor struct {
+	proc                    Processor
+	nodesStateChecker       NodesStateChecker
+	heartbeatCacher         HeartbeatCacheHandler
+	valStatsCacher          ValidatorStatisticsCacheHandler
+	economicMetricsCacher   GenericApiResponseCacheHandler
+	maxNodesStateCheckDelay time.Duration
+	startTime               time.Time
+}
+
+// NewProbesProcessor creates a new instance of ProbesProcessor. The proxy is reported as not alive if the nodes sync
+// state checks have not completed for longer than maxNodesStateCheckDelay
+func NewProbesProcessor(
+	proc Processor,
+	nodesStateChecker NodesStateChecker,
+	heartbeatCacher HeartbeatCacheHandler,
+	valStatsCacher ValidatorStatisticsCacheHandler,
+	economicMetricsCacher GenericApiResponseCacheHandler,
+	maxNodesStateCheckDelay time.Duration,
+) (*ProbesProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(nodesStateChecker) {
+		return nil, ErrNilNodesStateChecker
+	}
+	if check.IfNil(heartbeatCacher) {
+		return nil, ErrNilHeartbeatCacher
+	}
+	if check.IfNil(valStatsCacher) {
+		return nil, ErrNilValidatorStatisticsCacher
+	}
+	if check.IfNil(economicMetricsCacher) {
+		return nil, ErrNilEconomicMetricsCacher
+	}
+	if maxNodesStateCheckDelay <= 0 {
+		return nil, ErrInvalidMaxNodesStateCheckDelay
+	}
+
+	return &ProbesProcessor{
+		proc:                    proc,
+		nodesStateChecker:       nodesStateChecker,
+		heartbeatCacher:         heartbeatCacher,
+		valStatsCacher:          valStatsCacher,
+		economicMetricsCacher:   economicMetricsCacher,
+		maxNodesStateCheckDelay: maxNodesStateCheckDelay,
+		startTime:               time.Now(),
+	}, nil
+}
+
+// GetLiveness returns the liveness of the proxy. The proxy is alive as long as the nodes sync state checks keep
+// running, so that it should only be restarted if they are stuck
+func (pp *ProbesProcessor) GetLiveness() *data.ProbeStatus {
+	return newProbeStatus(pp.checkNodesStateChecks())
+}
+
+// GetReadiness returns the readiness of the proxy to serve requests: the configuration is loaded, the nodes sync state
+// was checked and each shard has at least one synced observer, and the periodically refreshed caches are populated
+func (pp *ProbesProcessor) GetReadiness() *data.ProbeStatus {
+	return newProbeStatus(
+		pp.checkConfig(),
+		pp.checkObservers(),
+		pp.checkCaches(),
+	)
+}
+
+func (pp *ProbesProcessor) checkNodesStateChecks() *data.ProbeCheck {
+	lastCheck := pp.nodesStateChecker.GetLastNodesStateCheckTime()
+	if lastCheck.IsZero() {
+		// the first check might still be in progress, so the delay is measured from the start of the proxy
+		lastCheck = pp.startTime
+	}
+
+	delay := time.Since(lastCheck)
+	if delay > pp.maxNodesStateCheckDelay {
+		return &data.ProbeCheck{
+			Name:    probeCheckNodesStateChecks,
+			Healthy: false,
+			Details: fmt.Sprintf("no nodes state check completed for %s", delay.Truncate(time.Second)),
+		}
+	}
+
+	return &data.ProbeCheck{
+		Name:    probeCheckNodesStateChecks,
+		Healthy: true,
+	}
+}
+
+func (pp *ProbesProcessor) checkConfig() *data.ProbeCheck {
+	return &data.ProbeCheck{
+		Name:    probeCheckConfig,
+		Healthy: true,
+		Details: fmt.Sprintf("%d shards configured", len(pp.proc.GetShardIDs())),
+	}
+}
+
+func (pp *ProbesProcessor) checkObservers() *data.ProbeCheck {
+	if pp.nodesStateChecker.GetLastNodesStateCheckTime().IsZero() {
+		return &data.ProbeCheck{
+			Name:    probeCheckObservers,
+			Healthy: false,
+			Details: "the nodes state was not checked yet",
+		}
+	}
+
+	syncedObservers := make(map[uint32]int)
+	for _, node := range pp.proc.GetObserverProvider().GetAllNodesWithSyncState() {
+		if node.IsSynced {
+			syncedObservers[node.ShardId]++
+		}
+	}
+
+	shardsWithoutObservers := make([]string, 0)
+	for _, shardID := range pp.proc.GetShardIDs() {
+		if syncedObservers[shardID] == 0 {
+			shardsWithoutObservers = append(shardsWithoutObservers, fmt.Sprintf("%d", shardID))
+		}
+	}
+	if len(shardsWithoutObservers) > 0 {
+		return &data.ProbeCheck{
+			Name:    probeCheckObservers,
+			Healthy: false,
+			Details: "no synced observer in shards " + strings.Join(shardsWithoutObservers, ", "),
+		}
+	}
+
+	return &data.ProbeCheck{
+		Name:    probeCheckObservers,
+		Healthy: true,
+	}
+}
+
+func (pp *ProbesProcessor) checkCaches() *data.ProbeCheck {
+	coldCaches := make([]string, 0)
+	_, err := pp.heartbeatCacher.LoadHeartbeats()
+	if err != nil {
+		coldCaches = append(coldCaches, "heartbeats")
+	}
+	_, err = pp.valStatsCacher.LoadValStats()
+	if err != nil {
+		coldCaches = append(coldCaches, "validator statistics")
+	}
+	_, err = pp.economicMetricsCacher.Load()
+	if err != nil {
+		coldCaches = append(coldCaches, "economics metrics")
+	}
+
+	if len(coldCaches) > 0 {
+		return &data.ProbeCheck{
+			Name:    probeCheckCaches,
+			Healthy: false,
+			Details: "caches not populated yet: " + strings.Join(coldCaches, ", "),
+		}
+	}
+
+	return &data.ProbeCheck{
+		Name:    probeCheckCaches,
+		Healthy: true,
+	}
+}
+
+func newProbeStatus(checks ...*data.ProbeCheck) *data.ProbeStatus {
+	status := &data.ProbeStatus{
+		Healthy: true,
+		Checks:  checks,
+	}
+	for _, probeCheck := range checks {
+		status.Healthy = status.Healthy && probeCheck.Healthy
+	}
+
+	return status
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *ProbesProcessor) IsInterfaceNil() bool {
+	return pp == nil
+}
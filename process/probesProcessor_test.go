@@ -0,0 +1,209 @@
+package process_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type probesProcessorArgs struct {
+	proc                    process.Processor
+	nodesStateChecker       process.NodesStateChecker
+	heartbeatCacher         process.HeartbeatCacheHandler
+	valStatsCacher          process.ValidatorStatisticsCacheHandler
+	economicMetricsCacher   process.GenericApiResponseCacheHandler
+	maxNodesStateCheckDelay time.Duration
+}
+
+func createWarmProbesProcessorArgs(nodes []*data.NodeData) probesProcessorArgs {
+	return probesProcessorArgs{
+		proc: &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, core.MetachainShardId}
+			},
+			GetObserverProviderCalled: func() observer.NodesProviderHandler {
+				return &mock.ObserversProviderStub{
+					GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+						return nodes
+					},
+				}
+			},
+		},
+		nodesStateChecker: &mock.NodesStateCheckerStub{
+			GetLastNodesStateCheckTimeCalled: func() time.Time {
+				return time.Now()
+			},
+		},
+		heartbeatCacher:         &mock.HeartbeatCacherMock{Data: &data.HeartbeatResponse{}},
+		valStatsCacher:          &mock.ValStatsCacherMock{Data: map[string]*data.ValidatorApiResponse{}},
+		economicMetricsCacher:   &mock.GenericApiResponseCacherMock{Data: &data.GenericAPIResponse{}},
+		maxNodesStateCheckDelay: time.Minute,
+	}
+}
+
+func newProbesProcessor(args probesProcessorArgs) (*process.ProbesProcessor, error) {
+	return process.NewProbesProcessor(
+		args.proc,
+		args.nodesStateChecker,
+		args.heartbeatCacher,
+		args.valStatsCacher,
+		args.economicMetricsCacher,
+		args.maxNodesStateCheckDelay,
+	)
+}
+
+func getProbeCheck(status *data.ProbeStatus, name string) *data.ProbeCheck {
+	for _, probeCheck := range status.Checks {
+		if probeCheck.Name == name {
+			return probeCheck
+		}
+	}
+
+	return nil
+}
+
+func TestNewProbesProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createWarmProbesProcessorArgs(nil)
+	args.proc = nil
+	pp, err := newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilCoreProcessor, err)
+
+	args = createWarmProbesProcessorArgs(nil)
+	args.nodesStateChecker = nil
+	pp, err = newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilNodesStateChecker, err)
+
+	args = createWarmProbesProcessorArgs(nil)
+	args.heartbeatCacher = nil
+	pp, err = newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilHeartbeatCacher, err)
+
+	args = createWarmProbesProcessorArgs(nil)
+	args.valStatsCacher = nil
+	pp, err = newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilValidatorStatisticsCacher, err)
+
+	args = createWarmProbesProcessorArgs(nil)
+	args.economicMetricsCacher = nil
+	pp, err = newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrNilEconomicMetricsCacher, err)
+
+	args = createWarmProbesProcessorArgs(nil)
+	args.maxNodesStateCheckDelay = 0
+	pp, err = newProbesProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, process.ErrInvalidMaxNodesStateCheckDelay, err)
+
+	pp, err = newProbesProcessor(createWarmProbesProcessorArgs(nil))
+	require.Nil(t, err)
+	assert.False(t, pp.IsInterfaceNil())
+}
+
+func TestProbesProcessor_GetLiveness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("recent nodes state check should be alive", func(t *testing.T) {
+		t.Parallel()
+
+		pp, _ := newProbesProcessor(createWarmProbesProcessorArgs(nil))
+		status := pp.GetLiveness()
+		assert.True(t, status.Healthy)
+	})
+	t.Run("pending first nodes state check should be alive", func(t *testing.T) {
+		t.Parallel()
+
+		args := createWarmProbesProcessorArgs(nil)
+		args.nodesStateChecker = &mock.NodesStateCheckerStub{}
+		pp, _ := newProbesProcessor(args)
+		status := pp.GetLiveness()
+		assert.True(t, status.Healthy)
+	})
+	t.Run("stuck nodes state checks should not be alive", func(t *testing.T) {
+		t.Parallel()
+
+		args := createWarmProbesProcessorArgs(nil)
+		args.nodesStateChecker = &mock.NodesStateCheckerStub{
+			GetLastNodesStateCheckTimeCalled: func() time.Time {
+				return time.Now().Add(-time.Hour)
+			},
+		}
+		pp, _ := newProbesProcessor(args)
+		status := pp.GetLiveness()
+		assert.False(t, status.Healthy)
+		assert.False(t, getProbeCheck(status, "nodesStateChecks").Healthy)
+	})
+}
+
+func TestProbesProcessor_GetReadiness(t *testing.T) {
+	t.Parallel()
+
+	syncedNodes := []*data.NodeData{
+		{ShardId: 0, Address: "addr0", IsSynced: true},
+		{ShardId: 0, Address: "addr1", IsSynced: false},
+		{ShardId: core.MetachainShardId, Address: "addr2", IsSynced: true},
+	}
+
+	t.Run("synced observers in all shards and warm caches should be ready", func(t *testing.T) {
+		t.Parallel()
+
+		pp, _ := newProbesProcessor(createWarmProbesProcessorArgs(syncedNodes))
+		status := pp.GetReadiness()
+		assert.True(t, status.Healthy)
+		assert.Len(t, status.Checks, 3)
+		assert.Equal(t, "2 shards configured", getProbeCheck(status, "config").Details)
+	})
+	t.Run("shard without synced observers should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []*data.NodeData{
+			{ShardId: 0, Address: "addr0", IsSynced: true},
+			{ShardId: core.MetachainShardId, Address: "addr2", IsSynced: false},
+		}
+		pp, _ := newProbesProcessor(createWarmProbesProcessorArgs(nodes))
+		status := pp.GetReadiness()
+		assert.False(t, status.Healthy)
+
+		observersCheck := getProbeCheck(status, "observers")
+		assert.False(t, observersCheck.Healthy)
+		assert.True(t, strings.Contains(observersCheck.Details, "4294967295"))
+	})
+	t.Run("unchecked nodes state should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createWarmProbesProcessorArgs(syncedNodes)
+		args.nodesStateChecker = &mock.NodesStateCheckerStub{}
+		pp, _ := newProbesProcessor(args)
+		status := pp.GetReadiness()
+		assert.False(t, status.Healthy)
+		assert.False(t, getProbeCheck(status, "observers").Healthy)
+	})
+	t.Run("cold caches should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		args := createWarmProbesProcessorArgs(syncedNodes)
+		args.valStatsCacher = &mock.ValStatsCacherMock{}
+		args.economicMetricsCacher = &mock.GenericApiResponseCacherMock{}
+		pp, _ := newProbesProcessor(args)
+		status := pp.GetReadiness()
+		assert.False(t, status.Healthy)
+
+		cachesCheck := getProbeCheck(status, "caches")
+		assert.False(t, cachesCheck.Healthy)
+		assert.Equal(t, "caches not populated yet: validator statistics, economics metrics", cachesCheck.Details)
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,7 +31,7 @@ func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*P
 }
 
 // GetProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -40,14 +41,14 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 	getProofEndpoint := "/proof/root-hash/" + rootHash + "/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
-			log.Error("GetProof request",
+			log.WithContext(ctx).Error("GetProof request",
 				"observer", observer.Address,
 				"address", address,
 				"error", err.Error(),
@@ -57,7 +58,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 		}
 
 		if respCode == http.StatusOK {
-			log.Info("GetProof request",
+			log.WithContext(ctx).Info("GetProof request",
 				"address", address,
 				"rootHash", rootHash,
 				"shard ID", observer.ShardId,
@@ -73,7 +74,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 }
 
 // GetProofDataTrie sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -83,14 +84,14 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 	getProofDataTrieEndpoint := fmt.Sprintf("/proof/root-hash/%s/address/%s/key/%s", rootHash, address, key)
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofDataTrieEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofDataTrieEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
-			log.Error("GetProofDataTrie request",
+			log.WithContext(ctx).Error("GetProofDataTrie request",
 				"observer", observer.Address,
 				"address", address,
 				"error", err.Error(),
@@ -100,7 +101,7 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 		}
 
 		if respCode == http.StatusOK {
-			log.Info("GetProofDataTrie request",
+			log.WithContext(ctx).Info("GetProofDataTrie request",
 				"address", address,
 				"rootHash", rootHash,
 				"shard ID", observer.ShardId,
@@ -116,7 +117,7 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 }
 
 // GetProofCurrentRootHash sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -126,14 +127,14 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 	getProofEndpoint := "/proof/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
-			log.Error("GetProofCurrentRootHash request",
+			log.WithContext(ctx).Error("GetProofCurrentRootHash request",
 				"observer", observer.Address,
 				"address", address,
 				"error", err.Error(),
@@ -143,7 +144,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 		}
 
 		if respCode == http.StatusOK {
-			log.Info("GetProof request",
+			log.WithContext(ctx).Info("GetProof request",
 				"address", address,
 				"shard ID", observer.ShardId,
 				"observer", observer.Address,
@@ -158,7 +159,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 }
 
 // VerifyProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -173,14 +174,14 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 	responseVerifyProof := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallPostRestEndPoint(observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
+		respCode, err := pp.proc.CallPostRestEndPoint(ctx, observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
 
 		if responseVerifyProof.Error != "" {
 			return nil, errors.New(responseVerifyProof.Error)
 		}
 
 		if err != nil {
-			log.Error("VerifyProof request",
+			log.WithContext(ctx).Error("VerifyProof request",
 				"observer", observer.Address,
 				"address", address,
 				"error", err.Error(),
@@ -190,7 +191,7 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 		}
 
 		if respCode == http.StatusOK {
-			log.Info("VerifyProof request",
+			log.WithContext(ctx).Info("VerifyProof request",
 				"address", address,
 				"rootHash", rootHash,
 				"proof", proof,
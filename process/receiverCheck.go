@@ -0,0 +1,162 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	codeMetadataLength      = 2
+	codeMetadataPayable     = 2
+	codeMetadataPayableBySC = 4
+
+	reasonNoValueTransferred      = "no EGLD or tokens are transferred"
+	reasonReceiverNotContract     = "the receiver is not a smart contract"
+	reasonContractNotDeployed     = "the receiver is a smart contract address without deployed code"
+	reasonEndpointPayability      = "the transfer calls the function %s, whose payability is declared by the contract code and cannot be checked from the code metadata"
+	reasonContractPayable         = "the contract is payable"
+	reasonContractPayableBySC     = "the contract is payable by smart contracts and the sender is a smart contract"
+	reasonContractNotPayable      = "the contract is not payable, so the direct transfers to it are rejected"
+	reasonContractNotPayableBySCs = "the contract is only payable by smart contracts, while the sender is a user account"
+)
+
+// CheckTransferReceiver checks whether the EGLD or the tokens transferred by the transaction would be rejected by
+// the receiver, because it is a contract which is not payable. The actual receiver of the NFT transfers is decoded
+// from the data field, and the payable flags are read from the code metadata of its account
+func (tp *TransactionProcessor) CheckTransferReceiver(ctx context.Context, tx *data.Transaction) (*data.ReceiverCheck, error) {
+	isSenderContract, err := tp.isContractAddress(tx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender: %s", ErrInvalidAddress, err.Error())
+	}
+
+	operation := tp.DecodeTransactionOperation(&transaction.ApiTransactionResult{
+		Sender:   tx.Sender,
+		Receiver: tx.Receiver,
+		Value:    tx.Value,
+		Data:     tx.Data,
+	})
+	check := &data.ReceiverCheck{
+		Receiver:      tx.Receiver,
+		TransfersEGLD: isPositiveValue(tx.Value),
+	}
+	setTransferredTokensAndCalledFunction(check, operation, tx.Data)
+
+	isReceiverContract, err := tp.isContractAddress(check.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver: %s", ErrInvalidAddress, err.Error())
+	}
+	check.IsContract = isReceiverContract
+	if !check.IsContract {
+		check.Reason = reasonReceiverNotContract
+		return check, nil
+	}
+
+	account, err := tp.getAccount(ctx, check.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	applyCodeMetadata(check, account)
+	evaluateReceiverCheck(check, isSenderContract)
+
+	return check, nil
+}
+
+func setTransferredTokensAndCalledFunction(check *data.ReceiverCheck, operation *data.TransactionOperation, txData []byte) {
+	if operation == nil {
+		if len(txData) > 0 {
+			check.CalledFunction = strings.Split(string(txData), argsSeparator)[0]
+		}
+		return
+	}
+
+	switch operation.Function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		check.TransfersTokens = len(operation.Tokens) > 0
+		check.CalledFunction = operation.CalledFunction
+		if len(operation.Receiver) > 0 {
+			check.Receiver = operation.Receiver
+		}
+	default:
+		check.CalledFunction = operation.Function
+	}
+}
+
+func applyCodeMetadata(check *data.ReceiverCheck, account *data.Account) {
+	check.IsDeployed = len(account.CodeHash) > 0 || len(account.CodeMetadata) > 0
+	if len(account.CodeMetadata) < codeMetadataLength {
+		return
+	}
+
+	check.IsPayable = account.CodeMetadata[1]&codeMetadataPayable != 0
+	check.IsPayableBySmartContract = account.CodeMetadata[1]&codeMetadataPayableBySC != 0
+}
+
+func evaluateReceiverCheck(check *data.ReceiverCheck, isSenderContract bool) {
+	switch {
+	case !check.TransfersEGLD && !check.TransfersTokens:
+		check.Reason = reasonNoValueTransferred
+	case !check.IsDeployed:
+		check.WillBeRejected = true
+		check.Reason = reasonContractNotDeployed
+	case len(check.CalledFunction) > 0:
+		check.Reason = fmt.Sprintf(reasonEndpointPayability, check.CalledFunction)
+	case check.IsPayable:
+		check.Reason = reasonContractPayable
+	case check.IsPayableBySmartContract && isSenderContract:
+		check.Reason = reasonContractPayableBySC
+	case check.IsPayableBySmartContract:
+		check.WillBeRejected = true
+		check.Reason = reasonContractNotPayableBySCs
+	default:
+		check.WillBeRejected = true
+		check.Reason = reasonContractNotPayable
+	}
+}
+
+func (tp *TransactionProcessor) isContractAddress(address string) (bool, error) {
+	if len(address) == 0 {
+		return false, nil
+	}
+
+	addressBytes, err := tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return false, err
+	}
+
+	return core.IsSmartContractAddress(addressBytes), nil
+}
+
+func (tp *TransactionProcessor) getAccount(ctx context.Context, address string) (*data.Account, error) {
+	shardID, err := tp.getShardByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.AccountApiResponse{}
+	for _, observer := range observers {
+		_, err = tp.proc.CallGetRestEndPoint(ctx, observer.Address, addressPath+address, &response)
+		if err == nil {
+			return &response.Data.Account, nil
+		}
+
+		log.WithContext(ctx).Error("receiver account request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+func isPositiveValue(value string) bool {
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	return ok && bigValue.Sign() > 0
+}
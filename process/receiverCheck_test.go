@@ -0,0 +1,176 @@
+package process_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createContractAddress(lastByte byte) string {
+	addressBytes := append(make([]byte, 8), bytes.Repeat([]byte{lastByte}, 24)...)
+	return testPubkeyConverter.SilentEncode(addressBytes, nil)
+}
+
+func createReceiverCheckProcessor(t *testing.T, accounts map[string]*data.Account) *process.TransactionProcessor {
+	processor := &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			for accountAddress, account := range accounts {
+				if path != "/address/"+accountAddress {
+					continue
+				}
+
+				value.(*data.AccountApiResponse).Data.Account = *account
+				return 0, nil
+			}
+
+			return 0, errors.New("account not found")
+		},
+	}
+	tp, err := process.NewTransactionProcessor(processor, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.NoError(t, err)
+
+	return tp
+}
+
+func TestTransactionProcessor_CheckTransferReceiver(t *testing.T) {
+	t.Parallel()
+
+	payableContract := createContractAddress(1)
+	payableBySCContract := createContractAddress(2)
+	notPayableContract := createContractAddress(3)
+	notDeployedContract := createContractAddress(4)
+	accounts := map[string]*data.Account{
+		payableContract:     {Address: payableContract, CodeHash: []byte("hash"), CodeMetadata: []byte{5, 2}},
+		payableBySCContract: {Address: payableBySCContract, CodeHash: []byte("hash"), CodeMetadata: []byte{5, 4}},
+		notPayableContract:  {Address: notPayableContract, CodeHash: []byte("hash"), CodeMetadata: []byte{5, 0}},
+		notDeployedContract: {Address: notDeployedContract},
+	}
+	tp := createReceiverCheckProcessor(t, accounts)
+
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: "invalid", Value: "1"})
+		require.Nil(t, check)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: "invalid", Receiver: payableContract, Value: "1"})
+		require.Nil(t, check)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("cannot get the contract account should error", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: createContractAddress(5), Value: "1"})
+		require.Nil(t, check)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("user account receiver should accept the transfer", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: validationReceiver, Value: "1"})
+		require.Nil(t, err)
+		assert.False(t, check.IsContract)
+		assert.True(t, check.TransfersEGLD)
+		assert.False(t, check.WillBeRejected)
+	})
+	t.Run("EGLD to a payable contract should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: payableContract, Value: "1"})
+		require.Nil(t, err)
+		assert.Equal(t, &data.ReceiverCheck{
+			Receiver:      payableContract,
+			IsContract:    true,
+			IsDeployed:    true,
+			IsPayable:     true,
+			TransfersEGLD: true,
+			Reason:        "the contract is payable",
+		}, check)
+	})
+	t.Run("EGLD to a not payable contract should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: notPayableContract, Value: "1"})
+		require.Nil(t, err)
+		assert.True(t, check.WillBeRejected)
+		assert.Equal(t, "the contract is not payable, so the direct transfers to it are rejected", check.Reason)
+	})
+	t.Run("EGLD from a user to a contract payable only by smart contracts should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: payableBySCContract, Value: "1"})
+		require.Nil(t, err)
+		assert.True(t, check.IsPayableBySmartContract)
+		assert.True(t, check.WillBeRejected)
+	})
+	t.Run("EGLD from a contract to a contract payable by smart contracts should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: payableContract, Receiver: payableBySCContract, Value: "1"})
+		require.Nil(t, err)
+		assert.False(t, check.WillBeRejected)
+	})
+	t.Run("transfer to a not deployed contract should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: notDeployedContract, Value: "1"})
+		require.Nil(t, err)
+		assert.False(t, check.IsDeployed)
+		assert.True(t, check.WillBeRejected)
+	})
+	t.Run("no value transferred should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: notPayableContract, Value: "0"})
+		require.Nil(t, err)
+		assert.False(t, check.WillBeRejected)
+		assert.Equal(t, "no EGLD or tokens are transferred", check.Reason)
+	})
+	t.Run("EGLD calling a contract function cannot be checked", func(t *testing.T) {
+		t.Parallel()
+
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: notPayableContract, Value: "1", Data: []byte("stake@01")})
+		require.Nil(t, err)
+		assert.Equal(t, "stake", check.CalledFunction)
+		assert.False(t, check.WillBeRejected)
+	})
+	t.Run("ESDT transfer without function call to a not payable contract should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := "ESDTTransfer@" + hexArgument("WEGLD-bd4d79") + "@0a"
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: notPayableContract, Value: "0", Data: []byte(dataField)})
+		require.Nil(t, err)
+		assert.True(t, check.TransfersTokens)
+		assert.False(t, check.TransfersEGLD)
+		assert.Empty(t, check.CalledFunction)
+		assert.True(t, check.WillBeRejected)
+	})
+	t.Run("NFT transfer should check the receiver from the data field", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := "ESDTNFTTransfer@" + hexArgument("NFT-123456") + "@01@01@" + hexAddress(payableContract)
+		check, err := tp.CheckTransferReceiver(context.Background(), &data.Transaction{Sender: validationSender, Receiver: validationSender, Value: "0", Data: []byte(dataField)})
+		require.Nil(t, err)
+		assert.Equal(t, payableContract, check.Receiver)
+		assert.True(t, check.TransfersTokens)
+		assert.False(t, check.WillBeRejected)
+	})
+}
@@ -0,0 +1,58 @@
+package process
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	acceptEncodingHeader  = "Accept-Encoding"
+	contentEncodingHeader = "Content-Encoding"
+	gzipEncoding          = "gzip"
+)
+
+// newObserversHttpClient creates the client used for the requests sent to the observers. The transparent compression
+// of the transport is disabled, as the gzip negotiation is handled explicitly so it can be switched off from config
+func newObserversHttpClient(requestTimeoutSec int, staticHeaders []config.StaticHeaderConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableCompression = true
+
+	headersTransport, err := NewObserverHeadersTransport(transport, staticHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Timeout:   time.Duration(requestTimeoutSec) * time.Second,
+		Transport: headersTransport,
+	}, nil
+}
+
+// setAcceptEncodingHeader advertises that gzip compressed bodies are accepted, if the response compression is enabled
+func (bp *BaseProcessor) setAcceptEncodingHeader(req *http.Request) {
+	if bp.responseCompression {
+		req.Header.Set(acceptEncodingHeader, gzipEncoding)
+	}
+}
+
+// readResponseBody reads the whole body of an observer response, decompressing it if the observer sent it gzip encoded
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get(contentEncodingHeader), gzipEncoding) {
+		return io.ReadAll(resp.Body)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(reader.Close())
+	}()
+
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,146 @@
+package process
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	argsSeparator              = "@"
+	returnCodeUserError        = "user error"
+	returnCodeExecutionFailed  = "execution failed"
+	signalErrorMessageTopicIdx = 1
+)
+
+// parseTransactionOutcome extracts the return code, the return message and the return data of a smart contract call
+// from the provided transaction, looking into its smart contract results first and into its logs afterwards
+func parseTransactionOutcome(tx *transaction.ApiTransactionResult) (*data.TransactionOutcome, error) {
+	outcome, found := parseOutcomeFromSCRs(tx)
+	if found {
+		return outcome, nil
+	}
+
+	allLogs := []*transaction.ApiLogs{tx.Logs}
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		allLogs = append(allLogs, scr.Logs)
+	}
+
+	outcome, found = parseOutcomeFromLogs(allLogs)
+	if found {
+		return outcome, nil
+	}
+
+	return nil, data.ErrTransactionOutcomeNotFound
+}
+
+func parseOutcomeFromSCRs(tx *transaction.ApiTransactionResult) (*data.TransactionOutcome, bool) {
+	for _, scr := range tx.SmartContractResults {
+		if scr == nil || scr.RcvAddr != tx.Sender {
+			continue
+		}
+
+		returnCode, returnData, ok := parseCallResultData(scr.Data)
+		if !ok {
+			continue
+		}
+
+		return &data.TransactionOutcome{
+			ReturnCode:    returnCode,
+			ReturnMessage: scr.ReturnMessage,
+			ReturnData:    returnData,
+		}, true
+	}
+
+	return nil, false
+}
+
+func parseOutcomeFromLogs(logs []*transaction.ApiLogs) (*data.TransactionOutcome, bool) {
+	event, found := findIdentifierInLogs(logs, core.SignalErrorOperation)
+	if found {
+		return parseSignalErrorEvent(event), true
+	}
+
+	event, found = findIdentifierInLogs(logs, internalVMErrorsEventIdentifier)
+	if found {
+		return &data.TransactionOutcome{
+			ReturnCode:    returnCodeExecutionFailed,
+			ReturnMessage: strings.TrimSpace(string(event.Data)),
+			ReturnData:    make([][]byte, 0),
+		}, true
+	}
+
+	event, found = findIdentifierInLogs(logs, core.WriteLogIdentifier)
+	if !found {
+		return nil, false
+	}
+
+	returnCode, returnData, ok := parseCallResultData(string(event.Data))
+	if !ok {
+		return nil, false
+	}
+
+	return &data.TransactionOutcome{
+		ReturnCode: returnCode,
+		ReturnData: returnData,
+	}, true
+}
+
+func parseSignalErrorEvent(event *transaction.Events) *data.TransactionOutcome {
+	outcome := &data.TransactionOutcome{
+		ReturnCode: returnCodeUserError,
+		ReturnData: make([][]byte, 0),
+	}
+
+	returnCode, returnData, ok := parseCallResultData(string(event.Data))
+	if ok {
+		outcome.ReturnCode = returnCode
+		outcome.ReturnData = returnData
+	}
+
+	if len(event.Topics) > signalErrorMessageTopicIdx {
+		outcome.ReturnMessage = string(event.Topics[signalErrorMessageTopicIdx])
+	}
+
+	return outcome
+}
+
+// parseCallResultData splits a result data of form @<return code hex>@<value hex>@<value hex>... into its components
+func parseCallResultData(resultData string) (string, [][]byte, bool) {
+	function, parts := splitDataField(resultData)
+	if len(function) > 0 || len(parts) == 0 {
+		return "", nil, false
+	}
+
+	returnCode, err := hex.DecodeString(parts[0])
+	if err != nil || len(returnCode) == 0 {
+		return "", nil, false
+	}
+
+	returnData := make([][]byte, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		value, errDecode := hex.DecodeString(part)
+		if errDecode != nil {
+			return "", nil, false
+		}
+
+		returnData = append(returnData, value)
+	}
+
+	return string(returnCode), returnData, true
+}
+
+// splitDataField splits a data field of form <function>@<argument hex>@<argument hex>... into the function and the
+// still encoded arguments. The function is empty for the results data, which start with the separator
+func splitDataField(dataField string) (string, []string) {
+	parts := strings.Split(dataField, argsSeparator)
+
+	return parts[0], parts[1:]
+}
@@ -0,0 +1,146 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransactionOutcome(t *testing.T) {
+	t.Parallel()
+
+	sender := "erd1sender"
+	t.Run("outcome from smart contract result should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender: sender,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					RcvAddr: "erd1other",
+					Data:    "@6f6b@01",
+				},
+				{
+					RcvAddr:       sender,
+					Data:          "@6f6b@0a@@7465737420",
+					ReturnMessage: "gas refund",
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", outcome.ReturnCode)
+		assert.Equal(t, "gas refund", outcome.ReturnMessage)
+		assert.Equal(t, [][]byte{{10}, {}, []byte("test ")}, outcome.ReturnData)
+	})
+	t.Run("outcome from signal error event should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender: sender,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					RcvAddr: sender,
+					Data:    "not a call result",
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{
+							{
+								Identifier: core.SignalErrorOperation,
+								Topics:     [][]byte{[]byte("address"), []byte("insufficient funds")},
+								Data:       []byte("@75736572206572726f72"),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		require.NoError(t, err)
+		assert.Equal(t, returnCodeUserError, outcome.ReturnCode)
+		assert.Equal(t, "insufficient funds", outcome.ReturnMessage)
+		assert.Empty(t, outcome.ReturnData)
+	})
+	t.Run("outcome from internal vm errors event should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender: sender,
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Identifier: internalVMErrorsEventIdentifier,
+						Data:       []byte("\n\truntime error "),
+					},
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		require.NoError(t, err)
+		assert.Equal(t, returnCodeExecutionFailed, outcome.ReturnCode)
+		assert.Equal(t, "runtime error", outcome.ReturnMessage)
+	})
+	t.Run("outcome from write log event should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender: sender,
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Identifier: core.WriteLogIdentifier,
+						Data:       []byte("@6f6b@2a"),
+					},
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", outcome.ReturnCode)
+		assert.Equal(t, [][]byte{{42}}, outcome.ReturnData)
+	})
+	t.Run("nil smart contract results should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender:               sender,
+			SmartContractResults: []*transaction.ApiSmartContractResult{nil},
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Identifier: core.WriteLogIdentifier,
+						Data:       []byte("@6f6b"),
+					},
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", outcome.ReturnCode)
+	})
+	t.Run("no outcome should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender: sender,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					RcvAddr: sender,
+					Data:    "@zz",
+				},
+			},
+		}
+
+		outcome, err := parseTransactionOutcome(tx)
+		assert.Equal(t, data.ErrTransactionOutcomeNotFound, err)
+		assert.Nil(t, outcome)
+	})
+}
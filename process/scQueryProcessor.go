@@ -1,13 +1,16 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
@@ -18,6 +21,10 @@ const scQueryServicePath = "/vm-values/query"
 const blockNonce = "blockNonce"
 const blockHash = "blockHash"
 
+// maxHyperblocksLookBack is the maximum number of hyperblocks checked when searching the last block of a shard
+// notarized up to the requested hyperblock
+const maxHyperblocksLookBack = 10
+
 // SCQueryProcessor is able to process smart contract queries
 type SCQueryProcessor struct {
 	proc                 Processor
@@ -42,7 +49,7 @@ func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (
 }
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
@@ -77,18 +84,18 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 			path = path + "?" + queryParams
 		}
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, path, request, &response)
+		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(ctx, observer.Address, path, request, &response)
 		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
 		isOk := httpStatus == http.StatusOK
 		responseHasExplicitError := len(response.Error) > 0
 
 		if isObserverDown {
-			log.LogIfError(err)
+			log.WithContext(ctx).LogIfError(err)
 			continue
 		}
 
 		if isOk {
-			log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
+			log.WithContext(ctx).Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
 			return response.Data.Data, response.Data.BlockInfo, nil
 		}
 
@@ -102,6 +109,195 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 	return nil, data.BlockInfo{}, WrapObserversError(response.Error)
 }
 
+// ExecuteMultiContractQuery runs the same query against all the provided contracts. The contracts are grouped by shard and
+// each shard's queries are dispatched in parallel. A failed query does not abort the others, its error being reported
+// in the result of the corresponding contract
+func (scQueryProcessor *SCQueryProcessor) ExecuteMultiContractQuery(ctx context.Context, query *data.SCQuery, scAddresses []string) (map[string]*data.SCQueryResult, error) {
+	if len(scAddresses) == 0 {
+		return nil, ErrEmptyContractsList
+	}
+
+	results := make(map[string]*data.SCQueryResult, len(scAddresses))
+	addressesByShard := make(map[uint32][]string)
+	for _, scAddress := range scAddresses {
+		if _, alreadyQueried := results[scAddress]; alreadyQueried {
+			continue
+		}
+
+		shardID, err := scQueryProcessor.computeShardIdForAddress(scAddress)
+		if err != nil {
+			results[scAddress] = &data.SCQueryResult{Error: err.Error()}
+			continue
+		}
+
+		// reserve the entry so duplicated addresses are queried only once
+		results[scAddress] = nil
+		addressesByShard[shardID] = append(addressesByShard[shardID], scAddress)
+	}
+
+	shardNonces, err := scQueryProcessor.getShardNoncesAtHyperblock(ctx, query, addressesByShard)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shards without a notarized block are resolved before any query is dispatched, so the results map is
+	// written without locking only while no goroutine is running
+	queriesByShard := make(map[uint32]*data.SCQuery, len(addressesByShard))
+	for shardID, addressesInShard := range addressesByShard {
+		shardQuery := *query
+		if query.HyperblockNonce.HasValue {
+			shardNonce, found := shardNonces[shardID]
+			if !found {
+				errNonce := fmt.Errorf("%w: shard %d, hyperblock nonce %d", ErrShardBlockNotNotarized, shardID, query.HyperblockNonce.Value)
+				for _, scAddress := range addressesInShard {
+					results[scAddress] = &data.SCQueryResult{Error: errNonce.Error()}
+				}
+				continue
+			}
+
+			shardQuery.BlockNonce = core.OptionalUint64{Value: shardNonce, HasValue: true}
+		}
+
+		queriesByShard[shardID] = &shardQuery
+	}
+
+	mutResults := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for shardID, shardQuery := range queriesByShard {
+		wg.Add(1)
+		go func(shardQuery *data.SCQuery, addresses []string) {
+			defer wg.Done()
+
+			for _, scAddress := range addresses {
+				result := scQueryProcessor.executeQueryForContract(ctx, shardQuery, scAddress)
+
+				mutResults.Lock()
+				results[scAddress] = result
+				mutResults.Unlock()
+			}
+		}(shardQuery, addressesByShard[shardID])
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// getShardNoncesAtHyperblock returns, for each of the queried shards, the nonce of the last block notarized up to the
+// requested hyperblock, so that all the contracts are queried against the same coordinate
+func (scQueryProcessor *SCQueryProcessor) getShardNoncesAtHyperblock(ctx context.Context, query *data.SCQuery, addressesByShard map[uint32][]string) (map[uint32]uint64, error) {
+	shardNonces := make(map[uint32]uint64)
+	if !query.HyperblockNonce.HasValue {
+		return shardNonces, nil
+	}
+
+	hyperblockNonce := query.HyperblockNonce.Value
+	if !scQueryProcessor.hasMetachain() {
+		// without a metachain, the hyperblock nonce is the nonce of the sovereign shard block
+		for shardID := range addressesByShard {
+			shardNonces[shardID] = hyperblockNonce
+		}
+
+		return shardNonces, nil
+	}
+
+	// the hyperblocks are checked from the newest to the oldest one, so the first notarization of a shard is the latest
+	shardNonces[core.MetachainShardId] = hyperblockNonce
+	for lookBack := uint64(0); lookBack < maxHyperblocksLookBack && lookBack <= hyperblockNonce; lookBack++ {
+		if containsAllShards(shardNonces, addressesByShard) {
+			break
+		}
+
+		metaBlock, err := scQueryProcessor.getMetaBlockByNonce(ctx, hyperblockNonce-lookBack)
+		if err != nil {
+			return nil, err
+		}
+
+		noncesInMetaBlock := make(map[uint32]uint64)
+		for _, notarizedBlock := range metaBlock.NotarizedBlocks {
+			if notarizedBlock.Nonce > noncesInMetaBlock[notarizedBlock.Shard] {
+				noncesInMetaBlock[notarizedBlock.Shard] = notarizedBlock.Nonce
+			}
+		}
+		for shardID, nonce := range noncesInMetaBlock {
+			_, found := shardNonces[shardID]
+			if !found {
+				shardNonces[shardID] = nonce
+			}
+		}
+	}
+
+	return shardNonces, nil
+}
+
+func containsAllShards(shardNonces map[uint32]uint64, addressesByShard map[uint32][]string) bool {
+	for shardID := range addressesByShard {
+		_, found := shardNonces[shardID]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (scQueryProcessor *SCQueryProcessor) hasMetachain() bool {
+	for _, shardID := range scQueryProcessor.proc.GetShardIDs() {
+		if shardID == core.MetachainShardId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (scQueryProcessor *SCQueryProcessor) getMetaBlockByNonce(ctx context.Context, nonce uint64) (*api.Block, error) {
+	observers, err := scQueryProcessor.proc.GetFullHistoryNodes(core.MetachainShardId, data.AvailabilityAll)
+	if err != nil {
+		observers, err = scQueryProcessor.proc.GetObservers(core.MetachainShardId, data.AvailabilityAll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	path := fmt.Sprintf("%s/%d", blockByNoncePath, nonce)
+	response := data.BlockApiResponse{}
+	for _, observer := range observers {
+		_, err = scQueryProcessor.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("hyperblock request for SC query", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return &response.Data.Block, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+func (scQueryProcessor *SCQueryProcessor) computeShardIdForAddress(address string) (uint32, error) {
+	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return scQueryProcessor.proc.ComputeShardId(addressBytes)
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueryForContract(ctx context.Context, query *data.SCQuery, scAddress string) *data.SCQueryResult {
+	contractQuery := *query
+	contractQuery.ScAddress = scAddress
+
+	vmOutput, blockInfo, err := scQueryProcessor.ExecuteQuery(ctx, &contractQuery)
+	if err != nil {
+		return &data.SCQueryResult{Error: err.Error()}
+	}
+
+	return &data.SCQueryResult{
+		Data:      vmOutput,
+		BlockInfo: blockInfo,
+	}
+}
+
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
 	request := data.VmValueRequest{}
 	request.Address = query.ScAddress
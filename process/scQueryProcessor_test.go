@@ -1,14 +1,18 @@
 package process
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -52,7 +56,7 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		},
 	}, testPubKeyConverter)
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -70,7 +74,7 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		},
 	}, testPubKeyConverter)
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -94,7 +98,7 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		},
 	}, testPubKeyConverter)
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 }
@@ -126,7 +130,7 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 		},
 	}, testPubKeyConverter)
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -170,7 +174,7 @@ func TestSCQueryProcessor_ExecuteQueryWithCoordinates(t *testing.T) {
 		},
 	}, testPubKeyConverter)
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -205,7 +209,7 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		},
 	}, testPubKeyConverter)
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -230,7 +234,195 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 		},
 	}, testPubKeyConverter)
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
+
+func TestSCQueryProcessor_ExecuteMultiContractQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty contracts list should error", func(t *testing.T) {
+		t.Parallel()
+
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+
+		results, err := processor.ExecuteMultiContractQuery(context.Background(), &data.SCQuery{FuncName: "function"}, nil)
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyContractsList, err)
+	})
+	t.Run("should query each contract on its shard", func(t *testing.T) {
+		t.Parallel()
+
+		scAddressShard1, _ := testPubKeyConverter.Encode(bytes.Repeat([]byte{1}, 32))
+		otherScAddressShard1, _ := testPubKeyConverter.Encode(bytes.Repeat([]byte{2}, 32))
+		dummyScAddressBytes, _ := testPubKeyConverter.Decode(dummyScAddress)
+
+		mutQueried := sync.Mutex{}
+		queriedAddresses := make(map[string]int)
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				if bytes.Equal(addressBuff, dummyScAddressBytes) {
+					return 0, nil
+				}
+				return 1, nil
+			},
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				request := dataValue.(data.VmValueRequest)
+				require.Equal(t, "balanceOf", request.FuncName)
+				require.Equal(t, []string{"aa"}, request.Args)
+
+				mutQueried.Lock()
+				queriedAddresses[request.Address]++
+				mutQueried.Unlock()
+
+				if request.Address == otherScAddressShard1 {
+					response.(*data.ResponseVmValue).Error = "function not found"
+					return http.StatusBadRequest, nil
+				}
+
+				require.Equal(t, request.Address == dummyScAddress, address == "observer0")
+				response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
+					ReturnData: [][]byte{[]byte(request.Address)},
+				}
+				response.(*data.ResponseVmValue).Data.BlockInfo = data.BlockInfo{Hash: address}
+
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter)
+
+		scAddresses := []string{dummyScAddress, scAddressShard1, "invalid address", otherScAddressShard1, dummyScAddress}
+		results, err := processor.ExecuteMultiContractQuery(context.Background(), &data.SCQuery{
+			FuncName:  "balanceOf",
+			Arguments: [][]byte{{0xaa}},
+		}, scAddresses)
+		require.Nil(t, err)
+		require.Len(t, results, 4)
+
+		require.Equal(t, []byte(dummyScAddress), results[dummyScAddress].Data.ReturnData[0])
+		require.Equal(t, "observer0", results[dummyScAddress].BlockInfo.Hash)
+		require.Empty(t, results[dummyScAddress].Error)
+		require.Equal(t, []byte(scAddressShard1), results[scAddressShard1].Data.ReturnData[0])
+		require.Equal(t, "observer1", results[scAddressShard1].BlockInfo.Hash)
+		require.Nil(t, results[otherScAddressShard1].Data)
+		require.Equal(t, "function not found", results[otherScAddressShard1].Error)
+		require.Nil(t, results["invalid address"].Data)
+		require.NotEmpty(t, results["invalid address"].Error)
+
+		require.Equal(t, map[string]int{dummyScAddress: 1, scAddressShard1: 1, otherScAddressShard1: 1}, queriedAddresses)
+	})
+	t.Run("hyperblock nonce should query each shard at the block notarized in the hyperblock", func(t *testing.T) {
+		t.Parallel()
+
+		scAddressShard1, _ := testPubKeyConverter.Encode(bytes.Repeat([]byte{1}, 32))
+		scAddressMeta, _ := testPubKeyConverter.Encode(bytes.Repeat([]byte{2}, 32))
+		scAddressShard2, _ := testPubKeyConverter.Encode(bytes.Repeat([]byte{3}, 32))
+		dummyScAddressBytes, _ := testPubKeyConverter.Decode(dummyScAddress)
+
+		metaBlocks := map[string][]*api.NotarizedBlock{
+			"/block/by-nonce/100": {{Shard: 0, Nonce: 500}, {Shard: 0, Nonce: 501}},
+			"/block/by-nonce/99":  {{Shard: 1, Nonce: 700}, {Shard: 0, Nonce: 499}},
+		}
+		mutQueried := sync.Mutex{}
+		queriedPaths := make(map[string]string)
+		requestedMetaBlocks := make([]string, 0)
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, 2, core.MetachainShardId}
+			},
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				switch {
+				case bytes.Equal(addressBuff, dummyScAddressBytes):
+					return 0, nil
+				case bytes.Equal(addressBuff, bytes.Repeat([]byte{1}, 32)):
+					return 1, nil
+				case bytes.Equal(addressBuff, bytes.Repeat([]byte{2}, 32)):
+					return core.MetachainShardId, nil
+				default:
+					return 2, nil
+				}
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, errors.New("no full history node")
+			},
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.Equal(t, fmt.Sprintf("observer%d", core.MetachainShardId), address)
+				requestedMetaBlocks = append(requestedMetaBlocks, path)
+
+				notarizedBlocks, found := metaBlocks[path]
+				if !found {
+					notarizedBlocks = []*api.NotarizedBlock{{Shard: 0, Nonce: 1}}
+				}
+				value.(*data.BlockApiResponse).Data.Block = api.Block{NotarizedBlocks: notarizedBlocks}
+				return http.StatusOK, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				request := dataValue.(data.VmValueRequest)
+
+				mutQueried.Lock()
+				queriedPaths[request.Address] = path
+				mutQueried.Unlock()
+
+				response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{}
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter)
+
+		results, err := processor.ExecuteMultiContractQuery(context.Background(), &data.SCQuery{
+			FuncName:        "balanceOf",
+			HyperblockNonce: core.OptionalUint64{Value: 100, HasValue: true},
+		}, []string{dummyScAddress, scAddressShard1, scAddressMeta, scAddressShard2})
+		require.Nil(t, err)
+		require.Len(t, results, 4)
+
+		require.Equal(t, map[string]string{
+			dummyScAddress:  "/vm-values/query?blockNonce=501",
+			scAddressShard1: "/vm-values/query?blockNonce=700",
+			scAddressMeta:   "/vm-values/query?blockNonce=100",
+		}, queriedPaths)
+		require.Nil(t, results[scAddressShard2].Data)
+		require.Contains(t, results[scAddressShard2].Error, ErrShardBlockNotNotarized.Error())
+		require.Len(t, requestedMetaBlocks, maxHyperblocksLookBack)
+	})
+	t.Run("hyperblock nonce without metachain should be used as the block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPath := ""
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{core.SovereignChainShardId}
+			},
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return core.SovereignChainShardId, nil
+			},
+			GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.Fail(t, "should not request the hyperblock")
+				return 0, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				queriedPath = path
+				response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{}
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter)
+
+		results, err := processor.ExecuteMultiContractQuery(context.Background(), &data.SCQuery{
+			FuncName:        "balanceOf",
+			HyperblockNonce: core.OptionalUint64{Value: 37, HasValue: true},
+		}, []string{dummyScAddress})
+		require.Nil(t, err)
+		require.Empty(t, results[dummyScAddress].Error)
+		require.Equal(t, "/vm-values/query?blockNonce=37", queriedPath)
+	})
+}
@@ -0,0 +1,369 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// SovereignConfigPath represents the path where an observer exposes the sovereign chain specific settings
+const SovereignConfigPath = "/network/sovereign-config"
+
+const (
+	getOwnerFunc       = "getOwner"
+	getTotalStakedFunc = "getTotalStakedTopUpStakedBlsKeys"
+
+	totalStakedTopUpIdx = 0
+	totalStakedIdx      = 1
+	numStakedKeysIdx    = 2
+
+	maxNotarizedHeadersRange = 100
+
+	// the owner of a BLS key only changes if the key is unstaked and staked again by another owner, so it is queried
+	// once in a while instead of on each validators request
+	validatorOwnerCacheDuration = time.Hour
+)
+
+type ownerStake struct {
+	stakePerNode *big.Int
+	topUpPerNode *big.Int
+}
+
+type cachedValidatorOwner struct {
+	owner      string
+	expiryTime time.Time
+}
+
+// SovereignProcessor is able to aggregate the sovereign chain's validators data
+type SovereignProcessor struct {
+	proc            Processor
+	scQueryProc     SCQueryService
+	pubKeyConverter core.PubkeyConverter
+
+	mutOwners      sync.RWMutex
+	ownersByBLSKey map[string]*cachedValidatorOwner
+	getTimeHandler func() time.Time
+}
+
+// NewSovereignProcessor creates a new instance of SovereignProcessor
+func NewSovereignProcessor(proc Processor, scQueryProc SCQueryService, pubKeyConverter core.PubkeyConverter) (*SovereignProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &SovereignProcessor{
+		proc:            proc,
+		scQueryProc:     scQueryProc,
+		pubKeyConverter: pubKeyConverter,
+		ownersByBLSKey:  make(map[string]*cachedValidatorOwner),
+		getTimeHandler:  time.Now,
+	}, nil
+}
+
+// GetValidatorsInfo returns the validator set of the provided epoch, along with the stake of each validator
+func (sp *SovereignProcessor) GetValidatorsInfo(ctx context.Context, epoch uint32, consensusGroupSize uint32) (*data.SovereignValidatorsInfo, error) {
+	validators, err := sp.getStartOfEpochValidators(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo := &data.SovereignValidatorsInfo{
+		Epoch:              epoch,
+		ConsensusGroupSize: consensusGroupSize,
+		Validators:         make([]*data.SovereignValidator, 0, len(validators)),
+	}
+
+	sp.evictExpiredOwners()
+
+	totalStake := big.NewInt(0)
+	stakesByOwner := make(map[string]*ownerStake)
+	for _, validator := range validators {
+		sovereignValidator := &data.SovereignValidator{
+			PublicKey: hex.EncodeToString(validator.PublicKey),
+			ShardID:   validator.ShardId,
+			List:      validator.List,
+			Index:     validator.Index,
+			Rating:    validator.TempRating,
+		}
+		validatorsInfo.Validators = append(validatorsInfo.Validators, sovereignValidator)
+
+		owner, errOwner := sp.getOwner(ctx, validator.PublicKey)
+		if errOwner != nil {
+			log.WithContext(ctx).Warn("cannot get validator owner", "public key", sovereignValidator.PublicKey, "error", errOwner)
+			continue
+		}
+		sovereignValidator.Owner = owner
+
+		stake, found := stakesByOwner[owner]
+		if !found {
+			stake, errOwner = sp.getOwnerStake(ctx, owner)
+			if errOwner != nil {
+				log.WithContext(ctx).Warn("cannot get owner stake", "owner", owner, "error", errOwner)
+				continue
+			}
+			stakesByOwner[owner] = stake
+		}
+
+		sovereignValidator.Stake = stake.stakePerNode.String()
+		sovereignValidator.TopUp = stake.topUpPerNode.String()
+		totalStake.Add(totalStake, stake.stakePerNode)
+	}
+	validatorsInfo.TotalStake = totalStake.String()
+
+	return validatorsInfo, nil
+}
+
+// GetChainParameters returns the sovereign chain specific settings, completed with the provided network config
+func (sp *SovereignProcessor) GetChainParameters(ctx context.Context, networkConfig *data.NetworkConfig) (*data.SovereignChainParameters, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	sovereignConfig, err := sp.getSovereignConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.SovereignChainParameters{
+		ChainID:                        networkConfig.Config.ChainID,
+		NativeTokenIdentifier:          sovereignConfig.NativeESDT,
+		Denomination:                   networkConfig.Config.Denomination,
+		MainChainNotarizationAddresses: sovereignConfig.MainChainNotarizationAddresses,
+		BridgeContracts:                sovereignConfig.BridgeContracts,
+		RoundDuration:                  networkConfig.Config.RoundDuration,
+		RoundsPerEpoch:                 networkConfig.Config.RoundsPerEpoch,
+		StartTime:                      networkConfig.Config.StartTime,
+	}, nil
+}
+
+// GetNotarizedMainChainHeaders returns the main chain headers notarized by the sovereign blocks with the nonces
+// between from and to, both included
+func (sp *SovereignProcessor) GetNotarizedMainChainHeaders(ctx context.Context, from uint64, to uint64) (*data.NotarizedMainChainHeaders, error) {
+	if from > to {
+		return nil, ErrInvalidNotarizedHeadersRange
+	}
+	if to-from >= maxNotarizedHeadersRange {
+		return nil, fmt.Errorf("%w: at most %d blocks can be requested", ErrInvalidNotarizedHeadersRange, maxNotarizedHeadersRange)
+	}
+
+	observers, err := sp.proc.GetObservers(core.SovereignChainShardId, data.AvailabilityAll)
+	if err != nil {
+		return nil, err
+	}
+
+	notarizedHeaders := &data.NotarizedMainChainHeaders{
+		From:    from,
+		To:      to,
+		Headers: make([]*data.NotarizedMainChainHeader, 0),
+	}
+	for nonce := from; nonce <= to; nonce++ {
+		block, errBlock := sp.getSovereignBlock(ctx, observers, nonce)
+		if errBlock != nil {
+			return nil, errBlock
+		}
+
+		for _, notarizedBlock := range block.NotarizedBlocks {
+			if notarizedBlock == nil || notarizedBlock.Shard != core.MainChainShardId {
+				continue
+			}
+
+			notarizedHeaders.Headers = append(notarizedHeaders.Headers, &data.NotarizedMainChainHeader{
+				Hash:                notarizedBlock.Hash,
+				Nonce:               notarizedBlock.Nonce,
+				Round:               notarizedBlock.Round,
+				SovereignBlockNonce: block.Nonce,
+				SovereignBlockHash:  block.Hash,
+			})
+		}
+	}
+
+	return notarizedHeaders, nil
+}
+
+func (sp *SovereignProcessor) getSovereignBlock(ctx context.Context, observers []*data.NodeData, nonce uint64) (*api.Block, error) {
+	path := fmt.Sprintf("%s/%d", blockByNoncePath, nonce)
+	response := data.BlockApiResponse{}
+	for _, observer := range observers {
+		_, err := sp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("sovereign block request", "observer", observer.Address, "nonce", nonce, "error", err.Error())
+			continue
+		}
+
+		log.WithContext(ctx).Info("sovereign block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+		return &response.Data.Block, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+func (sp *SovereignProcessor) getSovereignConfig(ctx context.Context) (*data.SovereignConfig, error) {
+	observers, err := sp.proc.GetObservers(core.SovereignChainShardId, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.SovereignConfigApiResponse{}
+	for _, observer := range observers {
+		_, err = sp.proc.CallGetRestEndPoint(ctx, observer.Address, SovereignConfigPath, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("sovereign config request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		log.WithContext(ctx).Info("sovereign config request", "shard id", observer.ShardId, "observer", observer.Address)
+		return &response.Data.Config, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+func (sp *SovereignProcessor) getStartOfEpochValidators(ctx context.Context, epoch uint32) ([]*data.StartOfEpochValidatorInfo, error) {
+	observers, err := sp.proc.GetObservers(sp.getValidatorsShardID(), data.AvailabilityAll)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(internalStartOfEpochValidatorsInfoPath, epoch)
+	response := data.StartOfEpochValidatorsApiResponse{}
+	for _, observer := range observers {
+		_, err = sp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
+		if err != nil {
+			log.WithContext(ctx).Error("sovereign validators request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		log.WithContext(ctx).Info("sovereign validators request", "shard id", observer.ShardId, "epoch", epoch, "observer", observer.Address)
+		return response.Data.Validators, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+// getValidatorsShardID returns the shard holding the validators data: the metachain, if configured, or the sovereign shard
+func (sp *SovereignProcessor) getValidatorsShardID() uint32 {
+	for _, shardID := range sp.proc.GetShardIDs() {
+		if shardID == core.MetachainShardId {
+			return core.MetachainShardId
+		}
+	}
+
+	return core.SovereignChainShardId
+}
+
+// getOwner returns the owner of the provided BLS key, queried from the staking contract only if the cached one, if any,
+// expired
+func (sp *SovereignProcessor) getOwner(ctx context.Context, blsKey []byte) (string, error) {
+	now := sp.getTimeHandler()
+	sp.mutOwners.RLock()
+	cachedOwner, found := sp.ownersByBLSKey[string(blsKey)]
+	sp.mutOwners.RUnlock()
+	if found && now.Before(cachedOwner.expiryTime) {
+		return cachedOwner.owner, nil
+	}
+
+	owner, err := sp.queryOwner(ctx, blsKey)
+	if err != nil {
+		return "", err
+	}
+
+	sp.mutOwners.Lock()
+	sp.ownersByBLSKey[string(blsKey)] = &cachedValidatorOwner{
+		owner:      owner,
+		expiryTime: now.Add(validatorOwnerCacheDuration),
+	}
+	sp.mutOwners.Unlock()
+
+	return owner, nil
+}
+
+// evictExpiredOwners removes the expired owners, so that the cache only holds the owners of the recent validators
+func (sp *SovereignProcessor) evictExpiredOwners() {
+	now := sp.getTimeHandler()
+
+	sp.mutOwners.Lock()
+	defer sp.mutOwners.Unlock()
+
+	for blsKey, cachedOwner := range sp.ownersByBLSKey {
+		if !now.Before(cachedOwner.expiryTime) {
+			delete(sp.ownersByBLSKey, blsKey)
+		}
+	}
+}
+
+func (sp *SovereignProcessor) queryOwner(ctx context.Context, blsKey []byte) (string, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: encodeSystemAddress(sp.pubKeyConverter, common.StakingContractAddressHex),
+		FuncName:  getOwnerFunc,
+		Arguments: [][]byte{blsKey},
+	}
+
+	res, _, err := sp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return "", err
+	}
+	if len(res.ReturnData) == 0 || len(res.ReturnData[0]) == 0 {
+		return "", fmt.Errorf("%w for %s", ErrEmptyReturnData, getOwnerFunc)
+	}
+
+	return sp.pubKeyConverter.SilentEncode(res.ReturnData[0], log), nil
+}
+
+func (sp *SovereignProcessor) getOwnerStake(ctx context.Context, owner string) (*ownerStake, error) {
+	ownerBytes, err := sp.pubKeyConverter.Decode(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorContractAddress := encodeSystemAddress(sp.pubKeyConverter, common.ValidatorContractAddressHex)
+	scQuery := &data.SCQuery{
+		ScAddress:  validatorContractAddress,
+		FuncName:   getTotalStakedFunc,
+		CallerAddr: validatorContractAddress,
+		Arguments:  [][]byte{ownerBytes},
+	}
+
+	res, _, err := sp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.ReturnData) <= numStakedKeysIdx {
+		return nil, fmt.Errorf("%w for %s", ErrEmptyReturnData, getTotalStakedFunc)
+	}
+
+	numStakedKeys := big.NewInt(0).SetBytes(res.ReturnData[numStakedKeysIdx])
+	if numStakedKeys.Sign() == 0 {
+		return &ownerStake{
+			stakePerNode: big.NewInt(0),
+			topUpPerNode: big.NewInt(0),
+		}, nil
+	}
+
+	topUp := big.NewInt(0).SetBytes(res.ReturnData[totalStakedTopUpIdx])
+	totalStaked := big.NewInt(0).SetBytes(res.ReturnData[totalStakedIdx])
+
+	return &ownerStake{
+		stakePerNode: totalStaked.Div(totalStaked, numStakedKeys),
+		topUpPerNode: topUp.Div(topUp, numStakedKeys),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *SovereignProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
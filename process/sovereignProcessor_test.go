@@ -0,0 +1,374 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSovereignProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := process.NewSovereignProcessor(nil, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+		assert.Nil(t, sp)
+		assert.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := process.NewSovereignProcessor(&mock.ProcessorStub{}, nil, &mock.PubKeyConverterMock{})
+		assert.Nil(t, sp)
+		assert.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := process.NewSovereignProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, nil)
+		assert.Nil(t, sp)
+		assert.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := process.NewSovereignProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+		assert.NoError(t, err)
+		assert.False(t, sp.IsInterfaceNil())
+	})
+}
+
+func TestSovereignProcessor_GetValidatorsInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers request fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{core.SovereignChainShardId}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 0, errors.New("observer down")
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		validatorsInfo, err := sp.GetValidatorsInfo(context.Background(), 2, 5)
+		assert.Nil(t, validatorsInfo)
+		assert.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("should aggregate validators and stakes", func(t *testing.T) {
+		t.Parallel()
+
+		requestedShard := uint32(1)
+		proc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{core.SovereignChainShardId}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				requestedShard = shardId
+				return []*data.NodeData{{Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Equal(t, "/internal/json/startofepoch/validators/by-epoch/2", path)
+				response := value.(*data.StartOfEpochValidatorsApiResponse)
+				response.Data.Validators = []*data.StartOfEpochValidatorInfo{
+					{PublicKey: []byte{0xaa}, List: "eligible", TempRating: 50},
+					{PublicKey: []byte{0xbb}, List: "eligible", Index: 1, TempRating: 60},
+					{PublicKey: []byte{0xcc}, List: "waiting", TempRating: 70},
+				}
+				return 0, nil
+			},
+		}
+		numStakeQueries := 0
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getOwner" {
+					if query.Arguments[0][0] == 0xcc {
+						return nil, data.BlockInfo{}, errors.New("owner not found")
+					}
+					return &vm.VMOutputApi{ReturnData: [][]byte{{0x01}}}, data.BlockInfo{}, nil
+				}
+
+				numStakeQueries++
+				assert.Equal(t, [][]byte{{0x01}}, query.Arguments)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{
+						big.NewInt(100).Bytes(),
+						big.NewInt(5000).Bytes(),
+						big.NewInt(2).Bytes(),
+					},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, scQueryProc, &mock.PubKeyConverterMock{})
+
+		validatorsInfo, err := sp.GetValidatorsInfo(context.Background(), 2, 5)
+		require.NoError(t, err)
+		assert.Equal(t, core.SovereignChainShardId, requestedShard)
+		assert.Equal(t, 1, numStakeQueries)
+		assert.Equal(t, uint32(2), validatorsInfo.Epoch)
+		assert.Equal(t, uint32(5), validatorsInfo.ConsensusGroupSize)
+		assert.Equal(t, "5000", validatorsInfo.TotalStake)
+		require.Len(t, validatorsInfo.Validators, 3)
+		assert.Equal(t, &data.SovereignValidator{
+			PublicKey: "aa",
+			List:      "eligible",
+			Rating:    50,
+			Owner:     "01",
+			Stake:     "2500",
+			TopUp:     "50",
+		}, validatorsInfo.Validators[0])
+		assert.Equal(t, "bb", validatorsInfo.Validators[1].PublicKey)
+		assert.Equal(t, "2500", validatorsInfo.Validators[1].Stake)
+		assert.Equal(t, &data.SovereignValidator{
+			PublicKey: "cc",
+			List:      "waiting",
+			Rating:    70,
+		}, validatorsInfo.Validators[2])
+	})
+}
+
+func TestSovereignProcessor_GetValidatorsInfoShouldCacheTheOwners(t *testing.T) {
+	t.Parallel()
+
+	proc := &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.SovereignChainShardId}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			response := value.(*data.StartOfEpochValidatorsApiResponse)
+			response.Data.Validators = []*data.StartOfEpochValidatorInfo{
+				{PublicKey: []byte{0xaa}},
+				{PublicKey: []byte{0xbb}},
+			}
+			return 0, nil
+		},
+	}
+	numOwnerQueries := 0
+	scQueryProc := &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			if query.FuncName == "getOwner" {
+				numOwnerQueries++
+				if query.Arguments[0][0] == 0xbb {
+					return nil, data.BlockInfo{}, errors.New("owner not found")
+				}
+				return &vm.VMOutputApi{ReturnData: [][]byte{{0x01}}}, data.BlockInfo{}, nil
+			}
+
+			return &vm.VMOutputApi{ReturnData: [][]byte{{}, {}, {}}}, data.BlockInfo{}, nil
+		},
+	}
+	sp, _ := process.NewSovereignProcessor(proc, scQueryProc, &mock.PubKeyConverterMock{})
+	now := time.Now()
+	sp.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	_, err := sp.GetValidatorsInfo(context.Background(), 2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numOwnerQueries)
+
+	// only the owner which could not be fetched is queried again
+	validatorsInfo, err := sp.GetValidatorsInfo(context.Background(), 2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 3, numOwnerQueries)
+	assert.Equal(t, "01", validatorsInfo.Validators[0].Owner)
+
+	now = now.Add(time.Hour)
+	_, err = sp.GetValidatorsInfo(context.Background(), 2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, numOwnerQueries)
+}
+
+func TestSovereignProcessor_GetChainParameters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := process.NewSovereignProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		chainParameters, err := sp.GetChainParameters(context.Background(), nil)
+		assert.Nil(t, chainParameters)
+		assert.Equal(t, process.ErrNilNetworkConfig, err)
+	})
+	t.Run("observers request fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 0, errors.New("observer down")
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		chainParameters, err := sp.GetChainParameters(context.Background(), &data.NetworkConfig{})
+		assert.Nil(t, chainParameters)
+		assert.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("should aggregate the sovereign config and the network config", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				assert.Equal(t, core.SovereignChainShardId, shardId)
+				return []*data.NodeData{{Address: "observer0"}, {Address: "observer1"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				numCalls++
+				assert.Equal(t, process.SovereignConfigPath, path)
+				if address == "observer0" {
+					return 0, errors.New("observer down")
+				}
+
+				response := value.(*data.SovereignConfigApiResponse)
+				response.Data.Config = data.SovereignConfig{
+					NativeESDT:                     "SOV-123456",
+					MainChainNotarizationAddresses: []string{"erd1notary"},
+					BridgeContracts: data.SovereignBridgeContracts{
+						MainChainESDTSafeAddress: "erd1mainsafe",
+						ESDTSafeAddress:          "erd1safe",
+						HeaderVerifierAddress:    "erd1verifier",
+						FeeMarketAddress:         "erd1feemarket",
+					},
+				}
+				return 0, nil
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		networkConfig := &data.NetworkConfig{}
+		networkConfig.Config.ChainID = "S"
+		networkConfig.Config.Denomination = 18
+		networkConfig.Config.RoundDuration = 6000
+		networkConfig.Config.RoundsPerEpoch = 200
+		networkConfig.Config.StartTime = 1700000000
+
+		chainParameters, err := sp.GetChainParameters(context.Background(), networkConfig)
+		require.NoError(t, err)
+		assert.Equal(t, 2, numCalls)
+		assert.Equal(t, &data.SovereignChainParameters{
+			ChainID:                        "S",
+			NativeTokenIdentifier:          "SOV-123456",
+			Denomination:                   18,
+			MainChainNotarizationAddresses: []string{"erd1notary"},
+			BridgeContracts: data.SovereignBridgeContracts{
+				MainChainESDTSafeAddress: "erd1mainsafe",
+				ESDTSafeAddress:          "erd1safe",
+				HeaderVerifierAddress:    "erd1verifier",
+				FeeMarketAddress:         "erd1feemarket",
+			},
+			RoundDuration:  6000,
+			RoundsPerEpoch: 200,
+			StartTime:      1700000000,
+		}, chainParameters)
+	})
+}
+
+func TestSovereignProcessor_GetNotarizedMainChainHeaders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := process.NewSovereignProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		notarizedHeaders, err := sp.GetNotarizedMainChainHeaders(context.Background(), 10, 9)
+		assert.Nil(t, notarizedHeaders)
+		assert.Equal(t, process.ErrInvalidNotarizedHeadersRange, err)
+
+		notarizedHeaders, err = sp.GetNotarizedMainChainHeaders(context.Background(), 0, 100)
+		assert.Nil(t, notarizedHeaders)
+		assert.True(t, errors.Is(err, process.ErrInvalidNotarizedHeadersRange))
+	})
+	t.Run("observers request fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 0, errors.New("observer down")
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		notarizedHeaders, err := sp.GetNotarizedMainChainHeaders(context.Background(), 1, 2)
+		assert.Nil(t, notarizedHeaders)
+		assert.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("should keep only the main chain headers", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				assert.Equal(t, core.SovereignChainShardId, shardId)
+				return []*data.NodeData{{Address: "observer0"}, {Address: "observer1"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if address == "observer0" {
+					return 0, errors.New("observer down")
+				}
+
+				requestedPaths = append(requestedPaths, path)
+				response := value.(*data.BlockApiResponse)
+				if path == "/block/by-nonce/5" {
+					response.Data.Block = api.Block{
+						Nonce: 5,
+						Hash:  "sovereignHash5",
+						NotarizedBlocks: []*api.NotarizedBlock{
+							{Hash: "mainHash1", Nonce: 101, Round: 201, Shard: core.MainChainShardId},
+							{Hash: "sovereignHash4", Nonce: 4, Round: 4, Shard: core.SovereignChainShardId},
+							{Hash: "mainHash2", Nonce: 102, Round: 202, Shard: core.MainChainShardId},
+						},
+					}
+					return 200, nil
+				}
+
+				response.Data.Block = api.Block{Nonce: 6, Hash: "sovereignHash6"}
+				return 200, nil
+			},
+		}
+		sp, _ := process.NewSovereignProcessor(proc, &mock.SCQueryServiceStub{}, &mock.PubKeyConverterMock{})
+
+		notarizedHeaders, err := sp.GetNotarizedMainChainHeaders(context.Background(), 5, 6)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/block/by-nonce/5", "/block/by-nonce/6"}, requestedPaths)
+
+		expectedHeaders := &data.NotarizedMainChainHeaders{
+			From: 5,
+			To:   6,
+			Headers: []*data.NotarizedMainChainHeader{
+				{Hash: "mainHash1", Nonce: 101, Round: 201, SovereignBlockNonce: 5, SovereignBlockHash: "sovereignHash5"},
+				{Hash: "mainHash2", Nonce: 102, Round: 202, SovereignBlockNonce: 5, SovereignBlockHash: "sovereignHash5"},
+			},
+		}
+		assert.Equal(t, expectedHeaders, notarizedHeaders)
+	})
+}
@@ -0,0 +1,252 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	getQueueSizeFunc = "getQueueSize"
+
+	metricTotalStakedValue     = "erd_total_staked_value"
+	metricTotalBaseStakedValue = "erd_total_base_staked_value"
+	metricTotalTopUpValue      = "erd_total_top_up_value"
+	metricInflation            = "erd_inflation"
+
+	secondsPerYear = 365 * 24 * 3600
+)
+
+// StakingOverviewProcessor aggregates the staking economics of the network out of the economics metrics, the validator
+// statistics, the auction list and the staking contract queue. The overview is computed at most once per cache validity
+// duration
+type StakingOverviewProcessor struct {
+	economicsProvider     EconomicsMetricsProvider
+	validatorsProvider    ValidatorsDataProvider
+	scQueryProc           SCQueryService
+	pubKeyConverter       core.PubkeyConverter
+	cacheValidityDuration time.Duration
+
+	mutOverview       sync.RWMutex
+	overview          *data.StakingOverview
+	overviewFetchTime time.Time
+}
+
+// NewStakingOverviewProcessor creates a new instance of StakingOverviewProcessor
+func NewStakingOverviewProcessor(
+	economicsProvider EconomicsMetricsProvider,
+	validatorsProvider ValidatorsDataProvider,
+	scQueryProc SCQueryService,
+	pubKeyConverter core.PubkeyConverter,
+	cacheValidityDuration time.Duration,
+) (*StakingOverviewProcessor, error) {
+	if economicsProvider == nil {
+		return nil, ErrNilEconomicsMetricsProvider
+	}
+	if check.IfNil(validatorsProvider) {
+		return nil, ErrNilValidatorStatisticsProvider
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if cacheValidityDuration <= 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
+
+	return &StakingOverviewProcessor{
+		economicsProvider:     economicsProvider,
+		validatorsProvider:    validatorsProvider,
+		scQueryProc:           scQueryProc,
+		pubKeyConverter:       pubKeyConverter,
+		cacheValidityDuration: cacheValidityDuration,
+	}, nil
+}
+
+// GetStakingOverview returns the total staked value, the number of validators and nodes per status, the auction list
+// summary, the estimated APR and the staking queue size
+func (sop *StakingOverviewProcessor) GetStakingOverview(ctx context.Context) (*data.StakingOverview, error) {
+	sop.mutOverview.RLock()
+	overview := sop.overview
+	isValid := time.Since(sop.overviewFetchTime) < sop.cacheValidityDuration
+	sop.mutOverview.RUnlock()
+
+	if overview != nil && isValid {
+		return overview, nil
+	}
+
+	overview, err := sop.computeStakingOverview(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sop.mutOverview.Lock()
+	sop.overview = overview
+	sop.overviewFetchTime = time.Now()
+	sop.mutOverview.Unlock()
+
+	return overview, nil
+}
+
+func (sop *StakingOverviewProcessor) computeStakingOverview(ctx context.Context) (*data.StakingOverview, error) {
+	economicsMetrics, err := sop.economicsProvider.GetEconomicsDataMetrics()
+	if err != nil {
+		return nil, err
+	}
+	if economicsMetrics == nil {
+		return nil, ErrMissingEconomicsMetric
+	}
+	networkConfig, err := sop.economicsProvider.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validatorStatistics, err := sop.validatorsProvider.GetValidatorStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auctionList, err := sop.validatorsProvider.GetAuctionList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &data.StakingOverview{
+		NumNodesPerStatus: make(map[string]int),
+		Auction:           computeAuctionSummary(auctionList),
+		QueueSize:         sop.getQueueSize(ctx),
+		Timestamp:         time.Now().Unix(),
+	}
+
+	overview.TotalStaked, err = getStringEconomicsMetric(economicsMetrics.Data, metricTotalStakedValue)
+	if err != nil {
+		return nil, err
+	}
+	overview.TotalBaseStaked, err = getStringEconomicsMetric(economicsMetrics.Data, metricTotalBaseStakedValue)
+	if err != nil {
+		return nil, err
+	}
+	overview.TotalTopUp, err = getStringEconomicsMetric(economicsMetrics.Data, metricTotalTopUpValue)
+	if err != nil {
+		return nil, err
+	}
+	inflation, err := getStringEconomicsMetric(economicsMetrics.Data, metricInflation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validator := range validatorStatistics.Statistics {
+		overview.NumValidators++
+		overview.NumNodesPerStatus[validator.ValidatorStatus]++
+	}
+
+	epochDurationSec := networkConfig.Config.RoundDuration * uint64(networkConfig.Config.RoundsPerEpoch) / 1000
+	overview.EstimatedAPR = estimateAPR(inflation, overview.TotalStaked, epochDurationSec)
+
+	return overview, nil
+}
+
+// getQueueSize returns the number of nodes in the staking queue, if the staking contract still exposes it
+func (sop *StakingOverviewProcessor) getQueueSize(ctx context.Context) *uint64 {
+	scQuery := &data.SCQuery{
+		ScAddress: encodeSystemAddress(sop.pubKeyConverter, common.StakingContractAddressHex),
+		FuncName:  getQueueSizeFunc,
+	}
+
+	res, _, err := sop.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		log.WithContext(ctx).Debug("staking overview: get queue size", "error", err.Error())
+		return nil
+	}
+	if len(res.ReturnData) == 0 {
+		return nil
+	}
+
+	queueSize, err := strconv.ParseUint(string(res.ReturnData[0]), 10, 64)
+	if err != nil {
+		log.WithContext(ctx).Debug("staking overview: parse queue size", "error", err.Error())
+		return nil
+	}
+
+	return &queueSize
+}
+
+func computeAuctionSummary(auctionList *data.AuctionListResponse) *data.StakingAuctionSummary {
+	summary := &data.StakingAuctionSummary{
+		MinQualifiedTopUp: "0",
+	}
+
+	var minQualifiedTopUp *big.Int
+	for _, owner := range auctionList.AuctionListValidators {
+		summary.NumOwners++
+		summary.NumNodes += len(owner.Nodes)
+
+		numQualifiedNodes := 0
+		for _, node := range owner.Nodes {
+			if node.Qualified {
+				numQualifiedNodes++
+			}
+		}
+		summary.NumQualifiedNodes += numQualifiedNodes
+		if numQualifiedNodes == 0 {
+			continue
+		}
+
+		topUpPerNode, ok := big.NewInt(0).SetString(owner.TopUpPerNode, 10)
+		if !ok {
+			continue
+		}
+		if minQualifiedTopUp == nil || topUpPerNode.Cmp(minQualifiedTopUp) < 0 {
+			minQualifiedTopUp = topUpPerNode
+		}
+	}
+
+	if minQualifiedTopUp != nil {
+		summary.MinQualifiedTopUp = minQualifiedTopUp.String()
+	}
+
+	return summary
+}
+
+// estimateAPR returns the yearly inflation rewards, extrapolated from the rewards of an epoch, divided by the total
+// staked value
+func estimateAPR(epochInflation string, totalStaked string, epochDurationSec uint64) float64 {
+	inflationValue, okInflation := big.NewFloat(0).SetString(epochInflation)
+	totalStakedValue, okTotalStaked := big.NewFloat(0).SetString(totalStaked)
+	if !okInflation || !okTotalStaked || totalStakedValue.Sign() <= 0 || epochDurationSec == 0 {
+		return 0
+	}
+
+	epochsPerYear := big.NewFloat(float64(secondsPerYear) / float64(epochDurationSec))
+	yearlyRewards := big.NewFloat(0).Mul(inflationValue, epochsPerYear)
+	apr, _ := big.NewFloat(0).Quo(yearlyRewards, totalStakedValue).Float64()
+
+	return apr
+}
+
+func getStringEconomicsMetric(economicsData interface{}, metric string) (string, error) {
+	value, ok := getMetric(economicsData, metric)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrMissingEconomicsMetric, metric)
+	}
+
+	valueStr, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrMissingEconomicsMetric, metric)
+	}
+
+	return valueStr, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sop *StakingOverviewProcessor) IsInterfaceNil() bool {
+	return sop == nil
+}
@@ -0,0 +1,225 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStakingOverviewProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics metrics provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(nil, &mock.ValidatorsDataProviderStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		require.Nil(t, sop)
+		require.Equal(t, process.ErrNilEconomicsMetricsProvider, err)
+	})
+	t.Run("nil validators data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(&mock.EconomicsMetricsProviderStub{}, nil, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		require.Nil(t, sop)
+		require.Equal(t, process.ErrNilValidatorStatisticsProvider, err)
+	})
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(&mock.EconomicsMetricsProviderStub{}, &mock.ValidatorsDataProviderStub{}, nil, testPubkeyConverter, time.Minute)
+		require.Nil(t, sop)
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(&mock.EconomicsMetricsProviderStub{}, &mock.ValidatorsDataProviderStub{}, &mock.SCQueryServiceStub{}, nil, time.Minute)
+		require.Nil(t, sop)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid cache validity duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(&mock.EconomicsMetricsProviderStub{}, &mock.ValidatorsDataProviderStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, 0)
+		require.Nil(t, sop)
+		require.Equal(t, process.ErrInvalidCacheValidityDuration, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sop, err := process.NewStakingOverviewProcessor(&mock.EconomicsMetricsProviderStub{}, &mock.ValidatorsDataProviderStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		require.NoError(t, err)
+		require.False(t, sop.IsInterfaceNil())
+	})
+}
+
+func TestStakingOverviewProcessor_GetStakingOverview(t *testing.T) {
+	t.Parallel()
+
+	createEconomicsProvider := func(metrics map[string]interface{}) *mock.EconomicsMetricsProviderStub {
+		return &mock.EconomicsMetricsProviderStub{
+			GetEconomicsDataMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{"metrics": metrics},
+				}, nil
+			},
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				networkConfig := &data.NetworkConfig{}
+				networkConfig.Config.RoundDuration = 6000
+				networkConfig.Config.RoundsPerEpoch = 14400
+
+				return networkConfig, nil
+			},
+		}
+	}
+	economicsMetrics := map[string]interface{}{
+		"erd_total_staked_value":      "2500000",
+		"erd_total_base_staked_value": "2000000",
+		"erd_total_top_up_value":      "500000",
+		"erd_inflation":               "1000",
+	}
+	validatorsProvider := &mock.ValidatorsDataProviderStub{
+		GetValidatorStatisticsCalled: func() (*data.ValidatorStatisticsResponse, error) {
+			return &data.ValidatorStatisticsResponse{
+				Statistics: map[string]*data.ValidatorApiResponse{
+					"key0": {ValidatorStatus: "eligible"},
+					"key1": {ValidatorStatus: "eligible"},
+					"key2": {ValidatorStatus: "auction"},
+				},
+			}, nil
+		},
+		GetAuctionListCalled: func() (*data.AuctionListResponse, error) {
+			return &data.AuctionListResponse{
+				AuctionListValidators: []*data.AuctionListValidatorAPIResponse{
+					{
+						Owner:        "owner0",
+						TopUpPerNode: "300",
+						Nodes:        []*data.AuctionNode{{BlsKey: "a", Qualified: true}, {BlsKey: "b"}},
+					},
+					{
+						Owner:        "owner1",
+						TopUpPerNode: "200",
+						Nodes:        []*data.AuctionNode{{BlsKey: "c"}},
+					},
+					{
+						Owner:        "owner2",
+						TopUpPerNode: "250",
+						Nodes:        []*data.AuctionNode{{BlsKey: "d", Qualified: true}},
+					},
+				},
+			}, nil
+		},
+	}
+	createSCQueryService := func(returnData []byte, err error) *mock.SCQueryServiceStub {
+		return &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqllls0lczs7", query.ScAddress)
+				require.Equal(t, "getQueueSize", query.FuncName)
+
+				return &vm.VMOutputApi{ReturnData: [][]byte{returnData}}, data.BlockInfo{}, err
+			},
+		}
+	}
+
+	t.Run("economics metrics error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		economicsProvider := &mock.EconomicsMetricsProviderStub{
+			GetEconomicsDataMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		sop, _ := process.NewStakingOverviewProcessor(economicsProvider, validatorsProvider, createSCQueryService([]byte("4"), nil), testPubkeyConverter, time.Minute)
+
+		overview, err := sop.GetStakingOverview(context.Background())
+		require.Nil(t, overview)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("auction list error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		failingValidatorsProvider := &mock.ValidatorsDataProviderStub{
+			GetAuctionListCalled: func() (*data.AuctionListResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		sop, _ := process.NewStakingOverviewProcessor(createEconomicsProvider(economicsMetrics), failingValidatorsProvider, createSCQueryService([]byte("4"), nil), testPubkeyConverter, time.Minute)
+
+		overview, err := sop.GetStakingOverview(context.Background())
+		require.Nil(t, overview)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("missing economics metric should error", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := map[string]interface{}{
+			"erd_total_staked_value": "2500000",
+		}
+		sop, _ := process.NewStakingOverviewProcessor(createEconomicsProvider(metrics), validatorsProvider, createSCQueryService([]byte("4"), nil), testPubkeyConverter, time.Minute)
+
+		overview, err := sop.GetStakingOverview(context.Background())
+		require.Nil(t, overview)
+		require.True(t, errors.Is(err, process.ErrMissingEconomicsMetric))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sop, _ := process.NewStakingOverviewProcessor(createEconomicsProvider(economicsMetrics), validatorsProvider, createSCQueryService([]byte("4"), nil), testPubkeyConverter, time.Minute)
+
+		overview, err := sop.GetStakingOverview(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "2500000", overview.TotalStaked)
+		require.Equal(t, "2000000", overview.TotalBaseStaked)
+		require.Equal(t, "500000", overview.TotalTopUp)
+		require.Equal(t, 3, overview.NumValidators)
+		require.Equal(t, map[string]int{"eligible": 2, "auction": 1}, overview.NumNodesPerStatus)
+		require.Equal(t, &data.StakingAuctionSummary{
+			NumOwners:         3,
+			NumNodes:          4,
+			NumQualifiedNodes: 2,
+			MinQualifiedTopUp: "250",
+		}, overview.Auction)
+		// 1000 per daily epoch, over a year, divided by 2500000
+		require.InDelta(t, 0.146, overview.EstimatedAPR, 1e-9)
+		require.NotNil(t, overview.QueueSize)
+		require.Equal(t, uint64(4), *overview.QueueSize)
+	})
+	t.Run("queue size not available should work without it", func(t *testing.T) {
+		t.Parallel()
+
+		sop, _ := process.NewStakingOverviewProcessor(createEconomicsProvider(economicsMetrics), validatorsProvider, createSCQueryService(nil, errors.New("function disabled")), testPubkeyConverter, time.Minute)
+
+		overview, err := sop.GetStakingOverview(context.Background())
+		require.NoError(t, err)
+		require.Nil(t, overview.QueueSize)
+		require.Equal(t, "2500000", overview.TotalStaked)
+	})
+	t.Run("should return the cached overview while it is valid", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		economicsProvider := createEconomicsProvider(economicsMetrics)
+		getEconomicsDataMetrics := economicsProvider.GetEconomicsDataMetricsCalled
+		economicsProvider.GetEconomicsDataMetricsCalled = func() (*data.GenericAPIResponse, error) {
+			numCalls++
+			return getEconomicsDataMetrics()
+		}
+		sop, _ := process.NewStakingOverviewProcessor(economicsProvider, validatorsProvider, createSCQueryService([]byte("4"), nil), testPubkeyConverter, time.Minute)
+
+		firstOverview, err := sop.GetStakingOverview(context.Background())
+		require.NoError(t, err)
+		secondOverview, err := sop.GetStakingOverview(context.Background())
+		require.NoError(t, err)
+		require.True(t, firstOverview == secondOverview)
+		require.Equal(t, 1, numCalls)
+	})
+}
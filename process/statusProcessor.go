@@ -1,6 +1,10 @@
 package process
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -9,20 +13,35 @@ import (
 type StatusProcessor struct {
 	proc                  Processor
 	statusMetricsProvider StatusMetricsProvider
+	shardIDCache          ShardIDCacher
+	reorgDetector         ReorgDetector
 }
 
 // NewStatusProcessor creates a new instance of AccountProcessor
-func NewStatusProcessor(proc Processor, statusMetricsProvider StatusMetricsProvider) (*StatusProcessor, error) {
+func NewStatusProcessor(
+	proc Processor,
+	statusMetricsProvider StatusMetricsProvider,
+	shardIDCache ShardIDCacher,
+	reorgDetector ReorgDetector,
+) (*StatusProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(statusMetricsProvider) {
 		return nil, ErrNilStatusMetricsProvider
 	}
+	if check.IfNil(shardIDCache) {
+		return nil, ErrNilShardIDCache
+	}
+	if check.IfNil(reorgDetector) {
+		return nil, ErrNilReorgDetector
+	}
 
 	return &StatusProcessor{
 		proc:                  proc,
 		statusMetricsProvider: statusMetricsProvider,
+		shardIDCache:          shardIDCache,
+		reorgDetector:         reorgDetector,
 	}, nil
 }
 
@@ -33,5 +52,51 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
-	return sp.statusMetricsProvider.GetMetricsForPrometheus()
+	metrics := sp.statusMetricsProvider.GetMetricsForPrometheus()
+
+	stringBuilder := strings.Builder{}
+	stringBuilder.WriteString(metrics)
+	for _, excludedObserver := range sp.proc.GetExcludedObservers() {
+		stringBuilder.WriteString(fmt.Sprintf("excluded_observer{observer=\"%s\",shard=\"%d\",reason=\"%s\"} 1\n",
+			excludedObserver.Address, excludedObserver.ShardID, excludedObserver.Reason))
+	}
+
+	sp.writeShardIDCacheMetrics(&stringBuilder)
+	sp.writeReorgDetectorMetrics(&stringBuilder)
+
+	return stringBuilder.String()
+}
+
+func (sp *StatusProcessor) writeShardIDCacheMetrics(stringBuilder *strings.Builder) {
+	shardIDCacheMetrics := sp.shardIDCache.GetMetrics()
+	if shardIDCacheMetrics.Capacity == 0 {
+		return
+	}
+
+	stringBuilder.WriteString(fmt.Sprintf("shard_id_cache_capacity %d\n", shardIDCacheMetrics.Capacity))
+	stringBuilder.WriteString(fmt.Sprintf("shard_id_cache_size %d\n", shardIDCacheMetrics.Size))
+	stringBuilder.WriteString(fmt.Sprintf("shard_id_cache_hits %d\n", shardIDCacheMetrics.Hits))
+	stringBuilder.WriteString(fmt.Sprintf("shard_id_cache_misses %d\n", shardIDCacheMetrics.Misses))
+	stringBuilder.WriteString(fmt.Sprintf("shard_id_cache_hit_rate %f\n", shardIDCacheMetrics.HitRate))
+}
+
+func (sp *StatusProcessor) writeReorgDetectorMetrics(stringBuilder *strings.Builder) {
+	reorgDetectorMetrics := sp.reorgDetector.GetMetrics()
+	if reorgDetectorMetrics.NumReorgsPerShard == nil {
+		return
+	}
+
+	shardIDs := make([]uint32, 0, len(reorgDetectorMetrics.NumReorgsPerShard))
+	for shardID := range reorgDetectorMetrics.NumReorgsPerShard {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		stringBuilder.WriteString(fmt.Sprintf("chain_reorgs_detected{shard=\"%d\"} %d\n", shardID, reorgDetectorMetrics.NumReorgsPerShard[shardID]))
+	}
+	stringBuilder.WriteString(fmt.Sprintf("chain_reorg_last_detected_timestamp %d\n", reorgDetectorMetrics.LastReorgTimestamp))
+	stringBuilder.WriteString(fmt.Sprintf("chain_reorg_tracked_blocks %d\n", reorgDetectorMetrics.NumTrackedBlocks))
 }
@@ -14,7 +14,7 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil base processor - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{}, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilCoreProcessor, err)
 	})
@@ -22,15 +22,31 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil status metric provider - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil)
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilStatusMetricsProvider, err)
 	})
 
+	t.Run("nil shard IDs cache - should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, nil, &mock.ReorgDetectorStub{})
+		require.Nil(t, sp)
+		require.Equal(t, ErrNilShardIDCache, err)
+	})
+
+	t.Run("nil reorg detector - should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, &mock.ShardIDCacheStub{}, nil)
+		require.Nil(t, sp)
+		require.Equal(t, ErrNilReorgDetector, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
 		require.NoError(t, err)
 		require.NotNil(t, sp)
 	})
@@ -48,7 +64,7 @@ func TestStatusProcessor_GetMetrics(t *testing.T) {
 			return expectedMetrics
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
@@ -66,7 +82,7 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 			return expectedOutput
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
@@ -74,3 +90,85 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, metrics)
 }
+
+func TestStatusProcessor_GetMetricsForPrometheusWithShardIDCache(t *testing.T) {
+	t.Parallel()
+
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "metrics\n"
+		},
+	}
+	shardIDCache := &mock.ShardIDCacheStub{
+		GetMetricsCalled: func() data.ShardIDCacheMetrics {
+			return data.ShardIDCacheMetrics{
+				Capacity: 100,
+				Size:     10,
+				Hits:     3,
+				Misses:   1,
+				HitRate:  0.75,
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, shardIDCache, &mock.ReorgDetectorStub{})
+	require.NoError(t, err)
+
+	expectedOutput := "metrics\n" +
+		"shard_id_cache_capacity 100\n" +
+		"shard_id_cache_size 10\n" +
+		"shard_id_cache_hits 3\n" +
+		"shard_id_cache_misses 1\n" +
+		"shard_id_cache_hit_rate 0.750000\n"
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
+
+func TestStatusProcessor_GetMetricsForPrometheusWithReorgDetector(t *testing.T) {
+	t.Parallel()
+
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "metrics\n"
+		},
+	}
+	reorgDetector := &mock.ReorgDetectorStub{
+		GetMetricsCalled: func() data.ReorgDetectorMetrics {
+			return data.ReorgDetectorMetrics{
+				NumReorgsPerShard:  map[uint32]uint64{1: 2, 0: 1},
+				LastReorgTimestamp: 1700000000,
+				NumTrackedBlocks:   30,
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.ShardIDCacheStub{}, reorgDetector)
+	require.NoError(t, err)
+
+	expectedOutput := "metrics\n" +
+		"chain_reorgs_detected{shard=\"0\"} 1\n" +
+		"chain_reorgs_detected{shard=\"1\"} 2\n" +
+		"chain_reorg_last_detected_timestamp 1700000000\n" +
+		"chain_reorg_tracked_blocks 30\n"
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
+
+func TestStatusProcessor_GetMetricsForPrometheusWithExcludedObservers(t *testing.T) {
+	t.Parallel()
+
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "metrics\n"
+		},
+	}
+	proc := &mock.ProcessorStub{
+		GetExcludedObserversCalled: func() []*data.ExcludedObserver {
+			return []*data.ExcludedObserver{
+				{Address: "address0", ShardID: 1, Reason: data.ExclusionReasonNetworkMismatch},
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(proc, statusProvider, &mock.ShardIDCacheStub{}, &mock.ReorgDetectorStub{})
+	require.NoError(t, err)
+
+	expectedOutput := "metrics\n" +
+		"excluded_observer{observer=\"address0\",shard=\"1\",reason=\"network mismatch\"} 1\n"
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
@@ -0,0 +1,125 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	stuckTransactionsFields = "hash,nonce"
+	hashField               = "hash"
+	nonceField              = "nonce"
+
+	// maxMissingNoncesToReport limits the number of missing nonces returned, as a gap can span over a wide range
+	maxMissingNoncesToReport = 100
+)
+
+// GetStuckTransactionsForSender returns the sender's transactions from the pool that cannot be executed because of
+// the missing nonces between the account nonce and their nonces, along with the nonces that have to be sent to unblock them
+func (tp *TransactionProcessor) GetStuckTransactionsForSender(ctx context.Context, sender string, accountNonce uint64) (*data.StuckTransactions, error) {
+	nonceGaps, err := tp.getTxPoolNonceGapsForSender(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	txsInPool, err := tp.getTxPoolForSender(ctx, sender, stuckTransactionsFields)
+	if err != nil {
+		return nil, err
+	}
+
+	stuckTxs := &data.StuckTransactions{
+		Address:       sender,
+		AccountNonce:  accountNonce,
+		Gaps:          make([]data.NonceGap, 0),
+		MissingNonces: make([]uint64, 0),
+		Transactions:  make([]data.StuckTransaction, 0),
+	}
+	if nonceGaps != nil {
+		stuckTxs.Gaps = getGapsAfterNonce(nonceGaps.Gaps, accountNonce)
+	}
+	if len(stuckTxs.Gaps) == 0 {
+		return stuckTxs, nil
+	}
+
+	stuckTxs.MissingNonces = getMissingNonces(stuckTxs.Gaps)
+	if txsInPool != nil {
+		stuckTxs.Transactions = getTransactionsAfterNonce(txsInPool.Transactions, stuckTxs.Gaps[0].From)
+	}
+
+	return stuckTxs, nil
+}
+
+// getGapsAfterNonce returns the sorted gaps, ignoring the nonces already executed, as the observer's pool might lag
+func getGapsAfterNonce(gaps []data.NonceGap, nonce uint64) []data.NonceGap {
+	result := make([]data.NonceGap, 0, len(gaps))
+	for _, gap := range gaps {
+		if gap.To < nonce || gap.To < gap.From {
+			continue
+		}
+		if gap.From < nonce {
+			gap.From = nonce
+		}
+
+		result = append(result, gap)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].From < result[j].From
+	})
+
+	return result
+}
+
+func getMissingNonces(gaps []data.NonceGap) []uint64 {
+	missingNonces := make([]uint64, 0)
+	for _, gap := range gaps {
+		for nonce := gap.From; nonce <= gap.To; nonce++ {
+			if len(missingNonces) == maxMissingNoncesToReport {
+				return missingNonces
+			}
+
+			missingNonces = append(missingNonces, nonce)
+		}
+	}
+
+	return missingNonces
+}
+
+func getTransactionsAfterNonce(txs []data.WrappedTransaction, nonce uint64) []data.StuckTransaction {
+	stuckTxs := make([]data.StuckTransaction, 0)
+	for _, tx := range txs {
+		txNonce, ok := getNonceFromTxFields(tx.TxFields)
+		if !ok || txNonce <= nonce {
+			continue
+		}
+
+		hash, _ := tx.TxFields[hashField].(string)
+		stuckTxs = append(stuckTxs, data.StuckTransaction{
+			Hash:  hash,
+			Nonce: txNonce,
+		})
+	}
+
+	sort.Slice(stuckTxs, func(i, j int) bool {
+		return stuckTxs[i].Nonce < stuckTxs[j].Nonce
+	})
+
+	return stuckTxs
+}
+
+func getNonceFromTxFields(txFields map[string]interface{}) (uint64, bool) {
+	switch nonce := txFields[nonceField].(type) {
+	case float64:
+		return uint64(nonce), true
+	case uint64:
+		return nonce, true
+	case json.Number:
+		value, err := nonce.Int64()
+		return uint64(value), err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,120 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stuckTxsSender = "erd1kwh72fxl5rwndatsgrvfu235q3pwyng9ax4zxcrg4ss3p6pwuugq3gt3yc"
+
+func createStuckTransactionsProcessor(t *testing.T, gaps []data.NonceGap, pool []data.WrappedTransaction) *process.TransactionProcessor {
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			if gaps == nil {
+				return 0, errors.New("observer down")
+			}
+
+			if strings.Contains(path, "nonce-gaps") {
+				response := value.(*data.TransactionsPoolNonceGapsForSenderApiResponse)
+				response.Data.NonceGaps.Gaps = gaps
+			} else {
+				response := value.(*data.TransactionsPoolForSenderApiResponse)
+				response.Data.TxPool.Transactions = pool
+			}
+
+			return http.StatusOK, nil
+		},
+	}, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.NoError(t, err)
+
+	return tp
+}
+
+func createPoolTx(hash string, nonce uint64) data.WrappedTransaction {
+	return data.WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"hash":  hash,
+			"nonce": float64(nonce),
+		},
+	}
+}
+
+func TestTransactionProcessor_GetStuckTransactionsForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers unavailable should return empty result", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createStuckTransactionsProcessor(t, nil, nil)
+
+		stuckTxs, err := tp.GetStuckTransactionsForSender(context.Background(), stuckTxsSender, 5)
+		require.NoError(t, err)
+		assert.Empty(t, stuckTxs.Gaps)
+		assert.Empty(t, stuckTxs.MissingNonces)
+		assert.Empty(t, stuckTxs.Transactions)
+	})
+	t.Run("no gaps should return empty result", func(t *testing.T) {
+		t.Parallel()
+
+		pool := []data.WrappedTransaction{createPoolTx("h5", 5), createPoolTx("h6", 6)}
+		tp := createStuckTransactionsProcessor(t, []data.NonceGap{}, pool)
+
+		stuckTxs, err := tp.GetStuckTransactionsForSender(context.Background(), stuckTxsSender, 5)
+		require.NoError(t, err)
+		assert.Equal(t, stuckTxsSender, stuckTxs.Address)
+		assert.Equal(t, uint64(5), stuckTxs.AccountNonce)
+		assert.Empty(t, stuckTxs.Gaps)
+		assert.Empty(t, stuckTxs.Transactions)
+	})
+	t.Run("should return the missing nonces and the blocked transactions", func(t *testing.T) {
+		t.Parallel()
+
+		pool := []data.WrappedTransaction{
+			createPoolTx("h12", 12),
+			createPoolTx("h9", 9),
+			createPoolTx("h7", 7),
+		}
+		gaps := []data.NonceGap{
+			{From: 10, To: 11},
+			{From: 3, To: 6},
+			{From: 1, To: 2},
+		}
+		tp := createStuckTransactionsProcessor(t, gaps, pool)
+
+		stuckTxs, err := tp.GetStuckTransactionsForSender(context.Background(), stuckTxsSender, 5)
+		require.NoError(t, err)
+		assert.Equal(t, []data.NonceGap{{From: 5, To: 6}, {From: 10, To: 11}}, stuckTxs.Gaps)
+		assert.Equal(t, []uint64{5, 6, 10, 11}, stuckTxs.MissingNonces)
+		assert.Equal(t, []data.StuckTransaction{
+			{Hash: "h7", Nonce: 7},
+			{Hash: "h9", Nonce: 9},
+			{Hash: "h12", Nonce: 12},
+		}, stuckTxs.Transactions)
+	})
+	t.Run("wide gap should cap the reported missing nonces", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createStuckTransactionsProcessor(t, []data.NonceGap{{From: 0, To: 1000}}, []data.WrappedTransaction{createPoolTx("h", 1001)})
+
+		stuckTxs, err := tp.GetStuckTransactionsForSender(context.Background(), stuckTxsSender, 0)
+		require.NoError(t, err)
+		assert.Len(t, stuckTxs.MissingNonces, 100)
+		assert.Equal(t, []data.StuckTransaction{{Hash: "h", Nonce: 1001}}, stuckTxs.Transactions)
+	})
+}
@@ -0,0 +1,13 @@
+package process
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// encodeSystemAddress returns the human readable form of a system address, encoded with the address converter of the chain
+func encodeSystemAddress(pubKeyConverter core.PubkeyConverter, addressHex string) string {
+	addressBytes, _ := hex.DecodeString(addressHex)
+	return pubKeyConverter.SilentEncode(addressBytes, log)
+}
@@ -0,0 +1,136 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	nativeTokenIdentifier = "EGLD"
+	nativeTokenDecimals   = 18
+)
+
+// TokenPriceProcessor serves the token prices from the configured price provider and computes the USD value of
+// token amounts
+type TokenPriceProcessor struct {
+	priceProvider   TokenPriceProvider
+	scQueryProc     SCQueryService
+	pubKeyConverter core.PubkeyConverter
+	mutDecimals     sync.RWMutex
+	decimals        map[string]uint32
+}
+
+// NewTokenPriceProcessor creates a new instance of TokenPriceProcessor
+func NewTokenPriceProcessor(priceProvider TokenPriceProvider, scQueryProc SCQueryService, pubKeyConverter core.PubkeyConverter) (*TokenPriceProcessor, error) {
+	if check.IfNil(priceProvider) {
+		return nil, ErrNilTokenPriceProvider
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &TokenPriceProcessor{
+		priceProvider:   priceProvider,
+		scQueryProc:     scQueryProc,
+		pubKeyConverter: pubKeyConverter,
+		decimals:        map[string]uint32{nativeTokenIdentifier: nativeTokenDecimals},
+	}, nil
+}
+
+// IsEnabled returns true if a price provider is configured
+func (tpp *TokenPriceProcessor) IsEnabled() bool {
+	return tpp.priceProvider.IsEnabled()
+}
+
+// GetTokenPrice returns the USD price of the token
+func (tpp *TokenPriceProcessor) GetTokenPrice(token string) (*data.TokenPrice, error) {
+	if len(token) == 0 {
+		return nil, ErrEmptyToken
+	}
+
+	return tpp.priceProvider.GetTokenPrice(token)
+}
+
+// GetUsdValue returns the USD value of the amount of token, given in its smallest denomination
+func (tpp *TokenPriceProcessor) GetUsdValue(ctx context.Context, token string, amount string) (float64, error) {
+	value, ok := big.NewFloat(0).SetString(amount)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidAmount, amount)
+	}
+
+	tokenPrice, err := tpp.GetTokenPrice(token)
+	if err != nil {
+		return 0, err
+	}
+
+	decimals, err := tpp.getDecimals(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+
+	denomination := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	value.Quo(value, denomination)
+	value.Mul(value, big.NewFloat(tokenPrice.Price))
+	usdValue, _ := value.Float64()
+
+	return usdValue, nil
+}
+
+// getDecimals returns the number of decimals of the token, read from the ESDT system smart contract on first use
+func (tpp *TokenPriceProcessor) getDecimals(ctx context.Context, token string) (uint32, error) {
+	tpp.mutDecimals.RLock()
+	decimals, found := tpp.decimals[token]
+	tpp.mutDecimals.RUnlock()
+	if found {
+		return decimals, nil
+	}
+
+	scQuery := &data.SCQuery{
+		ScAddress: encodeSystemAddress(tpp.pubKeyConverter, common.ESDTContractAddressHex),
+		FuncName:  tokenPropertiesFunc,
+		Arguments: [][]byte{[]byte(token)},
+	}
+	vmOutput, _, err := tpp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return 0, err
+	}
+
+	// the token properties following the name, type, owner, minted and burnt values are of form Name-value
+	decimals = 0
+	for _, property := range vmOutput.ReturnData {
+		name, value, ok := strings.Cut(string(property), tokenPropertySeparator)
+		if !ok || name != numDecimalsProperty {
+			continue
+		}
+
+		parsedDecimals, errParse := strconv.ParseUint(value, 10, 32)
+		if errParse != nil {
+			return 0, fmt.Errorf("invalid number of decimals for %s: %w", token, errParse)
+		}
+		decimals = uint32(parsedDecimals)
+		break
+	}
+
+	tpp.mutDecimals.Lock()
+	tpp.decimals[token] = decimals
+	tpp.mutDecimals.Unlock()
+
+	return decimals, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *TokenPriceProcessor) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,152 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTokenPriceProviderStub(prices map[string]float64) *mock.TokenPriceProviderStub {
+	return &mock.TokenPriceProviderStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetTokenPriceCalled: func(token string) (*data.TokenPrice, error) {
+			price, found := prices[token]
+			if !found {
+				return nil, errors.New("unknown token")
+			}
+
+			return &data.TokenPrice{Token: token, Price: price}, nil
+		},
+	}
+}
+
+func TestNewTokenPriceProcessor(t *testing.T) {
+	t.Parallel()
+
+	tpp, err := process.NewTokenPriceProcessor(nil, &mock.SCQueryServiceStub{}, testPubkeyConverter)
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilTokenPriceProvider, err)
+
+	tpp, err = process.NewTokenPriceProcessor(&mock.TokenPriceProviderStub{}, nil, testPubkeyConverter)
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilSCQueryService, err)
+
+	tpp, err = process.NewTokenPriceProcessor(&mock.TokenPriceProviderStub{}, &mock.SCQueryServiceStub{}, nil)
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	tpp, err = process.NewTokenPriceProcessor(&mock.TokenPriceProviderStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter)
+	require.Nil(t, err)
+	assert.False(t, tpp.IsInterfaceNil())
+	assert.False(t, tpp.IsEnabled())
+}
+
+func TestTokenPriceProcessor_GetTokenPrice(t *testing.T) {
+	t.Parallel()
+
+	tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(map[string]float64{"EGLD": 30}), &mock.SCQueryServiceStub{}, testPubkeyConverter)
+
+	tokenPrice, err := tpp.GetTokenPrice("")
+	assert.Nil(t, tokenPrice)
+	assert.Equal(t, process.ErrEmptyToken, err)
+
+	tokenPrice, err = tpp.GetTokenPrice("EGLD")
+	require.Nil(t, err)
+	assert.Equal(t, 30.0, tokenPrice.Price)
+}
+
+func TestTokenPriceProcessor_GetUsdValue(t *testing.T) {
+	t.Parallel()
+
+	prices := map[string]float64{"EGLD": 30, "USDC-c76f1f": 1, "TKN-abcdef": 0.5}
+
+	t.Run("native token should use 18 decimals without querying the system SC", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, data.BlockInfo{}, nil
+			},
+		}
+		tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(prices), scQueryProc, testPubkeyConverter)
+
+		usdValue, err := tpp.GetUsdValue(context.Background(), "EGLD", "2500000000000000000")
+		require.Nil(t, err)
+		assert.Equal(t, 75.0, usdValue)
+	})
+	t.Run("token decimals should be read once from the system SC", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				atomic.AddUint32(&numQueries, 1)
+				assert.Equal(t, "getTokenProperties", query.FuncName)
+				assert.Equal(t, []byte("USDC-c76f1f"), query.Arguments[0])
+
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{
+						[]byte("WrappedUSDC"),
+						[]byte("FungibleESDT"),
+						[]byte("owner"),
+						[]byte("0"),
+						[]byte("0"),
+						[]byte("NumDecimals-6"),
+						[]byte("IsPaused-false"),
+					},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(prices), scQueryProc, testPubkeyConverter)
+
+		usdValue, err := tpp.GetUsdValue(context.Background(), "USDC-c76f1f", "12500000")
+		require.Nil(t, err)
+		assert.Equal(t, 12.5, usdValue)
+
+		usdValue, err = tpp.GetUsdValue(context.Background(), "USDC-c76f1f", "1000000")
+		require.Nil(t, err)
+		assert.Equal(t, 1.0, usdValue)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(prices), &mock.SCQueryServiceStub{}, testPubkeyConverter)
+
+		_, err := tpp.GetUsdValue(context.Background(), "EGLD", "not a number")
+		assert.True(t, errors.Is(err, process.ErrInvalidAmount))
+	})
+	t.Run("price error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(prices), &mock.SCQueryServiceStub{}, testPubkeyConverter)
+
+		_, err := tpp.GetUsdValue(context.Background(), "MISSING-abcdef", "1")
+		assert.NotNil(t, err)
+	})
+	t.Run("system SC error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("no ticker with given name")
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		tpp, _ := process.NewTokenPriceProcessor(createTokenPriceProviderStub(prices), scQueryProc, testPubkeyConverter)
+
+		_, err := tpp.GetUsdValue(context.Background(), "TKN-abcdef", "1")
+		assert.Equal(t, expectedErr, err)
+	})
+}
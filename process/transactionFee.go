@@ -0,0 +1,90 @@
+package process
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ComputeTransactionFee computes the fee of a transaction from the network economics parameters, without calling
+// any observer. The move balance gas, which covers the data field and the guarded and relayed extras, is paid at
+// the full gas price, while the rest of the gas limit is paid at the gas price reduced by the gas price modifier.
+// The result is the exact fee of a move balance transaction and the maximum fee of a contract call, reached when
+// all the provided gas is consumed
+func (tp *TransactionProcessor) ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFee, error) {
+	gasPriceModifier, err := getGasPriceModifier(networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	moveBalanceGas := computeMoveBalanceGas(tx, networkConfig)
+	if tx.GasLimit < moveBalanceGas {
+		return nil, fmt.Errorf("%w: provided %d, required %d", ErrInsufficientGasLimit, tx.GasLimit, moveBalanceGas)
+	}
+
+	processingGas := tx.GasLimit - moveBalanceGas
+	processingGasPrice := uint64(float64(tx.GasPrice) * gasPriceModifier)
+
+	moveBalanceFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(tx.GasPrice))
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(processingGas), big.NewInt(0).SetUint64(processingGasPrice))
+	fee := big.NewInt(0).Add(moveBalanceFee, processingFee)
+
+	return &data.TransactionFee{
+		Fee:                fee.String(),
+		FeeDenominated:     denominate(fee, networkConfig.Config.Denomination),
+		MoveBalanceGas:     moveBalanceGas,
+		ProcessingGas:      processingGas,
+		ProcessingGasPrice: processingGasPrice,
+	}, nil
+}
+
+func computeMoveBalanceGas(tx *data.Transaction, networkConfig *data.NetworkConfig) uint64 {
+	moveBalanceGas := networkConfig.Config.MinGasLimit + networkConfig.Config.GasPerDataByte*uint64(len(tx.Data))
+	if tx.Options&guardedTxOptionsMask != 0 {
+		moveBalanceGas += networkConfig.Config.ExtraGasLimitGuardedTx
+	}
+	if len(tx.RelayerAddr) > 0 {
+		// the relayer pays an extra move balance for the inner transaction
+		moveBalanceGas += networkConfig.Config.MinGasLimit
+	}
+
+	return moveBalanceGas
+}
+
+// getGasPriceModifier returns the gas price modifier of the network, defaulting to 1 if the observers do not expose it
+func getGasPriceModifier(networkConfig *data.NetworkConfig) (float64, error) {
+	if len(networkConfig.Config.GasPriceModifier) == 0 {
+		return 1, nil
+	}
+
+	gasPriceModifier, err := networkConfig.Config.GasPriceModifier.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGasPriceModifier, err.Error())
+	}
+	if gasPriceModifier <= 0 || gasPriceModifier > 1 {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidGasPriceModifier, gasPriceModifier)
+	}
+
+	return gasPriceModifier, nil
+}
+
+// denominate formats the atomic value using the provided number of decimals, without the trailing zeros
+func denominate(value *big.Int, denomination int) string {
+	if denomination <= 0 {
+		return value.String()
+	}
+
+	divisor := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(denomination)), nil)
+	integerPart, fractionalPart := big.NewInt(0).QuoRem(value, divisor, big.NewInt(0))
+
+	fractional := fractionalPart.String()
+	fractional = strings.Repeat("0", denomination-len(fractional)) + fractional
+	fractional = strings.TrimRight(fractional, "0")
+	if len(fractional) == 0 {
+		return integerPart.String()
+	}
+
+	return integerPart.String() + "." + fractional
+}
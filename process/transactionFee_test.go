@@ -0,0 +1,111 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFeeNetworkConfig() *data.NetworkConfig {
+	networkConfig := createValidationNetworkConfig()
+	networkConfig.Config.GasPriceModifier = "0.01"
+	networkConfig.Config.Denomination = 18
+	networkConfig.Config.ExtraGasLimitGuardedTx = 50000
+
+	return networkConfig
+}
+
+func TestTransactionProcessor_ComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	tp := createValidationTransactionProcessor(t)
+
+	t.Run("move balance should pay the full gas price", func(t *testing.T) {
+		t.Parallel()
+
+		fee, err := tp.ComputeTransactionFee(createValidTransaction(), createFeeNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, &data.TransactionFee{
+			Fee:                "50000000000000",
+			FeeDenominated:     "0.00005",
+			MoveBalanceGas:     50000,
+			ProcessingGas:      0,
+			ProcessingGasPrice: 10000000,
+		}, fee)
+	})
+	t.Run("data field and processing gas should be accounted", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Data = []byte("test")
+		tx.GasLimit = 1056000
+
+		fee, err := tp.ComputeTransactionFee(tx, createFeeNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(56000), fee.MoveBalanceGas)
+		assert.Equal(t, uint64(1000000), fee.ProcessingGas)
+		// 56000 * 10^9 + 1000000 * 10^7
+		assert.Equal(t, "66000000000000", fee.Fee)
+		assert.Equal(t, "0.000066", fee.FeeDenominated)
+	})
+	t.Run("guarded and relayed transactions should pay the extras", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Version = 2
+		tx.Options = 2
+		tx.RelayerAddr = validationReceiver
+		tx.GasLimit = 150000
+
+		fee, err := tp.ComputeTransactionFee(tx, createFeeNetworkConfig())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(150000), fee.MoveBalanceGas)
+		assert.Equal(t, "150000000000000", fee.Fee)
+	})
+	t.Run("missing gas price modifier should use the full gas price", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createFeeNetworkConfig()
+		networkConfig.Config.GasPriceModifier = ""
+		tx := createValidTransaction()
+		tx.GasLimit = 60000
+
+		fee, err := tp.ComputeTransactionFee(tx, networkConfig)
+		require.Nil(t, err)
+		assert.Equal(t, "60000000000000", fee.Fee)
+	})
+	t.Run("insufficient gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Data = []byte("test")
+
+		fee, err := tp.ComputeTransactionFee(tx, createFeeNetworkConfig())
+		require.Nil(t, fee)
+		require.True(t, errors.Is(err, process.ErrInsufficientGasLimit))
+	})
+	t.Run("invalid gas price modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createFeeNetworkConfig()
+		networkConfig.Config.GasPriceModifier = "2"
+
+		fee, err := tp.ComputeTransactionFee(createValidTransaction(), networkConfig)
+		require.Nil(t, fee)
+		require.True(t, errors.Is(err, process.ErrInvalidGasPriceModifier))
+	})
+	t.Run("no denomination should return the atomic value", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createFeeNetworkConfig()
+		networkConfig.Config.Denomination = 0
+
+		fee, err := tp.ComputeTransactionFee(createValidTransaction(), networkConfig)
+		require.Nil(t, err)
+		assert.Equal(t, fee.Fee, fee.FeeDenominated)
+	})
+}
@@ -0,0 +1,114 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// VerifyTransactionHash re-computes the hash of the transaction from the fields returned by the observers and compares
+// it with the returned hash, catching the corrupted or mismatched observer data. Only the transactions signed by users
+// can be verified, as the hash of the ones generated by the protocol, such as the smart contract results or the rewards,
+// can not be computed from the returned fields
+func (tp *TransactionProcessor) VerifyTransactionHash(ctx context.Context, tx *transaction.ApiTransactionResult) (bool, error) {
+	if tx == nil || !isUserSignedTransactionType(tx.Type) {
+		return false, ErrTransactionHashNotVerifiable
+	}
+
+	regularTx, err := tp.buildTransactionFromApiResult(tx)
+	if err != nil {
+		return false, err
+	}
+
+	computedHash, err := core.CalculateHash(tp.marshalizer, tp.hasher, regularTx)
+	if err != nil {
+		return false, err
+	}
+
+	isVerified := hex.EncodeToString(computedHash) == tx.Hash
+	if !isVerified {
+		log.WithContext(ctx).Warn("the hash of the transaction returned by the observers does not match its fields",
+			"hash", tx.Hash,
+			"computed hash", hex.EncodeToString(computedHash))
+	}
+
+	return isVerified, nil
+}
+
+func isUserSignedTransactionType(txType string) bool {
+	return txType == string(transaction.TxTypeNormal) || txType == string(transaction.TxTypeInvalid)
+}
+
+func (tp *TransactionProcessor) buildTransactionFromApiResult(tx *transaction.ApiTransactionResult) (*transaction.Transaction, error) {
+	value := big.NewInt(0)
+	if len(tx.Value) > 0 {
+		_, ok := value.SetString(tx.Value, 10)
+		if !ok {
+			return nil, ErrInvalidTransactionValueField
+		}
+	}
+
+	receiver, err := tp.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	sender, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignatureBytes
+	}
+
+	regularTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     sender,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Signature:   signature,
+		Options:     tx.Options,
+	}
+
+	regularTx.GuardianAddr, err = tp.decodeOptionalAddress(tx.GuardianAddr)
+	if err != nil {
+		return nil, err
+	}
+	regularTx.GuardianSignature, err = hex.DecodeString(tx.GuardianSignature)
+	if err != nil {
+		return nil, ErrInvalidSignatureBytes
+	}
+	regularTx.RelayerAddr, err = tp.decodeOptionalAddress(tx.RelayerAddress)
+	if err != nil {
+		return nil, err
+	}
+	regularTx.RelayerSignature, err = hex.DecodeString(tx.RelayerSignature)
+	if err != nil {
+		return nil, ErrInvalidSignatureBytes
+	}
+
+	return regularTx, nil
+}
+
+func (tp *TransactionProcessor) decodeOptionalAddress(address string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	decoded, err := tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+
+	return decoded, nil
+}
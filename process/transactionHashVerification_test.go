@@ -0,0 +1,121 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createVerifiableApiTransaction(t *testing.T) *transaction.ApiTransactionResult {
+	sender, _ := testPubkeyConverter.Decode(validationSender)
+	receiver, _ := testPubkeyConverter.Decode(validationReceiver)
+	guardian, _ := testPubkeyConverter.Decode(validationReceiver)
+	protoTx := &transaction.Transaction{
+		Nonce:             7,
+		Value:             big.NewInt(1000),
+		RcvAddr:           receiver,
+		SndAddr:           sender,
+		GasPrice:          1000000000,
+		GasLimit:          50000,
+		Data:              []byte("hello"),
+		ChainID:           []byte("T"),
+		Version:           2,
+		Signature:         []byte("signature"),
+		Options:           2,
+		GuardianAddr:      guardian,
+		GuardianSignature: []byte("guardian signature"),
+	}
+	txHash, err := core.CalculateHash(marshalizer, hasher, protoTx)
+	require.NoError(t, err)
+
+	return &transaction.ApiTransactionResult{
+		Type:              string(transaction.TxTypeNormal),
+		Hash:              hex.EncodeToString(txHash),
+		Nonce:             7,
+		Value:             "1000",
+		Receiver:          validationReceiver,
+		Sender:            validationSender,
+		GasPrice:          1000000000,
+		GasLimit:          50000,
+		Data:              []byte("hello"),
+		ChainID:           "T",
+		Version:           2,
+		Signature:         hex.EncodeToString([]byte("signature")),
+		Options:           2,
+		GuardianAddr:      validationReceiver,
+		GuardianSignature: hex.EncodeToString([]byte("guardian signature")),
+		Status:            transaction.TxStatusSuccess,
+		BlockNonce:        100,
+	}
+}
+
+func TestTransactionProcessor_VerifyTransactionHash(t *testing.T) {
+	t.Parallel()
+
+	tp := createValidationTransactionProcessor(t)
+
+	t.Run("nil or protocol generated transaction should not be verifiable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tp.VerifyTransactionHash(context.Background(), nil)
+		assert.Equal(t, process.ErrTransactionHashNotVerifiable, err)
+
+		tx := createVerifiableApiTransaction(t)
+		tx.Type = string(transaction.TxTypeUnsigned)
+		_, err = tp.VerifyTransactionHash(context.Background(), tx)
+		assert.Equal(t, process.ErrTransactionHashNotVerifiable, err)
+	})
+	t.Run("invalid fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createVerifiableApiTransaction(t)
+		tx.Sender = "not an address"
+		_, err := tp.VerifyTransactionHash(context.Background(), tx)
+		assert.Equal(t, process.ErrInvalidAddress, err)
+
+		tx = createVerifiableApiTransaction(t)
+		tx.Value = "not a value"
+		_, err = tp.VerifyTransactionHash(context.Background(), tx)
+		assert.Equal(t, process.ErrInvalidTransactionValueField, err)
+
+		tx = createVerifiableApiTransaction(t)
+		tx.RelayerSignature = "not hex"
+		_, err = tp.VerifyTransactionHash(context.Background(), tx)
+		assert.Equal(t, process.ErrInvalidSignatureBytes, err)
+	})
+	t.Run("matching hash should be verified", func(t *testing.T) {
+		t.Parallel()
+
+		isVerified, err := tp.VerifyTransactionHash(context.Background(), createVerifiableApiTransaction(t))
+		require.NoError(t, err)
+		assert.True(t, isVerified)
+
+		invalidTx := createVerifiableApiTransaction(t)
+		invalidTx.Type = string(transaction.TxTypeInvalid)
+		isVerified, err = tp.VerifyTransactionHash(context.Background(), invalidTx)
+		require.NoError(t, err)
+		assert.True(t, isVerified)
+	})
+	t.Run("altered fields should not be verified", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createVerifiableApiTransaction(t)
+		tx.Value = "1001"
+		isVerified, err := tp.VerifyTransactionHash(context.Background(), tx)
+		require.NoError(t, err)
+		assert.False(t, isVerified)
+
+		tx = createVerifiableApiTransaction(t)
+		tx.Options = 0
+		isVerified, err = tp.VerifyTransactionHash(context.Background(), tx)
+		require.NoError(t, err)
+		assert.False(t, isVerified)
+	})
+}
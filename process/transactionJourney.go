@@ -0,0 +1,145 @@
+package process
+
+import (
+	"context"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// TransactionJourneyStageSource marks the step of the transaction's execution in the source shard
+	TransactionJourneyStageSource = "source"
+	// TransactionJourneyStageDestination marks the step of the transaction's execution in the destination shard
+	TransactionJourneyStageDestination = "destination"
+	// TransactionJourneyStageSmartContractResult marks the step of a smart contract result's execution in its receiver's shard
+	TransactionJourneyStageSmartContractResult = "smartContractResult"
+)
+
+// GetTransactionJourney returns the transaction fetched from both its source and destination shards, with the smart
+// contract results and logs merged, along with the timeline of the blocks which included it and its results. It
+// saves the support teams from reconstructing the processing of a cross-shard transaction by hand
+func (tp *TransactionProcessor) GetTransactionJourney(ctx context.Context, txHash string) (*data.TransactionJourney, error) {
+	const withResults = true
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
+	if err != nil {
+		return nil, err
+	}
+
+	isCrossShard := tx.SourceShard != tx.DestinationShard
+	sourceTx, foundAtSource := tp.getTxFromShard(ctx, txHash, tx.SourceShard, withResults)
+	timeline := []*data.TransactionJourneyStep{
+		newTransactionJourneyStep(TransactionJourneyStageSource, txHash, tx.SourceShard, sourceTx, foundAtSource),
+	}
+
+	if isCrossShard {
+		destinationTx, foundAtDestination := tp.getTxFromShard(ctx, txHash, tx.DestinationShard, withResults)
+		timeline = append(timeline, newTransactionJourneyStep(TransactionJourneyStageDestination, txHash, tx.DestinationShard, destinationTx, foundAtDestination))
+
+		if foundAtSource && foundAtDestination {
+			tx.Logs = tp.mergeLogsHandler.MergeLogEvents(sourceTx.Logs, destinationTx.Logs)
+		}
+	}
+
+	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
+	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
+
+	return &data.TransactionJourney{
+		Transaction:  tx,
+		IsCrossShard: isCrossShard,
+		Timeline:     append(timeline, tp.getSmartContractResultsJourneySteps(ctx, tx.SmartContractResults)...),
+	}, nil
+}
+
+// getSmartContractResultsJourneySteps fetches each smart contract result from its receiver's shard, returning them in
+// the order of their blocks' timestamps, the ones still pending being placed last
+func (tp *TransactionProcessor) getSmartContractResultsJourneySteps(ctx context.Context, scrs []*transaction.ApiSmartContractResult) []*data.TransactionJourneyStep {
+	steps := make([]*data.TransactionJourneyStep, 0, len(scrs))
+	for _, scr := range scrs {
+		shardID, err := tp.getShardByAddress(scr.RcvAddr)
+		if err != nil {
+			log.WithContext(ctx).Warn("cannot compute shard ID from smart contract result receiver",
+				"receiver address", scr.RcvAddr,
+				"error", err.Error())
+		}
+
+		var scrTx *transaction.ApiTransactionResult
+		found := false
+		if err == nil {
+			scrTx, found = tp.getTxFromShard(ctx, scr.Hash, shardID, false)
+		}
+
+		step := newTransactionJourneyStep(TransactionJourneyStageSmartContractResult, scr.Hash, shardID, scrTx, found)
+		step.Sender = scr.SndAddr
+		step.Receiver = scr.RcvAddr
+		steps = append(steps, step)
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].Pending != steps[j].Pending {
+			return !steps[i].Pending
+		}
+		if steps[i].Timestamp != steps[j].Timestamp {
+			return steps[i].Timestamp < steps[j].Timestamp
+		}
+
+		return steps[i].Hash < steps[j].Hash
+	})
+
+	return steps
+}
+
+func (tp *TransactionProcessor) getTxFromShard(ctx context.Context, txHash string, shardID uint32, withResults bool) (*transaction.ApiTransactionResult, bool) {
+	observers, err := tp.getNodesInShard(shardID, requestTypeFullHistoryNodes)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, observer := range observers {
+		getTxResponse, ok, withHttpError := tp.getTxFromObserver(ctx, observer, txHash, withResults)
+		if ok {
+			return &getTxResponse.Data.Transaction, true
+		}
+		if !withHttpError {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+func newTransactionJourneyStep(
+	stage string,
+	hash string,
+	shardID uint32,
+	tx *transaction.ApiTransactionResult,
+	found bool,
+) *data.TransactionJourneyStep {
+	step := &data.TransactionJourneyStep{
+		Stage:   stage,
+		Hash:    hash,
+		ShardID: shardID,
+		Pending: true,
+	}
+	if !found {
+		return step
+	}
+
+	step.Sender = tx.Sender
+	step.Receiver = tx.Receiver
+	step.BlockNonce = tx.BlockNonce
+	step.BlockHash = tx.BlockHash
+	step.MiniBlockHash = tx.MiniBlockHash
+	step.Timestamp = tx.Timestamp
+	step.Pending = len(tx.BlockHash) == 0
+
+	step.NotarizedInMetaNonce = tx.NotarizedAtDestinationInMetaNonce
+	step.NotarizedInMetaHash = tx.NotarizedAtDestinationInMetaHash
+	if stage == TransactionJourneyStageSource {
+		step.NotarizedInMetaNonce = tx.NotarizedAtSourceInMetaNonce
+		step.NotarizedInMetaHash = tx.NotarizedAtSourceInMetaHash
+	}
+
+	return step
+}
@@ -0,0 +1,220 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionJourneyProcessor(t *testing.T, responses map[string]map[string]*transaction.ApiTransactionResult) *process.TransactionProcessor {
+	observersInShard := func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+		return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+	}
+
+	tp, err := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				if string(addressBuff) == "bbbb" {
+					return 1, nil
+				}
+				return 0, nil
+			},
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled:        observersInShard,
+			GetFullHistoryNodesCalled: observersInShard,
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				getTxResponse, ok := value.(*data.GetTransactionResponse)
+				if !ok {
+					return http.StatusOK, nil
+				}
+
+				hash := strings.Split(strings.TrimPrefix(path, process.TransactionPath), "?")[0]
+				tx, found := responses[address][hash]
+				if !found {
+					return http.StatusNotFound, nil
+				}
+
+				getTxResponse.Data.Transaction = *tx
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	require.NoError(t, err)
+
+	return tp
+}
+
+func TestTransactionProcessor_GetTransactionJourney(t *testing.T) {
+	t.Parallel()
+
+	sndShard0 := hex.EncodeToString([]byte("aaaa"))
+	rcvShard1 := hex.EncodeToString([]byte("bbbb"))
+
+	t.Run("transaction not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionJourneyProcessor(t, nil)
+
+		journey, err := tp.GetTransactionJourney(context.Background(), "txHash")
+		require.Nil(t, journey)
+		require.Equal(t, errors.ErrTransactionNotFound, err)
+	})
+	t.Run("intra shard transaction should return only the source step", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionJourneyProcessor(t, map[string]map[string]*transaction.ApiTransactionResult{
+			"observer0": {
+				"txHash": {
+					Hash:                              "txHash",
+					Sender:                            sndShard0,
+					Receiver:                          sndShard0,
+					BlockNonce:                        10,
+					BlockHash:                         "blockHash0",
+					Timestamp:                         100,
+					NotarizedAtSourceInMetaNonce:      15,
+					NotarizedAtDestinationInMetaNonce: 15,
+				},
+			},
+		})
+
+		journey, err := tp.GetTransactionJourney(context.Background(), "txHash")
+		require.NoError(t, err)
+		require.False(t, journey.IsCrossShard)
+		require.Equal(t, uint64(15), journey.Transaction.HyperblockNonce)
+		require.Equal(t, []*data.TransactionJourneyStep{
+			{
+				Stage:                process.TransactionJourneyStageSource,
+				Hash:                 "txHash",
+				ShardID:              0,
+				Sender:               sndShard0,
+				Receiver:             sndShard0,
+				BlockNonce:           10,
+				BlockHash:            "blockHash0",
+				NotarizedInMetaNonce: 15,
+				Timestamp:            100,
+			},
+		}, journey.Timeline)
+	})
+	t.Run("cross shard transaction should merge the views of both shards", func(t *testing.T) {
+		t.Parallel()
+
+		scrExecuted := &transaction.ApiSmartContractResult{Hash: "scrExecuted", SndAddr: rcvShard1, RcvAddr: sndShard0}
+		scrPending := &transaction.ApiSmartContractResult{Hash: "scrPending", SndAddr: sndShard0, RcvAddr: rcvShard1}
+		tp := createTransactionJourneyProcessor(t, map[string]map[string]*transaction.ApiTransactionResult{
+			"observer0": {
+				"txHash": {
+					Hash:                         "txHash",
+					Sender:                       sndShard0,
+					Receiver:                     rcvShard1,
+					SourceShard:                  0,
+					DestinationShard:             1,
+					BlockNonce:                   10,
+					BlockHash:                    "blockHash0",
+					Timestamp:                    100,
+					NotarizedAtSourceInMetaNonce: 15,
+					SmartContractResults:         []*transaction.ApiSmartContractResult{scrPending},
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{{Identifier: "sourceEvent"}},
+					},
+				},
+				"scrExecuted": {
+					Hash:       "scrExecuted",
+					BlockNonce: 11,
+					BlockHash:  "blockHash0-scr",
+					Timestamp:  300,
+				},
+			},
+			"observer1": {
+				"txHash": {
+					Hash:                              "txHash",
+					Sender:                            sndShard0,
+					Receiver:                          rcvShard1,
+					SourceShard:                       0,
+					DestinationShard:                  1,
+					BlockNonce:                        12,
+					BlockHash:                         "blockHash1",
+					Timestamp:                         200,
+					NotarizedAtDestinationInMetaNonce: 20,
+					SmartContractResults:              []*transaction.ApiSmartContractResult{scrExecuted},
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{{Identifier: "destinationEvent"}},
+					},
+				},
+			},
+		})
+
+		journey, err := tp.GetTransactionJourney(context.Background(), "txHash")
+		require.NoError(t, err)
+		require.True(t, journey.IsCrossShard)
+		require.Len(t, journey.Transaction.SmartContractResults, 2)
+		require.Len(t, journey.Transaction.Logs.Events, 2)
+		require.Equal(t, []*data.TransactionJourneyStep{
+			{
+				Stage:                process.TransactionJourneyStageSource,
+				Hash:                 "txHash",
+				ShardID:              0,
+				Sender:               sndShard0,
+				Receiver:             rcvShard1,
+				BlockNonce:           10,
+				BlockHash:            "blockHash0",
+				NotarizedInMetaNonce: 15,
+				Timestamp:            100,
+			},
+			{
+				Stage:                process.TransactionJourneyStageDestination,
+				Hash:                 "txHash",
+				ShardID:              1,
+				Sender:               sndShard0,
+				Receiver:             rcvShard1,
+				BlockNonce:           12,
+				BlockHash:            "blockHash1",
+				NotarizedInMetaNonce: 20,
+				Timestamp:            200,
+			},
+			{
+				Stage:      process.TransactionJourneyStageSmartContractResult,
+				Hash:       "scrExecuted",
+				ShardID:    0,
+				Sender:     rcvShard1,
+				Receiver:   sndShard0,
+				BlockNonce: 11,
+				BlockHash:  "blockHash0-scr",
+				Timestamp:  300,
+			},
+			{
+				Stage:    process.TransactionJourneyStageSmartContractResult,
+				Hash:     "scrPending",
+				ShardID:  1,
+				Sender:   sndShard0,
+				Receiver: rcvShard1,
+				Pending:  true,
+			},
+		}, journey.Timeline)
+	})
+}
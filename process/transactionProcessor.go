@@ -1,10 +1,15 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -86,6 +91,13 @@ type TransactionProcessor struct {
 	mergeLogsHandler             LogsMergerHandler
 	shouldAllowEntireTxPoolFetch bool
 	txNotarizationChecker        TxNotarizationCheckerHandler
+	txStatusCache                TxStatusCacher
+	sentTxsCache                 SentTxsCacher
+	txsPolicyChecker             TransactionsPolicyChecker
+	txBroadcastFanout            int
+	eventsABIRegistry            EventsABIRegistry
+	nonceGapWarningThreshold     uint64
+	readOnlyMode                 atomic.Bool
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -98,6 +110,12 @@ func NewTransactionProcessor(
 	logsMerger LogsMergerHandler,
 	allowEntireTxPoolFetch bool,
 	txNotarizationChecker TxNotarizationCheckerHandler,
+	txStatusCache TxStatusCacher,
+	sentTxsCache SentTxsCacher,
+	txsPolicyChecker TransactionsPolicyChecker,
+	txBroadcastFanout int,
+	eventsABIRegistry EventsABIRegistry,
+	nonceGapWarningThreshold uint64,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -120,6 +138,21 @@ func NewTransactionProcessor(
 	if check.IfNil(txNotarizationChecker) {
 		return nil, ErrNilTxNotarizationCheckerHandler
 	}
+	if check.IfNil(txStatusCache) {
+		return nil, ErrNilTxStatusCache
+	}
+	if check.IfNil(sentTxsCache) {
+		return nil, ErrNilSentTxsCache
+	}
+	if check.IfNil(txsPolicyChecker) {
+		return nil, ErrNilTransactionsPolicyChecker
+	}
+	if txBroadcastFanout < 1 {
+		return nil, ErrInvalidTxBroadcastFanout
+	}
+	if check.IfNil(eventsABIRegistry) {
+		return nil, ErrNilEventsABIRegistry
+	}
 
 	// no reason to get this from configs. If we are going to change the marshaller for the relayed transaction v1,
 	// we will need also an enable epoch handler
@@ -134,59 +167,238 @@ func NewTransactionProcessor(
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
 		relayedTxsMarshaller:         relayedTxsMarshaller,
 		txNotarizationChecker:        txNotarizationChecker,
+		txStatusCache:                txStatusCache,
+		sentTxsCache:                 sentTxsCache,
+		txsPolicyChecker:             txsPolicyChecker,
+		txBroadcastFanout:            txBroadcastFanout,
+		eventsABIRegistry:            eventsABIRegistry,
+		nonceGapWarningThreshold:     nonceGapWarningThreshold,
 	}, nil
 }
 
-// SendTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
+// SetReadOnlyMode enables or disables the read-only mode, in which the transactions sending endpoints are rejected
+func (tp *TransactionProcessor) SetReadOnlyMode(enabled bool) {
+	tp.readOnlyMode.Store(enabled)
+}
+
+// IsReadOnlyModeEnabled returns true if the proxy runs in read-only mode
+func (tp *TransactionProcessor) IsReadOnlyModeEnabled() bool {
+	return tp.readOnlyMode.Load()
+}
+
+// SendTransaction relays the post request by sending the request to the right observer and replies back the answer.
+// An identical signed transaction already relayed, or still being relayed, during the deduplication window is not
+// broadcast again, its hash being returned as already submitted. If a broadcast fanout is configured, the transaction is first sent in parallel to that
+// many observers of the shard, the next ones being tried one by one only if none of them accepted it. A transaction
+// whose nonce is too far above the sender's account nonce is still relayed, but with a warning in the response
+func (tp *TransactionProcessor) SendTransaction(ctx context.Context, tx *data.Transaction) (int, *data.SentTransaction, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
-		return http.StatusBadRequest, "", err
+		return http.StatusBadRequest, nil, err
 	}
 
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
-		return http.StatusBadRequest, "", err
+		return http.StatusBadRequest, nil, err
+	}
+
+	// the transactions which cannot be hashed here are relayed as they are, the observers being the ones to reject them
+	computedTxHash, errHash := tp.ComputeTransactionHash(tx)
+	canBeDeduplicated := errHash == nil
+	isSent := false
+	if canBeDeduplicated {
+		if !tp.sentTxsCache.TryMarkSent(computedTxHash) {
+			log.WithContext(ctx).Debug("transaction already submitted, not relayed again", "hash", computedTxHash)
+			return http.StatusOK, &data.SentTransaction{
+				TxHash:           computedTxHash,
+				HashSource:       data.TxHashSourceComputed,
+				AlreadySubmitted: true,
+			}, nil
+		}
+
+		// the transaction is marked as sent before being relayed, so the mark is removed if no observer accepted it
+		defer func() {
+			if !isSent {
+				tp.sentTxsCache.UnmarkSent(computedTxHash)
+			}
+		}()
 	}
 
 	shardID, err := tp.proc.ComputeShardId(senderBuff)
 	if err != nil {
-		return http.StatusInternalServerError, "", err
+		return http.StatusInternalServerError, nil, err
 	}
 
-	observers, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	observers, err := tp.proc.GetObserversForWrite(shardID, data.AvailabilityRecent)
 	if err != nil {
-		return http.StatusInternalServerError, "", err
+		return http.StatusInternalServerError, nil, err
+	}
+
+	onTransactionSent := func(observerAddress string, txHash string) *data.SentTransaction {
+		log.WithContext(ctx).Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
+			observerAddress,
+			shardID,
+			txHash,
+		))
+		isSent = true
+		tp.proc.RecordWriteObserver(tx.Sender, observerAddress)
+
+		sentTx := newSentTransaction(txHash, computedTxHash)
+		sentTx.Warning = tp.checkNonceGap(ctx, observerAddress, tx)
+
+		return sentTx
 	}
 
 	txResponse := data.ResponseTransaction{}
+	numBroadcastObservers := core.MinInt(tp.txBroadcastFanout, len(observers))
+	if numBroadcastObservers > 1 {
+		result := tp.broadcastTransaction(ctx, observers[:numBroadcastObservers], tx)
+		if result.isSent() {
+			return result.respCode, onTransactionSent(result.observerAddress, result.txHash), nil
+		}
+		if !result.isObserverDown() {
+			return result.respCode, nil, result.err
+		}
+
+		txResponse.Error = result.responseError
+		observers = observers[numBroadcastObservers:]
+	}
+
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionSendPath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
-			log.Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
-				observer.Address,
-				shardID,
-				txResponse.Data.TxHash,
-			))
-			return respCode, txResponse.Data.TxHash, nil
+			return respCode, onTransactionSent(observer.Address, txResponse.Data.TxHash), nil
 		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
 		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
-			log.LogIfError(err)
+			log.WithContext(ctx).LogIfError(err)
 			continue
 		}
 
 		// if the request was bad, return the error message
-		return respCode, "", err
+		return respCode, nil, err
 	}
 
-	return http.StatusInternalServerError, "", WrapObserversError(txResponse.Error)
+	return http.StatusInternalServerError, nil, WrapObserversError(txResponse.Error)
+}
+
+// checkNonceGap returns a warning if the nonce of the sent transaction is more than the configured threshold above the
+// sender's account nonce, as read from the observer which accepted it. Such a transaction waits in the pool until all
+// the nonces in between are sent, which usually signals a wallet bug rather than an intended queue of transactions
+func (tp *TransactionProcessor) checkNonceGap(ctx context.Context, observerAddress string, tx *data.Transaction) string {
+	if tp.nonceGapWarningThreshold == 0 {
+		return ""
+	}
+
+	accountResponse := data.AccountApiResponse{}
+	_, err := tp.proc.CallGetRestEndPoint(ctx, observerAddress, addressPath+tx.Sender, &accountResponse)
+	if err != nil {
+		log.WithContext(ctx).Debug("cannot get the account nonce for the nonce gap check", "sender", tx.Sender, "error", err.Error())
+		return ""
+	}
+
+	accountNonce := accountResponse.Data.Account.Nonce
+	if tx.Nonce <= accountNonce || tx.Nonce-accountNonce <= tp.nonceGapWarningThreshold {
+		return ""
+	}
+
+	return fmt.Sprintf("the transaction nonce %d is %d above the current account nonce %d: the transaction will not "+
+		"be executed until all the nonces in between are sent", tx.Nonce, tx.Nonce-accountNonce, accountNonce)
+}
+
+// newSentTransaction returns the hash received from the observer, falling back to the locally computed one if the
+// observer omitted it, as the older observer versions do
+func newSentTransaction(observerTxHash string, computedTxHash string) *data.SentTransaction {
+	if len(observerTxHash) > 0 {
+		return &data.SentTransaction{TxHash: observerTxHash, HashSource: data.TxHashSourceObserver}
+	}
+	if len(computedTxHash) > 0 {
+		return &data.SentTransaction{TxHash: computedTxHash, HashSource: data.TxHashSourceComputed}
+	}
+
+	log.Warn("the observer did not return the hash of the sent transaction and it could not be computed")
+	return &data.SentTransaction{}
+}
+
+type broadcastResult struct {
+	observerAddress string
+	respCode        int
+	txHash          string
+	responseError   string
+	err             error
+}
+
+func (result *broadcastResult) isSent() bool {
+	return result.respCode == http.StatusOK && result.err == nil
+}
+
+func (result *broadcastResult) isObserverDown() bool {
+	return result.respCode == http.StatusNotFound || result.respCode == http.StatusRequestTimeout
+}
+
+// broadcastTransaction sends the transaction to all the provided observers in parallel and returns the first successful
+// result, the others being only logged once received. If none succeeded, an error returned by an observer which was up
+// is preferred, as it means the transaction itself was rejected
+func (tp *TransactionProcessor) broadcastTransaction(ctx context.Context, observers []*data.NodeData, tx *data.Transaction) *broadcastResult {
+	results := make(chan *broadcastResult, len(observers))
+	for _, observer := range observers {
+		go func(observerAddress string) {
+			txResponse := data.ResponseTransaction{}
+			respCode, err := tp.proc.CallPostRestEndPoint(ctx, observerAddress, TransactionSendPath, tx, &txResponse)
+			results <- &broadcastResult{
+				observerAddress: observerAddress,
+				respCode:        respCode,
+				txHash:          txResponse.Data.TxHash,
+				responseError:   txResponse.Error,
+				err:             err,
+			}
+		}(observer.Address)
+	}
+
+	var failedResult *broadcastResult
+	for numReceived := 1; numReceived <= len(observers); numReceived++ {
+		result := <-results
+		if result.isSent() {
+			go logRemainingBroadcastResults(results, len(observers)-numReceived)
+			return result
+		}
+
+		logBroadcastResult(result)
+		if failedResult == nil || failedResult.isObserverDown() {
+			failedResult = result
+		}
+	}
+
+	return failedResult
+}
+
+func logRemainingBroadcastResults(results chan *broadcastResult, numRemaining int) {
+	for i := 0; i < numRemaining; i++ {
+		logBroadcastResult(<-results)
+	}
+}
+
+func logBroadcastResult(result *broadcastResult) {
+	if result.isSent() {
+		log.Debug("transaction also accepted by observer", "observer", result.observerAddress, "hash", result.txHash)
+		return
+	}
+
+	errMessage := result.responseError
+	if result.err != nil {
+		errMessage = result.err.Error()
+	}
+	log.Debug("transaction broadcast to observer failed",
+		"observer", result.observerAddress,
+		"code", result.respCode,
+		"error", errMessage,
+	)
 }
 
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tp *TransactionProcessor) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -207,7 +419,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx, checkSignature)
+	response, err := tp.simulateTransaction(ctx, observers, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
@@ -235,7 +447,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
+	responseFromReceiverShard, err := tp.simulateTransaction(ctx, observersForReceiverShard, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
@@ -253,7 +465,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 	}, nil
 }
 
-func (tp *TransactionProcessor) simulateTransaction(
+func (tp *TransactionProcessor) simulateTransaction(ctx context.Context,
 	observers []*data.NodeData,
 	tx *data.Transaction,
 	checkSignature bool,
@@ -266,9 +478,9 @@ func (tp *TransactionProcessor) simulateTransaction(
 	txResponse := data.ResponseTransactionSimulation{}
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, txSimulatePath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, txSimulatePath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
-			log.Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
+			log.WithContext(ctx).Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
 				observer.ShardId,
 				txResponse.Data.Result.Hash,
@@ -278,7 +490,7 @@ func (tp *TransactionProcessor) simulateTransaction(
 
 		// if observer was down (or didn't respond in time), skip to the next one
 		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
-			log.LogIfError(err)
+			log.WithContext(ctx).LogIfError(err)
 			continue
 		}
 
@@ -289,69 +501,122 @@ func (tp *TransactionProcessor) simulateTransaction(
 	return nil, WrapObserversError(txResponse.Error)
 }
 
-// SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
-func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
+// SendMultipleTransactions relays the transactions to the observers of their senders' shards. The outcome of each
+// transaction is reported by its index in the provided list, so that the failed ones can be identified and sent again
+func (tp *TransactionProcessor) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
-	// TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
-	// breaks the function and returns nothing (but an error) even if some transactions were actually sent, successfully.
+	results := make([]*data.TransactionSendResult, len(txs))
+	txsByShardID := make(map[uint32][]*data.Transaction)
+	for i, currentTx := range txs {
+		currentTx.Index = i
+		results[i] = &data.TransactionSendResult{Index: i}
 
-	totalTxsSent := uint64(0)
-	txsToSend := make([]*data.Transaction, 0)
-	for i := 0; i < len(txs); i++ {
-		currentTx := txs[i]
 		err := tp.checkTransactionFields(currentTx)
 		if err != nil {
-			log.Warn("invalid tx received",
+			log.WithContext(ctx).Warn("invalid tx received",
 				"sender", currentTx.Sender,
 				"receiver", currentTx.Receiver,
 				"error", err)
+			results[i].Error = err.Error()
 			continue
 		}
-		txsToSend = append(txsToSend, currentTx)
+
+		shardID, err := tp.computeSenderShardID(currentTx)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		results[i].ShardID = &shardID
+		txsByShardID[shardID] = append(txsByShardID[shardID], currentTx)
 	}
-	if len(txsToSend) == 0 {
-		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+	if len(txsByShardID) == 0 {
+		// the results are returned along with the error, so that the clients know why each transaction was rejected
+		return data.MultipleTransactionsResponseData{
+			TxsHashes: make(map[int]string),
+			Results:   results,
+		}, ErrNoValidTransactionToSend
 	}
 
-	txsHashes := make(map[int]string)
-	txsByShardID := tp.groupTxsByShard(txsToSend)
+	totalTxsSent := uint64(0)
 	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
-		if err != nil {
-			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
-		}
-
-		for _, observer := range observersInShard {
-			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
-			if respCode == http.StatusOK && err == nil {
-				log.Info("transactions sent",
-					"observer", observer.Address,
-					"shard ID", shardID,
-					"total processed", txResponse.Data.NumOfTxs,
-				)
-				totalTxsSent += txResponse.Data.NumOfTxs
-
-				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
-				}
-
-				break
-			}
+		totalTxsSent += tp.sendTransactionsInShard(ctx, shardID, groupOfTxs, results)
+	}
 
-			log.LogIfError(err)
+	txsHashes := make(map[int]string)
+	for _, result := range results {
+		if len(result.Hash) > 0 {
+			txsHashes[result.Index] = result.Hash
 		}
 	}
 
 	return data.MultipleTransactionsResponseData{
 		NumOfTxs:  totalTxsSent,
 		TxsHashes: txsHashes,
+		Results:   results,
 	}, nil
 }
 
+// sendTransactionsInShard sends the transactions to the first observer of the shard accepting the request, filling
+// the result of each transaction. It returns the number of transactions accepted by the observer
+func (tp *TransactionProcessor) sendTransactionsInShard(ctx context.Context, shardID uint32, txs []*data.Transaction, results []*data.TransactionSendResult) uint64 {
+	observersInShard, err := tp.proc.GetObserversForWrite(shardID, data.AvailabilityRecent)
+	if err != nil {
+		setTransactionsSendError(txs, results, "", fmt.Errorf("%w: %s", ErrMissingObserver, err.Error()))
+		return 0
+	}
+
+	lastErr := ErrMissingObserver
+	lastObserver := ""
+	for _, observer := range observersInShard {
+		txResponse := &data.ResponseMultipleTransactions{}
+		respCode, errPost := tp.proc.CallPostRestEndPoint(ctx, observer.Address, MultipleTransactionsPath, txs, txResponse)
+		if respCode == http.StatusOK && errPost == nil {
+			log.WithContext(ctx).Info("transactions sent",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"total processed", txResponse.Data.NumOfTxs,
+			)
+
+			for key, tx := range txs {
+				result := results[tx.Index]
+				result.Observer = observer.Address
+
+				hash, found := txResponse.Data.TxsHashes[key]
+				if !found {
+					result.Error = ErrTransactionRejectedByObserver.Error()
+					continue
+				}
+				result.Hash = hash
+				tp.proc.RecordWriteObserver(tx.Sender, observer.Address)
+			}
+
+			return txResponse.Data.NumOfTxs
+		}
+
+		log.WithContext(ctx).LogIfError(errPost)
+		lastErr = errPost
+		if lastErr == nil {
+			lastErr = fmt.Errorf("%w: status code %d %s", ErrSendingRequest, respCode, txResponse.Error)
+		}
+		lastObserver = observer.Address
+	}
+
+	setTransactionsSendError(txs, results, lastObserver, lastErr)
+
+	return 0
+}
+
+func setTransactionsSendError(txs []*data.Transaction, results []*data.TransactionSendResult, observer string, err error) {
+	for _, tx := range txs {
+		results[tx.Index].Observer = observer
+		results[tx.Index].Error = err.Error()
+	}
+}
+
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tp *TransactionProcessor) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -362,12 +627,12 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 		return nil, err
 	}
 
-	return newTxCostProcessor.ResolveCostRequest(tx)
+	return newTxCostProcessor.ResolveCostRequest(ctx, tx)
 }
 
 // GetTransaction should return a transaction from observer
-func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+func (tp *TransactionProcessor) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
 	if err != nil {
 		return nil, err
 	}
@@ -378,13 +643,24 @@ func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool)
 	return tx, nil
 }
 
+// GetTransactionOutcome returns the parsed outcome of a smart contract call transaction
+func (tp *TransactionProcessor) GetTransactionOutcome(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+	const withResults = true
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTransactionOutcome(tx)
+}
+
 // GetTransactionByHashAndSenderAddress returns a transaction
-func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
+func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(ctx context.Context,
 	txHash string,
 	sndAddr string,
 	withResults bool,
 ) (*transaction.ApiTransactionResult, int, error) {
-	tx, err := tp.getTxWithSenderAddr(txHash, sndAddr, withResults)
+	tx, err := tp.getTxWithSenderAddr(ctx, txHash, sndAddr, withResults)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
@@ -392,6 +668,31 @@ func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
 	return tx, http.StatusOK, nil
 }
 
+// ComputeContractAddress returns the address of the contract that will be deployed by the provided deployer using
+// the provided nonce, along with the shard the contract will reside in
+func (tp *TransactionProcessor) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	deployerBytes, err := tp.pubKeyConverter.Decode(deployer)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	contractAddressBytes := computeContractAddress(deployerBytes, nonce)
+	shardID, err := tp.proc.ComputeShardId(contractAddressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddress, err := tp.pubKeyConverter.Encode(contractAddressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ContractAddress{
+		Address: contractAddress,
+		ShardID: shardID,
+	}, nil
+}
+
 func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error) {
 	var shardID uint32
 	if metachainIDStr := fmt.Sprintf("%d", core.MetachainShardId); address != metachainIDStr {
@@ -412,38 +713,45 @@ func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error
 }
 
 // GetTransactionStatus returns the status of a transaction
-func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender string) (string, error) {
-	tx, err := tp.getTransaction(txHash, sender, false)
+func (tp *TransactionProcessor) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	status, found := tp.txStatusCache.Get(txHash)
+	if found {
+		return string(status), nil
+	}
+
+	tx, err := tp.getTransaction(ctx, txHash, sender, false)
 	if err != nil {
 		return string(data.TxStatusUnknown), err
 	}
 
+	tp.txStatusCache.Put(txHash, tx.Status)
+
 	return string(tx.Status), nil
 }
 
-func (tp *TransactionProcessor) getTransaction(txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTransaction(ctx context.Context, txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	if sender != "" {
-		return tp.getTxWithSenderAddr(txHash, sender, withResults)
+		return tp.getTxWithSenderAddr(ctx, txHash, sender, withResults)
 	}
 
 	// get status of transaction from random observers
-	return tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	return tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 }
 
 // GetProcessedTransactionStatus returns the status of a transaction after local processing
-func (tp *TransactionProcessor) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tp *TransactionProcessor) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	const withResults = true
-	tx, err := tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 	if err != nil {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
 		}, err
 	}
 
-	return tp.computeTransactionStatus(tx, withResults), nil
+	return tp.computeTransactionStatus(ctx, tx, withResults), nil
 }
 
-func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
+func (tp *TransactionProcessor) computeTransactionStatus(ctx context.Context, tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
 	if !withResults {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
@@ -467,9 +775,9 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 		}
 	}
 
-	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(tx)
+	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(ctx, tx)
 	if err != nil {
-		log.Warn("error in TransactionProcessor.computeTransactionStatus", "error", err)
+		log.WithContext(ctx).Warn("error in TransactionProcessor.computeTransactionStatus", "error", err)
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
 		}
@@ -492,7 +800,7 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 
 	allLogs, err = tp.addMissingLogsOnProcessingExceptions(tx, allLogs, allScrs)
 	if err != nil {
-		log.Warn("error in TransactionProcessor.computeTransactionStatus on addMissingLogsOnProcessingExceptions call", "error", err)
+		log.WithContext(ctx).Warn("error in TransactionProcessor.computeTransactionStatus on addMissingLogsOnProcessingExceptions call", "error", err)
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
 		}
@@ -571,26 +879,26 @@ func isZeroValue(value string) bool {
 }
 
 func checkIfFailed(logs []*transaction.ApiLogs) (bool, string) {
-	found, reason := findIdentifierInLogs(logs, internalVMErrorsEventIdentifier)
+	event, found := findIdentifierInLogs(logs, internalVMErrorsEventIdentifier)
 	if found {
-		return true, reason
+		return true, string(event.Data)
 	}
 
-	found, reason = findIdentifierInLogs(logs, core.SignalErrorOperation)
+	event, found = findIdentifierInLogs(logs, core.SignalErrorOperation)
 	if found {
-		return true, reason
+		return true, string(event.Data)
 	}
 
 	return false, emptyDataStr
 }
 
 func checkIfCompleted(logs []*transaction.ApiLogs) bool {
-	found, _ := findIdentifierInLogs(logs, core.CompletedTxEventIdentifier)
+	_, found := findIdentifierInLogs(logs, core.CompletedTxEventIdentifier)
 	if found {
 		return true
 	}
 
-	found, _ = findIdentifierInLogs(logs, core.SCDeployIdentifier)
+	_, found = findIdentifierInLogs(logs, core.SCDeployIdentifier)
 	return found
 }
 
@@ -678,36 +986,33 @@ func (tp *TransactionProcessor) isRelayedMoveBalanceTransaction(
 	return innerIsMoveBalance, nil
 }
 
-func findIdentifierInLogs(logs []*transaction.ApiLogs, identifier string) (bool, string) {
-	if len(logs) == 0 {
-		return false, emptyDataStr
-	}
-
+// findIdentifierInLogs returns the first event having the provided identifier, if any
+func findIdentifierInLogs(logs []*transaction.ApiLogs, identifier string) (*transaction.Events, bool) {
 	for _, logInstance := range logs {
 		if logInstance == nil {
 			continue
 		}
 
-		found, reason := findIdentifierInSingleLog(logInstance, identifier)
+		event, found := findIdentifierInSingleLog(logInstance, identifier)
 		if found {
-			return true, string(reason)
+			return event, true
 		}
 	}
 
-	return false, emptyDataStr
+	return nil, false
 }
 
-func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (bool, []byte) {
+func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (*transaction.Events, bool) {
 	for _, event := range log.Events {
-		if event.Identifier == identifier {
-			return true, event.Data
+		if event != nil && event.Identifier == identifier {
+			return event, true
 		}
 	}
 
-	return false, []byte(emptyDataStr)
+	return nil, false
 }
 
-func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) gatherAllLogsAndScrs(ctx context.Context, tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
 	const withResults = true
 	allLogs := make([]*transaction.ApiLogs, 0)
 	allScrs := make([]*transaction.ApiTransactionResult, 0)
@@ -717,7 +1022,7 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	}
 
 	for _, scrFromTx := range tx.SmartContractResults {
-		scr, err := tp.GetTransaction(scrFromTx.Hash, withResults)
+		scr, err := tp.GetTransaction(ctx, scrFromTx.Hash, withResults)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%w for scr hash %s", err, scrFromTx.Hash)
 		}
@@ -736,7 +1041,7 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	return allLogs, allScrs, nil
 }
 
-func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxFromObservers(ctx context.Context, txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observersShardIDs := tp.proc.GetShardIDs()
 	shardIDWasFetch := make(map[uint32]*tupleHashWasFetched)
 	for _, observerShardID := range observersShardIDs {
@@ -749,7 +1054,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		var withHttpError bool
 		var ok bool
 		for _, observerInShard := range nodesInShard {
-			getTxResponse, ok, withHttpError = tp.getTxFromObserver(observerInShard, txHash, withResults)
+			getTxResponse, ok, withHttpError = tp.getTxFromObserver(ctx, observerInShard, txHash, withResults)
 			if !withHttpError {
 				break
 			}
@@ -761,7 +1066,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 
 		sndShardID, err := tp.getShardByAddress(getTxResponse.Data.Transaction.Sender)
 		if err != nil {
-			log.Warn("cannot compute shard ID from sender address",
+			log.WithContext(ctx).Warn("cannot compute shard ID from sender address",
 				"sender address", getTxResponse.Data.Transaction.Sender,
 				"error", err.Error())
 		}
@@ -772,7 +1077,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 
 		rcvShardID, err := tp.getShardByAddress(getTxResponse.Data.Transaction.Receiver)
 		if err != nil {
-			log.Warn("cannot compute shard ID from receiver address",
+			log.WithContext(ctx).Warn("cannot compute shard ID from receiver address",
 				"receiver address", getTxResponse.Data.Transaction.Receiver,
 				"error", err.Error())
 		}
@@ -796,11 +1101,11 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		if observerIsInDestShard {
 			// need to get transaction from source shard and merge scResults
 			// if withEvents is true
-			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
+			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(ctx, txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
 
 			tp.extraShardFromSCRs(txFromSource.SmartContractResults, shardIDWasFetch)
 
-			err = tp.fetchSCRSBasedOnShardMap(txFromSource, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, txFromSource, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -809,13 +1114,13 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		}
 
 		// get transaction from observer that is in destination shard
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			tp.extraShardFromSCRs(txFromDstShard.SmartContractResults, shardIDWasFetch)
 
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 
-			err = tp.fetchSCRSBasedOnShardMap(alteredTxFromDest, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, alteredTxFromDest, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -826,7 +1131,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		// return transaction from observer from source shard
 		// if did not get ok responses from observers from destination shard
 
-		err = tp.fetchSCRSBasedOnShardMap(&getTxResponse.Data.Transaction, shardIDWasFetch)
+		err = tp.fetchSCRSBasedOnShardMap(ctx, &getTxResponse.Data.Transaction, shardIDWasFetch)
 		if err != nil {
 			return nil, err
 		}
@@ -837,9 +1142,9 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 	return nil, errors.ErrTransactionNotFound
 }
 
-func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
+func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(ctx context.Context, tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
 	for shardID, info := range shardIDWasFetch {
-		scrs, err := tp.fetchSCRs(tx.Hash, info.hash, shardID)
+		scrs, err := tp.fetchSCRs(ctx, tx.Hash, info.hash, shardID)
 		if err != nil {
 			return err
 		}
@@ -854,7 +1159,7 @@ func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTran
 	return nil
 }
 
-func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
+func (tp *TransactionProcessor) fetchSCRs(ctx context.Context, txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
@@ -863,9 +1168,9 @@ func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32
 	apiPath := SCRsByTxHash + txHash + fmt.Sprintf(scrHashParam, scrHash)
 	for _, observer := range observers {
 		getTxResponseDst := &data.GetSCRsResponse{}
-		respCode, errG := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponseDst)
+		respCode, errG := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponseDst)
 		if errG != nil {
-			log.Trace("cannot get smart contract results", "address", observer.Address, "error", errG)
+			log.WithContext(ctx).Trace("cannot get smart contract results", "address", observer.Address, "error", errG)
 			continue
 		}
 
@@ -916,7 +1221,7 @@ func (tp *TransactionProcessor) extraShardFromSCRs(scrs []*transaction.ApiSmartC
 	}
 }
 
-func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
+func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(ctx context.Context, txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
 	if !withResults || len(tx.SmartContractResults) == 0 {
 		return tx
 	}
@@ -927,7 +1232,7 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -945,21 +1250,21 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	return tx
 }
 
-func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxWithSenderAddr(ctx context.Context, txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observers, sndShardID, err := tp.getShardObserversForSender(sender, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
 
 		rcvShardID, err := tp.getShardByAddress(getTxResponse.Data.Transaction.Receiver)
 		if err != nil {
-			log.Warn("cannot compute shard ID from receiver address",
+			log.WithContext(ctx).Warn("cannot compute shard ID from receiver address",
 				"receiver address", getTxResponse.Data.Transaction.Receiver,
 				"error", err.Error())
 		}
@@ -969,7 +1274,7 @@ func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withR
 			return &getTxResponse.Data.Transaction, nil
 		}
 
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 			return alteredTxFromDest, nil
@@ -1020,7 +1325,7 @@ func (tp *TransactionProcessor) getScResultsUnion(scResults []*transaction.ApiSm
 	return newSlice
 }
 
-func (tp *TransactionProcessor) getTxFromObserver(
+func (tp *TransactionProcessor) getTxFromObserver(ctx context.Context,
 	observer *data.NodeData,
 	txHash string,
 	withResults bool,
@@ -1031,12 +1336,12 @@ func (tp *TransactionProcessor) getTxFromObserver(
 		apiPath += withResultsParam
 	}
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponse)
 	if err != nil {
-		log.Trace("cannot get transaction", "address", observer.Address, "error", err)
+		log.WithContext(ctx).Trace("cannot get transaction", "address", observer.Address, "error", err)
 
 		if respCode == http.StatusTooManyRequests {
-			log.Warn("too many requests while getting tx from observer", "address", observer.Address, "tx hash", txHash)
+			log.WithContext(ctx).Warn("too many requests while getting tx from observer", "address", observer.Address, "tx hash", txHash)
 		}
 
 		return nil, false, true
@@ -1049,7 +1354,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 	return getTxResponse, true, false
 }
 
-func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
+func (tp *TransactionProcessor) getTxFromDestShard(ctx context.Context, txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
 	// cross shard transaction
 	destinationShardObservers, err := tp.proc.GetObservers(dstShardID, data.AvailabilityAll)
 	if err != nil {
@@ -1063,9 +1368,9 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 
 	for _, dstObserver := range destinationShardObservers {
 		getTxResponseDst := &data.GetTransactionResponse{}
-		respCode, err := tp.proc.CallGetRestEndPoint(dstObserver.Address, apiPath, getTxResponseDst)
+		respCode, err := tp.proc.CallGetRestEndPoint(ctx, dstObserver.Address, apiPath, getTxResponseDst)
 		if err != nil {
-			log.Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
+			log.WithContext(ctx).Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
 			continue
 		}
 
@@ -1079,43 +1384,76 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 	return nil, false
 }
 
-func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction) map[uint32][]*data.Transaction {
-	txsMap := make(map[uint32][]*data.Transaction)
-	for idx, tx := range txs {
-		senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
-		if err != nil {
-			continue
-		}
+func (tp *TransactionProcessor) computeSenderShardID(tx *data.Transaction) (uint32, error) {
+	senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return 0, err
+	}
 
-		senderShardID, err := tp.proc.ComputeShardId(senderBytes)
-		if err != nil {
-			continue
-		}
+	return tp.proc.ComputeShardId(senderBytes)
+}
 
-		tx.Index = idx
-		txsMap[senderShardID] = append(txsMap[senderShardID], tx)
-	}
+// transactionFieldCheck is one of the checks done on a transaction before sending it, along with the field it covers
+type transactionFieldCheck struct {
+	field string
+	check func(tx *data.Transaction) error
+}
 
-	return txsMap
+// getTransactionFieldChecks returns the checks done on a transaction before sending it, in the order they are applied.
+// They are shared by the send path, which stops at the first error, and by the validation, which reports all of them
+func (tp *TransactionProcessor) getTransactionFieldChecks() []transactionFieldCheck {
+	return []transactionFieldCheck{
+		{field: "sender", check: func(tx *data.Transaction) error {
+			return tp.checkAddressField(tx.Sender, errors.ErrInvalidSenderAddress)
+		}},
+		{field: "receiver", check: func(tx *data.Transaction) error {
+			return tp.checkAddressField(tx.Receiver, errors.ErrInvalidReceiverAddress)
+		}},
+		{field: "chainID", check: checkChainIDField},
+		{field: "version", check: checkVersionField},
+		{field: "signature", check: func(tx *data.Transaction) error {
+			return checkHexField(tx.Signature, errors.ErrInvalidSignatureHex)
+		}},
+		{field: "guardianSignature", check: func(tx *data.Transaction) error {
+			if len(tx.GuardianSignature) == 0 {
+				return nil
+			}
+			return checkHexField(tx.GuardianSignature, errors.ErrInvalidGuardianSignatureHex)
+		}},
+		{field: "guardian", check: func(tx *data.Transaction) error {
+			if len(tx.GuardianAddr) == 0 {
+				return nil
+			}
+			return tp.checkAddressField(tx.GuardianAddr, errors.ErrInvalidGuardianAddress)
+		}},
+		{field: "policy", check: tp.txsPolicyChecker.CheckTransaction},
+	}
 }
 
 func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) error {
-	_, err := tp.pubKeyConverter.Decode(tx.Sender)
-	if err != nil {
-		return &errors.ErrInvalidTxFields{
-			Message: errors.ErrInvalidSenderAddress.Error(),
-			Reason:  err.Error(),
+	for _, fieldCheck := range tp.getTransactionFieldChecks() {
+		err := fieldCheck.check(tx)
+		if err != nil {
+			return err
 		}
 	}
 
-	_, err = tp.pubKeyConverter.Decode(tx.Receiver)
+	return nil
+}
+
+func (tp *TransactionProcessor) checkAddressField(address string, errInvalidAddress error) error {
+	_, err := tp.pubKeyConverter.Decode(address)
 	if err != nil {
 		return &errors.ErrInvalidTxFields{
-			Message: errors.ErrInvalidReceiverAddress.Error(),
+			Message: errInvalidAddress.Error(),
 			Reason:  err.Error(),
 		}
 	}
 
+	return nil
+}
+
+func checkChainIDField(tx *data.Transaction) error {
 	if tx.ChainID == "" {
 		return &errors.ErrInvalidTxFields{
 			Message: "transaction must contain chainID",
@@ -1123,6 +1461,10 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 		}
 	}
 
+	return nil
+}
+
+func checkVersionField(tx *data.Transaction) error {
 	if tx.Version == 0 {
 		return &errors.ErrInvalidTxFields{
 			Message: "transaction must contain version",
@@ -1130,33 +1472,18 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 		}
 	}
 
-	_, err = hex.DecodeString(tx.Signature)
+	return nil
+}
+
+func checkHexField(value string, errInvalidHex error) error {
+	_, err := hex.DecodeString(value)
 	if err != nil {
 		return &errors.ErrInvalidTxFields{
-			Message: errors.ErrInvalidSignatureHex.Error(),
+			Message: errInvalidHex.Error(),
 			Reason:  err.Error(),
 		}
 	}
 
-	if len(tx.GuardianSignature) > 0 {
-		_, err = hex.DecodeString(tx.GuardianSignature)
-		if err != nil {
-			return &errors.ErrInvalidTxFields{
-				Message: errors.ErrInvalidGuardianSignatureHex.Error(),
-				Reason:  err.Error(),
-			}
-		}
-	}
-	if len(tx.GuardianAddr) > 0 {
-		_, err = tp.pubKeyConverter.Decode(tx.GuardianAddr)
-		if err != nil {
-			return &errors.ErrInvalidTxFields{
-				Message: errors.ErrInvalidGuardianAddress.Error(),
-				Reason:  err.Error(),
-			}
-		}
-	}
-
 	return nil
 }
 
@@ -1231,12 +1558,12 @@ func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestT
 }
 
 // GetTransactionsPool should return all transactions from all shards pool
-func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPool(fields)
+	txPool, err := tp.getTxPool(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1245,12 +1572,12 @@ func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.Transa
 }
 
 // GetTransactionsPoolForShard should return transactions pool from one observer from shard
-func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPoolForShard(shardID, fields)
+	txPool, err := tp.getTxPoolForShard(ctx, shardID, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1258,9 +1585,41 @@ func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fiel
 	return txPool, nil
 }
 
-// GetTransactionsPoolForSender should return transactions for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	txPool, err := tp.getTxPoolForSender(sender, fields)
+// GetAgedTransactionsPool should return the transactions pending in the pools of all shards for at least the
+// provided number of seconds. Only the transactions for which the observers provide the reception timestamp can be aged
+func (tp *TransactionProcessor) GetAgedTransactionsPool(ctx context.Context, olderThanSeconds uint64) (*data.AgedTransactionsPool, error) {
+	if !tp.shouldAllowEntireTxPoolFetch {
+		return nil, errors.ErrOperationNotAllowed
+	}
+
+	agedPool := &data.AgedTransactionsPool{
+		OlderThanSeconds:  olderThanSeconds,
+		Transactions:      make([]*data.AgedPoolTransaction, 0),
+		UnavailableShards: make([]uint32, 0),
+	}
+	for _, shardID := range tp.proc.GetShardIDs() {
+		txPool, err := tp.getTxPoolForShard(ctx, shardID, agedTxPoolFields)
+		if err != nil {
+			agedPool.UnavailableShards = append(agedPool.UnavailableShards, shardID)
+			continue
+		}
+
+		collectAgedTransactions(agedPool, shardID, txPool, time.Now())
+	}
+	sortAgedTransactions(agedPool.Transactions)
+
+	return agedPool, nil
+}
+
+// GetTransactionsPoolForSender should return transactions for sender from observer's pool. If allShards is set, the
+// pools of all the shards are queried and merged, as the relayed and guarded flows can place the transactions of the
+// sender in the pools of other shards than its own one
+func (tp *TransactionProcessor) GetTransactionsPoolForSender(ctx context.Context, sender, fields string, allShards bool) (*data.TransactionsPoolForSender, error) {
+	if allShards {
+		return tp.getTxPoolForSenderInAllShards(ctx, sender, fields)
+	}
+
+	txPool, err := tp.getTxPoolForSender(ctx, sender, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1268,14 +1627,52 @@ func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields stri
 	return txPool, nil
 }
 
+// GetTransactionsPoolForSenders should return the transactions from pool of each sender. The senders are grouped by
+// shard and each shard is queried in parallel, the requests of a shard being sent to the same observer as long as it
+// answers
+func (tp *TransactionProcessor) GetTransactionsPoolForSenders(ctx context.Context, senders []string, fields string) (*data.TransactionsPoolForSenders, error) {
+	sendersInShards := make(map[uint32][]string)
+	for _, sender := range senders {
+		shardID, err := tp.getShardByAddress(sender)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errors.ErrInvalidSenderAddress, sender)
+		}
+
+		sendersInShards[shardID] = append(sendersInShards[shardID], sender)
+	}
+
+	txPools := &data.TransactionsPoolForSenders{
+		Senders: make(map[string]*data.TransactionsPoolForSender, len(senders)),
+	}
+	mutTxPools := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(sendersInShards))
+	for shardID, sendersInShard := range sendersInShards {
+		go func(shardID uint32, sendersInShard []string) {
+			defer wg.Done()
+
+			txPoolsInShard := tp.getTxPoolForSendersInShard(ctx, shardID, sendersInShard, fields)
+
+			mutTxPools.Lock()
+			for sender, txPool := range txPoolsInShard {
+				txPools.Senders[sender] = txPool
+			}
+			mutTxPools.Unlock()
+		}(shardID, sendersInShard)
+	}
+	wg.Wait()
+
+	return txPools, nil
+}
+
 // GetLastPoolNonceForSender should return last nonce for sender from observer's pool
-func (tp *TransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return tp.getLastTxPoolNonceForSender(sender)
+func (tp *TransactionProcessor) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return tp.getLastTxPoolNonceForSender(ctx, sender)
 }
 
 // GetTransactionsPoolNonceGapsForSender should return nonce gaps for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return tp.getTxPoolNonceGapsForSender(sender)
+func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return tp.getTxPoolNonceGapsForSender(ctx, sender)
 }
 
 func (tp *TransactionProcessor) getShardObserversForSender(sender string, observersType requestType) ([]*data.NodeData, uint32, error) {
@@ -1289,10 +1686,11 @@ func (tp *TransactionProcessor) getShardObserversForSender(sender string, observ
 		return nil, 0, err
 	}
 
-	return observers, sndShardID, nil
+	// the pool of the observer which accepted the last transactions of the sender is the first one to contain them
+	return tp.proc.PreferWriteObserver(sender, observers), sndShardID, nil
 }
 
-func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
@@ -1300,7 +1698,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 		Rewards:              make([]data.WrappedTransaction, 0),
 	}
 	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
+		intraShardTxs, err := tp.getTxPoolForShard(ctx, shard, fields)
 		if err != nil {
 			continue
 		}
@@ -1313,15 +1711,16 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 	return txs, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
 	if err != nil {
-		log.Trace("cannot get observers for shard", "shard", shardID, "error", err)
+		log.WithContext(ctx).Trace("cannot get observers for shard", "shard", shardID, "error", err)
 		return nil, err
 	}
 
+	apiPath := TransactionsPoolPath + fieldsParam + fields
 	for _, observer := range observers {
-		txs, ok := tp.getTxPoolFromObserver(observer, fields)
+		txs, ok := tp.getTxPoolFromObserver(ctx, observer, apiPath)
 		if !ok {
 			continue
 		}
@@ -1329,23 +1728,34 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 		return txs, nil
 	}
 
-	log.Trace("cannot get tx pool for shard", "shard", shardID, "error", errors.ErrTransactionsNotFoundInPool.Error())
+	log.WithContext(ctx).Trace("cannot get tx pool for shard", "shard", shardID, "error", errors.ErrTransactionsNotFoundInPool.Error())
 	return nil, errors.ErrTransactionsNotFoundInPool
 }
 
-func (tp *TransactionProcessor) getTxPoolFromObserver(
+func (tp *TransactionProcessor) getTxPoolFromObserver(ctx context.Context,
 	observer *data.NodeData,
-	fields string,
+	apiPath string,
 ) (*data.TransactionsPool, bool) {
+	txsPoolResponseData, ok := tp.getTxPoolResponseFromObserver(ctx, observer, apiPath)
+	if !ok {
+		return nil, false
+	}
+
+	return &txsPoolResponseData.Transactions, true
+}
+
+func (tp *TransactionProcessor) getTxPoolResponseFromObserver(ctx context.Context,
+	observer *data.NodeData,
+	apiPath string,
+) (*data.TransactionsPoolResponseData, bool) {
 	txsPoolResponse := &data.TransactionsPoolApiResponse{}
-	apiPath := TransactionsPoolPath + fieldsParam + fields
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
-		log.Trace("cannot get tx pool", "address", observer.Address, "error", err)
+		log.WithContext(ctx).Trace("cannot get tx pool", "address", observer.Address, "error", err)
 
 		if respCode == http.StatusTooManyRequests {
-			log.Warn("too many requests while getting tx pool", "address", observer.Address)
+			log.WithContext(ctx).Warn("too many requests while getting tx pool", "address", observer.Address)
 		}
 
 		return nil, false
@@ -1355,10 +1765,12 @@ func (tp *TransactionProcessor) getTxPoolFromObserver(
 		return nil, false
 	}
 
-	return &txsPoolResponse.Data.Transactions, true
+	addAgeToTransactionsPool(&txsPoolResponse.Data.Transactions, time.Now())
+
+	return &txsPoolResponse.Data, true
 }
 
-func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tp *TransactionProcessor) getTxPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1369,7 +1781,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 	}
 	var ok bool
 	for _, observer := range observers {
-		txsInPool, ok = tp.getTxPoolForSenderFromObserver(observer, sender, fields)
+		txsInPool, ok = tp.getTxPoolForSenderFromObserver(ctx, observer, sender, fields)
 		if ok {
 			break
 		}
@@ -1378,7 +1790,90 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 	return txsInPool, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
+// getTxPoolForSenderInAllShards queries the pools of all the shards in parallel and merges the transactions of the
+// sender, ordered by nonce. The transactions found in more pools, such as the cross-shard ones, are returned once
+func (tp *TransactionProcessor) getTxPoolForSenderInAllShards(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	_, err := tp.getShardByAddress(sender)
+	if err != nil {
+		return nil, errors.ErrInvalidSenderAddress
+	}
+
+	shardIDs := tp.proc.GetShardIDs()
+	txPools := make([]*data.TransactionsPoolForSender, len(shardIDs))
+	wg := sync.WaitGroup{}
+	wg.Add(len(shardIDs))
+	for idx, shardID := range shardIDs {
+		go func(idx int, shardID uint32) {
+			defer wg.Done()
+
+			txPools[idx] = tp.getTxPoolForSendersInShard(ctx, shardID, []string{sender}, fields)[sender]
+		}(idx, shardID)
+	}
+	wg.Wait()
+
+	return mergeTxPoolsForSender(txPools), nil
+}
+
+func mergeTxPoolsForSender(txPools []*data.TransactionsPoolForSender) *data.TransactionsPoolForSender {
+	mergedTxPool := &data.TransactionsPoolForSender{
+		Transactions: []data.WrappedTransaction{},
+	}
+	mergedHashes := make(map[string]struct{})
+	for _, txPool := range txPools {
+		for _, tx := range txPool.Transactions {
+			hash := getStringFromTxFields(tx.TxFields, "hash")
+			_, isMerged := mergedHashes[hash]
+			if len(hash) > 0 && isMerged {
+				continue
+			}
+
+			mergedHashes[hash] = struct{}{}
+			mergedTxPool.Transactions = append(mergedTxPool.Transactions, tx)
+		}
+	}
+
+	sort.SliceStable(mergedTxPool.Transactions, func(i, j int) bool {
+		return getUint64FromTxFields(mergedTxPool.Transactions[i].TxFields, "nonce") <
+			getUint64FromTxFields(mergedTxPool.Transactions[j].TxFields, "nonce")
+	})
+
+	return mergedTxPool
+}
+
+func (tp *TransactionProcessor) getTxPoolForSendersInShard(ctx context.Context, shardID uint32, senders []string, fields string) map[string]*data.TransactionsPoolForSender {
+	txPools := make(map[string]*data.TransactionsPoolForSender, len(senders))
+	for _, sender := range senders {
+		txPools[sender] = &data.TransactionsPoolForSender{
+			Transactions: []data.WrappedTransaction{},
+		}
+	}
+
+	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
+	if err != nil {
+		log.WithContext(ctx).Trace("cannot get observers for shard", "shard", shardID, "error", err)
+		return txPools
+	}
+
+	// the observer which answered is kept for the next senders, the following ones being tried only on failures
+	observerIdx := 0
+	for _, sender := range senders {
+		for ; observerIdx < len(observers); observerIdx++ {
+			txPool, ok := tp.getTxPoolForSenderFromObserver(ctx, observers[observerIdx], sender, fields)
+			if ok {
+				txPools[sender] = txPool
+				break
+			}
+		}
+		if observerIdx == len(observers) {
+			log.WithContext(ctx).Trace("cannot get tx pool for senders", "shard", shardID, "error", errors.ErrTransactionsNotFoundInPool.Error())
+			break
+		}
+	}
+
+	return txPools
+}
+
+func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 	fields string,
@@ -1386,12 +1881,12 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	txsPoolResponse := &data.TransactionsPoolForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
-		log.Trace("cannot get tx pool for sender", "address", observer.Address, "sender", sender, "error", err)
+		log.WithContext(ctx).Trace("cannot get tx pool for sender", "address", observer.Address, "sender", sender, "error", err)
 
 		if respCode == http.StatusTooManyRequests {
-			log.Warn("too many requests while getting tx pool for sender", "address", observer.Address, "sender", sender)
+			log.WithContext(ctx).Warn("too many requests while getting tx pool for sender", "address", observer.Address, "sender", sender)
 		}
 
 		return nil, false
@@ -1401,17 +1896,19 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 		return nil, false
 	}
 
+	addAgeToWrappedTransactions(txsPoolResponse.Data.TxPool.Transactions, time.Now())
+
 	return &txsPoolResponse.Data.TxPool, true
 }
 
-func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint64, error) {
+func (tp *TransactionProcessor) getLastTxPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return 0, err
 	}
 
 	for _, observer := range observers {
-		nonce, ok := tp.getLastTxPoolNonceFromObserver(observer, sender)
+		nonce, ok := tp.getLastTxPoolNonceFromObserver(ctx, observer, sender)
 		if !ok {
 			continue
 		}
@@ -1422,19 +1919,19 @@ func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint
 	return 0, errors.ErrTransactionsNotFoundInPool
 }
 
-func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
+func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (uint64, bool) {
 	lastNonceResponse := &data.TransactionsPoolLastNonceForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + lastNonceParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, lastNonceResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, lastNonceResponse)
 	if err != nil {
-		log.Trace("cannot get last nonce from tx pool", "address", observer.Address, "sender", sender, "error", err)
+		log.WithContext(ctx).Trace("cannot get last nonce from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
 		if respCode == http.StatusTooManyRequests {
-			log.Warn("too many requests while getting last nonce from tx pool", "address", observer.Address, "sender", sender)
+			log.WithContext(ctx).Warn("too many requests while getting last nonce from tx pool", "address", observer.Address, "sender", sender)
 		}
 
 		return 0, false
@@ -1447,7 +1944,7 @@ func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
 	return lastNonceResponse.Data.Nonce, true
 }
 
-func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1458,7 +1955,7 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	}
 	var ok bool
 	for _, observer := range observers {
-		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(observer, sender)
+		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(ctx, observer, sender)
 		if ok {
 			break
 		}
@@ -1467,19 +1964,19 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	return nonceGaps, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(
+func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (*data.TransactionsPoolNonceGaps, bool) {
 	nonceGapsResponse := &data.TransactionsPoolNonceGapsForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + nonceGapsParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, nonceGapsResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, nonceGapsResponse)
 	if err != nil {
-		log.Warn("cannot get nonce gaps from tx pool", "address", observer.Address, "sender", sender, "error", err)
+		log.WithContext(ctx).Warn("cannot get nonce gaps from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
 		if respCode == http.StatusTooManyRequests {
-			log.Warn("too many requests while getting nonce gaps from tx pool", "address", observer.Address, "sender", sender)
+			log.WithContext(ctx).Warn("too many requests while getting nonce gaps from tx pool", "address", observer.Address, "sender", sender)
 		}
 
 		return nil, false
@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -10,8 +11,10 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -24,11 +27,15 @@ import (
 	"github.com/stretchr/testify/require"
 
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/factory"
 	"github.com/multiversx/mx-chain-proxy-go/process/logsevents"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
 )
 
 var hasher, _ = hasherFactory.NewHasher("blake2b")
@@ -95,6 +102,12 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 		logsMerger,
 		false,
 		factory.NewTxNotarizationChecker(),
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
 	return tp
@@ -103,7 +116,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -112,7 +125,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -121,7 +134,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -130,7 +143,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -139,7 +152,7 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
@@ -148,32 +161,90 @@ func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilTxNotarizationCheckShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, nil)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, nil, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilTxNotarizationCheckerHandler, err)
 }
 
+func TestNewTransactionProcessor_NilTxStatusCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, nil, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilTxStatusCache, err)
+}
+
+func TestNewTransactionProcessor_NilSentTxsCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, nil, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilSentTxsCache, err)
+}
+
+func TestNewTransactionProcessor_NilTransactionsPolicyCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, nil, 1, &disabled.EventsABIRegistry{}, 0)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilTransactionsPolicyChecker, err)
+}
+
+func TestNewTransactionProcessor_InvalidTxBroadcastFanoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 0, &disabled.EventsABIRegistry{}, 0)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrInvalidTxBroadcastFanout, err)
+}
+
+func TestNewTransactionProcessor_NilEventsABIRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, nil, 0)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilEventsABIRegistry, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
 }
 
+func TestTransactionProcessor_SetReadOnlyMode(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.False(t, tp.IsReadOnlyModeEnabled())
+
+	tp.SetReadOnlyMode(true)
+	require.True(t, tp.IsReadOnlyModeEnabled())
+
+	tp.SetReadOnlyMode(false)
+	require.False(t, tp.IsReadOnlyModeEnabled())
+}
+
 // ------- SendTransaction
 
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender: "invalid hex number",
 	})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "invalid byte")
 	require.Equal(t, http.StatusBadRequest, rc)
@@ -182,10 +253,10 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "no chainID")
 	require.Equal(t, http.StatusBadRequest, rc)
@@ -194,12 +265,12 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chainID",
 	})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "no version")
 	require.Equal(t, http.StatusBadRequest, rc)
@@ -222,13 +293,19 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chain",
 		Version: 1,
 	})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.Equal(t, errExpected, err)
 	require.Equal(t, http.StatusInternalServerError, rc)
 }
@@ -253,15 +330,21 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
 	})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.Equal(t, errExpected, err)
 	require.Equal(t, http.StatusInternalServerError, rc)
 }
@@ -292,15 +375,21 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
 	})
 
-	require.Empty(t, txHash)
+	require.Nil(t, sentTx)
 	require.Equal(t, errExpected, err)
 	require.Equal(t, http.StatusInternalServerError, rc)
 }
@@ -334,17 +423,487 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 	address := "DEADBEEF"
-	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
 	})
 
-	require.Equal(t, resultedTxHash, txHash)
+	require.Equal(t, txHash, sentTx.TxHash)
+	require.False(t, sentTx.AlreadySubmitted)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, rc)
+}
+
+func TestTransactionProcessor_SendTransactionShouldRecordTheWriteObserver(t *testing.T) {
+	t.Parallel()
+
+	addressFail := "address1"
+	recordedObservers := make(map[string]string)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: addressFail, ShardId: 0},
+					{Address: "address2", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				if address == addressFail {
+					return http.StatusRequestTimeout, errors.New("timeout")
+				}
+
+				txResponse := response.(*data.ResponseTransaction)
+				txResponse.Data.TxHash = "DEADBEEF"
+				return http.StatusOK, nil
+			},
+			RecordWriteObserverCalled: func(sender string, observerAddress string) {
+				recordedObservers[sender] = observerAddress
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	_, _, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Sender:  "aaaa",
+		ChainID: "chain",
+		Version: 1,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"aaaa": "address2"}, recordedObservers)
+}
+
+func TestTransactionProcessor_SendTransactionWithNonceGapShouldWarn(t *testing.T) {
+	t.Parallel()
+
+	createTxProcessor := func(accountNonce uint64, nonceGapWarningThreshold uint64) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return []*data.NodeData{{Address: "address1", ShardId: 0}}, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					txResponse := response.(*data.ResponseTransaction)
+					txResponse.Data.TxHash = "DEADBEEF"
+					return http.StatusOK, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					require.Equal(t, "address1", address)
+					require.Equal(t, "/address/aaaa", path)
+
+					accountResponse := value.(*data.AccountApiResponse)
+					accountResponse.Data.Account.Nonce = accountNonce
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			&mock.TxNotarizationCheckerMock{},
+			&disabled.TxStatusCache{},
+			&disabled.SentTxsCache{},
+			&disabled.TransactionsPolicyChecker{},
+			1,
+			&disabled.EventsABIRegistry{},
+			nonceGapWarningThreshold,
+		)
+
+		return tp
+	}
+	tx := &data.Transaction{
+		Nonce:   150,
+		Sender:  "aaaa",
+		ChainID: "chain",
+		Version: 1,
+	}
+
+	t.Run("gap above the threshold should warn", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxProcessor(5, 100)
+		statusCode, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "DEADBEEF", sentTx.TxHash)
+		require.Contains(t, sentTx.Warning, "nonce 150 is 145 above the current account nonce 5")
+	})
+	t.Run("gap within the threshold should not warn", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxProcessor(50, 100)
+		_, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Empty(t, sentTx.Warning)
+	})
+	t.Run("nonce below the account nonce should not warn", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxProcessor(200, 100)
+		_, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Empty(t, sentTx.Warning)
+	})
+	t.Run("disabled check should not warn", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxProcessor(0, 0)
+		_, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Empty(t, sentTx.Warning)
+	})
+}
+
+func TestTransactionProcessor_SendTransactionWithBroadcastFanout(t *testing.T) {
+	t.Parallel()
+
+	createTxProcessor := func(sendHandler func(address string, txResponse *data.ResponseTransaction) (int, error)) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return []*data.NodeData{
+						{Address: "address1", ShardId: 0},
+						{Address: "address2", ShardId: 0},
+						{Address: "address3", ShardId: 0},
+					}, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					return sendHandler(address, response.(*data.ResponseTransaction))
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			&mock.TxNotarizationCheckerMock{},
+			&disabled.TxStatusCache{},
+			&disabled.SentTxsCache{},
+			&disabled.TransactionsPolicyChecker{},
+			2,
+			&disabled.EventsABIRegistry{},
+			0,
+		)
+
+		return tp
+	}
+	tx := &data.Transaction{
+		Sender:  "aaaa",
+		ChainID: "chain",
+		Version: 1,
+	}
+
+	t.Run("should broadcast to the fanout observers and return the first success", func(t *testing.T) {
+		t.Parallel()
+
+		mutCalled := sync.Mutex{}
+		calledAddresses := make(map[string]struct{})
+		tp := createTxProcessor(func(address string, txResponse *data.ResponseTransaction) (int, error) {
+			mutCalled.Lock()
+			calledAddresses[address] = struct{}{}
+			mutCalled.Unlock()
+
+			if address == "address1" {
+				return http.StatusRequestTimeout, errors.New("timeout")
+			}
+
+			txResponse.Data.TxHash = "DEADBEEF"
+			return http.StatusOK, nil
+		})
+
+		rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, rc)
+		require.Equal(t, "DEADBEEF", sentTx.TxHash)
+
+		mutCalled.Lock()
+		defer mutCalled.Unlock()
+		_, isThirdObserverCalled := calledAddresses["address3"]
+		require.False(t, isThirdObserverCalled)
+	})
+	t.Run("rejected transaction should return the rejection", func(t *testing.T) {
+		t.Parallel()
+
+		errRejected := errors.New("invalid signature")
+		tp := createTxProcessor(func(address string, txResponse *data.ResponseTransaction) (int, error) {
+			if address == "address1" {
+				return http.StatusNotFound, errors.New("observer down")
+			}
+			if address == "address2" {
+				return http.StatusBadRequest, errRejected
+			}
+
+			require.Fail(t, "should have not been called")
+			return http.StatusOK, nil
+		})
+
+		rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, sentTx)
+		require.Equal(t, errRejected, err)
+		require.Equal(t, http.StatusBadRequest, rc)
+	})
+	t.Run("fanout observers down should fall back to the next ones", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxProcessor(func(address string, txResponse *data.ResponseTransaction) (int, error) {
+			if address != "address3" {
+				return http.StatusNotFound, errors.New("observer down")
+			}
+
+			txResponse.Data.TxHash = "DEADBEEF"
+			return http.StatusOK, nil
+		})
+
+		rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, rc)
+		require.Equal(t, "DEADBEEF", sentTx.TxHash)
+	})
+}
+
+func TestTransactionProcessor_SendTransactionBreakingThePolicyShouldNotRelay(t *testing.T) {
+	t.Parallel()
+
+	txsPolicyChecker, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxGasLimit: 1000}, &mock.PubKeyConverterMock{})
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Fail(t, "should not have relayed the transaction")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		txsPolicyChecker,
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	rc, sentTx, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Sender:   "aaaa",
+		Receiver: "bbbb",
+		ChainID:  "chain",
+		Version:  1,
+		GasLimit: 1001,
+	})
+
+	require.Nil(t, sentTx)
+	require.Equal(t, http.StatusBadRequest, rc)
+	require.Contains(t, err.Error(), apiErrors.ErrGasLimitAboveMaximum.Error())
+}
+
+func TestTransactionProcessor_SendTransactionObserverOmitsTheHashShouldReturnTheComputedHash(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	tx := &data.Transaction{
+		Nonce:     7,
+		Value:     "10",
+		Sender:    "aaaa",
+		Receiver:  "bbbb",
+		ChainID:   "chain",
+		Version:   1,
+		Signature: "cccc",
+	}
+	computedTxHash, err := tp.ComputeTransactionHash(tx)
+	require.Nil(t, err)
+
+	rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, rc)
+	require.Equal(t, &data.SentTransaction{TxHash: computedTxHash, HashSource: data.TxHashSourceComputed}, sentTx)
+}
+
+func TestTransactionProcessor_SendTransactionAlreadySubmittedShouldNotRelayAgain(t *testing.T) {
+	t.Parallel()
+
+	numRelayed := 0
+	sentTxsCache, _ := cache.NewSentTxsCache(storage.NewMemoryStorer(), time.Minute)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				numRelayed++
+				txResponse := response.(*data.ResponseTransaction)
+				txResponse.Data.TxHash = "observer hash"
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		sentTxsCache,
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	tx := &data.Transaction{
+		Nonce:     7,
+		Value:     "10",
+		Sender:    "aaaa",
+		Receiver:  "bbbb",
+		ChainID:   "chain",
+		Version:   1,
+		Signature: "cccc",
+	}
+	computedTxHash, err := tp.ComputeTransactionHash(tx)
+	require.Nil(t, err)
+
+	rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, rc)
+	require.Equal(t, &data.SentTransaction{TxHash: "observer hash", HashSource: data.TxHashSourceObserver}, sentTx)
+
+	rc, sentTx, err = tp.SendTransaction(context.Background(), tx)
 	require.Nil(t, err)
 	require.Equal(t, http.StatusOK, rc)
+	require.Equal(t, &data.SentTransaction{
+		TxHash:           computedTxHash,
+		HashSource:       data.TxHashSourceComputed,
+		AlreadySubmitted: true,
+	}, sentTx)
+	require.Equal(t, 1, numRelayed)
+
+	otherTx := *tx
+	otherTx.Nonce++
+	_, sentTx, err = tp.SendTransaction(context.Background(), &otherTx)
+	require.Nil(t, err)
+	require.False(t, sentTx.AlreadySubmitted)
+	require.Equal(t, 2, numRelayed)
+}
+
+func TestTransactionProcessor_SendTransactionRejectedShouldBeRelayedAgain(t *testing.T) {
+	t.Parallel()
+
+	numRelayed := 0
+	sentTxsCache, _ := cache.NewSentTxsCache(storage.NewMemoryStorer(), time.Minute)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				numRelayed++
+				if numRelayed == 1 {
+					return http.StatusBadRequest, errors.New("rejected")
+				}
+
+				txResponse := response.(*data.ResponseTransaction)
+				txResponse.Data.TxHash = "observer hash"
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		sentTxsCache,
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	tx := &data.Transaction{
+		Nonce:     7,
+		Value:     "10",
+		Sender:    "aaaa",
+		Receiver:  "bbbb",
+		ChainID:   "chain",
+		Version:   1,
+		Signature: "cccc",
+	}
+
+	rc, _, err := tp.SendTransaction(context.Background(), tx)
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, rc)
+
+	rc, sentTx, err := tp.SendTransaction(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, rc)
+	require.Equal(t, &data.SentTransaction{TxHash: "observer hash", HashSource: data.TxHashSourceObserver}, sentTx)
+	require.Equal(t, 2, numRelayed)
 }
 
 // //------- SendMultipleTransactions
@@ -386,9 +945,15 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, len(response.TxsHashes), len(txsToSend))
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
@@ -460,9 +1025,15 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
 	require.Equal(t, uint32(2), atomic.LoadUint32(&numOfTimesPostEndpointWasCalled))
@@ -475,6 +1046,135 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 	)
 }
 
+func TestTransactionProcessor_SendMultipleTransactionsShouldReportEachResult(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	sndrShard2 := hex.EncodeToString([]byte("dddddd"))
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: "invalid sender", ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard1, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard2, ChainID: "chain", Version: 1},
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				switch hex.EncodeToString(addressBuff) {
+				case sndrShard0:
+					return 0, nil
+				case sndrShard1:
+					return 1, nil
+				default:
+					return 2, nil
+				}
+			},
+			GetObserversCalled: func(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardID == 2 {
+					return nil, errors.New("no observer in shard 2")
+				}
+
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("observer%d-a", shardID), ShardId: shardID},
+					{Address: fmt.Sprintf("observer%d-b", shardID), ShardId: shardID},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				resp := response.(*data.ResponseMultipleTransactions)
+				switch address {
+				case "observer0-a":
+					return http.StatusRequestTimeout, errors.New("timeout")
+				case "observer0-b":
+					// the second transaction of the batch is rejected
+					resp.Data.NumOfTxs = 1
+					resp.Data.TxsHashes = map[int]string{0: "hash0"}
+					return http.StatusOK, nil
+				default:
+					resp.Error = "observer error"
+					return http.StatusInternalServerError, nil
+				}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.NumOfTxs)
+	require.Equal(t, map[int]string{0: "hash0"}, response.TxsHashes)
+	require.Len(t, response.Results, len(txsToSend))
+
+	shard0, shard1, shard2 := uint32(0), uint32(1), uint32(2)
+	assert.Equal(t, &data.TransactionSendResult{Index: 0, Hash: "hash0", ShardID: &shard0, Observer: "observer0-b"}, response.Results[0])
+	assert.Equal(t, 1, response.Results[1].Index)
+	assert.Contains(t, response.Results[1].Error, apiErrors.ErrInvalidSenderAddress.Error())
+	assert.Nil(t, response.Results[1].ShardID)
+	assert.Equal(t, &data.TransactionSendResult{
+		Index:    2,
+		Error:    process.ErrTransactionRejectedByObserver.Error(),
+		ShardID:  &shard0,
+		Observer: "observer0-b",
+	}, response.Results[2])
+	assert.Equal(t, &shard1, response.Results[3].ShardID)
+	assert.Equal(t, "observer1-b", response.Results[3].Observer)
+	assert.Contains(t, response.Results[3].Error, "observer error")
+	assert.Equal(t, &shard2, response.Results[4].ShardID)
+	assert.Empty(t, response.Results[4].Observer)
+	assert.Contains(t, response.Results[4].Error, process.ErrMissingObserver.Error())
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsAllInvalidShouldReturnTheResults(t *testing.T) {
+	t.Parallel()
+
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: "invalid sender", ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: hex.EncodeToString([]byte("bbbbbb")), Version: 1},
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				assert.Fail(t, "should not have sent the transactions")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Equal(t, process.ErrNoValidTransactionToSend, err)
+	require.Equal(t, uint64(0), response.NumOfTxs)
+	require.Empty(t, response.TxsHashes)
+	require.Len(t, response.Results, len(txsToSend))
+	assert.Contains(t, response.Results[0].Error, apiErrors.ErrInvalidSenderAddress.Error())
+	assert.Contains(t, response.Results[1].Error, "transaction must contain chainID")
+}
+
 func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -505,9 +1205,15 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseData)
@@ -561,9 +1267,15 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseDataCrossShard)
@@ -632,9 +1344,15 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -691,9 +1409,15 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -763,9 +1487,15 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -833,9 +1563,15 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -856,13 +1592,82 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "blablabla")
 	assert.Error(t, err)
 	assert.Equal(t, string(data.TxStatusUnknown), txStatus)
 }
 
+func TestTransactionProcessor_GetTransactionStatusShouldUseCache(t *testing.T) {
+	t.Parallel()
+
+	statuses := map[string]transaction.TxStatus{
+		"pendingHash": transaction.TxStatusPending,
+		"successHash": transaction.TxStatusSuccess,
+		"failHash":    transaction.TxStatusFail,
+	}
+	numRequests := make(map[string]int)
+	txStatusCache, _ := cache.NewTxStatusLRUCache(10, time.Hour, time.Hour)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				for hash, status := range statuses {
+					if strings.Contains(path, hash) {
+						numRequests[hash]++
+						responseGetTx := value.(*data.GetTransactionResponse)
+						responseGetTx.Data.Transaction = transaction.ApiTransactionResult{Status: status}
+						return http.StatusOK, nil
+					}
+				}
+
+				return http.StatusNotFound, errors.New("not found")
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.TxNotarizationCheckerMock{},
+		txStatusCache,
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
+	)
+
+	for i := 0; i < 3; i++ {
+		for hash, expectedStatus := range statuses {
+			status, err := tp.GetTransactionStatus(context.Background(), hash, "")
+			require.NoError(t, err)
+			assert.Equal(t, string(expectedStatus), status)
+		}
+
+		status, err := tp.GetTransactionStatus(context.Background(), "missingHash", "")
+		assert.Equal(t, string(data.TxStatusUnknown), status)
+		assert.NotNil(t, err)
+	}
+
+	assert.Equal(t, 1, numRequests["pendingHash"])
+	assert.Equal(t, 1, numRequests["successHash"])
+	assert.Equal(t, 1, numRequests["failHash"])
+}
+
 func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t *testing.T) {
 	t.Parallel()
 
@@ -913,9 +1718,15 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -937,7 +1748,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -960,7 +1771,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -982,7 +1793,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -1004,7 +1815,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -1027,7 +1838,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -1054,7 +1865,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1132,9 +1943,15 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), false)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
@@ -1183,9 +2000,15 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.True(t, secondObserverWasCalled)
 }
 
@@ -1230,9 +2053,15 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 }
 
 func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterSource(t *testing.T) {
@@ -1344,9 +2173,15 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), true)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), true)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.SmartContractResults))
@@ -1359,10 +2194,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.TxNotarizationCheckerMock{})
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("")
+		txs, err := tp.GetTransactionsPool(context.Background(), "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1400,10 +2235,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1513,7 +2348,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1521,7 +2356,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
 		}
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1530,10 +2365,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.TxNotarizationCheckerMock{})
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1563,10 +2398,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1658,7 +2493,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1666,7 +2501,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTx0, scrTx1},
 			Rewards:              []data.WrappedTransaction{rewardsTx0, rewardsTx1},
 		}
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1706,18 +2541,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce", false)
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, uint64(0), nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.NotNil(t, nonceGaps)
 		assert.NoError(t, err)
 	})
@@ -1783,21 +2618,179 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{})
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce", false)
 		require.Nil(t, err)
 		assert.Equal(t, &providedPool, txs)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, lastNonce, nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.Nil(t, err)
 		assert.Equal(t, providedGaps, nonceGaps.Gaps)
 	})
+	t.Run("txs in the pools of all shards should be merged", func(t *testing.T) {
+		t.Parallel()
+
+		providedPubKeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+		providedSenderStr := "erd1kwh72fxl5rwndatsgrvfu235q3pwyng9ax4zxcrg4ss3p6pwuugq3gt3yc"
+
+		homeShardTx := data.WrappedTransaction{TxFields: map[string]interface{}{"nonce": float64(5), "hash": "hash5"}}
+		crossShardTx := data.WrappedTransaction{TxFields: map[string]interface{}{"nonce": float64(3), "hash": "hash3"}}
+		relayedTx := data.WrappedTransaction{TxFields: map[string]interface{}{"nonce": float64(4), "hash": "hash4"}}
+		poolsInShards := map[string][]data.WrappedTransaction{
+			"observer0": {homeShardTx, crossShardTx},
+			"observer1": {crossShardTx, relayedTx},
+		}
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				require.True(t, strings.Contains(path, providedSenderStr))
+				txs, found := poolsInShards[address]
+				if !found {
+					return http.StatusInternalServerError, errors.New("observer down")
+				}
+
+				response := value.(*data.TransactionsPoolForSenderApiResponse)
+				response.Data.TxPool = data.TransactionsPoolForSender{
+					Transactions: txs,
+				}
+
+				return http.StatusOK, nil
+			},
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+		require.NotNil(t, tp)
+
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "nonce,hash", true)
+		require.Nil(t, err)
+		assert.Equal(t, []data.WrappedTransaction{crossShardTx, relayedTx, homeShardTx}, txs.Transactions)
+
+		txs, err = tp.GetTransactionsPoolForSender(context.Background(), "invalid sender", "nonce,hash", true)
+		assert.Nil(t, txs)
+		assert.Equal(t, apiErrors.ErrInvalidSenderAddress, err)
+	})
+}
+
+func TestTransactionProcessor_GetAgedTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+		agedPool, err := tp.GetAgedTransactionsPool(context.Background(), 60)
+		assert.Nil(t, agedPool)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("should return the old transactions of all shards, from the oldest one", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now().Unix()
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				assert.Equal(t, "/transaction/pool?fields=hash,sender,receiver,nonce,receivedAt", path)
+
+				response := value.(*data.TransactionsPoolApiResponse)
+				switch address {
+				case "observer0":
+					response.Data.Transactions = data.TransactionsPool{
+						RegularTransactions: []data.WrappedTransaction{
+							{TxFields: map[string]interface{}{"hash": "recent", "nonce": float64(1), "receivedAt": float64(now - 10)}},
+							{TxFields: map[string]interface{}{"hash": "old", "sender": "alice", "receiver": "bob", "nonce": float64(2), "receivedAt": float64(now - 120)}},
+							{TxFields: map[string]interface{}{"hash": "without age", "nonce": float64(3)}},
+						},
+					}
+				case "observer1":
+					response.Data.Transactions = data.TransactionsPool{
+						SmartContractResults: []data.WrappedTransaction{
+							{TxFields: map[string]interface{}{"hash": "oldest", "nonce": float64(4), "receivedAt": float64(now - 600)}},
+						},
+					}
+				default:
+					return http.StatusInternalServerError, errors.New("offline")
+				}
+
+				return http.StatusOK, nil
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+		agedPool, err := tp.GetAgedTransactionsPool(context.Background(), 60)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(60), agedPool.OlderThanSeconds)
+		assert.Equal(t, uint64(1), agedPool.TransactionsWithoutAge)
+		assert.Equal(t, []uint32{core.MetachainShardId}, agedPool.UnavailableShards)
+		require.Len(t, agedPool.Transactions, 2)
+
+		oldest := agedPool.Transactions[0]
+		assert.Equal(t, "oldest", oldest.Hash)
+		assert.Equal(t, "smartContractResult", oldest.Type)
+		assert.Equal(t, uint32(1), oldest.Shard)
+		assert.Equal(t, now-600, oldest.ReceivedAt)
+		assert.GreaterOrEqual(t, oldest.AgeSeconds, uint64(600))
+
+		old := agedPool.Transactions[1]
+		assert.Equal(t, &data.AgedPoolTransaction{
+			Hash:       "old",
+			Sender:     "alice",
+			Receiver:   "bob",
+			Nonce:      2,
+			Type:       "regular",
+			Shard:      0,
+			ReceivedAt: now - 120,
+			AgeSeconds: old.AgeSeconds,
+		}, old)
+		assert.GreaterOrEqual(t, old.AgeSeconds, uint64(120))
+	})
+	t.Run("pool transactions with reception time should get their age", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now().Unix()
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				response := value.(*data.TransactionsPoolApiResponse)
+				response.Data.Transactions = data.TransactionsPool{
+					RegularTransactions: []data.WrappedTransaction{
+						{TxFields: map[string]interface{}{"hash": "aged", "receivedAt": float64(now - 30)}},
+						{TxFields: map[string]interface{}{"hash": "received in the future", "receivedAt": float64(now + 30)}},
+						{TxFields: map[string]interface{}{"hash": "without age"}},
+					},
+				}
+
+				return http.StatusOK, nil
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+		txPool, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "hash,receivedAt")
+		require.NoError(t, err)
+		require.Len(t, txPool.RegularTransactions, 3)
+		assert.GreaterOrEqual(t, txPool.RegularTransactions[0].TxFields["ageSeconds"], uint64(30))
+		assert.Equal(t, uint64(0), txPool.RegularTransactions[1].TxFields["ageSeconds"])
+		assert.NotContains(t, txPool.RegularTransactions[2].TxFields, "ageSeconds")
+	})
 }
 
 func TestTransactionProcessor_computeTransactionStatus(t *testing.T) {
@@ -2092,9 +3085,15 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 		logsMerger,
 		true,
 		&mock.TxNotarizationCheckerMock{},
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
-	status, err := tp.GetProcessedTransactionStatus(string(hash0))
+	status, err := tp.GetProcessedTransactionStatus(context.Background(), string(hash0))
 	assert.Nil(t, err)
 	assert.Equal(t, string(transaction.TxStatusPending), status.Status) // not a move balance tx with missing finish markers
 }
@@ -2136,6 +3135,12 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *te
 		logsMerger,
 		false,
 		factory.NewTxNotarizationChecker(),
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
@@ -2221,8 +3226,118 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardRelayedV3WithMoveBalan
 		logsMerger,
 		false,
 		factory.NewTxNotarizationChecker(),
+		&disabled.TxStatusCache{},
+		&disabled.SentTxsCache{},
+		&disabled.TransactionsPolicyChecker{},
+		1,
+		&disabled.EventsABIRegistry{},
+		0,
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
 	require.Equal(t, string(transaction.TxStatusSuccess), status.Status)
 }
+
+func TestTransactionProcessor_GetTransactionsPoolForSenders(t *testing.T) {
+	t.Parallel()
+
+	providedPubKeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	sendersInShard0 := []string{validationSender, validationReceiver}
+	senderInShard1 := "erd1kwh72fxl5rwndatsgrvfu235q3pwyng9ax4zxcrg4ss3p6pwuugq3gt3yc"
+	shardOfSender := func(addressBuff []byte) uint32 {
+		address, _ := providedPubKeyConverter.Encode(addressBuff)
+		if address == senderInShard1 {
+			return 1
+		}
+
+		return 0
+	}
+
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+		txPools, err := tp.GetTransactionsPoolForSenders(context.Background(), []string{validationSender, "invalid"}, "")
+		assert.Nil(t, txPools)
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidSenderAddress))
+	})
+	t.Run("should query each shard, keeping the observer which answered", func(t *testing.T) {
+		t.Parallel()
+
+		mutCalls := sync.Mutex{}
+		calls := make(map[string]int)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return shardOfSender(addressBuff), nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardId == 0 {
+					return []*data.NodeData{
+						{Address: "offline observer", ShardId: 0},
+						{Address: "observer0", ShardId: 0},
+					}, nil
+				}
+
+				return []*data.NodeData{{Address: "observer1", ShardId: 1}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				mutCalls.Lock()
+				calls[address]++
+				mutCalls.Unlock()
+
+				if address == "offline observer" {
+					return http.StatusNotFound, errors.New("offline")
+				}
+				require.True(t, strings.Contains(path, "fields=sender,nonce"))
+
+				sender := path[strings.LastIndex(path, "=")+1:]
+				response := value.(*data.TransactionsPoolForSenderApiResponse)
+				response.Data.TxPool = data.TransactionsPoolForSender{
+					Transactions: []data.WrappedTransaction{
+						{TxFields: map[string]interface{}{"sender": sender, "observer": address}},
+					},
+				}
+
+				return http.StatusOK, nil
+			},
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+		senders := append([]string{senderInShard1}, sendersInShard0...)
+		txPools, err := tp.GetTransactionsPoolForSenders(context.Background(), senders, "sender,nonce")
+		require.Nil(t, err)
+		require.Len(t, txPools.Senders, 3)
+		for _, sender := range senders {
+			expectedObserver := "observer0"
+			if sender == senderInShard1 {
+				expectedObserver = "observer1"
+			}
+
+			require.Len(t, txPools.Senders[sender].Transactions, 1)
+			assert.Equal(t, sender, txPools.Senders[sender].Transactions[0].TxFields["sender"])
+			assert.Equal(t, expectedObserver, txPools.Senders[sender].Transactions[0].TxFields["observer"])
+		}
+		assert.Equal(t, map[string]int{"offline observer": 1, "observer0": 2, "observer1": 1}, calls)
+	})
+	t.Run("no observer answering should return empty pools", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return shardOfSender(addressBuff), nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "offline observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				return http.StatusNotFound, errors.New("offline")
+			},
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+
+		txPools, err := tp.GetTransactionsPoolForSenders(context.Background(), sendersInShard0, "")
+		require.Nil(t, err)
+		require.Len(t, txPools.Senders, 2)
+		for _, sender := range sendersInShard0 {
+			assert.Empty(t, txPools.Senders[sender].Transactions)
+		}
+	})
+}
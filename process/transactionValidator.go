@@ -0,0 +1,222 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	signatureLength           = 64
+	guardedTxOptionsMask      = uint32(1) << 1
+	minVersionWithOptions     = uint32(2)
+	noMaxBuiltInArguments     = -1
+	builtInArgumentsSeparator = "@"
+)
+
+type builtInFunctionArguments struct {
+	minArgs int
+	maxArgs int
+}
+
+// knownBuiltInFunctions holds the number of arguments accepted by the builtin functions checked on validation
+var knownBuiltInFunctions = map[string]builtInFunctionArguments{
+	core.BuiltInFunctionESDTTransfer:          {minArgs: 2, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionESDTNFTTransfer:       {minArgs: 4, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionMultiESDTNFTTransfer:  {minArgs: 5, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionESDTBurn:              {minArgs: 2, maxArgs: 2},
+	core.BuiltInFunctionESDTLocalMint:         {minArgs: 2, maxArgs: 2},
+	core.BuiltInFunctionESDTLocalBurn:         {minArgs: 2, maxArgs: 2},
+	core.BuiltInFunctionESDTNFTAddQuantity:    {minArgs: 3, maxArgs: 3},
+	core.BuiltInFunctionESDTNFTBurn:           {minArgs: 3, maxArgs: 3},
+	core.BuiltInFunctionESDTNFTCreate:         {minArgs: 7, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionESDTNFTAddURI:         {minArgs: 3, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionSetGuardian:           {minArgs: 2, maxArgs: 2},
+	core.BuiltInFunctionGuardAccount:          {minArgs: 0, maxArgs: 0},
+	core.BuiltInFunctionUnGuardAccount:        {minArgs: 0, maxArgs: 0},
+	core.BuiltInFunctionClaimDeveloperRewards: {minArgs: 0, maxArgs: 0},
+	core.BuiltInFunctionChangeOwnerAddress:    {minArgs: 1, maxArgs: 1},
+	core.BuiltInFunctionSaveKeyValue:          {minArgs: 2, maxArgs: noMaxBuiltInArguments},
+	core.BuiltInFunctionSetUserName:           {minArgs: 1, maxArgs: 1},
+}
+
+type transactionProblems []data.TransactionValidationProblem
+
+func (problems *transactionProblems) add(field string, message string, args ...interface{}) {
+	*problems = append(*problems, data.TransactionValidationProblem{
+		Field:   field,
+		Message: fmt.Sprintf(message, args...),
+	})
+}
+
+// ValidateTransaction performs the static checks of a transaction against the provided network configuration,
+// without sending it. The checks done before sending a transaction are applied first, followed by the ones depending
+// on the network configuration. All the problems found are returned, not only the first one
+func (tp *TransactionProcessor) ValidateTransaction(tx *data.Transaction, networkConfig *data.NetworkConfig) *data.TransactionValidationResult {
+	problems := make(transactionProblems, 0)
+
+	for _, fieldCheck := range tp.getTransactionFieldChecks() {
+		err := fieldCheck.check(tx)
+		if err != nil {
+			problems.add(fieldCheck.field, "%s", err.Error())
+		}
+	}
+
+	tp.validateAddress("relayer", tx.RelayerAddr, &problems)
+	validateValue(tx, &problems)
+	validateChainIDAndVersion(tx, networkConfig, &problems)
+	validateSignatures(tx, &problems)
+	validateGas(tx, networkConfig, &problems)
+	tp.validateBuiltInFunctionData(tx, &problems)
+
+	return &data.TransactionValidationResult{
+		Valid:    len(problems) == 0,
+		Problems: problems,
+	}
+}
+
+func (tp *TransactionProcessor) validateAddress(field string, address string, problems *transactionProblems) {
+	if len(address) == 0 {
+		return
+	}
+
+	_, err := tp.pubKeyConverter.Decode(address)
+	if err != nil {
+		problems.add(field, "invalid address: %s", err.Error())
+	}
+}
+
+func validateValue(tx *data.Transaction, problems *transactionProblems) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		problems.add("value", "value is not a base 10 number")
+		return
+	}
+	if value.Sign() < 0 {
+		problems.add("value", "value is negative")
+	}
+}
+
+func validateChainIDAndVersion(tx *data.Transaction, networkConfig *data.NetworkConfig, problems *transactionProblems) {
+	if len(tx.ChainID) > 0 && tx.ChainID != networkConfig.Config.ChainID {
+		problems.add("chainID", "chain ID mismatch: expected %s, got %s", networkConfig.Config.ChainID, tx.ChainID)
+	}
+
+	if tx.Version > 0 && tx.Version < networkConfig.Config.MinTransactionVersion {
+		problems.add("version", "version %d is lower than the minimum version %d", tx.Version, networkConfig.Config.MinTransactionVersion)
+	}
+	if tx.Options != 0 && tx.Version < minVersionWithOptions {
+		problems.add("options", "options require version %d or higher", minVersionWithOptions)
+	}
+	if tx.Options&guardedTxOptionsMask != 0 {
+		if len(tx.GuardianAddr) == 0 {
+			problems.add("guardian", "guarded transaction without guardian")
+		}
+		if len(tx.GuardianSignature) == 0 {
+			problems.add("guardianSignature", "guarded transaction without guardian signature")
+		}
+	}
+}
+
+func validateSignatures(tx *data.Transaction, problems *transactionProblems) {
+	validateSignature("signature", tx.Signature, true, problems)
+	validateSignature("guardianSignature", tx.GuardianSignature, false, problems)
+	validateSignature("relayerSignature", tx.RelayerSignature, false, problems)
+
+	if len(tx.RelayerAddr) > 0 && len(tx.RelayerSignature) == 0 {
+		problems.add("relayerSignature", "relayed transaction without relayer signature")
+	}
+}
+
+func validateSignature(field string, signature string, isMandatory bool, problems *transactionProblems) {
+	if len(signature) == 0 {
+		if isMandatory {
+			problems.add(field, "missing signature")
+		}
+		return
+	}
+
+	// the signatures which are not hex encoded are already reported by the checks done before sending
+	signatureBytes, err := hex.DecodeString(signature)
+	if err == nil && len(signatureBytes) != signatureLength {
+		problems.add(field, "invalid signature length: expected %d bytes, got %d", signatureLength, len(signatureBytes))
+	}
+}
+
+func validateGas(tx *data.Transaction, networkConfig *data.NetworkConfig, problems *transactionProblems) {
+	if tx.GasPrice < networkConfig.Config.MinGasPrice {
+		problems.add("gasPrice", "gas price %d is lower than the minimum gas price %d", tx.GasPrice, networkConfig.Config.MinGasPrice)
+	}
+
+	minGasLimit := networkConfig.Config.MinGasLimit + networkConfig.Config.GasPerDataByte*uint64(len(tx.Data))
+	if tx.GasLimit < minGasLimit {
+		problems.add("gasLimit", "gas limit %d is lower than the minimum gas limit %d", tx.GasLimit, minGasLimit)
+	}
+
+	maxGasLimit := networkConfig.Config.MaxGasPerTransaction
+	if maxGasLimit > 0 && tx.GasLimit > maxGasLimit {
+		problems.add("gasLimit", "gas limit %d is higher than the maximum gas limit %d", tx.GasLimit, maxGasLimit)
+	}
+}
+
+func (tp *TransactionProcessor) validateBuiltInFunctionData(tx *data.Transaction, problems *transactionProblems) {
+	if len(tx.Data) == 0 {
+		return
+	}
+
+	tokens := strings.Split(string(tx.Data), builtInArgumentsSeparator)
+	function, arguments := tokens[0], tokens[1:]
+	expectedArguments, isKnown := knownBuiltInFunctions[function]
+	if !isKnown {
+		return
+	}
+
+	numArguments := len(arguments)
+	if numArguments < expectedArguments.minArgs {
+		problems.add("data", "%s requires at least %d arguments, got %d", function, expectedArguments.minArgs, numArguments)
+	}
+	if expectedArguments.maxArgs != noMaxBuiltInArguments && numArguments > expectedArguments.maxArgs {
+		problems.add("data", "%s accepts at most %d arguments, got %d", function, expectedArguments.maxArgs, numArguments)
+	}
+
+	decodedArguments := make([][]byte, 0, numArguments)
+	for idx, argument := range arguments {
+		decodedArgument, err := hex.DecodeString(argument)
+		if err != nil {
+			problems.add("data", "argument %d of %s is not hex encoded", idx, function)
+			return
+		}
+		decodedArguments = append(decodedArguments, decodedArgument)
+	}
+
+	switch function {
+	case core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		if tx.Sender != tx.Receiver {
+			problems.add("receiver", "%s must be sent to the sender itself", function)
+		}
+	}
+
+	if function == core.BuiltInFunctionMultiESDTNFTTransfer && numArguments >= expectedArguments.minArgs {
+		validateMultiESDTNFTTransferArguments(decodedArguments, problems)
+	}
+}
+
+func validateMultiESDTNFTTransferArguments(arguments [][]byte, problems *transactionProblems) {
+	// destination, number of transfers, then token identifier, nonce and quantity for each transfer, optionally
+	// followed by the function to be called and its arguments
+	numTransfers := big.NewInt(0).SetBytes(arguments[1])
+	if !numTransfers.IsUint64() || numTransfers.Uint64() == 0 {
+		problems.add("data", "invalid number of transfers for %s", core.BuiltInFunctionMultiESDTNFTTransfer)
+		return
+	}
+
+	numTransferArguments := uint64(len(arguments) - 2)
+	if numTransfers.Uint64() > numTransferArguments/3 {
+		problems.add("data", "%s declares %d transfers but provides the arguments for %d",
+			core.BuiltInFunctionMultiESDTNFTTransfer, numTransfers.Uint64(), numTransferArguments/3)
+	}
+}
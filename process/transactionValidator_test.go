@@ -0,0 +1,169 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validationSender   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	validationReceiver = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+func createValidationNetworkConfig() *data.NetworkConfig {
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinGasLimit = 50000
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.MinTransactionVersion = 1
+	networkConfig.Config.GasPerDataByte = 1500
+	networkConfig.Config.MaxGasPerTransaction = 600000000
+
+	return networkConfig
+}
+
+func createValidTransaction() *data.Transaction {
+	return &data.Transaction{
+		Nonce:     1,
+		Value:     "1000",
+		Receiver:  validationReceiver,
+		Sender:    validationSender,
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		Signature: strings.Repeat("aa", 64),
+		ChainID:   "T",
+		Version:   1,
+	}
+}
+
+func createValidationTransactionProcessor(t *testing.T) *process.TransactionProcessor {
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.NoError(t, err)
+
+	return tp
+}
+
+func getProblemFields(result *data.TransactionValidationResult) []string {
+	fields := make([]string, 0, len(result.Problems))
+	for _, problem := range result.Problems {
+		fields = append(fields, problem.Field)
+	}
+
+	return fields
+}
+
+func TestTransactionProcessor_ValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid transaction should not report problems", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createValidationTransactionProcessor(t)
+		result := tp.ValidateTransaction(createValidTransaction(), createValidationNetworkConfig())
+		require.True(t, result.Valid)
+		require.Empty(t, result.Problems)
+	})
+	t.Run("should report all the problems", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Sender = "invalid"
+		tx.Value = "-5"
+		tx.ChainID = "1"
+		tx.Signature = "aabb"
+		tx.GasPrice = 10
+
+		tp := createValidationTransactionProcessor(t)
+		result := tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.False(t, result.Valid)
+		require.Equal(t, []string{"sender", "value", "chainID", "signature", "gasPrice"}, getProblemFields(result))
+	})
+	t.Run("gas limit should account for the data field and be bounded", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Data = []byte("hello")
+
+		tp := createValidationTransactionProcessor(t)
+		result := tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"gasLimit"}, getProblemFields(result))
+		require.Contains(t, result.Problems[0].Message, "57500")
+
+		tx.GasLimit = 600000001
+		result = tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"gasLimit"}, getProblemFields(result))
+		require.Contains(t, result.Problems[0].Message, "maximum")
+	})
+	t.Run("guarded transaction without guardian should report problems", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Version = 2
+		tx.Options = 2
+
+		tp := createValidationTransactionProcessor(t)
+		result := tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"guardian", "guardianSignature"}, getProblemFields(result))
+	})
+	t.Run("known builtin functions should be checked", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createValidationTransactionProcessor(t)
+
+		tx := createValidTransaction()
+		tx.GasLimit = 500000
+		tx.Data = []byte("ESDTTransfer@" + hex.EncodeToString([]byte("TKN-123456")))
+		result := tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"data"}, getProblemFields(result))
+
+		tx.Data = []byte("ESDTTransfer@" + hex.EncodeToString([]byte("TKN-123456")) + "@zz")
+		result = tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"data"}, getProblemFields(result))
+		require.Contains(t, result.Problems[0].Message, "not hex encoded")
+
+		tx.Data = []byte("ESDTTransfer@" + hex.EncodeToString([]byte("TKN-123456")) + "@0a")
+		result = tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.True(t, result.Valid)
+
+		tx.Data = []byte("ESDTNFTTransfer@" + hex.EncodeToString([]byte("NFT-123456")) + "@01@01@0102")
+		result = tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"receiver"}, getProblemFields(result))
+
+		tx.Receiver = tx.Sender
+		tx.Data = []byte("MultiESDTNFTTransfer@0102@02@" + hex.EncodeToString([]byte("NFT-123456")) + "@01@01")
+		result = tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.Equal(t, []string{"data"}, getProblemFields(result))
+		require.Contains(t, result.Problems[0].Message, "declares 2 transfers")
+	})
+	t.Run("the transactions policy should be checked as on the send path", func(t *testing.T) {
+		t.Parallel()
+
+		txsPolicyChecker, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxGasLimit: 1000}, testPubkeyConverter)
+		tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, testPubkeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, txsPolicyChecker, 1, &disabled.EventsABIRegistry{}, 0)
+		require.NoError(t, err)
+
+		result := tp.ValidateTransaction(createValidTransaction(), createValidationNetworkConfig())
+		require.Equal(t, []string{"policy"}, getProblemFields(result))
+		require.Contains(t, result.Problems[0].Message, apiErrors.ErrGasLimitAboveMaximum.Error())
+	})
+	t.Run("unknown functions should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.GasLimit = 500000
+		tx.Data = []byte("myEndpoint@zz")
+
+		tp := createValidationTransactionProcessor(t)
+		result := tp.ValidateTransaction(tx, createValidationNetworkConfig())
+		require.True(t, result.Valid)
+	})
+}
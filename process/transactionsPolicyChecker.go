@@ -0,0 +1,161 @@
+package process
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type transactionsPolicyChecker struct {
+	pubKeyConverter  core.PubkeyConverter
+	maxGasLimit      uint64
+	maxValue         *big.Int
+	maxDataFieldSize int
+	allowedReceivers map[string]struct{}
+	deniedReceivers  map[string]struct{}
+}
+
+// NewTransactionsPolicyChecker returns a new instance of transactionsPolicyChecker, enforcing the limits and the
+// receivers lists configured by the operator on the relayed transactions. The limits set to 0 are not enforced
+func NewTransactionsPolicyChecker(
+	cfg config.TransactionsPolicyConfig,
+	pubKeyConverter core.PubkeyConverter,
+) (*transactionsPolicyChecker, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if cfg.MaxDataFieldSize < 0 {
+		return nil, ErrInvalidMaxDataFieldSize
+	}
+
+	var maxValue *big.Int
+	if len(cfg.MaxValue) > 0 {
+		var ok bool
+		maxValue, ok = big.NewInt(0).SetString(cfg.MaxValue, 10)
+		if !ok || maxValue.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMaxTransactionValue, cfg.MaxValue)
+		}
+	}
+
+	allowedReceivers, err := decodeReceivers(cfg.AllowedReceivers, pubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the allowed receivers", err)
+	}
+	deniedReceivers, err := decodeReceivers(cfg.DeniedReceivers, pubKeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the denied receivers", err)
+	}
+
+	return &transactionsPolicyChecker{
+		pubKeyConverter:  pubKeyConverter,
+		maxGasLimit:      cfg.MaxGasLimit,
+		maxValue:         maxValue,
+		maxDataFieldSize: cfg.MaxDataFieldSize,
+		allowedReceivers: allowedReceivers,
+		deniedReceivers:  deniedReceivers,
+	}, nil
+}
+
+// decodeReceivers returns the set of the provided receivers, keyed by their decoded bytes so that the different
+// encodings of the same address match
+func decodeReceivers(receivers []string, pubKeyConverter core.PubkeyConverter) (map[string]struct{}, error) {
+	decodedReceivers := make(map[string]struct{}, len(receivers))
+	for _, receiver := range receivers {
+		receiverBytes, err := pubKeyConverter.Decode(receiver)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrInvalidAddress, receiver, err.Error())
+		}
+
+		decodedReceivers[string(receiverBytes)] = struct{}{}
+	}
+
+	return decodedReceivers, nil
+}
+
+// CheckTransaction returns an error describing the first rule of the policy broken by the provided transaction, if any
+func (tpc *transactionsPolicyChecker) CheckTransaction(tx *data.Transaction) error {
+	if tpc.maxGasLimit > 0 && tx.GasLimit > tpc.maxGasLimit {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrGasLimitAboveMaximum.Error(),
+			Reason:  fmt.Sprintf("gas limit %d is above the maximum of %d", tx.GasLimit, tpc.maxGasLimit),
+		}
+	}
+
+	err := tpc.checkValue(tx.Value)
+	if err != nil {
+		return err
+	}
+
+	if tpc.maxDataFieldSize > 0 && len(tx.Data) > tpc.maxDataFieldSize {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrDataFieldTooLarge.Error(),
+			Reason:  fmt.Sprintf("data field of %d bytes is larger than the maximum of %d bytes", len(tx.Data), tpc.maxDataFieldSize),
+		}
+	}
+
+	return tpc.checkReceiver(tx.Receiver)
+}
+
+func (tpc *transactionsPolicyChecker) checkValue(value string) error {
+	if tpc.maxValue == nil {
+		return nil
+	}
+
+	txValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrInvalidTxValue.Error(),
+			Reason:  fmt.Sprintf("%s is not a valid number", value),
+		}
+	}
+	if txValue.Cmp(tpc.maxValue) > 0 {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrValueAboveMaximum.Error(),
+			Reason:  fmt.Sprintf("value %s is above the maximum of %s", value, tpc.maxValue.String()),
+		}
+	}
+
+	return nil
+}
+
+func (tpc *transactionsPolicyChecker) checkReceiver(receiver string) error {
+	if len(tpc.allowedReceivers) == 0 && len(tpc.deniedReceivers) == 0 {
+		return nil
+	}
+
+	receiverBytes, err := tpc.pubKeyConverter.Decode(receiver)
+	if err != nil {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrInvalidReceiverAddress.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	_, isDenied := tpc.deniedReceivers[string(receiverBytes)]
+	if isDenied {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrReceiverNotAllowed.Error(),
+			Reason:  fmt.Sprintf("receiver %s is denied", receiver),
+		}
+	}
+
+	_, isAllowed := tpc.allowedReceivers[string(receiverBytes)]
+	if len(tpc.allowedReceivers) > 0 && !isAllowed {
+		return &apiErrors.ErrInvalidTxFields{
+			Message: apiErrors.ErrReceiverNotAllowed.Error(),
+			Reason:  fmt.Sprintf("receiver %s is not in the allowed list", receiver),
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpc *transactionsPolicyChecker) IsInterfaceNil() bool {
+	return tpc == nil
+}
@@ -0,0 +1,135 @@
+package process_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var policyTestPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+func encodeTestAddress(t *testing.T, b byte) string {
+	address, err := policyTestPubKeyConverter.Encode(bytes.Repeat([]byte{b}, 32))
+	require.Nil(t, err)
+
+	return address
+}
+
+func requireTxPolicyViolation(t *testing.T, err error, expectedMessage error) {
+	invalidTxFields := &apiErrors.ErrInvalidTxFields{}
+	require.True(t, errors.As(err, &invalidTxFields))
+	require.Equal(t, expectedMessage.Error(), invalidTxFields.Message)
+}
+
+func TestNewTransactionsPolicyChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{}, nil)
+		assert.Nil(t, tpc)
+		assert.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid max data field size should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxDataFieldSize: -1}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc)
+		assert.Equal(t, process.ErrInvalidMaxDataFieldSize, err)
+	})
+	t.Run("invalid max value should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxValue: "1.5"}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc)
+		assert.True(t, errors.Is(err, process.ErrInvalidMaxTransactionValue))
+
+		tpc, err = process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxValue: "-1"}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc)
+		assert.True(t, errors.Is(err, process.ErrInvalidMaxTransactionValue))
+	})
+	t.Run("invalid receivers should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{AllowedReceivers: []string{"invalid"}}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+
+		tpc, err = process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{DeniedReceivers: []string{"invalid"}}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc)
+		assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{}, policyTestPubKeyConverter)
+		assert.Nil(t, err)
+		assert.False(t, tpc.IsInterfaceNil())
+	})
+}
+
+func TestTransactionsPolicyChecker_CheckTransaction(t *testing.T) {
+	t.Parallel()
+
+	receiver := encodeTestAddress(t, 1)
+	anotherReceiver := encodeTestAddress(t, 2)
+
+	t.Run("no limits should accept any transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{}, policyTestPubKeyConverter)
+		err := tpc.CheckTransaction(&data.Transaction{
+			Receiver: "not even decoded",
+			Value:    "not even parsed",
+			GasLimit: 600000000,
+			Data:     bytes.Repeat([]byte{'a'}, 100000),
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxGasLimit: 1000}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc.CheckTransaction(&data.Transaction{GasLimit: 1000}))
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{GasLimit: 1001}), apiErrors.ErrGasLimitAboveMaximum)
+	})
+	t.Run("value", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxValue: "1000000000000000000000"}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc.CheckTransaction(&data.Transaction{Value: "1000000000000000000000"}))
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Value: "1000000000000000000001"}), apiErrors.ErrValueAboveMaximum)
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Value: "ten"}), apiErrors.ErrInvalidTxValue)
+	})
+	t.Run("data field size", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{MaxDataFieldSize: 4}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc.CheckTransaction(&data.Transaction{Data: []byte("abcd")}))
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Data: []byte("abcde")}), apiErrors.ErrDataFieldTooLarge)
+	})
+	t.Run("allowed receivers", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{AllowedReceivers: []string{receiver}}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc.CheckTransaction(&data.Transaction{Receiver: receiver}))
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Receiver: anotherReceiver}), apiErrors.ErrReceiverNotAllowed)
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Receiver: "invalid"}), apiErrors.ErrInvalidReceiverAddress)
+	})
+	t.Run("denied receivers", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, _ := process.NewTransactionsPolicyChecker(config.TransactionsPolicyConfig{DeniedReceivers: []string{receiver}}, policyTestPubKeyConverter)
+		assert.Nil(t, tpc.CheckTransaction(&data.Transaction{Receiver: anotherReceiver}))
+		requireTxPolicyViolation(t, tpc.CheckTransaction(&data.Transaction{Receiver: receiver}), apiErrors.ErrReceiverNotAllowed)
+	})
+}
@@ -0,0 +1,132 @@
+package process
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	txPoolReceivedAtField = "receivedAt"
+	txPoolAgeSecondsField = "ageSeconds"
+	agedTxPoolFields      = "hash,sender,receiver,nonce,receivedAt"
+
+	agedTxTypeRegular             = "regular"
+	agedTxTypeSmartContractResult = "smartContractResult"
+	agedTxTypeReward              = "reward"
+)
+
+// addAgeToTransactionsPool sets the ageSeconds field of each transaction for which the observer provided the unix
+// timestamp of its reception
+func addAgeToTransactionsPool(txPool *data.TransactionsPool, now time.Time) {
+	if txPool == nil {
+		return
+	}
+
+	addAgeToWrappedTransactions(txPool.RegularTransactions, now)
+	addAgeToWrappedTransactions(txPool.SmartContractResults, now)
+	addAgeToWrappedTransactions(txPool.Rewards, now)
+}
+
+func addAgeToWrappedTransactions(txs []data.WrappedTransaction, now time.Time) {
+	for _, tx := range txs {
+		receivedAt, ok := getReceivedAtFromTxFields(tx.TxFields)
+		if !ok {
+			continue
+		}
+
+		tx.TxFields[txPoolAgeSecondsField] = computeAgeSeconds(receivedAt, now)
+	}
+}
+
+func getReceivedAtFromTxFields(txFields map[string]interface{}) (int64, bool) {
+	switch receivedAt := txFields[txPoolReceivedAtField].(type) {
+	case float64:
+		return int64(receivedAt), true
+	case json.Number:
+		value, err := receivedAt.Int64()
+		return value, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// computeAgeSeconds returns 0 for timestamps in the future, as the clocks of the observers may drift from the proxy's one
+func computeAgeSeconds(receivedAt int64, now time.Time) uint64 {
+	age := now.Unix() - receivedAt
+	if age < 0 {
+		return 0
+	}
+
+	return uint64(age)
+}
+
+// collectAgedTransactions appends the transactions older than the threshold to the aged pool, counting the ones
+// for which the observer did not provide the reception timestamp
+func collectAgedTransactions(agedPool *data.AgedTransactionsPool, shardID uint32, txPool *data.TransactionsPool, now time.Time) {
+	collectAgedWrappedTransactions(agedPool, shardID, agedTxTypeRegular, txPool.RegularTransactions, now)
+	collectAgedWrappedTransactions(agedPool, shardID, agedTxTypeSmartContractResult, txPool.SmartContractResults, now)
+	collectAgedWrappedTransactions(agedPool, shardID, agedTxTypeReward, txPool.Rewards, now)
+}
+
+func collectAgedWrappedTransactions(
+	agedPool *data.AgedTransactionsPool,
+	shardID uint32,
+	txType string,
+	txs []data.WrappedTransaction,
+	now time.Time,
+) {
+	for _, tx := range txs {
+		receivedAt, ok := getReceivedAtFromTxFields(tx.TxFields)
+		if !ok {
+			agedPool.TransactionsWithoutAge++
+			continue
+		}
+
+		ageSeconds := computeAgeSeconds(receivedAt, now)
+		if ageSeconds < agedPool.OlderThanSeconds {
+			continue
+		}
+
+		agedPool.Transactions = append(agedPool.Transactions, &data.AgedPoolTransaction{
+			Hash:       getStringFromTxFields(tx.TxFields, "hash"),
+			Sender:     getStringFromTxFields(tx.TxFields, "sender"),
+			Receiver:   getStringFromTxFields(tx.TxFields, "receiver"),
+			Nonce:      getUint64FromTxFields(tx.TxFields, "nonce"),
+			Type:       txType,
+			Shard:      shardID,
+			ReceivedAt: receivedAt,
+			AgeSeconds: ageSeconds,
+		})
+	}
+}
+
+// sortAgedTransactions orders the transactions from the oldest to the newest one
+func sortAgedTransactions(txs []*data.AgedPoolTransaction) {
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].AgeSeconds != txs[j].AgeSeconds {
+			return txs[i].AgeSeconds > txs[j].AgeSeconds
+		}
+
+		return txs[i].Hash < txs[j].Hash
+	})
+}
+
+func getStringFromTxFields(txFields map[string]interface{}, field string) string {
+	value, _ := txFields[field].(string)
+	return value
+}
+
+func getUint64FromTxFields(txFields map[string]interface{}, field string) uint64 {
+	switch value := txFields[field].(type) {
+	case float64:
+		return uint64(value)
+	case json.Number:
+		uintValue, _ := value.Int64()
+		return uint64(uintValue)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,199 @@
+package process
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const txPoolChunkParams = "&from=%d&size=%d"
+
+// GetTransactionsPoolChunk returns at most size transactions from the shard's pool, starting with the provided index.
+// The pagination parameters are forwarded to the observers, while the pools of the observers not supporting them are
+// sliced by the proxy
+func (tp *TransactionProcessor) GetTransactionsPoolChunk(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+	if !tp.shouldAllowEntireTxPoolFetch {
+		return nil, errors.ErrOperationNotAllowed
+	}
+	if size == 0 {
+		return nil, ErrInvalidTransactionsPoolChunkSize
+	}
+
+	return tp.getTxPoolChunk(ctx, shardID, fields, from, size)
+}
+
+// StreamTransactionsPool fetches the pools of all shards, or only the one of the provided shard, in chunks of the
+// provided size, passing each of them to the handler as soon as it is fetched. This way, the client receives the pool
+// progressively instead of waiting for a single huge response. The pool of an observer ignoring the pagination
+// parameters is fetched only once and the chunks are sliced out of it
+func (tp *TransactionProcessor) StreamTransactionsPool(ctx context.Context,
+	shardID core.OptionalUint32,
+	fields string,
+	chunkSize uint32,
+	handler func(chunk *data.TransactionsPoolChunk) error,
+) error {
+	if !tp.shouldAllowEntireTxPoolFetch {
+		return errors.ErrOperationNotAllowed
+	}
+	if chunkSize == 0 {
+		return ErrInvalidTransactionsPoolChunkSize
+	}
+
+	shardIDs := tp.proc.GetShardIDs()
+	if shardID.HasValue {
+		shardIDs = []uint32{shardID.Value}
+	}
+
+	for _, id := range shardIDs {
+		err := tp.streamTxPoolForShard(ctx, id, fields, chunkSize, handler)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tp *TransactionProcessor) streamTxPoolForShard(ctx context.Context,
+	shardID uint32,
+	fields string,
+	chunkSize uint32,
+	handler func(chunk *data.TransactionsPoolChunk) error,
+) error {
+	from := uint32(0)
+	for {
+		txPoolResponse, err := tp.getTxPoolResponse(ctx, shardID, fields, from, chunkSize)
+		if err != nil {
+			return err
+		}
+		if !txPoolResponse.Paginated {
+			return streamTxPoolChunks(shardID, &txPoolResponse.Transactions, from, chunkSize, handler)
+		}
+
+		chunk := newTransactionsPoolChunk(shardID, txPoolResponse, from, chunkSize)
+		err = handler(chunk)
+		if err != nil {
+			return err
+		}
+
+		// an empty chunk ends the stream even if the pool was refilled meanwhile
+		if !chunk.HasMore || chunk.NextFrom == from {
+			return nil
+		}
+
+		from = chunk.NextFrom
+	}
+}
+
+// streamTxPoolChunks passes to the handler the chunks sliced out of an entire pool, starting with the provided index
+func streamTxPoolChunks(
+	shardID uint32,
+	txPool *data.TransactionsPool,
+	from uint32,
+	chunkSize uint32,
+	handler func(chunk *data.TransactionsPoolChunk) error,
+) error {
+	for {
+		chunk := sliceTransactionsPoolChunk(shardID, txPool, from, chunkSize)
+		err := handler(chunk)
+		if err != nil {
+			return err
+		}
+
+		if !chunk.HasMore || chunk.NextFrom == from {
+			return nil
+		}
+
+		from = chunk.NextFrom
+	}
+}
+
+func (tp *TransactionProcessor) getTxPoolChunk(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolChunk, error) {
+	txPoolResponse, err := tp.getTxPoolResponse(ctx, shardID, fields, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTransactionsPoolChunk(shardID, txPoolResponse, from, size), nil
+}
+
+func (tp *TransactionProcessor) getTxPoolResponse(ctx context.Context, shardID uint32, fields string, from uint32, size uint32) (*data.TransactionsPoolResponseData, error) {
+	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
+	if err != nil {
+		log.WithContext(ctx).Trace("cannot get observers for shard", "shard", shardID, "error", err)
+		return nil, err
+	}
+
+	apiPath := TransactionsPoolPath + fieldsParam + fields + fmt.Sprintf(txPoolChunkParams, from, size)
+	for _, observer := range observers {
+		txPoolResponse, ok := tp.getTxPoolResponseFromObserver(ctx, observer, apiPath)
+		if !ok {
+			continue
+		}
+
+		return txPoolResponse, nil
+	}
+
+	log.WithContext(ctx).Trace("cannot get tx pool chunk for shard", "shard", shardID, "error", errors.ErrTransactionsNotFoundInPool.Error())
+	return nil, errors.ErrTransactionsNotFoundInPool
+}
+
+// newTransactionsPoolChunk builds the chunk out of the observer's response. A response not flagged as paginated comes
+// from an observer which ignored the pagination parameters and returned its entire pool, so the chunk is sliced out of it
+func newTransactionsPoolChunk(shardID uint32, txPoolResponse *data.TransactionsPoolResponseData, from uint32, size uint32) *data.TransactionsPoolChunk {
+	if !txPoolResponse.Paginated {
+		return sliceTransactionsPoolChunk(shardID, &txPoolResponse.Transactions, from, size)
+	}
+
+	chunk := sliceTransactionsPoolChunk(shardID, &txPoolResponse.Transactions, 0, size)
+	chunk.From = from
+	chunk.NextFrom += from
+	chunk.HasMore = chunk.NextFrom-from == size
+
+	return chunk
+}
+
+// sliceTransactionsPoolChunk returns the chunk of at most size transactions of the pool, starting with the provided index
+func sliceTransactionsPoolChunk(shardID uint32, txPool *data.TransactionsPool, from uint32, size uint32) *data.TransactionsPoolChunk {
+	numTxs := uint64(len(txPool.RegularTransactions) + len(txPool.SmartContractResults) + len(txPool.Rewards))
+
+	start, end := uint64(from), uint64(from)+uint64(size)
+	regularTxs, start, end := sliceWrappedTransactions(txPool.RegularTransactions, start, end)
+	scrs, start, end := sliceWrappedTransactions(txPool.SmartContractResults, start, end)
+	rewards, _, _ := sliceWrappedTransactions(txPool.Rewards, start, end)
+
+	return &data.TransactionsPoolChunk{
+		ShardID: shardID,
+		From:    from,
+		TxPool: data.TransactionsPool{
+			RegularTransactions:  regularTxs,
+			SmartContractResults: scrs,
+			Rewards:              rewards,
+		},
+		NextFrom: from + uint32(len(regularTxs)+len(scrs)+len(rewards)),
+		HasMore:  uint64(from)+uint64(size) < numTxs,
+	}
+}
+
+// sliceWrappedTransactions returns the transactions between the start and end indexes, along with the indexes shifted
+// so that they can be applied to the next list
+func sliceWrappedTransactions(txs []data.WrappedTransaction, start uint64, end uint64) ([]data.WrappedTransaction, uint64, uint64) {
+	numTxs := uint64(len(txs))
+	slice := make([]data.WrappedTransaction, 0)
+	if start < numTxs {
+		slice = txs[start:minUint64(end, numTxs)]
+	}
+
+	return slice, start - minUint64(start, numTxs), end - minUint64(end, numTxs)
+}
+
+func minUint64(a uint64, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
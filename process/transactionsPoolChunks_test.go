@@ -0,0 +1,291 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createPoolTxs(prefix string, numTxs int) []data.WrappedTransaction {
+	txs := make([]data.WrappedTransaction, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		txs = append(txs, data.WrappedTransaction{
+			TxFields: map[string]interface{}{"hash": fmt.Sprintf("%s%d", prefix, i)},
+		})
+	}
+
+	return txs
+}
+
+func createTxPoolChunksProcessor(t *testing.T, allowEntireTxPoolFetch bool, callGetRestEndPoint func(address string, path string, value interface{}) (int, error)) *process.TransactionProcessor {
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: callGetRestEndPoint,
+	}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, allowEntireTxPoolFetch, &mock.TxNotarizationCheckerMock{}, &disabled.TxStatusCache{}, &disabled.SentTxsCache{}, &disabled.TransactionsPolicyChecker{}, 1, &disabled.EventsABIRegistry{}, 0)
+	require.NoError(t, err)
+
+	return tp
+}
+
+// respondWithEntirePool simulates the observers which ignore the pagination parameters
+func respondWithEntirePool(pool data.TransactionsPool) func(address string, path string, value interface{}) (int, error) {
+	return func(address string, path string, value interface{}) (int, error) {
+		response := value.(*data.TransactionsPoolApiResponse)
+		response.Data.Transactions = pool
+
+		return http.StatusOK, nil
+	}
+}
+
+func hashesOfPoolTxs(txs []data.WrappedTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.TxFields["hash"].(string))
+	}
+
+	return hashes
+}
+
+func TestTransactionProcessor_GetTransactionsPoolChunk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, false, nil)
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 0, "", 0, 10)
+		require.Nil(t, chunk)
+		require.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("zero size should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, nil)
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 0, "", 0, 0)
+		require.Nil(t, chunk)
+		require.Equal(t, process.ErrInvalidTransactionsPoolChunkSize, err)
+	})
+	t.Run("observers not answering should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			return http.StatusInternalServerError, errors.New("observer down")
+		})
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 0, "", 0, 10)
+		require.Nil(t, chunk)
+		require.Equal(t, apiErrors.ErrTransactionsNotFoundInPool, err)
+	})
+	t.Run("observer paginating the pool", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			require.Equal(t, "observer1", address)
+			require.Equal(t, "/transaction/pool?fields=hash&from=4&size=2", path)
+
+			response := value.(*data.TransactionsPoolApiResponse)
+			response.Data.Transactions = data.TransactionsPool{
+				RegularTransactions: createPoolTxs("tx", 2),
+			}
+			response.Data.Paginated = true
+			return http.StatusOK, nil
+		})
+
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 1, "hash", 4, 2)
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), chunk.ShardID)
+		require.Equal(t, uint32(4), chunk.From)
+		require.Equal(t, uint32(6), chunk.NextFrom)
+		require.True(t, chunk.HasMore)
+		require.Len(t, chunk.TxPool.RegularTransactions, 2)
+	})
+	t.Run("observer ignoring the pagination should slice the pool", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, respondWithEntirePool(data.TransactionsPool{
+			RegularTransactions:  createPoolTxs("tx", 3),
+			SmartContractResults: createPoolTxs("scr", 2),
+			Rewards:              createPoolTxs("reward", 2),
+		}))
+
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 0, "hash", 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx2"}, hashesOfPoolTxs(chunk.TxPool.RegularTransactions))
+		require.Equal(t, []string{"scr0", "scr1"}, hashesOfPoolTxs(chunk.TxPool.SmartContractResults))
+		require.Empty(t, chunk.TxPool.Rewards)
+		require.Equal(t, uint32(5), chunk.NextFrom)
+		require.True(t, chunk.HasMore)
+
+		chunk, err = tp.GetTransactionsPoolChunk(context.Background(), 0, "hash", 5, 3)
+		require.NoError(t, err)
+		require.Empty(t, chunk.TxPool.RegularTransactions)
+		require.Empty(t, chunk.TxPool.SmartContractResults)
+		require.Equal(t, []string{"reward0", "reward1"}, hashesOfPoolTxs(chunk.TxPool.Rewards))
+		require.Equal(t, uint32(7), chunk.NextFrom)
+		require.False(t, chunk.HasMore)
+	})
+	t.Run("observer ignoring the pagination with a pool of exactly size transactions", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, respondWithEntirePool(data.TransactionsPool{
+			RegularTransactions: createPoolTxs("tx", 3),
+		}))
+
+		chunk, err := tp.GetTransactionsPoolChunk(context.Background(), 0, "hash", 0, 3)
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx0", "tx1", "tx2"}, hashesOfPoolTxs(chunk.TxPool.RegularTransactions))
+		require.Equal(t, uint32(3), chunk.NextFrom)
+		require.False(t, chunk.HasMore)
+
+		chunk, err = tp.GetTransactionsPoolChunk(context.Background(), 0, "hash", 3, 3)
+		require.NoError(t, err)
+		require.Empty(t, chunk.TxPool.RegularTransactions)
+		require.Equal(t, uint32(3), chunk.NextFrom)
+		require.False(t, chunk.HasMore)
+	})
+}
+
+func TestTransactionProcessor_StreamTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, false, nil)
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{}, "", 10, func(chunk *data.TransactionsPoolChunk) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		})
+		require.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("should stream the pools of all shards in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			response := value.(*data.TransactionsPoolApiResponse)
+			if address == "observer0" {
+				response.Data.Transactions = data.TransactionsPool{RegularTransactions: createPoolTxs("tx", 5)}
+			}
+			if address == "observer1" {
+				response.Data.Transactions = data.TransactionsPool{Rewards: createPoolTxs("reward", 1)}
+			}
+
+			return http.StatusOK, nil
+		})
+
+		streamedHashes := make(map[uint32][]string)
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{}, "hash", 2, func(chunk *data.TransactionsPoolChunk) error {
+			hashes := append(hashesOfPoolTxs(chunk.TxPool.RegularTransactions), hashesOfPoolTxs(chunk.TxPool.Rewards)...)
+			streamedHashes[chunk.ShardID] = append(streamedHashes[chunk.ShardID], strings.Join(hashes, ","))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[uint32][]string{
+			0: {"tx0,tx1", "tx2,tx3", "tx4"},
+			1: {"reward0"},
+		}, streamedHashes)
+	})
+	t.Run("observer ignoring the pagination with a pool of exactly size transactions should end the stream", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := 0
+		pool := data.TransactionsPool{RegularTransactions: createPoolTxs("tx", 4)}
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			numRequests++
+			return respondWithEntirePool(pool)(address, path, value)
+		})
+
+		streamedHashes := make([]string, 0)
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{Value: 0, HasValue: true}, "hash", 2, func(chunk *data.TransactionsPoolChunk) error {
+			streamedHashes = append(streamedHashes, strings.Join(hashesOfPoolTxs(chunk.TxPool.RegularTransactions), ","))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx0,tx1", "tx2,tx3"}, streamedHashes)
+		require.Equal(t, 1, numRequests)
+
+		streamedHashes = streamedHashes[:0]
+		numRequests = 0
+		err = tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{Value: 0, HasValue: true}, "hash", 4, func(chunk *data.TransactionsPoolChunk) error {
+			streamedHashes = append(streamedHashes, strings.Join(hashesOfPoolTxs(chunk.TxPool.RegularTransactions), ","))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx0,tx1,tx2,tx3"}, streamedHashes)
+		require.Equal(t, 1, numRequests)
+	})
+	t.Run("observer paginating the pool should be requested until an empty chunk", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			requestedPaths = append(requestedPaths, path)
+
+			response := value.(*data.TransactionsPoolApiResponse)
+			if len(requestedPaths) == 1 {
+				response.Data.Transactions = data.TransactionsPool{RegularTransactions: createPoolTxs("tx", 2)}
+			}
+			response.Data.Paginated = true
+			return http.StatusOK, nil
+		})
+
+		numChunks := 0
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{Value: 0, HasValue: true}, "hash", 2, func(chunk *data.TransactionsPoolChunk) error {
+			numChunks++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, numChunks)
+		require.Equal(t, []string{
+			"/transaction/pool?fields=hash&from=0&size=2",
+			"/transaction/pool?fields=hash&from=2&size=2",
+		}, requestedPaths)
+	})
+	t.Run("should stream only the requested shard", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, func(address string, path string, value interface{}) (int, error) {
+			require.Equal(t, "observer1", address)
+			return http.StatusOK, nil
+		})
+
+		numChunks := 0
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{Value: 1, HasValue: true}, "", 2, func(chunk *data.TransactionsPoolChunk) error {
+			numChunks++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, numChunks)
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTxPoolChunksProcessor(t, true, respondWithEntirePool(data.TransactionsPool{
+			RegularTransactions: createPoolTxs("tx", 5),
+		}))
+
+		expectedErr := errors.New("client disconnected")
+		numChunks := 0
+		err := tp.StreamTransactionsPool(context.Background(), core.OptionalUint32{}, "", 2, func(chunk *data.TransactionsPoolChunk) error {
+			numChunks++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numChunks)
+	})
+}
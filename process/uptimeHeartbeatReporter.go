@@ -0,0 +1,221 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// the heartbeats are signed as the responses of the signed routes are, so that the registries verify them alike
+const (
+	heartbeatSignatureHeader          = "X-Proxy-Signature"
+	heartbeatSignatureTimestampHeader = "X-Proxy-Signature-Timestamp"
+	heartbeatSignerHeader             = "X-Proxy-Signer"
+)
+
+// ArgsUptimeHeartbeatReporter holds the arguments needed to create an UptimeHeartbeatReporter
+type ArgsUptimeHeartbeatReporter struct {
+	Proc                  Processor
+	StatusMetricsProvider StatusMetricsProvider
+	Signer                PayloadSigner
+	RegistryURL           string
+	PublicURL             string
+	Version               string
+	Interval              time.Duration
+	RequestTimeout        time.Duration
+}
+
+// UptimeHeartbeatReporter periodically posts a signed health summary of the proxy to an external uptime registry, so
+// that the network dashboards can track the availability of the public gateways
+type UptimeHeartbeatReporter struct {
+	proc                  Processor
+	statusMetricsProvider StatusMetricsProvider
+	signer                PayloadSigner
+	registryURL           string
+	publicURL             string
+	version               string
+	interval              time.Duration
+	httpClient            *http.Client
+	lastReportTime        time.Time
+	lastNumRequests       uint64
+	lastNumErrors         uint64
+	cancelFunc            func()
+}
+
+// NewUptimeHeartbeatReporter creates a new instance of UptimeHeartbeatReporter and starts sending the heartbeats
+func NewUptimeHeartbeatReporter(args ArgsUptimeHeartbeatReporter) (*UptimeHeartbeatReporter, error) {
+	err := checkUptimeHeartbeatReporterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	uhr := &UptimeHeartbeatReporter{
+		proc:                  args.Proc,
+		statusMetricsProvider: args.StatusMetricsProvider,
+		signer:                args.Signer,
+		registryURL:           args.RegistryURL,
+		publicURL:             args.PublicURL,
+		version:               args.Version,
+		interval:              args.Interval,
+		httpClient:            &http.Client{Timeout: args.RequestTimeout},
+		lastReportTime:        time.Now(),
+	}
+
+	var ctx context.Context
+	ctx, uhr.cancelFunc = context.WithCancel(context.Background())
+	go uhr.reportHeartbeats(ctx)
+
+	return uhr, nil
+}
+
+func checkUptimeHeartbeatReporterArgs(args ArgsUptimeHeartbeatReporter) error {
+	if check.IfNil(args.Proc) {
+		return ErrNilCoreProcessor
+	}
+	if check.IfNil(args.StatusMetricsProvider) {
+		return ErrNilStatusMetricsProvider
+	}
+	if check.IfNil(args.Signer) {
+		return ErrNilPayloadSigner
+	}
+	if len(args.RegistryURL) == 0 {
+		return ErrEmptyRegistryURL
+	}
+	if args.Interval <= 0 {
+		return ErrInvalidHeartbeatInterval
+	}
+	if args.RequestTimeout <= 0 {
+		return ErrInvalidRequestTimeout
+	}
+
+	return nil
+}
+
+func (uhr *UptimeHeartbeatReporter) reportHeartbeats(ctx context.Context) {
+	ticker := time.NewTicker(uhr.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := uhr.postHeartbeat(uhr.createHeartbeat(time.Now()))
+			if err != nil {
+				log.WithContext(ctx).Warn("cannot post the uptime heartbeat", "url", uhr.registryURL, "error", err)
+			}
+		case <-ctx.Done():
+			log.WithContext(ctx).Debug("closing the uptime heartbeats loop")
+			return
+		}
+	}
+}
+
+// createHeartbeat computes the health of the shards from the last nodes sync state check and the requests rates since
+// the previous heartbeat
+func (uhr *UptimeHeartbeatReporter) createHeartbeat(now time.Time) *data.UptimeHeartbeat {
+	syncedObservers := make(map[uint32]int)
+	for _, node := range uhr.proc.GetObserverProvider().GetAllNodesWithSyncState() {
+		if node.IsSynced {
+			syncedObservers[node.ShardId]++
+		}
+	}
+
+	heartbeat := &data.UptimeHeartbeat{
+		PublicURL:       uhr.publicURL,
+		Version:         uhr.version,
+		Timestamp:       now.Unix(),
+		HealthyShards:   make([]uint32, 0),
+		UnhealthyShards: make([]uint32, 0),
+	}
+	for _, shardID := range uhr.proc.GetShardIDs() {
+		if syncedObservers[shardID] > 0 {
+			heartbeat.HealthyShards = append(heartbeat.HealthyShards, shardID)
+		} else {
+			heartbeat.UnhealthyShards = append(heartbeat.UnhealthyShards, shardID)
+		}
+	}
+	sortShardIDs(heartbeat.HealthyShards)
+	sortShardIDs(heartbeat.UnhealthyShards)
+	heartbeat.Healthy = len(heartbeat.UnhealthyShards) == 0
+
+	numRequests, numErrors := uint64(0), uint64(0)
+	for _, endpointMetrics := range uhr.statusMetricsProvider.GetAll() {
+		numRequests += endpointMetrics.NumRequests
+		numErrors += endpointMetrics.NumErrors
+	}
+	heartbeat.NumRequests = numRequests
+	heartbeat.NumErrors = numErrors
+
+	elapsedSeconds := now.Sub(uhr.lastReportTime).Seconds()
+	if elapsedSeconds > 0 {
+		heartbeat.RequestsPerSecond = float64(numRequests-uhr.lastNumRequests) / elapsedSeconds
+		heartbeat.ErrorsPerSecond = float64(numErrors-uhr.lastNumErrors) / elapsedSeconds
+	}
+
+	uhr.lastReportTime = now
+	uhr.lastNumRequests = numRequests
+	uhr.lastNumErrors = numErrors
+
+	return heartbeat
+}
+
+func (uhr *UptimeHeartbeatReporter) postHeartbeat(heartbeat *data.UptimeHeartbeat) error {
+	requestBody, err := json.Marshal(heartbeat)
+	if err != nil {
+		return err
+	}
+
+	signature, err := uhr.signer.SignPayload(heartbeat.Timestamp, requestBody)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, uhr.registryURL, bytes.NewReader(requestBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(heartbeatSignatureHeader, hex.EncodeToString(signature))
+	request.Header.Set(heartbeatSignatureTimestampHeader, strconv.FormatInt(heartbeat.Timestamp, 10))
+	request.Header.Set(heartbeatSignerHeader, uhr.signer.SignerID())
+
+	response, err := uhr.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("uptime registry responded with code %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func sortShardIDs(shardIDs []uint32) {
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+}
+
+// Close stops sending the heartbeats
+func (uhr *UptimeHeartbeatReporter) Close() error {
+	uhr.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (uhr *UptimeHeartbeatReporter) IsInterfaceNil() bool {
+	return uhr == nil
+}
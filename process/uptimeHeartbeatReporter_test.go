@@ -0,0 +1,195 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsUptimeHeartbeatReporter() process.ArgsUptimeHeartbeatReporter {
+	return process.ArgsUptimeHeartbeatReporter{
+		Proc:                  &mock.ProcessorStub{},
+		StatusMetricsProvider: &mock.StatusMetricsProviderStub{},
+		Signer:                &mock.PayloadSignerStub{},
+		RegistryURL:           "http://127.0.0.1:9100/heartbeats",
+		PublicURL:             "https://gateway.example.com",
+		Version:               "v1.2.3",
+		Interval:              time.Minute,
+		RequestTimeout:        time.Second,
+	}
+}
+
+func TestNewUptimeHeartbeatReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.Proc = nil
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil status metrics provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.StatusMetricsProvider = nil
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrNilStatusMetricsProvider, err)
+	})
+	t.Run("nil signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.Signer = nil
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrNilPayloadSigner, err)
+	})
+	t.Run("empty registry URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.RegistryURL = ""
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrEmptyRegistryURL, err)
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.Interval = 0
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrInvalidHeartbeatInterval, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUptimeHeartbeatReporter()
+		args.RequestTimeout = 0
+		uhr, err := process.NewUptimeHeartbeatReporter(args)
+		require.Nil(t, uhr)
+		require.Equal(t, process.ErrInvalidRequestTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		uhr, err := process.NewUptimeHeartbeatReporter(createMockArgsUptimeHeartbeatReporter())
+		require.NoError(t, err)
+		require.False(t, uhr.IsInterfaceNil())
+		require.NoError(t, uhr.Close())
+	})
+}
+
+func TestUptimeHeartbeatReporter_ShouldPostSignedHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	type postedHeartbeat struct {
+		header http.Header
+		body   []byte
+	}
+	postedHeartbeats := make(chan *postedHeartbeat, 10)
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		postedHeartbeats <- &postedHeartbeat{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer registry.Close()
+
+	args := createMockArgsUptimeHeartbeatReporter()
+	args.RegistryURL = registry.URL
+	args.Interval = 50 * time.Millisecond
+	args.Proc = &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MetachainShardId, 1, 0}
+		},
+		GetObserverProviderCalled: func() observer.NodesProviderHandler {
+			return &mock.ObserversProviderStub{
+				GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+					return []*data.NodeData{
+						{ShardId: 0, IsSynced: true},
+						{ShardId: 1, IsSynced: false},
+						{ShardId: core.MetachainShardId, IsSynced: true},
+					}
+				},
+			}
+		},
+	}
+	args.StatusMetricsProvider = &mock.StatusMetricsProviderStub{
+		GetAllCalled: func() map[string]*data.EndpointMetrics {
+			return map[string]*data.EndpointMetrics{
+				"/network/config":   {NumRequests: 7, NumErrors: 1},
+				"/transaction/send": {NumRequests: 3},
+			}
+		},
+	}
+	args.Signer = &mock.PayloadSignerStub{
+		SignPayloadCalled: func(timestamp int64, body []byte) ([]byte, error) {
+			return append([]byte(strconv.FormatInt(timestamp, 10)+"."), body...), nil
+		},
+		SignerIDCalled: func() string {
+			return "erd1signer"
+		},
+	}
+
+	uhr, err := process.NewUptimeHeartbeatReporter(args)
+	require.NoError(t, err)
+	defer func() {
+		_ = uhr.Close()
+	}()
+
+	var posted *postedHeartbeat
+	select {
+	case posted = <-postedHeartbeats:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no heartbeat posted")
+	}
+
+	heartbeat := &data.UptimeHeartbeat{}
+	err = json.Unmarshal(posted.body, heartbeat)
+	require.NoError(t, err)
+	require.Equal(t, "https://gateway.example.com", heartbeat.PublicURL)
+	require.Equal(t, "v1.2.3", heartbeat.Version)
+	require.False(t, heartbeat.Healthy)
+	require.Equal(t, []uint32{0, core.MetachainShardId}, heartbeat.HealthyShards)
+	require.Equal(t, []uint32{1}, heartbeat.UnhealthyShards)
+	require.Equal(t, uint64(10), heartbeat.NumRequests)
+	require.Equal(t, uint64(1), heartbeat.NumErrors)
+	require.Greater(t, heartbeat.RequestsPerSecond, float64(0))
+
+	timestamp := strconv.FormatInt(heartbeat.Timestamp, 10)
+	require.Equal(t, "application/json", posted.header.Get("Content-Type"))
+	require.Equal(t, timestamp, posted.header.Get("X-Proxy-Signature-Timestamp"))
+	require.Equal(t, "erd1signer", posted.header.Get("X-Proxy-Signer"))
+	expectedSignature := append([]byte(timestamp+"."), posted.body...)
+	require.Equal(t, hex.EncodeToString(expectedSignature), posted.header.Get("X-Proxy-Signature"))
+
+	// the requests counters did not change, so the next heartbeat reports no traffic
+	select {
+	case posted = <-postedHeartbeats:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no second heartbeat posted")
+	}
+	heartbeat = &data.UptimeHeartbeat{}
+	err = json.Unmarshal(posted.body, heartbeat)
+	require.NoError(t, err)
+	require.Equal(t, float64(0), heartbeat.RequestsPerSecond)
+	require.Equal(t, float64(0), heartbeat.ErrorsPerSecond)
+}
@@ -1,12 +1,13 @@
 package process
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // GetAuctionList returns the auction list from a metachain observer node
-func (vsp *ValidatorStatisticsProcessor) GetAuctionList() (*data.AuctionListResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -14,13 +15,13 @@ func (vsp *ValidatorStatisticsProcessor) GetAuctionList() (*data.AuctionListResp
 
 	var valStatsResponse data.AuctionListAPIResponse
 	for _, observer := range observers {
-		_, err := vsp.proc.CallGetRestEndPoint(observer.Address, auctionListPath, &valStatsResponse)
+		_, err := vsp.proc.CallGetRestEndPoint(ctx, observer.Address, auctionListPath, &valStatsResponse)
 		if err == nil {
-			log.Info("auction list fetched from API", "observer", observer.Address)
+			log.WithContext(ctx).Info("auction list fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
 		}
 
-		log.Error("getAuctionListFromApi", "observer", observer.Address, "error", err)
+		log.WithContext(ctx).Error("getAuctionListFromApi", "observer", observer.Address, "error", err)
 	}
 
 	return nil, ErrAuctionListNotAvailable
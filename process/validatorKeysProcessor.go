@@ -0,0 +1,137 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	blsKeyLength         = 96
+	getBLSKeyStatusFunc  = "getBLSKeyStatus"
+	getRewardAddressFunc = "getRewardAddress"
+)
+
+// ValidatorKeysProcessor is able to resolve the staking data of the validator BLS keys
+type ValidatorKeysProcessor struct {
+	scQueryProc      SCQueryService
+	valStatsProvider ValidatorStatisticsProvider
+	pubKeyConverter  core.PubkeyConverter
+}
+
+// NewValidatorKeysProcessor creates a new instance of ValidatorKeysProcessor
+func NewValidatorKeysProcessor(
+	scQueryProc SCQueryService,
+	valStatsProvider ValidatorStatisticsProvider,
+	pubKeyConverter core.PubkeyConverter,
+) (*ValidatorKeysProcessor, error) {
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(valStatsProvider) {
+		return nil, ErrNilValidatorStatisticsProvider
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &ValidatorKeysProcessor{
+		scQueryProc:      scQueryProc,
+		valStatsProvider: valStatsProvider,
+		pubKeyConverter:  pubKeyConverter,
+	}, nil
+}
+
+// GetBLSKeyInfo returns the staking status (staked, jailed, queued or unStaked), the owner and the reward address of the
+// provided BLS key, read from the staking contract, along with its statistics, if the key is a known validator
+func (vkp *ValidatorKeysProcessor) GetBLSKeyInfo(ctx context.Context, blsKey string) (*data.BLSKeyInfo, error) {
+	blsKeyBytes, err := hex.DecodeString(blsKey)
+	if err != nil || len(blsKeyBytes) != blsKeyLength {
+		return nil, ErrInvalidBLSKey
+	}
+
+	statusData, err := vkp.queryStakingSC(ctx, getBLSKeyStatusFunc, blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	ownerData, err := vkp.queryStakingSC(ctx, getOwnerFunc, blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	rewardAddressData, err := vkp.queryStakingSC(ctx, getRewardAddressFunc, blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := vkp.encodeAddress(ownerData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, getOwnerFunc)
+	}
+	rewardAddress, err := vkp.encodeAddress(rewardAddressData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, getRewardAddressFunc)
+	}
+
+	return &data.BLSKeyInfo{
+		BLSKey:        hex.EncodeToString(blsKeyBytes),
+		Status:        string(statusData),
+		Owner:         owner,
+		RewardAddress: rewardAddress,
+		Statistics:    vkp.getValidatorStatistics(ctx, hex.EncodeToString(blsKeyBytes)),
+	}, nil
+}
+
+func (vkp *ValidatorKeysProcessor) queryStakingSC(ctx context.Context, function string, blsKey []byte) ([]byte, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: encodeSystemAddress(vkp.pubKeyConverter, common.StakingContractAddressHex),
+		FuncName:  function,
+		Arguments: [][]byte{blsKey},
+	}
+
+	res, _, err := vkp.scQueryProc.ExecuteQuery(ctx, scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.ReturnData) == 0 || len(res.ReturnData[0]) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrEmptyReturnData, function)
+	}
+
+	return res.ReturnData[0], nil
+}
+
+// encodeAddress encodes an address returned by the staking contract, which returns some of the addresses as raw bytes
+// and others hex encoded
+func (vkp *ValidatorKeysProcessor) encodeAddress(addressData []byte) (string, error) {
+	if len(addressData) == vkp.pubKeyConverter.Len() {
+		return vkp.pubKeyConverter.Encode(addressData)
+	}
+
+	addressBytes, err := hex.DecodeString(string(addressData))
+	if err != nil || len(addressBytes) != vkp.pubKeyConverter.Len() {
+		return "", ErrInvalidAddress
+	}
+
+	return vkp.pubKeyConverter.Encode(addressBytes)
+}
+
+// getValidatorStatistics returns the statistics of the provided key, if available. The statistics are optional, the
+// staking data being returned even if they cannot be fetched
+func (vkp *ValidatorKeysProcessor) getValidatorStatistics(ctx context.Context, blsKey string) *data.ValidatorApiResponse {
+	valStats, err := vkp.valStatsProvider.GetValidatorStatistics(ctx)
+	if err != nil {
+		log.WithContext(ctx).Warn("validator keys: cannot get the validator statistics", "error", err.Error())
+		return nil
+	}
+
+	return valStats.Statistics[blsKey]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vkp *ValidatorKeysProcessor) IsInterfaceNil() bool {
+	return vkp == nil
+}
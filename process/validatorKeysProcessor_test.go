@@ -0,0 +1,173 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidatorKeysProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, err := process.NewValidatorKeysProcessor(nil, &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+		require.Nil(t, vkp)
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil validator statistics provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, err := process.NewValidatorKeysProcessor(&mock.SCQueryServiceStub{}, nil, testPubkeyConverter)
+		require.Nil(t, vkp)
+		require.Equal(t, process.ErrNilValidatorStatisticsProvider, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, err := process.NewValidatorKeysProcessor(&mock.SCQueryServiceStub{}, &mock.ValidatorStatisticsProviderStub{}, nil)
+		require.Nil(t, vkp)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, err := process.NewValidatorKeysProcessor(&mock.SCQueryServiceStub{}, &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+		require.NoError(t, err)
+		require.False(t, vkp.IsInterfaceNil())
+	})
+}
+
+func TestValidatorKeysProcessor_GetBLSKeyInfo(t *testing.T) {
+	t.Parallel()
+
+	blsKey := strings.Repeat("ab", 96)
+	owner := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	rewardAddress := "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+	ownerBytes, _ := testPubkeyConverter.Decode(owner)
+	rewardAddressBytes, _ := testPubkeyConverter.Decode(rewardAddress)
+
+	createSCQueryService := func(returnData map[string][]byte) *mock.SCQueryServiceStub {
+		return &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqllls0lczs7", query.ScAddress)
+				require.Equal(t, blsKey, hex.EncodeToString(query.Arguments[0]))
+
+				return &vm.VMOutputApi{ReturnData: [][]byte{returnData[query.FuncName]}}, data.BlockInfo{}, nil
+			},
+		}
+	}
+	stakedKeyReturnData := map[string][]byte{
+		"getBLSKeyStatus":  []byte("staked"),
+		"getOwner":         ownerBytes,
+		"getRewardAddress": []byte(hex.EncodeToString(rewardAddressBytes)),
+	}
+
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, _ := process.NewValidatorKeysProcessor(createSCQueryService(stakedKeyReturnData), &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), "not hex")
+		require.Nil(t, keyInfo)
+		require.Equal(t, process.ErrInvalidBLSKey, err)
+
+		keyInfo, err = vkp.GetBLSKeyInfo(context.Background(), "abcd")
+		require.Nil(t, keyInfo)
+		require.Equal(t, process.ErrInvalidBLSKey, err)
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		vkp, _ := process.NewValidatorKeysProcessor(scQueryProc, &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), blsKey)
+		require.Nil(t, keyInfo)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("empty return data should error", func(t *testing.T) {
+		t.Parallel()
+
+		vkp, _ := process.NewValidatorKeysProcessor(createSCQueryService(map[string][]byte{}), &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), blsKey)
+		require.Nil(t, keyInfo)
+		require.True(t, errors.Is(err, process.ErrEmptyReturnData))
+	})
+	t.Run("invalid owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		returnData := map[string][]byte{
+			"getBLSKeyStatus":  []byte("staked"),
+			"getOwner":         []byte("invalid"),
+			"getRewardAddress": rewardAddressBytes,
+		}
+		vkp, _ := process.NewValidatorKeysProcessor(createSCQueryService(returnData), &mock.ValidatorStatisticsProviderStub{}, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), blsKey)
+		require.Nil(t, keyInfo)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyStatistics := &data.ValidatorApiResponse{ShardId: 2, ValidatorStatus: "eligible", Rating: 90}
+		valStatsProvider := &mock.ValidatorStatisticsProviderStub{
+			GetValidatorStatisticsCalled: func() (*data.ValidatorStatisticsResponse, error) {
+				return &data.ValidatorStatisticsResponse{
+					Statistics: map[string]*data.ValidatorApiResponse{blsKey: keyStatistics},
+				}, nil
+			},
+		}
+		vkp, _ := process.NewValidatorKeysProcessor(createSCQueryService(stakedKeyReturnData), valStatsProvider, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), strings.ToUpper(blsKey))
+		require.NoError(t, err)
+		require.Equal(t, &data.BLSKeyInfo{
+			BLSKey:        blsKey,
+			Status:        "staked",
+			Owner:         owner,
+			RewardAddress: rewardAddress,
+			Statistics:    keyStatistics,
+		}, keyInfo)
+	})
+	t.Run("validator statistics not available should return the staking data", func(t *testing.T) {
+		t.Parallel()
+
+		valStatsProvider := &mock.ValidatorStatisticsProviderStub{
+			GetValidatorStatisticsCalled: func() (*data.ValidatorStatisticsResponse, error) {
+				return nil, errors.New("validator statistics not available")
+			},
+		}
+		returnData := map[string][]byte{
+			"getBLSKeyStatus":  []byte("queued"),
+			"getOwner":         ownerBytes,
+			"getRewardAddress": rewardAddressBytes,
+		}
+		vkp, _ := process.NewValidatorKeysProcessor(createSCQueryService(returnData), valStatsProvider, testPubkeyConverter)
+
+		keyInfo, err := vkp.GetBLSKeyInfo(context.Background(), blsKey)
+		require.NoError(t, err)
+		require.Equal(t, &data.BLSKeyInfo{
+			BLSKey:        blsKey,
+			Status:        "queued",
+			Owner:         owner,
+			RewardAddress: rewardAddress,
+		}, keyInfo)
+	})
+}
@@ -2,16 +2,20 @@ package process
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 const (
 	validatorStatisticsPath = "/validator/statistics"
 	auctionListPath         = "/validator/auction"
+
+	validatorStatisticsSnapshotKey = "validatorStatistics"
 )
 
 // ValidatorStatisticsProcessor is able to process validator statistics data requests
@@ -19,6 +23,7 @@ type ValidatorStatisticsProcessor struct {
 	proc                  Processor
 	cacher                ValidatorStatisticsCacheHandler
 	cacheValidityDuration time.Duration
+	snapshotPersister     CacheSnapshotPersister
 	cancelFunc            func()
 }
 
@@ -27,6 +32,7 @@ func NewValidatorStatisticsProcessor(
 	proc Processor,
 	cacher ValidatorStatisticsCacheHandler,
 	cacheValidityDuration time.Duration,
+	snapshotPersister CacheSnapshotPersister,
 ) (*ValidatorStatisticsProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -37,28 +43,110 @@ func NewValidatorStatisticsProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if check.IfNil(snapshotPersister) {
+		return nil, ErrNilCacheSnapshotPersister
+	}
 	hbp := &ValidatorStatisticsProcessor{
 		proc:                  proc,
 		cacher:                cacher,
 		cacheValidityDuration: cacheValidityDuration,
+		snapshotPersister:     snapshotPersister,
 	}
 
 	return hbp, nil
 }
 
 // GetValidatorStatistics will simply forward the validator statistics data from an observer
-func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	valStatsToReturn, err := vsp.cacher.LoadValStats()
 	if err == nil {
 		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
 	}
 
-	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
+	log.WithContext(ctx).Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
+
+	return vsp.getValidatorStatisticsFromApi(ctx)
+}
+
+// GetFilteredValidatorStatistics returns the page of validator statistics matching the provided options. The filtering
+// is done over the full data set, which is usually served from cache
+func (vsp *ValidatorStatisticsProcessor) GetFilteredValidatorStatistics(ctx context.Context, options common.ValidatorStatisticsQueryOptions) (*data.ValidatorStatisticsPage, error) {
+	err := checkValidatorStatisticsQueryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	valStats, err := vsp.GetValidatorStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(valStats.Statistics))
+	for key, stats := range valStats.Statistics {
+		if stats != nil && isValidatorMatchingOptions(stats, options) {
+			keys = append(keys, key)
+		}
+	}
+	// the keys are sorted in order to provide stable pages between requests
+	sort.Strings(keys)
+
+	page := &data.ValidatorStatisticsPage{
+		Statistics: make(map[string]*data.ValidatorApiResponse),
+		TotalCount: len(keys),
+	}
+	for _, key := range getPage(keys, options.From, options.Size) {
+		page.Statistics[key] = valStats.Statistics[key]
+	}
+
+	return page, nil
+}
+
+func checkValidatorStatisticsQueryOptions(options common.ValidatorStatisticsQueryOptions) error {
+	if options.MinRating.HasValue && options.MaxRating.HasValue && options.MinRating.Value > options.MaxRating.Value {
+		return ErrInvalidRatingRange
+	}
+	if options.MinLeaderSuccess.HasValue && options.MaxLeaderSuccess.HasValue && options.MinLeaderSuccess.Value > options.MaxLeaderSuccess.Value {
+		return ErrInvalidLeaderSuccessRange
+	}
+
+	return nil
+}
+
+func isValidatorMatchingOptions(stats *data.ValidatorApiResponse, options common.ValidatorStatisticsQueryOptions) bool {
+	if options.ShardID.HasValue && stats.ShardId != options.ShardID.Value {
+		return false
+	}
+	if options.MinRating.HasValue && float64(stats.Rating) < options.MinRating.Value {
+		return false
+	}
+	if options.MaxRating.HasValue && float64(stats.Rating) > options.MaxRating.Value {
+		return false
+	}
+	if options.MinLeaderSuccess.HasValue && stats.NumLeaderSuccess < options.MinLeaderSuccess.Value {
+		return false
+	}
+	if options.MaxLeaderSuccess.HasValue && stats.NumLeaderSuccess > options.MaxLeaderSuccess.Value {
+		return false
+	}
+
+	return true
+}
+
+// getPage returns the keys of the requested page. A zero size means that all the keys starting from the offset are returned
+func getPage(keys []string, from uint32, size uint32) []string {
+	if uint64(from) >= uint64(len(keys)) {
+		return nil
+	}
+
+	keys = keys[from:]
+	if size > 0 && uint64(size) < uint64(len(keys)) {
+		keys = keys[:size]
+	}
 
-	return vsp.getValidatorStatisticsFromApi()
+	return keys
 }
 
-func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -67,56 +155,78 @@ func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	var valStatsResponse data.ValidatorStatisticsApiResponse
 	var err error
 	for _, observer := range observers {
-		_, err = vsp.proc.CallGetRestEndPoint(observer.Address, validatorStatisticsPath, &valStatsResponse)
+		_, err = vsp.proc.CallGetRestEndPoint(ctx, observer.Address, validatorStatisticsPath, &valStatsResponse)
 		if err == nil {
-			log.Info("validator statistics fetched from API", "observer", observer.Address)
+			log.WithContext(ctx).Info("validator statistics fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
 		}
-		log.Error("validator statistics", "observer", observer.Address, "error", "no response")
+		log.WithContext(ctx).Error("validator statistics", "observer", observer.Address, "error", "no response")
 	}
 	return nil, ErrValidatorStatisticsNotAvailable
 }
 
-// StartCacheUpdate will start the updating of the cache from the API at a given period
+// StartCacheUpdate will start the updating of the cache from the API at a given period. The cache is first
+// warm-loaded from the saved snapshot, if any, which delays the first update while the snapshot is still fresh
 func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 	if vsp.cancelFunc != nil {
 		log.Error("ValidatorStatisticsProcessor - cache update already started")
 		return
 	}
 
+	firstUpdateDelay := vsp.warmLoadCache()
+
 	var ctx context.Context
 	ctx, vsp.cancelFunc = context.WithCancel(context.Background())
 
 	go func(ctx context.Context) {
-		timer := time.NewTimer(vsp.cacheValidityDuration)
+		timer := time.NewTimer(firstUpdateDelay)
 		defer timer.Stop()
 
-		vsp.handleCacheUpdate()
-
 		for {
-			timer.Reset(vsp.cacheValidityDuration)
-
 			select {
 			case <-timer.C:
-				vsp.handleCacheUpdate()
+				vsp.handleCacheUpdate(ctx)
 			case <-ctx.Done():
-				log.Debug("finishing ValidatorStatisticsProcessor cache update...")
+				log.WithContext(ctx).Debug("finishing ValidatorStatisticsProcessor cache update...")
 				return
 			}
+
+			timer.Reset(vsp.cacheValidityDuration)
 		}
 	}(ctx)
 }
 
-func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
-	valStats, err := vsp.getValidatorStatisticsFromApi()
+func (vsp *ValidatorStatisticsProcessor) warmLoadCache() time.Duration {
+	valStats := make(map[string]*data.ValidatorApiResponse)
+	savedAt, found := vsp.snapshotPersister.LoadSnapshot(validatorStatisticsSnapshotKey, &valStats)
+	if !found {
+		return 0
+	}
+
+	err := vsp.cacher.StoreValStats(valStats)
 	if err != nil {
-		log.Warn("validator statistics: get from API", "error", err.Error())
+		log.Warn("validator statistics: store snapshot in cache", "error", err.Error())
+		return 0
+	}
+
+	return computeFirstCacheUpdateDelay("validator statistics", savedAt, vsp.cacheValidityDuration)
+}
+
+func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate(ctx context.Context) {
+	valStats, err := vsp.getValidatorStatisticsFromApi(ctx)
+	if err != nil {
+		log.WithContext(ctx).Warn("validator statistics: get from API", "error", err.Error())
 	}
 
 	if valStats != nil {
 		err = vsp.cacher.StoreValStats(valStats.Statistics)
 		if err != nil {
-			log.Warn("validator statistics: store in cache", "error", err.Error())
+			log.WithContext(ctx).Warn("validator statistics: store in cache", "error", err.Error())
+		}
+
+		err = vsp.snapshotPersister.SaveSnapshot(validatorStatisticsSnapshotKey, valStats.Statistics)
+		if err != nil {
+			log.WithContext(ctx).Warn("validator statistics: save snapshot", "error", err.Error())
 		}
 	}
 }
@@ -129,3 +239,8 @@ func (vsp *ValidatorStatisticsProcessor) Close() error {
 
 	return nil
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vsp *ValidatorStatisticsProcessor) IsInterfaceNil() bool {
+	return vsp == nil
+}
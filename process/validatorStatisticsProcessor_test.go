@@ -1,21 +1,27 @@
 package process_test
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/multiversx/mx-chain-proxy-go/storage"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheSnapshotPersister{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -24,7 +30,7 @@ func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, time.Second, &disabled.CacheSnapshotPersister{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilValidatorStatisticsCacher, err)
@@ -33,16 +39,25 @@ func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second, &disabled.CacheSnapshotPersister{})
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
 }
 
+func TestNewValidatorStatisticsProcessor_NilSnapshotPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, nil)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, process.ErrNilCacheSnapshotPersister, err)
+}
+
 func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheSnapshotPersister{})
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -51,10 +66,10 @@ func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T)
 func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheSnapshotPersister{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -78,11 +93,12 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataOkValuesShouldPa
 	},
 		&mock.ValStatsCacherMock{},
 		time.Second,
+		&disabled.CacheSnapshotPersister{},
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -105,11 +121,12 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsNoMetaObserverShould
 	},
 		&mock.ValStatsCacherMock{},
 		time.Second,
+		&disabled.CacheSnapshotPersister{},
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, res)
 	assert.Error(t, err)
 }
@@ -132,10 +149,11 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 		},
 		cacher,
 		time.Second,
+		&disabled.CacheSnapshotPersister{},
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetValidatorStatistics()
+	_, err = hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -147,15 +165,101 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 		"key0": {TempRating: 50.7},
 	}
 	cacher := &mock.ValStatsCacherMock{Data: valStatsMap}
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, &disabled.CacheSnapshotPersister{})
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Statistics, valStatsMap)
 }
 
+func TestValidatorStatisticsProcessor_GetFilteredValidatorStatistics(t *testing.T) {
+	t.Parallel()
+
+	valStatsMap := map[string]*data.ValidatorApiResponse{
+		"key0": {ShardId: 0, Rating: 100, NumLeaderSuccess: 5},
+		"key1": {ShardId: 1, Rating: 80, NumLeaderSuccess: 1},
+		"key2": {ShardId: 0, Rating: 50, NumLeaderSuccess: 0},
+		"key3": {ShardId: core.MetachainShardId, Rating: 90, NumLeaderSuccess: 3},
+		"key4": {ShardId: 0, Rating: 95, NumLeaderSuccess: 4},
+	}
+	createProcessor := func() *process.ValidatorStatisticsProcessor {
+		hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{Data: valStatsMap}, time.Second, &disabled.CacheSnapshotPersister{})
+		return hp
+	}
+
+	t.Run("no options should return all the statistics", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, valStatsMap, page.Statistics)
+		assert.Equal(t, 5, page.TotalCount)
+	})
+	t.Run("should filter by shard, rating and leader success", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{
+			ShardID:          core.OptionalUint32{Value: 0, HasValue: true},
+			MinRating:        common.OptionalFloat64{Value: 60, HasValue: true},
+			MaxRating:        common.OptionalFloat64{Value: 99, HasValue: true},
+			MinLeaderSuccess: core.OptionalUint32{Value: 1, HasValue: true},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]*data.ValidatorApiResponse{"key4": valStatsMap["key4"]}, page.Statistics)
+		assert.Equal(t, 1, page.TotalCount)
+
+		page, err = createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{
+			MaxLeaderSuccess: core.OptionalUint32{Value: 1, HasValue: true},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]*data.ValidatorApiResponse{"key1": valStatsMap["key1"], "key2": valStatsMap["key2"]}, page.Statistics)
+	})
+	t.Run("should paginate over the sorted keys", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{From: 1, Size: 2})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]*data.ValidatorApiResponse{"key1": valStatsMap["key1"], "key2": valStatsMap["key2"]}, page.Statistics)
+		assert.Equal(t, 5, page.TotalCount)
+
+		page, err = createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{From: 4, Size: 10})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]*data.ValidatorApiResponse{"key4": valStatsMap["key4"]}, page.Statistics)
+
+		page, err = createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{From: 5})
+		require.Nil(t, err)
+		assert.Empty(t, page.Statistics)
+		assert.Equal(t, 5, page.TotalCount)
+	})
+	t.Run("invalid ranges should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{
+			MinRating: common.OptionalFloat64{Value: 60, HasValue: true},
+			MaxRating: common.OptionalFloat64{Value: 50, HasValue: true},
+		})
+		require.Nil(t, page)
+		require.Equal(t, process.ErrInvalidRatingRange, err)
+
+		page, err = createProcessor().GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{
+			MinLeaderSuccess: core.OptionalUint32{Value: 6, HasValue: true},
+			MaxLeaderSuccess: core.OptionalUint32{Value: 5, HasValue: true},
+		})
+		require.Nil(t, page)
+		require.Equal(t, process.ErrInvalidLeaderSuccessRange, err)
+	})
+	t.Run("statistics not available should error", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second, &disabled.CacheSnapshotPersister{})
+		page, err := hp.GetFilteredValidatorStatistics(context.Background(), common.ValidatorStatisticsQueryOptions{})
+		require.Nil(t, page)
+		require.Error(t, err)
+	})
+}
+
 func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -171,7 +275,8 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 		},
 	},
 		cacher,
-		25*time.Millisecond)
+		25*time.Millisecond,
+		&disabled.CacheSnapshotPersister{})
 
 	assert.Nil(t, err)
 	hp.StartCacheUpdate()
@@ -190,3 +295,60 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 	time.Sleep(5 * time.Millisecond)
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
+
+func TestValidatorStatisticsProcessor_StartCacheUpdateWithSnapshots(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(numOfTimesHttpWasCalled *int32) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				atomic.AddInt32(numOfTimesHttpWasCalled, 1)
+				response := value.(*data.ValidatorStatisticsApiResponse)
+				response.Data.Statistics = map[string]*data.ValidatorApiResponse{"live": {TempRating: 20}}
+				return 0, nil
+			},
+		}
+	}
+
+	t.Run("fresh snapshot should be served without fetching from API", func(t *testing.T) {
+		t.Parallel()
+
+		persister, _ := cache.NewSnapshotPersister(storage.NewMemoryStorer(), time.Hour)
+		snapshotStats := map[string]*data.ValidatorApiResponse{"snapshot": {TempRating: 10}}
+		_ = persister.SaveSnapshot("validatorStatistics", snapshotStats)
+
+		numOfTimesHttpWasCalled := int32(0)
+		cacher := &mock.ValStatsCacherMock{}
+		hp, _ := process.NewValidatorStatisticsProcessor(createProcessor(&numOfTimesHttpWasCalled), cacher, time.Hour, persister)
+		hp.StartCacheUpdate()
+		defer func() {
+			_ = hp.Close()
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+		assert.Equal(t, snapshotStats, cacher.Data)
+	})
+	t.Run("update should save the snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		persister, _ := cache.NewSnapshotPersister(storage.NewMemoryStorer(), time.Hour)
+
+		numOfTimesHttpWasCalled := int32(0)
+		hp, _ := process.NewValidatorStatisticsProcessor(createProcessor(&numOfTimesHttpWasCalled), &mock.ValStatsCacherMock{}, time.Hour, persister)
+		hp.StartCacheUpdate()
+		defer func() {
+			_ = hp.Close()
+		}()
+
+		require.Eventually(t, func() bool {
+			valStats := make(map[string]*data.ValidatorApiResponse)
+			_, found := persister.LoadSnapshot("validatorStatistics", &valStats)
+			return found && valStats["live"] != nil
+		}, time.Second, 5*time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+	})
+}
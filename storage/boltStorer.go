@@ -0,0 +1,256 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	boltOpenTimeout = time.Second
+	// boltExpiredValuesSweepInterval is the interval at which the expired values are removed from the database file, the
+	// expired values being ignored, though still stored, until then
+	boltExpiredValuesSweepInterval = time.Minute
+)
+
+var (
+	boltBucketName         = []byte("proxy")
+	boltExpiriesBucketName = []byte("proxy-expiries")
+)
+
+// boltStorer keeps the values in an embedded BoltDB database file, so they survive a restart of the proxy. All the
+// values are stored in a single bucket, the keys being kept sorted by the database, while the expiry times of the values
+// stored with a TTL are kept in a second bucket, under the same keys
+type boltStorer struct {
+	db             *bolt.DB
+	getTimeHandler func() time.Time
+	// nextSweepTime is only accessed from the write transactions, which the database serializes
+	nextSweepTime time.Time
+}
+
+// NewBoltStorer returns a new instance of boltStorer, creating the database file and its directory if missing. The
+// file is locked while open, so a second proxy using the same file fails to start instead of corrupting it
+func NewBoltStorer(filePath string) (*boltStorer, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyStorageFilePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(filePath, 0644, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, errCreate := tx.CreateBucketIfNotExists(boltBucketName)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		_, errCreate = tx.CreateBucketIfNotExists(boltExpiriesBucketName)
+		return errCreate
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &boltStorer{
+		db:             db,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Put stores the value under the provided key
+func (bs *boltStorer) Put(key string, value []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+
+	return bs.update(func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error {
+		err := expiriesBucket.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(key), value)
+	})
+}
+
+// PutIfAbsent stores the value under the provided key only if no value is stored under it, returning true if the value
+// was stored. The check and the write are done in the same write transaction, so they are atomic. The value expires
+// after the provided TTL, if positive
+func (bs *boltStorer) PutIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrEmptyKey
+	}
+
+	isStored := false
+	err := bs.update(func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error {
+		now := bs.getTimeHandler()
+		err := bs.sweepExpiredValues(bucket, expiriesBucket, now)
+		if err != nil {
+			return err
+		}
+
+		keyBytes := []byte(key)
+		if bucket.Get(keyBytes) != nil && !isExpiredBoltValue(expiriesBucket, keyBytes, now) {
+			return nil
+		}
+
+		err = bucket.Put(keyBytes, value)
+		if err != nil {
+			return err
+		}
+
+		isStored = true
+		expiryTime := computeExpiryTime(now, ttl)
+		if expiryTime.IsZero() {
+			return expiriesBucket.Delete(keyBytes)
+		}
+
+		expiryBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(expiryBytes, uint64(expiryTime.UnixNano()))
+		return expiriesBucket.Put(keyBytes, expiryBytes)
+	})
+
+	return isStored, err
+}
+
+func (bs *boltStorer) sweepExpiredValues(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket, now time.Time) error {
+	if now.Before(bs.nextSweepTime) {
+		return nil
+	}
+
+	expiredKeys := make([][]byte, 0)
+	cursor := expiriesBucket.Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if isExpiredBoltValue(expiriesBucket, key, now) {
+			expiredKeys = append(expiredKeys, append([]byte{}, key...))
+		}
+	}
+
+	// the keys are not deleted while iterating, as this would move the cursor
+	for _, key := range expiredKeys {
+		err := bucket.Delete(key)
+		if err != nil {
+			return err
+		}
+
+		err = expiriesBucket.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	bs.nextSweepTime = now.Add(boltExpiredValuesSweepInterval)
+
+	return nil
+}
+
+func isExpiredBoltValue(expiriesBucket *bolt.Bucket, key []byte, now time.Time) bool {
+	expiryBytes := expiriesBucket.Get(key)
+	if len(expiryBytes) != 8 {
+		return false
+	}
+
+	expiryTime := time.Unix(0, int64(binary.BigEndian.Uint64(expiryBytes)))
+	return !now.Before(expiryTime)
+}
+
+// Get returns the value stored under the provided key
+func (bs *boltStorer) Get(key string) ([]byte, error) {
+	var value []byte
+	err := bs.view(func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error {
+		storedValue := bucket.Get([]byte(key))
+		if storedValue == nil || isExpiredBoltValue(expiriesBucket, []byte(key), bs.getTimeHandler()) {
+			return ErrKeyNotFound
+		}
+
+		// the returned slice is only valid during the transaction
+		value = append([]byte{}, storedValue...)
+		return nil
+	})
+
+	return value, err
+}
+
+// Remove deletes the value stored under the provided key, if any
+func (bs *boltStorer) Remove(key string) error {
+	if len(key) == 0 {
+		return nil
+	}
+
+	return bs.update(func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error {
+		err := expiriesBucket.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Delete([]byte(key))
+	})
+}
+
+// Keys returns the sorted keys starting with the provided prefix
+func (bs *boltStorer) Keys(prefix string) ([]string, error) {
+	keys := make([]string, 0)
+	err := bs.view(func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error {
+		now := bs.getTimeHandler()
+		prefixBytes := []byte(prefix)
+		cursor := bucket.Cursor()
+		for key, _ := cursor.Seek(prefixBytes); key != nil && bytes.HasPrefix(key, prefixBytes); key, _ = cursor.Next() {
+			if !isExpiredBoltValue(expiriesBucket, key, now) {
+				keys = append(keys, string(key))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (bs *boltStorer) update(handler func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error) error {
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		return handler(tx.Bucket(boltBucketName), tx.Bucket(boltExpiriesBucketName))
+	})
+
+	return convertBoltError(err)
+}
+
+func (bs *boltStorer) view(handler func(bucket *bolt.Bucket, expiriesBucket *bolt.Bucket) error) error {
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		return handler(tx.Bucket(boltBucketName), tx.Bucket(boltExpiriesBucketName))
+	})
+
+	return convertBoltError(err)
+}
+
+func convertBoltError(err error) error {
+	if errors.Is(err, bolt.ErrDatabaseNotOpen) {
+		return ErrStorageClosed
+	}
+
+	return err
+}
+
+// Close closes the database file
+func (bs *boltStorer) Close() error {
+	return bs.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bs *boltStorer) IsInterfaceNil() bool {
+	return bs == nil
+}
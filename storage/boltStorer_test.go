@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoltStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		bs, err := NewBoltStorer("")
+		require.Nil(t, bs)
+		require.Equal(t, ErrEmptyStorageFilePath, err)
+	})
+	t.Run("should work and create the directory", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "storage", "proxy.db")
+		bs, err := NewBoltStorer(filePath)
+		require.NoError(t, err)
+		require.False(t, bs.IsInterfaceNil())
+		require.FileExists(t, filePath)
+		require.NoError(t, bs.Close())
+	})
+}
+
+func TestBoltStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store the values", func(t *testing.T) {
+		t.Parallel()
+
+		bs, _ := NewBoltStorer(filepath.Join(t.TempDir(), "proxy.db"))
+		testStorer(t, bs)
+	})
+	t.Run("values stored with a TTL should expire", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		bs, _ := NewBoltStorer(filepath.Join(t.TempDir(), "proxy.db"))
+		bs.getTimeHandler = func() time.Time {
+			return now
+		}
+		testStorerExpiry(t, bs, func(duration time.Duration) {
+			now = now.Add(duration)
+		})
+		require.NoError(t, bs.Close())
+	})
+	t.Run("put should clear the TTL", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		bs, _ := NewBoltStorer(filepath.Join(t.TempDir(), "proxy.db"))
+		bs.getTimeHandler = func() time.Time {
+			return now
+		}
+		_, _ = bs.PutIfAbsent("key", []byte("value"), time.Minute)
+		require.NoError(t, bs.Put("key", []byte("new value")))
+
+		now = now.Add(time.Hour)
+		value, err := bs.Get("key")
+		require.NoError(t, err)
+		require.Equal(t, []byte("new value"), value)
+		require.NoError(t, bs.Close())
+	})
+	t.Run("values should survive a restart", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "proxy.db")
+		bs, _ := NewBoltStorer(filePath)
+		require.NoError(t, bs.Put("faucet/requests", []byte("requests")))
+		require.NoError(t, bs.Close())
+
+		restartedStorer, _ := NewBoltStorer(filePath)
+		value, err := restartedStorer.Get("faucet/requests")
+		require.NoError(t, err)
+		require.Equal(t, []byte("requests"), value)
+		require.NoError(t, restartedStorer.Close())
+	})
+	t.Run("closed storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		bs, _ := NewBoltStorer(filepath.Join(t.TempDir(), "proxy.db"))
+		require.NoError(t, bs.Close())
+
+		err := bs.Put("key", []byte("value"))
+		require.Equal(t, ErrStorageClosed, err)
+	})
+}
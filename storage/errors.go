@@ -0,0 +1,24 @@
+package storage
+
+import "errors"
+
+// ErrKeyNotFound signals that the requested key is not stored
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrEmptyKey signals that an empty key has been provided
+var ErrEmptyKey = errors.New("empty key")
+
+// ErrUnknownStorageType signals that an unknown storage type has been provided
+var ErrUnknownStorageType = errors.New("unknown storage type")
+
+// ErrEmptyStorageFilePath signals that an empty file path has been provided for the BoltDB storage
+var ErrEmptyStorageFilePath = errors.New("empty storage file path")
+
+// ErrEmptyRedisAddress signals that an empty address has been provided for the Redis storage
+var ErrEmptyRedisAddress = errors.New("empty redis address")
+
+// ErrInvalidRedisTimeout signals that an invalid timeout has been provided for the Redis storage
+var ErrInvalidRedisTimeout = errors.New("invalid redis timeout")
+
+// ErrStorageClosed signals that the storage was closed
+var ErrStorageClosed = errors.New("storage closed")
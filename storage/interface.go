@@ -0,0 +1,14 @@
+package storage
+
+import "time"
+
+// Storer defines what a key-value storage persisting the proxy-side data should be able to do
+type Storer interface {
+	Put(key string, value []byte) error
+	PutIfAbsent(key string, value []byte, ttl time.Duration) (bool, error)
+	Get(key string) ([]byte, error)
+	Remove(key string) error
+	Keys(prefix string) ([]string, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryExpiredValuesSweepInterval is the interval at which the expired values are removed from memory, the expired
+// values being ignored, though still held, until then
+const memoryExpiredValuesSweepInterval = time.Minute
+
+type memoryValue struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (mv *memoryValue) isExpired(now time.Time) bool {
+	return !mv.expiresAt.IsZero() && !now.Before(mv.expiresAt)
+}
+
+// memoryStorer keeps the values in memory only, so they are lost on restart
+type memoryStorer struct {
+	values         map[string]*memoryValue
+	nextSweepTime  time.Time
+	getTimeHandler func() time.Time
+	mutValues      sync.RWMutex
+}
+
+// NewMemoryStorer returns a new instance of memoryStorer
+func NewMemoryStorer() *memoryStorer {
+	return &memoryStorer{
+		values:         make(map[string]*memoryValue),
+		getTimeHandler: time.Now,
+	}
+}
+
+// Put stores a copy of the value under the provided key
+func (ms *memoryStorer) Put(key string, value []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+
+	ms.mutValues.Lock()
+	ms.values[key] = &memoryValue{
+		value: append([]byte{}, value...),
+	}
+	ms.mutValues.Unlock()
+
+	return nil
+}
+
+// PutIfAbsent stores a copy of the value under the provided key only if no value is stored under it, returning true if
+// the value was stored. The value expires after the provided TTL, if positive
+func (ms *memoryStorer) PutIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrEmptyKey
+	}
+
+	ms.mutValues.Lock()
+	defer ms.mutValues.Unlock()
+
+	now := ms.getTimeHandler()
+	ms.sweepExpiredValues(now)
+
+	storedValue, found := ms.values[key]
+	if found && !storedValue.isExpired(now) {
+		return false, nil
+	}
+
+	ms.values[key] = &memoryValue{
+		value:     append([]byte{}, value...),
+		expiresAt: computeExpiryTime(now, ttl),
+	}
+
+	return true, nil
+}
+
+func (ms *memoryStorer) sweepExpiredValues(now time.Time) {
+	if now.Before(ms.nextSweepTime) {
+		return
+	}
+
+	for key, storedValue := range ms.values {
+		if storedValue.isExpired(now) {
+			delete(ms.values, key)
+		}
+	}
+	ms.nextSweepTime = now.Add(memoryExpiredValuesSweepInterval)
+}
+
+// Get returns a copy of the value stored under the provided key
+func (ms *memoryStorer) Get(key string) ([]byte, error) {
+	ms.mutValues.RLock()
+	defer ms.mutValues.RUnlock()
+
+	storedValue, found := ms.values[key]
+	if !found || storedValue.isExpired(ms.getTimeHandler()) {
+		return nil, ErrKeyNotFound
+	}
+
+	return append([]byte{}, storedValue.value...), nil
+}
+
+// Remove deletes the value stored under the provided key, if any
+func (ms *memoryStorer) Remove(key string) error {
+	ms.mutValues.Lock()
+	delete(ms.values, key)
+	ms.mutValues.Unlock()
+
+	return nil
+}
+
+// Keys returns the sorted keys starting with the provided prefix
+func (ms *memoryStorer) Keys(prefix string) ([]string, error) {
+	ms.mutValues.RLock()
+	defer ms.mutValues.RUnlock()
+
+	now := ms.getTimeHandler()
+	keys := make([]string, 0)
+	for key, storedValue := range ms.values {
+		if strings.HasPrefix(key, prefix) && !storedValue.isExpired(now) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// Close does nothing as there is no resource to release
+func (ms *memoryStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ms *memoryStorer) IsInterfaceNil() bool {
+	return ms == nil
+}
+
+// computeExpiryTime returns the time the values stored now expire at, the zero time meaning they never expire
+func computeExpiryTime(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return now.Add(ttl)
+}
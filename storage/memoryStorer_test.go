@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testStorer(t *testing.T, storer Storer) {
+	require.False(t, storer.IsInterfaceNil())
+
+	err := storer.Put("", []byte("value"))
+	require.Equal(t, ErrEmptyKey, err)
+
+	value, err := storer.Get("faucet/requests")
+	require.Nil(t, value)
+	require.Equal(t, ErrKeyNotFound, err)
+
+	require.NoError(t, storer.Put("faucet/requests", []byte("requests")))
+	require.NoError(t, storer.Put("cache-snapshots/networkConfig", []byte("config")))
+	require.NoError(t, storer.Put("cache-snapshots/economicMetrics", []byte("metrics")))
+	require.NoError(t, storer.Put("cache-snapshots/economicMetrics", []byte("new metrics")))
+
+	value, err = storer.Get("cache-snapshots/economicMetrics")
+	require.NoError(t, err)
+	require.Equal(t, []byte("new metrics"), value)
+
+	keys, err := storer.Keys("cache-snapshots/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"cache-snapshots/economicMetrics", "cache-snapshots/networkConfig"}, keys)
+
+	require.NoError(t, storer.Remove("cache-snapshots/networkConfig"))
+	require.NoError(t, storer.Remove("missing"))
+	_, err = storer.Get("cache-snapshots/networkConfig")
+	require.Equal(t, ErrKeyNotFound, err)
+
+	keys, err = storer.Keys("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"cache-snapshots/economicMetrics", "faucet/requests"}, keys)
+
+	_, err = storer.PutIfAbsent("", []byte("value"), time.Minute)
+	require.Equal(t, ErrEmptyKey, err)
+
+	isStored, err := storer.PutIfAbsent("sent-txs/hash", []byte("first"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, isStored)
+	isStored, err = storer.PutIfAbsent("sent-txs/hash", []byte("second"), time.Minute)
+	require.NoError(t, err)
+	require.False(t, isStored)
+	isStored, err = storer.PutIfAbsent("faucet/requests", []byte("other requests"), 0)
+	require.NoError(t, err)
+	require.False(t, isStored)
+
+	value, err = storer.Get("sent-txs/hash")
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), value)
+
+	require.NoError(t, storer.Remove("sent-txs/hash"))
+	isStored, err = storer.PutIfAbsent("sent-txs/hash", []byte("third"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, isStored)
+
+	require.NoError(t, storer.Close())
+}
+
+// testStorerExpiry checks that the values stored with a TTL expire, the provided handler moving the time forward
+func testStorerExpiry(t *testing.T, storer Storer, forwardTime func(duration time.Duration)) {
+	isStored, err := storer.PutIfAbsent("sent-txs/hash0", []byte("value"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, isStored)
+	require.NoError(t, storer.Put("faucet/requests", []byte("requests")))
+
+	forwardTime(30 * time.Second)
+	isStored, err = storer.PutIfAbsent("sent-txs/hash1", []byte("value"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, isStored)
+	isStored, err = storer.PutIfAbsent("sent-txs/hash0", []byte("value"), time.Minute)
+	require.NoError(t, err)
+	require.False(t, isStored)
+
+	forwardTime(30 * time.Second)
+	_, err = storer.Get("sent-txs/hash0")
+	require.Equal(t, ErrKeyNotFound, err)
+	keys, err := storer.Keys("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"faucet/requests", "sent-txs/hash1"}, keys)
+
+	isStored, err = storer.PutIfAbsent("sent-txs/hash0", []byte("new value"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, isStored)
+
+	forwardTime(time.Hour)
+	value, err := storer.Get("faucet/requests")
+	require.NoError(t, err)
+	require.Equal(t, []byte("requests"), value)
+	keys, err = storer.Keys("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"faucet/requests"}, keys)
+}
+
+func TestMemoryStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store the values", func(t *testing.T) {
+		t.Parallel()
+
+		testStorer(t, NewMemoryStorer())
+	})
+	t.Run("values stored with a TTL should expire", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		ms := NewMemoryStorer()
+		ms.getTimeHandler = func() time.Time {
+			return now
+		}
+		testStorerExpiry(t, ms, func(duration time.Duration) {
+			now = now.Add(duration)
+		})
+
+		_, _ = ms.PutIfAbsent("key", []byte("value"), 0)
+		require.Len(t, ms.values, 2)
+	})
+	t.Run("should store copies of the values", func(t *testing.T) {
+		t.Parallel()
+
+		ms := NewMemoryStorer()
+		value := []byte("value")
+		require.NoError(t, ms.Put("key", value))
+		value[0] = 'V'
+
+		storedValue, _ := ms.Get("key")
+		require.Equal(t, []byte("value"), storedValue)
+		storedValue[0] = 'V'
+
+		storedValue, _ = ms.Get("key")
+		require.Equal(t, []byte("value"), storedValue)
+	})
+}
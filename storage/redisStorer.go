@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisScanCount = 1000
+
+// ArgsRedisStorer holds the arguments needed to create a redisStorer
+type ArgsRedisStorer struct {
+	Address   string
+	Password  string
+	DB        int
+	KeyPrefix string
+	Timeout   time.Duration
+}
+
+// redisStorer keeps the values in a Redis server, so that they are shared by all the proxies using the same server and
+// survive their restarts. The connections are pooled by the Redis client, which opens them on the first use and opens
+// them again after a failure
+type redisStorer struct {
+	client    *redis.Client
+	keyPrefix string
+	timeout   time.Duration
+}
+
+// NewRedisStorer returns a new instance of redisStorer. All the keys are stored under the provided prefix, so that
+// more proxies, or other applications, can use the same Redis database
+func NewRedisStorer(args ArgsRedisStorer) (*redisStorer, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyRedisAddress
+	}
+	if args.Timeout <= 0 {
+		return nil, ErrInvalidRedisTimeout
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         args.Address,
+		Password:     args.Password,
+		DB:           args.DB,
+		DialTimeout:  args.Timeout,
+		ReadTimeout:  args.Timeout,
+		WriteTimeout: args.Timeout,
+	})
+
+	return &redisStorer{
+		client:    client,
+		keyPrefix: args.KeyPrefix,
+		timeout:   args.Timeout,
+	}, nil
+}
+
+// Put stores the value under the provided key
+func (rs *redisStorer) Put(key string, value []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+
+	ctx, cancel := rs.createContext()
+	defer cancel()
+
+	err := rs.client.Set(ctx, rs.keyPrefix+key, value, 0).Err()
+	return convertRedisError(err)
+}
+
+// PutIfAbsent stores the value under the provided key only if no value is stored under it, returning true if the value
+// was stored. The check and the write are a single SET NX command, so they are atomic across all the proxies using the
+// same server. The value expires after the provided TTL, if positive
+func (rs *redisStorer) PutIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrEmptyKey
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	ctx, cancel := rs.createContext()
+	defer cancel()
+
+	isStored, err := rs.client.SetNX(ctx, rs.keyPrefix+key, value, ttl).Result()
+	if err != nil {
+		return false, convertRedisError(err)
+	}
+
+	return isStored, nil
+}
+
+// Get returns the value stored under the provided key
+func (rs *redisStorer) Get(key string) ([]byte, error) {
+	ctx, cancel := rs.createContext()
+	defer cancel()
+
+	value, err := rs.client.Get(ctx, rs.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, convertRedisError(err)
+	}
+
+	return value, nil
+}
+
+// Remove deletes the value stored under the provided key, if any
+func (rs *redisStorer) Remove(key string) error {
+	ctx, cancel := rs.createContext()
+	defer cancel()
+
+	err := rs.client.Del(ctx, rs.keyPrefix+key).Err()
+	return convertRedisError(err)
+}
+
+// Keys returns the sorted keys starting with the provided prefix. The keys are iterated with SCAN, so that a large
+// database is not blocked as it would be by KEYS
+func (rs *redisStorer) Keys(prefix string) ([]string, error) {
+	ctx, cancel := rs.createContext()
+	defer cancel()
+
+	pattern := escapeRedisPattern(rs.keyPrefix+prefix) + "*"
+	uniqueKeys := make(map[string]struct{})
+	iterator := rs.client.Scan(ctx, 0, pattern, redisScanCount).Iterator()
+	for iterator.Next(ctx) {
+		uniqueKeys[strings.TrimPrefix(iterator.Val(), rs.keyPrefix)] = struct{}{}
+	}
+	err := iterator.Err()
+	if err != nil {
+		return nil, convertRedisError(err)
+	}
+
+	keys := make([]string, 0, len(uniqueKeys))
+	for key := range uniqueKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func (rs *redisStorer) createContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rs.timeout)
+}
+
+func convertRedisError(err error) error {
+	if errors.Is(err, redis.ErrClosed) {
+		return ErrStorageClosed
+	}
+
+	return err
+}
+
+// escapeRedisPattern escapes the characters having a special meaning in the glob-style patterns of SCAN
+func escapeRedisPattern(pattern string) string {
+	escaped := strings.Builder{}
+	for _, character := range pattern {
+		if strings.ContainsRune(`*?[]\`, character) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(character)
+	}
+
+	return escaped.String()
+}
+
+// Close closes the connections to the Redis server
+func (rs *redisStorer) Close() error {
+	return rs.client.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *redisStorer) IsInterfaceNil() bool {
+	return rs == nil
+}
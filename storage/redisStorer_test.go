@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsRedisStorer(address string) ArgsRedisStorer {
+	return ArgsRedisStorer{
+		Address:   address,
+		KeyPrefix: "proxy/",
+		Timeout:   time.Second,
+	}
+}
+
+func TestNewRedisStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewRedisStorer(createArgsRedisStorer(""))
+		require.Nil(t, rs)
+		require.Equal(t, ErrEmptyRedisAddress, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsRedisStorer("127.0.0.1:6379")
+		args.Timeout = 0
+		rs, err := NewRedisStorer(args)
+		require.Nil(t, rs)
+		require.Equal(t, ErrInvalidRedisTimeout, err)
+	})
+	t.Run("should work without connecting", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewRedisStorer(createArgsRedisStorer("127.0.0.1:1"))
+		require.NoError(t, err)
+		require.False(t, rs.IsInterfaceNil())
+	})
+}
+
+func TestRedisStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store the values under the key prefix", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		rs, _ := NewRedisStorer(createArgsRedisStorer(server.Addr()))
+		testStorer(t, rs)
+
+		require.True(t, server.Exists("proxy/faucet/requests"))
+	})
+	t.Run("values stored with a TTL should expire", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		rs, _ := NewRedisStorer(createArgsRedisStorer(server.Addr()))
+		testStorerExpiry(t, rs, server.FastForward)
+
+		_, _ = rs.PutIfAbsent("key", []byte("value"), time.Minute)
+		require.Equal(t, time.Minute, server.TTL("proxy/key"))
+	})
+	t.Run("should authenticate and select the database", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		server.RequireAuth("secret")
+		args := createArgsRedisStorer(server.Addr())
+		args.Password = "secret"
+		args.DB = 2
+		rs, _ := NewRedisStorer(args)
+
+		require.NoError(t, rs.Put("key", []byte("value")))
+		server.Select(2)
+		value, err := server.Get("proxy/key")
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		server.RequireAuth("secret")
+		args := createArgsRedisStorer(server.Addr())
+		args.Password = "other secret"
+		rs, _ := NewRedisStorer(args)
+
+		err := rs.Put("key", []byte("value"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "WRONGPASS")
+	})
+	t.Run("unreachable server should error", func(t *testing.T) {
+		t.Parallel()
+
+		listener, _ := net.Listen("tcp", "127.0.0.1:0")
+		address := listener.Addr().String()
+		_ = listener.Close()
+
+		rs, _ := NewRedisStorer(createArgsRedisStorer(address))
+		_, err := rs.Get("key")
+		require.Error(t, err)
+	})
+	t.Run("restarted server should be reconnected", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		rs, _ := NewRedisStorer(createArgsRedisStorer(server.Addr()))
+		require.NoError(t, rs.Put("key", []byte("value")))
+
+		server.Close()
+		require.NoError(t, server.Restart())
+
+		value, err := rs.Get("key")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+	})
+	t.Run("closed storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := miniredis.RunT(t)
+		rs, _ := NewRedisStorer(createArgsRedisStorer(server.Addr()))
+		require.NoError(t, rs.Close())
+
+		err := rs.Put("key", []byte("value"))
+		require.Equal(t, ErrStorageClosed, err)
+	})
+}
+
+func TestEscapeRedisPattern(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `proxy/\*\?\[\]\\/`, escapeRedisPattern(`proxy/*?[]\/`))
+	require.Equal(t, "proxy/", escapeRedisPattern("proxy/"))
+}
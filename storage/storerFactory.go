@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+var log = logger.GetOrCreate("storage")
+
+const (
+	// MemoryStorageType is the type of the storage keeping the data in memory only
+	MemoryStorageType = "memory"
+	// BoltStorageType is the type of the storage writing the data in an embedded BoltDB database file
+	BoltStorageType = "bolt"
+	// RedisStorageType is the type of the storage writing the data in a Redis server
+	RedisStorageType = "redis"
+)
+
+// NewStorer returns the storer of the configured type. The Redis password is provided apart, as it is read from the
+// credentials file
+func NewStorer(cfg config.StorageConfig, redisPassword string) (Storer, error) {
+	switch cfg.Type {
+	case MemoryStorageType:
+		log.Info("using the memory storage")
+		return NewMemoryStorer(), nil
+	case BoltStorageType:
+		log.Info("using the bolt storage", "file", cfg.FilePath)
+		return NewBoltStorer(cfg.FilePath)
+	case RedisStorageType:
+		log.Info("using the redis storage", "address", cfg.Redis.Address, "db", cfg.Redis.DB, "key prefix", cfg.Redis.KeyPrefix)
+		return NewRedisStorer(ArgsRedisStorer{
+			Address:   cfg.Redis.Address,
+			Password:  redisPassword,
+			DB:        cfg.Redis.DB,
+			KeyPrefix: cfg.Redis.KeyPrefix,
+			Timeout:   time.Duration(cfg.Redis.TimeoutSec) * time.Second,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStorageType, cfg.Type)
+	}
+}
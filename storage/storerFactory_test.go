@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewStorer(config.StorageConfig{Type: "leveldb"}, "")
+		require.Nil(t, storer)
+		require.True(t, errors.Is(err, ErrUnknownStorageType))
+	})
+	t.Run("memory type should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewStorer(config.StorageConfig{Type: MemoryStorageType}, "")
+		require.NoError(t, err)
+		require.Equal(t, "*storage.memoryStorer", fmt.Sprintf("%T", storer))
+	})
+	t.Run("bolt type should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewStorer(config.StorageConfig{Type: BoltStorageType, FilePath: filepath.Join(t.TempDir(), "proxy.db")}, "")
+		require.NoError(t, err)
+		require.Equal(t, "*storage.boltStorer", fmt.Sprintf("%T", storer))
+		require.NoError(t, storer.Close())
+
+		_, err = NewStorer(config.StorageConfig{Type: BoltStorageType}, "")
+		require.Equal(t, ErrEmptyStorageFilePath, err)
+	})
+	t.Run("redis type should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewStorer(config.StorageConfig{
+			Type: RedisStorageType,
+			Redis: config.RedisStorageConfig{
+				Address:    "127.0.0.1:6379",
+				TimeoutSec: 5,
+			},
+		}, "secret")
+		require.NoError(t, err)
+		require.Equal(t, "*storage.redisStorer", fmt.Sprintf("%T", storer))
+
+		_, err = NewStorer(config.StorageConfig{Type: RedisStorageType}, "")
+		require.Equal(t, ErrEmptyRedisAddress, err)
+	})
+}
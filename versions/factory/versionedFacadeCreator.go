@@ -4,6 +4,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/api"
 	apiv_next "github.com/multiversx/mx-chain-proxy-go/api/groups/v_next"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
 	facadeVersions "github.com/multiversx/mx-chain-proxy-go/facade/versions"
@@ -29,6 +30,21 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	GasPriceProcessor            facade.GasPriceProcessor
+	SovereignProcessor           facade.SovereignProcessor
+	NetworkStatusStreamer        facade.NetworkStatusStreamer
+	NodePassthroughProc          facade.NodePassthroughProcessor
+	CollectionsProc              facade.CollectionsProcessor
+	DataFreshnessProc            facade.DataFreshnessProcessor
+	BlocksExporter               facade.BlocksExporter
+	TokenPriceProcessor          facade.TokenPriceProcessor
+	ProbesProcessor              facade.ProbesProcessor
+	FinalityProcessor            facade.FinalityProcessor
+	ValidatorKeysProcessor       facade.ValidatorKeysProcessor
+	FaucetRequestsQueue          facade.FaucetRequestsQueue
+	StakingOverviewProcessor     facade.StakingOverviewProcessor
+	DelegationProcessor          facade.DelegationProcessor
+	ClientsUsageProvider         facade.ClientsUsageProvider
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -45,6 +61,11 @@ func CreateVersionsRegistry(facadeArgs FacadeArgs, apiConfigParser ApiConfigPars
 		return nil, err
 	}
 
+	err = addVersionsV1AndV2(versionsRegistry)
+	if err != nil {
+		return nil, err
+	}
+
 	// un-comment these lines if you want to start proxy also with the v_next
 
 	// err = addVersionV_next(facadeArgs, versionsRegistry)
@@ -69,6 +90,34 @@ func addVersionV1_0AsDefault(versionRegistry data.VersionsRegistryHandler, apiCo
 	return versionRegistry.AddVersion("", v1_0handler)
 }
 
+// addVersionsV1AndV2 mounts the v1.0 handlers under the /v1 and /v2 route trees. /v1 is kept stable, while /v2 uses
+// a different serializer, so breaking changes of the responses' shape can live under it
+func addVersionsV1AndV2(versionRegistry data.VersionsRegistryHandler) error {
+	versionsMap, err := versionRegistry.GetAllVersions()
+	if err != nil {
+		return err
+	}
+
+	v1_0handler, ok := versionsMap["v1.0"]
+	if !ok {
+		return versions.ErrVersionNotFound
+	}
+
+	err = versionRegistry.AddVersion("v1", v1_0handler)
+	if err != nil {
+		return err
+	}
+
+	return versionRegistry.AddVersion("v2",
+		&data.VersionData{
+			Facade:     v1_0handler.Facade,
+			ApiHandler: v1_0handler.ApiHandler,
+			ApiConfig:  v1_0handler.ApiConfig,
+			Serializer: shared.NewResponseSerializerV2(),
+		},
+	)
+}
+
 func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) error {
 	v1_0Facade, err := createVersionV1_0Facade(facadeArgs)
 	if err != nil {
@@ -90,6 +139,7 @@ func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistry
 			Facade:     v1_0Facade,
 			ApiHandler: apiHandler,
 			ApiConfig:  *apiConfig,
+			Serializer: shared.NewResponseSerializerV1(),
 		},
 	)
 }
@@ -111,6 +161,21 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		GasPriceProcessor:            facadeArgs.GasPriceProcessor,
+		SovereignProcessor:           facadeArgs.SovereignProcessor,
+		NetworkStatusStreamer:        facadeArgs.NetworkStatusStreamer,
+		NodePassthroughProc:          facadeArgs.NodePassthroughProc,
+		CollectionsProc:              facadeArgs.CollectionsProc,
+		DataFreshnessProc:            facadeArgs.DataFreshnessProc,
+		BlocksExporter:               facadeArgs.BlocksExporter,
+		TokenPriceProcessor:          facadeArgs.TokenPriceProcessor,
+		ProbesProcessor:              facadeArgs.ProbesProcessor,
+		FinalityProcessor:            facadeArgs.FinalityProcessor,
+		ValidatorKeysProcessor:       facadeArgs.ValidatorKeysProcessor,
+		FaucetRequestsQueue:          facadeArgs.FaucetRequestsQueue,
+		StakingOverviewProcessor:     facadeArgs.StakingOverviewProcessor,
+		DelegationProcessor:          facadeArgs.DelegationProcessor,
+		ClientsUsageProvider:         facadeArgs.ClientsUsageProvider,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -169,6 +234,21 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		GasPriceProcessor:            facadeArgs.GasPriceProcessor,
+		SovereignProcessor:           facadeArgs.SovereignProcessor,
+		NetworkStatusStreamer:        facadeArgs.NetworkStatusStreamer,
+		NodePassthroughProc:          facadeArgs.NodePassthroughProc,
+		CollectionsProc:              facadeArgs.CollectionsProc,
+		DataFreshnessProc:            facadeArgs.DataFreshnessProc,
+		BlocksExporter:               facadeArgs.BlocksExporter,
+		TokenPriceProcessor:          facadeArgs.TokenPriceProcessor,
+		ProbesProcessor:              facadeArgs.ProbesProcessor,
+		FinalityProcessor:            facadeArgs.FinalityProcessor,
+		ValidatorKeysProcessor:       facadeArgs.ValidatorKeysProcessor,
+		FaucetRequestsQueue:          facadeArgs.FaucetRequestsQueue,
+		StakingOverviewProcessor:     facadeArgs.StakingOverviewProcessor,
+		DelegationProcessor:          facadeArgs.DelegationProcessor,
+		ClientsUsageProvider:         facadeArgs.ClientsUsageProvider,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -208,5 +288,20 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.GasPriceProcessor,
+		args.SovereignProcessor,
+		args.NetworkStatusStreamer,
+		args.NodePassthroughProc,
+		args.CollectionsProc,
+		args.DataFreshnessProc,
+		args.BlocksExporter,
+		args.TokenPriceProcessor,
+		args.ProbesProcessor,
+		args.FinalityProcessor,
+		args.ValidatorKeysProcessor,
+		args.FaucetRequestsQueue,
+		args.StakingOverviewProcessor,
+		args.DelegationProcessor,
+		args.ClientsUsageProvider,
 	)
 }